@@ -0,0 +1,18 @@
+package agents
+
+import (
+	"github.com/michenriksen/aquatone/core"
+)
+
+func init() {
+	core.RegisterAgent(func() core.Agent { return NewTCPPortScanner() })
+	core.RegisterAgent(func() core.Agent { return NewURLPublisher() })
+	core.RegisterAgent(func() core.Agent { return NewURLRequester() })
+	core.RegisterAgent(func() core.Agent { return NewURLHostnameResolver() })
+	core.RegisterAgent(func() core.Agent { return NewURLPageTitleExtractor() })
+	core.RegisterAgent(func() core.Agent { return NewURLScreenshotter() })
+	core.RegisterAgent(func() core.Agent { return NewURLTechnologyFingerprinter() })
+	core.RegisterAgent(func() core.Agent { return NewURLTakeoverDetector() })
+	core.RegisterAgent(func() core.Agent { return NewURLFaviconFingerprinter() })
+	core.RegisterOptionalAgent(func() core.Agent { return NewURLSANHarvester() })
+}
@@ -3,6 +3,7 @@ package agents
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	"github.com/michenriksen/aquatone/core"
@@ -29,9 +30,20 @@ func (a *TCPPortScanner) Register(s *core.Session) error {
 func (a *TCPPortScanner) OnHost(host string) {
 	a.session.Out.Debug("[%s] Received new host: %s\n", a.ID(), host)
 	for _, port := range a.session.Ports {
+		if a.session.Stopped() {
+			a.session.Out.Debug("[%s] Session is stopping; skipping remaining ports on %s\n", a.ID(), host)
+			return
+		}
+		if !a.session.Scope.PortInScope(host, port) {
+			a.session.Out.Debug("[%s] Port %d on %s is out of scope; skipping\n", a.ID(), port, host)
+			continue
+		}
 		a.session.WaitGroup.Add()
 		go func(port int, host string) {
 			defer a.session.WaitGroup.Done()
+			if a.session.Stopped() {
+				return
+			}
 			if a.scanPort(port, host) {
 				a.session.Stats.IncrementPortOpen()
 				a.session.Out.Info("%s: port %s %s\n", host, Green(fmt.Sprintf("%d", port)), Green("open"))
@@ -45,7 +57,7 @@ func (a *TCPPortScanner) OnHost(host string) {
 }
 
 func (a *TCPPortScanner) scanPort(port int, host string) bool {
-	conn, _ := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", host, port), time.Duration(*a.session.Options.ScanTimeout)*time.Millisecond)
+	conn, _ := net.DialTimeout("tcp", net.JoinHostPort(host, strconv.Itoa(port)), time.Duration(*a.session.Options.ScanTimeout)*time.Millisecond)
 	if conn != nil {
 		conn.Close()
 		return true
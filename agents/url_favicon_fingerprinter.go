@@ -0,0 +1,241 @@
+package agents
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/michenriksen/aquatone/core"
+)
+
+const maxFaviconSize = 1024 * 1024
+
+type FaviconFingerprint struct {
+	Name    string   `json:"name"`
+	Website string   `json:"website"`
+	MMH3    []int32  `json:"mmh3"`
+	MD5     []string `json:"md5"`
+	SHA256  []string `json:"sha256"`
+}
+
+func (f FaviconFingerprint) Matches(favicon *core.Favicon) bool {
+	for _, hash := range f.MMH3 {
+		if hash == favicon.MMH3 {
+			return true
+		}
+	}
+	for _, hash := range f.MD5 {
+		if strings.ToLower(hash) == favicon.MD5 {
+			return true
+		}
+	}
+	for _, hash := range f.SHA256 {
+		if strings.ToLower(hash) == favicon.SHA256 {
+			return true
+		}
+	}
+	return false
+}
+
+type URLFaviconFingerprinter struct {
+	session      *core.Session
+	fingerprints []FaviconFingerprint
+}
+
+func NewURLFaviconFingerprinter() *URLFaviconFingerprinter {
+	return &URLFaviconFingerprinter{}
+}
+
+func (a *URLFaviconFingerprinter) ID() string {
+	return "agent:url_favicon_fingerprinter"
+}
+
+func (a *URLFaviconFingerprinter) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+	if err := a.loadFingerprints(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *URLFaviconFingerprinter) loadFingerprints() error {
+	fingerprints, err := a.session.Asset("static/favicon_fingerprints.json")
+	if err != nil {
+		return fmt.Errorf("Can't read favicon fingerprints file: %s", err)
+	}
+	if err := json.Unmarshal(fingerprints, &a.fingerprints); err != nil {
+		return fmt.Errorf("Can't parse favicon fingerprints file: %s", err)
+	}
+	return nil
+}
+
+func (a *URLFaviconFingerprinter) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.AddPage(page.URL)
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.DonePage(page.URL)
+		if a.session.Stopped() {
+			return
+		}
+
+		favicon := a.fetchFavicon(page)
+		if favicon == nil {
+			a.session.Out.Debug("[%s] No favicon found for %s\n", a.ID(), page.URL)
+			return
+		}
+		a.session.Out.Debug("[%s] Favicon for %s at %s has mmh3 hash %d\n", a.ID(), page.URL, favicon.URL, favicon.MMH3)
+		page.Lock()
+		page.Favicon = favicon
+		page.Unlock()
+
+		for _, f := range a.fingerprints {
+			if f.Matches(favicon) {
+				a.session.Out.Debug("[%s] Identified technology %s on %s from favicon\n", a.ID(), f.Name, page.URL)
+				page.AddTagIfMissing(f.Name, "info", f.Website)
+			}
+		}
+	}(page)
+}
+
+// fetchFavicon tries the icons declared with <link rel="icon"> in the saved
+// response body before falling back to /favicon.ico.
+func (a *URLFaviconFingerprinter) fetchFavicon(page *core.Page) *core.Favicon {
+	base := page.ParsedURL()
+	candidates := a.declaredIcons(page)
+	candidates = append(candidates, "/favicon.ico")
+
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToLower(candidate), "data:") {
+			if data, ok := decodeDataURI(candidate); ok {
+				return core.NewFavicon(base.String(), data)
+			}
+			continue
+		}
+
+		ref, err := url.Parse(candidate)
+		if err != nil {
+			continue
+		}
+		iconURL := base.ResolveReference(ref)
+		if iconURL.Scheme != "http" && iconURL.Scheme != "https" {
+			continue
+		}
+		if !a.session.Scope.URLInScope(iconURL.String()) {
+			a.session.Out.Debug("[%s] Skipping out of scope favicon %s for %s\n", a.ID(), iconURL, page.URL)
+			continue
+		}
+
+		if data, ok := a.download(base, iconURL); ok {
+			return core.NewFavicon(iconURL.String(), data)
+		}
+	}
+	return nil
+}
+
+func (a *URLFaviconFingerprinter) declaredIcons(page *core.Page) []string {
+	var icons []string
+	body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+	if err != nil {
+		return icons
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		a.session.Out.Debug("[%s] Error when parsing HTML body file for %s: %s\n", a.ID(), page.URL, err)
+		return icons
+	}
+
+	doc.Find("link[rel][href]").Each(func(i int, s *goquery.Selection) {
+		rel, _ := s.Attr("rel")
+		for _, token := range strings.Fields(strings.ToLower(rel)) {
+			if token == "icon" {
+				href, _ := s.Attr("href")
+				icons = append(icons, strings.TrimSpace(href))
+				return
+			}
+		}
+	})
+	return icons
+}
+
+// download fetches an icon. The custom Cookie and Authorization headers are
+// only sent when the icon has the same origin as the page, and at most
+// maxFaviconSize bytes of the body are read. Redirects are followed within
+// the scope and the -max-redirects limit only.
+func (a *URLFaviconFingerprinter) download(pageURL *url.URL, iconURL *url.URL) ([]byte, bool) {
+	agent := SetRequestHeaders(Gorequest(a.session.Options).Get(iconURL.String()), a.session)
+	if !core.SameOrigin(pageURL, iconURL) {
+		RemoveCredentialHeaders(agent)
+	}
+	req, err := agent.MakeRequest()
+	if err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		return nil, false
+	}
+	agent.Client.Transport = agent.Transport
+	agent.Client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) > *a.session.Options.MaxRedirects {
+			return http.ErrUseLastResponse
+		}
+		if !a.session.Scope.URLInScope(req.URL.String()) {
+			a.session.Out.Debug("[%s] Skipping out of scope favicon redirect to %s for %s\n", a.ID(), req.URL, pageURL)
+			return http.ErrUseLastResponse
+		}
+		if !core.SameOrigin(pageURL, req.URL) {
+			for name := range req.Header {
+				if IsCredentialHeader(name) {
+					req.Header.Del(name)
+				}
+			}
+		}
+		return nil
+	}
+	resp, err := agent.Client.Do(req)
+	if err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		return nil, false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return nil, false
+	}
+	if strings.HasPrefix(strings.ToLower(resp.Header.Get("Content-Type")), "text/html") {
+		return nil, false
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxFaviconSize+1))
+	if err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		return nil, false
+	}
+	if len(body) == 0 || len(body) > maxFaviconSize {
+		return nil, false
+	}
+	return body, true
+}
+
+func decodeDataURI(uri string) ([]byte, bool) {
+	i := strings.Index(uri, ",")
+	if i == -1 || !strings.HasSuffix(strings.ToLower(uri[:i]), ";base64") {
+		return nil, false
+	}
+	data, err := base64.StdEncoding.DecodeString(uri[i+1:])
+	if err != nil || len(data) == 0 {
+		return nil, false
+	}
+	return data, true
+}
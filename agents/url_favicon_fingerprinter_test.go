@@ -0,0 +1,85 @@
+package agents
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/michenriksen/aquatone/core"
+)
+
+func newTestSession(t *testing.T) *core.Session {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "aquatone-agents")
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := core.DefaultOptions()
+	*options.OutDir = dir
+	*options.Silent = true
+	sess, err := core.NewSessionWithOptions(options)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return sess
+}
+
+func TestFaviconDownloadFollowsRedirectsInScopeOnly(t *testing.T) {
+	var outOfScopeHits int32
+	outOfScope := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&outOfScopeHits, 1)
+		w.Write([]byte("icon"))
+	}))
+	defer outOfScope.Close()
+
+	var crossOriginAuth atomic.Value
+	crossOrigin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		crossOriginAuth.Store(r.Header.Get("Authorization"))
+		w.Write([]byte("icon"))
+	}))
+	defer crossOrigin.Close()
+
+	page := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/out.ico":
+			http.Redirect(w, r, outOfScope.URL+"/favicon.ico", http.StatusFound)
+		case "/cross.ico":
+			http.Redirect(w, r, crossOrigin.URL+"/favicon.ico", http.StatusFound)
+		}
+	}))
+	defer page.Close()
+
+	sess := newTestSession(t)
+	defer os.RemoveAll(*sess.Options.OutDir)
+	sess.Scope = core.NewScope()
+	for _, server := range []*httptest.Server{page, crossOrigin} {
+		if err := sess.Scope.AddRule(server.Listener.Addr().String()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sess.RequestHeaders["Authorization"] = "Bearer secret"
+	a := NewURLFaviconFingerprinter()
+	a.session = sess
+
+	pageURL, _ := url.Parse(page.URL + "/")
+	outURL, _ := url.Parse(page.URL + "/out.ico")
+	if _, ok := a.download(pageURL, outURL); ok {
+		t.Error("expected redirect out of scope not to be followed")
+	}
+	if atomic.LoadInt32(&outOfScopeHits) != 0 {
+		t.Error("expected out of scope host not to be requested")
+	}
+
+	crossURL, _ := url.Parse(page.URL + "/cross.ico")
+	if data, ok := a.download(pageURL, crossURL); !ok || string(data) != "icon" {
+		t.Fatalf("expected redirect in scope to be followed, got %q", data)
+	}
+	if auth, _ := crossOriginAuth.Load().(string); auth != "" {
+		t.Errorf("expected Authorization not to be sent to another origin, got %q", auth)
+	}
+}
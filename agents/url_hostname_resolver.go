@@ -36,13 +36,15 @@ func (a *URLHostnameResolver) OnURLResponsive(url string) {
 
 	if page.IsIPHost() {
 		a.session.Out.Debug("[%s] Skipping hostname resolving on IP host: %s\n", a.ID(), url)
+		page.Lock()
 		page.Addrs = []string{page.ParsedURL().Hostname()}
+		page.Unlock()
 		return
 	}
 
-	a.session.WaitGroup.Add()
+	a.session.WaitGroup.AddPage(page.URL)
 	go func(page *core.Page) {
-		defer a.session.WaitGroup.Done()
+		defer a.session.WaitGroup.DonePage(page.URL)
 		addrs, err := net.LookupHost(fmt.Sprintf("%s.", page.ParsedURL().Hostname()))
 		if err != nil {
 			a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
@@ -50,6 +52,8 @@ func (a *URLHostnameResolver) OnURLResponsive(url string) {
 			return
 		}
 
+		page.Lock()
 		page.Addrs = addrs
+		page.Unlock()
 	}(page)
 }
@@ -23,6 +23,7 @@ func (a *URLPageTitleExtractor) ID() string {
 
 func (a *URLPageTitleExtractor) Register(s *core.Session) error {
 	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	s.EventBus.SubscribeAsync(core.URLRendered, a.OnURLRendered, false)
 	a.session = s
 
 	return nil
@@ -36,21 +37,64 @@ func (a *URLPageTitleExtractor) OnURLResponsive(url string) {
 		return
 	}
 
-	a.session.WaitGroup.Add()
+	a.session.WaitGroup.AddPage(page.URL)
 	go func(page *core.Page) {
-		defer a.session.WaitGroup.Done()
+		defer a.session.WaitGroup.DonePage(page.URL)
 		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
 		if err != nil {
 			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
 			return
 		}
 
-		doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+		title, err := a.extractTitle(body)
 		if err != nil {
 			a.session.Out.Debug("[%s] Error when parsing HTML body file for %s: %s\n", a.ID(), page.URL, err)
 			return
 		}
+		page.Lock()
+		if page.RenderedPath == "" {
+			page.PageTitle = title
+		}
+		page.Unlock()
+	}(page)
+}
 
-		page.PageTitle = strings.TrimSpace(doc.Find("Title").Text())
+// OnURLRendered replaces the title with the one from the rendered DOM, as
+// many single page applications set their title with JavaScript.
+func (a *URLPageTitleExtractor) OnURLRendered(url string) {
+	a.session.Out.Debug("[%s] Received new rendered URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.AddPage(page.URL)
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.DonePage(page.URL)
+		body, err := a.session.ReadFile(page.RenderedPath)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading rendered DOM file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+
+		title, err := a.extractTitle(body)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error when parsing rendered DOM file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+		if title != "" {
+			page.Lock()
+			page.PageTitle = title
+			page.Unlock()
+		}
 	}(page)
 }
+
+func (a *URLPageTitleExtractor) extractTitle(body []byte) (string, error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(doc.Find("Title").Text()), nil
+}
@@ -2,8 +2,8 @@ package agents
 
 import (
 	"crypto/tls"
-	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	"github.com/michenriksen/aquatone/core"
@@ -29,12 +29,18 @@ func (a *URLPublisher) Register(s *core.Session) error {
 
 func (a *URLPublisher) OnTCPPort(port int, host string) {
 	a.session.Out.Debug("[%s] Received new open port on %s: %d\n", a.ID(), host, port)
+	if a.session.Stopped() {
+		return
+	}
 	var url string
 	if a.isTLS(port, host) {
 		url = HostAndPortToURL(host, port, "https")
 	} else {
 		url = HostAndPortToURL(host, port, "http")
 	}
+	if a.session.Stopped() {
+		return
+	}
 	a.session.EventBus.Publish(core.URL, url)
 }
 
@@ -51,7 +57,7 @@ func (a *URLPublisher) isTLS(port int, host string) bool {
 	conf := &tls.Config{
 		InsecureSkipVerify: true,
 	}
-	conn, err := tls.DialWithDialer(dialer, "tcp", fmt.Sprintf("%s:%d", host, port), conf)
+	conn, err := tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(host, strconv.Itoa(port)), conf)
 	if err != nil {
 		return false
 	}
@@ -3,6 +3,7 @@ package agents
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"strings"
 
@@ -28,17 +29,35 @@ func (a *URLRequester) Register(s *core.Session) error {
 	return nil
 }
 
+func (a *URLRequester) redirectPolicy(req gorequest.Request, via []gorequest.Request) error {
+	if !*a.session.Options.FollowRedirects || len(via) > *a.session.Options.MaxRedirects {
+		return http.ErrUseLastResponse
+	}
+	if !a.session.Scope.URLInScope(req.URL.String()) {
+		a.session.OutOfScope(req.URL.String())
+		return http.ErrUseLastResponse
+	}
+	return nil
+}
+
 func (a *URLRequester) OnURL(url string) {
 	a.session.Out.Debug("[%s] Received new URL %s\n", a.ID(), url)
-	a.session.WaitGroup.Add()
+	if a.session.Stopped() {
+		return
+	}
+	if *a.session.Options.Resume && a.session.GetPage(url) != nil {
+		a.session.Out.Debug("[%s] Skipping URL %s already present in resumed session\n", a.ID(), url)
+		return
+	}
+
+	a.session.WaitGroup.AddPage(url)
 	go func(url string) {
-		defer a.session.WaitGroup.Done()
+		defer a.session.WaitGroup.DonePage(url)
+		if a.session.Stopped() {
+			return
+		}
 		http := Gorequest(a.session.Options)
-		resp, _, errs := http.Get(url).
-			Set("User-Agent", RandomUserAgent()).
-			Set("X-Forwarded-For", RandomIPv4Address()).
-			Set("Via", fmt.Sprintf("1.1 %s", RandomIPv4Address())).
-			Set("Forwarded", fmt.Sprintf("for=%s;proto=http;by=%s", RandomIPv4Address(), RandomIPv4Address())).End()
+		resp, _, errs := SetRequestHeaders(http.Get(url).RedirectPolicy(a.redirectPolicy), a.session).End()
 		var status string
 		if errs != nil {
 			a.session.Stats.IncrementRequestFailed()
@@ -91,25 +110,84 @@ func (a *URLRequester) createPageFromResponse(url string, resp gorequest.Respons
 		return nil, err
 	}
 
+	page.Lock()
 	page.Status = resp.Status
+	page.Redirects = nil
+	page.Unlock()
 	for name, value := range resp.Header {
 		page.AddHeader(name, strings.Join(value, " "))
 	}
 
+	for _, hop := range a.redirectChain(resp) {
+		a.session.Out.Debug("[%s] %s redirected with %s to %s\n", a.ID(), hop.Request.URL, hop.Status, hop.Header.Get("Location"))
+		page.AddRedirect(hop.Request.URL.String(), hop.Status, hop.Header.Get("Location"))
+	}
+
+	// The TLS details belong to the response to the page's own URL, not to
+	// the last response of a redirect chain that may have ended on another
+	// scheme or host.
+	origin := (*http.Response)(resp)
+	if chain := a.redirectChain(resp); len(chain) > 0 {
+		origin = chain[0]
+	}
+	if origin.TLS != nil {
+		a.addTLSInfo(page, origin)
+	}
+
 	return page, nil
 }
 
+func (a *URLRequester) addTLSInfo(page *core.Page, resp *http.Response) {
+	page.Lock()
+	page.TLS = core.NewTLSInfo(resp.TLS, resp.Request.URL.Hostname())
+	page.Unlock()
+	if leaf := page.TLS.Leaf(); leaf != nil {
+		a.session.Out.Debug("[%s] %s uses %s with %s and certificate for %s issued by %s\n", a.ID(), page.URL, page.TLS.Version, page.TLS.CipherSuite, leaf.Subject, leaf.Issuer)
+	}
+	if page.TLS.Expired {
+		page.AddTag("Expired Certificate", "warning", "")
+	}
+	if page.TLS.SelfSigned {
+		page.AddTag("Self-Signed Certificate", "warning", "")
+	}
+	if page.TLS.NameMismatch {
+		page.AddTag("Certificate Name Mismatch", "warning", "")
+	}
+}
+
+// redirectChain returns the redirect responses that led to resp, in the
+// order they were received. If resp is itself a redirect that was not
+// followed, it is included as the last hop.
+func (a *URLRequester) redirectChain(resp gorequest.Response) []*http.Response {
+	var chain []*http.Response
+	if isRedirect(resp) {
+		chain = append(chain, resp)
+	}
+	for req := resp.Request; req != nil && req.Response != nil; req = req.Response.Request {
+		chain = append([]*http.Response{req.Response}, chain...)
+	}
+	return chain
+}
+
+func isRedirect(resp *http.Response) bool {
+	return resp.StatusCode >= 300 && resp.StatusCode < 400 && resp.Header.Get("Location") != ""
+}
+
 func (a *URLRequester) writeHeaders(page *core.Page) {
 	filepath := fmt.Sprintf("headers/%s.txt", page.BaseFilename())
+	page.Lock()
 	headers := fmt.Sprintf("%s\n", page.Status)
 	for _, header := range page.Headers {
 		headers += fmt.Sprintf("%v: %v\n", header.Name, header.Value)
 	}
+	page.Unlock()
 	if err := ioutil.WriteFile(a.session.GetFilePath(filepath), []byte(headers), 0644); err != nil {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 		a.session.Out.Error("Failed to write HTTP response headers for %s to %s\n", page.URL, a.session.GetFilePath(filepath))
 	}
+	page.Lock()
 	page.HeadersPath = filepath
+	page.Unlock()
 }
 
 func (a *URLRequester) writeBody(page *core.Page, resp gorequest.Response) {
@@ -125,5 +203,7 @@ func (a *URLRequester) writeBody(page *core.Page, resp gorequest.Response) {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 		a.session.Out.Error("Failed to write HTTP response body for %s to %s\n", page.URL, a.session.GetFilePath(filepath))
 	}
+	page.Lock()
 	page.BodyPath = filepath
+	page.Unlock()
 }
@@ -0,0 +1,130 @@
+package agents
+
+import (
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/michenriksen/aquatone/core"
+	"golang.org/x/net/publicsuffix"
+)
+
+type URLSANHarvester struct {
+	session *core.Session
+	seen    map[string]bool
+	mutex   sync.Mutex
+}
+
+func NewURLSANHarvester() *URLSANHarvester {
+	return &URLSANHarvester{
+		seen: make(map[string]bool),
+	}
+}
+
+func (a *URLSANHarvester) ID() string {
+	return "agent:url_san_harvester"
+}
+
+func (a *URLSANHarvester) Register(s *core.Session) error {
+	s.EventBus.SubscribeAsync(core.Host, a.OnHost, false)
+	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	a.session = s
+
+	return nil
+}
+
+func (a *URLSANHarvester) OnHost(host string) {
+	a.markSeen(host)
+}
+
+func (a *URLSANHarvester) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.markSeen(page.Hostname)
+	if page.TLS == nil || page.TLS.Leaf() == nil {
+		return
+	}
+
+	leaf := page.TLS.Leaf()
+	names := append([]string{leaf.CommonName}, leaf.SANs...)
+	for _, name := range names {
+		if a.session.Stopped() {
+			return
+		}
+		hostname, ok := a.normalizeName(name)
+		if !ok || !a.markSeen(hostname) {
+			continue
+		}
+		if !a.isRelated(hostname, page.Hostname) {
+			a.session.Out.Debug("[%s] Skipping unrelated certificate name %s on %s\n", a.ID(), hostname, page.URL)
+			continue
+		}
+		if !a.session.Scope.HostInScope(hostname) {
+			a.session.Out.Debug("[%s] Skipping out of scope certificate name %s on %s\n", a.ID(), hostname, page.URL)
+			continue
+		}
+
+		a.session.Out.Info("%s: %s %s\n", page.URL, Green("new hostname from certificate:"), hostname)
+		a.session.EventBus.Publish(core.Host, hostname)
+	}
+}
+
+// markSeen records a hostname and returns false if it was already seen. Every
+// hostname is only ever published once, so certificates referring to each
+// other can't make the harvester loop.
+func (a *URLSANHarvester) markSeen(hostname string) bool {
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.seen[hostname] {
+		return false
+	}
+	a.seen[hostname] = true
+	return true
+}
+
+// normalizeName turns a certificate name into a hostname that can be scanned.
+// Wildcard names are reduced to the domain they cover, and IP addresses and
+// invalid names are skipped.
+func (a *URLSANHarvester) normalizeName(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "."))
+	name = strings.TrimPrefix(name, "*.")
+	if name == "" || net.ParseIP(name) != nil || !strings.Contains(name, ".") {
+		return "", false
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return "", false
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+				return "", false
+			}
+		}
+	}
+	return name, true
+}
+
+// isRelated reports whether a certificate name may be harvested from a page.
+// With a scope file, the scope decides; without one, only names under the
+// same registered domain as the page are harvested to avoid wandering off
+// into shared CDN and hosting certificates.
+func (a *URLSANHarvester) isRelated(hostname string, pageHostname string) bool {
+	if !a.session.Scope.Empty() {
+		return true
+	}
+	domain, err := publicsuffix.EffectiveTLDPlusOne(hostname)
+	if err != nil {
+		return false
+	}
+	pageDomain, err := publicsuffix.EffectiveTLDPlusOne(strings.TrimSuffix(pageHostname, "."))
+	if err != nil {
+		return false
+	}
+	return domain == pageDomain
+}
@@ -4,20 +4,34 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/michenriksen/aquatone/browser"
 	"github.com/michenriksen/aquatone/core"
 )
 
+// maxFullPageSize caps the size of full page screenshots in CSS pixels.
+const maxFullPageSize = 16384
+
 type URLScreenshotter struct {
 	session         *core.Session
 	chromePath      string
 	tempUserDirPath string
+	script          string
+	cookies         []*http.Cookie
+	launchOnce      sync.Once
+	launchErr       error
+	browserMutex    sync.Mutex
+	browser         *browser.Browser
+	pool            *browser.Pool
 }
 
 func NewURLScreenshotter() *URLScreenshotter {
@@ -31,9 +45,18 @@ func (a *URLScreenshotter) ID() string {
 func (a *URLScreenshotter) Register(s *core.Session) error {
 	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
 	s.EventBus.SubscribeAsync(core.SessionEnd, a.OnSessionEnd, false)
+	s.OnAbort(a.abort)
 	a.session = s
-	a.createTempUserDir()
-	a.locateChrome()
+	if err := a.loadScript(); err != nil {
+		return err
+	}
+	a.parseCookies()
+	if err := a.createTempUserDir(); err != nil {
+		return err
+	}
+	if err := a.locateChrome(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -46,33 +69,57 @@ func (a *URLScreenshotter) OnURLResponsive(url string) {
 		return
 	}
 
-	a.session.WaitGroup.Add()
+	a.session.WaitGroup.AddPage(page.URL)
 	go func(page *core.Page) {
-		defer a.session.WaitGroup.Done()
+		defer a.session.WaitGroup.DonePage(page.URL)
+		if a.session.Stopped() {
+			return
+		}
 		a.screenshotPage(page)
 	}(page)
 }
 
 func (a *URLScreenshotter) OnSessionEnd() {
 	a.session.Out.Debug("[%s] Received SessionEnd event\n", a.ID())
+	if b := a.launchedBrowser(); b != nil {
+		a.pool.Close()
+		b.Close()
+		a.session.Out.Debug("[%s] Closed Chrome/Chromium\n", a.ID())
+	}
 	os.RemoveAll(a.tempUserDirPath)
 	a.session.Out.Debug("[%s] Deleted temporary user directory at: %s\n", a.ID(), a.tempUserDirPath)
 }
 
-func (a *URLScreenshotter) createTempUserDir() {
+// abort kills the browser and deletes its user directory when the session is
+// aborted, as Aquatone exits without waiting for SessionEnd.
+func (a *URLScreenshotter) abort() {
+	if b := a.launchedBrowser(); b != nil {
+		b.Kill()
+		a.session.Out.Debug("[%s] Killed Chrome/Chromium\n", a.ID())
+	}
+	os.RemoveAll(a.tempUserDirPath)
+}
+
+func (a *URLScreenshotter) launchedBrowser() *browser.Browser {
+	a.browserMutex.Lock()
+	defer a.browserMutex.Unlock()
+	return a.browser
+}
+
+func (a *URLScreenshotter) createTempUserDir() error {
 	dir, err := ioutil.TempDir("", "aquatone-chrome")
 	if err != nil {
-		a.session.Out.Fatal("Unable to create temporary user directory for Chrome/Chromium browser\n")
-		os.Exit(1)
+		return fmt.Errorf("Unable to create temporary user directory for Chrome/Chromium browser: %s", err)
 	}
 	a.session.Out.Debug("[%s] Created temporary user directory at: %s\n", a.ID(), dir)
 	a.tempUserDirPath = dir
+	return nil
 }
 
-func (a *URLScreenshotter) locateChrome() {
+func (a *URLScreenshotter) locateChrome() error {
 	if *a.session.Options.ChromePath != "" {
 		a.chromePath = *a.session.Options.ChromePath
-		return
+		return nil
 	}
 
 	paths := []string{
@@ -95,8 +142,8 @@ func (a *URLScreenshotter) locateChrome() {
 	}
 
 	if a.chromePath == "" {
-		a.session.Out.Fatal("Unable to locate a valid installation of Chrome. Install Google Chrome or try specifying a valid location with the -chrome-path option.\n")
-		os.Exit(1)
+		os.RemoveAll(a.tempUserDirPath)
+		return fmt.Errorf("Unable to locate a valid installation of Chrome. Install Google Chrome or try specifying a valid location with the -chrome-path option")
 	}
 
 	if strings.Contains(strings.ToLower(a.chromePath), "chrome") {
@@ -105,14 +152,14 @@ func (a *URLScreenshotter) locateChrome() {
 		out, err := exec.Command(a.chromePath, "--version").Output()
 		if err != nil {
 			a.session.Out.Warn("An error occurred while trying to determine version of Chromium.\n\n")
-			return
+			return nil
 		}
 		version := string(out)
 		re := regexp.MustCompile(`(\d+)\.`)
 		match := re.FindStringSubmatch(version)
 		if len(match) <= 0 {
 			a.session.Out.Warn("Unable to determine version of Chromium. Screenshotting might be unreliable.\n\n")
-			return
+			return nil
 		}
 		majorVersion, _ := strconv.Atoi(match[1])
 		if majorVersion < 72 {
@@ -121,67 +168,373 @@ func (a *URLScreenshotter) locateChrome() {
 	}
 
 	a.session.Out.Debug("[%s] Located Chrome/Chromium binary at %s\n", a.ID(), a.chromePath)
+	return nil
 }
 
-func (a *URLScreenshotter) screenshotPage(page *core.Page) {
-	filePath := fmt.Sprintf("screenshots/%s.png", page.BaseFilename())
-	var chromeArguments = []string{
-		"--headless", "--disable-gpu", "--hide-scrollbars", "--mute-audio", "--disable-notifications",
-		"--no-first-run", "--disable-crash-reporter", "--ignore-certificate-errors", "--incognito",
-		"--disable-infobars", "--disable-sync", "--no-default-browser-check",
-		"--user-data-dir=" + a.tempUserDirPath,
-		"--user-agent=" + RandomUserAgent(),
-		"--window-size=" + *a.session.Options.Resolution,
-		"--screenshot=" + a.session.GetFilePath(filePath),
+func (a *URLScreenshotter) loadScript() error {
+	script := *a.session.Options.ScreenshotScript
+	if strings.HasPrefix(script, "@") {
+		data, err := ioutil.ReadFile(strings.TrimPrefix(script, "@"))
+		if err != nil {
+			return fmt.Errorf("Unable to read screenshot script: %s", err)
+		}
+		script = string(data)
+	}
+	a.script = strings.TrimSpace(script)
+	return nil
+}
+
+// launchBrowser starts the shared browser the first time a screenshot is
+// needed, so runs without responsive URLs never start Chrome/Chromium.
+func (a *URLScreenshotter) launchBrowser() error {
+	a.launchOnce.Do(func() {
+		b, err := browser.Launch(a.session.Context(), browser.Config{
+			Path:        a.chromePath,
+			UserDataDir: a.tempUserDirPath,
+			Proxy:       *a.session.Options.Proxy,
+		})
+		if err != nil {
+			a.launchErr = fmt.Errorf("Unable to launch Chrome/Chromium: %s", err)
+			a.session.Out.Error("%s\n", a.launchErr)
+			return
+		}
+		a.session.Out.Debug("[%s] Launched Chrome/Chromium with %d tabs\n", a.ID(), *a.session.Options.ScreenshotTabs)
+		a.pool = browser.NewPool(b, *a.session.Options.ScreenshotTabs, a.setupTab)
+		a.browserMutex.Lock()
+		a.browser = b
+		a.browserMutex.Unlock()
+	})
+	return a.launchErr
+}
+
+// parseCookies splits the custom Cookie header into cookies, which are set
+// in the browser for the host of each page before it is loaded.
+func (a *URLScreenshotter) parseCookies() {
+	if cookie, ok := a.session.RequestHeaders["Cookie"]; ok {
+		req := &http.Request{Header: http.Header{"Cookie": {cookie}}}
+		a.cookies = req.Cookies()
+	}
+}
+
+// setupTab applies the custom headers to a new tab. Cookie and Authorization
+// are not set as extra headers, as those are sent with every request of the
+// page, including requests to third party hosts. The Authorization header is
+// instead added to requests to the origin of the page only. When a scope is
+// given, documents outside of it are blocked, so redirects, frames and
+// navigations by scripts can't reach out of scope hosts.
+func (a *URLScreenshotter) setupTab(ctx context.Context, tab *browser.Tab) error {
+	if headers := a.extraHeaders(); len(headers) > 0 {
+		if err := tab.SetExtraHeaders(ctx, headers); err != nil {
+			return err
+		}
 	}
 
-	if os.Geteuid() == 0 {
-		chromeArguments = append(chromeArguments, "--no-sandbox")
+	authorization, hasAuthorization := a.session.RequestHeaders["Authorization"]
+	if !hasAuthorization && a.session.Scope.Empty() {
+		return nil
 	}
+	return tab.InterceptRequests(ctx, func(req browser.Request) (map[string]string, bool) {
+		if a.outOfScopeDocument(req) {
+			a.session.OutOfScope(req.URL)
+			return nil, true
+		}
+		if !hasAuthorization {
+			return nil, false
+		}
+		page, err := url.Parse(req.PageURL)
+		if err != nil {
+			return nil, false
+		}
+		reqURL, err := url.Parse(req.URL)
+		if err != nil || !core.SameOrigin(page, reqURL) {
+			return nil, false
+		}
+		return map[string]string{"Authorization": authorization}, false
+	})
+}
 
-	if *a.session.Options.Proxy != "" {
-		chromeArguments = append(chromeArguments, "--proxy-server="+*a.session.Options.Proxy)
+// extraHeaders returns the custom headers that are sent with every request of
+// a tab. The User-Agent is set per viewport, and cookies and Authorization are
+// scoped to the page as described in setupTab.
+func (a *URLScreenshotter) extraHeaders() map[string]string {
+	headers := make(map[string]string)
+	for name, value := range a.session.RequestHeaders {
+		if name != "User-Agent" && !IsCredentialHeader(name) {
+			headers[name] = value
+		}
 	}
+	return headers
+}
 
-	chromeArguments = append(chromeArguments, page.URL)
+// outOfScopeDocument reports whether a request loads a page or frame from a
+// host that is out of scope.
+func (a *URLScreenshotter) outOfScopeDocument(req browser.Request) bool {
+	if req.ResourceType != "Document" || a.session.Scope.Empty() {
+		return false
+	}
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	return !a.session.Scope.URLInScope(req.URL)
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.session.Options.ScreenshotTimeout)*time.Millisecond)
-	defer cancel()
+// userAgent returns a custom User-Agent header if one is given, or else the
+// user agent of the viewport profile or a random desktop user agent.
+func (a *URLScreenshotter) userAgent(viewport core.Viewport) string {
+	if userAgent, ok := a.session.RequestHeaders["User-Agent"]; ok {
+		return userAgent
+	}
+	if viewport.UserAgent != "" {
+		return viewport.UserAgent
+	}
+	return RandomUserAgent()
+}
 
-	cmd := exec.CommandContext(ctx, a.chromePath, chromeArguments...)
-	if err := cmd.Start(); err != nil {
-		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
-		a.session.Stats.IncrementScreenshotFailed()
-		a.session.Out.Error("%s: screenshot failed: %s\n", page.URL, err)
-		a.killChromeProcessIfRunning(cmd)
-		return
+// screenshotFilePath returns the path of the screenshot of a page in a
+// viewport. The first viewport keeps the plain filename used before viewports
+// were introduced.
+func (a *URLScreenshotter) screenshotFilePath(page *core.Page, index int, viewport core.Viewport) string {
+	if index == 0 {
+		return fmt.Sprintf("screenshots/%s.png", page.BaseFilename())
 	}
+	return fmt.Sprintf("screenshots/%s__%s.png", page.BaseFilename(), viewport.Name)
+}
 
-	if err := cmd.Wait(); err != nil {
-		a.session.Stats.IncrementScreenshotFailed()
-		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
-		if ctx.Err() == context.DeadlineExceeded {
-			a.session.Out.Error("%s: screenshot timed out\n", page.URL)
-			a.killChromeProcessIfRunning(cmd)
+func (a *URLScreenshotter) screenshotPage(page *core.Page) {
+	fullPage := *a.session.Options.FullPage
+	for i, viewport := range a.session.Viewports {
+		if a.session.Stopped() {
+			return
+		}
+
+		filePath := a.screenshotFilePath(page, i, viewport)
+		if *a.session.Options.Resume {
+			if _, err := os.Stat(a.session.GetFilePath(filePath)); err == nil {
+				a.session.Out.Debug("[%s] Skipping %s screenshot of %s as it already exists on disk\n", a.ID(), viewport.Name, page.URL)
+				if i == 0 {
+					a.resumeRendered(page)
+				}
+				if page.AddScreenshot(viewport.Name, filePath, fullPage) {
+					a.hashScreenshot(page, filePath)
+				}
+				continue
+			}
+		}
+
+		if err := a.launchBrowser(); err != nil {
+			a.session.Stats.IncrementScreenshotFailed()
 			return
 		}
 
-		a.session.Out.Error("%s: screenshot failed: %s\n", page.URL, err)
-		a.killChromeProcessIfRunning(cmd)
+		// The rendered DOM and browser log are only collected in the first
+		// viewport as they rarely differ between viewports.
+		if err := a.takeScreenshot(page, viewport, filePath, i == 0); err != nil {
+			a.screenshotFailed(page, viewport, err)
+			continue
+		}
+
+		a.session.Stats.IncrementScreenshotSuccessful()
+		a.session.Out.Info("%s: %s\n", page.URL, Green(a.describe("screenshot successful", viewport)))
+		if page.AddScreenshot(viewport.Name, filePath, fullPage) {
+			a.hashScreenshot(page, filePath)
+		}
+	}
+}
+
+// hashScreenshot stores the perceptual hash of a page's main screenshot, used
+// to cluster pages that look the same.
+func (a *URLScreenshotter) hashScreenshot(page *core.Page, filePath string) {
+	hash, err := core.ImageHashFile(a.session.GetFilePath(filePath))
+	if err != nil {
+		a.session.Out.Debug("[%s] Error hashing screenshot of %s: %v\n", a.ID(), page.URL, err)
 		return
 	}
+	page.Lock()
+	page.ScreenshotHash = hash
+	page.Unlock()
+}
+
+func (a *URLScreenshotter) takeScreenshot(page *core.Page, viewport core.Viewport, filePath string, collect bool) error {
+	tab, err := a.pool.Acquire(a.session.Context())
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(a.session.Context(), time.Duration(*a.session.Options.ScreenshotTimeout)*time.Millisecond)
+	defer cancel()
+
+	var recorder *browser.Recorder
+	if collect {
+		recorder = tab.Record()
+	}
+	data, html, err := a.capture(ctx, tab, page.URL, viewport, collect)
+	if recorder != nil {
+		console, failedRequests := recorder.Stop()
+		if err == nil {
+			a.addBrowserLog(page, console, failedRequests)
+		}
+	}
+	a.pool.Release(tab)
+	if err != nil {
+		return err
+	}
 
-	a.session.Stats.IncrementScreenshotSuccessful()
-	a.session.Out.Info("%s: %s\n", page.URL, Green("screenshot successful"))
-	page.ScreenshotPath = filePath
-	page.HasScreenshot = true
-	a.killChromeProcessIfRunning(cmd)
+	if err := ioutil.WriteFile(a.session.GetFilePath(filePath), data, 0644); err != nil {
+		return err
+	}
+	if html != "" {
+		a.writeRendered(page, html)
+	}
+	return nil
 }
 
-func (a *URLScreenshotter) killChromeProcessIfRunning(cmd *exec.Cmd) {
-	if cmd.Process == nil {
+// capture loads a page in a viewport and returns the screenshot and, if
+// collect is true, the rendered DOM.
+func (a *URLScreenshotter) capture(ctx context.Context, tab *browser.Tab, url string, viewport core.Viewport, collect bool) ([]byte, string, error) {
+	err := tab.SetViewport(ctx, browser.Viewport{
+		Width:             viewport.Width,
+		Height:            viewport.Height,
+		DeviceScaleFactor: viewport.DeviceScaleFactor,
+		Mobile:            viewport.Mobile,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if err := tab.SetUserAgent(ctx, a.userAgent(viewport)); err != nil {
+		return nil, "", err
+	}
+	if len(a.cookies) > 0 {
+		if err := tab.SetCookies(ctx, url, a.cookies); err != nil {
+			return nil, "", err
+		}
+	}
+	if err := tab.Navigate(ctx, url); err != nil {
+		return nil, "", err
+	}
+	a.preparePage(ctx, tab, url)
+
+	var data []byte
+	if *a.session.Options.FullPage {
+		data, err = tab.FullPageScreenshot(ctx, maxFullPageSize)
+	} else {
+		data, err = tab.Screenshot(ctx)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	var html string
+	if collect && *a.session.Options.SaveBody {
+		if html, err = tab.HTML(ctx); err != nil {
+			a.session.Out.Debug("[%s] Error getting rendered DOM of %s: %v\n", a.ID(), url, err)
+		}
+	}
+	return data, html, nil
+}
+
+func (a *URLScreenshotter) renderedFilePath(page *core.Page) string {
+	return fmt.Sprintf("html/%s__rendered.html", page.BaseFilename())
+}
+
+// writeRendered saves the rendered DOM of a page and lets other agents know
+// that it is available.
+func (a *URLScreenshotter) writeRendered(page *core.Page, html string) {
+	filePath := a.renderedFilePath(page)
+	if err := ioutil.WriteFile(a.session.GetFilePath(filePath), []byte(html), 0644); err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to write rendered DOM for %s to %s\n", page.URL, a.session.GetFilePath(filePath))
 		return
 	}
-	cmd.Process.Release()
-	cmd.Process.Kill()
+	page.Lock()
+	page.RenderedPath = filePath
+	page.Unlock()
+	a.session.EventBus.Publish(core.URLRendered, page.URL)
+}
+
+func (a *URLScreenshotter) resumeRendered(page *core.Page) {
+	filePath := a.renderedFilePath(page)
+	if _, err := os.Stat(a.session.GetFilePath(filePath)); err == nil {
+		page.Lock()
+		page.RenderedPath = filePath
+		page.Unlock()
+	}
+}
+
+func (a *URLScreenshotter) addBrowserLog(page *core.Page, console []browser.ConsoleMessage, failedRequests []browser.FailedRequest) {
+	page.Lock()
+	defer page.Unlock()
+	for _, msg := range console {
+		page.Console = append(page.Console, core.ConsoleMessage{
+			Level:  msg.Level,
+			Text:   msg.Text,
+			Source: msg.Source,
+		})
+	}
+	for _, req := range failedRequests {
+		page.FailedRequests = append(page.FailedRequests, core.FailedRequest{
+			URL:    req.URL,
+			Status: req.Status,
+			Error:  req.Error,
+		})
+	}
+	if len(console) > 0 || len(failedRequests) > 0 {
+		a.session.Out.Debug("[%s] Recorded %d console errors and %d failed requests on %s\n", a.ID(), len(console), len(failedRequests), page.URL)
+	}
+}
+
+// preparePage waits for the configured conditions and runs the screenshot
+// script. The page is captured anyway if a wait does not finish in time, so
+// waits give up when only a quarter of the screenshot timeout is left.
+func (a *URLScreenshotter) preparePage(ctx context.Context, tab *browser.Tab, url string) {
+	waitCtx := ctx
+	if deadline, ok := ctx.Deadline(); ok {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithDeadline(ctx, deadline.Add(-time.Until(deadline)/4))
+		defer cancel()
+	}
+
+	if *a.session.Options.WaitNetworkIdle {
+		if err := tab.WaitForNetworkIdle(waitCtx); err != nil {
+			a.session.Out.Debug("[%s] Gave up waiting for network idle on %s: %v\n", a.ID(), url, err)
+		}
+	}
+	if selector := *a.session.Options.WaitSelector; selector != "" {
+		if err := tab.WaitForSelector(waitCtx, selector); err != nil {
+			a.session.Out.Debug("[%s] Gave up waiting for %s on %s: %v\n", a.ID(), selector, url, err)
+		}
+	}
+	if a.script != "" {
+		if err := tab.Evaluate(waitCtx, a.script, nil); err != nil {
+			a.session.Out.Warn("%s: screenshot script failed: %s\n", url, err)
+		}
+	}
+	if delay := *a.session.Options.ScreenshotDelay; delay > 0 {
+		select {
+		case <-time.After(time.Duration(delay) * time.Millisecond):
+		case <-waitCtx.Done():
+		}
+	}
+}
+
+// describe adds the viewport name to a status message when screenshots are
+// taken in more than one viewport.
+func (a *URLScreenshotter) describe(msg string, viewport core.Viewport) string {
+	if len(a.session.Viewports) > 1 {
+		return fmt.Sprintf("%s (%s)", msg, viewport.Name)
+	}
+	return msg
+}
+
+func (a *URLScreenshotter) screenshotFailed(page *core.Page, viewport core.Viewport, err error) {
+	a.session.Stats.IncrementScreenshotFailed()
+	a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+	switch {
+	case a.session.Stopped():
+		a.session.Out.Error("%s: %s\n", page.URL, a.describe("screenshot cancelled", viewport))
+	case err == context.DeadlineExceeded:
+		a.session.Out.Error("%s: %s\n", page.URL, a.describe("screenshot timed out", viewport))
+	default:
+		a.session.Out.Error("%s: %s: %s\n", page.URL, a.describe("screenshot failed", viewport), err)
+	}
 }
@@ -0,0 +1,34 @@
+package agents
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/michenriksen/aquatone/core"
+)
+
+func TestURLScreenshotterRequestHeaders(t *testing.T) {
+	sess := newTestSession(t)
+	defer os.RemoveAll(*sess.Options.OutDir)
+	sess.RequestHeaders = map[string]string{
+		"User-Agent":    "Custom/1.0",
+		"X-Api-Version": "2",
+		"Cookie":        "session=abc; theme=dark",
+		"Authorization": "Bearer secret",
+	}
+	a := NewURLScreenshotter()
+	a.session = sess
+	a.parseCookies()
+
+	want := map[string]string{"X-Api-Version": "2"}
+	if headers := a.extraHeaders(); !reflect.DeepEqual(headers, want) {
+		t.Errorf("expected extra headers %v, got %v", want, headers)
+	}
+	if len(a.cookies) != 2 || a.cookies[0].Name != "session" || a.cookies[1].Value != "dark" {
+		t.Errorf("expected cookies session and theme, got %v", a.cookies)
+	}
+	if userAgent := a.userAgent(core.Viewport{UserAgent: "Mobile/1.0"}); userAgent != "Custom/1.0" {
+		t.Errorf("expected custom User-Agent to be used for screenshots, got %s", userAgent)
+	}
+}
@@ -39,9 +39,9 @@ func (a *URLTakeoverDetector) OnURLResponsive(u string) {
 		return
 	}
 
-	a.session.WaitGroup.Add()
+	a.session.WaitGroup.AddPage(page.URL)
 	go func(p *core.Page) {
-		defer a.session.WaitGroup.Done()
+		defer a.session.WaitGroup.DonePage(p.URL)
 		a.runDetectorFunctions(p)
 	}(page)
 }
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"os"
 	"regexp"
 
 	"github.com/PuerkitoBio/goquery"
@@ -18,7 +17,7 @@ type FingerprintRegexp struct {
 type Fingerprint struct {
 	Name               string            `json:"name"`
 	Categories         []string          `json:"categories"`
-	Implies            []string          `json:"implies"`
+	Implies            ImpliedNames      `json:"implies"`
 	Website            string            `json:"website"`
 	Headers            map[string]string `json:"headers"`
 	HTML               []string          `json:"html"`
@@ -30,6 +29,34 @@ type Fingerprint struct {
 	MetaFingerprints   map[string]FingerprintRegexp
 }
 
+// ImpliedNames holds the names of the technologies implied by a fingerprint.
+// The fingerprints file lists them either by name or as full fingerprints.
+type ImpliedNames []string
+
+func (n *ImpliedNames) UnmarshalJSON(data []byte) error {
+	var implied []json.RawMessage
+	if err := json.Unmarshal(data, &implied); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(implied))
+	for _, raw := range implied {
+		var name string
+		if err := json.Unmarshal(raw, &name); err == nil {
+			names = append(names, name)
+			continue
+		}
+		var fingerprint struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &fingerprint); err != nil {
+			return err
+		}
+		names = append(names, fingerprint.Name)
+	}
+	*n = names
+	return nil
+}
+
 func (f *Fingerprint) LoadPatterns() {
 	f.HeaderFingerprints = make(map[string]FingerprintRegexp)
 	f.MetaFingerprints = make(map[string]FingerprintRegexp)
@@ -92,22 +119,27 @@ func (a *URLTechnologyFingerprinter) ID() string {
 
 func (a *URLTechnologyFingerprinter) Register(s *core.Session) error {
 	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
+	s.EventBus.SubscribeAsync(core.URLRendered, a.OnURLRendered, false)
 	a.session = s
-	a.loadFingerprints()
+	if err := a.loadFingerprints(); err != nil {
+		return err
+	}
 
 	return nil
 }
 
-func (a *URLTechnologyFingerprinter) loadFingerprints() {
+func (a *URLTechnologyFingerprinter) loadFingerprints() error {
 	fingerprints, err := a.session.Asset("static/wappalyzer_fingerprints.json")
 	if err != nil {
-		a.session.Out.Fatal("Can't read technology fingerprints file\n")
-		os.Exit(1)
+		return fmt.Errorf("Can't read technology fingerprints file: %s", err)
+	}
+	if err := json.Unmarshal(fingerprints, &a.fingerprints); err != nil {
+		return fmt.Errorf("Can't parse technology fingerprints file: %s", err)
 	}
-	json.Unmarshal(fingerprints, &a.fingerprints)
 	for i, _ := range a.fingerprints {
 		a.fingerprints[i].LoadPatterns()
 	}
+	return nil
 }
 
 func (a *URLTechnologyFingerprinter) OnURLResponsive(url string) {
@@ -118,31 +150,56 @@ func (a *URLTechnologyFingerprinter) OnURLResponsive(url string) {
 		return
 	}
 
-	a.session.WaitGroup.Add()
+	a.session.WaitGroup.AddPage(page.URL)
+	go func(page *core.Page) {
+		defer a.session.WaitGroup.DonePage(page.URL)
+		a.addTags(page, append(a.fingerprintHeaders(page), a.fingerprintBody(page)...))
+	}(page)
+}
+
+// OnURLRendered fingerprints the rendered DOM of a page to identify
+// technologies that are only visible after scripts have run.
+func (a *URLTechnologyFingerprinter) OnURLRendered(url string) {
+	a.session.Out.Debug("[%s] Received new rendered URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("Unable to find page for URL: %s\n", url)
+		return
+	}
+
+	a.session.WaitGroup.AddPage(page.URL)
 	go func(page *core.Page) {
-		defer a.session.WaitGroup.Done()
-		seen := make(map[string]struct{})
-		fingerprints := append(a.fingerprintHeaders(page), a.fingerprintBody(page)...)
-		for _, f := range fingerprints {
-			if _, ok := seen[f.Name]; ok {
+		defer a.session.WaitGroup.DonePage(page.URL)
+		body, err := a.session.ReadFile(page.RenderedPath)
+		if err != nil {
+			a.session.Out.Debug("[%s] Error reading rendered DOM file for %s: %s\n", a.ID(), page.URL, err)
+			return
+		}
+		a.addTags(page, a.fingerprintHTML(page, body))
+	}(page)
+}
+
+func (a *URLTechnologyFingerprinter) addTags(page *core.Page, fingerprints []Fingerprint) {
+	seen := make(map[string]struct{})
+	for _, f := range fingerprints {
+		if _, ok := seen[f.Name]; ok {
+			continue
+		}
+		seen[f.Name] = struct{}{}
+		page.AddTagIfMissing(f.Name, "info", f.Website)
+		for _, impl := range f.Implies {
+			if _, ok := seen[impl]; ok {
 				continue
 			}
-			seen[f.Name] = struct{}{}
-			page.AddTag(f.Name, "info", f.Website)
-			for _, impl := range f.Implies {
-				if _, ok := seen[impl]; ok {
-					continue
-				}
-				seen[impl] = struct{}{}
-				for _, implf := range a.fingerprints {
-					if impl == implf.Name {
-						page.AddTag(implf.Name, "info", implf.Website)
-						break
-					}
+			seen[impl] = struct{}{}
+			for _, implf := range a.fingerprints {
+				if impl == implf.Name {
+					page.AddTagIfMissing(implf.Name, "info", implf.Website)
+					break
 				}
 			}
 		}
-	}(page)
+	}
 }
 
 func (a *URLTechnologyFingerprinter) fingerprintHeaders(page *core.Page) []Fingerprint {
@@ -173,6 +230,11 @@ func (a *URLTechnologyFingerprinter) fingerprintBody(page *core.Page) []Fingerpr
 		a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
 		return technologies
 	}
+	return a.fingerprintHTML(page, body)
+}
+
+func (a *URLTechnologyFingerprinter) fingerprintHTML(page *core.Page, body []byte) []Fingerprint {
+	var technologies []Fingerprint
 	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		a.session.Out.Debug("[%s] Error when parsing HTML body file for %s: %s\n", a.ID(), page.URL, err)
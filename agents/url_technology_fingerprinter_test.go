@@ -0,0 +1,30 @@
+package agents
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/michenriksen/aquatone/core"
+)
+
+func TestFingerprintImpliedNames(t *testing.T) {
+	var fingerprint Fingerprint
+	data := `{"name": "WordPress", "implies": ["MySQL", {"name": "PHP", "implies": []}]}`
+	if err := json.Unmarshal([]byte(data), &fingerprint); err != nil {
+		t.Fatal(err)
+	}
+	if len(fingerprint.Implies) != 2 || fingerprint.Implies[0] != "MySQL" || fingerprint.Implies[1] != "PHP" {
+		t.Errorf("expected implied names MySQL and PHP, got %v", fingerprint.Implies)
+	}
+}
+
+func TestURLTechnologyFingerprinterLoadsFingerprints(t *testing.T) {
+	a := NewURLTechnologyFingerprinter()
+	a.session = &core.Session{}
+	if err := a.loadFingerprints(); err != nil {
+		t.Fatal(err)
+	}
+	if len(a.fingerprints) == 0 {
+		t.Fatal("expected fingerprints to be loaded")
+	}
+}
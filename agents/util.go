@@ -102,6 +102,40 @@ func Gorequest(o core.Options) *gorequest.SuperAgent {
 		TLSClientConfig(&tls.Config{InsecureSkipVerify: true})
 }
 
+// SetRequestHeaders adds a random User-Agent, spoofed client address headers
+// unless disabled, and the custom headers of the session to a request.
+// Custom headers take precedence over the generated ones.
+func SetRequestHeaders(req *gorequest.SuperAgent, s *core.Session) *gorequest.SuperAgent {
+	req.Set("User-Agent", RandomUserAgent())
+	if *s.Options.SpoofHeaders {
+		req.Set("X-Forwarded-For", RandomIPv4Address()).
+			Set("Via", fmt.Sprintf("1.1 %s", RandomIPv4Address())).
+			Set("Forwarded", fmt.Sprintf("for=%s;proto=http;by=%s", RandomIPv4Address(), RandomIPv4Address()))
+	}
+	for name, value := range s.RequestHeaders {
+		req.Set(name, value)
+	}
+	return req
+}
+
+// RemoveCredentialHeaders removes the Cookie and Authorization headers from
+// a request. It is used for requests made on behalf of a page to another
+// origin, which must not receive the credentials given for the page.
+func RemoveCredentialHeaders(req *gorequest.SuperAgent) *gorequest.SuperAgent {
+	for name := range req.Header {
+		if IsCredentialHeader(name) {
+			delete(req.Header, name)
+		}
+	}
+	return req
+}
+
+// IsCredentialHeader reports whether a header carries credentials that are
+// only sent to the origin of the page.
+func IsCredentialHeader(name string) bool {
+	return strings.EqualFold(name, "Cookie") || strings.EqualFold(name, "Authorization")
+}
+
 func BaseFilenameFromURL(s string) string {
 	u, err := url.Parse(s)
 	if err != nil {
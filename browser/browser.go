@@ -0,0 +1,209 @@
+package browser
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"sync"
+	"time"
+)
+
+const launchTimeout = 20 * time.Second
+
+var devToolsURLRegex = regexp.MustCompile(`DevTools listening on (ws://\S+)`)
+
+type Config struct {
+	Path        string
+	UserDataDir string
+	Proxy       string
+	Flags       []string
+}
+
+// Browser is a headless Chrome/Chromium process controlled over the DevTools
+// protocol.
+type Browser struct {
+	conn      *Conn
+	cmd       *exec.Cmd
+	host      string
+	exited    chan struct{}
+	closeOnce sync.Once
+}
+
+func Launch(ctx context.Context, config Config) (*Browser, error) {
+	args := []string{
+		"--headless", "--disable-gpu", "--hide-scrollbars", "--mute-audio", "--disable-notifications",
+		"--no-first-run", "--disable-crash-reporter", "--ignore-certificate-errors",
+		"--disable-infobars", "--disable-sync", "--no-default-browser-check",
+		"--remote-debugging-port=0", "--remote-allow-origins=*",
+		"--user-data-dir=" + config.UserDataDir,
+	}
+	if os.Geteuid() == 0 {
+		args = append(args, "--no-sandbox")
+	}
+	if config.Proxy != "" {
+		args = append(args, "--proxy-server="+config.Proxy)
+	}
+	args = append(args, config.Flags...)
+	args = append(args, "about:blank")
+
+	// The browser's child processes inherit stderr, so it is read through a
+	// plain pipe to let Wait return as soon as the browser itself exits.
+	stderr, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.Command(config.Path, args...)
+	cmd.Stderr = w
+	setProcessGroup(cmd)
+	err = cmd.Start()
+	w.Close()
+	if err != nil {
+		stderr.Close()
+		return nil, err
+	}
+
+	b := &Browser{
+		cmd:    cmd,
+		exited: make(chan struct{}),
+	}
+
+	wsURL := make(chan string, 1)
+	go func() {
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			if match := devToolsURLRegex.FindStringSubmatch(scanner.Text()); match != nil {
+				wsURL <- match[1]
+				break
+			}
+		}
+		io.Copy(ioutil.Discard, stderr)
+		stderr.Close()
+	}()
+	go func() {
+		cmd.Wait()
+		close(b.exited)
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, launchTimeout)
+	defer cancel()
+
+	select {
+	case u := <-wsURL:
+		parsed, err := url.Parse(u)
+		if err != nil {
+			b.kill()
+			return nil, err
+		}
+		b.host = parsed.Host
+		if b.conn, err = Dial(ctx, u); err != nil {
+			b.kill()
+			return nil, err
+		}
+		return b, nil
+	case <-b.exited:
+		return nil, fmt.Errorf("Browser exited before DevTools endpoint became available")
+	case <-ctx.Done():
+		b.kill()
+		return nil, fmt.Errorf("Timed out waiting for DevTools endpoint: %s", ctx.Err())
+	}
+}
+
+// NewTab opens a new tab in its own browser context so that cookies and
+// storage are not shared with other tabs.
+func (b *Browser) NewTab(ctx context.Context) (*Tab, error) {
+	var browserContext struct {
+		BrowserContextID string `json:"browserContextId"`
+	}
+	if err := b.conn.Call(ctx, "Target.createBrowserContext", nil, &browserContext); err != nil {
+		return nil, err
+	}
+
+	var target struct {
+		TargetID string `json:"targetId"`
+	}
+	params := map[string]interface{}{
+		"url":              "about:blank",
+		"browserContextId": browserContext.BrowserContextID,
+	}
+	if err := b.conn.Call(ctx, "Target.createTarget", params, &target); err != nil {
+		b.disposeBrowserContext(browserContext.BrowserContextID)
+		return nil, err
+	}
+
+	tab := &Tab{
+		browser:          b,
+		targetID:         target.TargetID,
+		browserContextID: browserContext.BrowserContextID,
+		lifecycle:        make(map[string]map[string]bool),
+		changed:          make(chan struct{}),
+	}
+	conn, err := Dial(ctx, fmt.Sprintf("ws://%s/devtools/page/%s", b.host, target.TargetID))
+	if err != nil {
+		tab.Close()
+		return nil, err
+	}
+	tab.conn = conn
+	go tab.watchLifecycle()
+
+	for _, method := range []string{"Page.enable", "Network.enable", "Runtime.enable"} {
+		if err := conn.Call(ctx, method, nil, nil); err != nil {
+			tab.Close()
+			return nil, err
+		}
+	}
+	if err := conn.Call(ctx, "Page.setLifecycleEventsEnabled", map[string]bool{"enabled": true}, nil); err != nil {
+		tab.Close()
+		return nil, err
+	}
+	return tab, nil
+}
+
+// Close asks the browser to shut down and kills it if it has not exited
+// within a few seconds.
+func (b *Browser) Close() {
+	b.closeOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		b.conn.Call(ctx, "Browser.close", nil, nil)
+		cancel()
+		b.conn.Close()
+
+		select {
+		case <-b.exited:
+		case <-time.After(5 * time.Second):
+			b.kill()
+		}
+	})
+}
+
+// Kill kills the browser and its child processes right away. It can be called
+// while Close is waiting for the browser to shut down.
+func (b *Browser) Kill() {
+	b.kill()
+	b.conn.Close()
+}
+
+func (b *Browser) kill() {
+	if b.cmd.Process == nil {
+		return
+	}
+	killProcessGroup(b.cmd)
+	<-b.exited
+}
+
+func (b *Browser) closeTarget(targetID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	b.conn.Call(ctx, "Target.closeTarget", map[string]string{"targetId": targetID}, nil)
+}
+
+func (b *Browser) disposeBrowserContext(id string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	b.conn.Call(ctx, "Target.disposeBrowserContext", map[string]string{"browserContextId": id}, nil)
+}
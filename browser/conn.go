@@ -0,0 +1,193 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+const maxMessageSize = 256 << 20
+
+var ErrConnClosed = errors.New("DevTools connection closed")
+
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (%d)", e.Message, e.Code)
+}
+
+type message struct {
+	ID     int64           `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params interface{}     `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *Error          `json:"error,omitempty"`
+}
+
+type Event struct {
+	Method string
+	Params json.RawMessage
+}
+
+type incoming struct {
+	ID     int64           `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *Error          `json:"error"`
+}
+
+// Conn is a connection to a DevTools protocol endpoint. Commands are sent with
+// Call and events are delivered to channels returned by Subscribe.
+type Conn struct {
+	ws          *websocket.Conn
+	writeMutex  sync.Mutex
+	mutex       sync.Mutex
+	nextID      int64
+	pending     map[int64]chan *incoming
+	subscribers map[string][]chan Event
+	closed      chan struct{}
+	closeOnce   sync.Once
+}
+
+func Dial(ctx context.Context, wsURL string) (*Conn, error) {
+	config, err := websocket.NewConfig(wsURL, "http://localhost/")
+	if err != nil {
+		return nil, err
+	}
+	config.Dialer = &net.Dialer{Timeout: 10 * time.Second}
+	if deadline, ok := ctx.Deadline(); ok {
+		config.Dialer.Deadline = deadline
+	}
+
+	ws, err := websocket.DialConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	ws.MaxPayloadBytes = maxMessageSize
+
+	c := &Conn{
+		ws:          ws,
+		pending:     make(map[int64]chan *incoming),
+		subscribers: make(map[string][]chan Event),
+		closed:      make(chan struct{}),
+	}
+	go c.read()
+	return c, nil
+}
+
+// Call sends a command and waits for its result, which is unmarshalled into
+// result unless it is nil.
+func (c *Conn) Call(ctx context.Context, method string, params interface{}, result interface{}) error {
+	ch := make(chan *incoming, 1)
+	c.mutex.Lock()
+	c.nextID++
+	id := c.nextID
+	c.pending[id] = ch
+	c.mutex.Unlock()
+
+	defer func() {
+		c.mutex.Lock()
+		delete(c.pending, id)
+		c.mutex.Unlock()
+	}()
+
+	c.writeMutex.Lock()
+	err := websocket.JSON.Send(c.ws, message{ID: id, Method: method, Params: params})
+	c.writeMutex.Unlock()
+	if err != nil {
+		c.Close()
+		return err
+	}
+
+	select {
+	case msg := <-ch:
+		if msg.Error != nil {
+			return fmt.Errorf("%s: %s", method, msg.Error)
+		}
+		if result != nil && len(msg.Result) > 0 {
+			return json.Unmarshal(msg.Result, result)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.closed:
+		return ErrConnClosed
+	}
+}
+
+// Subscribe returns a channel receiving every event with one of the given
+// methods, in the order they arrive, until the returned function is called.
+// Events are dropped if the channel is not drained in time.
+func (c *Conn) Subscribe(methods ...string) (<-chan Event, func()) {
+	ch := make(chan Event, 128)
+	c.mutex.Lock()
+	for _, method := range methods {
+		c.subscribers[method] = append(c.subscribers[method], ch)
+	}
+	c.mutex.Unlock()
+
+	return ch, func() {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+		for _, method := range methods {
+			subscribers := c.subscribers[method]
+			for i, sub := range subscribers {
+				if sub == ch {
+					c.subscribers[method] = append(subscribers[:i], subscribers[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+}
+
+func (c *Conn) Closed() <-chan struct{} {
+	return c.closed
+}
+
+func (c *Conn) Close() error {
+	var err error
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		err = c.ws.Close()
+	})
+	return err
+}
+
+func (c *Conn) read() {
+	defer c.Close()
+	for {
+		var msg incoming
+		if err := websocket.JSON.Receive(c.ws, &msg); err != nil {
+			if err == websocket.ErrFrameTooLarge {
+				continue
+			}
+			return
+		}
+
+		c.mutex.Lock()
+		if msg.ID != 0 {
+			if ch, ok := c.pending[msg.ID]; ok {
+				ch <- &msg
+			}
+		} else if msg.Method != "" {
+			for _, ch := range c.subscribers[msg.Method] {
+				select {
+				case ch <- Event{Method: msg.Method, Params: msg.Params}:
+				default:
+				}
+			}
+		}
+		c.mutex.Unlock()
+	}
+}
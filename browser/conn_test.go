@@ -0,0 +1,257 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+var (
+	// errFakeNoReply makes the fake DevTools server ignore a command.
+	errFakeNoReply = &Error{Message: "no reply"}
+	// errFakeClose makes the fake DevTools server close the connection.
+	errFakeClose = &Error{Message: "close"}
+)
+
+// fakeHandler answers a command sent to the fake DevTools server. Events can
+// be sent with send, also after the handler has returned.
+type fakeHandler func(send func(method string, params interface{}), params json.RawMessage) (interface{}, *Error)
+
+// fakeTargetHandler answers a command sent to the page endpoint of a target
+// and is given the ID of the target.
+type fakeTargetHandler func(targetID string, params json.RawMessage) (interface{}, *Error)
+
+type fakeCall struct {
+	Method string
+	Params json.RawMessage
+}
+
+// fakeDevTools is a DevTools protocol endpoint that records every command and
+// answers with the registered handler, or an empty result if there is none.
+type fakeDevTools struct {
+	*httptest.Server
+	mutex          sync.Mutex
+	handlers       map[string]fakeHandler
+	targetHandlers map[string]fakeTargetHandler
+	calls          []fakeCall
+}
+
+func newFakeDevTools() *fakeDevTools {
+	f := &fakeDevTools{
+		handlers:       make(map[string]fakeHandler),
+		targetHandlers: make(map[string]fakeTargetHandler),
+	}
+	f.Server = httptest.NewServer(websocket.Handler(f.serve))
+	return f
+}
+
+func (f *fakeDevTools) handle(method string, handler fakeHandler) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.handlers[method] = handler
+}
+
+// handleTarget registers a handler for commands sent to page endpoints. It
+// takes precedence over a handler registered with handle.
+func (f *fakeDevTools) handleTarget(method string, handler fakeTargetHandler) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.targetHandlers[method] = handler
+}
+
+func (f *fakeDevTools) url(path string) string {
+	return "ws://" + f.Listener.Addr().String() + path
+}
+
+// callsTo returns the parameters of every command with the given method.
+func (f *fakeDevTools) callsTo(method string) []json.RawMessage {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	var params []json.RawMessage
+	for _, call := range f.calls {
+		if call.Method == method {
+			params = append(params, call.Params)
+		}
+	}
+	return params
+}
+
+func (f *fakeDevTools) serve(ws *websocket.Conn) {
+	defer ws.Close()
+	targetID := ""
+	if path := ws.Request().URL.Path; strings.HasPrefix(path, "/devtools/page/") {
+		targetID = strings.TrimPrefix(path, "/devtools/page/")
+	}
+	var writeMutex sync.Mutex
+	write := func(v interface{}) {
+		writeMutex.Lock()
+		defer writeMutex.Unlock()
+		websocket.JSON.Send(ws, v)
+	}
+	send := func(method string, params interface{}) {
+		write(map[string]interface{}{"method": method, "params": params})
+	}
+
+	for {
+		var msg struct {
+			ID     int64           `json:"id"`
+			Method string          `json:"method"`
+			Params json.RawMessage `json:"params"`
+		}
+		if err := websocket.JSON.Receive(ws, &msg); err != nil {
+			return
+		}
+		f.mutex.Lock()
+		f.calls = append(f.calls, fakeCall{Method: msg.Method, Params: msg.Params})
+		handler := f.handlers[msg.Method]
+		targetHandler := f.targetHandlers[msg.Method]
+		f.mutex.Unlock()
+
+		var result interface{} = struct{}{}
+		var err *Error
+		if targetHandler != nil && targetID != "" {
+			result, err = targetHandler(targetID, msg.Params)
+		} else if handler != nil {
+			result, err = handler(send, msg.Params)
+		}
+		switch {
+		case err == errFakeNoReply:
+		case err == errFakeClose:
+			return
+		case err != nil:
+			write(map[string]interface{}{"id": msg.ID, "error": err})
+		default:
+			write(map[string]interface{}{"id": msg.ID, "result": result})
+		}
+	}
+}
+
+func dialFake(t *testing.T, f *fakeDevTools) *Conn {
+	t.Helper()
+	conn, err := Dial(context.Background(), f.url("/devtools/browser/test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func TestConnCall(t *testing.T) {
+	f := newFakeDevTools()
+	defer f.Close()
+	f.handle("Test.echo", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		return params, nil
+	})
+	f.handle("Test.fail", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		return nil, &Error{Code: -32000, Message: "something went wrong"}
+	})
+	conn := dialFake(t, f)
+	defer conn.Close()
+
+	var result struct {
+		Value string `json:"value"`
+	}
+	if err := conn.Call(context.Background(), "Test.echo", map[string]string{"value": "hello"}, &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.Value != "hello" {
+		t.Errorf("expected result value hello, got %q", result.Value)
+	}
+
+	err := conn.Call(context.Background(), "Test.fail", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "something went wrong (-32000)") {
+		t.Errorf("expected protocol error, got %v", err)
+	}
+}
+
+func TestConnCallContext(t *testing.T) {
+	f := newFakeDevTools()
+	defer f.Close()
+	f.handle("Test.hang", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		return nil, errFakeNoReply
+	})
+	conn := dialFake(t, f)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := conn.Call(ctx, "Test.hang", nil, nil); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	// The connection is still usable after a command timed out.
+	if err := conn.Call(context.Background(), "Test.ping", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConnClosedByEndpoint(t *testing.T) {
+	f := newFakeDevTools()
+	defer f.Close()
+	f.handle("Test.close", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		return nil, errFakeClose
+	})
+	conn := dialFake(t, f)
+	defer conn.Close()
+
+	if err := conn.Call(context.Background(), "Test.close", nil, nil); err != ErrConnClosed {
+		t.Fatalf("expected ErrConnClosed, got %v", err)
+	}
+	select {
+	case <-conn.Closed():
+	default:
+		t.Fatal("expected connection to be closed")
+	}
+	if err := conn.Call(context.Background(), "Test.ping", nil, nil); err == nil {
+		t.Fatal("expected call on closed connection to fail")
+	}
+}
+
+func TestConnSubscribe(t *testing.T) {
+	f := newFakeDevTools()
+	defer f.Close()
+	f.handle("Test.trigger", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		send("Test.other", map[string]int{"n": 0})
+		send("Test.event", map[string]int{"n": 1})
+		send("Test.event", map[string]int{"n": 2})
+		return nil, nil
+	})
+	conn := dialFake(t, f)
+	defer conn.Close()
+
+	events, unsubscribe := conn.Subscribe("Test.event")
+	if err := conn.Call(context.Background(), "Test.trigger", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	// Events sent before the result are delivered before Call returns.
+	for want := 1; want <= 2; want++ {
+		select {
+		case event := <-events:
+			var params struct {
+				N int `json:"n"`
+			}
+			if err := json.Unmarshal(event.Params, &params); err != nil {
+				t.Fatal(err)
+			}
+			if event.Method != "Test.event" || params.N != want {
+				t.Errorf("expected Test.event %d, got %s %d", want, event.Method, params.N)
+			}
+		default:
+			t.Fatalf("expected event %d to be delivered", want)
+		}
+	}
+
+	unsubscribe()
+	if err := conn.Call(context.Background(), "Test.trigger", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-events:
+		t.Errorf("expected no events after unsubscribing, got %s", event.Method)
+	default:
+	}
+}
@@ -0,0 +1,111 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const continueRequestTimeout = 10 * time.Second
+
+// Request is a request made by a tab that was paused by InterceptRequests.
+type Request struct {
+	// PageURL is the URL last passed to Navigate.
+	PageURL      string
+	URL          string
+	ResourceType string
+}
+
+// RequestFunc decides how a paused request is continued. It returns headers
+// to add to the request, or block set to fail the request instead.
+type RequestFunc func(req Request) (headers map[string]string, block bool)
+
+// InterceptRequests pauses every request of the tab before it is sent and
+// continues it with the headers returned by fn added, or fails it if fn
+// blocks it. This allows headers to be sent to some hosts only, which
+// Network.setExtraHTTPHeaders can't do, and navigations to be restricted,
+// including redirects and navigations started by scripts.
+func (t *Tab) InterceptRequests(ctx context.Context, fn RequestFunc) error {
+	events, unsubscribe := t.conn.Subscribe("Fetch.requestPaused")
+	params := map[string]interface{}{
+		"patterns": []map[string]string{
+			{"urlPattern": "*", "requestStage": "Request"},
+		},
+	}
+	if err := t.conn.Call(ctx, "Fetch.enable", params, nil); err != nil {
+		unsubscribe()
+		return err
+	}
+
+	go func() {
+		defer unsubscribe()
+		for {
+			select {
+			case event := <-events:
+				// Requests are continued concurrently so the subscription
+				// is drained quickly and no paused request is dropped.
+				go t.continueRequest(event.Params, fn)
+			case <-t.conn.Closed():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (t *Tab) continueRequest(params json.RawMessage, fn RequestFunc) {
+	var event struct {
+		RequestID    string `json:"requestId"`
+		ResourceType string `json:"resourceType"`
+		Request      struct {
+			URL     string            `json:"url"`
+			Headers map[string]string `json:"headers"`
+		} `json:"request"`
+	}
+	if err := json.Unmarshal(params, &event); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), continueRequestTimeout)
+	defer cancel()
+
+	extra, block := fn(Request{
+		PageURL:      t.navigatedURL(),
+		URL:          event.Request.URL,
+		ResourceType: event.ResourceType,
+	})
+	args := map[string]interface{}{"requestId": event.RequestID}
+	if block {
+		args["errorReason"] = "BlockedByClient"
+		t.conn.Call(ctx, "Fetch.failRequest", args, nil)
+		return
+	}
+	if len(extra) > 0 {
+		args["headers"] = mergeHeaders(event.Request.Headers, extra)
+	}
+	t.conn.Call(ctx, "Fetch.continueRequest", args, nil)
+}
+
+// mergeHeaders returns the headers of a request with extra headers added, in
+// the form expected by Fetch.continueRequest. Extra headers replace request
+// headers with the same name.
+func mergeHeaders(headers map[string]string, extra map[string]string) []map[string]string {
+	var merged []map[string]string
+	for name, value := range headers {
+		replaced := false
+		for extraName := range extra {
+			if strings.EqualFold(name, extraName) {
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, map[string]string{"name": name, "value": value})
+		}
+	}
+	for name, value := range extra {
+		merged = append(merged, map[string]string{"name": name, "value": value})
+	}
+	return merged
+}
@@ -0,0 +1,75 @@
+package browser
+
+import (
+	"context"
+	"sync"
+)
+
+// Pool hands out a limited number of tabs in a single browser. Every tab is
+// opened in its own browser context and is closed when it is released, which
+// disposes of the context along with its cookies, storage, caches and service
+// workers, so nothing a page leaves behind is visible to the next page.
+type Pool struct {
+	browser *Browser
+	setup   func(context.Context, *Tab) error
+	slots   chan struct{}
+	mutex   sync.Mutex
+	closed  bool
+}
+
+// NewPool creates a pool of up to size tabs. Setup, if not nil, is called for
+// every new tab before it is handed out.
+func NewPool(b *Browser, size int, setup func(context.Context, *Tab) error) *Pool {
+	if size < 1 {
+		size = 1
+	}
+	return &Pool{
+		browser: b,
+		setup:   setup,
+		slots:   make(chan struct{}, size),
+	}
+}
+
+func (p *Pool) Acquire(ctx context.Context) (*Tab, error) {
+	select {
+	case p.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	p.mutex.Lock()
+	closed := p.closed
+	p.mutex.Unlock()
+	if closed {
+		<-p.slots
+		return nil, ErrConnClosed
+	}
+
+	tab, err := p.browser.NewTab(ctx)
+	if err != nil {
+		<-p.slots
+		return nil, err
+	}
+	if p.setup != nil {
+		if err := p.setup(ctx, tab); err != nil {
+			tab.Close()
+			<-p.slots
+			return nil, err
+		}
+	}
+	return tab, nil
+}
+
+// Release closes a tab and frees its slot for a new tab.
+func (p *Pool) Release(tab *Tab) {
+	tab.Close()
+	<-p.slots
+}
+
+// Close makes Acquire fail. Tabs still in use are closed when they are
+// released.
+func (p *Pool) Close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.closed = true
+}
@@ -0,0 +1,243 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeCookie is a cookie stored by the fake browser.
+type fakeCookie struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// newFakeBrowser returns a browser connected to a fake DevTools endpoint that
+// creates numbered targets and browser contexts. Every browser context has its
+// own cookie jar, which is thrown away when the context is disposed.
+func newFakeBrowser(t *testing.T) (*Browser, *fakeDevTools) {
+	t.Helper()
+	f := newFakeDevTools()
+	var mutex sync.Mutex
+	targets, contexts := 0, 0
+	targetContexts := make(map[string]string)
+	jars := make(map[string][]fakeCookie)
+
+	f.handle("Target.createBrowserContext", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		contexts++
+		id := fmt.Sprintf("C%d", contexts)
+		jars[id] = nil
+		return map[string]string{"browserContextId": id}, nil
+	})
+	f.handle("Target.disposeBrowserContext", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		var p struct {
+			BrowserContextID string `json:"browserContextId"`
+		}
+		json.Unmarshal(params, &p)
+		mutex.Lock()
+		defer mutex.Unlock()
+		delete(jars, p.BrowserContextID)
+		return nil, nil
+	})
+	f.handle("Target.createTarget", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		var p struct {
+			BrowserContextID string `json:"browserContextId"`
+		}
+		json.Unmarshal(params, &p)
+		mutex.Lock()
+		defer mutex.Unlock()
+		targets++
+		id := fmt.Sprintf("T%d", targets)
+		targetContexts[id] = p.BrowserContextID
+		return map[string]string{"targetId": id}, nil
+	})
+	f.handleTarget("Network.setCookies", func(targetID string, params json.RawMessage) (interface{}, *Error) {
+		var p struct {
+			Cookies []fakeCookie `json:"cookies"`
+		}
+		json.Unmarshal(params, &p)
+		mutex.Lock()
+		defer mutex.Unlock()
+		id := targetContexts[targetID]
+		jars[id] = append(jars[id], p.Cookies...)
+		return nil, nil
+	})
+	f.handleTarget("Network.getCookies", func(targetID string, params json.RawMessage) (interface{}, *Error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		cookies := jars[targetContexts[targetID]]
+		if cookies == nil {
+			cookies = []fakeCookie{}
+		}
+		return map[string]interface{}{"cookies": cookies}, nil
+	})
+	return &Browser{conn: dialFake(t, f), host: f.Listener.Addr().String()}, f
+}
+
+func getFakeCookies(t *testing.T, tab *Tab) []fakeCookie {
+	t.Helper()
+	var result struct {
+		Cookies []fakeCookie `json:"cookies"`
+	}
+	if err := tab.conn.Call(context.Background(), "Network.getCookies", nil, &result); err != nil {
+		t.Fatal(err)
+	}
+	return result.Cookies
+}
+
+func TestPoolOpensNewTabForEveryPage(t *testing.T) {
+	b, f := newFakeBrowser(t)
+	defer f.Close()
+	defer b.conn.Close()
+
+	setups := 0
+	pool := NewPool(b, 1, func(ctx context.Context, tab *Tab) error {
+		setups++
+		return nil
+	})
+	defer pool.Close()
+
+	first, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.callsTo("Page.setLifecycleEventsEnabled")) != 1 {
+		t.Error("expected lifecycle events to be enabled in new tab")
+	}
+	pool.Release(first)
+	if len(f.callsTo("Target.closeTarget")) != 1 || len(f.callsTo("Target.disposeBrowserContext")) != 1 {
+		t.Error("expected released tab and its browser context to be closed")
+	}
+
+	second, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Release(second)
+	if second.targetID != "T2" || second.browserContextID != "C2" {
+		t.Errorf("expected a new tab in a new browser context, got target %s in %s", second.targetID, second.browserContextID)
+	}
+	if setups != 2 {
+		t.Errorf("expected setup to run for every tab, ran %d times", setups)
+	}
+}
+
+func TestPoolDoesNotShareCookiesBetweenPages(t *testing.T) {
+	b, f := newFakeBrowser(t)
+	defer f.Close()
+	defer b.conn.Close()
+
+	pool := NewPool(b, 1, nil)
+	defer pool.Close()
+
+	first, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cookie := &http.Cookie{Name: "session", Value: "secret"}
+	if err := first.SetCookies(context.Background(), "http://example.com/", []*http.Cookie{cookie}); err != nil {
+		t.Fatal(err)
+	}
+	if cookies := getFakeCookies(t, first); len(cookies) != 1 {
+		t.Fatalf("expected cookie to be set on first page, got %v", cookies)
+	}
+	pool.Release(first)
+
+	second, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Release(second)
+	if cookies := getFakeCookies(t, second); len(cookies) != 0 {
+		t.Errorf("expected no cookies on next page, got %v", cookies)
+	}
+}
+
+func TestPoolLimitsTabs(t *testing.T) {
+	b, f := newFakeBrowser(t)
+	defer f.Close()
+	defer b.conn.Close()
+
+	pool := NewPool(b, 1, nil)
+	defer pool.Close()
+
+	first, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := pool.Acquire(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected Acquire to wait for a free tab, got %v", err)
+	}
+
+	pool.Release(first)
+	if len(f.callsTo("Target.closeTarget")) != 1 {
+		t.Error("expected released tab to be closed")
+	}
+
+	second, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Release(second)
+	if second == first || second.targetID != "T2" {
+		t.Errorf("expected a new tab after releasing, got target %s", second.targetID)
+	}
+}
+
+func TestPoolSetupError(t *testing.T) {
+	b, f := newFakeBrowser(t)
+	defer f.Close()
+	defer b.conn.Close()
+
+	setupErr := errors.New("setup failed")
+	pool := NewPool(b, 1, func(ctx context.Context, tab *Tab) error {
+		return setupErr
+	})
+	defer pool.Close()
+
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_, err := pool.Acquire(ctx)
+		cancel()
+		if err != setupErr {
+			t.Fatalf("expected setup error, got %v", err)
+		}
+	}
+	if len(f.callsTo("Target.closeTarget")) != 2 {
+		t.Error("expected tabs that failed setup to be closed")
+	}
+}
+
+func TestPoolClose(t *testing.T) {
+	b, f := newFakeBrowser(t)
+	defer f.Close()
+	defer b.conn.Close()
+
+	pool := NewPool(b, 2, nil)
+	busy, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool.Close()
+	if len(f.callsTo("Target.closeTarget")) != 0 {
+		t.Error("expected tab in use to stay open")
+	}
+	pool.Release(busy)
+	if len(f.callsTo("Target.closeTarget")) != 1 {
+		t.Error("expected tab released after Close to be closed")
+	}
+	if _, err := pool.Acquire(context.Background()); err != ErrConnClosed {
+		t.Errorf("expected Acquire on closed pool to fail, got %v", err)
+	}
+}
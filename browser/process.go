@@ -0,0 +1,20 @@
+//go:build !windows
+// +build !windows
+
+package browser
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup starts the browser in its own process group, so it isn't
+// interrupted together with Aquatone and all its child processes can be
+// killed at once.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+func killProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,241 @@
+package browser
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// maxRecordedEntries caps the number of console messages and failed requests
+// kept by a Recorder so noisy pages do not bloat the session.
+const maxRecordedEntries = 100
+
+type ConsoleMessage struct {
+	Level  string
+	Text   string
+	Source string
+}
+
+type FailedRequest struct {
+	URL    string
+	Status int
+	Error  string
+}
+
+// Recorder collects console errors, uncaught exceptions and failed requests
+// for subresources in a tab until it is stopped.
+type Recorder struct {
+	mutex           sync.Mutex
+	requests        map[string]string
+	consoleMessages []ConsoleMessage
+	failedRequests  []FailedRequest
+	stop            chan struct{}
+	done            chan struct{}
+}
+
+// Record starts recording events in the tab. It should be called before
+// navigating so that early errors are included.
+func (t *Tab) Record() *Recorder {
+	r := &Recorder{
+		requests: make(map[string]string),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	events, unsubscribe := t.conn.Subscribe(
+		"Runtime.consoleAPICalled",
+		"Runtime.exceptionThrown",
+		"Network.requestWillBeSent",
+		"Network.responseReceived",
+		"Network.loadingFailed",
+	)
+
+	go func() {
+		defer close(r.done)
+		defer unsubscribe()
+		for {
+			select {
+			case event := <-events:
+				r.handle(event)
+			case <-r.stop:
+				// Handle events that arrived before Stop was called.
+				for {
+					select {
+					case event := <-events:
+						r.handle(event)
+					default:
+						return
+					}
+				}
+			case <-t.conn.Closed():
+				return
+			}
+		}
+	}()
+	return r
+}
+
+// Stop ends the recording and returns what was recorded.
+func (r *Recorder) Stop() ([]ConsoleMessage, []FailedRequest) {
+	close(r.stop)
+	<-r.done
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.consoleMessages, r.failedRequests
+}
+
+func (r *Recorder) handle(event Event) {
+	switch event.Method {
+	case "Runtime.consoleAPICalled":
+		r.onConsoleAPICalled(event.Params)
+	case "Runtime.exceptionThrown":
+		r.onExceptionThrown(event.Params)
+	case "Network.requestWillBeSent":
+		r.onRequestWillBeSent(event.Params)
+	case "Network.responseReceived":
+		r.onResponseReceived(event.Params)
+	case "Network.loadingFailed":
+		r.onLoadingFailed(event.Params)
+	}
+}
+
+func (r *Recorder) onConsoleAPICalled(params json.RawMessage) {
+	var event struct {
+		Type string `json:"type"`
+		Args []struct {
+			Type        string          `json:"type"`
+			Value       json.RawMessage `json:"value"`
+			Description string          `json:"description"`
+		} `json:"args"`
+		StackTrace *struct {
+			CallFrames []struct {
+				URL        string `json:"url"`
+				LineNumber int    `json:"lineNumber"`
+			} `json:"callFrames"`
+		} `json:"stackTrace"`
+	}
+	if json.Unmarshal(params, &event) != nil || (event.Type != "error" && event.Type != "assert") {
+		return
+	}
+
+	var parts []string
+	for _, arg := range event.Args {
+		var s string
+		switch {
+		case arg.Type == "string" && json.Unmarshal(arg.Value, &s) == nil:
+			parts = append(parts, s)
+		case arg.Description != "":
+			parts = append(parts, arg.Description)
+		case len(arg.Value) > 0:
+			parts = append(parts, string(arg.Value))
+		}
+	}
+	var source string
+	if event.StackTrace != nil && len(event.StackTrace.CallFrames) > 0 {
+		frame := event.StackTrace.CallFrames[0]
+		source = fmt.Sprintf("%s:%d", frame.URL, frame.LineNumber+1)
+	}
+	r.addConsoleMessage(ConsoleMessage{Level: "error", Text: strings.Join(parts, " "), Source: source})
+}
+
+func (r *Recorder) onExceptionThrown(params json.RawMessage) {
+	var event struct {
+		ExceptionDetails struct {
+			Text       string `json:"text"`
+			URL        string `json:"url"`
+			LineNumber int    `json:"lineNumber"`
+			Exception  *struct {
+				Description string `json:"description"`
+			} `json:"exception"`
+		} `json:"exceptionDetails"`
+	}
+	if json.Unmarshal(params, &event) != nil {
+		return
+	}
+	details := event.ExceptionDetails
+	text := details.Text
+	if details.Exception != nil && details.Exception.Description != "" {
+		text = strings.SplitN(details.Exception.Description, "\n", 2)[0]
+	}
+	var source string
+	if details.URL != "" {
+		source = fmt.Sprintf("%s:%d", details.URL, details.LineNumber+1)
+	}
+	r.addConsoleMessage(ConsoleMessage{Level: "exception", Text: text, Source: source})
+}
+
+func (r *Recorder) onRequestWillBeSent(params json.RawMessage) {
+	var event struct {
+		RequestID string `json:"requestId"`
+		Request   struct {
+			URL string `json:"url"`
+		} `json:"request"`
+	}
+	if json.Unmarshal(params, &event) != nil {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.requests[event.RequestID] = event.Request.URL
+}
+
+// onResponseReceived records error responses for subresources. The request
+// for the page itself has the same ID as the navigation's loader and is
+// skipped, as its status is already known.
+func (r *Recorder) onResponseReceived(params json.RawMessage) {
+	var event struct {
+		RequestID string `json:"requestId"`
+		LoaderID  string `json:"loaderId"`
+		Response  struct {
+			URL        string `json:"url"`
+			Status     int    `json:"status"`
+			StatusText string `json:"statusText"`
+		} `json:"response"`
+	}
+	if json.Unmarshal(params, &event) != nil || event.Response.Status < 400 || event.RequestID == event.LoaderID {
+		return
+	}
+	r.addFailedRequest(FailedRequest{
+		URL:    event.Response.URL,
+		Status: event.Response.Status,
+		Error:  event.Response.StatusText,
+	})
+}
+
+func (r *Recorder) onLoadingFailed(params json.RawMessage) {
+	var event struct {
+		RequestID     string `json:"requestId"`
+		ErrorText     string `json:"errorText"`
+		Canceled      bool   `json:"canceled"`
+		BlockedReason string `json:"blockedReason"`
+	}
+	if json.Unmarshal(params, &event) != nil || event.Canceled {
+		return
+	}
+	r.mutex.Lock()
+	url := r.requests[event.RequestID]
+	r.mutex.Unlock()
+
+	errorText := event.ErrorText
+	if event.BlockedReason != "" {
+		errorText = fmt.Sprintf("%s (%s)", errorText, event.BlockedReason)
+	}
+	r.addFailedRequest(FailedRequest{URL: url, Error: errorText})
+}
+
+func (r *Recorder) addConsoleMessage(msg ConsoleMessage) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.consoleMessages) < maxRecordedEntries {
+		r.consoleMessages = append(r.consoleMessages, msg)
+	}
+}
+
+func (r *Recorder) addFailedRequest(req FailedRequest) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.failedRequests) < maxRecordedEntries {
+		r.failedRequests = append(r.failedRequests, req)
+	}
+}
@@ -0,0 +1,174 @@
+package browser
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+)
+
+type Tab struct {
+	browser          *Browser
+	conn             *Conn
+	targetID         string
+	browserContextID string
+	closeOnce        sync.Once
+
+	mutex     sync.Mutex
+	url       string
+	loaderID  string
+	lifecycle map[string]map[string]bool
+	changed   chan struct{}
+}
+
+func (t *Tab) Conn() *Conn {
+	return t.conn
+}
+
+func (t *Tab) SetUserAgent(ctx context.Context, userAgent string) error {
+	return t.conn.Call(ctx, "Network.setUserAgentOverride", map[string]string{"userAgent": userAgent}, nil)
+}
+
+func (t *Tab) SetExtraHeaders(ctx context.Context, headers map[string]string) error {
+	return t.conn.Call(ctx, "Network.setExtraHTTPHeaders", map[string]interface{}{"headers": headers}, nil)
+}
+
+// SetCookies stores cookies for the host of a URL in the tab's browser
+// context. The cookies are only sent to that host.
+func (t *Tab) SetCookies(ctx context.Context, url string, cookies []*http.Cookie) error {
+	params := make([]map[string]interface{}, 0, len(cookies))
+	for _, cookie := range cookies {
+		params = append(params, map[string]interface{}{
+			"name":  cookie.Name,
+			"value": cookie.Value,
+			"url":   url,
+			"path":  "/",
+		})
+	}
+	return t.conn.Call(ctx, "Network.setCookies", map[string]interface{}{"cookies": params}, nil)
+}
+
+type Viewport struct {
+	Width             int
+	Height            int
+	DeviceScaleFactor float64
+	Mobile            bool
+}
+
+// SetViewport emulates a screen of the given size. Mobile viewports also
+// enable touch events and mobile layout rules such as the viewport meta tag.
+func (t *Tab) SetViewport(ctx context.Context, viewport Viewport) error {
+	scale := viewport.DeviceScaleFactor
+	if scale == 0 {
+		scale = 1
+	}
+	params := map[string]interface{}{
+		"width":             viewport.Width,
+		"height":            viewport.Height,
+		"deviceScaleFactor": scale,
+		"mobile":            viewport.Mobile,
+	}
+	if err := t.conn.Call(ctx, "Emulation.setDeviceMetricsOverride", params, nil); err != nil {
+		return err
+	}
+	return t.conn.Call(ctx, "Emulation.setTouchEmulationEnabled", map[string]bool{"enabled": viewport.Mobile}, nil)
+}
+
+// Navigate loads a URL and waits for the load event of the new document.
+// Only lifecycle events of the loader returned by Page.navigate count, so a
+// late load event of the previous document can't end the wait early.
+func (t *Tab) Navigate(ctx context.Context, url string) error {
+	t.resetLifecycle(url)
+
+	var result struct {
+		LoaderID  string `json:"loaderId"`
+		ErrorText string `json:"errorText"`
+	}
+	if err := t.conn.Call(ctx, "Page.navigate", map[string]string{"url": url}, &result); err != nil {
+		return err
+	}
+	if result.ErrorText != "" {
+		return fmt.Errorf("%s", result.ErrorText)
+	}
+	if result.LoaderID == "" {
+		// Navigations within the same document, e.g. to a fragment, don't
+		// create a new loader and have no load event to wait for.
+		return nil
+	}
+	t.setLoaderID(result.LoaderID)
+	return t.waitForLifecycleEvent(ctx, "load")
+}
+
+// HTML returns the current DOM of the page serialized as HTML, including any
+// changes made by scripts since it was loaded.
+func (t *Tab) HTML(ctx context.Context) (string, error) {
+	var html string
+	expression := `(document.doctype ? new XMLSerializer().serializeToString(document.doctype) + "\n" : "") + document.documentElement.outerHTML`
+	if err := t.Evaluate(ctx, expression, &html); err != nil {
+		return "", err
+	}
+	return html, nil
+}
+
+// Screenshot captures the current viewport as a PNG image.
+func (t *Tab) Screenshot(ctx context.Context) ([]byte, error) {
+	return t.captureScreenshot(ctx, map[string]interface{}{"format": "png"})
+}
+
+// FullPageScreenshot captures the whole document as a PNG image. Width and
+// height are capped at maxSize CSS pixels as very large captures fail.
+func (t *Tab) FullPageScreenshot(ctx context.Context, maxSize int) ([]byte, error) {
+	type size struct {
+		Width  float64 `json:"width"`
+		Height float64 `json:"height"`
+	}
+	var metrics struct {
+		ContentSize    size  `json:"contentSize"`
+		CSSContentSize *size `json:"cssContentSize"`
+	}
+	if err := t.conn.Call(ctx, "Page.getLayoutMetrics", nil, &metrics); err != nil {
+		return nil, err
+	}
+
+	content := metrics.ContentSize
+	if metrics.CSSContentSize != nil {
+		content = *metrics.CSSContentSize
+	}
+	if content.Width < 1 || content.Height < 1 {
+		return t.Screenshot(ctx)
+	}
+
+	return t.captureScreenshot(ctx, map[string]interface{}{
+		"format":                "png",
+		"captureBeyondViewport": true,
+		"clip": map[string]interface{}{
+			"x":      0,
+			"y":      0,
+			"width":  math.Min(content.Width, float64(maxSize)),
+			"height": math.Min(content.Height, float64(maxSize)),
+			"scale":  1,
+		},
+	})
+}
+
+func (t *Tab) captureScreenshot(ctx context.Context, params map[string]interface{}) ([]byte, error) {
+	var result struct {
+		Data string `json:"data"`
+	}
+	if err := t.conn.Call(ctx, "Page.captureScreenshot", params, &result); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(result.Data)
+}
+
+func (t *Tab) Close() {
+	t.closeOnce.Do(func() {
+		if t.conn != nil {
+			t.conn.Close()
+		}
+		t.browser.closeTarget(t.targetID)
+		t.browser.disposeBrowserContext(t.browserContextID)
+	})
+}
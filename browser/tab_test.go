@@ -0,0 +1,230 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestTab(t *testing.T, f *fakeDevTools) *Tab {
+	t.Helper()
+	conn, err := Dial(context.Background(), f.url("/devtools/page/T1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tab := &Tab{
+		conn:      conn,
+		targetID:  "T1",
+		lifecycle: make(map[string]map[string]bool),
+		changed:   make(chan struct{}),
+	}
+	go tab.watchLifecycle()
+	return tab
+}
+
+func lifecycleEvent(loaderID string, name string) map[string]string {
+	return map[string]string{"frameId": "T1", "loaderId": loaderID, "name": name}
+}
+
+func TestTabNavigateIgnoresStaleLoadEvents(t *testing.T) {
+	f := newFakeDevTools()
+	defer f.Close()
+
+	var loaded int32
+	f.handle("Page.navigate", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		// Events of the document previously loaded in the tab arrive while
+		// the new navigation is already under way.
+		send("Page.lifecycleEvent", lifecycleEvent("L1", "load"))
+		send("Page.loadEventFired", map[string]float64{"timestamp": 1})
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			atomic.StoreInt32(&loaded, 1)
+			send("Page.lifecycleEvent", lifecycleEvent("L2", "load"))
+		}()
+		return map[string]string{"frameId": "T1", "loaderId": "L2"}, nil
+	})
+	tab := newTestTab(t, f)
+	defer tab.conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tab.Navigate(ctx, "http://example.com/"); err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&loaded) != 1 {
+		t.Fatal("expected Navigate to wait for the load event of the new loader")
+	}
+}
+
+func TestTabNavigateEventBeforeResult(t *testing.T) {
+	f := newFakeDevTools()
+	defer f.Close()
+	f.handle("Page.navigate", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		send("Page.lifecycleEvent", lifecycleEvent("L2", "load"))
+		send("Page.lifecycleEvent", lifecycleEvent("L2", "networkIdle"))
+		return map[string]string{"frameId": "T1", "loaderId": "L2"}, nil
+	})
+	tab := newTestTab(t, f)
+	defer tab.conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := tab.Navigate(ctx, "http://example.com/"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tab.WaitForNetworkIdle(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTabNavigateError(t *testing.T) {
+	f := newFakeDevTools()
+	defer f.Close()
+	f.handle("Page.navigate", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		return map[string]string{"frameId": "T1", "loaderId": "L2", "errorText": "net::ERR_NAME_NOT_RESOLVED"}, nil
+	})
+	tab := newTestTab(t, f)
+	defer tab.conn.Close()
+
+	err := tab.Navigate(context.Background(), "http://example.invalid/")
+	if err == nil || err.Error() != "net::ERR_NAME_NOT_RESOLVED" {
+		t.Fatalf("expected navigation error, got %v", err)
+	}
+}
+
+func TestTabSetCookies(t *testing.T) {
+	f := newFakeDevTools()
+	defer f.Close()
+	tab := newTestTab(t, f)
+	defer tab.conn.Close()
+
+	cookies := []*http.Cookie{{Name: "session", Value: "abc"}, {Name: "theme", Value: "dark"}}
+	if err := tab.SetCookies(context.Background(), "https://example.com/app/", cookies); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := f.callsTo("Network.setCookies")
+	if len(calls) != 1 {
+		t.Fatalf("expected one Network.setCookies call, got %d", len(calls))
+	}
+	var params struct {
+		Cookies []map[string]string `json:"cookies"`
+	}
+	if err := json.Unmarshal(calls[0], &params); err != nil {
+		t.Fatal(err)
+	}
+	if len(params.Cookies) != 2 {
+		t.Fatalf("expected 2 cookies, got %d", len(params.Cookies))
+	}
+	want := map[string]string{"name": "session", "value": "abc", "url": "https://example.com/app/", "path": "/"}
+	for key, value := range want {
+		if params.Cookies[0][key] != value {
+			t.Errorf("expected cookie %s to be %q, got %q", key, value, params.Cookies[0][key])
+		}
+	}
+	if len(f.callsTo("Network.setExtraHTTPHeaders")) != 0 {
+		t.Error("expected cookies not to be set as extra headers")
+	}
+}
+
+func TestTabInterceptRequests(t *testing.T) {
+	f := newFakeDevTools()
+	defer f.Close()
+	f.handle("Page.navigate", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		return map[string]string{"frameId": "T1"}, nil
+	})
+	f.handle("Test.loadResources", func(send func(string, interface{}), params json.RawMessage) (interface{}, *Error) {
+		headers := map[string]string{"Accept": "*/*", "authorization": "Basic old"}
+		send("Fetch.requestPaused", map[string]interface{}{
+			"requestId": "R1",
+			"request":   map[string]interface{}{"url": "http://example.com/app.js", "headers": headers},
+		})
+		send("Fetch.requestPaused", map[string]interface{}{
+			"requestId": "R2",
+			"request":   map[string]interface{}{"url": "http://cdn.example.net/lib.js", "headers": headers},
+		})
+		send("Fetch.requestPaused", map[string]interface{}{
+			"requestId":    "R3",
+			"resourceType": "Document",
+			"request":      map[string]interface{}{"url": "http://blocked.example.net/", "headers": headers},
+		})
+		return nil, nil
+	})
+	tab := newTestTab(t, f)
+	defer tab.conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := tab.InterceptRequests(ctx, func(req Request) (map[string]string, bool) {
+		if req.ResourceType == "Document" && strings.HasPrefix(req.URL, "http://blocked.") {
+			return nil, true
+		}
+		if strings.HasPrefix(req.URL, req.PageURL) {
+			return map[string]string{"Authorization": "Bearer token"}, false
+		}
+		return nil, false
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.callsTo("Fetch.enable")) != 1 {
+		t.Fatal("expected Fetch.enable to be called")
+	}
+	if err := tab.Navigate(ctx, "http://example.com/"); err != nil {
+		t.Fatal(err)
+	}
+	if err := tab.conn.Call(ctx, "Test.loadResources", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var calls, failed []json.RawMessage
+	for len(calls) < 2 || len(failed) < 1 {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("expected 2 Fetch.continueRequest and 1 Fetch.failRequest calls, got %d and %d", len(calls), len(failed))
+		case <-time.After(10 * time.Millisecond):
+		}
+		calls = f.callsTo("Fetch.continueRequest")
+		failed = f.callsTo("Fetch.failRequest")
+	}
+
+	continued := make(map[string][]map[string]string)
+	for _, call := range calls {
+		var params struct {
+			RequestID string              `json:"requestId"`
+			Headers   []map[string]string `json:"headers"`
+		}
+		if err := json.Unmarshal(call, &params); err != nil {
+			t.Fatal(err)
+		}
+		continued[params.RequestID] = params.Headers
+	}
+
+	headers := make(map[string]string)
+	for _, header := range continued["R1"] {
+		headers[header["name"]] = header["value"]
+	}
+	if len(headers) != 2 || headers["Accept"] != "*/*" || headers["Authorization"] != "Bearer token" {
+		t.Errorf("expected same origin request to get the Authorization header, got %v", continued["R1"])
+	}
+	if headers, ok := continued["R2"]; !ok || headers != nil {
+		t.Errorf("expected cross origin request to be continued unchanged, got %v", headers)
+	}
+	if _, ok := continued["R3"]; ok {
+		t.Error("expected blocked request not to be continued")
+	}
+	var blocked struct {
+		RequestID   string `json:"requestId"`
+		ErrorReason string `json:"errorReason"`
+	}
+	if err := json.Unmarshal(failed[0], &blocked); err != nil {
+		t.Fatal(err)
+	}
+	if blocked.RequestID != "R3" || blocked.ErrorReason != "BlockedByClient" {
+		t.Errorf("expected blocked request to fail with BlockedByClient, got %+v", blocked)
+	}
+}
@@ -0,0 +1,156 @@
+package browser
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const selectorPollInterval = 100 * time.Millisecond
+
+// watchLifecycle records the lifecycle events of the tab's main frame, such as
+// load and networkIdle, per loader so waits can check for events that arrived
+// before they started.
+func (t *Tab) watchLifecycle() {
+	events, unsubscribe := t.conn.Subscribe("Page.lifecycleEvent")
+	defer unsubscribe()
+	for {
+		select {
+		case e := <-events:
+			var event struct {
+				FrameID  string `json:"frameId"`
+				LoaderID string `json:"loaderId"`
+				Name     string `json:"name"`
+			}
+			if err := json.Unmarshal(e.Params, &event); err != nil || event.FrameID != t.targetID {
+				continue
+			}
+			t.mutex.Lock()
+			if t.lifecycle[event.LoaderID] == nil {
+				t.lifecycle[event.LoaderID] = make(map[string]bool)
+			}
+			t.lifecycle[event.LoaderID][event.Name] = true
+			t.notifyLocked()
+			t.mutex.Unlock()
+		case <-t.conn.Closed():
+			return
+		}
+	}
+}
+
+func (t *Tab) resetLifecycle(url string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.url = url
+	t.loaderID = ""
+	t.lifecycle = make(map[string]map[string]bool)
+}
+
+func (t *Tab) setLoaderID(id string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.loaderID = id
+	t.notifyLocked()
+}
+
+func (t *Tab) notifyLocked() {
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+// navigatedURL returns the URL last passed to Navigate.
+func (t *Tab) navigatedURL() string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.url
+}
+
+// WaitForNetworkIdle waits until the page has had no network connections for
+// 500 ms after the last navigation.
+func (t *Tab) WaitForNetworkIdle(ctx context.Context) error {
+	return t.waitForLifecycleEvent(ctx, "networkIdle")
+}
+
+// waitForLifecycleEvent waits for a lifecycle event of the loader of the last
+// navigation.
+func (t *Tab) waitForLifecycleEvent(ctx context.Context, name string) error {
+	for {
+		t.mutex.Lock()
+		fired := t.loaderID != "" && t.lifecycle[t.loaderID][name]
+		changed := t.changed
+		t.mutex.Unlock()
+		if fired {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.conn.Closed():
+			return ErrConnClosed
+		}
+	}
+}
+
+// WaitForSelector waits until an element matching a CSS selector exists in
+// the page.
+func (t *Tab) WaitForSelector(ctx context.Context, selector string) error {
+	quoted, err := json.Marshal(selector)
+	if err != nil {
+		return err
+	}
+	expression := fmt.Sprintf("document.querySelector(%s) !== null", quoted)
+	for {
+		var found bool
+		if err := t.Evaluate(ctx, expression, &found); err != nil {
+			return err
+		}
+		if found {
+			return nil
+		}
+
+		select {
+		case <-time.After(selectorPollInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Evaluate runs a JavaScript expression in the page and waits for it to
+// finish, including any promise it returns. The result is unmarshalled into
+// result unless it is nil.
+func (t *Tab) Evaluate(ctx context.Context, expression string, result interface{}) error {
+	params := map[string]interface{}{
+		"expression":    expression,
+		"awaitPromise":  true,
+		"returnByValue": result != nil,
+	}
+	var response struct {
+		Result struct {
+			Value json.RawMessage `json:"value"`
+		} `json:"result"`
+		ExceptionDetails *struct {
+			Text      string `json:"text"`
+			Exception *struct {
+				Description string `json:"description"`
+			} `json:"exception"`
+		} `json:"exceptionDetails"`
+	}
+	if err := t.conn.Call(ctx, "Runtime.evaluate", params, &response); err != nil {
+		return err
+	}
+	if details := response.ExceptionDetails; details != nil {
+		if details.Exception != nil && details.Exception.Description != "" {
+			return errors.New(details.Exception.Description)
+		}
+		return errors.New(details.Text)
+	}
+	if result != nil && len(response.Result.Value) > 0 {
+		return json.Unmarshal(response.Result.Value, result)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+// Command fetchassets downloads the JavaScript and CSS libraries used by the
+// HTML report, and the images referenced by the stylesheets, to
+// static/vendor/ so they can be embedded in the binary.
+//
+//	go run ./cmd/fetchassets
+//	go-bindata -pkg core -o core/bindata.go static/...
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/michenriksen/aquatone/core"
+)
+
+func main() {
+	dir := flag.String("dir", filepath.Join("static", "vendor"), "Directory to write assets to")
+	flag.Parse()
+
+	if err := os.MkdirAll(*dir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to create %s: %s\n", *dir, err)
+		os.Exit(1)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	failed := false
+	for _, asset := range core.ReportAssets {
+		content, err := fetch(client, asset, filepath.Join(*dir, asset.Name))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", asset.Name, err)
+			failed = true
+			continue
+		}
+		fmt.Printf("%s: ok\n", asset.Name)
+
+		for _, image := range asset.Images(content) {
+			if _, err := fetch(client, image, filepath.Join(*dir, filepath.FromSlash(image.Name))); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %s\n", image.Name, err)
+				failed = true
+				continue
+			}
+			fmt.Printf("%s: ok\n", image.Name)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// fetch downloads an asset and writes it to a file if it matches its
+// integrity hash. Images don't have an integrity hash, but are downloaded
+// from the same pinned version as their stylesheet.
+func fetch(client *http.Client, asset core.ReportAsset, filename string) ([]byte, error) {
+	resp, err := client.Get(asset.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Unexpected status %s from %s", resp.Status, asset.URL)
+	}
+
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if asset.Integrity != "" && !core.VerifyIntegrity(content, asset.Integrity) {
+		return nil, fmt.Errorf("Content of %s does not match integrity hash %s", asset.URL, asset.Integrity)
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return nil, err
+	}
+	return content, ioutil.WriteFile(filename, content, 0644)
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultAgents can be given in the list of agents to enable as a shorthand
+// for all agents that are enabled by default.
+const DefaultAgents = "default"
+
+type Agent interface {
+	ID() string
+	Register(s *Session) error
+}
+
+type AgentFactory func() Agent
+
+type agentRegistration struct {
+	factory  AgentFactory
+	optional bool
+}
+
+var agentRegistrations []agentRegistration
+
+func RegisterAgent(factory AgentFactory) {
+	agentRegistrations = append(agentRegistrations, agentRegistration{factory: factory})
+}
+
+// RegisterOptionalAgent registers an agent that is disabled unless it is
+// explicitly enabled.
+func RegisterOptionalAgent(factory AgentFactory) {
+	agentRegistrations = append(agentRegistrations, agentRegistration{factory: factory, optional: true})
+}
+
+func AgentName(id string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(id)), "agent:")
+}
+
+func AgentNames() []string {
+	var names []string
+	for _, registration := range agentRegistrations {
+		names = append(names, AgentName(registration.factory().ID()))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func OptionalAgentNames() []string {
+	var names []string
+	for _, registration := range agentRegistrations {
+		if registration.optional {
+			names = append(names, AgentName(registration.factory().ID()))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func LoadAgents(enable string, disable string) ([]Agent, error) {
+	available := make(map[string]bool)
+	for _, name := range AgentNames() {
+		available[name] = true
+	}
+
+	enabled, err := parseAgentList(enable, available, true)
+	if err != nil {
+		return nil, err
+	}
+	disabled, err := parseAgentList(disable, available, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(enabled) == 0 {
+		enabled[DefaultAgents] = true
+	}
+
+	var agents []Agent
+	for _, registration := range agentRegistrations {
+		agent := registration.factory()
+		name := AgentName(agent.ID())
+		if !enabled[name] && (registration.optional || !enabled[DefaultAgents]) {
+			continue
+		}
+		if disabled[name] {
+			continue
+		}
+		agents = append(agents, agent)
+	}
+
+	if len(agents) == 0 {
+		return nil, fmt.Errorf("No agents are enabled")
+	}
+
+	return agents, nil
+}
+
+func parseAgentList(list string, available map[string]bool, allowDefault bool) (map[string]bool, error) {
+	agents := make(map[string]bool)
+	for _, id := range strings.Split(list, ",") {
+		name := AgentName(id)
+		if name == "" {
+			continue
+		}
+		if !available[name] && !(allowDefault && name == DefaultAgents) {
+			return nil, fmt.Errorf("Unknown agent %s. Available agents: %s", name, strings.Join(AgentNames(), ", "))
+		}
+		agents[name] = true
+	}
+	return agents, nil
+}
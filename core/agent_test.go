@@ -0,0 +1,69 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+type testAgent struct {
+	id string
+}
+
+func (a testAgent) ID() string {
+	return a.id
+}
+
+func (a testAgent) Register(s *Session) error {
+	return nil
+}
+
+func TestLoadAgents(t *testing.T) {
+	saved := agentRegistrations
+	defer func() { agentRegistrations = saved }()
+	agentRegistrations = nil
+	RegisterAgent(func() Agent { return testAgent{"agent:alpha"} })
+	RegisterAgent(func() Agent { return testAgent{"agent:beta"} })
+	RegisterOptionalAgent(func() Agent { return testAgent{"agent:gamma"} })
+
+	tests := []struct {
+		name    string
+		enable  string
+		disable string
+		want    []string
+		err     string
+	}{
+		{name: "empty list enables defaults", want: []string{"agent:alpha", "agent:beta"}},
+		{name: "default keyword", enable: "default", want: []string{"agent:alpha", "agent:beta"}},
+		{name: "named agent only", enable: "beta", want: []string{"agent:beta"}},
+		{name: "names are normalized", enable: " Agent:Alpha ,", want: []string{"agent:alpha"}},
+		{name: "optional agent with defaults", enable: "default,gamma", want: []string{"agent:alpha", "agent:beta", "agent:gamma"}},
+		{name: "optional agent only", enable: "gamma", want: []string{"agent:gamma"}},
+		{name: "disable default agent", disable: "alpha", want: []string{"agent:beta"}},
+		{name: "disable wins over enable", enable: "default,gamma", disable: "gamma,beta", want: []string{"agent:alpha"}},
+		{name: "all agents disabled", enable: "alpha", disable: "alpha", err: "No agents are enabled"},
+		{name: "unknown agent to enable", enable: "default,delta", err: "Unknown agent delta. Available agents: alpha, beta, gamma"},
+		{name: "unknown agent to disable", disable: "delta", err: "Unknown agent delta"},
+		{name: "default keyword can't be disabled", disable: "default", err: "Unknown agent default"},
+	}
+
+	for _, tt := range tests {
+		agents, err := LoadAgents(tt.enable, tt.disable)
+		if tt.err != "" {
+			if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+				t.Errorf("%s: expected error %q, got %v", tt.name, tt.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tt.name, err)
+			continue
+		}
+		var ids []string
+		for _, agent := range agents {
+			ids = append(ids, agent.ID())
+		}
+		if strings.Join(ids, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("%s: expected agents %v, got %v", tt.name, tt.want, ids)
+		}
+	}
+}
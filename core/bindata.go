@@ -1,5 +1,6 @@
 // Code generated by go-bindata.
 // sources:
+// static/favicon_fingerprints.json
 // static/report_template.html
 // static/wappalyzer_fingerprints.json
 // DO NOT EDIT!
@@ -69,7 +70,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _staticReport_templateHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x69\x97\xea\xb6\xb2\x00\xfa\x3d\xbf\xc2\x97\x24\x97\xee\x4b\x83\x6d\xcc\xd8\xe9\xee\x75\x98\xe7\x79\x26\x37\x2f\x47\xb6\xe5\x01\x6c\xc9\xd8\xb2\x0d\xec\xb5\xff\xfb\x5b\x1e\xa0\x19\xbb\x3b\xd3\x7d\xe7\xc3\xdb\xc9\xde\x60\x0d\xa5\xaa\x52\xa9\x54\x55\x2a\x99\x97\xff\x12\xb1\x40\x76\x06\xa4\x14\xa2\x6b\x6f\x3f\xbc\x78\x1f\x94\x06\x90\xfc\x1a\x81\x28\xf2\xf6\xc3\x0f\x2f\x0a\x04\xe2\xdb\x0f\x14\xf5\xa2\x43\x02\x28\x41\x01\xa6\x05\xc9\x6b\xc4\x26\x52\x3c\x17\x79\xaf\x40\x40\x87\xaf\x11\x47\x85\xae\x81\x4d\x12\xa1\x04\x8c\x08\x44\xe4\x35\xe2\xaa\x22\x51\x5e\x45\xe8\xa8\x02\x8c\xfb\x0f\x4f\x94\x8a\x54\xa2\x02\x2d\x6e\x09\x40\x83\xaf\xec\x13\x65\x29\xa6\x8a\xd6\x71\x82\xe3\x92\x4a\x5e\x11\xbe\x02\x2c\x42\x4b\x30\x55\x83\xa8\x18\x9d\xc0\x2e\x6c\x6c\x40\x30\x82\xd4\x10\xfa\xa3\x5e\xf6\x02\x36\x51\xb0\x79\xd2\xa1\xa3\x0a\x0a\x80\x1a\x55\x87\xc8\x54\xd7\x16\x44\xd4\x83\x42\x88\x61\x3d\xd3\x34\x71\x55\x02\xcd\x84\x80\x75\x5a\x57\x05\xe5\xd0\xe0\xf1\x0a\xa8\x0c\x11\x34\x01\x39\x83\x7b\x44\xc4\xf9\xf6\x2d\x31\x85\xa6\xa5\x62\xf4\xfd\xfb\x55\x57\x13\xf3\x98\x58\x27\xfd\x10\x56\x91\x08\xb7\x4f\x14\xc2\x12\xd6\x34\xec\x06\x5d\x88\x4a\x34\xf8\x76\x41\xdd\x0b\x1d\x14\x7b\x0d\x34\x15\xad\x29\x13\x6a\xaf\x11\x8b\xec\x34\x68\x29\x10\x92\x08\xa5\x98\x50\x7a\x8d\x1c\x08\xb2\x08\x10\xd6\x06\x20\x4a\x82\xc7\x98\x58\xc4\x04\x86\x20\x22\x9f\xc0\x63\x01\x9d\x4a\x70\x09\x96\x16\x2c\xeb\xbd\x2c\xa1\xab\x28\x21\x58\x56\xe4\x07\x8a\xa2\x28\x15\x11\x28\x9b\x2a\xd9\xbd\x46\x2c\x05\x70\xb9\x54\x5c\x96\x7b\xbb\x21\xa3\xce\x4b\x7c\x67\xe0\x70\x73\xd5\xd0\x01\x97\xea\x94\x63\x62\x9d\x66\xa5\x41\x36\x97\xa2\x57\x19\x61\x41\xab\xcd\xf1\x60\xd2\x53\x84\x99\x99\xdd\xe6\x9b\x0e\x1e\x6e\xc7\xc9\xce\xd2\x65\xc7\x11\x4a\x30\xb1\x65\x61\x53\x95\x55\xf4\x1a\x01\x08\xa3\x9d\x8e\x6d\x2b\xf2\x65\xca\x3c\x32\x56\x96\x08\x35\xd5\x31\x13\x08\x12\x1a\x19\x3a\xed\xa8\xd6\xca\x8a\x23\x48\x5c\x6c\xae\xff\x95\x4a\x24\x53\x89\x2c\x2d\xaa\x16\xf1\x6a\x3e\xa3\x49\x71\x32\xa3\x71\xa1\x66\xaf\x53\x9b\xb1\xab\x9b\xbb\x2a\xbf\x5c\x8e\x11\x37\x30\x6b\xc3\xdd\x72\xc6\x5a\xb8\x94\x6f\xd1\xe5\x5d\x26\xb7\xb7\x72\x96\xcd\x17\xab\xbd\x49\x26\x4f\x64\xba\x56\x5b\x4a\xeb\x46\x91\xff\x98\x26\x9f\x12\xca\x5b\x66\xaf\x11\x02\xb7\xc4\xe3\xb7\x5f\x43\x51\x12\xc6\x04\x9a\xd4\x37\xff\x81\xa2\x78\x6c\x8a\xd0\x8c\x13\x6c\x3c\x53\xac\xb1\xa5\x2c\xac\xa9\x22\x65\xca\x3c\x78\x60\x9e\xa8\xe0\xff\x04\x9b\x4c\x3f\xfe\x12\x76\xd0\x81\x29\xab\x28\xe8\x90\x66\x8c\xed\xa1\xdc\x00\xa2\xa8\x22\xf9\xbc\xd0\x1b\x3b\x0e\x34\x55\x46\xcf\x94\x00\x11\x81\xe6\xa1\x46\xc2\x88\xc4\x2d\x75\x0f\x9f\x29\x36\xf9\xde\x41\xc0\x1a\x36\x9f\xbd\xf1\x1f\x32\xb9\x27\x2a\xf8\x1b\x8e\xfd\xfd\x87\x53\x02\xc0\x91\x84\xb0\x8f\x8a\x14\x68\xaa\x84\xfa\x2f\x55\xf7\x84\x17\x20\x72\x86\x85\x08\x05\x6c\x02\x6f\x39\x3f\x53\x36\x12\xa1\xa9\xa9\x08\x9e\x01\x4e\x08\xc0\xc4\xb6\x05\xb5\x23\xe4\x90\x56\x1e\x13\x82\xf5\x53\xca\x2e\x7b\xc4\x55\x02\xf5\x4b\x84\x7e\xe4\x72\x9c\x98\x62\x3f\xe3\xc5\x6d\x58\x09\x03\xc8\x30\x2e\x00\x53\x3c\x82\xf5\x55\xd9\x33\xc5\x31\x77\x18\xac\x41\x89\x9c\xcf\xd2\x33\x95\x4c\x1b\x5b\x8a\x65\x8c\x2d\x95\x3e\x7c\x3b\x34\x11\x55\xcb\xd0\xc0\xce\x63\x9c\xc7\x8a\x38\xaf\x61\x61\x7d\x8e\x92\xa5\x22\x59\x83\xf1\x00\x15\x8c\x08\x50\x11\x34\x4f\x50\x7b\xfa\xbc\x99\xa7\xcc\xa1\x69\xc5\x09\xe0\x35\x78\xc1\xd8\x67\xca\x43\xcc\x47\x2e\xfc\x72\x3e\xbc\x0f\xc0\x12\x4c\x08\x91\xa5\x60\x72\x02\xfb\x00\xc7\xc0\x96\x1a\x4c\xa9\x09\x35\x40\x54\x07\x1e\xa8\xc3\x0e\x34\x25\x0d\xbb\xcf\x94\xa2\x8a\x22\x44\xbf\x9c\xcb\xfb\x61\x4a\xbf\x20\xf2\x77\xb0\x39\xe2\x40\x4c\x80\x0e\x58\xf8\xdf\x25\x6c\xea\x54\x22\x6d\x51\x10\x58\x30\x8e\xed\xe3\xa4\x08\xb6\x69\x79\x82\xb1\xc7\x58\x8f\xab\x47\x94\xc2\x79\x65\x19\xe6\xe7\x3b\x12\xe1\x11\x6e\x62\x2d\x6e\x98\xd0\x79\xba\x53\x87\xe0\x96\x5c\x8a\x4a\xfa\x2b\x00\xe3\xaa\x80\xd1\xbb\x3e\x00\xc2\x5a\x36\xb1\x8d\xc4\xb8\xaa\x03\x19\x3e\x53\xb6\xa9\x3d\x44\x44\x40\xc0\xb3\x5f\x40\x5b\x8e\x1c\xdb\xea\xda\xd3\xcf\x9c\x60\x39\x32\xb5\xd5\x35\x64\xbd\x46\x3d\x4d\xf9\x4c\xd3\xae\xeb\x26\x5c\x2e\x81\x4d\x99\x4e\x32\x0c\xe3\x35\x8e\x52\x92\xaa\x69\xaf\xd1\x9f\x93\x5c\x46\xc8\xa6\xb3\x62\x94\xf2\x36\xed\x22\xde\xbe\x46\x19\x8a\xa1\x72\x54\x2e\xfa\x33\x07\x7f\xe6\x04\x6f\xeb\xa0\xc4\xd7\x68\x27\x9d\x48\xa6\x29\x46\x8b\xa7\xa8\xe0\x3f\x36\x91\x8e\x7b\x7f\x93\xc1\x5f\x2a\xfc\x8c\x87\xe5\xfb\x28\x1d\x00\xf0\x86\xfb\x99\x83\x91\xc7\x4f\xc8\xf6\x78\xf5\x1f\x48\x76\x32\x91\xf5\xc9\x66\x13\x69\x8f\x64\xea\x84\x54\xff\xfb\xa1\x3c\x15\xf7\xff\xfb\x32\xd9\x2a\x12\x55\xc1\xb3\x1f\x2c\x4a\x53\x6f\x91\x7c\x50\x58\x01\xa2\xe7\x50\x78\x20\xca\x97\x0b\x37\x6e\xaa\xb2\x42\x9e\xa9\xf4\xcd\x15\x7b\x7b\xc9\xdf\x95\xf2\x1b\x7d\xc8\xbb\xd2\xf3\xf7\x09\x09\xe8\xaa\xb6\x7b\xa6\x0a\x87\x5d\x8e\xea\x9b\xf8\x89\x2a\x61\x64\x61\x0d\x58\x4f\x54\x07\x22\x0d\x3f\x51\x1d\x8c\x80\x80\x9f\xa8\xb6\x2d\xa8\x22\x08\xeb\xe1\x13\xd5\x56\x79\x18\xe8\x7e\xaf\x09\x7e\xa2\xca\x70\x05\xa6\x36\x35\x02\xc8\x0a\x4b\x8a\xaa\x67\x8b\x40\xa0\x53\x53\x68\x82\xd3\x9a\x12\xb6\x4d\x15\x9a\x54\x17\xba\x4f\x94\x8e\x11\xb6\x0c\x20\xc0\x27\xca\x82\xa6\x2a\x7d\x81\x94\x44\x50\x10\x77\x80\x66\x9f\xb0\x03\x9b\x62\x9c\x37\x21\x58\x3f\x53\xfe\x47\x1c\x68\xda\x57\xb4\xef\xb7\x3f\xad\xc8\xbe\xb0\x9f\xc9\x26\x30\x94\x3f\xa4\x67\xaf\xa6\x95\xa2\x14\x18\x48\x47\xf6\x74\xa3\x3a\x35\x1b\x92\x27\xe5\x01\x19\x7f\x48\x11\xfb\x48\xde\x40\x0d\xf0\x16\xd6\x6c\x72\x44\xcd\x1f\x8b\x39\x3c\x79\xbb\xe3\xc9\xe3\x07\x78\x5f\x8b\x68\xc0\x16\x0d\x03\xcf\xc2\x89\x7b\x5b\x8b\x06\x76\xff\x27\x18\x50\xd4\x3e\xee\x1b\xec\xcf\x54\x3e\x9f\xcf\xff\x72\x7f\xed\x4a\xfe\x9f\xcf\x0d\xaf\xd0\x4e\x0b\x67\xe2\x72\x87\xb8\x4d\x69\xc2\x30\xb1\x6c\x42\xcb\xba\xd4\x03\x01\x49\xc0\x26\xf8\x97\x9b\x0a\xe2\xb4\xe6\xb0\x27\x5d\x93\xcb\x5d\xe9\x11\x4b\xc1\x6e\x5c\xc7\x26\x8c\xf3\x36\x21\x27\x7a\xfa\x9e\xf5\xf9\x99\x64\xff\xf8\xbe\x71\x77\xb0\x08\xb4\xfb\xdb\xf9\x8d\x69\x39\xec\xdb\x06\x56\x4f\xcd\x36\x8a\x7a\xa1\x7d\x43\xfb\xed\x87\x17\x3a\x70\x5a\x7f\x78\xe1\xb1\xb8\xf3\x4d\x70\x04\x1c\x4a\xd0\x80\x65\xbd\x46\x10\x70\x78\x60\x52\xc1\x47\x1c\x6e\x0d\x80\xc4\xb8\x2e\x1e\x0a\x44\x60\xae\x29\x5e\xf6\x3f\x43\x23\xfd\x05\x9c\xf7\x8d\xf3\x26\x40\xe2\xc1\x2b\xf9\x31\xf2\x56\x18\x4c\x0a\xe3\x5e\xb7\xf2\x42\x83\xb0\x47\xc8\xa8\xf3\x6e\x04\xcb\xb2\x06\xcd\x48\xe8\x0a\x04\x6d\x22\x94\xb7\xad\x85\x75\xaf\x11\x01\x6b\x1a\x30\x2c\x78\x28\x06\xa6\xec\xb9\xdb\x3f\x06\x20\x3a\x10\xd9\x91\x90\x0f\xc0\x54\xc1\x61\x0f\xb5\xce\x5b\x04\x75\x01\x69\x50\x7c\x8d\x48\x40\xf3\x20\xfa\xa5\x1a\xe0\x3d\xef\x6a\xec\x8f\xe7\x11\xad\xca\xbe\x2e\x0e\x69\xf5\xdc\x15\x03\xdc\xc1\xdc\xdf\xa5\x23\x6f\x2f\xb4\xd7\x24\xa4\x94\x0e\xc8\x78\x0b\x66\xf6\x45\x54\x8f\x8c\x3e\x90\x72\xe0\xec\x3b\x69\xaa\x78\x80\xec\xa3\x7b\x1c\xd9\xd6\x2e\xc6\xf5\xa6\x4d\x37\xe3\x9e\xe0\x1e\x5b\xf9\x4e\xe2\x49\xbb\xc0\x42\x17\x4d\x6c\x88\xd8\x45\x27\xcd\x2e\x26\x2e\xee\xbb\x96\x87\x76\x21\x49\xef\x93\xe8\x23\xe5\x89\xa1\x55\x3e\x80\xa2\x4c\xac\xdd\x9b\xa7\xe3\x78\x27\xc3\x85\x73\xa2\x00\xcb\xc0\x86\x6d\xbc\x46\x88\x69\xc3\x3b\x93\xf1\x76\xd6\xaf\xef\x8d\x7b\x8a\xf8\x41\x90\xc2\xc7\x13\xae\x1e\x09\xd0\xdf\x67\xda\x9f\x53\x0d\x8a\xfc\xee\x92\x84\xf3\x61\xde\xf9\x71\x84\xe2\x31\xef\xc8\x04\xda\xef\x4c\xf3\xbb\xb8\xa5\xea\xaa\x06\x3c\x1f\x39\xf2\x56\xdc\x51\xa3\xe3\xe3\x05\x66\x7f\x04\xa6\x82\x2d\x62\xf9\xe0\xea\xde\xb7\x3f\x0b\x29\xd8\x88\x23\x6f\x23\xff\x33\x60\xdd\x25\xbf\x68\x51\x75\x4e\xe4\x85\xd6\xd4\x0f\xa5\xe7\x13\xa1\xb9\xc4\xc0\x57\xcb\x91\xb7\x9a\xf7\x71\x36\xf2\xe9\x40\x2f\xb4\xad\x1d\x96\x48\x88\xcd\x0b\x8d\x80\xe3\x2f\x94\x17\x1d\xa8\x28\x14\x2f\xef\x6b\xe4\x7d\xcd\x84\x9b\x7d\x20\x8f\xc0\x30\x0e\x3a\xc8\xc4\x36\xf1\xec\x16\x15\xba\x6f\x2f\xf4\xe9\x93\x0f\xd9\x83\x12\x80\x0e\x3d\x72\xaf\x7b\xf0\xf5\x00\xc1\x38\x0c\xe2\x6f\x47\xba\x4d\xa0\xf8\xae\xba\xce\x23\x57\xd4\x7f\xeb\xaa\x28\x62\xf2\x0b\xa5\x03\x11\x52\xae\x4a\x94\x40\x2f\x1c\x49\xf5\x55\xad\xbf\xc6\xb1\xf9\x6c\x42\xf1\x17\xdf\x34\x74\x83\x3d\x84\xc7\x9a\x18\x79\xfb\xef\x1f\x33\xe9\x34\xc7\xfd\x12\xaa\x0b\x8a\xdf\x79\xbc\x3d\x0f\xe5\x9c\x86\xda\x12\x02\xd6\x23\xd4\x41\xe3\xfd\xce\x6b\x00\xad\x23\x6f\x61\xc8\xee\x38\xf0\x31\x74\xe7\x71\xfe\x85\x36\x0e\xc4\xbd\x5d\xc1\xf6\xdc\x00\xde\xde\xe9\x10\x08\x58\x92\x20\xbc\x8a\xed\x5d\x0f\xf6\xa2\xea\xf2\x89\x28\x58\xa6\xf0\x7a\xea\x75\x18\x48\xfe\x85\x07\x16\xcc\xa4\x9e\xd4\x69\xb1\x37\x74\x99\x56\x4d\xc6\x85\x42\xa1\xd0\x1d\x4d\x94\xca\x44\x2e\x14\x0a\x2d\xff\x59\x2b\x15\x16\x85\x42\xa1\x3c\x5a\xd7\x5b\x7d\xaf\xa0\x36\x1f\x56\x67\xf5\xe1\x98\x4f\x2e\x19\x31\x59\xdd\x2d\x07\xc5\xe2\xb2\x96\x57\x97\xa3\x62\x93\x9f\x55\xd1\x72\xda\xd4\x16\xb3\x61\x5a\x10\x34\xcd\xeb\x50\xea\x15\x9b\xc3\x4a\x75\x02\xbb\xa6\x35\xef\xe4\xfb\xd3\x8a\x20\x20\x96\x99\x36\x6b\xc9\xe9\xb6\x3c\x26\xa3\xb1\x54\x31\x1a\x62\x6d\x06\xd3\xb5\x94\xd8\x62\x9a\x74\x45\xda\x74\xcb\x8b\x4e\xac\xc5\x02\xa1\x44\x17\x2a\x3b\xa7\xb9\x29\xd5\xf3\x7a\xa3\x84\x88\x51\x5e\xe7\xa6\x2e\x40\x86\xbc\x62\xd8\x4e\x21\xb3\x48\xf6\x17\x7a\xc3\xb0\xac\x56\xc7\xe0\xfa\x6e\x4f\xda\x72\xb3\x3a\x4c\xd2\x30\x69\xe7\x88\xa9\x4f\x72\xbb\xd9\x9c\x87\x74\x7f\xd5\x13\xb3\xd9\x3d\x3d\x9e\xf5\xdb\x23\xb9\x4f\xba\x60\x95\xde\xf4\xac\x82\xdc\xea\x15\xc9\xb4\x84\xf9\x02\x6e\xb9\x9b\x9e\x5c\xc8\xf0\xab\xbd\x36\x1e\xe1\xea\xbc\x30\x81\x9d\xee\xb4\x5f\x5b\x09\x05\xbb\x3b\x50\x37\x15\xb1\xb5\x95\x46\x95\x6e\xa9\x23\x8f\x1b\xad\xfd\xbe\x08\xaa\xcd\x56\xaa\x82\x0a\x63\x54\x2d\x15\xa6\x6c\x77\xb9\xca\xca\xe5\x5d\xb6\x20\xcc\xf3\x6e\x69\xdd\x00\x93\x12\x9c\x8c\xcd\xe5\x0e\xae\x62\x49\xbe\x8b\xc8\x66\x5c\x54\x06\xd6\x9c\x2f\xac\x1b\xb9\x5e\x75\xdd\x74\x21\x2d\x42\x7b\x96\x24\xab\xc5\xa4\xcf\xe5\x69\x41\xcb\x48\x33\xb6\x3b\xe7\x49\x72\x2c\x26\x69\xc9\x9b\xf7\x4c\x52\x73\x04\x7a\xec\x26\x6b\xdc\x6a\xd5\xeb\x64\x96\xf4\xac\x3e\x29\xb1\x33\x32\x43\x63\x83\x1b\x0d\x65\x95\x27\xeb\x09\xcf\xe7\x1d\x32\x05\x1c\xdd\x2a\x5a\x7d\x5b\xa3\xcd\x18\xc6\xbd\x5e\x3b\x8d\x6d\x66\x29\xce\x34\x63\x34\x4e\xa7\x72\x13\xc1\x69\xef\xf2\x60\xd2\xe7\xf6\xa9\x4e\x75\x42\x83\x2e\x93\x15\x63\x19\xbc\x4b\x0b\xce\x2c\xc6\x64\xfa\x35\x97\xc9\xf4\x3b\x8a\x31\x5f\x70\x79\xc5\x94\xb3\x6e\x45\xec\x56\x2c\x97\x86\x4c\x51\xa9\x0f\x63\x92\x96\xea\x96\x0b\x3b\x9c\x8b\x49\xfd\x59\xae\xda\x95\x19\x7b\xde\xd6\xd6\x5c\x61\xce\x14\x5b\x19\x59\xda\xab\x88\x5d\x68\x2d\x03\x8d\x67\xda\xde\x4a\x56\xb8\xc1\xa6\x94\xb4\x17\x03\x73\x3a\x1c\x4d\x33\x79\xc8\x03\xe4\x64\xed\xac\xed\x2e\x25\x6e\x28\xe7\x98\x8c\x2c\xae\x2c\x29\x45\x54\x65\x6e\xc9\xed\x45\x49\xb5\x7a\x29\xa1\x21\xa6\x4a\x5c\x7a\x8f\xb8\x8e\xb3\xa9\x12\x7e\x96\x34\xb2\x90\xb5\xa6\x25\x79\x3e\x65\xf3\x10\x8d\x0d\x37\xb5\x80\x44\x21\x9b\xca\x74\x93\xcd\xd9\x1b\xa7\x5d\x05\x0e\x2e\xd2\xfb\xa5\x3d\xc8\x4d\xdc\x05\x10\xd7\xdb\x94\x3c\x68\x64\xca\x95\x58\x5f\x4d\xb1\xe2\x66\x85\x33\xbd\x99\x25\x8c\xbb\xfa\x5e\x9a\x26\xbb\xca\x62\xdd\x5e\xd2\xb2\x80\x9a\x23\xde\x9e\x0b\x5c\x77\x5f\xe6\x5d\xa1\xa6\x6c\x76\x4e\x19\xd8\x8b\x6c\xaa\x4a\xa6\x19\x67\xc3\x6e\x88\x81\xcd\x2a\x26\xb3\x42\x6f\x6f\x65\x27\xb3\x51\x9f\x61\x05\x5b\x63\xe7\x69\x86\x4b\xb1\xf9\xe9\xa4\x36\x98\x27\x63\xd3\xfc\x22\x56\xb3\x32\xeb\xfa\x48\x17\xd4\x94\xdd\x56\xb8\xad\xd6\x6f\x93\x7c\x8c\x03\x03\xbb\xb8\x2c\xee\x47\xeb\x62\x79\x64\x4d\x07\xa6\x38\xe0\x5b\xf3\x71\x32\x2b\x3a\x59\x08\x97\x9d\xa4\x38\xe1\x93\x31\xa7\x3f\x45\x0e\x67\x26\xdb\x68\xdd\x1d\xb0\x74\xb6\xd3\x6b\xad\x86\x9b\xee\x1c\x25\x05\xa6\x59\x2b\x88\x9d\x31\x13\x33\x47\x9b\x99\x3a\xd5\xc4\x39\xce\x77\xe9\x6c\x3e\x93\x6f\xd4\x58\x52\xa9\x8e\xd2\xcd\xed\x78\xc4\x1b\x66\x5e\x93\x67\xac\x91\x91\xea\x92\x99\x8e\xd1\x22\x6e\xb5\x05\x97\x1e\x8f\x73\x6e\xaf\xac\xa6\x48\x4e\x8d\x95\xeb\xd9\x95\xa1\xd7\x3b\xb6\x8e\x99\xd8\x76\xed\x76\xc7\x53\xad\x3b\xae\x2c\x7a\xe5\xca\x96\x11\xca\x13\x5e\x4f\x59\x5d\x5e\x37\xb9\x39\x07\x54\x81\xb6\x39\x93\xe1\x8b\xcb\x9a\x98\x2b\x77\xd1\x32\x29\x91\x7a\x05\xe5\xdc\x72\x87\xcb\xf5\xe7\x43\xd4\x1b\x49\x1d\x65\x55\x9b\x57\x07\x72\xb1\xe4\xc2\x8c\xc6\xb5\xb5\xed\x86\xa4\xab\xb5\xae\x2d\x8a\x0e\x67\xee\x87\x99\x98\x63\x26\x95\x12\x5a\xf1\xc5\xda\x9e\xcd\xc4\xa4\x96\x86\x96\x3a\x2f\x3b\xbd\x55\x0b\x67\x5b\xb6\xd4\xa2\x47\xda\x2c\x36\xc9\xce\xfa\xb9\xc6\x98\xd4\x6a\x9b\x82\x18\x53\x54\xbd\x2b\x0e\x78\x21\x49\x9b\x2b\x31\xbf\x71\xb6\xa4\x0b\xb2\xb1\x15\x5a\x15\x01\x97\x5f\x2c\xcb\xb3\x7d\xdd\x9d\x0b\x93\x6a\xa6\x88\x16\xb3\x7a\xb1\xb7\xa7\x33\x0b\x3d\xb3\xda\xcf\x98\xec\xaa\x21\xaa\x5c\xa9\x94\xb7\xcc\xc6\xa8\x3f\x13\xf2\xb1\x5e\xab\xb7\x9f\x09\xb8\x56\x12\x0d\x13\x2e\xe4\xa1\x9e\xdc\x76\xcd\x71\xbd\x5f\xd1\xf2\x76\x25\xbb\x2b\x8d\x07\xc3\x54\xc3\x5e\x97\xdd\x39\xd9\xcd\xe9\xd9\x4e\xe2\x0a\xa8\x25\x97\xdb\x13\x6d\x2f\x0f\xa0\xb0\x63\xd5\x94\xb2\x42\x6a\xac\xa9\x57\x88\x2a\xe5\xdc\xb1\xd2\x9c\x96\x2c\xcd\x04\xc5\x51\xa1\x53\x91\xe9\x02\xa3\x8f\x74\xa0\x8c\x57\xad\xb9\x2c\x5b\x35\x4b\xe6\x70\x5a\xa8\xee\x8a\xd3\x8c\xdd\x9c\x69\x31\xbe\xb1\xc9\x16\xb1\xab\x15\x17\x76\x55\x4f\x09\xac\xa5\xc4\xaa\x5b\x91\xcd\x95\xc4\xfc\x42\x58\x33\xb1\x49\xa5\x98\xeb\x97\xea\xc4\x91\x9b\xb1\x5d\x4f\x18\xa5\x5b\x93\x5c\xbe\x50\x4c\xab\xe5\xe9\x76\x3e\x56\x1b\x82\xb2\xb3\x2b\xdc\x50\x1b\xf2\x75\xd1\x90\xf9\x58\x6b\x56\x48\xce\x20\x23\x29\xdd\x41\xb5\xaf\x2e\x3b\x23\xb3\x63\x4e\xd3\x31\xa9\xb7\x6a\xec\x16\x0e\x3b\x01\xf3\x06\xec\xd7\xe5\x81\x3e\x15\xf5\x66\x6f\xc8\xed\x0b\xdd\xcc\x5a\xb2\xaa\xeb\xb2\x3e\xc0\x0d\xba\xdd\xe5\x35\x99\xa9\xc0\xb1\xea\xa4\x17\xc5\xfc\xb2\xd0\x75\x8b\xfb\x5a\xab\xd6\xd9\x6e\xca\x86\x52\xd0\x2a\xfd\xec\x80\xad\xa9\xcb\xad\x34\x2e\x21\xa3\xb8\x1e\xf6\xea\x4a\xbb\xd9\xd6\x5a\xdd\x76\xb7\xa6\xb6\xf7\xcb\x0a\x69\x76\x92\x56\x81\x4e\xf5\xeb\xab\x2d\x5b\xc9\x8a\x3b\xba\x31\xcf\x42\xe8\x74\x96\x42\xb9\x56\x1e\x2a\x7a\x47\xe1\xe5\x32\x71\xcc\x94\x98\x63\x6b\x7c\x61\x68\x2d\xd2\xe9\x0e\x5b\xc9\xca\xd6\xd8\xdc\x08\x05\xae\x57\x62\x46\x8a\x5c\x6d\xaa\xc5\xf2\x62\x49\x0f\xed\xe5\x6e\xb0\x53\x17\x74\x25\xa5\xc8\xb5\x1c\xa1\x47\xac\x2d\x76\xb1\x55\x2c\x4c\x4b\x44\x15\x48\xd6\x06\x83\xa2\xee\xca\xdd\x7d\xdf\x1e\x74\x56\xdd\xa1\x51\x8b\x2d\x95\x2d\xc9\x37\x27\xdb\x36\xc7\x72\xb4\xcc\xc6\xe4\xba\x94\x2a\xdb\x15\x85\x17\xa1\x33\xdf\xe7\x26\xdd\xf6\x9a\xd9\x4a\x7a\x3a\x5d\xae\xd7\x8c\x6c\xac\xeb\x6c\xf6\xf5\x64\x79\x9f\x5a\x5b\x39\x31\x3f\xad\xf1\x05\x80\xf3\x3b\x31\xd6\x2a\xe4\xdc\x66\x2c\x3f\x37\x45\x3e\x99\xb6\x45\x24\xd3\xd9\x8d\x5c\x93\xda\xdd\xa1\x94\xef\xeb\xab\x64\xa9\x89\x57\xf9\x79\xbb\x83\xb7\x69\x9e\x2c\x5a\x69\x11\xe5\x8b\x48\xd6\xa7\x12\x9b\xa7\x57\xf5\xf2\x58\x63\x36\xe3\xf1\x3c\xb5\x58\x6a\x30\xdd\x47\x25\x6b\xc5\xa6\x06\xb1\x4e\x5b\xb7\x67\xb1\xe6\xbe\x99\x57\xa5\xa6\x21\xdb\x32\x1a\x16\x53\x68\x3b\x64\x54\x92\x6e\x0a\x4c\x36\x26\xb0\x31\x7e\xc5\xe2\x66\x31\xb6\x1d\x32\xa2\x1e\x53\xd6\x43\x5b\xab\x4a\x33\xcc\xb5\xa6\x74\x72\xb0\x61\xa6\xb1\xaa\x41\x77\x85\x3e\x6f\x25\x01\x6f\xb4\x92\xc6\x06\x28\x9d\x82\x90\xd5\x80\x3e\x63\x71\x51\xd7\x20\x9e\xe8\x83\x4c\x85\xdf\x36\x26\x29\x7e\x30\x75\x9a\x3d\xa0\xe6\x93\x15\x00\xc4\x6e\xa9\xb1\x2b\xaa\x4d\x51\xa1\xe9\x51\x95\x2e\x77\xf9\x8e\xeb\xcc\xf4\x7d\xbd\x94\xee\xeb\xa5\x89\x82\xe6\xab\x5e\x0f\x8c\xaa\xd6\x56\x48\x97\xb5\xe4\x62\x9d\x04\x92\xc4\x57\x6d\x36\xcd\x16\xfb\xe2\xa2\x97\x77\x33\xd2\xac\x24\x89\xab\x5d\x7f\xbc\x69\xb8\x7a\x87\x11\x93\xb1\x5c\xa5\xbb\x68\x0c\x27\x6c\x12\xb3\xb1\xed\xba\x0e\xca\x75\x4e\x2c\x77\x1a\x78\xdd\x77\x10\x2a\x2c\xe5\x71\xa3\xb0\xce\x57\xf0\xd8\x5c\xf3\xf5\x4a\x95\x17\x86\xbb\x65\x6d\x56\x9e\x0d\x06\xcb\xe6\xc4\x26\x83\x4a\xd6\x2e\xaa\xd2\xae\x67\x89\xeb\x39\x4a\xaf\xf8\xf4\x32\x29\x0c\xf2\xed\x76\x77\x5e\xc9\xd5\xc0\xc8\xdd\x2b\x6c\xdb\xd4\xf2\x9b\xd1\x5e\xb7\xf5\xd4\xba\x30\xcf\x6f\xe5\x95\xb9\x1b\xcd\x06\xfd\x5c\x7b\xd4\xcd\xf4\x00\xdf\x49\x1b\xa5\xa4\x51\x29\xb9\x29\xb6\x46\x73\x9d\x82\xb5\x28\x8d\x60\x71\x36\x80\x55\xec\x76\x8b\xc9\x0e\x76\x8a\x83\x4d\xa7\x91\xee\x2c\x6b\xe3\xcd\x70\x53\x8b\xb9\x68\x34\x35\x6b\x7d\xb0\x9b\x49\x3b\xa9\x3e\xdc\x32\xc9\x41\x36\xdf\x94\xf6\x96\xcc\x6d\x7a\xcb\xbc\x59\xb1\xfb\xd8\xa8\x95\xdd\x45\x5b\xb3\x4b\x90\x18\xbb\x95\xde\xab\x17\x62\xa5\x51\x16\x16\xf9\x49\xcd\xb1\x69\x90\xca\x36\x16\xc2\x78\x9b\x6a\x69\x79\x21\xb7\x2a\xaa\x7c\x2a\x2b\xb7\x0c\xdb\x2e\x8d\x54\x7e\x38\x65\xd8\x31\xd3\x05\xf3\x2d\xe3\xae\x36\xed\x4c\x29\x37\x2f\xca\x46\x17\x8c\xf7\xec\xae\x3b\x9a\x81\x32\xef\xac\x5a\xfd\x4d\x35\x59\x5c\xd4\xea\x6e\x7f\xbe\xb2\x8a\xd9\xc9\x68\xc4\x99\xfc\xaa\x45\xa7\xd8\x9e\xed\xc6\xc4\xb1\xbd\xd2\x00\xca\x2f\xfb\x39\xd2\xcd\x4b\xfd\x4a\x7e\xbd\xd7\x26\x5a\x56\x5c\x48\x5b\xd7\x49\x4b\xe6\x60\x4f\x66\x3b\xa3\x6a\xb5\x9c\xb4\x03\x7b\xab\x66\xb1\x38\xaa\x26\x2b\x99\xcc\x24\xdf\x1f\x55\x54\x35\x2f\xe9\xb9\x64\x1a\x96\x0a\xf2\x6c\xca\x74\x4a\xc5\xe1\x1e\x8b\xb2\xc5\xb6\xb5\xf4\xac\xe6\xb6\x6a\x15\xba\x3b\x90\x19\x7b\x3f\xcb\x8e\x8a\xa8\xbb\x97\xa6\xa0\xa0\x4a\xa2\x9e\x6a\xca\x39\xb7\xb7\x32\x9b\x96\xba\xa5\x4d\x59\xe8\x10\xb3\x4d\x66\xf5\xae\x5e\x24\xa6\xa0\xe6\x46\xf3\xb2\xd0\xc8\xf7\xd1\x6c\x44\x60\x3d\x4d\x92\xa8\xd8\x2f\x75\x06\xaa\xd2\xed\x8d\xf2\xd3\x4d\x65\xa6\x2d\x0d\x09\x70\xe6\x44\x06\xdd\x6e\x0b\x77\x99\xd8\x40\x62\xc9\x0c\xda\x92\x43\xfa\x19\x33\x03\xbb\x8c\x14\xe3\x86\x8e\x12\x9b\xd2\x75\x6d\x99\xeb\x15\xda\xd9\x96\x64\x55\xb2\x45\x31\x59\x1b\x36\xc7\x06\x59\xf2\x29\xab\x69\x16\xf9\x75\xb7\x96\xdf\x17\x8a\x8d\x7e\x9a\x29\xb5\x4a\xb9\x2d\xd3\x4d\x73\xb1\x6a\x4d\x12\x1b\xce\xcc\x19\x4b\x39\x89\xd3\xd6\xee\x7a\x31\xae\x2c\xd3\xb1\x79\x46\xef\xb7\xf7\xcb\x1a\x9d\x9b\xc7\x64\x5a\x6c\xcd\x67\x3b\x7e\xd7\x87\x86\xba\xc4\xf4\x2e\x27\xd0\x79\xb5\xae\x6a\x4a\x85\xc5\x4e\xb3\xe7\xe0\xc2\x50\xdb\x3b\xdd\x4a\x7e\xdb\x2e\xce\x16\x36\x6c\xd7\x8a\x0d\xa7\xc7\x8c\x96\xc2\x6a\x3e\x67\x8c\xed\xc2\x29\xee\x5d\x4e\x53\x6c\x5d\x9a\xd7\xb4\x05\xae\xb0\xe9\x7c\x69\x69\x6d\xb1\x9d\xd7\xd8\xfa\xce\xaa\xd5\x72\xe3\x59\x2b\xa3\xf6\x74\x30\xd5\xd3\x23\x7a\x9d\x4b\xa9\x44\xca\xf4\x54\x1b\xcf\x73\xe9\x5a\xd2\x1c\x16\x31\xbd\x58\x97\x6a\x15\xd2\x4f\xb5\x5b\xfa\x6e\x35\x90\x2d\x4e\xc9\x0a\x2c\x3d\x80\x36\x5b\xdb\xef\x04\xbb\x52\x2d\xef\x49\xbf\xdb\x49\x75\xe7\xfd\xee\x58\x4c\x55\xf2\x75\x9a\x4d\x82\x26\xea\xc7\x94\x0c\xde\xa0\x05\x69\xf6\x9d\x18\x16\x36\x3d\x76\x6e\xb2\x99\xaa\x58\x51\xb3\xb9\x56\xbf\xc1\x95\x8a\x85\x59\x6d\x52\xdd\xd2\x29\xd3\x5d\x37\x9a\xb9\x4d\xb7\xb6\x17\xd4\x14\xe4\x6a\x9c\x32\x19\x8c\x9b\xa8\xbf\x99\xa4\xbb\x72\x81\x75\x44\x3b\xd6\xaf\xc4\xb4\xac\x00\xda\xbc\x5b\xe0\xe5\xf4\x10\x18\x53\xa9\x50\x1a\xb5\x45\xa9\x62\xa5\xda\x6e\x81\x6c\xc6\x7c\xda\x72\x15\x58\x88\x15\x53\x45\xde\xd8\x64\xf0\xb4\xd2\x8e\xed\x69\xc3\xca\x14\x4a\x58\x27\xa5\xb9\x8c\x76\x4b\xb8\x5f\xad\xda\xf2\xdc\x18\xd5\x0b\x1c\x1c\x76\x63\xcd\x1a\x23\xf7\xe9\x0a\x9c\x55\xdc\xee\x30\x9d\xaa\x2c\x8b\xab\x55\x95\x14\x39\x29\x3f\xe5\x76\x25\xab\xc0\xaf\x27\x13\x4b\x41\xb1\x1a\x62\xe4\xee\x0e\xc0\xdd\x34\x56\x73\x18\xa9\x30\x58\x14\x56\x72\x9d\xb7\x26\xc9\x91\xc2\x0e\x3c\xb7\xa0\x30\x9a\x4c\x7b\xc3\x56\xba\xb4\x68\x34\x5e\x4f\x63\x09\x40\x23\xaf\x91\xa2\xbd\xa3\x3a\x90\x2a\x50\x25\xdf\x81\x89\x1c\xbc\xae\x43\xa8\x0e\xd8\x04\x9f\x9e\xb0\x86\xd1\xb2\xcb\xe2\xc8\xdb\x89\xaf\xf4\x42\x07\x5e\x61\xe0\x2c\x06\x59\x15\x81\xa3\x73\x3c\x5e\xc7\x22\x4c\xac\x36\x36\x34\x77\xbe\xcb\x14\x7c\x8d\x73\x09\x2e\xc1\x26\x2c\x4d\xd5\xfd\xd3\xf4\xd5\xdd\xc3\xf4\x4d\x4e\xa5\xe7\xb1\x7c\x26\x5d\xde\xf7\x18\x73\x9c\x05\x7c\x2b\xc5\x36\x47\x64\xd0\x28\x6c\xa6\xf2\x70\xba\x37\xf8\x3d\x4e\x5b\xfa\xbc\x65\xa4\x16\xd2\xd0\xa9\xc7\x72\x80\x27\xe3\x0a\xdb\x57\x33\x2b\x75\x8f\x03\xb8\xf7\x0e\xd4\x5f\xe8\x00\xe7\xb7\xbb\xe8\x8b\x68\x65\x25\x04\x0d\xdb\xa2\xa4\x01\x33\x70\xfb\xc0\x0a\x6c\x69\x4d\xe5\x2d\xda\xc0\x86\x01\xcd\xc4\xca\xa2\xd9\x04\x9b\x4a\x64\x69\x5b\x17\x0f\x85\x1f\xd3\x35\xe9\x25\xe1\x98\x29\x19\xf5\x8d\x38\x6a\x0e\x32\x4a\x93\xec\xd2\xad\xa9\xa1\x90\xbe\xb2\x9f\xad\xf2\xb3\x1e\x2b\x68\xf5\x71\xa7\x06\xb8\x66\x79\xe9\x9a\x68\xb0\x49\x59\xd5\x5c\x46\x6c\xd4\xbb\xe5\x3d\x33\x63\xff\x22\x5d\x7f\x20\x9f\x63\x75\x99\xce\x71\x9f\xa8\xe6\x6a\xa4\x4f\xe5\x9d\xc8\x18\x9c\x31\x2f\xb2\xe6\x50\xe5\x97\x93\xc2\x02\x37\x1a\xbb\x4c\xcf\x1c\x64\xa6\xe6\xaa\x51\x01\x55\x89\x46\xcd\xda\xbe\xb1\xad\x96\x2d\x29\xb5\x65\xb6\x8d\x4e\xac\xc8\x64\x57\xc3\xce\x5f\x9f\xac\xeb\x54\x0e\x3f\x21\xc0\x12\xb0\x09\xff\xc5\x26\xf2\x09\xf6\xa4\x20\xfe\x31\x35\xe9\xf2\x6c\x6f\xe6\x47\x29\x20\x6f\x46\xdc\xac\xe5\xf4\x4d\xa5\xda\x6a\x02\xd9\x58\xec\xea\xbd\xa2\x25\x71\x74\x79\x6b\x97\x5b\xbd\xe1\x6e\x53\x72\x92\xd6\x02\x9a\x79\x81\xae\x6c\x45\xa5\xdf\x6b\xe7\x4a\x35\xe5\x0f\x50\xf3\x5f\xf1\x38\x55\x86\x0e\xd4\xb0\xa1\x43\x44\x28\x27\x88\x9d\x50\x58\xa2\xa6\x76\x18\x32\x51\xa0\x66\x48\xb6\x46\x09\xc1\xd1\x17\xa5\x61\x59\x56\x91\xfc\x87\x98\xe1\xd8\xf0\x5f\xc9\x44\x26\xc1\x32\x61\x36\x8b\x0d\x3f\x60\x40\xde\xce\x6b\x7b\x9e\x56\xcc\x1c\x64\x53\xb5\x76\x1d\xa6\xc7\x95\x9e\x39\x56\xeb\xdc\x80\xb8\xe9\xf2\x3c\xb9\x74\xf3\x73\x5a\xce\x0a\x9b\x55\x8e\x9d\x25\x3b\x42\xa5\xb3\x4d\x97\x5a\x3d\x6b\xbf\x15\xf9\xdc\x4a\xfe\x22\x03\xa8\x78\xfc\x8f\x4d\xe9\x2d\x2a\x3e\x9e\xca\x1c\x89\x81\xb6\x66\x4f\xa6\x08\xa5\x47\xfd\x7e\x8d\xee\xf2\x70\x59\xaa\x67\xc6\xb3\x86\x03\xe6\x0d\x9d\x96\xcb\xbc\x4d\x86\x0e\xa9\xc0\x8a\xb6\xdf\x6e\x67\x60\xd9\x8d\xd5\xe8\x65\xa3\x22\x36\x68\x29\xb6\xfb\xfb\xa6\x72\xe8\xc7\xda\xfe\xd6\x19\x8d\x07\xf1\xbb\x7f\x71\x09\x26\x91\x39\x72\x24\x2c\xfd\x80\x29\xe3\x61\xb1\xe2\x74\x17\x43\x09\xb9\x2b\xd1\xdd\xd1\xca\x64\x5a\x51\x67\x83\x9e\xc6\x33\x62\xbf\xbb\x53\x63\x25\x86\xee\xd9\xcb\xde\x62\xdf\xee\x3b\xf9\x7e\xb6\x93\x24\xcb\xe4\x6a\xd3\x82\xbd\x79\x6c\x6d\x8c\xb8\x7f\x70\x7a\x3f\x26\xe9\xe3\xb9\x86\xdd\x51\xcd\x59\x14\x78\x3c\xa1\x2d\xa9\x97\x12\x6b\x0e\xbb\xc9\x95\xd2\x39\xdd\xec\x36\xad\x3c\x67\x17\xf1\x0e\xd1\xd3\x41\x7a\x94\x8b\xb5\x8a\xf4\x7c\xa3\xab\x58\xa8\x94\x0b\x6b\x59\x04\xa5\x5a\xaf\x33\xfe\x27\x94\xd0\xe7\xf9\x64\xf7\xe9\xc1\x60\xdd\xaa\xce\x67\xc4\x5e\xf1\xcd\x79\xd6\xad\x2d\xeb\xc9\x06\xb7\x67\x3b\xf3\x4d\x6e\x2d\x30\xc3\x8d\xd4\x41\xbb\x6a\x71\x21\x90\x62\xb1\x43\xb3\xb5\xb4\x99\x5f\x1a\xed\x5a\x16\x5a\x30\x23\x8d\x45\x3b\xf5\x55\x7a\x4e\x08\x3a\xc9\x2e\xdb\xc6\x09\xd4\x0d\x0d\x10\xf8\x7e\xa8\x51\x0a\xb3\x0f\xc6\x87\x9a\x63\x98\xfa\xe4\x68\x21\x38\x84\x3b\x86\xfa\xe3\x82\x66\x5b\x9e\xe4\x1f\x33\xb1\x2c\x4d\x15\x61\x84\x7a\xf6\xa0\x46\x0f\xa5\xbf\x47\xa9\x18\xa5\x8a\xe1\xf9\x88\x7f\x26\xe7\x00\xed\xfa\x9c\xe3\x05\x1f\x4f\x77\x6e\xe4\x42\x9c\x87\xe0\x35\x95\x7a\x3e\x3b\xff\x8a\xfe\x78\x35\x9c\x13\x97\xb0\xf9\x1a\x79\xf0\xb0\xae\x99\xd8\x36\x9e\x28\xff\x6c\xf6\x91\x52\x11\xe5\x07\xea\x1b\xc8\x2f\xb7\x22\x21\x30\x1f\xfd\x38\xc1\xaf\x11\xbf\x61\x84\x7a\x0e\xf1\xf9\x46\x45\x81\x40\x54\x07\x46\x9f\x03\x18\xd4\xeb\xeb\x2b\xc5\x50\xdf\x3d\x66\x9f\x9d\x1d\xd0\x58\x3b\x79\x3a\x3d\xec\x7a\x27\x09\x1d\x43\xee\x1f\x35\xf3\x4f\x36\xfe\x10\x0d\x9f\x23\x7b\x7e\x9c\xf2\x9e\xb3\x16\x0e\xe3\x15\x1c\x00\xfb\x50\x3d\x04\x78\x15\x89\xcf\x5e\x49\x50\x7f\x2c\x5a\xc3\xf0\x30\x29\x61\xdb\xaa\xe8\x31\xe2\x08\xef\xc6\x51\xcb\xcd\xf3\x93\x9b\x09\x4e\x11\xea\x39\x08\xd3\xdf\x98\xd2\x1b\xe7\x6d\xfe\x9c\xbd\x46\xfc\x9e\x17\xf4\x9d\x9e\x53\xde\xcf\xa5\x0a\x8f\xc8\x82\xbc\xb3\xf0\x48\xee\xec\x04\xf3\x26\x3c\xcb\x8c\x63\xa4\xed\x22\x6f\x7d\x13\x3a\x2a\xb6\xad\xeb\x1e\x97\x67\x4e\xf7\xc9\x46\x70\x4b\xfe\x1c\xd9\x7e\xcf\x3f\x42\xf6\x31\x97\xea\x2f\x92\xdd\x85\x5b\xf2\x09\xc9\x97\x87\x6c\x8a\x49\xd1\x57\x07\x5e\x7f\x4c\x53\xf5\x03\x4d\x25\x5e\x68\xa9\x8b\x05\x24\x52\x47\x49\xbc\xa9\xc6\xbc\x8a\x30\xef\x27\xc8\xbc\x20\xa6\x8d\x04\x7f\x90\x67\x3f\x85\xfa\x20\xd7\xa6\x76\xc2\xdb\x9f\xbe\x51\x87\x52\x3f\x9b\xe0\x8a\xc4\x6b\x4d\x79\x23\x17\xd2\x5b\x3e\x18\x3d\x7b\x8a\x1a\x62\x07\x9a\xaf\x91\x3d\xc6\xfa\xe8\xd8\xf2\xac\xde\x26\xaf\x11\x1b\xdd\x6f\xa0\x63\x07\xbe\x46\xfc\xbc\x91\x25\xc6\xfa\x4c\x25\x4a\xc9\x4f\x7e\x38\xd5\xaa\xaa\x2e\x53\x4e\x5c\x95\x42\xa2\x14\x60\x9d\x02\x7b\xf6\x37\x3a\xbf\xe6\x1d\xdd\x3e\x20\x4a\xe4\x8c\x5b\x1e\x90\x0b\x9a\x22\xd4\xb3\xef\x83\x1e\x59\x15\x20\x26\x68\xaa\xb0\x7e\x8d\x60\x03\xa2\xd1\x79\x12\x47\xe4\x30\xfd\x27\x68\x41\xcd\x82\x7f\xea\x14\x0d\x7a\x8f\x15\xab\x58\xe8\x14\x0a\x85\xb2\xc1\xd4\x59\xc3\x3f\x45\x63\x8b\x9d\x69\x65\xae\xa6\x62\x93\x54\x7f\x52\xe3\x6c\x7e\xd7\x5d\x37\xfb\x9d\x3d\x29\xa9\x46\x4b\xe4\x20\x97\xee\x4e\xa6\x53\x75\xa9\x6f\xb8\xdc\xbc\xb5\xf1\xfa\x94\xe6\xc5\xc6\x6c\xee\xc1\xc9\x56\x0a\x85\x42\x6f\x5b\xa8\x4d\x5b\x6e\x8a\x2f\x14\x0a\x55\x9e\xd1\x2a\x83\xe9\x30\x85\x7a\xdc\x62\x3c\x95\xf8\xa1\x32\xaa\xe7\x84\x8a\xe3\x16\x1b\xe3\x72\xc9\xad\x02\xb1\x61\x0b\x33\x45\xd5\x50\x13\xeb\xbb\x2c\x41\x9b\xf1\x32\xb5\x59\x54\xdb\x6e\x45\xaa\x18\xfc\xa0\xdb\x2b\xf5\xb9\xb9\xe3\xec\x2b\xf2\xde\x9d\x55\x8b\xa8\x94\xce\x20\x92\x4b\x5b\x23\xce\xd8\x5b\x96\xb4\x9a\x0d\xd2\x7b\xb9\x52\xf8\x6b\x7f\xca\x29\x87\xd3\x84\x8c\x6e\x67\xd7\x4d\x69\x96\xcd\x49\xfd\x0c\x9d\x1c\x8b\x19\x9a\x75\xa4\xb9\x9a\x36\xf5\x49\xbf\x9b\xa6\x73\x69\x32\xeb\x3a\xfc\x14\xd9\xe9\x01\x90\xec\x9a\xc9\x6d\xd5\xfd\x20\x2f\x32\x76\x4d\x61\x61\xaa\xbf\xc8\xe7\x9d\x8d\x5a\xd3\xd2\x6b\x89\xcf\x75\xe0\x9a\x07\xbd\x4d\x09\x4d\x92\x62\x59\xc1\x1b\x75\x9d\x1b\xf7\xf2\x8d\x39\x2b\xad\xc9\x78\x1a\x73\xf6\xb1\x58\xa9\x6d\xcf\x49\x3e\x25\xa2\xbe\x2e\xb6\x99\x4c\x66\xb2\x02\x3c\x9a\x71\xcd\x79\xd3\xe4\x3b\x5c\x55\xeb\x31\x63\x30\x37\x4c\x89\x5f\x99\x73\x42\x2f\x56\x1a\x37\x4e\x65\x92\xdb\xa4\x34\xd3\x89\xd4\x01\xbd\xa5\xc6\xb1\x7a\x8e\x61\xa5\x61\xd2\x4a\xe6\x96\x0b\xb2\x8e\x99\x1b\x69\x9d\xa9\x71\x9b\xfd\xaa\xc8\xa0\x09\xa7\xc8\xa9\xfe\x24\x95\x9a\x4a\x68\x3a\x4f\x2d\x67\xd6\x72\xb3\x6d\x32\x74\x4c\xac\xf4\xda\xe9\x7e\x3a\x5f\xce\x3b\x4e\xc6\x95\xd0\x06\x14\x19\x37\x3d\x5f\xaf\xfa\x23\x69\x43\x67\x93\x8a\x9d\xb4\x66\x66\x9d\xdb\x66\xfb\x25\xb8\x37\xcd\x4e\x47\x62\x8d\x7e\x41\x14\xa6\xe5\x7c\x85\x2e\x29\x5d\xb6\xd3\xdf\x0f\x60\x4c\xe4\x94\xfd\x9c\xc1\x83\xb4\x1e\x73\xca\x9b\x4c\x2d\xab\x6c\x9c\xec\x68\x5e\x27\xe5\x02\x58\x88\x46\xaa\x3b\x45\x80\x9e\x0c\x64\xa6\x29\xf5\x63\xd9\xc5\x50\x49\xa5\xd8\xaa\x5e\x27\x29\xab\x4d\xd7\xcc\xfe\x38\xbb\x32\xe8\x58\x2b\xcf\x6c\x40\xba\xbe\x32\x25\xb5\x36\x4b\x92\xf1\x02\x09\xb5\x1d\x3d\xc9\x0c\xea\x43\x35\xeb\x74\x0a\x4c\xae\xd5\xe3\x4a\xba\x38\xd6\xcc\x05\x33\xb5\xb9\xf1\xde\x6d\xd5\x7b\x2d\xc4\xb7\x94\xc1\x2c\x69\x8c\x26\xe3\xb2\xd6\xdf\xf1\x19\x66\x30\xeb\xe4\x73\x7d\x40\x27\x9d\x4e\x69\x4b\x83\x62\xa3\x9c\xda\x0a\x9c\x5e\x01\xb1\x4e\x11\x69\x83\xad\x0a\x14\xdd\xd6\x36\x34\xd3\x1f\xe4\x84\xcc\x66\x5b\xce\xcc\xd9\xa1\x2c\x26\xbb\xa3\x5c\x7e\x90\x29\xa5\xac\x0c\x5f\xde\x3b\x56\x69\x4b\x2f\x19\x0d\xcd\x67\x8b\xa2\x99\x75\x67\xb3\xe4\x7c\xce\x60\xd3\x4d\x2d\x88\xb2\xdf\xba\x9b\x7e\x17\xc1\x7a\xb5\x9d\x54\x17\x7a\x25\x96\x4d\x67\x27\x20\x53\xe9\xf5\x7b\x9d\xe6\x46\x50\x56\x7a\x71\x40\xdb\xa9\xd8\xc6\x29\xcc\x16\x62\x73\xd1\xd5\x94\x59\xce\x46\x2c\x74\x35\xbd\xc9\x19\xed\x7a\xc9\xb2\xdc\xb4\x53\x55\x94\x45\x31\xbd\x68\xc6\x18\x6b\xd3\xb6\x97\x53\x9a\x66\x98\x8d\x60\x0b\x88\xef\xa4\xe5\x49\x37\x2b\xee\x9d\x4e\x21\x29\x88\x4d\x5c\x5f\xa1\x1c\xdb\x33\x49\x8e\x2e\x09\xc9\x9d\xdb\xae\xf7\xb2\xa4\x59\x2f\xb9\x7b\x41\x27\x9b\x0a\x9f\x6b\xf5\x4c\x44\x9b\xe3\x89\x35\xe7\xcd\xc1\x76\xbb\xa9\x59\xb9\x18\xaf\x5b\xcb\x22\xee\xcf\x39\xba\x95\x44\x8e\xae\x39\xc9\x72\xad\x52\x5f\x6d\xf2\x22\xa7\x57\x46\xb3\x5e\xba\x4f\x6f\xf6\xe6\x48\x9a\xcc\x73\xeb\x79\x6a\x5d\x98\xf5\x44\x9e\x5b\xed\xa4\x89\xd4\x96\xd7\x82\x41\x97\x07\x6e\x2d\x3d\xd9\xcb\x48\xc8\xd8\xf6\x5c\x12\x77\x46\x67\x96\xe1\x4a\x5b\x8d\x6c\x70\x2e\x9d\xdb\xd4\x9c\x6c\x2e\x36\xca\x3b\x8d\x7a\x4f\x72\xc6\xca\xa0\x9f\xcd\xbb\xe3\x19\xe8\x76\x5c\x52\xcd\xd5\x74\xcb\x6a\x59\x56\x69\x3b\x5e\x6d\x84\x4c\xb9\xdb\xaf\x8e\x95\x5e\x4a\xa8\x15\xd3\xbc\x43\xf3\x7a\x71\x39\xc4\xb9\x58\x89\xde\xf5\x75\xba\x2f\x4f\xf8\xf9\x5c\x9d\xd2\x4e\x73\xe2\x64\x46\xa9\x0a\xb2\xa4\x99\x6c\xd5\xbb\xa6\x9a\x17\x39\xe4\xe1\x25\x6d\x1c\x81\xd7\x53\xe6\x6e\x96\xdd\xe9\xe3\x92\x20\x4d\x67\xf2\x94\x75\xf4\x12\x6d\xe8\x4b\x4b\x4a\xb6\x21\x67\xcf\x47\x63\xb7\xaa\xd7\x47\xb3\xb2\x58\x57\xc6\x3d\x5a\x2b\x74\x61\x76\xb8\xa8\xe1\x65\xbb\x3f\xb0\x84\x4c\x66\x5b\xae\xcd\x8a\x5b\x59\x4c\x36\xf3\x48\x52\x49\xac\xc3\x59\xed\x3e\x9f\xa9\x68\xa0\xab\xac\x7a\xe5\xd8\x9e\xd7\xd3\x9d\xb5\xd0\x5d\x2a\x75\x5e\x25\x5a\xac\xb8\xc8\xe4\x6d\xc4\x13\x04\x56\xd2\x48\xd5\x3a\x92\xdb\xae\x17\xa7\xe9\x6c\x6e\xd8\xdd\x2e\x96\xb0\x36\xed\x37\x57\x6e\x2b\x95\xd9\x4e\x95\xe4\x68\x23\x20\x34\x5b\x8a\xf3\x96\xba\xb7\x77\x79\x7d\x39\x60\x1b\xb5\x7d\xd9\x76\x0a\x9b\x2d\xad\x95\x56\xdb\x45\x8e\x66\x9c\x2a\x6f\x98\xd5\x4d\x36\xe3\xc1\x61\xdd\xfc\x7e\x36\x2b\xcb\x79\xbc\x88\xb5\x24\x94\x9d\x3b\xf2\x70\x91\x35\xb6\xc6\x8e\x1e\x0b\xfb\x09\x67\xb5\x27\x9c\xb5\x52\x4d\x8f\x26\x11\x96\x8a\x4b\x7d\xbf\xec\x99\xf9\x2d\xcf\x74\x16\xe9\x9c\x33\x76\xab\x73\xb1\xeb\xae\xac\xe5\xaa\xad\xac\xdb\xa3\x56\xa6\x3c\x76\x81\xb1\x74\xf2\x78\x5e\x60\x49\x66\x2d\xf3\x9d\x5e\x26\x57\x8e\xc5\x3a\xee\x9c\x13\x07\x4d\x52\xdf\xe6\x96\xa9\xf2\xb2\xcb\xa2\x11\xef\x94\xf2\x5c\x99\xce\x71\x70\x93\xec\xab\xc3\x7e\x71\xc3\xd6\xc1\x72\x6d\xe5\xfa\x7a\x91\xf0\xdc\x72\xb4\x5c\x32\xac\x5e\x11\x63\x6d\xa6\x3d\x17\x74\x29\xcd\xcd\xd9\x64\x7e\x4c\xcf\x2b\x6e\x79\xca\xcd\x67\x58\x72\xd3\x55\x45\x4f\xc5\x60\xbd\xc1\x5b\x66\x8f\xce\xe0\xa9\x32\x48\xef\x6a\x88\xaf\x75\x0c\xc4\xd2\x9d\x32\x70\x94\xfa\x88\x1d\xe7\xfa\x8c\x9b\x31\xdd\x5e\x4d\xb7\x6b\xe3\x7a\x5f\xd3\x1c\x39\xd7\x4c\x8a\x7c\xbf\x20\x2e\x59\x71\x0c\x3b\x55\x1a\x29\x83\x98\x91\xe3\xf7\x02\x57\xa2\xa5\x7d\xb1\x1c\xcb\x24\xe7\x39\x9b\x03\x9b\x3a\xed\x4c\x4b\x29\x8d\x76\x9a\xfb\x5c\x7f\x3f\x1f\x55\xea\x31\x67\x13\xd3\xb3\x43\x29\xa6\x0d\x74\x27\xdf\x61\x85\xae\xa1\x54\xc7\x4a\x87\xe5\x52\x62\x97\xe7\x93\x19\x15\xe1\x7c\x26\x55\x23\x72\x2d\x36\x8a\x19\x6b\xa3\x24\xad\x72\x7b\x45\x9d\x4d\x68\x05\xb8\xad\x7e\xb3\x5d\xcc\x26\x6d\x94\x32\x98\x1e\x1a\x33\x49\x71\xb5\x4a\x63\xbb\x9a\xcb\x20\x21\x2b\xe5\x84\xec\x50\x14\x92\xbd\x35\x22\x68\xbf\x4f\xad\xb3\x53\x27\x3f\xd6\x61\x76\x5c\xe8\xa1\xfa\x14\x14\x5d\x57\xa2\xe9\x2d\x8b\x0c\x3e\xdd\xa3\x87\xd5\xa5\x33\x34\x17\x31\x9b\xd1\xc5\x71\x7b\x64\x8c\xf7\x65\x45\xa9\xd5\xf3\xc3\x51\x6c\xae\xdb\xdc\xb8\x9c\x9a\x8b\x9c\x04\xb3\xb1\xb9\x2d\x0d\x99\xd2\x5f\xdc\x93\x72\x5d\x3a\x55\xe5\xb8\x9c\xba\x17\x6b\xdb\xd9\x2c\x77\x1d\xcd\xfe\xcc\xc2\x08\x9e\x11\x3e\x33\x3a\xe8\xb7\xcf\x6c\x2f\x1f\x1c\x8f\xc5\xdd\xa9\x15\xa4\xa4\xcf\xaa\x7d\x33\x2f\x72\x6a\x17\x79\xff\x8c\xfd\xd2\xb7\x83\xa5\x77\x2c\xa2\xbe\xbf\xd0\x4a\xfa\x0b\xd0\x3c\x73\xe6\xed\x05\xea\x6f\x5d\x4c\xf9\x85\x2f\x34\xd4\xdf\x2e\x3a\x1b\xe7\x7d\x2f\x2d\xf8\xc0\xde\x3e\x38\x73\xd1\x20\xa1\xdf\xff\x37\x6e\xa8\x9a\x16\x58\xac\x7e\x0e\x7a\xf0\xd5\x35\x81\x41\x79\x9e\x82\xdf\xa6\xe4\x75\xab\x62\x73\x44\x00\xb1\xad\x87\xc7\x77\x6a\x2c\xbf\xc4\x23\xc5\xb7\xda\x5f\xc0\xc1\xeb\x23\x40\x3e\x38\x7d\x09\x02\x64\xeb\xe8\x89\x10\x20\x27\x82\xf4\xb6\x8b\x34\xa8\x03\x01\x1f\xe0\x16\xb9\xa0\x20\xee\x61\xe8\x01\xf4\xac\x7b\x1f\x29\xff\x01\x6e\x89\x87\xd1\x99\xd7\x60\x7c\x6d\x86\xcf\x72\xd7\x42\x07\xeb\x98\xab\x79\x40\x90\x20\x8a\x27\x28\x8e\x6d\xe2\x5f\x9a\x32\x4c\x55\x07\xe6\xce\x2f\xb3\x74\xca\x87\x13\x50\x78\x69\xbb\x96\x21\x01\xaa\x66\x05\x86\xeb\xdb\x54\x85\x2e\x15\x16\x79\xd8\x9e\x38\x73\x97\x43\x58\x50\xc0\x48\xbc\x35\x08\x25\x69\x18\x90\x20\xd7\xfa\xc8\xe3\x77\xeb\xf9\x32\xd5\x6c\xaa\x5a\x2a\xf1\xb3\x17\x4f\xf8\x73\xc2\x92\x3f\xed\x44\x79\x43\xd6\x83\x5b\x0f\x63\xc0\x6b\xf0\xd2\x99\x0a\x6e\x42\x1c\x52\x01\x83\x6b\x11\xde\xbf\x71\x8b\x98\xaa\x01\xc5\xf0\x49\xf1\xdc\x97\x43\x8d\x4e\x5d\x5f\xa6\x78\xf7\xbd\x88\x57\x7e\x84\xe8\x3d\xc4\x35\x9f\x0b\x27\x93\x47\xcc\xb3\x45\x40\x14\xca\x12\xb0\x11\x64\x10\x46\xde\x02\x7c\x5f\x68\xa2\x7c\xd4\x6a\x0a\x34\x1b\x9e\x37\x7a\xa1\xdf\x01\x7b\x35\xe1\x65\xe5\xa0\xf7\x21\xfb\xfb\x88\xc2\x61\x49\x84\xce\xa1\x8a\xa8\x90\xa2\x77\x71\x16\xc2\x05\x16\x60\xf4\x10\xd4\x3f\x9e\xaf\x60\x72\x24\x36\xbc\x4c\x82\x80\x1e\x08\x7d\xf0\x9c\xf0\x9e\x3d\xb9\x27\xe2\xc7\xfd\xfc\x4b\x28\xa7\x1d\x83\x5b\x29\x17\x3d\x2f\x68\x7c\xa7\xea\x85\xf6\x27\xe2\xcf\x08\x49\x90\x44\xeb\x49\xdf\x07\xbe\xb6\x89\x5d\xea\xe6\xb5\x97\xc8\x9d\x18\x18\xd6\xe2\xa9\x73\x56\x9d\xc6\xa0\x2e\x23\x4d\xb7\x43\x4a\x97\x61\x85\x0b\xf8\xb9\x1b\xf0\xcf\xef\xf8\x84\x03\x85\x85\x07\xb7\x38\x9c\xe7\xc3\x98\x67\x5d\x6e\x0e\xfe\x97\xd6\x9f\x55\xdc\xbd\xa7\x4c\xdf\xe1\xf2\x71\x4a\x95\xe4\x31\xef\x39\xb8\x04\x1a\x4f\x05\x9a\x36\xb8\x2a\x72\x7e\xb7\x88\x32\xf8\x38\x17\x79\xf3\xb3\x9e\x29\xfe\x3c\x33\x5b\x49\x9e\x69\xd3\x60\xf7\x0b\x83\xb8\x0d\x3f\x52\x18\xa7\x58\xea\xc5\x5f\xcb\xef\xfd\x4a\x41\x03\x2b\xa1\x41\x24\x13\xe5\x18\x94\x3c\xeb\xa8\x22\x2a\x7c\xb6\xc6\x78\xa4\x84\x17\xd5\x2f\x26\x39\x08\x12\x87\xfc\x3f\xb0\xe2\x7a\xa0\x5f\x2f\x51\xfa\x2d\x08\x31\x9e\x8a\x88\xf5\x07\x3a\xfb\xed\x4f\xcf\xce\x2f\x23\x98\x5f\x47\xe1\x6c\x9f\x3a\xa5\xea\xf6\x9e\x15\xde\xf2\xf8\x57\xb8\xb1\x9c\x73\x88\x8a\xbd\x52\x6c\x3a\x42\x3d\x8b\xaa\xe5\x49\x99\x78\xd5\xe0\xed\xf5\xb3\xa9\xb8\xd8\x84\x4e\xf7\x37\x4d\xf6\x3f\xfc\x7b\xc2\xd4\xe5\x0d\x9d\xc8\x9b\x3f\x40\x07\x9b\xf0\xfd\x82\xc6\xdf\x21\xd5\x7e\xe6\xfe\x3f\x2a\xd0\xe1\xdd\x80\x3f\x22\xcb\x07\xbc\xfe\x21\x09\x3e\x80\xbf\x21\x34\xb7\xa5\xf6\x83\x0e\x9f\xca\xea\xc7\x83\xfd\x7f\x22\x9f\x57\xec\xfd\xcf\x91\xca\xf7\x6d\xec\x9f\x13\xca\x3b\xb2\xe8\x71\xe6\x4a\x10\x2f\x25\xf0\xbd\xd1\xe1\x3c\xe7\x5a\xf6\x4e\x76\xd8\x2b\xc9\xfb\xf5\x6c\x94\x1b\x7a\xf2\x76\xbb\xeb\x43\x9c\xdb\x90\xde\x5e\xe8\x93\xd1\xbf\x24\x43\x27\x44\xdc\x10\xa0\xd3\xda\x83\xf4\xfc\x07\x8a\x8d\x7f\x81\xe7\x13\xe3\xe7\xe2\xf2\xed\xcd\x93\x86\xe0\x22\xd0\x3b\x48\x8f\xa1\x77\x7c\x9a\x9b\x57\x39\x4f\xba\xb6\x83\x9a\x5e\x58\x71\xea\x8c\x72\x6f\x61\x25\xe5\xb7\x4c\x24\x12\x2f\xb4\xc2\xdd\x36\x91\x0e\x57\x43\xef\x1e\x40\x1e\x1a\xc4\x79\x60\x52\xbc\x1c\x57\x91\x84\x4f\x99\x72\xe8\x1f\x1e\x4a\x1d\x9a\xf3\xc0\x0c\x4f\x94\x7c\x13\x15\x61\xf7\x35\xc2\x9c\x96\xe8\x2a\xba\x2c\x01\xdb\xd7\x48\x32\xcd\x30\x17\x5c\xb9\x14\xb0\x3f\x61\x72\xad\x80\x03\x82\xd2\xc3\x6b\x54\x6c\x24\xf8\x17\xca\x0d\x60\x5a\x70\x04\x2d\x4b\xc5\xe8\xc1\x0a\x3e\x1f\x8f\xb7\x49\x35\x48\xfc\xe3\x35\xea\xf5\x58\x44\x1d\xb2\x3d\x9e\xa9\xb0\x79\x22\x2c\x78\x3a\xb9\xeb\x04\x88\xf5\x5e\xef\x3f\xbe\xd7\xfa\x42\xfe\x4c\xfd\xfa\xdb\x79\xd1\xf5\xae\xee\xb5\x09\x9b\x1c\x0e\x98\x24\x6c\x52\x0f\x1e\x56\x5e\x8f\x89\xa9\x79\x6a\xe2\x30\x8c\x0f\xf7\xf1\x04\x51\x0f\xf3\xa0\x34\x61\xd8\x96\xf2\x70\xd6\xf0\xd7\x10\xc2\x6f\xc7\xeb\xe5\x57\x63\x78\x4b\xfe\x72\x80\x6b\x2c\x4f\x47\xf4\x7a\x1d\x92\x00\x4e\x59\x46\xf9\xb0\x9e\xfd\x7f\x9f\x4e\x4a\x8f\xac\x38\x96\x7d\x3f\x7e\xbb\x22\x15\x4b\x9f\x60\xf2\xab\x07\xfe\xb7\xc7\xb3\x71\x43\x6c\xbe\xc0\x86\x1b\x28\x1c\x19\x78\xc3\xe2\xf2\x41\x85\xd0\xaf\x58\xf8\x51\x47\x0b\x9b\xe4\xe1\x01\x3c\x51\xfc\x23\xf5\xfa\x76\x82\xac\x09\x89\x6d\x22\xea\x30\x65\x81\x16\xa4\xe2\x14\x7f\x56\x70\x1c\xea\x38\x68\xd8\xcf\x1b\xf3\xec\xd2\xf4\xd4\xf6\x53\x19\x0d\x8c\x20\x22\x0f\xd1\xfe\x2d\x37\x23\xfa\xf4\xfe\x22\x94\x50\xb5\x3d\x53\xd1\x1f\x3f\x74\x49\xa2\x87\x19\x14\xa1\xa6\xea\x6a\x28\xa9\xd1\x9f\xbe\x45\x9f\xa8\xe8\xf7\xe8\x51\xac\x3d\x84\x1e\x1e\xaf\x09\xbc\x31\x3d\xe1\x16\xf0\x4c\xb1\xe9\xab\x69\xf8\x7e\x80\x67\x98\xd8\xb0\x9e\x4f\xba\xdf\x5b\x35\x05\xd3\x04\xbb\xb3\x19\xf1\x98\xf5\x01\x4f\x8e\x46\xea\xc7\xec\xb8\xb2\x65\xff\xa3\x38\x71\x49\xf8\xa1\xb1\x47\xae\x4d\xa0\x78\xd5\x3e\x24\xe8\xe1\x7c\xc1\x98\xd0\xb2\x35\xe2\xad\xde\xef\x27\xa5\x67\x8b\xd1\x5b\x89\x44\x51\xad\x6b\x8d\xe3\xfd\x51\x25\xea\x21\x70\xa1\xb1\x45\xfc\xc0\x86\x8a\x42\xa8\x97\x4d\x0f\xa3\xfd\x7a\xd6\xfe\xb7\xd3\xc5\xea\x7d\x3d\x4a\x7a\x48\x19\xe5\x9f\x12\x7f\x09\xd4\x85\x16\x0a\x31\x14\x9f\xa9\xdf\x13\x36\x52\x37\x36\x6c\x88\x0f\x51\xaf\xf5\x21\x77\xe9\xf7\xe8\xe3\xd3\x55\x87\x83\x9a\xf2\x3e\x7f\xbb\xa8\xfd\xfe\xc3\xbd\xa7\xef\x67\x5c\xf5\x27\xfc\xf7\x20\x60\x63\x3d\x84\xfc\xf8\xe5\x7a\x8e\x3f\x94\xd7\xd1\xb9\xf9\x7a\x47\x5c\xef\x18\xb9\x7f\xa7\xb4\x9e\xd8\x6d\x7f\x83\xa8\x7e\x48\x73\xed\x60\x7b\xdd\xa1\xf6\xca\x36\xfb\x2a\x9d\x1f\xa2\xf6\xf9\xde\xfc\xe5\xc5\xa6\x83\x35\x2c\x03\x02\x2c\x78\xb5\xd8\xbc\x15\x85\xb0\x08\x2d\x7f\xbd\xfd\x72\x51\x03\x45\xd9\xaf\xf9\xf5\xb7\x5f\x7e\xf8\x73\x6b\xd1\xb7\xe1\x45\xea\x95\xfa\xb7\xf7\xed\xf7\x9f\xbe\x1d\xf3\xb3\xbe\xff\xfb\x7c\x51\xf9\x58\x04\x36\xbf\x78\x6b\xd5\x78\x6b\x26\xa8\xbd\x5c\x1e\xfe\xbb\x04\x9e\x8f\xb9\x30\x97\xd5\xb2\x89\x6d\xe3\x99\x8a\x1a\xfe\x0c\x5e\x54\xfa\xab\xe1\x99\x62\xcf\xd7\xd0\x19\xb5\x27\x0a\x85\x00\xf9\x8a\xc2\x13\x76\x10\x20\x7b\xdc\xf8\xa0\x69\xc0\x56\x02\xe4\x80\x27\x04\xc8\xbf\xff\xf4\x8d\x00\x39\xa1\x00\x4b\xb9\xe4\xc8\x61\xe8\xff\x7a\x08\x3a\xa8\x28\x60\xd2\xe3\x2d\xb8\x07\x06\xfa\x4d\x6f\x6b\x9d\x03\x17\xfd\x26\xd7\x3a\xe6\x84\x95\x87\x93\x90\xdb\x8d\x0e\x0c\x25\x40\xbe\xe2\x67\xf0\xe7\xc0\xd5\x5b\xb5\xdf\xaf\x89\xbc\xa3\x4f\x2f\x89\x0a\x63\xcd\xb1\x57\x8a\xbb\x01\xe3\xaa\xc4\x17\xde\x40\x87\xdf\x82\x2c\x99\x58\x3f\x4a\x14\x45\x70\xc8\x97\x6b\xc0\x8f\x97\x83\x5d\x2a\xdc\xdb\xb2\x02\x44\xd1\xfc\x48\x58\xbc\xfa\xa3\xb4\xdc\x69\x1c\x88\x8b\x57\x19\xc8\x8b\xf7\xed\xf7\x9f\xbe\x79\x1f\xf7\x85\x25\x6c\xfe\x25\x69\x09\xda\x7e\x2c\x2e\x41\x9b\x0f\xe5\xc5\x6b\xf2\xb1\xac\x78\x2d\x3e\x11\x96\xbf\x49\x56\x42\x92\x4e\x84\xe5\x9f\x90\x95\x60\x94\x3f\x21\x2c\xa7\xdf\x6f\xa9\xd4\x83\xf3\x72\xaa\x55\x3f\x76\x79\xa8\x70\xe6\xcf\x1d\x8d\xd0\x78\x7f\x79\xa5\xd8\x6b\x01\x10\x30\x22\x2a\xb2\xe1\x2f\x1f\x49\xf2\x21\x9c\xe7\x4b\xde\xc1\x38\xf9\xe9\xdb\x61\x98\xfb\x3a\xfc\xd8\xf1\x9e\x1a\x3f\x36\xb8\xa3\xc9\xa3\x21\xc1\x57\x02\x73\x90\xa6\xf7\x8c\xef\xbb\x0a\x9d\x8a\xdd\xe1\xc8\xff\x50\xdc\xe3\x87\xda\xde\x9f\x8a\xc3\xce\x76\x06\xe2\x9a\x91\x1f\xca\x4d\x20\x35\x37\x36\xbe\x40\x84\x8e\x5c\xb8\x94\x98\x4b\x6b\xf3\x5c\x66\xae\x6d\xba\x5f\x11\x74\x29\x47\xb5\x12\xde\x1e\x3f\x82\xe4\xe1\x68\xe4\x85\x0a\xe0\x89\xba\x6c\xe1\xe3\xfd\x78\xed\xf4\x1e\xcd\x08\x1d\xdb\xc8\xb7\x22\x8e\x71\x8a\x33\xc3\xc1\x17\xcd\x9f\x10\xdc\x92\xb1\x2a\xac\x1f\x1e\x2e\x1c\x49\x8a\xfa\xe9\x21\xfa\x63\x70\x1e\x1e\x7d\x4c\x28\xaa\x08\x1f\xce\xa8\xf2\xaa\x6f\x04\x91\xa2\x8f\xfe\x9b\xbb\xce\xdb\x1e\x42\x20\x9e\xf5\x42\xbd\x06\x43\x9f\x5a\x34\xb7\xda\x5e\x09\x9e\xcf\x89\xe7\x23\x9c\x5f\x99\xdf\xce\x05\xc7\x67\xc8\x49\x3d\xfb\xdb\x1d\x3b\xda\x37\x7b\x0e\x6f\x33\x7b\x7d\x27\xe4\x10\x86\x8a\x3e\x9e\x89\x93\x6f\x5f\x05\x37\x32\xa8\xd7\xe3\x34\x74\x83\x92\x87\x63\xef\xe8\xa3\x87\x91\x3f\xfc\xd3\x05\xe6\x1a\xd8\x61\x9b\x3c\x5f\x2f\x24\xdd\x30\xb1\x03\xc5\x76\x58\xef\x5f\x5e\x38\x27\xea\xfb\xd3\x2d\x1e\x5c\x02\xb2\x14\x60\x78\x76\xac\x88\x49\xf4\xc3\xfe\x21\x8f\xae\x95\x89\xff\x02\xb9\x6f\x87\x17\xe8\x7a\x96\x01\x8e\x5e\x76\xa6\x28\x4b\xc7\x98\x28\x5f\x41\xd4\x50\x76\x96\x2a\xdc\x18\x0a\x22\x3f\x6a\x7b\x13\x86\xbf\x70\x05\x58\x20\x1a\xb0\x92\x45\x60\x9d\x9b\xc0\x47\x24\x0c\x53\x45\x72\xdb\x57\x05\xcf\x54\x92\x63\xae\xf7\xa4\xa0\x49\x09\x23\x8b\x00\x44\x9e\x29\x26\xc1\xe6\x2e\x97\xe8\x65\x2f\x1d\x6c\xa7\x50\xc3\x82\x4a\x76\xcf\x14\x9b\xca\x5c\xd1\x8e\x35\x07\x9a\xcf\x54\xf4\x12\xc7\x2b\xfd\x45\x54\x1d\x5a\x04\x1a\xde\xb8\x5c\xfa\x0a\x0e\x01\xbc\xaa\xa9\xfb\xf0\x3d\xc4\xd7\xf4\x1d\x39\x44\x4c\xfb\x8a\x41\x14\xe5\xf9\x22\x7e\x5f\xeb\x99\x4a\xa6\x99\x1b\xd4\xdb\x86\x08\x08\x6c\x84\x77\x62\xbc\x56\x1f\xd3\x7e\xf1\xe8\x6b\xe8\x1b\x33\x17\x58\xdf\xb7\x30\x0e\xc5\x27\xfa\x63\x32\x07\xb2\xa9\x74\xf4\x33\x56\xfb\x66\xe7\x87\x80\x18\x26\xcb\x4b\xd2\xe7\x80\x7c\x9b\xe4\x43\x48\x6c\x16\x24\xf9\xdc\xe7\x90\x4e\xf6\xa3\x0f\xe1\x49\x92\xc0\x32\xd9\x2b\x78\xf7\xd5\xfd\xb9\x32\x09\x15\x49\x02\xa3\x87\xe8\x99\x24\x1c\x95\xcf\x93\xb7\x73\x99\x40\xb7\xae\x14\x72\xa8\xb9\xa0\x29\x40\x44\xbc\xcd\xed\xf5\xd0\x34\xf1\x2e\x14\x14\x4d\x85\x65\x04\x13\xa0\x3d\x52\xff\x43\xb1\x0c\x73\xbe\x1d\x1d\x94\x5f\x02\x10\x62\x3e\x44\xcf\x22\xec\xd1\x27\xea\x0a\xe6\x63\x42\xb0\xac\x87\xa8\x7f\xd1\x3b\xfa\x44\xfd\xfb\xa7\x6f\xef\x48\x7c\xff\xf9\xdf\x67\xaa\xfe\x2e\xbd\x02\xbc\xa0\xb8\x71\x84\x5f\xc6\xc8\x73\xcc\xaf\xb7\xa0\x4f\x51\xf5\x16\xc0\x05\x76\x51\x96\x61\x7e\x8e\x5e\x6c\xc0\xf7\x37\xab\xeb\x8d\xed\x0e\x05\x07\xdc\xe1\x83\x3f\xe8\x49\xd4\xe5\x3d\x72\xfb\x1e\x34\xb0\x88\x89\x77\x7f\xd7\xe6\x7b\xb9\xa1\x7e\xbf\x88\x15\xdf\x8b\x7a\x74\x31\xa9\x62\x1b\x89\x77\x03\x1f\x91\x17\x85\x7d\xeb\x61\x6c\x58\x09\xaa\x8c\x51\x94\x50\x6b\x84\x5d\xca\x55\xa0\x09\x29\xa2\x00\x42\xa9\x56\xe2\x85\x56\xd8\xb7\xc8\x87\x03\x9d\x9d\x0a\x7f\x10\xff\xbc\xbc\x10\xf8\xa7\xa3\x2c\x9e\x09\x3a\x22\x9e\x92\xbf\x3a\x12\x39\x8b\xbc\x7c\x1e\xc0\x3c\x5c\x75\xbb\x8a\x60\x86\xb1\x36\x41\xb1\xd1\xfa\xe1\x3d\x3a\xf2\x44\x71\x7f\x38\xe2\x76\x4c\x38\xba\xc3\x9a\xcb\x1b\x48\x7f\x29\xf8\xf4\x4c\xf5\xf8\x15\x14\xc8\x95\x39\x08\x89\x82\xc5\xb3\xe6\x37\x93\x3b\xaf\x62\x4b\x41\x8e\x67\x09\x8b\x9e\xc2\xf1\x8f\xba\x1a\x88\x3c\xd0\xff\xcf\xc3\xff\x8a\xb1\xc7\xff\xb5\xe8\x04\xdc\x42\xe1\x9d\x43\x61\x4e\xa8\x67\x0d\x9d\x2d\x2b\xcf\xbf\x39\x01\xf5\x46\xa5\xf2\xf9\x4b\x6b\x3c\xe4\x7a\x98\xdd\x29\x02\x24\x43\x33\x7a\xb6\x36\x03\xd7\xf1\x0a\x16\xf7\x19\x2c\x17\x98\x48\x45\xf2\x97\x80\x25\x3f\x03\xa6\x22\x09\x7f\x09\x12\xfb\x19\x24\xcb\x16\x04\x4f\xe9\xdf\x00\xf6\x61\xb7\x43\x3e\xe8\x79\xc7\x77\xb1\x7c\x5f\x15\xe7\x17\xbd\x1e\xa0\x03\xd1\x45\x08\xfd\xa7\xa0\x30\x11\xe4\x8a\x06\xda\xf4\x1b\x15\x3d\xbe\xc7\x3e\xea\x79\x6b\x02\xd0\xe0\x43\xf2\x31\x7a\xe6\xda\x9c\x0c\x73\x79\xa3\xec\xaf\x0d\xc4\xde\x1f\xe8\xc6\xc5\xb4\x5b\x63\xf9\x7e\xf8\xf1\x1d\xd6\xaf\xd7\x63\x6b\xd8\x82\x16\x79\x88\xde\xff\x85\x81\xe8\x85\xbb\xf3\x31\xf2\xf1\xe0\xce\x74\xf4\x99\x7a\x08\x5b\x7a\x80\xe7\x54\xfc\x1d\x8d\x04\x96\x24\x0b\x92\x87\xc7\x84\x06\x25\xf2\x48\xd1\x27\x55\xfe\xee\xf5\xf0\x18\x6e\xd7\x54\x8c\x8a\xfe\xec\xe7\x5f\x9f\x02\x5b\xdc\x06\x46\xb0\x71\x0e\x2b\x78\x51\xcb\x39\xb0\xbb\xfc\xbc\x71\xa7\xee\x16\x3f\x43\x2c\x4c\xff\xb3\x0c\x25\x60\x6b\xe4\xda\xc7\xd3\xbd\xee\x07\x2d\xe6\x73\x3d\x72\xf9\xd6\xe5\xc8\x59\xa7\xb3\x0e\x09\x49\x45\xe2\x43\x34\xe1\x17\x06\xf9\xef\xd1\x47\x3f\x88\x79\xa2\x5d\x6c\x53\xfb\x1c\xc2\xc9\x74\x6a\x2a\x5a\x47\x1f\x43\xf3\x41\x31\xa1\x14\x7d\xa2\x4e\x74\xd5\xd9\xf5\xc4\xcf\x01\x5f\x08\xcb\x11\xb0\x65\x0a\x1f\xc1\x3d\x58\x2f\x1a\x39\x6b\xf5\x31\x2d\xfe\xd3\x43\xd4\xdb\xfc\xa3\xf7\xe7\xee\x34\xa7\xfc\xef\x9d\x38\xf1\x34\x5b\xfd\xaa\x87\xe9\x9f\x2a\x1c\x36\x3a\x55\x83\x0f\xd1\xaf\xe4\xc5\x7e\x9c\x12\x7b\xbe\xe4\x3c\x57\x7b\x6a\xc3\x8b\xb0\x8c\x7f\xa7\xf3\xca\x42\x0f\xe1\x3c\x9f\x70\x37\x2c\xfa\xc8\xd5\x31\x21\xf2\xdf\x3c\x6f\x42\x2b\x11\x7c\x3f\xaf\xf7\x94\xb9\x2a\x0c\xfd\x9a\xaa\xe7\x70\x79\x0d\x2f\x0a\xcf\x2c\xc7\xc4\x4f\x7e\xd4\xe5\x21\x7a\xc6\xbd\x5b\xbf\x0a\x10\xfd\xbf\x58\x07\x8e\x6a\xa9\x24\x48\xa3\x0e\x52\x98\xee\xaf\x84\x2f\xc2\x83\x6e\xdc\x04\xee\x91\x94\xcf\xa0\x86\xed\xbe\xb6\xb8\x8e\xd0\x4d\x68\x19\x18\x59\x9f\x23\xcd\x63\x71\xf7\x09\xec\x7b\xab\xe8\xeb\x86\xdb\xf9\xb4\xdd\x37\x6e\x6f\x5d\x7f\xf8\xd3\x96\xdc\x51\x9e\x6f\x9e\x10\xde\xb0\xe5\x6e\x5f\x21\x38\x5b\x27\x9e\x79\x12\xa6\xfc\xab\x48\x30\x21\xb0\xa0\x35\x82\x82\xed\x39\xbd\xf7\xcc\x94\xf0\x2a\xc6\x7d\x33\xe5\x04\xa8\x08\xff\x10\xd0\x9b\x26\xd9\xb5\x09\x1e\x8d\xfe\xd1\x59\x3b\xc9\x2e\xfc\xf4\x70\xfb\x1f\x31\xb9\x43\xec\x02\xe4\x04\x8c\x2c\x72\x48\x3a\xa2\x5e\xa9\x6f\xdf\x12\xdf\xc3\x43\x91\xa0\x2a\x0c\x76\xfe\x9e\x80\x5b\x02\x91\xf8\x70\x33\x9b\xec\x89\xfa\x46\x09\xb6\x69\x42\x44\xfc\x37\x03\x3d\x53\xae\x8a\x44\xec\x26\x34\x2c\xf8\x4e\xb4\x7f\xfc\x78\xdc\xe4\x03\xc8\xc1\x6b\x70\xc2\xa0\xe5\xd4\x86\xc1\x3b\x85\x8e\xfa\xd4\xaf\xf6\xc8\x3c\x12\xf3\x8d\x32\x00\x51\x9e\xa9\x28\x1d\x7d\xf2\x2c\x2d\x60\x79\xdf\x8d\xeb\xf7\xb3\x47\x9f\xa8\x23\xc3\x9f\xbf\x96\x24\xf4\xf8\x74\x64\xde\xdd\xe3\xf0\x0f\x52\x9e\xa8\xef\xa7\xca\xfb\x1d\x51\xe3\xec\x45\xef\x5f\xc1\xeb\x3d\x51\xe7\x12\xa5\x53\x0c\x3e\x19\x30\x90\xa0\x0f\x87\xbb\xcc\xb3\xf8\x0b\xa3\x05\x01\xe6\x8f\x06\x7b\x4f\x70\xf8\x70\x98\xa7\xbf\x9f\xf5\x7e\x62\xe2\xc7\x8c\xf0\x5a\xfc\x43\xb8\x3d\x1d\xf2\x24\xfd\x36\xfe\xf7\x3b\xe8\xfe\xcf\x87\x38\x9e\x85\x4a\x1e\x8f\x8a\xe6\xb7\xb3\xa5\xec\x00\x93\x02\x86\xf1\xbe\xa0\x8e\x4b\xc9\x3f\xf2\xfa\x11\x18\x46\xf4\x34\x01\x26\xc0\xea\x8b\x9a\x25\x58\xac\xcf\xe1\xe7\x51\xc1\x5d\xe6\xa5\x9e\x64\xd5\xfa\x5b\x1b\x25\x01\x11\x46\x28\x02\x78\xff\xb5\x3c\xaf\x91\x38\x7b\x48\xa3\x15\x55\xa0\x61\xf9\xd6\x3b\x59\x82\x34\xf6\x0b\xc3\xfc\x3a\x1b\x39\x30\x40\x02\x30\xc1\xb6\x1a\xdf\x6a\x37\x73\x92\x83\xca\xf0\x17\x26\xef\x5c\xd4\x0a\xda\x04\x7b\xc5\x79\xa6\xf0\xfb\xcd\xd7\x13\x93\x27\x72\x71\xc5\xf5\x3d\x2b\xfc\xfc\x87\x4c\x8e\x57\xe8\xf0\xf1\xf7\x4b\x44\xd5\xd2\xd5\x23\xb8\xf3\x9f\x20\x29\xf9\xed\x6e\xbd\x8d\xe6\xc6\xab\x6b\xfe\xdb\x0f\xe5\xff\x72\xeb\x9d\x34\xa7\x29\xe1\xd4\xc7\x37\xc8\x02\xa2\x2e\x2e\x0f\x9f\x5c\x2d\xbd\x7b\x15\xf6\xc2\x8d\x09\x7e\x29\xe0\xce\xdb\x60\x22\xc1\x1b\x4f\x22\xc1\x3b\x3c\x23\x14\xfd\xf6\xe1\x7b\x73\xae\xd0\xbb\xba\xf9\xfa\x09\xbf\x0f\x09\xf5\xc7\x50\xc4\x6d\xde\xbf\xf9\xfc\xfe\x84\x5d\xb7\xb3\xb1\x0f\xaf\x78\xfa\x1b\x45\xfe\xcc\xa5\xf9\xff\xe5\xfd\xff\x58\xde\x15\xee\x6d\x18\x5a\xf6\x54\x68\x2c\x3f\x9f\x5f\x2a\xb8\xbc\x28\x7c\x6d\x7f\x7b\x5c\xba\x7f\x7d\xf2\xeb\xa2\xfd\xe9\xda\xbb\xbc\x30\x72\xe5\x4a\xdd\xb9\x4c\xfd\x67\xa1\xdf\x74\xac\xc2\x4b\xe2\x43\xe0\x1e\x18\xf6\xf7\x8d\x74\xe1\x64\x9d\x0c\x75\x98\xa4\xcb\xb1\xfe\x03\xd4\xc1\x0b\x1d\x5c\x44\xfe\xe1\x85\x0e\x7e\x3b\xfa\xff\x0d\x00\x00\xff\xff\x2c\xca\xe7\xea\x4c\x7a\x00\x00")
+var _staticFavicon_fingerprintsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x84\x93\x4f\x6f\x9b\x30\x18\x87\xef\x7c\x0a\x8b\x73\x71\x82\xff\x80\x9d\x5b\x3a\xa9\x51\xa7\x4d\xda\xd4\x9d\x56\xf5\x60\x53\x03\xee\x82\xed\x61\x33\xd6\x4d\xfb\xee\x13\x24\xd1\xa6\x12\xe0\x86\xcc\xf3\xf3\xf3\xbe\xaf\xed\xc7\x08\x80\xdf\x11\x00\x00\xc4\x46\x34\x2a\xde\x81\xf8\xbd\x32\xdf\xb4\xf1\xf1\xcd\x69\xb9\x57\xd2\xeb\x30\xfe\xa9\x43\x70\x7e\xb7\xd9\xbc\x9c\x08\xa8\xed\x05\x6a\x9a\x1a\xc7\x3b\xf0\xc8\x52\xca\x32\x9c\xa2\xa7\x08\x80\x3f\x37\xd3\xbd\x1f\x5c\xab\x4d\x05\x6e\xad\x0d\x0b\xfb\xfb\x91\x82\xda\x6e\x5c\x6b\x5f\x54\x11\xfc\x79\x29\x91\xff\x05\x2f\xce\x34\xcd\x30\xc2\x0c\xa5\x73\xd2\x83\x0e\x1f\x84\x5c\xf0\x09\x69\xbb\x00\x2b\x1d\x8e\x42\xc2\xc2\x36\x13\x03\xca\x19\x46\x38\x63\xb3\x8a\x07\x6b\x44\xfb\xb9\x93\x6a\xc1\xd2\xf7\x3d\xf4\x03\xf7\xbd\x93\x0a\xda\xb6\x9a\x68\x08\xa3\x88\xe6\x19\x25\x73\x9a\x3b\x0a\x6e\xef\x0f\xc9\xfd\xa7\x15\x4d\x49\x87\x36\x86\xe9\x3d\x77\xc3\xf4\xa4\xae\x12\xed\x12\xaf\xda\x1f\xba\x50\xfe\xad\x38\xc1\x98\x22\x82\x28\xe6\x73\xe2\x7d\x38\x0a\xef\xb5\x30\xe0\x9d\x35\xe5\xb1\x53\xa6\x58\x6b\x55\x5c\x22\x63\x29\xde\x96\xa1\x17\xad\xda\x14\x93\xfc\xbf\x2a\xb6\x34\xcd\xf9\xc2\xed\xb9\xb3\x6d\xd0\x46\x05\x30\x7e\x1c\x44\x58\xab\xa1\x3c\x07\xae\x1d\x2a\x27\x94\x6c\x19\xcd\x67\x6d\x7b\x27\x8a\x5a\x81\x2f\xb6\x29\xc4\xd2\x6d\x0d\x23\x00\xc5\x88\x5f\x3b\xd7\x04\xf1\x7c\x9b\x71\xc2\xf1\x9c\xca\xd5\xee\xe3\xeb\xfe\xb9\xd1\x66\xa5\x21\x57\xbb\xe6\x55\x0c\x20\x34\x6a\xf2\x12\x12\x92\x67\x08\xa7\x7c\x9b\xcd\x89\xbe\x0a\x29\xf5\xcf\x15\xc9\xaf\x11\xba\x36\x33\xc6\x11\x25\x88\xd3\xd3\x3b\x88\x9e\xa2\xbf\x03\x00\x33\x4a\x82\xd1\x3f\x04\x00\x00")
+
+func staticFavicon_fingerprintsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_staticFavicon_fingerprintsJson,
+		"static/favicon_fingerprints.json",
+	)
+}
+
+func staticFavicon_fingerprintsJson() (*asset, error) {
+	bytes, err := staticFavicon_fingerprintsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "static/favicon_fingerprints.json", size: 1087, mode: os.FileMode(420), modTime: time.Unix(1792323476, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _staticReport_templateHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xbd\x67\x7b\xe3\x38\xb2\x30\xfa\xbd\x7f\x05\x8e\x66\x66\x65\x1f\x59\xa2\x24\x2a\xba\x6d\xdf\xa3\x2c\x2b\xe7\x34\x67\xee\x2c\x03\x18\x24\x26\x91\xa0\x52\xbf\xfe\xef\xf7\x01\x83\x44\x52\x94\xed\x0e\xbb\x77\x3f\xbc\x3d\xd3\x2d\x12\xa1\x50\x55\x28\x14\x50\x85\x02\xf8\xf4\x5f\xac\xca\xa0\xa3\x06\x81\x80\x64\xe9\xe5\xcb\x13\xfe\x01\x12\xa5\xf0\xcf\x11\xa8\x44\x5e\xbe\x7c\x79\x12\x20\xc5\xbe\x7c\x01\xe0\x49\x86\x88\x02\x8c\x40\xe9\x06\x44\xcf\x11\x13\x71\xf1\x42\xe4\x92\xa1\x50\x32\x7c\x8e\xec\x44\xb8\xd7\x54\x1d\x45\x00\xa3\x2a\x08\x2a\xe8\x39\xb2\x17\x59\x24\x3c\xb3\x70\x27\x32\x30\x6e\xbd\x3c\x00\x51\x11\x91\x48\x49\x71\x83\xa1\x24\xf8\x9c\x7a\x00\x86\xa0\x8b\xca\x26\x8e\xd4\x38\x27\xa2\x67\x45\xbd\x02\xcc\x42\x83\xd1\x45\x0d\x89\xaa\xe2\x81\x5d\xda\x9a\x14\x52\x15\x08\x46\xd0\x6a\x35\x58\x8b\x32\x91\xa0\xea\x9e\x0a\x5d\x91\x11\x28\x28\x81\x26\x54\x74\x71\x63\x40\x05\xdc\x09\x08\x69\xc6\x23\x41\xa0\xbd\x88\xa0\x9e\x60\x54\x99\x90\x45\x46\x70\x0b\xdc\x5f\x01\xe5\xa1\x02\x75\x0a\xa9\x7a\x18\x22\xbb\x6f\xdf\x12\x33\xa8\x1b\xa2\xaa\xbc\xbd\x5d\x55\xd5\x55\x5a\x45\x86\xa7\x9e\xa2\x8a\x0a\x0b\x0f\x0f\x40\x51\x39\x55\x92\xd4\xbd\x5d\x05\x89\x48\x82\x2f\x01\xea\x9e\x08\x3b\xf9\x0b\x00\xdf\xbe\x51\x86\x01\x11\x88\xd0\xaa\x8a\x0c\xa4\x53\x5a\x42\x16\x95\x04\x63\x18\x91\xb7\x37\x6f\xfe\x4e\x34\xfc\x39\x4f\x06\x3a\x4a\x10\xe0\x2e\x7f\x8e\x20\x78\x40\x04\xce\xc2\x30\x01\xe0\x54\x15\x41\x1d\x7c\xb3\x5e\x00\xa0\x55\x9d\x85\x7a\x1c\xa9\xda\x23\x48\x69\x07\x60\xa8\x92\xc8\x02\x9d\xa7\xa9\xbb\xe4\x03\xb0\xff\x4f\xa4\xd2\xd9\xfb\xaf\x4e\x05\x99\xd2\x79\x51\xb1\x2b\x64\x93\xda\xc1\x4d\xd7\x28\x96\x15\x15\xde\x9f\x88\xdb\x8e\x53\x92\xc8\x2b\x8f\x80\x81\x0a\x82\xba\x9b\xc3\xa9\x0a\x8a\x1b\xe2\x09\x3e\x82\x54\xfa\x52\x81\x51\x25\x55\x7f\xc4\xed\xdf\xe5\x0a\x0f\xc0\xfe\xeb\xb4\xfd\xf6\xc5\x4b\x00\x05\xbe\xf9\xeb\x88\x8a\x00\x75\x11\x81\xff\x12\x65\xcc\x48\x4a\x41\x3e\x2c\x58\xc8\xa8\x3a\x85\x45\xeb\x11\x98\x0a\x0b\x75\x49\x54\xa0\x0f\x70\x82\xa1\x74\xd5\x34\xa0\x04\xbe\xf9\x69\xa5\x55\x84\x54\xd9\x4b\x59\xb0\x46\x5c\x44\x50\x0e\x22\xf4\x1b\x59\x20\xd9\x4c\xea\x23\x5e\x84\xc3\x4a\x68\x14\x0f\xe3\x0c\xa5\xb3\x67\xb0\xd6\xb0\x7a\x04\x64\xf2\x06\x83\x25\xc8\x21\x7f\x2f\x3d\x82\x74\x56\x3b\x80\x54\x52\x3b\x80\xac\xfb\xe4\x16\x61\x45\x43\x93\xa8\x23\x66\x1c\x66\x45\x9c\x96\x54\x66\xe3\x47\xc9\x10\x15\x5e\x82\x71\x1b\x15\x55\x41\x94\xa8\x40\xdd\x83\xda\xc3\xc7\xc5\xb0\x62\x81\xba\x11\x47\x14\x2d\xc1\x00\x63\x1f\x01\x46\xcc\x42\xce\x79\xf0\x37\x6f\x01\x30\x18\x1d\x42\xc5\x10\x54\xe4\x81\xed\xc2\xd1\x54\x43\xb4\xbb\x54\x87\x12\x85\xc4\x1d\x74\xa9\x53\x77\x50\xe7\x24\x75\xff\x08\x04\x91\x65\xa1\xf2\xd5\x2f\xef\x6e\x97\x7e\x42\xe4\x7d\xd8\x58\x1d\xf2\x09\xc4\x64\xea\x10\x17\xa0\xc8\x0b\xe8\x11\x64\xb2\xe1\x94\xb9\x6a\xd4\xb8\x25\x6f\xa9\x8f\x38\x72\xae\x88\x74\x4a\x71\x39\x61\x3d\x73\xaa\x2e\x83\x44\xd6\x00\x90\x32\x60\x5c\x35\xcf\x82\xc1\x98\xba\x81\x85\xf3\xa4\xaa\x72\x5c\x54\xbe\xfa\x65\x2b\x95\x4c\xfe\x71\x43\x2a\x31\x8d\xba\x2a\xc5\x35\x1d\xee\x1e\x6e\xe4\x29\xf0\x80\x82\xe2\x9a\xfd\x0c\xc0\xb8\xc8\xa8\xca\x45\x27\x51\xcc\x86\xd7\x55\x53\x61\xe3\xa2\x4c\xf1\xf0\x11\x98\xba\x74\x17\x61\x29\x44\x3d\x5a\x09\x84\xb1\xe3\x63\x07\x59\x7a\xf8\x83\x64\x8c\x1d\x0f\x0e\xb2\xa4\x18\xcf\x51\xac\xe4\x1f\x09\x62\xbf\xdf\x27\xf6\x64\x42\xd5\x79\x22\x9d\x4c\x26\x71\xe1\x28\xe0\x44\x49\x7a\x8e\xfe\x91\x26\x73\x4c\x3e\x9b\x67\xa3\x00\x73\xbf\xac\x1e\x9e\xa3\x49\x90\x04\x05\x50\x88\xfe\x41\xc2\x3f\x48\x46\xa3\x90\x00\xd8\xe7\x68\x37\x9b\x48\x67\x41\x52\x8a\x67\x80\xfd\x5f\x2a\x91\x8d\xe3\xbf\x69\xfb\x2f\x70\x7e\xe3\x4e\xfa\x29\x4a\xd8\x00\x70\x73\x7f\x90\x30\x72\xff\x01\xd9\x98\x57\xff\x81\x64\xa7\x13\x79\x8b\xec\x54\x22\x0b\x52\x36\x99\xc0\x43\x32\x70\xd3\x33\x71\xeb\xbf\x4f\x93\x2d\x2a\xac\xc8\xe0\xf9\xd4\x00\x92\x18\x46\xb2\xab\x34\x6d\x44\xfd\x50\x68\x8a\xe5\x83\xca\x23\xae\xdb\xa3\x2b\x1b\x3a\x46\xc2\xd5\xce\x4d\x29\xb7\xea\x20\xe9\x47\xcb\x27\xf0\x23\x9e\xfc\x83\x35\xd3\xd9\xb0\x8a\x3a\x64\x45\x1d\x32\xe8\xc3\xe6\xae\x34\x02\x19\xae\x11\x18\x55\x31\x54\x09\xda\xe0\x1e\x3c\x19\x1c\x25\x4a\x90\x8d\xeb\x70\x6b\x42\xe3\xd7\xb5\xe7\xe7\x2e\xba\x4c\x51\xd6\xac\xce\x51\xb2\x28\x1d\x1f\x41\x49\x51\x95\xa3\xac\x9a\x06\x18\xe8\xea\x03\xa8\x58\x48\x52\xc6\x03\xe8\x42\x45\x52\x1f\x40\x57\x55\x28\x46\x7d\x00\x1d\x93\x11\x59\xca\xc9\x87\x0f\xa0\x23\xd2\xd0\x9e\xa9\x71\x11\xf5\x01\x54\xe1\x9a\x9a\x99\x60\x4c\x29\x86\x93\x52\x16\xf1\x82\x08\x52\x32\x98\x41\x9d\xf2\xe6\x54\x54\x53\x17\xa1\x0e\x7a\x70\xff\x00\x64\x55\x51\x0d\x8d\x62\xe0\x03\x30\xa0\x2e\x72\x9f\x20\x25\x61\x27\xc4\x77\x94\x64\x7a\x38\xa5\xea\x6c\x9c\xd6\x21\xb5\x79\x04\xd6\x4f\x9c\x92\xa4\xcf\xcc\x95\xdf\x7e\x78\xda\xf9\xc4\xea\x83\xd7\x29\x4d\xf8\xae\x59\x31\xa4\xc7\xdd\x59\x2a\xef\x5d\x56\x78\x17\x79\x69\x4f\xba\x4d\xc6\x77\x4d\x9b\x16\x92\x21\xa8\x51\xb4\xa1\x4a\x26\x3a\xa3\x66\xb5\x95\x74\xdf\xf0\x5a\xc6\xf3\xfa\x0e\xde\xd7\x83\xd3\x66\x8b\xa4\x52\x78\x3d\x1a\xc7\x0b\x01\x89\x3a\xfe\x5b\x30\x00\xe0\x14\xb7\x96\xfa\x8f\xa0\x58\x2c\x16\xbf\xde\xd6\x72\x9c\xf5\xe7\xe3\x65\xb2\xb3\xaa\x76\x7a\x22\xfb\x29\x4a\x13\x9a\xae\xf2\x3a\x34\xae\xd6\x15\x36\x49\x94\x89\xd4\xaf\xa1\xaa\xd4\x9b\xe3\xce\xde\xd7\xe4\x92\x57\x1a\xd7\x10\xd4\x7d\x5c\x56\x75\x18\xa7\x4d\x84\x3c\x33\xda\x2d\x5b\xe1\x23\xc9\xfe\xed\xb2\xc4\xe9\xaa\x2c\x25\xdd\x5e\xf8\x84\x74\x8b\xbb\xc2\xd1\x54\xd1\xbb\xc8\x06\xe0\x89\xb0\xcc\xa2\x97\x2f\x4f\x84\x6d\xee\x7e\x79\xa2\x55\xf6\x68\xd9\x62\x0a\xb5\x03\x8c\x44\x19\xc6\x73\x44\xa1\x76\x34\xa5\x03\xfb\x27\x0e\x0f\x1a\xa5\xb0\x71\x99\x75\x13\x58\x4a\xdf\x00\x9a\xb7\x7e\x1d\x93\xea\x89\xf2\xd7\x8d\xd3\x3a\xa5\xb0\x11\x20\xe8\x90\x7b\x8e\xfc\x16\x79\x29\x0d\xa7\xa5\x49\xbf\x57\x7b\x22\x28\xa7\x86\xc3\x28\x7f\x35\xa4\xf2\xbc\x04\xf5\x88\x63\xb8\xd9\x65\x22\x00\x2f\x00\x9c\xbc\xe7\x08\xa3\x4a\x12\xa5\x19\xd0\x4d\xa6\x74\x1e\x1b\xea\xbf\xd9\x20\xba\x50\x31\x23\x0e\x1f\x28\x5d\xa4\xdc\xd5\x86\xe1\x2f\x61\xe7\xd9\xa4\x41\xf6\x39\xc2\x51\x92\x01\x9d\x54\x89\xa2\xa1\xf4\x1c\x99\x58\xed\x61\xa2\x45\xde\xd2\xc5\x0e\xad\x00\x3c\x19\x1a\x75\x03\x73\x6b\x3d\x13\x79\x79\x22\x70\x11\x87\x52\xc2\x26\xe3\xc5\xee\xd9\x27\x56\x3c\x33\xda\x25\xc5\xe5\xec\x85\x34\x91\x7d\x8e\x78\xd0\x3d\xb7\x6c\x4a\x81\x76\x71\xb7\xc9\x7a\x1c\x0b\xee\xb9\x14\x00\x4f\x92\xe8\x29\x67\xdb\x53\xac\xae\x6a\xac\xba\x57\x3c\xc5\x02\x1d\x17\x97\x44\x65\x73\x2e\xe7\x90\x74\xe9\x44\x0b\x29\x2c\x86\x46\xd5\x05\x05\x74\x55\xba\xd5\x4f\xe7\xf6\x3c\xcd\x39\x7d\x22\x50\x86\xa6\x6a\xa6\xf6\x1c\x41\xba\x09\x6f\x74\xc6\x8b\xaf\xde\x00\xb7\xeb\x45\xdc\x15\x24\x00\x82\x5c\x3d\x13\x20\x5f\x7a\xda\xea\x53\x09\xb2\xf4\x31\x48\x82\xbf\x99\x27\xea\x0a\x0a\x66\xde\x99\x09\x84\x55\x99\xa0\x8f\x71\x43\x94\x45\x89\xd2\x45\x74\x8c\xbc\x94\x8f\x60\x7c\x7e\x0d\x60\xf6\x3d\x30\x05\xd5\x40\x86\x05\xae\x89\x9f\x7e\x14\x92\x3d\x11\x47\x5e\xc6\xd6\xaf\xcd\xba\x20\xbf\x08\x56\xdc\x79\xe4\x85\x90\xc4\x77\xa5\xe7\x03\xa1\x09\x62\x60\xa9\xe5\xc8\x4b\x03\xff\xf8\x5a\xf6\x36\xf4\x44\x98\xd2\xcb\x17\x1f\x36\x4f\x84\x42\xed\xac\x81\xf2\x24\x53\xa2\xe2\x88\x17\x7e\x8c\x5c\xc6\x8c\x33\xd9\xdb\xf2\x48\x69\x9a\xab\x83\x74\xd5\x44\x78\xdd\x22\xc2\xfd\xcb\x13\xe1\x7d\xb3\x20\x63\x28\x36\x68\xc7\x7f\x82\xab\xdb\x8f\x2e\x04\xcd\x6d\xc4\x9a\x8e\x64\x13\x41\xf6\xa2\xba\xfc\x3e\x2f\xf0\x0f\x59\x64\x59\x15\x7d\x05\x32\xc5\x42\xb0\x17\x91\x60\xeb\x85\x33\xa9\x96\xaa\xb5\xc6\xb8\xaa\x3f\xea\x90\xfd\x6a\x2d\x0d\xf7\xf6\x1c\x42\xab\x12\x1b\x79\xf9\xc7\x6f\xb9\x6c\x96\x24\xbf\x3a\xea\x02\xd0\x47\xcc\x5b\x9b\x95\xae\xbf\xce\xeb\xa4\xc3\x5e\xbb\x08\x70\x35\xde\xdf\xb4\x44\x29\x9b\xc8\x8b\xe3\xec\x3b\x37\x7c\x76\xfa\x61\xce\x3f\x11\x9a\x4b\xdc\xcb\x15\x6c\x6c\x30\xd1\xe6\x51\x86\x14\xa3\x72\x1c\x84\x57\x5e\xc1\xeb\xc6\x9e\x44\x99\xff\x72\x11\x05\x43\x67\x9e\xbd\xf6\x99\xa6\xf0\x5f\x69\xca\x80\xb9\xcc\x83\x38\x2b\xf7\x47\xfb\x64\xbb\xc1\xab\xa5\x52\xa9\xd4\x1b\x4f\x85\xda\x94\x2f\x95\x4a\x6d\xeb\x5d\xaa\x94\x96\xa5\x52\xa9\x3a\xde\x34\xdb\x03\x9c\xd0\x58\x8c\xea\xf3\xe6\x68\x42\xa7\x57\x49\x36\x5d\x3f\xae\x86\xe5\xf2\xaa\x51\x14\x57\xe3\x72\x8b\x9e\xd7\x95\xd5\xac\x25\x2d\xe7\xa3\x2c\xc3\x48\x12\xae\x50\xe9\x97\x5b\xa3\x5a\x7d\x0a\x7b\xba\xb1\xe8\x16\x07\xb3\x1a\xc3\x28\xa9\xe4\xac\xd5\x48\xcf\x0e\xd5\x09\x1a\x4f\xb8\x9a\xf6\xca\x36\xe6\x30\xdb\xc8\xb0\xed\x64\x8b\xa8\x71\xdb\x5e\x75\xd9\x8d\xb5\x53\x14\x53\x21\x4a\xb5\xe3\xae\xb5\xad\x34\x8b\xf2\x6b\x45\x41\x5a\x75\x53\x98\xed\x29\x45\xe3\xd7\xc9\x54\xb7\x94\x5b\xa6\x07\x4b\xf9\x55\x33\x8c\x76\x57\x23\x07\xfb\x3e\x77\x20\xe7\x4d\x98\x26\x60\xda\x2c\x20\x5d\x9e\x16\x8e\xf3\x05\x0d\x89\xc1\xba\xcf\xe6\xf3\x27\x62\x32\x1f\x74\xc6\xfc\x00\xf5\xa8\x75\x76\xdb\x37\x4a\x7c\xbb\x5f\x46\xb3\x8a\x4a\x97\xd4\xf6\x7e\xdb\xe7\x4b\x39\x7a\x7d\x92\x26\x63\xb5\xbe\x28\x4d\x61\xb7\x37\x1b\x34\xd6\x4c\xc9\xec\x0d\xc5\x6d\x8d\x6d\x1f\xb8\x71\xad\x57\xe9\xf2\x93\xd7\xf6\xe9\x54\xa6\xea\xad\x76\xa6\xa6\x94\x26\x4a\xbd\x52\x9a\xa5\x7a\xab\x75\x9e\xaf\x1e\xf3\x25\x66\x51\xdc\x57\x36\xaf\xd4\xb4\x02\xa7\x13\x7d\x75\x84\xeb\x58\x9a\xee\x29\x68\x3b\x29\x0b\x43\x63\x41\x97\x36\xaf\x85\x7e\x7d\xd3\xda\x43\x82\x85\xe6\x3c\x8d\xd6\xcb\xe9\x80\x2c\x12\x8c\x94\xe3\xe6\xa9\xde\x82\x46\xe9\x09\x9b\x26\x38\xdc\xef\xb9\xb4\xb4\x63\x88\xc9\x3e\xdd\x20\xd7\xeb\x7e\x37\xb7\x22\xe6\xcd\x69\x25\x35\x47\x73\x65\xa2\x91\xe3\x11\x2f\xd2\x68\x33\xa5\xe9\xe2\x0e\xcd\x28\x92\x68\x97\x8d\x81\x29\x11\x7a\x4c\x55\xfb\xfd\x4e\x56\x35\x93\x2b\x76\x2e\x69\xe3\x49\x36\x53\x98\x32\xbb\xce\xb1\x48\x4d\x07\xe4\x29\xd3\xad\x4f\x09\xaa\x97\xcc\xb3\xb1\x9c\x7a\xcc\x32\xbb\x79\x2c\x99\x1b\x34\xf6\xc9\xdc\xa0\x2b\x68\x8b\x25\x59\x14\x74\x3e\xbf\xaf\xb1\xbd\x9a\xb1\x27\x60\xb2\x2c\x34\x47\x31\x4e\xca\xf4\xaa\xa5\xa3\x5a\x88\x71\x83\x79\xa1\xde\xe3\x93\xe6\xa2\x23\x6d\xc8\xd2\x22\x59\x6e\xe7\x78\xee\x24\x2a\xa9\xa5\xd4\xd6\x94\xc9\x5c\x3a\x19\xe9\x1a\x39\xdc\x56\xd2\xe6\x72\xa8\xcf\x46\xe3\x59\xae\x08\x69\x4a\xd9\xe5\xcd\xbc\xb9\x5f\x71\xe4\x88\x2f\x24\x73\x3c\xbb\x36\xb8\x0c\x12\x85\x85\xc1\x77\x96\x15\xd1\xe8\x67\x98\x57\x36\x53\x21\xb3\x27\x85\xec\xee\xb6\x75\x44\xcf\xd3\x5a\x1e\xa6\x8c\x59\x85\x5f\xcc\x52\x45\xa8\x4c\xb4\x7d\x66\x09\x91\x80\xb6\xb5\xd9\x36\x5f\x30\xb7\xbb\x4e\x9d\xda\xa9\x65\xe2\xb4\x32\x87\x85\xe9\x7e\x49\xb1\x9b\x43\x86\x1f\xbe\xe6\xaa\xb5\xd8\x40\xcc\xa4\xd8\xed\x5a\xcd\xf5\xe7\x06\x33\xe9\xc9\x27\x6e\x96\xee\x09\xcb\x4d\x67\x45\xf0\x8c\xd2\x1a\xd3\xe6\x82\x21\x7b\xa7\x2a\xbd\x67\x1a\xc2\xf6\xb8\xab\x52\xe6\x32\x9f\xa9\xa3\x59\x6e\xb7\x4d\x6d\x91\xa6\xea\x75\x15\xcd\x4b\xfd\x93\x91\x9f\xce\xc7\x83\x64\x8a\x31\xa5\xd4\x22\x9b\x24\x33\xa9\xe2\x6c\xda\x18\x2e\xd2\xb1\x59\x71\x19\x6b\x18\xb9\x4d\x73\x2c\x33\x62\xc6\xec\x08\xe4\x41\x1a\x74\x50\x31\x46\x52\x43\xb3\xbc\x2a\x9f\xc6\x9b\x72\x75\x6c\xcc\x86\x3a\x3b\xa4\xdb\x8b\x49\x3a\xcf\xee\xf2\x10\xae\xba\x69\x76\x4a\xa7\x63\xbb\xc1\x4c\xd9\x91\x7a\xba\xa3\x6c\x7a\xc3\x14\x91\xef\xf6\xdb\xeb\xd1\xb6\xb7\x50\xd2\x4c\xb2\xd5\x28\xb1\xdd\x49\x32\xa6\x8f\xb7\x73\x71\x26\xb1\x0b\xb5\xd8\x23\xf2\xc5\x5c\xf1\xb5\x91\x42\xb5\xfa\x38\xdb\x3a\x4c\xc6\xb4\xa6\x17\x25\x7e\x9e\xd2\x72\x5c\x93\xd3\xb3\x31\x82\x55\xdb\x1d\x66\x4f\x4c\x26\x85\x7d\xbf\x2a\x66\x50\x41\x8c\x55\x9b\xf9\xb5\x26\x37\xbb\xa6\xac\x26\x63\x87\xcd\xbe\x37\x99\x49\xbd\x49\x6d\xd9\xaf\xd6\x0e\x49\xa6\x3a\xa5\xe5\x8c\xd1\xa3\x65\x9d\x5c\x90\x94\xc8\x10\x26\xa9\x27\xe9\xf2\xaa\xc1\x16\xaa\x3d\x65\x95\xe6\x50\xb3\xa6\x14\xf6\xd5\x2e\x59\x18\x2c\x46\x4a\x7f\xcc\x75\x85\x75\x63\x51\x1f\xf2\xe5\xca\x1e\xe6\x24\xb2\x23\x1d\xb6\x28\x5b\x6f\xf4\x4c\x96\xdd\x91\xfa\x69\x94\x8b\xed\xf4\xb4\x50\x51\xd6\x74\xb9\x71\x4a\xe5\x62\x5c\x5b\x52\x56\x32\xcd\xef\xfa\xeb\xb6\x9a\x6f\x9b\x5c\x9b\x18\x4b\xf3\xd8\x34\x3f\x1f\x14\x5e\x27\xa8\xd1\xd8\x96\xd8\x98\x20\xca\x3d\x76\x48\x33\x69\x42\x5f\xb3\xc5\xed\xee\x80\x7a\x54\x3e\xb6\x56\xd6\x65\x8a\x2c\x2e\x57\xd5\xf9\xa9\xb9\x5f\x30\xd3\x7a\xae\xac\x2c\xe7\xcd\x72\xff\x44\xe4\x96\x72\x6e\x7d\x9a\x27\xf3\xeb\x57\x56\x24\x2b\x95\xa2\xa1\xbf\x8e\x07\x73\xa6\x18\xeb\xb7\xfb\xa7\x39\xa3\x36\x2a\xac\xa6\xc3\x25\x3f\x92\xd3\x87\x9e\x3e\x69\x0e\x6a\x52\xd1\xac\xe5\x8f\x95\xc9\x70\x94\x79\x35\x37\xd5\xfd\x02\x1d\x17\xc4\xfc\xc8\x91\x25\xa5\xcd\x57\x3b\x53\xe9\xc4\x0f\x21\x73\x4c\x89\x19\x61\xad\x88\xb1\x96\x5c\x43\x22\x57\xd8\x4f\x84\xd6\xac\x62\x48\x3a\x55\x1e\x97\xba\x35\x9e\x28\x25\xe5\xb1\x4c\x09\x93\x75\x7b\xc1\xf3\x46\xc3\xe0\x49\x35\xcb\xd4\x8f\xe5\x59\xce\x6c\xcd\xa5\x18\xfd\xba\xcd\x97\xd5\xbd\x54\x5e\x9a\x75\x39\xc3\xa4\x0c\x21\x56\x3f\xb0\xa9\x42\x85\x2d\x2e\x99\x4d\x32\x36\xad\x95\x0b\x83\x4a\x13\xed\xf8\x56\xec\xd8\x67\xc6\xd9\xf6\xb4\x50\x2c\x95\xb3\x62\x75\x76\x58\x4c\xc4\x57\x46\x38\x9a\x35\x72\x24\x8d\xe8\x26\xab\xf1\x74\xac\x3d\x2f\xa5\xe7\x30\xc9\x09\xbd\x61\x7d\x20\xae\xba\x63\xbd\xab\xcf\xb2\x31\xae\xbf\x7e\x3d\x2e\x77\xa9\x29\xb5\x78\x85\x83\x26\x3f\x94\x67\xac\xdc\xea\x8f\xc8\x53\xa9\x97\xdb\x70\x46\x7d\x53\x95\x87\xea\x2b\xd1\xe9\xd1\x12\x9f\xac\xc1\x89\xb8\xcb\x2e\xcb\xc5\x55\xa9\xb7\x2f\x9f\x1a\xed\x46\xf7\xb0\xad\x6a\x42\x49\xaa\x0d\xf2\xc3\x54\x43\x5c\x1d\xb8\x49\x45\xd1\xca\x9b\x51\xbf\x29\x74\x5a\x1d\xa9\xdd\xeb\xf4\x1a\x62\xe7\xb4\xaa\xa1\x56\x37\x6d\x94\x88\xcc\xa0\xb9\x3e\xa4\x6a\x79\xf6\x48\xbc\x2e\xf2\x10\xee\xba\x2b\xa6\xda\xa8\x8e\x04\xb9\x2b\xd0\x7c\x15\xed\xf4\x0c\x5b\x48\x35\xe8\xd2\xc8\x58\x66\xb3\xdd\x54\x2d\xcf\x1b\x13\x7d\xcb\x94\xc8\x7e\x25\x39\x16\xf8\x7a\x4b\x2c\x57\x97\x2b\x62\x64\xae\x8e\xc3\xa3\xb8\x24\x6a\x19\x81\x6f\x14\x10\x31\x4e\x99\x6c\x4f\x35\xca\xa5\x59\x05\x89\x0c\xca\x9b\xd4\xb0\x2c\xef\xf9\xde\x69\x60\x0e\xbb\xeb\xde\x48\x6b\xc4\x56\xc2\x01\x15\x5b\xd3\x43\x87\x4c\x91\x04\x9f\x8a\xf1\x4d\x2e\x53\x35\x6b\x02\xcd\xc2\xdd\xe2\x54\x98\xf6\x3a\x9b\xe4\x81\x93\xb3\xd9\x6a\xb3\xa1\xe5\x63\xbd\xdd\xf6\xd4\x4c\x57\x4f\x99\x8d\x51\x60\x8b\xb3\x06\x5d\xa2\xd4\xe2\x91\x8d\xb5\x4b\x85\x7d\x2b\x56\x5c\xe8\x2c\x9d\xce\x9a\xac\xc2\x13\xf9\x2d\xdf\xe0\x3a\xbd\x11\x57\x1c\xc8\xeb\x74\xa5\xa5\xae\x8b\x8b\x4e\x57\x3d\x64\x69\xb4\x6c\x67\x59\xa5\x58\x56\x78\x79\xc6\xa5\x8a\xc4\xba\x59\x9d\x48\xc9\xed\x64\xb2\xc8\x2c\x57\x12\xcc\x0e\x94\x8a\xb1\x4e\x65\x86\xb1\x6e\x47\x36\xe7\xb1\xd6\xa9\x55\x14\xb9\x96\xc6\x9b\xbc\x32\x2a\x67\x94\xc3\x28\x29\xa2\x6c\x8b\x49\xe6\x63\x4c\x2a\x46\xaf\x53\x6a\xab\x1c\x3b\x8c\x92\xac\x1c\x13\x36\x23\x53\xaa\x73\x73\x95\x6c\xcf\x88\xf4\x70\x9b\x9c\xc5\xea\x1a\xd1\x63\x06\xb4\x91\xa6\x68\xad\x9d\xd6\xb6\x94\xd0\x2d\x31\x79\x89\x92\xe7\x29\xb5\x2c\x4b\x50\x9d\xca\xc3\x5c\x8d\x3e\xbc\x4e\x33\xf4\x70\xb6\x6b\xf5\x29\xb1\x98\xae\x51\x14\xdb\xab\xbc\x1e\xcb\x62\x8b\x15\x08\x62\x5c\x27\xaa\x3d\xba\xbb\xdf\xcd\xe5\x53\xb3\x92\x1d\xc8\x95\xa9\xa0\x2c\xd6\xfd\x3e\x35\xae\x1b\x07\x26\x5b\x95\xd2\xcb\x4d\x9a\xe2\x38\xba\x6e\xa6\xb2\xa9\xf2\x80\x5d\xf6\x8b\xfb\x1c\x37\xaf\x70\xec\xfa\x38\x98\x6c\x5f\xf7\x72\x37\xc9\xa6\x63\x85\x5a\x6f\xf9\x3a\x9a\xa6\xd2\x6a\x2a\x76\xd8\x34\xa9\x6a\x93\x64\xab\xdd\x57\x75\x33\xd8\x29\x4a\x69\xc5\x4f\x5e\x4b\x9b\x62\x4d\x9d\xe8\x1b\xba\x59\xab\xd3\xcc\xe8\xb8\x6a\xcc\xab\xf3\xe1\x70\xd5\x9a\x9a\x68\x58\xcb\x9b\x65\x91\x3b\xf6\x0d\x76\xb3\x50\xb2\x6b\x3a\xbb\x4a\x33\xc3\x62\xa7\xd3\x5b\xd4\x0a\x0d\x6a\xbc\x3f\x09\xa9\x8e\x2e\x15\xb7\xe3\x93\x6c\xca\x99\x4d\x69\x51\x3c\xf0\x6b\xfd\x38\x9e\x0f\x07\x85\xce\xb8\x97\xeb\x53\x74\x37\xab\x55\xd2\x5a\xad\xb2\xcf\xa4\x1a\x04\xd9\x2d\x19\xcb\xca\x18\x96\xe7\x43\x58\x57\xf7\xbd\x72\xba\xab\xee\xca\xc3\x6d\xf7\x35\xdb\x5d\x35\x26\xdb\xd1\xb6\x11\xdb\x2b\xe3\x99\xde\x18\x50\xc7\x39\x77\xe4\x9a\xa3\x43\x32\x3d\xcc\x17\x5b\xdc\xc9\xe0\xc9\x6d\x7f\x55\xd4\x6b\xe6\x40\xd5\x1a\xd5\xfd\xb2\x23\x99\x15\x88\xb4\xe3\x5a\xee\x37\x4b\xb1\xca\x38\x0f\xcb\xf4\xb4\xb1\x33\x09\x2a\x93\x7f\x5d\x32\x93\x43\xa6\x2d\x15\x99\xc2\xba\x2c\xd2\x99\x3c\xdf\xd6\x4c\xb3\x32\x16\xe9\xd1\x2c\x99\x9a\x24\x7b\xd4\xe2\x90\xdc\xaf\xb7\x9d\x5c\xa5\xb0\x28\xf3\x5a\x8f\x9a\x9c\x52\xc7\xde\x78\x4e\x55\xe9\xdd\xba\x3d\xd8\xd6\xd3\xe5\x65\xa3\xb9\x1f\x2c\xd6\x46\x39\x3f\x1d\x8f\x49\x9d\x5e\xb7\x89\x4c\xaa\x6f\xee\x63\xec\xc4\x5c\x4b\x94\x52\x5c\x0d\x0a\xa8\x57\xe4\x06\xb5\xe2\xe6\x24\x4d\xa5\x3c\xbb\xe4\x0e\xfb\x5d\x96\xd3\x87\x27\x34\x3f\x6a\x75\xa3\xbd\xcb\xee\x60\x7f\xdd\x2a\x97\xc7\xf5\x74\x2d\x97\x9b\x16\x07\xe3\x9a\x28\x16\x39\xb9\x90\xce\xc2\x4a\x89\x9f\xcf\x92\xdd\x4a\x79\x74\x52\x59\xde\x48\x75\xa4\xec\xbc\xb1\x6f\x37\x6a\x44\x6f\xc8\x27\xcd\xd3\x3c\x3f\x2e\x2b\xbd\x13\x37\xa3\x4a\x22\xc7\xca\x99\x16\x5f\xd8\xf7\xd7\x7a\xcb\x10\x0f\x84\xce\x33\x5d\xa4\x77\xd0\xbc\xd9\x93\xcb\x48\x67\xc4\xc2\x78\x51\x65\x5e\x8b\x03\x65\x3e\x46\xb0\x99\x45\x69\xa5\x3c\xa8\x74\x87\xa2\xd0\xeb\x8f\x8b\xb3\x6d\x6d\x2e\xad\x34\x8e\x22\xf5\x29\x4f\xf5\x7a\x6d\xb5\x97\x8c\x0d\xb9\x14\x9a\x43\x93\xdb\xa1\x41\x4e\xcf\xc1\x5e\x92\x8b\x91\xa3\x9d\x10\x9b\x11\x4d\x69\x55\xe8\x97\x3a\xf9\x36\x67\xd4\xf2\x65\x36\xdd\x18\xb5\x26\x1a\x5a\xd1\x19\xa3\xa5\x97\xe9\x4d\xaf\x51\x3c\x95\xca\xaf\x83\x6c\xb2\xd2\xae\x14\x0e\xc9\x5e\x96\x8c\xd5\x1b\x1c\xfb\xba\x9b\xef\x26\x5c\x81\x23\xa5\xcd\x7e\xb3\x9c\xd4\x56\xd9\xd8\x22\x27\x0f\x3a\xa7\x55\x83\x28\x2c\x62\x3c\xc1\xb6\x17\xf3\x23\x7d\x1c\x40\x4d\x5c\xa9\xc4\xb1\xc0\x10\x45\xb1\x29\x4a\x42\x2d\xa5\xee\x5a\xfd\x9d\x5a\x1a\x49\xa7\x5d\xaf\x56\x3c\x74\xca\xf3\xa5\x09\x3b\x8d\xf2\xeb\xae\x9f\x1c\xaf\x98\xf5\x62\x91\xd4\x0e\xcb\x5d\xf9\xb4\x27\x25\xc1\x94\xb9\x45\x43\x5a\xaa\xb5\x54\xb6\x58\x59\x19\x07\xd5\x2c\x4a\xa9\xe6\xd1\x68\x34\x0a\x93\x79\x3b\x27\xf6\x65\x6a\x26\x67\xc7\xc4\xa6\x90\x11\x11\x97\xeb\x8b\xa6\xba\x28\x64\x1b\x69\x7d\x54\x56\x89\xe5\xa6\xd2\xa8\xa1\x41\xa6\xd3\x96\x8f\xeb\x21\x6f\x90\x42\x9e\x49\x11\x43\x68\xa6\x1a\xa7\x23\x63\xd6\xea\xd5\x13\x1a\xf4\xba\x99\xde\x62\xd0\x9b\xb0\x99\x5a\xb1\x49\xa4\xd2\x54\x4b\x19\xc4\x84\x9c\xba\x55\x96\xa8\x35\xd8\xc5\x54\x66\xdb\x4f\x2d\xf4\x54\xae\xce\xd6\xc4\x7c\xa1\x3d\x78\x25\x2b\xe5\xd2\xbc\x31\xad\x1f\x88\x8c\xbe\xdf\xbc\xb6\x0a\xdb\x5e\xe3\xc4\x88\x19\x48\x36\x48\x61\x3a\x9c\xb4\x94\xc1\x76\x9a\xed\xf1\xa5\xd4\x8e\x35\x63\x83\x5a\x4c\xca\x33\x54\x87\xde\x97\x68\x3e\x3b\xa2\xb4\x19\x57\xaa\x8c\x3b\x2c\x57\x33\x32\x9d\x7d\x09\x6d\x27\x74\xd6\xd8\x0b\xb0\x14\x2b\x67\xca\xb4\xb6\xcd\xa9\xb3\x5a\x27\x76\x22\x34\x23\x57\xaa\xa8\x32\xaa\x2c\x78\xe5\xb8\x82\xa7\xf5\xba\xc3\x2f\xb4\x71\xb3\x44\xc2\x51\x2f\xd6\x6a\x24\xf9\x01\x51\x83\xf3\xda\xbe\x37\xca\x66\x6a\xab\xf2\x7a\x5d\x47\x65\x92\x2b\xce\xc8\x63\xc5\x28\xd1\x9b\xe9\xd4\x10\x94\x58\x43\x49\xf2\xbd\x23\x05\x8f\xb3\x58\x63\x97\xe4\x4a\xc3\x65\x69\xcd\x37\x69\x63\x9a\x1e\x0b\xa9\x21\x36\x0b\x4a\xe3\xe9\xac\x3f\x6a\x67\x2b\xcb\xd7\xd7\x67\xaf\x2f\x81\x92\xd0\x73\xa4\x6c\x1e\x41\x17\x82\x12\xa8\x58\x06\x4c\xc4\xb5\xba\x5c\x57\x1d\xf6\x8b\x78\xf7\xc3\x1d\x6f\x59\x30\x39\xf2\xe2\xb1\x95\x9e\x08\xdb\x2a\x7c\xf9\xe2\x0d\x31\x58\x6f\x4d\xa8\x1f\xe3\x64\x82\x4c\xa4\x12\x86\x24\xca\x56\xc0\xc1\x3a\x18\x89\xa0\xa9\x9a\x06\xf5\xf0\x3c\x7f\x14\xc3\x55\xb6\xb5\x27\x6f\x30\xd8\x8b\xe8\xcd\x7f\xfa\xaf\x78\x1c\x54\xe1\x0e\x4a\xaa\x26\x43\x05\x81\x9d\x6d\x83\x02\x95\x03\x33\xd3\x31\x3d\x05\x28\x69\x1c\x76\x0e\xd9\x5b\x08\x40\x52\x79\x5e\x54\x78\x5c\xdd\x0e\x28\xb1\x2d\x35\xd7\xf0\x63\x58\x0c\x9f\x85\x92\xb8\xd3\x13\x0a\x44\x84\xa2\xc9\xc4\xce\x84\xff\x93\x4e\xe4\x12\xa9\x24\xc1\x8a\x06\xc2\xef\x18\x89\x2f\x00\x00\x80\x1d\x89\x3c\xf6\x6e\x3c\x47\x0c\x81\x22\x0b\x99\x78\xd1\x2c\x4a\x27\x9a\x10\xf4\x02\x4c\x65\x1a\x9d\x26\xcc\x4e\x6a\x7d\x7d\x22\x36\xc9\x21\xda\x67\xab\x8b\xf4\x6a\x5f\x5c\x10\x7c\x9e\xd9\xae\x0b\xa9\x79\xba\xcb\xd4\xba\x87\x6c\xa5\xdd\x37\x4e\x07\x96\x2e\xac\x79\x1b\x2e\xa3\xab\x86\xa1\xea\x22\x2f\x2a\xcf\x11\xca\xdd\x2e\xb1\x1c\x68\x16\xda\x2f\x20\x1e\xf7\x45\x82\x60\xa4\xbe\x87\x3b\x23\xcb\x0d\xf0\x4b\x99\x14\xb7\x5d\x0b\xff\x43\x26\x92\x89\xdc\x99\x55\x4e\xea\x3b\x1c\x9b\x8c\xca\xb5\x5d\x6f\x39\xe2\x94\xfd\x9a\xdd\x1f\x09\x61\x3a\xab\x89\xf3\x61\x5f\xa2\x93\xec\xa0\x77\x14\x63\x95\x24\xd1\x37\x57\xfd\xe5\xa9\x33\xd8\x15\x07\xf9\x6e\x1a\xad\xd2\xeb\x6d\x1b\xf6\x17\xb1\x8d\x36\x26\x7f\x9c\x63\x2e\x6e\xa1\x62\xe7\xc6\xce\xd8\x19\x1e\x66\x78\x82\x67\x0e\x71\x04\x65\x4d\xa2\x10\xbc\x78\x01\x2b\xce\xc6\xe6\xc4\xcd\x79\xf9\x72\xed\x8b\xb3\xbd\xd6\x67\xdf\x58\x9c\x91\x4c\x03\xf7\xc7\x39\xd0\xc4\x90\x44\x16\x46\xc0\x23\x86\x1a\x75\x53\xff\x8e\x82\x18\x10\x59\xc7\xa1\x68\x39\xb1\x77\x94\x74\xed\x18\x7c\x52\xcf\xee\xd0\x90\x6d\x56\xbf\xcf\x4a\x12\xc1\xa3\xcf\x61\x1c\xfd\xed\xaa\xb9\x5d\x9c\x53\xf5\xe7\xc8\x1d\xc6\xba\xa1\xab\xa6\x86\x43\xb8\x58\x78\xb8\x07\xa2\x02\x70\xa2\xf1\xaa\x58\xe9\x46\xc4\x01\x66\xa1\x1f\x47\xea\x73\xc4\x2a\x18\x01\x8f\x0e\x3e\xdf\x40\x94\x62\xf0\xc6\x53\xf4\xd1\x86\x01\x9e\x9f\x9f\x41\x12\xbc\x45\x5e\xbc\x3e\x30\xac\x6d\x54\xc9\xf3\xe6\xf5\x0e\x5f\x48\x52\xce\x3e\xaa\xf7\x8a\x59\xae\xc0\xef\xa2\xe1\x63\x64\xfd\xfe\xc7\x4b\x04\x88\xd3\x0c\x4e\x70\x01\x5b\x50\x31\x02\xb4\xa8\xb0\x8f\x38\xc5\xce\x3f\x27\x6d\xa0\xe3\x7d\x4d\x98\xa6\xc8\x62\x46\x9c\xe1\x85\xf8\x26\x43\x1d\x8e\xa1\xb1\x13\x11\xf0\x68\xfb\xb5\x42\xba\x34\xc4\x41\x6d\xf5\xd9\x73\xc4\xaa\x19\xa0\xcf\xeb\xd8\xbf\x1d\xa6\xe1\xf8\x94\xed\xb0\x1a\xc7\x87\xed\x73\xf9\x87\xc2\x33\xf4\xb8\xaa\x48\xc7\xc8\xcb\x40\x87\x3b\x51\x35\x8d\xeb\x1a\x41\x27\xed\x6d\xb2\x71\xec\xc4\x8f\x91\x6d\xd5\xfc\x1e\xb2\xcf\x61\x1a\x3f\x49\x76\x0f\x1e\xd0\x07\x24\x07\xbd\xd2\x82\x0e\x88\x2b\x0f\xb1\xa3\xe6\x3e\xa9\xa9\x06\xb6\xa6\x62\x03\x5a\x2a\x30\x80\x58\x70\x96\xc4\x50\x35\x86\x33\x9c\x8d\x72\x7b\xab\x12\xe9\xa6\xc2\x58\x8d\x3c\x5a\xd1\x8a\xae\x5c\xeb\x92\x87\xb7\xbf\x7f\x03\x6e\x2a\x78\xfb\x12\x42\xe2\xb5\xa6\x0c\x89\xa8\xc2\xc3\x47\x55\x1e\xb1\x96\x87\x78\x83\xf3\x39\x82\x23\x97\xc6\xe7\x92\xbe\x7c\x13\x87\xac\x2a\xb7\x0b\xc8\xea\x0e\xc7\x8b\xe2\x8d\xd6\x95\xaa\xca\x73\x11\x09\x15\x6b\xb7\xd0\xab\x55\x45\x99\x07\xbb\xb8\xc8\x39\x44\x09\x94\xe1\x05\xf6\x68\x4d\x92\x17\x4c\x07\x14\x12\x22\x3e\x46\xe1\xfa\x01\x72\x22\xe0\xd1\x5a\xaf\x9d\xb9\x64\xe3\xc4\x48\x22\xb3\x79\x8e\xa8\x1a\x54\xc6\xfe\x0d\xcf\x08\x20\xae\x30\x82\x92\x01\x7f\xc8\xe3\x0c\xf1\x6b\xcd\x28\x97\xba\xd8\xe3\xac\x25\x9b\x29\x0d\xa7\x34\x52\xe5\xee\xac\xb6\x10\x33\xb1\x69\x66\x30\x6d\x90\x26\x7d\xec\x6d\x5a\x83\xee\x09\x55\x44\xad\xcd\x92\x90\xcc\xf6\xa6\xb3\x99\xb8\x92\xb7\x64\x61\xd1\xde\xe2\x3a\x95\x45\xf9\x75\xbe\xc0\x70\xf2\xb5\x52\xa9\xd4\x3f\x94\x1a\xb3\xf6\x3e\x43\x97\x4a\xa5\x3a\x9d\x94\x6a\xc3\xd9\x28\xa3\xf4\xc9\xe5\x64\xc6\xd1\x23\x61\xdc\x2c\x30\xb5\xdd\xbe\xfc\x3a\xa9\x56\xf6\x75\x8a\x7d\x35\x99\xb9\x20\x4a\x4a\x4b\x95\x8f\x79\xa4\x6c\x27\xab\xcc\x76\x59\xef\xec\x6b\x5c\x4d\xa3\x87\xbd\x7e\x65\x40\x2e\x76\xbb\x53\x8d\x3f\xed\xe7\xf5\xb2\x52\xc9\xe6\x14\x54\xc8\x1a\x63\x52\x3b\x19\x06\xb7\x9e\x0f\xb3\x27\xbe\x56\xfa\xb9\x3f\xd5\xcc\x8e\x94\x98\x9c\x6c\xe6\x37\x2d\x6e\x9e\x2f\x70\x83\x1c\x91\x9e\xb0\x39\x22\xb5\xe3\x16\x62\x56\x97\xa7\x83\x5e\x96\x28\x64\xd1\xbc\xb7\xa3\x67\x8a\x99\x1d\x52\x9c\xd9\xd0\xc9\x83\x78\x1a\x16\xd9\xa4\xd9\x10\x52\x30\x33\x58\x16\x8b\xbb\xad\xd8\x90\xb2\x1b\x8e\x2e\x74\xe1\x86\xa6\xfa\xdb\x8a\x32\x4d\xb3\x55\x41\xdd\x8a\x9b\xc2\xa4\x5f\x7c\x5d\xa4\xb8\x0d\x9a\xcc\x62\xbb\x53\x2c\x56\xe9\x98\x0b\x54\xcc\xb0\xca\x40\x66\x3b\xc9\x5c\x6e\xba\xa6\x68\x65\x4e\xb6\x16\x2d\x9d\xee\x92\x75\xa9\x9f\x9c\x50\x0b\x4d\xe7\xe8\xb5\xbe\x40\xc4\x72\x2d\x91\x93\x4c\x2e\x7d\x48\x73\x73\x19\x71\x5d\xaa\xbf\x92\xc8\x94\x5c\x48\xa6\xb8\x51\xda\x48\x17\x56\x4b\xb4\x89\xe9\x5b\x6e\x93\x6b\x90\xdb\xd3\xba\x9c\x54\xa6\xa4\xc0\x67\x06\xd3\x4c\x66\xc6\x29\xb3\x45\x66\x35\x37\x56\xdb\x43\x2b\x49\xc4\xd8\x5a\xbf\x93\x1d\x64\x8b\xd5\xe2\x6e\x97\xdb\x73\xca\x96\x2a\x27\xf7\xd9\xc5\x66\x3d\x18\x73\x5b\x22\x9f\x16\xcc\xb4\x31\xd7\x9b\xe4\x21\x3f\xa8\xc0\x93\xae\x77\xbb\x5c\x4a\x1b\x94\x58\x66\x56\x2d\xd6\x88\x8a\xd0\x4b\x75\x07\xa7\x21\x8c\xb1\xa4\x70\x5a\x24\xd5\x61\x56\x8e\xed\xaa\xdb\x5c\x23\x2f\x6c\x77\xf9\xf1\xa2\x89\xaa\x25\x6a\xc9\x6a\x99\xde\x4c\xa1\x88\xe9\x90\x4f\xb6\xb8\x41\x2c\xbf\x1c\x09\x99\x4c\xaa\x2e\x37\x51\xc6\xe8\x10\x0d\x7d\x30\xc9\xaf\x35\x22\xd6\x2e\x26\xb7\x54\xb6\xb9\xd6\x39\xb1\x31\x4f\xa3\xc9\x52\x61\x1a\x47\x62\x9a\x1b\x36\x47\x62\x7e\xd7\x2d\x25\x0b\xed\x3e\x59\x91\xd9\x89\xa4\x2f\x93\x33\x93\x9c\x9c\xf6\xed\x66\xbf\xad\xd0\x6d\x61\x38\x4f\x6b\xe3\xe9\xa4\x2a\x0d\x8e\x74\x2e\x39\x9c\x77\x8b\x85\x01\x45\xa4\x77\xdd\xca\x81\xa0\xca\xaf\xd5\xcc\x81\x21\xe5\x1a\x15\xeb\x96\x15\x69\x78\x10\x29\x41\x36\xa5\x2d\x91\x1c\x0c\x0b\x4c\x6e\x7b\xa8\xe6\x16\xa9\x11\xcf\xa6\x7b\xe3\x42\x71\x98\xab\x64\x8c\x1c\x5d\x3d\xed\x8c\xca\x81\x58\x25\x25\x65\x31\x5f\x96\xf5\xfc\x7e\x3e\x4f\x2f\x16\x49\x55\xdf\x67\x96\x48\x38\x1d\xf6\xdb\x41\x4f\x81\xcd\x7a\x27\x2d\x2e\xe5\x5a\x2c\x9f\xcd\x4f\xa9\x5c\xad\x3f\xe8\x77\x5b\x5b\x46\x58\xcb\xe5\x21\x61\x66\x62\xdb\x5d\x69\xbe\x64\x5b\xcb\x9e\x24\xcc\x0b\xa6\x92\x82\x7b\x49\x6e\x91\x5a\xa7\x59\x31\x8c\x7d\x76\x57\x17\x84\x65\x39\xbb\x6c\xc5\x92\xc6\xb6\x63\xae\x66\x04\x91\x4c\x6e\x19\x93\x51\xe8\x6e\x96\x9f\xf6\xf2\xec\x69\xd7\x2d\xa5\x19\xb6\xa5\x36\xd7\x4a\x21\xd5\xd7\x51\x81\xa8\x30\xe9\xe3\xbe\xd3\xec\xe7\x51\xab\x59\xd9\x9f\x18\x19\x6d\x6b\x74\xa1\xdd\xd7\x15\x42\x9f\x4c\x8d\x05\xad\x0f\x0f\x87\x6d\xc3\x28\xc4\x68\xd9\x58\x95\xd5\xc1\x82\x24\xda\x69\x65\x27\x4b\xbb\x74\xb5\x51\x6b\xae\xb7\x45\x96\x94\x6b\xe3\x79\x3f\x3b\x20\xb6\x27\x7d\xcc\x4d\x17\x85\xcd\x22\xb3\x29\xcd\xfb\x2c\x4d\xae\x8f\xdc\x94\xeb\xf0\x1b\x46\x23\xaa\xc3\x7d\x23\x3b\x3d\xf1\x0a\x93\x33\xcd\x05\xc7\x1e\xb5\xee\x3c\x47\x56\x0e\x12\xda\xaa\x85\x6c\x61\xdb\xd8\xe5\x0b\xb1\x71\x71\xf7\xda\xec\x73\xbb\x89\x30\x1c\xe4\x8b\xfb\xc9\x9c\xea\x75\xf7\xa8\x5e\x68\xc8\x86\xd1\x36\x8c\xca\x61\xb2\xde\x32\xb9\x6a\x6f\x50\x9f\x08\xfd\x0c\xd3\x28\x67\xe9\x1d\x41\xcb\xe5\xd5\x48\x2d\xc4\x2a\xc4\x71\x20\x13\x03\x7e\x4a\x2f\x16\xe2\x8c\xd8\xb5\xa6\xbb\xdc\x38\x53\x53\x0c\x6e\xce\x1b\xcd\x9e\x2e\x16\x59\x52\xc1\x78\x71\xdb\x1d\x43\xcb\x19\xfd\x38\xcf\x1f\xe5\x49\x85\xe1\x66\x73\x7e\x96\xda\xc9\x15\x42\x93\x57\x06\x97\xee\x40\xd2\x5c\x8c\x27\xfb\xba\xdc\x1c\xcf\xab\x6c\x53\x98\xf4\x09\xa9\xd4\x83\xf9\xd1\xb2\xa1\xae\x3a\x83\xa1\xc1\xe4\x72\x87\x6a\x63\x5e\x3e\xf0\x6c\xba\x55\x54\x38\x11\xc5\xba\xa4\xd1\x19\xd0\xb9\x9a\x44\xf5\x84\x75\xbf\x1a\x3b\xd1\x72\xb6\xbb\x61\x7a\x2b\xa1\x49\x8b\x48\x8a\x95\x97\xb9\xa2\xa9\xd0\x48\xa1\xd6\xdc\x58\x94\xba\xdc\xbe\xd3\x2c\xcf\xb2\xf9\xc2\xa8\x77\x58\xae\x60\x63\x36\x68\xad\xf7\xed\x4c\xee\x30\x13\xd2\xe3\x2d\xa3\x28\xf3\x15\xbb\x68\x8b\x27\xf3\x58\x94\x57\xc3\xd4\x6b\xe3\x54\x35\x77\xa5\xed\x81\x90\x2a\xeb\xc3\xb2\x40\x24\x77\x75\x5a\xd3\xeb\xdb\x7c\x0e\xc3\x49\xed\x8b\xa7\xf9\xbc\xca\x17\xd5\x65\xac\xcd\x29\xf9\xc5\x8e\x1f\x2d\xf3\xda\x41\x3b\x12\x13\xe6\x34\x25\x8d\xce\x94\x34\xd6\xa2\x8e\x69\x62\x61\xa5\xbc\x92\x4f\xab\xbe\x5e\x3c\xd0\xc9\xee\x32\x5b\xd8\x4d\xf6\xf5\x05\xdb\xdb\xaf\x8d\xd5\xba\x23\x6c\x3a\xe3\x76\xae\x3a\xd9\x53\xda\x6a\x57\x54\x17\xa5\x14\xca\x6d\x78\xba\xdb\xcf\x15\xaa\xb1\x58\x77\xbf\x20\xd9\x61\x0b\x35\x0f\x85\x55\xa6\xba\xea\xa5\x94\x31\xbd\xab\x14\xc9\x2a\x51\x20\xe1\x36\x3d\x10\x47\x83\xf2\x36\xd5\xa4\x56\x1b\xa3\x30\x90\xcb\x88\x26\x57\xe3\xd5\x2a\x99\x92\x6b\x6c\xac\x93\xec\x2c\x18\x99\xcb\x92\x8b\x54\xba\x38\x21\x16\xb5\x7d\x75\x46\x2e\xe6\x2a\xb7\xcf\xd6\x05\x39\x13\x83\xcd\x57\xda\xd0\xfb\x44\x4e\x9d\x09\xc3\xec\xb1\xa1\xd0\x8d\xae\xa6\xa4\x88\x6e\x95\xda\x09\xcd\x71\x6a\x52\x18\x24\xf7\x39\x7d\xdf\x6f\xc8\x66\x63\xd2\x1c\x48\xd2\x8e\x2f\xb4\xd2\x2c\x3d\x28\xb1\xab\x14\x3b\x81\xdd\x3a\xa1\x08\xc3\x98\x56\xa0\x4f\x0c\x59\x21\xb8\x53\xb9\x1a\xcb\xa5\x17\x05\x93\xa4\xb6\x4d\x62\x37\xab\x64\x24\x62\xd7\x3a\x15\x06\xa7\xc5\xb8\xd6\x8c\xed\xb6\x31\x39\x3f\xe2\x62\xd2\x50\xde\x15\xbb\x29\xa6\xa7\x09\xf5\x89\xd0\x4d\x91\x19\xb6\x47\xd3\xe9\x9c\xa8\xa8\xc5\x5c\xa6\x81\xf8\x46\x6c\x1c\xd3\x36\x5a\x85\x5b\x17\x4e\x82\x38\x9f\x12\x02\xb5\x6f\x0f\x5a\x9d\x72\x3e\x6d\x2a\x19\x2d\xd9\x57\x26\xc9\x34\xbb\x5e\x67\x55\xb3\x5e\xc8\x29\x4c\x9e\x2b\x30\xf9\x11\xcb\xa4\xfb\x1b\x05\x29\xa7\x53\x66\x93\x9f\xed\x8a\x13\x19\xe6\x27\xa5\xbe\xd2\x9c\x51\xe5\xfd\x9e\x23\x88\x43\x4a\xd1\xe8\x6c\x9f\x18\xd5\x57\xbb\x91\xbe\x8c\x99\x49\x99\x9d\x74\xc6\xda\xe4\x54\x15\x84\x46\xb3\x38\x1a\xc7\x16\xb2\x49\x4e\xaa\x99\x05\x4b\x72\x30\x1f\x5b\x98\xdc\x28\x59\xf9\xc9\x39\xa9\xd0\x23\x32\x75\x92\x2c\x88\x27\xb6\x71\x98\xcf\x0b\xd7\x9e\x9f\x8f\x56\x18\xf6\xbb\xa2\xfa\x16\x1d\xc4\xcb\x47\xcb\x2e\x0b\x1c\x0e\x82\x8a\x04\x8c\x30\xcf\x02\xe8\x02\xd1\x00\xff\xf8\x07\x08\xa6\x25\x24\xa8\xf0\x48\x00\x2f\x20\x75\xc6\x92\x46\x4a\x1c\x07\xbc\x69\xe0\xfc\x14\x37\x64\xe0\x8f\x7a\x77\x97\xe9\x56\xb6\xdf\xbe\xb3\x57\xee\x67\x63\xee\xd2\x5a\xd0\x9a\xf3\x22\x12\x0c\x9b\xba\xe0\x72\x31\xf5\x2e\xe6\xdd\xa5\xe2\xab\x95\xf6\xff\x80\x28\xc6\xd5\x80\x8c\xaa\xb0\x94\x7e\x8c\x82\x47\x3b\x45\x35\x91\x75\x3c\xe2\x92\xe3\x5f\xbd\x05\x01\x3d\xdb\x28\x46\x5e\x7e\xff\xe6\x69\x24\xe1\x92\x0d\xde\x2e\x11\x51\x37\x17\xfe\x59\x5f\xff\x58\x4b\xec\x88\xb7\x4b\xf0\x3f\x13\x2b\xf5\xc5\x5d\x65\x9f\x93\x70\x03\x42\xf6\x13\xd0\xf0\x7a\xf2\xe5\x09\xca\x2f\x3d\x15\x58\x89\x4f\x04\x94\x5f\x02\x95\x35\x7f\xdd\xa0\xf5\x64\xdb\x3a\x2e\x77\xa3\x76\x9c\xb6\xf5\x6f\x5c\x13\x25\xc9\xb6\x16\xac\x80\x59\xfb\x71\xaf\x53\x1a\xc0\x56\x9a\x55\xa6\x82\xab\xd5\x55\x7d\x8c\x28\x64\x1a\x77\xf7\x17\x6a\x0c\x2b\x05\x93\x62\x59\x4c\x76\x33\x1e\x06\x9c\x23\xa8\xcf\x12\x79\x4e\x71\xe4\xf1\xd2\xff\x41\x9c\xec\x47\x09\x7b\x48\x3d\xf8\x5d\x4c\x99\x33\x24\x8b\x9d\x5e\xac\x82\x6d\x80\x37\xe0\x26\x3d\xb9\x76\x57\x28\x96\xde\x31\xf2\x62\x3c\x11\x6e\x61\xd7\x7e\x7c\xa2\x5c\x59\x47\x14\x7f\x96\x6d\x44\xf1\xc6\xd9\xca\x45\x14\x9f\xb0\x63\x8d\x02\x31\x29\x37\xe9\xf4\xd1\xe6\xeb\xa1\x38\xee\x01\x0c\x10\x0f\x18\x8b\x3c\xeb\x05\x1e\x2c\xe9\xf4\x59\xa4\xda\xe7\x54\x88\x2f\x90\xc8\x31\xde\xcf\x81\x73\x97\x81\x08\xbc\x03\x4a\xd3\x45\x99\xd2\x8f\x56\x9a\x21\x03\x0b\x8e\x4d\x61\xd0\x38\xaa\x42\x44\x89\x92\x61\x5b\x46\x2f\x33\x11\xee\x81\x93\x84\xb1\xf5\x38\x0a\x82\x4d\x9c\xc7\xec\x55\x23\x80\x93\x54\x0a\xd9\x81\xaf\x67\x1e\x5f\xcc\xb3\x60\xdc\xcf\x4c\x34\x44\x64\x85\x92\x79\xf8\xe3\x61\xc9\x0f\x1b\xe8\xb8\xc9\xa6\x1d\x82\x3e\xc1\x11\xe8\x41\x43\xdd\x0e\x4b\x77\xc8\xb3\x5f\xac\x7f\xe3\x06\xd2\x45\x0d\xb2\xce\x9b\x80\x4d\x63\x37\xc7\x51\xb4\xbe\xc8\xf6\x8b\x5d\x8f\x70\xfa\x19\x22\x7e\xb1\xc7\x82\xb7\xf3\x90\xee\x1b\xe4\x48\x00\x06\xa3\x6a\x76\x38\x57\xe4\xc5\xc6\xf7\x89\x40\xc2\x7b\xa5\x66\x38\x80\xde\x5f\xe8\x89\xb8\x00\xc6\x39\xce\x99\x53\xbb\xb6\x1b\x8a\x7b\x46\xc1\x1d\x12\x36\x1d\x40\x54\x80\x43\xd1\x45\x9c\x19\x47\x81\xd8\x18\xdd\xd9\xf9\xf7\x7e\x0d\x85\xce\xc4\xda\xd9\xd6\x39\x0d\x4b\xe8\xed\xf7\x04\x7e\xc7\x72\x8f\xd8\xf7\xeb\x59\x27\x02\xbc\x15\xad\x84\x60\xcd\x00\x8d\x17\xaa\x9e\x08\xab\x23\x7e\x54\x48\x46\x67\xad\xf4\x8b\xc5\x24\x70\x18\xe5\x57\x0a\xca\x74\xd4\xf9\x48\x4a\x6c\xd5\xff\x51\xa9\x8e\xca\x58\x31\xc8\xbf\x42\x9c\x5c\x7a\x81\xa8\x9c\xb5\xb7\x71\x4b\x64\x3c\x3a\x14\x77\xbc\x5b\xde\xf6\x69\x85\x88\x8c\xaf\xd0\x65\x12\x43\xec\x77\xc2\x97\x1c\x82\xff\x5d\xd2\xe5\x9c\xbd\xf9\xd5\xb2\xe5\x3b\x97\xf4\x2b\x25\xcb\xc1\xf7\x43\xe9\x52\x4d\x9d\xf9\x25\x4a\x48\x86\x86\xe1\x38\xfb\x1d\xa2\x2e\x5a\xc8\xc9\x4b\x48\x78\x1f\xce\x5a\x5c\x46\xe1\x81\x81\xd6\x31\xf6\x28\x5e\x58\xda\x4c\x61\x29\x85\x87\xba\xb5\xae\xb4\x13\xf6\x94\xae\x88\x0a\x1f\xfd\xa4\xf0\xb9\xcd\xb8\xf3\x34\x62\xbf\xaf\x9e\x61\x31\xe3\xdf\x25\x51\x75\xeb\xe4\xd9\xc8\x39\x78\xf6\xab\x05\x2b\xf4\x5c\xdb\xaf\x14\x30\x1b\x7d\xe0\xe0\xff\x91\x9c\xd5\x74\x5d\xd5\x7f\x8d\x72\xb2\xda\xb3\x75\x93\x4d\xdb\xa7\x55\x93\x55\xfc\xb6\x66\x0a\xae\x50\xdd\x0a\xb6\x96\xf2\xc1\x70\x15\x17\xf0\xac\x54\x3d\xd9\x10\x53\xfb\xef\x92\xa3\x49\x67\xfc\xab\x85\xe7\x7c\x6e\xf3\x17\x0a\x0c\x60\x54\x09\x2f\xe5\x9f\x23\xe9\xc8\xcb\xa4\x33\xfe\x19\x61\xb8\xd5\xe1\xce\x19\x53\xbc\x85\xa6\x22\x95\x51\xa5\xf7\x74\x80\x64\x78\x16\x2c\x48\x32\x12\x6e\x64\x80\x27\x76\xdf\xc9\x61\x44\x4d\x80\xfa\xd8\x14\xd1\xbb\xda\x01\xc3\xf7\x8b\x90\x04\x29\x2e\x20\x9e\x7a\x60\x2f\x2c\x0c\xfd\xb1\x49\xaf\xb1\xc9\x14\xc0\x3e\x1c\x7f\x10\x90\x73\xdc\x66\xc2\xb0\x21\x84\x08\x3a\x11\x64\x9f\xee\xc1\x35\x61\x50\x8a\x65\x31\x9e\x5f\x5c\x63\xf1\x13\x58\x97\x24\x04\x75\xc5\x3a\x4d\x09\x7a\x94\x0c\x8d\x60\xdb\x1f\xe3\xef\xdd\x71\xf6\x4f\x01\x8f\x56\x47\xe0\x86\xba\xa2\x21\x53\x88\x11\xc0\x9b\x87\x5c\x8c\xe9\x5a\x15\x95\xbb\xe8\x03\x88\xde\x7f\x8a\xec\x4f\x50\xf4\x6a\x18\x26\xd4\x83\xb0\x7e\x9e\x0c\x03\x4a\xdc\x58\xe4\x15\xc8\x7a\x89\x10\xad\xd6\xc0\x5b\x50\x88\xfc\x35\x22\x2f\xe0\x0e\xbf\xc5\x0d\xeb\xf5\xde\x67\x2b\xff\x0a\xa2\x67\x94\x24\xb2\x9f\xa3\xf9\x43\x42\xe1\x41\x13\xf5\x33\x95\xf8\xca\x05\x0a\x55\x29\x04\xef\x2c\x82\x15\x15\x95\x21\xa7\xea\xf0\x1e\xbc\x01\xa4\x82\xf0\x22\x25\x0e\x41\xfd\x97\x75\xe9\x18\xea\x22\x25\x81\x9e\x29\xd3\x3f\xd2\xb3\x17\x89\xb3\xe0\xd8\x60\x7e\x15\x6e\x6d\x78\xfc\x24\xdf\xcf\x68\x6c\xe0\x71\x72\xd4\xe0\xb5\xd0\xb8\xb9\x63\xf1\x04\x23\x2f\xc0\x53\x1e\xa7\x80\x37\x40\x8b\xc8\xeb\x67\xf1\xe9\x3c\x9b\x42\x91\x57\x28\x64\xea\xb0\x24\xf1\xaa\x2e\x22\x41\xfe\x65\x7d\xd0\x2c\xc5\xd3\xd9\x1c\xa8\x8b\x78\xbd\xa7\xe9\xa2\xf2\x33\xaa\x8e\xbb\x40\xf9\x1e\x75\x67\xe9\x75\xa8\x23\x91\xc3\xe1\x40\xd0\xef\x81\xfa\x98\x86\x8a\x40\x89\xca\x0f\x60\xed\x2b\x7d\x76\x25\x5b\x4b\x1b\x8c\x0d\x10\x15\x70\x85\x99\x21\x89\x0c\xbc\x4b\xd9\x7e\x36\x9c\xe3\x55\xef\x3e\xc7\xa8\x43\xf2\x3b\x2c\xf0\x74\xf9\x2f\x5e\x8b\xd8\xa7\x0a\xb1\x07\xe8\x9d\x58\x0a\x5d\xdd\x83\xd0\x7b\x00\x22\x37\x62\x9c\x54\x29\x9e\xf1\xcf\xa0\xde\x18\xa3\x60\x24\x51\x78\xc8\x50\xd0\x7b\x1c\x80\x5f\x08\x81\x1f\xb0\xf9\xbf\xd7\xb3\xea\x60\x76\xce\x08\x56\x3d\x23\x1a\x68\xe7\x1a\x11\x9f\x8f\xca\x85\xeb\x24\x3a\x50\x9d\xb7\x33\x4c\x5f\x95\x6b\x88\x48\x0a\x21\x0a\x49\xc6\x19\x6b\x24\x19\x9e\x54\x17\x2a\x92\x6e\x42\xf4\x19\xb1\x5e\xa8\x4e\xc6\x99\x51\xce\x7b\x90\x4d\x4e\xb2\xbf\xd2\xa5\x2f\xbd\xd0\xaf\x1b\x0f\x35\x74\xbc\x48\x70\x3e\x3b\xeb\x8c\x8b\x3f\xf9\xba\xe7\xec\xf4\x50\x18\x67\xd4\x42\xdb\x0e\x95\xbb\x9f\x72\x7f\x1a\xe5\xe3\xe5\xf8\xf0\x8d\x01\x76\x1e\xcd\x42\xfa\x7c\x06\xd8\xbe\xbe\x2a\x9e\xb1\x15\x90\x7d\x6d\x82\xff\x9e\x0d\xa0\xd1\x71\x32\xf2\x82\x61\x1a\x80\xf6\x9f\x52\x16\xd2\x3e\x67\xb6\xcd\x51\x27\x3e\xd3\xde\xc8\x89\x83\x14\x78\xb2\xb8\x79\xa9\x57\xb1\x0b\x78\x19\x6a\x2b\x37\x6f\x45\x51\x01\xce\xbb\x31\x51\xc7\x82\x73\xdd\xdb\xd5\x86\x8a\x5d\x24\xce\xa8\x02\xb4\x16\xe6\x97\x53\xb7\xc0\x90\x29\x49\xf2\xee\xf8\x5c\x63\xf0\x67\x10\xd7\xbf\xac\x2d\x20\xaf\xa2\x77\x85\xe1\x53\x95\xa9\x1d\xd4\x7d\x65\xc1\x7f\x3d\x3f\x03\xc5\x94\x24\x9f\xfe\x70\xb6\x42\xbe\x1f\x9f\x37\xab\x9e\x61\x87\x22\xff\xfe\x0d\x74\x29\x24\x24\xac\x3b\x31\xee\x7e\x02\xc7\xff\xc6\x37\x3f\xdc\x83\xb7\x3f\x80\x93\x07\x2e\xd1\xb6\x00\xa9\xd7\x3b\x55\x9e\x70\xb5\x4f\x35\xa9\x43\x4d\x87\x06\x54\x90\xb5\xf0\x3f\x6f\x05\xfd\x40\xdd\xf3\x66\x56\xd8\xee\x8a\x47\xf1\xdb\x81\xc1\xce\x40\x75\xc7\xc8\xa7\xda\xb3\xc2\x4a\xbd\xd3\x86\xf1\xbd\xc2\xe3\x3d\x60\x10\x8c\x5a\xfd\x3c\x0a\x3e\x0a\xbd\x54\x85\xef\x25\x39\x9b\xbd\xff\xe3\x6c\xf8\xf8\x87\x0e\x88\x3d\x83\x54\x16\xc7\x1b\x8b\x06\x56\x3f\xec\x55\x81\x97\xe7\x8f\xc6\x68\x60\x73\xc8\xbb\xef\x24\xf1\xd6\x8f\x75\xf5\x1d\x08\x5e\x63\x12\x79\xb1\x1a\xe8\xaa\x3a\xf4\xef\xd9\xfe\xac\xba\xb3\xae\x37\xf8\x97\x6a\x3a\xe7\x02\x85\xef\x51\x72\x2e\x5e\x3f\xa1\xda\xde\x93\x60\x17\x7c\x88\xd0\x84\x4b\xed\x3b\x15\x3e\x94\xd5\xf7\x1b\xfb\xff\x45\x3e\xaf\xd8\xfb\x9f\x23\x95\x97\xa5\xed\xbf\x4e\x28\x6f\xc8\x22\xe6\xcc\x95\x20\x06\x25\xf0\x52\xc8\x8d\xe1\xbf\x96\x3d\xcf\xaa\xfb\x4a\xf2\xfe\xf4\xb5\x12\xa2\x27\xc3\xcb\x5d\x07\xee\x87\x43\xc2\x9b\xf8\x97\xd6\x3f\x25\x43\x1e\x22\x42\x04\xc8\x9b\xfb\xf2\x1c\xe0\xc9\x7f\x8e\xd8\x58\xb7\x9c\x7c\x60\x10\x05\x6e\x28\x0b\x8d\x2e\xb7\xca\x78\x40\x46\x5e\xce\x28\x85\x83\x0b\xdc\x77\xe5\xa9\xda\xb1\x73\xfa\x4e\x86\x37\x08\x86\x7c\x71\x32\x81\x55\x32\x91\x48\x3c\x11\x02\x19\x6e\x36\xb9\xf7\x67\xdd\x3c\x74\xe2\x16\x88\xd3\x94\x8e\xef\x82\x12\x15\x4e\xf5\x32\xc5\xad\xef\x44\x38\xb9\xc5\x69\x4a\x77\x4e\x11\x58\x96\xb3\xa2\xee\x9f\x23\x49\x6f\x8a\x2c\x2a\xc1\x14\xea\xf0\x1c\x49\x67\x93\xc9\x00\x57\x82\x02\xf6\x03\x6b\xf1\x35\xb5\xa3\xec\x54\x87\x4e\x82\x00\x65\x5d\xdd\x1b\x50\x37\x00\xab\x2a\x51\x04\x70\xf0\x85\x75\x7c\x02\x4c\x47\xaf\x06\x10\x15\xa0\xc0\x3d\xf6\xa6\x1b\x0f\xc0\x50\x01\x27\x4a\xd0\x00\x50\xa6\x21\xcb\x42\x16\x88\x8a\x0b\xc6\x19\x0f\x38\x1f\xe8\xd0\xbe\xe3\x94\xd2\x21\xc0\x91\x17\x90\x05\x48\xd0\x55\x93\x17\x80\x6a\x9b\xf9\xd3\x51\x07\xc3\x36\x10\xa4\xd8\x84\x05\x42\x82\xc8\xc9\xb4\xf2\x9e\xc1\xb7\x37\xfb\x1a\x2f\xce\x54\x18\x6b\x5f\x14\x83\x9e\x8e\x3a\x77\xf8\xde\xca\xfb\xf3\x6d\x60\x22\x07\xee\xfe\x0b\x27\x81\xff\xf3\x7f\x00\xfe\x4d\x58\xb1\x58\x7d\xee\x2e\x6a\xc5\xcc\x47\xef\xad\x55\x6d\xf2\x52\x03\x00\x1d\x22\x53\x57\xac\xd2\x5f\x9d\xc4\x37\x2f\xb8\x0b\x1e\x7f\xe2\x32\x7f\x79\xeb\x62\x3c\x0d\xa8\x51\xd6\x45\xd1\xe0\x39\xd0\xe4\x43\xf4\xfe\xab\xaf\xa8\x73\x21\xb4\xe5\xd2\x72\x0a\x1b\x26\x8d\x37\x2c\x14\xfe\x2e\xfb\x70\x01\x75\x8f\xd7\x8e\x12\xc5\xc0\xbb\xa8\x13\xdd\x8f\x1d\xbf\x41\x70\x56\xc7\x3c\x03\x0a\xa9\xf4\x5d\x00\xd8\x05\xa9\x18\x48\xdd\x07\xea\xd1\x47\x04\x31\x53\x71\x5f\x4e\x45\x05\x15\x4a\xba\x4e\x1d\xef\x30\x38\x47\xcd\x78\x2a\x70\xaa\x0e\xee\x70\x2d\x11\x3c\x83\xe4\x57\x20\x82\x27\xe0\x29\xf9\x15\x88\xb1\x98\x97\x25\xc0\x06\xff\xa7\xf8\x17\x78\xb6\x0b\xe2\xcb\xc4\x2b\x2a\x0b\x4b\xe8\x4e\xf4\x00\x7e\x3b\x3f\x05\x39\x0c\x9e\xb1\x48\x24\x18\x1d\x52\x08\xf6\xdd\xcc\x3b\x8c\x6e\x59\x52\xe9\xbb\x3f\xad\x16\xfe\x7a\x00\xdf\x2c\x71\x7e\xf4\xf1\xf5\xed\x42\xed\xdb\x17\x5f\x0f\x07\x9b\xf1\x5f\x2a\xed\x0a\x96\x46\xe9\x06\x1c\x43\x03\x9b\x64\x77\x86\xfd\x7b\xa1\xcf\xc3\xf6\x0b\xc9\xce\xce\xca\x23\x70\x8a\xbb\x5b\x2d\x0f\xe7\x12\x78\x43\xcd\xb8\xe4\x5b\xaf\x97\x5c\x4b\xc1\x3f\x82\x3f\xff\xf2\x27\x5d\xaf\x68\x71\x99\x00\x6d\xe7\xee\xc1\x35\xa6\xba\x04\x44\xe5\xdc\x8c\x05\xd7\xdb\x37\x56\x77\xd8\xd3\x89\x66\x1a\xc2\x9d\xaf\xe0\x9f\x0e\x84\xbf\xee\xbf\xde\x6a\x03\x4f\x77\xc1\x06\xae\xb1\x0c\x0e\x10\x67\x3d\xe4\x63\x19\xb0\x60\x3d\x5a\xff\x3e\x78\x52\x43\x58\x01\x80\xdf\x8e\x7a\xb4\x8c\x52\x6f\xfe\x95\x59\x68\x17\x09\x91\x34\x3c\xa0\xbd\xc8\xbb\x28\x63\x4b\x39\x2c\xfd\x4f\x8c\xdf\x5f\x7e\xf1\x76\xc8\x09\x5a\x77\xcf\xef\x00\x08\x94\xfd\x1a\x02\xed\x8a\x86\xf7\x01\x5e\x15\x0f\x1b\x57\x57\xb2\xa1\x72\x1f\x74\xdd\x7b\xf4\x7e\x2c\x37\x21\x28\x9c\x25\x2e\xc4\x3c\xb3\x40\x39\xd0\xaf\x64\xee\xbd\x8a\x86\xaa\xa3\xbb\x3b\xea\x01\xd0\xf7\xe0\xf9\xe5\x5a\x95\x53\x7e\xcf\x43\x1c\xd0\xbe\x84\x73\x53\xe7\x46\x9d\x7a\xb8\x4d\x9f\x52\x98\x99\xd6\x9d\x60\x9a\xaa\x40\x05\xdd\x45\x07\x61\xce\xaa\xe8\xc3\x19\x01\x77\x79\xf4\x08\xa2\xbf\xbd\xeb\xd8\x8a\xba\xd2\x8b\x4f\x57\xcb\xa2\x33\xb4\xa3\xbf\x7f\xc3\x5a\xfe\x2d\x7a\x16\x7e\x8c\xd0\x5d\xc8\x5c\x15\xd2\x3d\xce\x7a\xf1\x11\xa4\xb2\x57\xdd\xf0\xe6\xc2\xd3\x74\x55\x33\x1e\x3d\xd5\x6f\xa9\x19\x6b\x42\xf0\xf5\x08\x66\xd6\x3b\x3c\x39\x5b\xb4\xef\xb3\xe3\xca\xf0\xfd\x8f\xe2\x44\x90\x70\xb7\x30\x26\x17\xbb\xe5\xae\xca\x3b\x04\xdd\xf9\x07\x8c\x0e\x0d\x53\x42\xd6\xa2\xc5\x93\xea\x1b\x8c\x78\x24\x22\x41\x34\xae\x55\xb4\xab\xa5\x6c\xd7\xb7\x6a\x20\x2b\x3a\x51\x54\x1c\xa8\xc1\xa2\x6e\x6b\x7f\xfa\xca\xff\xe5\x1d\xac\xf8\xf1\xfe\xab\xaf\xd6\x1b\xb0\xce\x12\x7e\x0a\x14\x78\xbe\x2a\x07\x00\x56\xdd\x7f\x27\x4c\x45\xdc\x9a\xf0\x95\xbd\x8b\xe2\xd2\xee\xe1\xf6\xbf\xa3\xf7\x0f\x57\x15\x5c\xbd\x8e\x7f\xff\x0a\xe4\xbe\x7d\xb9\xf5\xf6\xe6\xe3\xaa\xd5\xe1\x7f\xdb\x51\x97\xc6\x9d\xc3\x8f\x6b\xbd\xf7\xbe\xbc\x8e\xfd\xb6\xee\x0d\x71\xbd\x61\x11\xff\x4a\x69\xf5\x18\x79\xbf\x40\x54\xdf\xa5\xb9\xe1\x1a\x6a\x37\xa8\xbd\x32\xe4\x3e\x4b\xe7\xbb\xa8\x3d\x7c\x9f\x96\x79\x6f\xb0\xc9\xd4\x06\x56\x29\x44\x19\xf0\x6a\xb0\xe1\x11\xa5\xa8\x2c\xf4\x1a\x09\x97\x1c\xc8\xf2\x56\xce\x9f\x7f\x7d\xfd\xf2\x63\x63\xd1\x32\xf8\x59\xf0\x0c\xfe\x89\x9f\xfe\xfe\xfd\xdb\xf9\x00\xff\xdb\x3f\xfd\x83\xca\xc2\xc2\x76\x10\xb0\x61\xa3\x06\x8f\x19\x3b\x37\x38\x3c\xac\xdb\x59\x1f\xcf\x87\xa5\x83\xd9\xd6\xf9\x98\x47\x10\xd5\xac\x1e\x0c\x64\x5a\xa3\xe1\x11\xa4\xfc\x63\xe8\xeb\x97\x70\x85\x82\x4f\x14\x5c\xab\x90\x33\x3b\x10\xc5\x63\x6e\xbc\x53\xd4\x66\x2b\xa2\x78\x9b\x27\x88\xe2\xff\xfe\xfd\x1b\x3e\x3c\x20\x50\x86\x10\xe4\xc8\xd9\x84\xba\xb3\x2b\x88\x8a\xcd\xa4\xfb\x30\xb8\x2e\x03\xad\xa2\xe1\x5a\xc7\xe5\xa2\x55\xe4\x21\x34\xdb\x61\xa5\x7b\x9c\x21\xbc\x90\xcb\x50\x44\xf1\xd1\xf0\x12\x2e\x57\xc3\x72\xdf\xae\x89\xbc\xa1\x4f\x83\x44\xd9\xaa\x0b\x3b\x7c\xc8\x10\x18\x57\x29\x90\x3d\xeb\xf0\x30\xc8\x9c\x8e\xaf\xce\x76\x24\x0a\x20\xd5\xe1\xcb\x35\xe0\xfb\xaf\x1f\x28\xdc\x70\x59\xa1\x58\x56\x7f\x4f\x58\x70\xfe\x59\x5a\x6e\x14\xb6\xc5\x05\x67\xda\xf2\x82\x9f\xfe\xfe\xfd\x1b\xfe\xb9\x2d\x2c\x4e\xf1\x4f\x49\x8b\x5d\xf6\x7d\x71\xb1\xcb\xbc\x2b\x2f\xb8\xc8\xfb\xb2\x82\x4b\x7c\x20\x2c\xbf\x48\x56\x1c\x92\x3c\xc2\xf2\xaf\x90\x15\xbb\x95\x1f\x10\x96\x1b\x82\x73\x16\x0b\xd7\xda\xf3\x6a\xd5\xf7\x6d\x44\xb7\xe7\xfd\x86\x86\xb3\x78\x7f\x7a\x06\xa9\xfb\x2b\x6e\x61\x83\x5f\x54\x4c\xf8\xf5\x3d\x49\x76\x7d\xff\x96\xe4\xb9\x8b\x93\xdf\xbf\xb9\xcd\xdc\xd6\xe1\xe7\x8a\xb7\xd4\xf8\xb9\xc0\x0d\x4d\x1e\x75\x08\x8e\xde\x52\xe5\x97\x4d\xca\x9b\x0a\x1d\xc4\x6e\x70\xe4\xbf\x01\x79\xff\xae\xb6\xb7\xba\xc2\x9d\xd9\x7c\x20\xae\x19\xf9\xae\xdc\xd8\x52\x13\x32\xf1\xd9\x22\x74\xe6\xc2\x97\xf7\x65\x28\x20\x33\xd7\x6b\xba\x3f\xb1\x67\x07\xdf\xc3\x84\xe7\xf8\x31\x44\x77\xe7\x45\x9e\xa3\x00\x1e\x40\xb0\x84\x85\xf7\xfd\x5f\xb7\x57\x4d\xb2\x6a\x2a\xd6\x2a\xe2\xec\xd8\xf1\x2d\x1c\x2c\xd1\xfc\x1d\xdf\xed\x32\x11\x99\xcd\xdd\x5d\xc0\x90\x04\xe0\xf7\xbb\xe8\x6f\xf6\xa1\xb6\xe8\x7d\x42\x10\x59\x78\x77\xff\x35\x90\x1d\xe2\x71\x8e\xde\x5b\xdf\x42\xf0\x97\x75\x7d\x46\x78\xf5\x02\x9e\xed\xa6\xbd\x2b\x9a\xb0\xb2\x57\x82\x67\x71\xe2\xf1\x0c\xe7\xcf\xe4\x5f\x7e\xc1\xb1\x18\xe2\xc9\x4f\xfd\x75\x63\x1d\x6d\x2d\x7b\x1c\x7f\x34\x78\xbe\x10\xe2\xfa\xac\xa3\xf7\x5f\xbf\x04\x8a\x2b\x10\xed\x55\x7d\x03\x9e\xcf\xdd\xd0\xb3\x53\xee\xce\xb5\xa3\xf7\x18\x23\xab\xf9\x87\x00\xe6\x12\x75\x54\x4d\xf4\x78\x3d\x90\x64\x4d\x57\x77\x90\xed\x38\xf9\xd6\xed\x56\x7e\xa2\xde\x1e\xc2\x78\x10\x04\x64\x08\x14\x76\x02\x46\x59\x15\x45\xdf\xad\xef\xf0\xe8\x5a\x99\x58\x9f\xe4\xf8\xe6\x7e\x40\x0e\xaf\x0c\xd4\x68\xb0\x32\x00\x86\xac\xaa\x48\xf8\x0c\xa2\x9a\x70\x34\x44\x26\xa4\x29\xa8\x58\x5b\x3c\xa1\x30\xac\x81\xcb\xc0\x12\x92\x28\x23\x5d\xa6\x0c\xff\x12\xd8\xfd\x63\xe0\x48\x40\xbe\x63\xa9\x82\x47\x90\x26\x93\x0f\x37\x8a\xe0\x13\x32\x88\x52\xf0\x27\x4c\x12\xa9\x42\x70\x88\x06\x6b\xc9\xd4\x61\x06\x25\x95\xb1\x9c\x67\xa9\x4c\xee\x8a\x76\x55\xda\x41\xfd\x11\x44\x83\x38\x5e\xe9\x2f\x24\xca\xd0\x40\x10\x7f\x49\x25\x41\x66\xaf\xe0\x20\x8a\x16\x25\xf1\xe4\x7c\x87\xef\x9a\xbe\x33\x87\x90\x6e\xc2\x6b\xda\xb0\x2d\x62\xd5\x35\xf0\xd7\x50\x92\x21\xd4\x9b\x1a\x4b\x21\xf8\xea\x5c\x9a\x86\x4b\xbd\x4f\x7b\xe0\xd5\xd2\xd0\x21\x3d\x67\xaf\xbe\xaf\xd3\xcf\xe2\x13\xfd\x2d\x5d\xa0\xf2\x99\x6c\xf4\x23\x56\x5b\xcb\xce\x77\x01\x25\x93\x79\x9a\xe3\x3e\x06\x64\xad\x49\xde\x85\x94\xca\x53\x69\xba\xf0\x31\x24\xcf\x7c\xf4\x2e\x3c\x8e\x63\x52\xc9\x7c\xf4\xf3\x4b\x04\xbf\x32\x71\x14\x49\x42\x55\xee\xa2\x3e\x49\x38\x2b\x9f\x07\x3c\x73\xe9\x94\x6c\x5c\x29\x64\x47\x73\x41\x1d\xef\x34\xe3\xc9\xed\xd9\x2d\x9a\xb8\x08\x05\x20\x80\x93\x86\x54\x44\x49\xf7\x76\x7c\x90\x7f\x3a\x72\x95\x5f\x82\x42\x48\xbf\x8b\xfa\xb6\xe3\xa2\x0f\xe0\x0a\xe6\x3d\xfe\xac\xe6\x5d\xd4\xba\x3a\x33\xfa\x00\xfe\xf9\xfb\xb7\x0b\x12\x6f\x7f\xfc\xf3\xfe\xeb\x67\xe8\x65\x60\x80\xe2\xd7\x33\xfc\xaa\xaa\x60\xc3\xfc\x2e\x84\xe2\x0f\x50\xc5\x03\x20\x80\x5d\x14\x7f\x08\x27\x1a\x98\x80\x6f\x4f\x56\xd7\x13\xdb\x0d\x0a\x5c\xdc\xe1\x9d\xd5\xe8\xd7\x2f\xde\xf2\x01\xa9\x62\xa1\x81\x74\xf5\xf8\xab\x26\xdf\xe0\x84\xfa\x16\xf0\x15\xdf\xf2\x7a\xf4\x54\x54\xc7\xe1\x65\x37\x1d\x1f\x91\x27\x21\xf5\xd2\x57\x55\xcd\x48\x80\xaa\xb5\xdd\xb9\x51\xd4\x3d\xd8\x0b\x50\x87\x00\x09\x14\x02\xa2\x81\x37\x89\x53\x2f\x91\x77\x1b\xf2\x85\x90\xbc\xe3\xff\x0c\xde\x18\xf9\xc3\x5e\x16\xbc\x04\x1d\x5b\xfb\x7c\x0f\xef\x7a\x5e\x3e\x76\x60\xba\x77\x21\x5e\x79\x30\x1d\x5f\x1b\x23\x98\xca\xe6\xee\xe2\x1d\x79\x00\xe4\x77\x7b\xdc\xce\x11\xcb\x37\x58\x13\xbc\xa2\xee\xa7\x9c\x4f\x8f\xc0\xde\x2f\xbc\x92\xc9\x4f\x78\xe4\x02\x17\x79\x3c\x82\xe4\x97\x2b\x3b\xee\x5d\x96\xfa\x2f\x85\x0b\xf0\x14\x5b\x38\x67\x46\x06\xef\x54\x09\xcd\xf8\xd3\x4a\x0d\x60\x15\xd8\x9c\x39\x13\xf2\x1d\x10\x12\xde\xad\x6e\x10\xee\x66\x0d\x03\x37\xf0\x55\xbb\x5e\x71\x43\x24\xa8\xac\xaf\x47\x42\x2f\xf9\xb8\x72\xdf\xd9\xa7\x0d\xf1\x0e\xb1\xb5\x29\xae\x1b\x78\xf6\xbe\x23\xfe\xdf\xbb\xff\x65\x63\xf7\xff\x6b\x10\x09\x78\x80\x8c\x97\x77\x56\x79\xbc\xe0\xbc\xff\x1a\x60\xb0\x07\xd4\x0b\xc8\x14\x8b\x37\x98\xe5\xdc\x82\xe1\x1c\xc8\xfd\xfa\xe5\xca\x3a\xbf\x82\x45\x7e\x04\xcb\x3d\x18\xf4\x19\x60\xe9\x8f\x80\xe1\x70\x92\x4f\x41\x4a\x7d\x04\xc9\x30\x19\x06\xcf\xab\x21\xc0\xde\xad\x76\xbe\xeb\x26\x5c\x4e\xde\x1e\x3c\x03\xc9\x7f\x5b\x4a\xb8\x22\x91\x29\xcd\xd3\x83\xe7\x3a\x0f\xe0\xce\x7d\xb6\x26\x81\x7f\xfe\xfe\xcd\x7b\xc4\xfe\x0d\xdc\x79\x12\x6c\xca\xdf\xee\xff\x79\xef\x9c\x85\xfb\x5f\xc5\x3b\xd3\x79\x70\xf2\x5f\x00\x79\x07\x77\x50\x09\xec\x9c\xfc\x6e\x27\x26\xec\x7b\x3e\xec\x49\x14\x1f\xf2\x72\x3f\x9d\x1b\xc5\x46\x3a\x43\x49\xf0\x2e\x7d\x1f\x05\x6f\xe1\xcd\x04\x6f\x9a\xfc\xb9\x86\x52\xb7\x1b\x0a\xb9\xb0\x32\xac\x2d\x37\xc4\xc4\xfe\x18\xe4\xf3\x75\xdb\x92\x6a\x40\x03\xdd\x45\x6f\x7f\xbf\x38\x1a\xb0\x72\xdf\x47\x3e\x6e\x5f\xc4\x1c\x7d\x04\x77\x4e\x49\x0c\x78\x01\xe2\x17\x34\x12\x2a\xc7\x19\x10\xdd\xdd\x27\xf0\xc7\x07\xef\x01\xe1\xc9\xb2\x16\x2d\x77\xf7\xce\x2a\x0d\xc4\x40\xf4\x0f\xeb\x6e\x20\x2f\xb0\x65\x38\x30\xa4\x6a\x7e\x58\xf6\x8d\xe7\x7e\x60\x37\xf9\x19\x72\xe1\x66\x18\x3f\x1d\x2c\x74\xeb\xb7\x0a\x39\xca\x94\xd0\xb5\x69\x2f\xe3\xea\xee\xe4\x65\x71\x3d\x12\xfc\x7c\x61\xc4\x57\xc9\x57\x01\x9f\xef\x62\xef\xa2\x09\x2b\xd1\xbe\x9b\x29\x7a\x6f\xf9\xae\x3d\xe3\xc5\xd4\xa5\x8f\x21\x78\xba\x13\x87\x59\x45\xef\x9d\x55\x23\xbe\xda\x26\xfa\x70\x0e\x98\x0a\x4c\x09\x58\xa9\xdf\x7f\x0c\x3c\x20\x30\x67\xe0\x86\xce\x44\x1f\x40\x18\x4c\xa7\x00\x25\x21\xb7\xc0\x27\x48\xb1\xde\xee\xa2\x78\xc9\x17\xbd\xdd\x75\xde\xeb\x80\x7e\x6d\xbf\xb1\x1e\xc8\x91\xab\x1a\xba\xb5\x97\xe4\x2e\x6f\x44\x09\xde\x45\x3f\x73\x8a\xe9\xfd\x03\x4c\xfe\x11\x87\x1d\x2c\x33\x13\x06\x9c\x71\x56\xec\xda\x95\x5d\xe6\xc0\x79\xf4\x70\xd7\x49\x7a\xcf\xc0\xd5\xa1\x62\x7d\xc1\x55\x87\x46\xc2\x7e\xf6\xe7\x63\x2d\x2b\x32\x23\x2b\xa7\xae\x18\x76\xc1\x40\xa2\xcf\x5e\x48\xfc\x6e\xf9\xda\xee\xa2\x3e\xee\x85\x7d\x5d\x37\x7a\x35\x5f\x87\x4c\x09\xfe\xe5\x50\xf0\xec\xd9\x7d\x88\x4d\x78\x2e\x33\xba\xd5\x3f\xd7\xc7\xdd\x82\xe7\xd7\x3e\x3e\xba\xe6\x47\xfe\x56\x4f\xdd\xea\x2b\xcf\x3c\xf9\x18\x46\xde\x47\x16\xfa\xa5\xd7\x2e\xb4\x86\x76\x5f\x68\x07\x7a\xea\xdc\xee\xc9\x0f\xfb\x32\xc0\x91\x20\x43\x6e\xeb\x8d\x73\xc5\x50\xf7\x68\xe8\x72\xe4\x73\xc0\x42\xcc\xd7\x7f\xbd\x9e\xdd\x89\x86\x88\xec\xe3\x9d\x76\x18\x75\x50\xd3\xde\x86\xe7\x17\x79\x67\x6c\x0c\xa8\x6b\xb1\xbe\xd1\x30\xdc\xc7\x75\x6a\x7f\x1e\x54\xe1\xcd\xfb\x14\xfd\x55\x43\x3f\xd7\x03\xb7\x51\x78\xbf\x2b\xfc\x84\xe3\xf3\xb8\xdf\x4f\xb5\x0e\x0d\x4d\x55\x8c\x8f\xb9\x7e\x06\xff\x6b\x48\xbd\x6e\xf7\x7b\x68\xb5\xc7\x28\x64\xbf\x93\x5e\xa7\x56\x9c\x55\xe5\x0f\xe9\xf5\x35\xf1\x0b\x68\x0e\x6d\xfb\x7b\x86\xda\xad\x59\xfc\xf3\xee\x02\xff\xb4\x71\xdb\xa5\x12\x76\x73\xde\x0f\xfb\x0f\xce\xf3\x69\x68\x5c\x4a\x88\x79\x1b\x7e\xfb\xdc\x95\xd5\x6f\xa7\x27\x44\x05\x07\x34\x1b\xd0\x18\x43\xc6\xc4\xae\xd6\x5b\x96\x9b\x73\x59\xcd\x6d\xcb\xcd\x03\x94\x85\xdf\x05\x34\xd4\x4a\xbd\xb6\xd7\xa2\xd1\x1f\xea\xb5\xe0\x04\x71\xbb\xdf\xc2\x2f\xb3\xfb\xe1\x9e\xf3\xcc\xad\x9f\x8f\x8a\xba\x3e\x7d\xfd\x9e\xef\x2e\xe4\x7e\xb4\x1f\x46\xd7\x69\xf4\xbb\x91\x0d\x3d\x8f\xfd\x0e\xd2\xef\x5c\xc1\xf5\x13\xac\xb6\xa1\x7d\x37\xf2\x48\xfa\x18\xe1\xe0\x5d\x4f\x3f\x8c\x25\x92\x8c\x5b\x9e\xc0\x30\xc7\x1d\xbe\x68\xe3\x96\xbb\x2e\x78\x5d\xc5\x79\x7d\x7a\xe3\x86\x8d\xf7\xfc\x73\xc1\x2a\x7f\x26\xff\xfa\x60\x24\xe2\x38\xf6\xef\xf3\xb9\x79\xae\x98\xc1\x1b\x61\xa1\xfe\x18\xbc\x76\xbd\x94\x48\x20\xf5\x75\xdc\xb7\x1d\xca\x77\xf7\x9e\x43\x24\xc9\x07\x90\x4a\x7e\xb7\x02\xf7\x9c\x85\xfb\x30\xba\xf2\x5f\xe2\xf3\x75\xb0\xfb\xf2\xc5\x1d\x6b\xc8\x8d\x7a\x07\xcf\xe0\xdb\xb7\xc4\xdb\xdb\x57\x4f\x96\xb3\xdb\xfe\x77\x02\x1e\x10\xb4\x0e\xa3\x87\x9c\xff\x78\x00\xdf\x00\x63\xea\x3a\x54\x90\xf5\x45\xad\x47\xb0\x17\x15\x56\xdd\x9f\xef\x69\xb4\xe2\xdf\xce\xee\x06\x1b\xb2\xfd\xd5\x29\x67\xd7\x7c\x66\x42\xab\xa6\x7e\x36\x18\xac\x6c\x4c\xe6\x99\x98\x6f\xd6\x91\xa0\x47\x10\x25\xa2\x0f\x80\x92\x44\xca\xc0\xcf\x21\x9f\x5c\x8f\x3e\x80\x33\xc3\x1f\x3f\x17\xa5\x7e\xff\x70\x66\xde\xcd\x78\xcc\x77\x62\xee\xc1\x9b\xd7\x26\xb9\x20\xea\xff\x76\xfb\x67\xf0\xba\x44\x8a\x07\x51\xf2\x62\xf0\x41\x83\xb6\x04\xbd\xdb\x5c\x30\xd0\xf7\x27\x5a\xb3\x23\x1c\xde\x6b\xec\x12\x61\xfb\x6e\x33\x0f\xbf\x9e\xf5\xd6\x51\xa2\xf7\x19\x81\x4b\xfc\x8b\x70\x7b\x70\x4f\x36\x59\x65\xac\xe7\x1b\xe8\xfe\xf7\xbb\x38\xfa\xf6\xea\xee\xcf\x8a\xe6\x2f\xdf\x50\xde\x51\x3a\xa0\x34\xed\x32\xa0\xce\x43\xc9\x8a\xb9\xfa\x8d\xd2\xb4\xa8\x77\xbf\xc7\xc6\xea\x93\x9a\xc5\x1e\xac\x8f\xce\xef\x59\xc1\x05\x4f\x51\x7a\xce\x80\x5a\xab\x5c\xc0\x51\x2c\x8c\x00\xbc\x3b\x8a\xf7\x56\x9e\x23\xf1\x94\x7b\xe8\x93\x15\x29\x49\xe5\xc3\xbe\x1a\x65\x1f\xba\x0e\xb8\x08\xaf\xcf\xce\xda\xa6\xaa\x0d\xc6\x5e\x61\xc7\x0f\x52\xe8\x09\x5a\x3b\xd3\x39\x05\x77\xe3\xaa\x21\xbb\x8c\xbd\x6c\xf4\x9f\x6b\x15\xb2\xfe\x32\xf6\x05\xf1\x81\x8b\xe0\x2f\x67\x98\x43\x2f\xd9\xb7\x5c\xcb\xce\x97\xb6\x58\xd1\x90\xc5\x33\x38\x87\x01\x56\x64\xdc\x73\xa4\x62\x95\x0b\xfb\x5e\xd6\x35\x9b\x5e\xfe\x61\xc5\x92\x7c\x0d\xfb\x6a\xd6\x47\x37\xe8\x5f\x11\x1e\xf8\xc6\x81\xe7\x82\xf2\x9b\x17\xaa\x07\x1c\xaa\xf6\xc7\xff\x6f\x7c\xaf\x2a\x62\x7f\x98\x29\x62\x7f\x96\x13\x7f\x79\xe1\xdd\x2f\x7b\x5d\xa1\x77\x75\x7f\xfa\x07\xfc\x76\x8f\x7f\x9f\x37\x6a\xc2\x79\xff\x62\xf1\xfb\x03\x76\x85\x9f\x1d\xb6\x1e\x7e\xad\xc8\xfb\xbc\xab\xff\x57\xde\xff\xcd\xf2\x1e\xfc\xd8\x5a\xf8\xdd\xd7\xf6\x51\x79\xd7\x3e\x03\xd6\x3d\x70\x8f\xfe\x93\xf2\xe0\xea\xfa\xd5\xf0\x7b\xc4\x3d\x97\xad\xdd\x40\xdb\x6d\xcc\x76\xb0\x00\xc7\x9a\xbf\x6a\x2e\xa4\xb1\xc0\xdd\xf6\x57\x4d\xfd\xd0\x80\xfb\x50\x23\x04\x2f\x5d\xb8\x72\x05\xde\xf8\x50\xc0\x8f\x42\x0f\x75\xb6\x39\x1f\x40\x18\x51\x7b\x97\x61\xbf\xae\xa5\x80\xaf\xcb\xd3\x94\xdb\x49\xbf\xa8\xad\x6b\x1f\x93\xdb\x96\x93\x03\xaa\xfd\x6e\xb0\xad\xff\x00\x85\xf8\x44\xd8\x97\x09\x7e\x79\x22\x04\x24\x4b\x2f\x5f\xfe\xbf\x01\x00\x40\x3b\x10\x8d\x5b\x9b\x00\x00")
 
 func staticReport_templateHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -84,12 +105,12 @@ func staticReport_templateHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/report_template.html", size: 31308, mode: os.FileMode(420), modTime: time.Unix(1558255764, 0)}
+	info := bindataFileInfo{name: "static/report_template.html", size: 39771, mode: os.FileMode(420), modTime: time.Unix(1792325104, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _staticWappalyzer_fingerprintsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\xeb\x7a\xdb\x38\xb2\x30\x8c\xfe\x7f\xaf\x02\xed\xb7\xf7\x1a\x29\x09\xc5\xe9\xee\xb5\xd6\x4c\x6b\x39\xd6\xeb\x53\x0e\x33\x71\xe2\x89\x3d\x9d\x9e\x6d\xda\x7e\x40\x12\xa2\x60\x93\x00\x1b\x00\x25\x2b\xa3\xd9\x17\xb2\xff\xed\x9f\xfb\x36\xbe\x4b\xf9\xae\xe4\x7b\x70\x20\x45\xc9\xe2\x49\xa6\x6c\xa7\x47\x3f\xba\x63\x49\x24\x50\x28\x14\xaa\x0a\x75\xbc\xf8\x5f\x00\xfc\xf3\x7f\x01\x00\xc0\x0e\x81\x11\xda\xe9\x83\x9d\x1f\x0e\xad\x03\x2c\x18\xbe\xdb\x79\xa5\x7f\xf0\xa0\x40\x01\x65\x18\xf1\x9d\x3e\xb8\x50\xdf\x01\xb0\x73\x78\x72\xb6\xa3\xfe\xbe\x34\x8f\x8d\x10\xf4\x11\x93\xcf\xfc\x33\x7d\xe6\x0c\x09\xeb\x90\xd2\x5b\xac\x06\x3e\x78\x7f\xfe\xf9\xfd\xaf\xd7\x66\x58\x00\x76\x7e\xb5\x4e\xe9\x04\x31\xe4\x5b\x72\x30\xf9\x84\x9a\x17\x9c\x61\x81\xc0\x09\x24\x30\x40\x4c\xcf\xf1\xaf\x74\x0e\x11\x85\x79\x20\x3a\x83\xfe\x6e\x88\xc9\xed\xc5\xd5\xde\xe5\x4b\x8f\x46\x31\x25\x88\x08\x6e\xbb\x6a\xa0\x59\x67\xd0\xe7\xcc\x9b\x8d\x18\x1a\x76\x5f\x3b\x3b\xe6\x6b\xbb\x33\xe8\xdf\xf0\x99\x40\x51\x1c\x42\x81\x78\xb7\xbb\xb8\x10\xee\x31\x1c\x8b\xfc\x34\x3f\x78\x96\x7e\x75\xf1\xc1\x08\x09\x98\x2d\x37\x05\x11\x47\x71\xb8\x88\xaa\x14\x1d\x39\x1c\x9f\xbe\x3b\xcd\xd0\x50\x84\x61\xf5\xcb\x29\xa3\x01\x83\x51\x84\x49\x00\x3e\x40\x12\x24\x30\x40\x7c\x27\x7b\xe2\x32\x37\xc8\x7d\xfc\x9b\x3d\x60\x63\xc4\xe4\xa4\xf1\x28\xb6\x07\x9d\x0b\xc7\xf1\x7b\x97\x2f\xbb\x83\x1c\x00\x0b\x7b\x71\x30\x95\x0f\x5f\x2d\x3f\x9d\x3d\xfc\xaf\xfc\x9c\xe9\x7e\xac\x84\x28\x87\xc8\x95\xbf\x2f\xe0\x6f\x79\xe8\x3c\x1e\x57\xbe\x3d\x41\x2e\xc7\x42\xa1\x73\x24\x44\xdc\xb7\xed\x78\x14\xf7\x08\x12\x29\xa8\xff\x5a\xd8\xac\xfb\x8f\x4f\x26\x93\x5e\xb6\xb3\x3d\x96\xc8\xf7\x14\x04\x4b\x07\xe2\xe7\x1f\xf6\xe3\xb8\xfc\x30\x1c\x7b\x34\x8a\x10\xf3\x50\xf9\x91\xb8\x47\xc6\x15\x74\x27\x01\xe5\x8e\xd3\x77\x1c\xdb\x71\x6c\xc1\xa0\x77\xeb\x38\xbd\x9f\x7f\x80\x71\xec\x38\x3d\x4c\x73\x5f\xde\x70\xc7\x19\x34\x24\xcf\x32\xfc\x70\x83\x20\x35\x59\xcf\xa3\x91\x5d\x84\x9e\x9f\xfc\x43\xc8\x44\x35\xb3\x78\xd5\x10\x59\x2b\x88\xf2\xa7\xa3\xc3\xfd\xcf\xe7\x05\x2c\xa1\x02\x97\x9d\x41\x5f\x4c\xc2\x91\xfc\x47\xa2\xac\x3b\x70\x9c\x1e\xe4\xf1\xec\x27\xff\x3a\x89\x39\x0a\x43\x85\xc4\x6e\x8b\x28\x34\x18\xfc\xc9\xf7\x20\x13\x12\x85\x45\x18\xdc\xb7\xde\x30\xf9\x67\x29\x0a\xff\x02\xc7\xf0\x4c\xad\x0a\xbc\x65\x30\x1e\x61\x8f\x37\x24\x36\x33\xd0\x2e\xb4\xb8\x87\x08\xba\xb8\xda\xdd\xbb\x7c\xb1\x57\xc5\xfb\xf2\x4c\xc0\x86\x43\x09\x68\x67\xd0\x77\x9c\x5e\x84\x89\x42\xe2\x0d\x6f\x8b\x2b\x8a\x11\x43\x48\x8e\x57\x8b\x35\x16\x22\x64\x99\x51\xac\x62\x8c\x6b\x31\xb1\xdc\xe4\x0a\xd4\xd5\x78\x58\x1e\xa0\x55\x2e\x27\x8f\xa5\x9a\xfb\x86\xf7\x28\x0b\x6a\xb1\x3a\xf9\x8e\xde\xb8\x1e\xa6\x85\x34\x78\x04\x8e\x0f\xf0\x59\x39\x0d\xee\x13\x18\x4e\xc5\xfa\x94\xf7\x9d\x65\xa9\x59\x80\x47\x89\x90\x82\x1a\x08\x18\xcc\x19\x83\xfc\x5d\xfd\x7c\xef\x5b\x70\x0e\x83\x3c\x7c\x4b\x83\x45\x11\x25\xea\xa5\x22\x5a\x6e\xfd\x50\x23\x17\xf3\x1e\x41\xbd\x9b\xb8\x10\xa1\x9f\x3e\x70\x2d\x7a\x4b\x51\xfa\x05\xb9\x40\x8b\xe8\x0a\xa4\x2e\x88\xf2\x6c\xf0\xfb\x22\xba\x36\x5f\x6c\x15\x27\x90\x86\x1a\xa0\x52\x3e\x77\x0e\xde\x13\x81\x18\x41\x02\x28\x52\xfa\x5a\x85\x9d\x75\x09\xee\x71\xd6\x2c\xb0\x59\x8d\x92\x8f\x88\xd4\x59\xf7\xaf\xf8\x1c\x3f\xee\x9a\xcd\xb0\x77\x58\x60\xc7\x51\x90\x8e\xb0\x70\x9c\x9e\xfc\xa2\x5d\x69\x57\x1f\x21\x5f\xce\x04\x14\xfc\xb9\xec\x3d\x00\x3b\x01\x22\x88\x41\x41\x59\x0e\x3c\x90\x1e\xad\xce\xa0\x0f\x1c\xa7\xe3\x26\x38\xf4\x81\xf9\xce\x71\xba\xdd\x41\x77\xa7\xb9\x90\x3b\x45\x2c\x7c\x7c\xdd\xdf\x71\x5c\x39\xb1\xe3\xb8\x72\x31\x03\x7b\x30\x9e\x33\x8e\x6f\x44\xb9\x47\x2c\xac\x2d\xf2\x24\x31\x4e\xb8\xdc\xc4\x1e\xa7\x09\xf3\xd0\x90\xb2\x00\xa5\x97\x83\x95\x24\xe9\x79\x28\x94\x24\x80\x7c\x70\x42\x5d\x1c\x22\x70\xaa\xf0\x5d\x57\x29\x53\x2a\xdc\x84\xb2\xdb\x75\x85\xa3\xfc\x42\xde\x63\x5f\x80\xce\xa0\x0f\xa3\x78\xf6\x7f\xff\x7f\xff\x7f\xc5\x37\xd3\xf6\x0e\x6e\xaa\xe9\xc3\x28\x8e\x19\xbd\x41\x9e\x48\xd1\xbc\x12\x4f\x5f\x13\x56\xa1\xa9\x9e\x42\x58\x6d\x1a\x80\x72\x1c\x8b\xa1\x00\x53\x62\xc6\x26\x49\x18\xbe\x5a\xfc\x5d\xc2\x59\xf2\x6b\x48\x45\xf1\xaf\x52\xa4\x62\x4a\xcc\x8f\x4f\x25\x27\x94\xf2\x25\xe1\xe9\x45\xd8\x63\x94\xd3\x61\xf9\x55\x40\x3e\x0a\x0e\x8f\x3e\x56\xdc\xa7\x8e\x3e\x56\x62\x98\x67\x87\xff\xaa\x33\xe8\x1f\x1f\xee\x7b\xde\xec\xf8\xf0\x6c\x76\x7c\x78\xd4\xcd\xdb\x60\x8e\x0f\xad\x23\xe4\x26\xc1\xf3\x44\x94\x8d\x88\x95\x70\x5b\x2e\x06\x7b\x88\xdb\x9e\x4f\x0a\x6f\xa2\xfb\xde\x6f\x09\x86\xe0\x30\xa4\x89\xdf\x02\x89\xfe\x6a\xed\xbf\xb3\x8e\xc9\x18\x33\x4a\x22\x44\x84\x42\xa5\xe3\x4c\x5e\x7e\xbf\x51\x4d\x4b\xcf\xbe\x42\x72\x1c\xb1\x24\x86\x35\x65\x47\x66\x9d\x5b\x66\xac\x05\x92\xe2\xf8\x2e\xc6\x4c\x0d\xb3\xf3\xc3\xcf\xe0\x23\x1d\x83\x1f\x7e\xfe\xd3\x9f\x97\x4d\x44\x1a\x02\xeb\x10\x7a\xa3\xe5\x43\x67\x9e\x78\x9b\x97\xa3\x57\xfa\x79\x75\x4d\xe2\xf5\xc5\x4d\x6e\xc0\xdd\xce\xa0\x1f\x62\x72\x3b\xe3\x62\x1a\xa2\xae\x32\xf3\x39\x3b\xb6\xa4\x1b\x6e\x77\x06\x7d\x1f\x0d\x61\x12\x8a\x19\x0c\xc3\xae\xfc\x2c\x46\x28\x42\x7c\x16\x51\x3f\x09\x11\xef\xda\xab\x71\xb0\xfa\x4a\xe7\x2b\x60\x9b\x5c\xe4\x96\xf5\x86\x66\xeb\xbd\xbf\xe7\x8b\xfb\xbe\xb0\xf7\x8b\x06\xc3\xf2\xcd\x07\x35\x94\x87\xa5\xb5\x81\x62\xd2\x00\xa0\xb6\x11\x11\x80\x46\x86\xc4\x25\x84\x80\xd5\x3a\xc7\x2a\x48\x57\xe9\x1e\xab\x9e\x5b\xb1\x63\xf7\xa7\x5c\xa9\x8b\xac\x1a\xad\xd2\xe0\x08\x32\xb5\x04\x54\xdf\xe2\x35\xb9\x2d\x68\x34\xaf\x8a\xcf\xfe\x7e\xac\xce\x5c\xad\xb3\x7f\xef\x4a\xb9\x0c\x4d\xa5\xb6\xd8\x19\xf4\xf5\x84\x9d\x41\xff\xfb\x99\x9d\x6d\xde\xec\xe2\xca\xb6\x2e\xbb\xb3\xce\xa0\x7f\x35\x73\x1c\xb7\xfb\xee\xfc\xfc\xf4\xa8\xfb\x2d\xa8\x8f\x50\xad\xa7\x2e\xba\x4f\x11\xf3\x28\x81\xf5\xf0\x7d\x04\x05\x74\x21\x6f\xa2\x9b\x3f\x53\x3c\xa5\xaa\x60\xac\x97\x9f\x2a\x2a\x55\xd8\xda\x8f\xe0\x57\x4a\xc0\xf1\xe1\x8f\x8f\x45\xa0\x8e\xd3\xd1\x93\x3a\xce\xd3\x52\xdf\x1c\xba\x02\xc6\x6d\x70\x93\x2e\x59\xea\x30\x0d\x19\x79\xa6\xaa\xac\x00\xb4\x04\x5b\xdf\x34\x6f\xe5\xfa\x02\xd7\x83\x0a\x7b\x99\xfb\x21\x9b\xa6\xee\xa1\x5f\x1c\x02\x79\x3f\xda\xb5\x2d\xa6\xea\x4a\xa4\x14\xca\x52\xef\xc7\xbe\x27\xac\x4f\xa4\x5c\xdb\x3c\x81\xec\x16\x09\x29\x89\xf7\x13\x41\x23\x28\xe4\xb5\xa4\x54\xfb\x7c\x22\x83\x96\x27\x2c\x8d\xaa\xc2\xd5\xfa\xef\xc9\x10\x13\x2c\xa6\x15\xb6\x1b\x7f\x8c\x98\xc0\x5c\xae\xf9\x23\x12\xeb\x5c\x8b\x8b\x56\x6c\x66\x80\x3e\x36\x90\x68\xa3\x96\xe3\xf4\x60\xd2\xb2\x39\x2b\x9b\x42\x4e\x60\x86\x5f\x8d\x95\xcf\xb8\xd2\xd0\xdb\x02\x4a\xcc\x48\x9d\x41\x7f\x17\x45\x2e\xf2\x95\x3a\xac\x7d\xdc\xaf\x1d\x4d\xb8\x83\xbe\x6d\x47\x23\xc7\xf1\x95\x7b\xcb\x67\x12\x2e\xc7\xe9\xb1\xc4\x9e\x0d\x43\xc8\x47\x63\xc8\xf8\x6b\x67\xe7\xe2\xca\xd9\xb9\x7c\xd1\x19\xf4\xd5\x52\xff\x5f\x3f\xed\xdb\x52\x7b\x86\xfe\x4c\xbf\xdb\xbd\xf7\xb2\xf9\x74\xed\x42\x42\x10\xeb\x76\x67\x52\x33\xef\x0c\xfa\x58\xf9\x15\x66\x38\x0a\xb4\x72\xce\x99\x37\x83\xea\xaf\xd4\xe9\x9e\x81\x05\xfd\xe5\x51\x2b\xfd\xee\x0a\xa6\xf4\x05\x8f\x32\xe4\x38\x3d\xc7\xf1\x95\x8e\x3e\xcb\x06\xee\x0c\xfa\xc6\x9f\x30\x93\x2b\x80\x5c\x20\x26\x97\x71\x6f\x15\x2d\x3b\xf7\xcc\xd8\x25\x9e\xe3\x7d\xff\x33\x0d\xc3\xa7\x3e\x2a\x12\x8b\x33\x6e\xb0\x41\x95\xa3\x33\x13\xec\x2d\xe2\x82\x86\x61\x39\xeb\xf0\x20\x1f\x3d\x35\x2e\xae\x2e\xae\x1c\xc7\xbe\x7c\xa1\xa8\x46\xff\xfd\xd2\x71\x7a\xdd\x01\x54\xe0\x19\x03\xb9\x3c\x53\xea\xd5\x19\xf4\xd3\x4b\x64\x7b\x98\x92\x13\x95\x63\xca\x3f\x1b\xd1\x38\x96\xeb\x2c\x45\xd7\x17\xec\x07\x48\xb4\x8c\x21\xcf\x27\x8e\xd3\xe3\x23\x1a\x3b\x4e\x2f\x46\xf6\x44\x4d\xd2\x32\x12\x94\x70\xf5\x7d\x6e\x96\x59\x81\x8d\xf3\x11\x7e\x0a\x4c\x40\xdf\x17\x23\xcc\x0d\x4d\xdc\xf0\xcd\xe0\x40\x4e\x51\xb5\x7e\xba\x4f\x2a\x04\xee\xc6\x10\x40\x21\x31\x12\xd6\x8e\x10\x49\xec\x18\x06\x68\x1d\xa7\x7f\x4d\x64\xc8\xe9\xca\xb1\x11\x61\x52\x25\x6a\xd3\x0b\x59\x1a\x43\xb6\xa6\x9c\x35\x73\xed\xda\x70\x0f\xec\xf2\x18\x12\xe0\x85\x90\x4b\xf1\x69\x4c\xcb\xce\xce\x5e\x76\x35\xde\xb5\xe5\x13\x7b\x73\xc3\x2a\x25\x5e\x88\xbd\xdb\xd7\xce\x8e\x4b\xfd\xe9\xa1\xfc\xdb\x71\x3a\x68\x8c\x88\x70\x9c\xee\xff\x38\x3b\x80\x92\x90\x42\x5f\x0f\x87\x87\xd3\x5f\xcc\xa0\x4e\xe7\x0f\xd9\xa8\x7f\xd0\x8f\x16\x07\x69\x34\xdb\x04\x0d\xda\x36\x1e\xed\xf9\xc6\xa3\x41\x4d\x73\xa5\x1e\x18\x9f\xa0\x00\xb6\x2e\x49\xb5\xc1\x7b\xfe\x9a\xc1\x3b\xd4\xb3\x39\xce\x7c\x25\x85\x1e\x2c\xad\x0c\x02\xe5\xc4\xd2\x3a\xa9\xd2\x33\x5f\xae\x18\xa3\x98\xe9\x5c\x5c\x41\xeb\xeb\xa5\x79\xa5\xf7\x42\xf1\x9a\xef\x5b\x0e\x6c\x33\x28\x2c\xf5\x07\xfa\xd4\x45\xe0\x90\x86\xfe\x9b\x44\xb9\x91\xca\xd9\x2f\x72\x6b\x3b\x00\x01\xd8\x99\xc7\xc4\x1e\xbe\x39\xff\xf4\xd7\xe3\x8f\xaf\x2b\x30\xfb\x9d\x65\x81\xb3\xf3\xfd\xcf\xe7\x40\x8f\x76\x0e\x03\x25\x97\x86\x51\x65\xf8\x96\x37\x84\x37\xf0\xae\xa9\xec\xd2\x6f\xaf\xe0\x14\x87\x6f\x4e\x3e\x3c\x0a\xab\x78\xbe\xc7\x1a\x4a\xd2\x50\x12\x31\x66\xd4\x4f\x3c\xc1\x6d\x8f\x86\xfe\x50\xd1\x89\x35\x84\x11\x0e\xa7\x3d\x05\x72\xcd\x83\xdf\x68\xc0\x62\x62\x3d\x3a\x3f\x29\xa7\xd2\x73\x18\xac\x2b\x12\xab\xa8\xcc\x86\x9c\x23\xc1\x7b\x6a\x29\xbe\x88\x72\x26\x9b\xd6\x4e\x6d\x94\x9a\x51\x7a\x73\x84\x31\xa4\xfd\xfb\xdc\x1e\xa1\x30\xb6\x11\xb9\xfe\xfb\x99\xed\x8b\xc8\xf6\xae\xe9\x18\xb1\x31\x46\x93\x1a\x98\x3b\xbe\x8b\x11\xc3\x88\x78\xf3\xb0\xf3\x52\x44\x56\xc6\xbe\x17\x1e\x64\x1f\x8f\x33\x2d\x42\x5f\xc2\x63\xc8\xa4\xae\x92\x0b\x6c\xf3\xf1\x58\xdd\xa0\x7d\x28\xa0\x95\x05\xb5\x5b\x31\x14\x23\xf3\xd6\xcb\xcb\x1b\x8f\xf5\x2b\x8f\x3e\x12\x9e\xed\x23\x8e\x03\xd2\x58\x77\xd5\x43\xac\x38\xff\x7f\x81\xe3\x9a\x66\xf1\xdf\xed\xf9\xbf\x81\xe3\x45\xd3\x78\x2d\xb9\x9e\x52\x2c\x4c\xe6\x84\x6c\x79\x21\x4d\x7c\x1b\x11\x81\x58\xcc\x30\x47\x96\x31\x64\x58\x91\x22\xc3\x08\x11\x51\x83\x7c\xdf\xd2\x0f\x78\x5c\x11\x94\x71\xec\x63\x41\x5b\x3b\xf6\xc5\xc4\x74\x2f\x96\x2a\x07\x61\x81\x63\x74\xed\xeb\xc2\x3d\xae\x19\xd0\x50\x22\xa2\x14\x59\x27\x09\x7f\xa6\xa8\xba\x92\xa0\x29\x8f\x1b\x18\xd8\x83\x8e\xe3\xf8\x19\xa6\xd6\x46\x54\x94\x70\x34\xc7\x54\x39\x66\x3e\x53\x97\xbe\x43\x61\x45\xaa\xc3\x11\xf5\x12\x49\x97\xca\x8a\x0d\xce\x29\x0d\xdb\x37\x57\x4d\x46\x9d\x41\x3f\x11\x38\xe4\xb3\x31\x62\xb3\x98\xd1\xbb\xe9\x2c\x84\x24\x98\x09\x1a\x63\x6f\x16\xf1\xa0\x3b\x43\xa3\x30\xf6\x47\x22\xea\x56\x5d\x47\xef\xe1\x79\x71\xb9\x9d\x41\x5f\x47\xfa\x3d\x8c\x24\x57\x90\x23\xa3\x2e\x95\x62\xa9\xfc\x08\x1f\x9d\x7e\xd8\xaf\x08\xf7\xd9\x50\x48\xe8\x55\x66\x42\xbd\xb8\x52\x21\x8d\x3d\xe8\xc7\x21\x24\x7f\x32\x17\xfe\xb9\x4c\xba\xca\x19\x5b\xbf\xa3\x8e\xd3\xeb\x3a\xce\x44\xbf\x30\x0e\x24\xfe\xe3\xb6\x85\xbd\x3e\xe1\x0a\x9a\x72\xff\x8b\x3f\x86\xc4\x43\xbe\xf6\xb0\x6d\x2e\xaa\xd4\xbc\x0e\x27\x52\xef\xe6\xcc\x03\xaf\xc1\xc5\xd5\xee\xe5\x4b\x0f\xc6\xa3\x29\x25\x16\x5c\x3d\x6e\xfb\x57\xf5\xad\x00\x5e\x53\x00\x6b\x3a\x91\xbf\xaa\xb8\xd0\x52\x76\x28\x6f\xc1\xe0\x4c\x30\x04\xa3\xa7\xb6\x58\x2b\x1f\x88\x3e\x69\x88\x09\xae\x60\xd2\xe7\x73\x06\xfd\x3b\xe3\x14\xf1\x68\xb4\x81\xcc\xa5\xfc\x94\xe5\xf8\x9a\xa2\x8a\x0b\xf2\x29\x9c\x4a\xa1\x01\x4e\x19\xf5\x10\xe7\x8f\x21\x5e\xd7\xe7\x3a\x53\x54\xe1\x04\xfd\x8a\xd8\xed\x63\x79\xfb\x4a\xac\x2a\x12\x8c\x7a\x46\x95\xfc\xb3\x52\x52\xf1\x0d\x98\x70\xf5\x1c\xa5\x88\x43\x01\x82\xe5\x78\x3b\x08\x69\x50\xc7\x50\xb5\x64\x1c\x3c\xfe\x11\xa8\xc1\xc1\x58\xaa\x4d\x2f\xbb\x4f\x14\xa1\xb9\x35\xa3\x6e\x82\xdd\x2f\x9b\x51\x5f\x15\x6f\xc0\xcd\xdf\x12\xc4\xa6\x8d\xf3\x07\x3f\x60\x97\x41\xf5\xcc\x66\xa5\x63\x6e\xf6\x9b\xdf\x24\xa4\x17\x3d\xeb\xd2\x6c\xc6\x0b\xc7\xf1\xbb\x17\x57\xf6\xa5\x36\x79\x2e\x6e\xe3\x3c\x04\xcf\xd6\x2f\xde\x4b\x3d\x7c\x75\x7f\x70\x63\x3c\x55\x8f\x0e\xc6\x88\x75\x06\x7d\x8e\x29\xe9\x0e\x5e\xaf\x8c\x4d\xdd\x68\x54\x99\x81\xa8\x81\xd0\x76\x43\x1a\x20\x12\x60\x82\xca\x1c\xec\x43\xca\x05\x6d\x25\x37\x7b\xc5\x61\xd1\xa3\x83\x33\x08\xcf\xc0\xc1\x2f\x1b\x65\x29\x55\xbc\x55\x41\x52\xca\x5b\x87\x02\xb1\x83\xa4\xc2\x4d\xb8\x6e\x9e\xba\x79\x7d\xd7\xf7\xf7\xce\x47\x98\x83\x4f\x24\xc4\x04\x9d\x09\xca\x10\xc0\x1c\xb8\x8c\x26\xc1\x48\x00\x41\xc1\x94\x26\xc0\x9d\x82\x5f\xf0\xbe\xa5\x1f\x02\x6f\x23\xf7\x1d\x50\xe0\xb9\xc9\xd4\x71\x7a\xe0\x3d\x19\x52\xa6\xa3\xa0\x00\x24\xbe\x4a\x06\x65\xd8\x4d\xf4\x17\x02\xe4\x84\xb1\xd4\x7b\xe6\x6f\xfa\x68\xd7\xf6\xfd\xca\x8c\x65\x3e\xa2\xb1\x25\x95\x3c\xec\x2d\xbf\xbf\x01\x1d\xc9\x0c\x6f\x06\x5f\xb9\x35\x23\x5a\x15\x2e\xf5\x6c\xd3\x1c\xe5\x3e\x04\x58\x8c\x12\x57\x1b\xaf\xc8\x2d\x24\xc8\x86\x72\x45\x45\xab\xc5\xcc\x83\x55\x41\x2f\x1f\xf0\x18\x81\xc3\x11\x14\x9b\xba\xd0\xea\x28\x06\xa8\x61\x51\xb5\x12\xda\x0e\xe3\xd0\x43\x97\x65\x54\x63\x86\x2a\x2c\x4d\x75\xd2\x78\xe6\x82\xfe\x4a\x8f\xf8\x84\x6c\xe8\x8e\x58\xd6\x9f\xff\x08\x7f\xf3\x7e\x84\x3d\xf9\x77\xfc\x03\xc4\x85\xcb\xbf\x85\x11\xac\x48\x75\xad\xb3\xfe\x5f\x2d\x3d\x92\x75\xce\x20\xe1\x92\x73\x20\xff\x89\x53\x95\xe4\xf6\x2b\x98\x4a\x19\xf2\x2d\x4c\x88\xc0\x24\xa8\x38\xfa\x9e\x47\xcd\x73\xb5\x30\x61\x9e\x95\xe4\xf0\x86\x21\x04\xe6\xef\x83\x33\x3a\x14\x13\xc8\x50\x91\xf2\x9b\x32\xf1\xac\x60\x0e\x4c\x87\xb3\x02\x86\x90\x3c\x30\x1e\x9f\x6b\x12\x3b\x46\x18\x82\x83\x29\xc8\x26\xee\x83\x5d\xa8\xaf\x22\x23\x86\x86\xf9\xf0\xbe\xce\xa0\xaf\x19\x76\x77\x90\x8d\xab\x6f\xa6\x6a\xae\x8d\x87\x30\x7c\x80\x0c\x8e\x51\xcd\xf4\xa8\x55\xfe\x5a\xf0\x3c\x6d\x22\x73\xa8\xb7\xa9\x41\xdf\x4a\xf8\xfa\x03\x53\x83\x42\x4d\xca\xb5\xb5\x65\xae\x19\x92\x3e\x73\xe5\x3c\xe9\x16\x82\x77\xe7\xe7\xa7\x8d\xa2\x19\x5e\xe5\xbf\x6f\x5e\xab\x02\xde\xde\x42\x4b\x82\xd9\x19\xf4\xed\x42\xe7\xcf\x63\xb3\xef\x5b\x58\x26\xba\xc3\x80\x86\x18\x82\xcf\x08\x86\x02\x47\x08\x9c\x21\xc8\xbc\x8a\x90\x1b\xfd\x0c\x38\x56\x77\x96\xe7\xa7\xc9\xa5\xfa\xaa\x5e\x5a\x29\x95\x84\x21\xc0\x04\x7c\x22\x08\x9c\x1d\x7f\x02\xa7\xd0\xab\x30\x76\x9d\x1d\x7f\x5a\xf3\x3e\xf1\x9d\x65\x81\x15\xd3\x65\x95\x17\xba\x60\xd3\x62\xe3\x0b\x65\xfe\x29\x43\xbc\x66\xd1\xa1\x7c\x21\x2b\xf5\x45\xce\x60\xb5\x7c\xb2\x0b\x2c\x37\x52\xfc\xca\x99\x19\x0a\x53\xf9\xa9\xf2\xd3\xb0\xe3\xf4\xa4\xfc\xa4\x2c\xb0\x9d\x9d\x0a\xc3\x4c\x6e\x3c\x25\xcf\x81\x1c\xed\xc2\xd9\xf9\xc3\xa5\xca\x94\xe5\x23\x84\x84\xfa\xa8\x84\xf5\x4b\x7b\x12\x5b\xb9\x30\x79\x4c\xbc\x30\xf1\x55\x82\xec\xc2\x62\xd2\xd0\x83\x17\x3a\x44\x41\x0d\x00\xa3\xd8\x9a\xc4\xd6\xd2\x83\x99\x0e\xc1\x1d\xc7\x7f\x29\x21\x8f\xf3\x61\xe5\xcb\xa8\x58\x6d\x03\x29\x86\xaa\x9e\xc8\xbc\xe7\xd8\xcb\x36\x13\x54\x9a\xb8\xb6\x22\xf6\x77\x29\x62\x5f\x55\x6f\xe6\xc9\xf4\xec\x6f\x1f\x9a\x6d\xe7\x8a\x0c\xcf\x55\x20\xfe\x0e\x13\xf1\xfa\xb6\x1d\x4d\xf9\x6f\xe1\xe2\xd1\x6e\xa0\xcb\x4c\x28\xf3\x63\x79\x24\x1b\x95\x2b\x5b\x78\xcb\x8e\xc3\x24\xc0\x84\xdb\x30\x0c\x2d\x4c\x2c\x4a\x90\xc5\x11\xb5\x62\xe8\xdd\x16\xfb\x85\xc3\x10\x05\x8c\x82\xcf\x34\x3a\xad\x0e\xe8\x5a\xb3\x0a\x97\x9e\xc3\x4a\x2f\x5e\x56\x3a\xd9\x73\xd2\x74\x34\x8c\xf3\x72\x1a\x91\x8b\x7c\x1f\xf9\xd6\x04\xb9\x96\x2e\x11\x62\xf1\x1f\x4b\xb0\x48\xa7\x7f\x7f\xbf\xf9\x22\x38\x45\xb8\x30\x53\xdc\x33\xec\x48\xb8\x92\xb4\x9e\x55\x5b\xb1\x6d\x07\x94\x0a\x2e\x18\x8c\x9f\xe0\x12\x99\x1b\x77\x57\x89\xf0\x3d\xdb\x71\x5e\x7c\xe7\x38\x2f\x40\x06\x96\xf6\x6e\x65\x39\x70\x8e\xe3\x77\x8b\xc4\xf2\x40\x5d\xcf\x95\xa3\xd0\xf6\x38\xcf\xf9\x0f\xdc\x74\x34\xc7\xe9\x75\x06\xfd\x08\x13\x75\x67\xf7\x38\x2f\x1f\x2b\x8d\x57\xcc\xde\x5f\x74\x41\xdc\x7f\x3f\x0d\x63\x5c\x0c\x77\x0c\xc2\x69\x3c\xc2\x1e\x25\x20\xfb\xcb\x6a\xa2\x33\x88\x09\x16\x42\x25\xf4\x69\xb3\xa4\x21\x01\x77\xc5\xd6\x01\xb0\x93\x7d\x5d\xd7\xd1\xb2\x74\x72\xe5\xc7\x1b\xde\x1d\xd8\x05\xab\x7e\xb4\x9a\x8f\x01\x12\x19\x08\x75\xd3\xf2\xff\x91\x1d\x5c\x50\x4e\xc8\x4f\xeb\x09\x93\x38\x9e\x26\xd8\x9e\xe9\x03\x3d\x85\x23\x4a\x61\x9c\xe6\x5d\x75\x1f\x01\xc1\x7d\x5b\x02\x10\xaa\xa5\x37\xf2\x51\xe9\x0b\x95\x62\x45\xa5\x17\xaa\x08\x4e\x2b\xfc\xde\x4f\x16\x9e\x29\x41\xeb\x0c\xfa\xe0\x97\x4c\x49\xbc\x80\xd6\xd7\xcb\x87\x06\x67\x4e\x7e\x52\x92\x5b\xaf\xbc\x18\x2d\x5f\x29\xd1\xe5\x9a\x86\x8c\x92\xaa\x1a\xc2\x35\x8c\xc5\xbf\x60\x68\xca\x43\xa8\x51\xdf\xc8\x51\x1d\xa7\xfb\x7d\xbe\xde\xd5\x7e\xf4\xd5\x3a\x1c\x5a\xef\x1f\xc3\x8a\xac\x27\x5d\x71\x36\xcb\xcb\x42\x94\x45\x0e\x2c\x94\x83\x78\x86\xf6\xca\xba\x1c\xad\xa0\xbc\x43\x8d\xb2\x7e\xf9\xd7\xbc\x8c\x78\x8a\x35\xc1\xe5\xea\x24\xad\xea\x80\xf7\x2b\x91\x6c\x49\xe9\xdb\x24\xa5\xb4\x3a\x48\x09\x0d\xad\xc0\xf1\xda\x05\xe6\x9e\xc8\x99\xbb\x02\x57\xa5\x87\xa6\xa2\x22\xf4\xfb\x3a\x85\xf4\x72\x2e\x4c\x39\xe0\xf6\x94\x3c\xab\x53\x52\xa2\x41\x1e\x51\xef\x36\xbb\x3a\x57\xe0\xe9\x90\x12\x01\x31\x69\x54\xd3\xa9\xf2\x26\xa4\x4a\x7f\x8f\x30\x57\x35\xbe\x11\x11\x00\x73\x80\xee\x62\xe4\x09\xe4\x03\x77\x0a\xc4\x08\x01\x5f\x01\x09\xde\x1d\xef\x7f\x38\x7f\x77\xf8\xee\xf8\xf0\xaf\x00\x58\xd6\x5e\xe5\xa5\xe2\x71\xb0\x2e\x95\x21\x0d\x61\x7d\xde\xb4\x62\xb7\x50\x08\xb9\xc0\x5e\x48\xa1\xef\xc2\x10\x12\x0f\x93\xa0\xf2\xf0\x7e\x38\xa8\x88\xc2\xa0\xd0\x07\x07\x6a\xb8\xb4\xd1\xca\x23\x73\xab\x42\xca\xdb\x1e\xd7\x75\x84\x5a\x4b\x84\x73\xf6\x53\x45\x3d\x2b\xcc\x3d\x14\x86\x90\x20\x9a\x34\xd1\x95\xae\xf4\xf8\x67\x3f\x3d\x51\x78\xee\x96\xa8\xda\xd1\x94\xf8\x4f\x25\x14\xe4\xd6\xb1\xb5\x3e\xd4\xbb\x7c\xcf\xcd\xa1\xe6\xdd\x2c\x59\x55\x1e\x3c\x09\xc2\x30\x5d\x58\x69\x05\x87\x08\x89\x69\x85\x02\xd9\x3c\xc5\xb8\x68\x95\x66\x40\xa8\x26\x6d\x9a\x2b\xd7\x19\xf4\x35\xb4\xb3\x7d\x32\x9d\x40\x86\xc0\x39\xf2\x46\x84\x86\x34\xc0\x88\xaf\x53\xff\x7e\x9b\xbf\xd4\x38\x7f\x49\x6f\x5d\x39\x45\x61\x46\x7b\x73\xe7\xf4\xc6\x89\xaa\x3e\x05\x29\xd0\xd6\xa0\x93\x6d\x2e\xc5\x26\x48\xaf\x61\x49\x1a\x28\x37\xcf\x8b\xca\xb3\xe5\x24\x14\x22\xf1\x2b\x62\x5d\x37\x94\xc3\x6a\xfc\x41\x29\x0c\xad\xd7\xb6\xd3\x9c\xdd\x8c\x5e\x7e\x65\x96\x0b\xe4\x53\x0e\xf6\x2b\x93\xc3\x36\x83\x8a\x14\x80\x37\x90\x04\x5f\x47\x34\xb9\xfe\xcb\xd9\xf5\xd9\xd1\x5f\xb5\xbb\x22\x6d\x11\x37\x9e\x27\x7f\xb4\x8d\x25\x76\xdb\x83\x06\x84\x9e\x57\xdc\xfb\x85\xe8\x74\xb8\xa7\xce\xab\xd4\x09\x02\x96\xa1\x1f\x03\x94\xe3\xf4\x30\xdb\x48\x2a\xb3\x99\xa0\x87\x59\x31\x62\x82\x24\x84\x15\xca\x53\x9b\x0d\x47\x94\x77\x0e\x90\x20\xed\x94\xf1\xda\xd9\xc9\x68\x23\x0d\x40\xda\xbc\xd6\xa4\x57\x5d\x16\x8c\xa7\x9f\x00\x27\x50\x20\x86\x61\x45\x66\x41\x93\x4a\x4c\x4d\x49\xc6\x78\x06\x99\x67\xe9\x66\x78\x1a\x30\x2b\x32\x80\x6d\xaa\x2d\x9e\x41\xc0\x5f\xce\x1a\x7b\xf3\x36\xe2\x9d\xee\x0c\xfa\x3e\x1e\xcf\xe4\x6f\xba\xe6\x2b\x09\x2c\x18\xc7\xaf\x97\xdc\xbf\xfa\xdb\x26\xae\x5d\x83\xcf\xc6\x39\x71\x9d\x41\xdf\x1a\x30\xef\xa2\xe7\x38\xfe\xe5\x8b\xee\x8b\x6e\xba\x33\xe5\x49\x72\x29\xe5\xbd\x78\x4c\x3f\xae\x99\xb4\x61\xf7\xbe\x94\xc2\x7a\xf7\x5e\x2f\x39\x30\x7f\xa9\x50\x06\xdb\x64\x25\x95\x34\xb1\x48\x0f\x25\xb7\x94\xda\x34\xf0\x90\xfd\x5f\xb9\xf7\x6d\xb3\xb4\xaa\x4d\x12\xe0\x48\xd5\x66\x7a\x5c\x86\xff\x22\x0d\xc7\x80\x44\x58\x9d\x41\xdf\x15\x64\xe6\xd1\x70\xc6\xe8\x64\x16\xc2\x29\x4d\xc4\xcc\x65\x08\xfa\x1e\x4b\x22\x77\x16\x21\x92\xcc\x62\x18\x60\xa2\xd2\xf5\x66\x5c\xa0\x98\xcf\x38\x0a\x91\x27\x66\x1e\xe4\x9e\x9c\x79\xe6\x8d\x90\x77\xeb\xd2\xbb\x99\x07\x43\x44\x7c\xc8\x66\x43\xca\xa2\x19\x26\x71\x22\x2c\x92\xc8\x7b\xb1\xfe\x20\xc7\x53\xe3\x30\x28\xd0\x8c\x41\x1f\xd3\x19\x0f\xb1\x1c\x83\x4f\xb0\xf0\x46\x33\xc1\x10\xb2\xcc\xf8\x02\x47\xc8\x8a\xb1\x77\x8b\xd8\x4c\xa8\xe4\x1f\xc4\x66\x49\x1c\x52\xe8\xcf\xe0\x18\x0a\xc8\x66\x2e\xf4\x03\x34\xf3\x20\xf3\xe5\xff\x68\xc2\x51\x28\x57\x13\xc2\x98\xa3\x59\x88\xb9\x98\xc5\x34\xa6\x63\x39\x00\xa5\xa1\xc0\xf1\x4c\x40\x37\x44\xf2\xff\x7c\x26\x60\xa0\xe6\x08\x31\x41\x6a\xe2\x19\x0c\x11\x13\xb3\x88\xfa\x30\x9c\x45\x88\x73\x18\xa0\x19\xa1\x02\x0f\xb1\xa7\xd7\x1f\xcb\xbb\x06\xe2\x5c\x0e\xeb\x51\x32\xc4\x2c\x9a\xf1\x18\x93\x19\x24\xde\x88\x4a\x78\xbc\x5b\x4b\xd0\x78\xe6\xe3\xb1\x5a\x96\xcf\xe0\x04\xb1\x5c\xbf\xa5\x5d\x0c\x72\x3b\xa0\x02\x61\xcc\xbf\xd6\xa6\x43\x9d\x3f\x23\xe8\x89\xe7\x21\x43\xe6\xf5\xcd\x98\x02\xaa\x81\x94\x50\x2f\xc8\x23\x3f\xc1\x62\x64\x41\xdf\xa7\x84\x77\x07\x6b\x24\x52\xa7\x03\x95\x88\x08\xf5\xc8\xe3\x0a\x08\x35\x65\xd3\xe6\xae\x44\xf4\x74\x9d\xb7\x42\x6e\x93\x6f\x1f\xd3\xaa\xff\x7b\xad\x56\x31\x4f\x14\x0a\xb9\xd0\x02\xa6\x18\x4d\xe0\xdd\x01\xac\xaa\x11\xb6\x14\x11\xdc\x98\x15\xab\x10\x3f\x1d\x40\xaf\xee\x21\xf6\x48\x15\x01\xdc\x30\x07\xd8\xda\xde\x1a\xdb\xde\xd4\xbe\xf4\xea\x93\x0e\xf4\x29\xad\xaa\xa0\xd6\x3a\xed\xe8\x49\x1f\xe5\x10\xe9\xb9\xea\x23\xe4\x2f\x67\xa7\x5f\xf0\x6d\x45\x1e\xb0\x7c\xe2\xe1\x6d\x33\xef\xa2\x90\xf0\xfe\x0d\x8f\x27\xf8\x16\xbf\xae\xd2\x34\xcd\x73\xad\x5d\xd2\xf4\x72\xcf\x69\xe4\xc1\x9a\x42\xf6\xe1\x6d\x90\xae\xf4\xac\xd6\x21\x9d\x52\x81\x96\xa3\xce\x4b\x8d\x97\x8e\xe3\x6a\x58\x75\x4b\x58\xeb\xb9\x74\x83\x9d\x83\x5c\x90\xbe\xb1\xc4\xc3\xca\x71\x0c\x6a\xf0\xb2\x25\x50\x4b\x30\xff\xad\x67\x72\x2c\xf3\x3e\x50\x37\x91\xa3\x6f\xdb\x42\x11\x4b\x6f\x45\x1b\xb5\x0a\x86\x61\xce\x59\x0d\x56\xb1\x70\x76\x5a\x55\x52\xea\x9d\x93\xe6\x67\xe4\xb1\x1d\xd8\x5b\xf9\xdd\x58\x7e\xaf\xa4\xdb\x32\x22\x64\x70\x38\xc4\x9e\x21\xb2\x8d\x64\x0d\x9d\x9f\x3d\x97\xae\xfd\x42\x2f\xd6\x74\xee\x9f\xe3\xa8\xd8\xc7\xa1\x91\xf4\x45\x5e\xca\x6b\x9c\xd4\xd6\x0d\xb1\xdb\x73\xf4\x54\xe7\x68\xa2\xb6\xbc\xd6\x39\x42\x77\x35\x3a\xa7\x7f\x80\xc4\x97\x48\x95\x8f\x82\x83\x04\x87\xfe\x3a\x71\x1f\x67\x30\x44\x7c\x48\x99\x67\x1c\x7f\x60\x3e\xfb\x93\x10\xd6\x1c\x9e\x9a\x41\x93\x9f\x4f\xda\xb6\xaa\xbc\x76\x76\x5c\x06\x89\xff\xb7\x04\x0a\xc4\x08\x64\xd3\x37\x01\xe3\xf9\x84\xf2\x27\x8f\x87\xe4\x19\x96\x1a\x95\xb8\xf0\xd1\x18\x85\x34\x46\x6c\x69\x00\xdb\xa7\x1e\xb7\xa1\x08\x21\xef\xa9\x6e\xe1\x3d\x18\xa3\x3b\x8f\xfa\xa8\x27\xc1\xb7\xd3\x4f\xea\x8f\x6b\x4c\x04\xa3\xbd\x91\x28\xf6\x67\xc7\x94\x0b\x46\xe3\x11\x02\x1b\x08\xa8\x58\xd8\x2b\x65\x01\x83\x31\xe5\x16\x43\x43\x86\xf8\x08\xba\xfa\x66\xb7\x69\x43\xc0\x47\x89\x9c\x9b\xba\xd1\x6f\xbf\x57\x1e\x48\xa8\x8f\x1a\x98\xda\x94\x09\x29\xa5\x0d\x2f\x2a\x37\xef\xc7\xf1\x47\x74\x97\x54\x15\xa3\x6e\xaf\x4a\xec\x8a\x5e\x8c\xd0\x27\x77\x5c\x67\x79\x12\x24\x66\xf7\x6b\x04\xaf\xaa\x13\x5b\xf1\xca\xc3\x6d\x70\x31\x91\x78\x29\x0d\x28\x89\x63\x2f\xa9\x92\x1f\x7f\xe7\x88\x81\x4f\xc4\xa5\x90\xf9\x95\xc5\xb3\x9a\x3a\x7a\x87\x90\x0b\xc7\xe9\x41\x0d\x88\x84\x75\x33\x8e\xa2\xf9\xf8\x85\xb8\x60\x90\x0b\x51\x95\x3c\x58\xbb\xc2\x65\x3a\x9c\xbc\x13\x75\x7a\x2f\x97\x12\xe3\xb2\x1f\x8b\xd3\xe2\x52\x96\x60\x4a\x83\xb9\xd3\x5a\xf5\xc0\xf4\xc0\xba\xb6\x89\xae\x06\x96\xce\x55\x45\x8f\xd9\x9b\xed\xb9\xd3\xb7\x81\x65\x9b\xe0\xa5\x4d\x03\xcb\xf4\xc6\x96\xb2\x50\xe6\xbd\x7d\x7f\x06\xf6\x4f\xdf\x83\x21\x65\x60\xee\x92\xaa\x08\x4e\x87\x71\xcb\x61\x1f\x37\x92\x23\x42\xe6\x05\x69\x4e\xf2\xfc\xd0\xb8\x90\xa3\x08\xc6\xf7\x1e\x68\x97\x4f\x64\x8a\x0f\x37\xb3\xe8\x86\x94\x70\x0c\x35\xb0\xc5\xd7\x35\x26\xf0\x10\x7a\x82\xb2\x8a\x02\xa4\x47\x68\xbc\x56\x2b\x0f\xf3\x7a\x51\x77\xc4\xdc\xfc\x9d\x41\x1f\x9c\x32\xaa\x52\xea\x81\x22\x77\x55\xe9\x49\x7f\xbe\x67\x58\x29\xde\x0c\x7d\x0f\xc9\xfa\x3e\xd9\x94\x05\x0a\xf9\xd9\x44\x8e\xd3\x6b\x57\x64\xdd\x0c\x19\x55\x55\xd5\x6c\x1a\x23\x62\x99\x79\xff\x37\xe5\x96\x9c\xb5\x06\xea\xc1\xdc\x4e\xb0\x11\x7b\xc2\x02\x92\x1f\xad\xee\x88\x86\x63\x95\x25\xfc\x1e\xd1\x15\x2f\x79\x15\xf1\x2d\xf3\x9a\xf5\x4a\x68\xb4\x4c\x90\xcb\x40\xad\xf6\x4d\xd7\x27\xce\xe5\xf1\x5a\xbf\xde\x57\x12\x2d\xa8\x63\xbb\x5d\x93\xf4\xc7\x90\xa8\xfc\xfb\x87\x27\xf4\xef\x7f\x4e\x65\xa3\x29\xc9\x2d\xc7\xd6\x25\x03\x80\xe3\x74\xa0\xfc\xa8\x72\xc0\xd3\x2e\xf0\x4f\xea\x61\x96\x97\xdb\xf9\xea\x4b\xf5\x3a\xee\x61\x7c\x44\xbd\xea\xeb\x65\x26\x6b\xd2\x12\x11\xd9\x17\x25\xfa\xc9\x06\x0d\x6d\xf7\xbb\x29\x99\xa5\x80\xa5\xc0\xe2\x35\xf1\x17\x21\x31\xa2\xbe\x14\x71\x3d\xf2\xd5\x86\x72\x70\x9f\x7a\xa5\x78\x24\x28\xaa\xd0\x90\x7f\xc1\x3e\xa2\xe0\x34\x84\xd3\xb5\xbb\x01\xef\xc2\x74\x2a\x2b\x56\xe3\x54\xea\xae\xe9\xf3\xa6\xa6\x5f\xdb\x97\x87\x74\xf8\x72\x83\xad\x90\x3c\x10\x43\x02\x0e\xb0\x70\x93\x6a\xb3\xed\x43\x35\x81\x10\xef\xad\x98\xd3\xf4\x4f\x16\x58\x84\xe8\xb5\xb3\x73\x01\xad\xaf\x7f\xb4\x7e\xbe\x7c\xe9\xec\x00\xec\xbf\x76\x76\x4c\xcb\x2c\x2b\xe3\xd4\x20\x6d\x7e\x18\x61\xa1\x9e\xc8\xbf\xa2\x7e\xe3\x53\x2e\x50\x64\xb9\x09\x0e\x7d\x13\x64\xb6\xf8\xd8\x1e\x18\xcf\xbb\x33\xaf\x61\x50\x91\x5b\x18\xc7\xa1\x09\xfd\xb2\xb2\x22\x51\x19\x1e\x9b\x8b\xc6\xd3\xa9\x18\x65\xad\x64\x8b\x37\xa1\xea\x74\x2f\xb3\xff\xca\xdb\x87\x09\xc2\xe1\x5d\x0d\xc0\x4a\x35\x01\x3c\xef\xeb\x85\x02\xbc\x89\xa9\x46\x45\xdd\xa7\x94\xa8\xd3\x16\x4d\x99\x36\xdb\x4d\xb7\xd0\x4e\x7b\x84\xd6\x38\x3f\x87\x94\x0c\xc3\x04\x11\xaf\x2a\x8c\xaa\x3a\x96\x41\x5d\xf9\xe6\xe3\x59\x9f\xd1\x6f\x09\xe2\xc2\x3a\xc7\x6a\xd2\x86\xb7\x6f\x53\xe9\x6b\xef\xf2\x65\xb6\x5c\x53\x14\x2b\x5b\xb0\x97\xcd\xd5\x19\xf4\xcd\x05\x7c\xc5\xc2\x54\xa7\xf3\x3a\xba\x78\xe9\xa1\x99\x4f\x66\x31\xb3\x30\x51\xb0\xb0\xad\xb3\xa6\x1e\xf5\xaf\xd5\xf0\x6c\x35\xed\xcf\x77\x67\x4e\xfc\x02\xc1\xc8\x52\x61\xb3\x2e\x65\x9a\xdb\xa5\xcf\x57\x9f\x8b\x37\x98\x8f\x8e\xa7\x55\xe1\x72\x0f\x94\x2a\x4a\x70\xec\x75\x06\xfd\x0f\x34\x00\x98\x00\x41\x41\x77\x60\x66\x06\x9d\x41\x1f\x12\x1f\x1c\xb2\xc4\xc3\x6e\x88\x40\x37\x67\x3a\xd9\xb5\xf5\xab\x8f\x13\x1c\x55\x82\xf7\x21\xe6\x23\x34\x45\x4d\x38\xce\x5f\x30\xab\x50\x6b\xde\x73\x9e\x28\x97\xb5\x77\xbb\xb6\x5e\x33\x67\x26\x8e\xc3\x5f\x96\xf2\x93\xce\xa0\x9f\xad\xe7\x06\x33\x38\x93\xff\xb3\xdc\x24\xb0\x84\x04\x01\x93\xc0\xee\x2e\x71\x17\x39\xe6\x5f\xde\x7f\xde\x57\x7c\x67\x7c\xf9\x62\xdc\x19\xf4\xb5\x8c\xef\xcb\x7d\x32\xc5\x83\xe5\x3f\x3a\x12\x57\x75\xef\x1a\xac\xcd\x7b\xe0\x0d\x4f\x75\x08\xa3\x13\xac\x32\x75\xae\x12\xeb\x12\xc8\x35\x24\xfa\x96\x39\xb5\xc9\x9c\x24\x3d\x35\x3d\x21\x40\x1f\x82\x43\x1a\x86\x48\xde\xb1\x1b\x9d\x98\x57\x0f\x65\x50\x45\x54\x6a\x86\x55\x07\x04\xcb\x39\x15\x7f\x55\x00\x5a\xba\x9c\x6d\x8e\x1c\x73\xc7\x4c\xbe\xa0\x4e\x9b\x79\xd8\x71\x7a\xe6\xf1\xc7\x62\x54\x35\xf7\x20\x61\x28\xc4\x15\xec\xa9\xf5\x64\x45\xa8\x67\x55\xa9\x45\xca\x4d\xfa\x6a\xf1\x77\x75\x31\x88\x20\x26\xea\xd7\xf4\xe9\xac\x70\x66\x9c\xab\x65\x94\x8d\x68\x09\xc8\x02\x24\x2c\xec\xab\x97\x1c\xc7\xaf\xbc\xd8\xe9\x71\x1f\x98\xee\x57\xb1\x43\x66\x92\xb2\xbc\xc8\x31\x24\x01\x14\x55\xcd\xac\x1f\xd8\xf1\x2b\xad\x02\xfb\x02\x18\x07\xcf\xbc\x14\x2f\xf2\x03\xe4\x38\x3d\x68\xe0\x30\xdd\x25\xab\xb0\xd7\xec\xfd\x07\xa3\xd1\x0c\x5e\x6a\x8b\x99\x20\x4e\xa3\x38\x44\x55\xb8\x7c\x50\x87\x87\x65\x84\xbe\xcc\xaa\xea\xee\x5d\xbe\x80\x73\x10\xee\x97\xd5\xad\x40\xa8\x9d\x7b\x39\x6d\xf8\xf7\xfd\xcc\x71\x06\x6d\x27\x78\x87\x08\x8e\x11\xa3\x89\xa9\xbc\xdb\xc3\x34\x3f\x75\x21\xab\x38\x38\x3e\x79\x64\x36\xa1\xd8\x80\x8b\xa2\xc7\x51\xfa\x10\xe9\xb9\x28\xea\x61\x32\x2c\x3c\xaa\x07\xef\xdf\xee\x1f\x1e\x6f\x28\x94\xa4\x33\xe8\xaf\xb8\x10\x6a\xc2\x7a\xa9\x67\x9e\xed\x7e\x67\x59\x52\x15\x3b\xc3\x42\x35\xec\x63\x09\x21\x52\x11\xd1\x3f\x17\x87\x05\x94\x2a\x5b\x0b\x66\x40\x3d\x52\x1d\x1b\xa0\x7e\x7b\xeb\x98\x7d\x9e\x8e\x59\x17\x07\xd0\x43\x25\xdd\x0c\x0f\xa0\x1b\xe2\x0a\xbb\x75\x9a\xe1\x02\x4e\x20\x81\xc1\x82\xa2\xf5\x8e\x72\xa1\xe3\xfe\x08\x6a\x4d\xdb\x6a\x97\xcf\x49\xe5\xc8\x55\xab\x2c\xad\xf7\x71\x00\xbd\x5b\x97\x92\x5c\x1c\xd5\xd3\xd5\xc7\x77\x53\x58\xd6\x8a\x53\xd1\x83\xac\x38\x91\x7f\x27\x12\x2a\x8f\xb2\xfa\xc1\x62\x4f\x5b\x4b\x3c\x99\x03\xbc\xd4\xfc\xf6\x91\x7b\xde\xf6\x6d\x7b\x0e\x4b\xb3\x18\xb3\x74\x2f\xcb\xf3\xc7\x25\xf5\xf9\xac\x2a\xd5\xec\x29\x0a\x3e\xa5\x90\x81\xc3\x93\x33\xe5\x4b\x75\x1c\xbf\xb4\x9c\x78\x21\xf9\x6d\x05\xc2\x73\x10\x08\x66\x3b\x2b\x02\x1e\xe5\xae\x57\xc7\x99\xd4\x6b\xf8\xb9\x88\xe3\x6c\xe4\xa7\x94\x09\xae\x01\xa2\xec\x1e\x71\x00\x09\x1f\x55\xb6\x7c\xcd\xbb\x74\x1f\x94\x23\x61\xc6\x38\x48\x70\x28\x40\x12\x53\xa2\xaa\xe1\x2e\xe9\x7f\xae\x86\xc9\x8a\x47\x71\xd6\x5a\x6c\x4f\x55\xf8\x7f\xb9\x6b\xc3\xbd\xce\xa0\x3f\x7e\xb0\xc1\x7f\xe9\xf4\xab\x09\x81\x3c\xbb\xdb\x13\xff\x4d\x9e\x78\xad\xff\x64\x74\x53\x7e\xea\x39\xaa\xd7\x4f\xb2\x79\x5c\x53\x3a\xb6\xe3\x18\x7c\x3b\xce\xe6\x13\xa6\x8a\x29\x73\xeb\xba\x5d\x97\xfa\x1a\xbb\x6e\x7d\xea\xf1\xde\xfc\x35\xfb\x47\xdb\xf4\x66\xb1\xe5\xa5\x42\xb1\x64\x9d\xb1\xa6\x16\x5a\x44\x9a\x38\x38\x80\x24\x38\x1b\x55\xe9\x48\xf5\xa3\xa7\x7f\xb5\xce\x8e\x3f\xff\x72\xfc\xd9\xdc\x77\x0f\xf6\x3f\xbe\x3d\x7b\xf7\xe9\xf4\x49\x05\x93\x3a\xac\x38\x70\x21\x09\xf8\x88\xaa\xde\x40\x3d\xb7\xb0\xc6\xdc\x01\x0e\x8e\x92\xa8\x66\x7d\x82\xf9\xed\x6d\x2d\xc1\xa4\x8a\xb7\xef\x8e\x7e\xd8\x33\x93\xf6\xc1\x99\x80\x41\xa0\x0c\x15\xaa\x07\x1f\x90\xdf\x82\xf7\x51\x4c\x99\x40\x0c\x8c\x55\x57\x27\x63\x41\x70\xef\xd5\x27\x6c\xff\x48\x2f\x37\x02\x2c\x0b\x51\xbc\xdf\x00\xf0\xdb\x75\xd8\xdc\x6b\xe8\x97\x8d\xb7\x95\xc8\xcf\x57\x22\xd3\xaf\x88\xd1\x71\xcf\x47\xb6\x8b\x03\x3f\x89\xe2\x5e\x3c\x8a\x4b\xce\x79\xc6\xd5\xda\x60\x7e\xa5\xd6\xec\x9c\xc7\x58\x55\xd3\x54\x2e\x5d\x77\x0e\xc1\xca\x2e\x7d\x05\x95\x5c\x6b\xbd\xdb\x86\xf7\x2b\x37\x47\xa9\x4e\x8f\x03\x15\x06\xb1\x49\x24\x76\x06\xfd\x23\x8c\x38\x5a\x0c\xe6\x71\xf5\xcc\x8e\xd3\xf3\xd1\x6c\xe1\x17\x3b\x82\x98\x5c\x9b\xdf\xaf\x0d\xce\xe2\x51\xbc\x71\x86\xb9\xe5\x05\xcf\x80\x17\x98\x7d\x2f\xb3\xd0\x62\x21\xf6\xfd\xcd\xa7\x1e\x56\x9c\x67\x17\x0b\x01\x7d\x93\x11\x63\xdf\x70\x5b\x7e\xa1\x8c\x72\xdf\xb7\x7b\x9c\xcd\x44\xe5\xc7\xf8\xeb\x04\xb9\x9b\x39\xc5\xed\x1c\xba\xb2\x35\xce\xf5\x3c\xb9\x8a\xde\xb8\xb0\xcc\xdb\x41\x08\xab\xca\x70\x6f\xa2\xfb\x82\x2b\xa7\x55\xd5\x51\x26\x4f\x78\x43\xdb\x86\xe2\x34\x0b\xc5\xd1\x2e\x5d\xc1\x2d\xb5\x7d\xa5\xa7\x27\x44\xbc\xa5\x1c\xd4\xa7\xcb\xcb\x70\xd5\x22\xca\x97\x89\xe3\x9e\x18\x6f\x3e\x93\xa0\x33\xe8\xc7\x90\xc1\x68\xa6\x9a\x31\xcf\x74\xde\xb6\x8e\x9c\x73\x43\x1c\x3b\x4e\x4f\x8c\xed\x38\x84\xd3\xc7\x71\x60\xbb\x66\xdd\x85\x68\xa1\x41\x65\x03\x6d\xf9\xd0\x13\xe4\x9e\x18\xd8\xbe\xdf\xc6\xe2\x3f\x6b\x83\x8e\x3e\x7f\x6a\xab\xca\x0f\x60\x82\x86\x98\x8f\x9e\x67\xab\xdc\x14\x3a\xe5\x52\xe4\x85\x69\x96\x0d\x0e\x9e\xce\xa7\x1b\x52\x16\x20\x15\xfa\x14\x33\x7a\x83\x3c\xc1\x6d\x37\x45\x44\x11\xa2\x68\x05\x37\x5e\xd3\xe2\x4a\x61\xde\xd8\xaa\xbc\x04\x4f\x5b\xa0\x4a\x11\x0e\x2d\x4d\x13\x3f\xa0\x2e\x6c\xe2\x82\x5f\xf2\x4a\xb7\xa6\xed\x52\xf7\xa1\x11\x81\x7a\xa4\x15\xac\xea\x2d\xa5\x41\x88\xc0\xbc\x6d\x48\x2d\xa6\xb5\xd4\x65\x64\xf9\x5c\xaf\x95\x56\x0b\xa3\xd8\x82\xe9\xb8\xba\xee\x83\x98\xc6\xe8\xf5\x85\xb3\xf3\x87\xcb\x40\x81\x99\xfd\xac\xbe\x5b\x3d\xf7\x6a\xe7\xbd\x7e\x7f\x3e\x7e\x9a\xd2\x69\x77\x06\xfd\x00\xce\x12\xe6\x8d\x54\xa5\x6d\xf3\x73\xf7\xb1\xca\x32\xf7\x6d\x5b\x83\xa6\x2e\x32\x70\x19\xad\x95\x17\x36\x49\xa0\x3c\x86\x1e\xfa\xe1\xbf\xfe\x58\xca\xfe\x68\xe8\x83\xc3\x51\x55\x09\xc4\x0f\x78\x8c\xf4\x63\xad\x12\xf0\x3c\x2e\x73\x1c\x71\x89\x7b\x97\x86\xbe\x37\x82\xc2\x5c\xe0\x20\xf6\x6d\xc7\xf1\xff\xf9\xc3\x9f\xff\x65\xbb\x9e\xe3\xf4\xc6\x11\xff\x4f\xf9\xec\x26\x2a\xa3\xe8\x73\xaf\xe7\x2f\x0f\xc2\xa1\xa1\xff\x96\xe1\xaa\x4c\xe3\xbc\xd3\x75\x1d\x55\x25\xa5\x7f\x65\xee\x62\x28\xd4\x04\xaf\x0a\xf1\xf2\x11\x42\x42\x7d\x04\x5a\x83\xa3\xa1\x1f\xcc\x21\xaa\xf9\x56\x4c\xb9\xb0\x20\xf1\xad\x18\x06\x48\x27\x35\x2e\x04\x2b\x67\x51\xa3\x3c\xb5\x91\x99\x69\x56\x15\x9c\x58\x11\x23\x3a\x89\x2d\x8f\x12\x81\x88\xb0\x75\x3c\x39\xb7\x57\x4f\xd9\x12\xcb\xfa\x42\x99\x7f\xca\x10\xaf\xc9\xab\xf2\x1b\xb4\xbc\x49\xcb\xa7\xb3\x40\xc3\x92\x18\x92\x33\x4b\x3c\x3b\xb9\x12\x3b\xd8\x71\x7a\x93\xcc\xf7\x5d\xa1\x5f\xe5\xc6\xab\xb3\x6b\x12\xad\x9d\x41\xdf\x60\x76\x86\x89\x17\x26\x3e\xe2\x5d\x7b\xa9\x17\x8a\x8f\xc7\xb9\xde\x0b\x6a\x00\xc9\x49\x27\xb1\xb5\xf4\xe0\xbd\x6d\x9e\xc4\xf9\x0d\x5e\x46\xc5\x6a\x3e\x5a\x0c\x55\x3d\x5e\x79\x4f\xcb\xce\x36\x13\x54\x9a\xd6\x1a\x95\xf2\x5d\xb4\x27\x82\x52\x12\x01\x35\xf4\xf0\xa5\x95\x81\x62\x6a\x01\xa0\xb6\x8d\x51\x3d\x5a\xdf\xce\xb8\x84\x10\xb0\x5a\x7d\x5f\x05\xe9\x73\xa9\x12\xbc\x64\x8f\xbc\x37\x7a\xc1\x66\x2e\x7b\xd3\x40\xf5\x76\xae\xf0\xaa\xad\x02\xf1\xf7\x59\x8c\xf9\x9e\x17\x0e\x80\x9a\xd5\x98\x95\x80\xa4\xcc\x8f\xe5\x91\x6c\xd4\x28\x22\x15\x1a\x15\x5a\x48\x85\x02\xf2\x24\x41\x85\x12\xaa\xe6\x62\x68\xeb\xb1\xd8\x88\x01\xa0\xa1\xc7\x82\x86\xa2\xe7\x95\x10\x1c\x19\xe2\x2a\x17\x5b\x1b\x91\x72\x0b\x59\x12\xf9\x14\x9c\x55\x35\xeb\x3c\xec\x6a\xb8\xb4\xfc\x55\xe2\x7b\xcf\x80\x0a\xc6\x9d\x8b\xab\xdd\x47\x88\x50\x38\xa4\x3e\x7a\x1f\x10\x2c\xea\x36\xe1\x5f\x85\xa6\x3a\xf4\x5b\xa9\x0c\xa9\xf6\x4d\xba\xb3\x17\x8c\xd0\x6b\x67\xc7\xc3\xd7\x1e\x67\xc3\x6b\x41\x6f\x11\xd9\x78\x75\xd9\x39\x28\x5b\x55\xe2\x1b\x92\x71\xab\x54\x89\x9a\x3c\xc6\xa3\x3e\xc2\x9a\xf4\x9b\x24\x17\x67\xc7\xb6\x5c\xc4\x99\x84\xd0\xcd\xf3\x1c\xdd\xac\x65\xcf\x76\x9c\x17\xdf\x39\xce\x0b\x90\x4d\x0d\xc6\x1d\x6d\x65\xcb\xfe\xdf\x5d\x75\xdd\x1b\x18\xb7\xbf\xb3\x73\x69\x7b\x9c\xe7\xda\x57\x65\x59\xad\xba\x5c\xab\x6e\xf1\xda\x1d\x78\xb9\x2b\xd7\xbd\x71\x9c\x1d\x35\xd2\x8b\xec\xdd\xfb\xc9\x86\xf3\x77\xcd\xa5\xe5\x65\x5a\x45\x4d\xbf\x1a\x84\xd3\x78\xa4\xda\x96\x65\x7f\x15\x37\x2e\x33\x43\x89\x09\x16\x42\x45\x77\xe9\xcc\x41\x63\x51\x70\x97\xb6\x41\x59\xd1\xcc\x57\x75\x1a\x00\x2e\x99\xce\xe5\xc7\x1b\xde\x1d\xd8\x05\xab\x6b\xdd\x48\x11\x20\x91\x4d\x55\x8b\xdc\xc0\x39\x74\xc3\x0a\x41\xd7\x82\xa5\xf2\xde\xe6\xe7\x33\x4d\x33\x88\x2d\xd5\x09\xaf\x71\xb6\x69\xe9\xfb\xed\x99\x3c\x97\x8f\x68\x31\xc6\x0a\x8f\xea\x32\x8f\x59\xaf\x8e\xe0\x1a\xc7\xb7\xcd\x23\xfc\xd0\x63\xbc\xde\x51\x5e\x46\xdd\x6a\x3b\x43\xed\x63\xdd\xf8\x68\x3f\xe4\x78\x2f\xc3\xde\x7a\x05\xfb\x55\xc7\x1e\x54\x44\x51\xde\xfc\x2d\x41\x75\xcb\x61\x3e\x6d\x06\xdd\xcd\x6f\x12\xd2\x35\xda\x29\xea\x17\xcb\xfb\x29\xea\x67\x96\x53\xf3\x3a\x83\x3e\xc7\x94\x74\x07\x8f\x9c\xa3\x27\x37\xd3\x40\xd4\x40\xc3\x58\x62\x80\xbd\x09\x22\x5f\x47\xf8\x0e\x13\xa9\xe9\xf4\x3c\x52\x62\xaa\x4e\x88\x87\xc0\xf1\x9d\x37\x82\x24\xa8\x10\x03\x27\x90\xdd\x22\x95\x28\xbb\x9f\x08\x1a\xa9\x5a\x35\x9b\xb2\xf7\x0b\x18\x28\x83\xb2\x04\x0f\x19\xe8\x36\x17\x77\xb9\x30\x4d\xa9\xd8\x64\x10\x13\xc1\xaa\x12\xaa\x4e\xe1\x34\x42\x44\x80\x53\x46\x3d\xc4\xf9\x63\x78\x86\xd7\xf6\x68\xa4\x2b\x8a\x35\xcc\xe5\x21\x6b\x0c\x07\x23\xc1\x63\xfa\x50\x6b\xcc\xaa\x3b\xc7\x7c\xf0\xef\xb7\xc1\x5a\xe6\xd7\x67\x1f\xac\xa5\x49\x28\xdd\xb8\x72\xda\xa1\x13\x8e\xd8\x06\xda\x97\x34\x27\x8b\x7b\x96\xbc\x0c\xb6\x07\x15\x8b\xf8\x9c\xb8\x35\xe5\x69\xcb\xc1\x3b\x27\x94\x04\x0c\x85\xb3\x2f\xc7\x07\x0c\x7b\xb7\x33\x09\x48\xf7\x5b\xb0\xda\xb1\xc4\x9d\x5a\x21\x24\x41\xa3\x64\x74\xbd\x5b\x55\xc9\xbf\x89\x5b\x79\xb1\x59\xf0\xc1\x96\x14\x88\x78\x70\x80\xea\x63\xb3\xb2\x6d\x2f\x9d\xf5\x7a\xe9\xb8\x8a\x68\x7a\xba\x58\xea\x6a\xaa\x0a\xce\x08\x0c\x2a\xe2\xd8\x17\xc3\x31\xda\xd2\x8e\x6c\x37\x09\x38\x81\xc1\x7a\x05\x35\x2a\x57\xae\xc7\x2e\xe3\xe0\x49\xf0\x15\x87\xe1\x23\xd4\x7f\x34\x37\x4a\x44\x04\x62\xd7\x6e\x22\x55\x41\x2f\xc0\xce\xce\x5e\xce\x14\x14\x41\x4c\x74\xa1\x66\xd7\xc0\x65\xb9\xd4\x9f\x3a\x3b\xb9\x67\xb2\xbc\xf3\x4c\xaf\xd4\xa6\xf4\xf4\x0d\xe3\x82\x57\xbd\xba\x3a\x17\x7f\xb4\x7e\x56\xb7\x06\x5d\x2e\xf2\x1d\x0a\xe3\xdd\xdc\x58\xaa\x58\xb4\x9a\x0f\x93\x21\x65\x5a\xfd\x75\x76\xb2\x82\xfe\x7a\x6d\xd7\xd0\xf7\xc3\x6b\xf9\x84\xb3\xb3\x67\xaa\x3e\x82\x87\x96\x7c\x5e\x94\x55\x06\xf6\xfb\x7e\xef\x66\x5e\x28\xc4\xc2\xc7\x97\x55\x8e\xe3\xca\x89\x75\xef\x52\x30\xb0\x07\xe3\xc1\xb7\x16\x68\x8a\x58\xd8\x38\xcc\xd4\x6c\x59\xb9\xb8\x0a\xab\x0a\xc6\xb7\x62\xfb\x5d\x6d\xb6\x79\xe9\xca\xe9\xeb\x1b\xde\x5a\x66\x3d\x61\x54\x56\x54\xf0\x20\x61\xba\x30\x18\x85\xac\x22\xa8\xea\x04\x71\xae\x9a\x37\xca\x47\xd7\x2e\xaa\xbf\x50\xad\x62\x42\x43\x11\x42\x37\x75\xb9\xed\x5e\xbe\xdc\xe5\x82\x51\x12\xec\x2d\xc2\xb5\x61\x89\xbe\xf5\x1a\x6f\xe4\x34\x2f\x79\x8d\x4b\xcc\x65\x5f\x34\x1d\x80\x43\x1a\x45\x09\xc1\x62\x3a\x3f\x8b\x4f\x6b\x0f\x1e\x43\x06\xbe\x1c\xbe\xb9\x3e\xdd\x3f\x7f\xa7\x28\xb6\x89\xbd\xf4\xcb\xe1\x1b\xc7\xe9\x2d\x68\x14\x6b\xe3\x7c\x3e\xec\xd6\x21\xfa\xef\xe1\x10\x95\xc2\xcd\xf0\xc7\xa6\xd5\x96\x97\x5e\x5b\xcd\xf7\x39\x26\x88\x73\x70\x08\x05\x0c\xa7\xbc\xf5\x10\x20\xf3\xa2\x2a\x0a\x71\x70\x78\xfd\xe9\xe0\xe3\x17\x60\x59\xc5\x75\xd9\xd3\x89\x0c\x38\xda\x20\xde\xba\x1e\xae\x17\xed\x99\x49\xca\x11\x34\x3d\x43\x61\xf8\x0c\xf2\x6a\xe7\x86\x5b\xae\x9d\x4f\x6e\x32\xe5\x28\x0c\xe7\xb9\xb6\x6d\x63\x29\x1d\xbe\x0c\x3f\x87\x47\x1f\xff\xf4\xa7\x87\x17\xff\xca\xf5\xec\x57\x23\x5a\xe7\x09\x73\xe9\x66\x8d\x94\x65\xeb\x4f\xed\x6e\x9e\x4f\xfe\xf4\xa7\xd2\xf5\xbf\x39\xf9\x50\x61\xa8\x7e\x92\x76\x4d\x6b\x6c\x38\xf4\xa9\xab\x73\x1d\x4c\x5b\x1e\x6e\x7b\x34\xf4\x87\x89\x2a\xab\x3f\x84\x11\x0e\xa7\xa5\xa5\x77\x0e\xff\xaa\x73\xb4\xca\xf1\xf1\x19\x7b\x23\x70\x8e\xee\x04\x78\xac\x8c\xae\x35\x70\xe1\xdd\x22\x05\x5c\xe9\xd6\x9f\x9c\x81\x13\xe8\x23\x70\x26\x07\xae\x61\x06\xdb\xf4\x3a\x97\xaf\xb1\xcb\x00\x16\x22\x43\xbf\xbc\xd5\x88\x9f\x67\x1c\xa5\x17\xf1\x08\xfa\x88\xab\x4d\x2c\xbb\xdf\x1e\x9e\x3c\x63\x4a\x54\x90\x75\xc0\x83\xec\x29\x5b\x6a\x7c\x7a\x6a\x54\x52\x31\x9a\x13\xa3\x8d\x0a\xcb\x52\x1c\x9e\xbe\x05\x47\x0c\x06\x94\x0c\xc3\xaa\x02\xa1\xeb\x78\x33\xb3\xb1\x41\xf6\xfa\x26\x68\x77\x61\x15\x5b\xaa\xfd\x26\xa9\xd6\x4f\x37\xb0\xc2\xa3\x75\x78\x06\x0e\x21\xab\xb8\x05\x3d\xb0\xee\xd3\x7f\x10\x97\xc7\xff\x93\x8b\x4a\xef\x0c\xfa\x0b\x85\x9e\x3c\x6e\x79\x90\x99\x04\xcc\xd9\xe1\x99\x25\x41\xca\x85\x89\xca\x1f\x22\x8b\x50\x8d\x73\xf5\xca\xae\xad\x23\xd6\xb6\x26\xb2\x7f\x03\x62\x56\x2c\x58\xd3\x48\xa9\x7e\x0a\xbd\x11\x7a\x53\xc9\x79\x1b\xde\xce\xde\x9c\x81\x39\x25\xfe\x6a\x1d\xbe\xf9\xc1\x74\xf8\x5b\xf8\xf2\xc7\xd2\x7e\x86\xed\x10\x69\x1d\x2c\x49\x14\x0c\xc3\xd2\xf2\xcd\x87\xd0\xf7\x2b\x70\xb4\x5e\x59\x85\x2b\x35\xf2\x13\x05\xda\xbc\xa5\xff\xce\x8e\x69\x15\xaf\x49\x9b\x06\x3d\x78\x72\xbf\x4c\x89\xd9\x52\x82\xb9\x45\x73\x3e\xb8\x09\x47\xd2\x5a\x4a\xcb\xaa\xee\x76\x29\xa8\x5b\x46\xff\x0c\x88\x72\x8d\x9b\x1f\xbc\x45\x15\x95\xb8\x0f\x61\x2c\xbc\x11\x38\xa9\xba\xf4\xa9\xc7\x60\x2e\xae\xe6\x31\x0d\x96\x3a\x1b\xdf\x53\x30\x44\x9b\x69\xf8\x95\x0e\x5e\x7e\x70\x99\x4b\x09\x78\x0c\x5b\xae\x19\x69\x57\x97\xdc\xd7\x15\x53\x54\x60\x83\xa7\x60\x80\x3e\x57\x75\x19\x20\x26\x88\xa5\x59\x74\x25\x51\x29\x76\x67\xd0\x47\xaa\xfd\xd8\x8c\xb3\x71\x57\x21\xd3\x8c\x93\x55\x4b\x69\x1b\x9f\x66\xfc\x94\x5e\x0b\x10\x1a\xd0\x4d\x79\x0c\x54\x1d\x06\xa5\xdc\xaa\x69\xc0\x10\xa1\xca\x16\x7d\x12\xec\x80\x3a\x4e\xaf\x04\x19\x0a\x54\x16\xd0\x6b\xd5\x21\x76\xed\xf6\xc4\x5b\x06\xf9\x2c\x18\x24\x0b\xa8\x69\x9a\x89\xc7\x15\x87\x5f\xb8\x88\xb1\xe9\x9a\x6d\x9b\x5e\xad\x23\xd8\x57\x6c\x4a\x0a\xc6\xd3\x68\x85\xdb\x98\xc5\xf5\x62\x16\xbd\x94\x78\xca\x24\x31\x22\xe2\x53\x45\xe0\xf5\xa7\x18\x31\xa8\xd2\x3b\xce\xa6\x5c\xa0\xa8\xc9\x75\x62\x61\xfc\x55\x94\xa5\x7f\xdf\x24\x5d\x55\x61\x09\x11\x41\xcb\xcd\x2b\x23\x18\xa1\x10\x65\xf5\x17\x9f\x91\x89\xda\x4b\x41\xb3\xbc\x74\x5b\x1a\x1d\xad\xfd\x58\xde\x35\xeb\x87\xa9\x2c\xdc\x27\xeb\x9c\xa7\xe5\x80\x74\x3d\xa1\x6a\xfa\x39\x4f\xc7\x9a\x5d\x5c\xd9\xd6\x65\x77\x66\xaa\x4d\xba\xdd\x77\xe7\xe7\xa7\x47\xdf\x44\x9c\x3a\x54\xeb\x59\x38\x95\xaf\xb6\xe2\xf7\x79\x8b\xdf\xec\xcc\x70\xc5\xce\x4a\x8a\x93\xcb\x93\x8f\xc3\x0a\x65\xf1\xc3\x1a\x5e\x00\x33\x70\x51\x52\xc9\xb2\x5a\xe9\xec\xec\xdd\x7b\x63\xd7\x86\xeb\x18\x4e\xef\x39\x0a\xca\x21\xd9\x6a\x95\xdf\x0a\x59\x2b\xcb\xa1\xde\xcc\x0a\x61\xc6\x44\x13\x75\xf2\x2d\x83\xf1\xa8\xfd\x94\x05\x05\x87\x8e\x2b\x76\x13\xe2\x87\x48\x25\x4d\xaf\x4e\xc7\x95\x62\x8e\x89\x1b\x9e\x46\xe5\x63\x2e\x32\xe1\xd1\x19\xf4\xad\x8b\x2b\x5b\x6e\xea\x2c\x82\x5c\x20\x36\x0b\xa1\x40\x5c\x74\xf5\x14\xbd\xe5\x42\x0c\x9e\x4f\xd4\x48\x5e\x48\x13\x7f\x18\xc2\xb4\x68\x8e\x0d\x6f\xe0\x9d\x1d\x62\x97\xeb\x17\xd5\x6b\xab\xa6\x29\x19\x58\x7d\xe3\xa3\x10\x8f\x99\xb1\x17\x74\x06\x7d\x12\x47\xb3\x60\x64\x9b\x35\x74\xf5\x1f\xea\xc9\xff\xb3\x6a\x11\x29\xf4\x6a\x95\x0b\x33\xb5\xa7\xf6\xf0\x39\xbd\x48\x98\x2a\xe9\xc5\x45\x55\x75\x3e\x37\x94\xd9\xe2\xa5\xb3\x6f\x20\xb1\x25\x1b\xbb\xf4\x02\x36\x42\x8c\xde\x56\xa5\xf5\xae\x6b\x6a\x37\x83\xaf\x2c\xab\xfd\x84\xfe\x07\x03\x96\x65\x4a\x3c\x57\xe1\x87\x4d\x4f\x6b\xb8\x22\x1e\xda\xc7\x21\x77\xa7\x30\x73\x6e\x7b\xed\xfd\x3b\x96\x66\xf7\xd4\xee\xc7\xe5\xb7\xda\x11\x1a\x23\x86\x44\x85\xe6\x76\x3a\xa2\x82\x82\xb7\x30\x0c\xd1\xfa\x95\x6b\x56\xb6\xb3\xcf\x8c\xc8\x5e\x0a\x89\xb1\x78\xee\x54\x06\x11\x2f\xbc\x52\xca\xf3\xee\x95\x3e\xcd\x96\x0d\x06\x59\xd2\xda\x60\xad\x76\x03\x5b\x85\xee\x89\x15\x3a\x9e\xa7\x9d\xd2\xa2\xd2\x87\x23\x2c\xf0\x6d\x45\x86\xd8\xe6\xfd\x26\xfa\x1b\xa5\x58\x69\x80\x36\xe3\x30\xd1\x63\x97\x4a\xa4\x5b\xf8\x50\x43\x8d\x44\x98\xe7\x21\xce\xad\x43\x4a\x04\xa3\xa1\xb5\x1f\x86\x74\x62\xbd\xcb\x9e\xdb\xf9\xd5\x3a\xfc\xeb\xfe\x47\x6b\xff\xf4\xbd\xf5\xd7\xe3\x7f\xcc\x25\xda\x07\x53\xd8\x79\x37\x0b\xc9\x85\xc4\xa8\xad\x7b\xff\xa3\x0a\x34\xf3\x11\x65\x42\xd5\x7f\x6e\x4b\x5c\xdd\xe3\x03\x72\xd2\x1c\x0b\xd8\x36\x1c\x79\x62\xa9\x56\x62\x11\x3a\xa3\x61\xcd\x6a\x99\xdf\x46\x57\xcf\x39\xc0\x05\xa9\x5e\x1f\x12\x0f\x11\xf4\xef\x5c\x7a\x79\x71\x71\xcb\xc9\x68\xc5\x65\x67\xb2\xdf\x4b\x91\x05\x6a\x9c\xc8\x82\xa5\x80\x32\x24\xae\x58\x15\x28\x46\x66\xd1\xe8\x45\x48\x2d\x7a\x7e\x25\x72\x8b\x40\x29\x4c\x75\x2b\x1a\xbd\xba\xcc\x4d\x36\x5d\x29\x09\xdc\x1f\x27\x54\x54\xde\x9b\x5b\x87\x6d\x8f\x32\x64\xe7\x87\xad\x9b\x4a\x77\x7f\x28\x4e\x43\x66\xd7\x61\x2e\xdb\xba\x45\xf7\xeb\x16\x95\xa0\xeb\x94\x72\x11\x30\xf4\xef\xd9\x67\x59\x5e\xad\x62\x8d\x00\xfe\x9b\x2a\x64\x60\xd7\x36\xa8\xdf\x42\x92\xbd\xb0\x52\x25\x0b\x21\xc3\xa2\x99\x8d\xa0\x54\x41\x2b\x0c\xbf\xf0\x42\x66\x45\x10\x93\x79\x9c\xca\x72\x67\x13\x15\xd4\xb2\x50\x98\x52\xbe\x93\xe0\xc6\xb5\x45\xe5\x6b\xf2\xa4\x84\x90\xe9\x77\x93\xc8\x5f\x61\xc3\x2c\x56\xdb\x9a\x39\xea\xf4\x4c\x8d\x8b\x32\x6e\xa4\xce\xa8\x8a\x6f\x01\x24\xb0\x4c\x1d\x93\xd7\xce\x4e\xa6\xa4\x6d\xbc\xba\x76\x1d\x7a\x56\xcd\x54\x34\xc6\x4c\xf9\x88\x4a\x3a\x56\x17\x2e\x4d\xa7\x3d\x1f\x71\x1c\x14\xd7\x46\x3c\x0c\xb1\x77\xfb\xee\xc9\x4c\xa2\x72\xf6\x11\x82\x6b\x9a\x85\x0b\x29\x6c\x7b\xe7\x7f\xea\x3b\xbf\xe1\xc2\x21\x74\x79\x84\x7c\xac\xe4\x96\x9d\x6d\xb7\x8d\x89\x8f\xee\xca\x53\x59\xe5\xb3\xe7\xb0\x2a\x97\xae\x6d\xc2\x6c\x46\x80\x35\x30\xa0\xd6\x2c\x60\x58\x1e\x1a\x25\x1f\xaa\x90\x29\x1b\x3a\x82\x5c\x40\x81\x3d\xc7\xe9\x05\x48\x28\x58\xa7\xab\x1a\x5b\x3d\x14\x13\xd9\xe0\x15\x58\x40\x44\xa0\x3b\xe4\x6d\x34\xfb\xc7\xcb\xa6\x71\x9c\x9e\x12\xa1\x8e\xc3\x07\xaf\xe5\xff\xd4\xa7\xff\x69\x29\x85\xa7\x1e\x71\x18\x50\x2a\xf0\x12\xbb\x14\x32\xbf\xd2\xd5\x79\x82\xb9\x87\xc2\x10\x12\x44\x93\xf6\x59\xb5\x06\xa2\x33\xe8\x5b\x8b\xd5\xa1\x37\x59\xea\x3d\x9b\xf6\x86\x97\x5b\x0e\x43\x9a\xf8\x9b\x4b\x1c\xab\x40\x8e\xad\x1c\xb0\x1e\x64\xc2\xea\x0c\xfa\x90\x73\x24\xf8\x8c\x0b\xca\x60\x80\xba\x65\x66\x43\x00\x76\x60\x22\x46\xa9\xcd\x3b\x5d\x04\xf8\xf0\xe1\xb0\xc4\xd2\x55\x41\x59\x19\x30\xe5\x44\x45\x13\xff\x4d\x08\xab\xfa\xbf\x34\xcc\x4e\x9a\x7b\xa2\xbf\xcf\x79\x93\x87\x96\xca\x03\xb2\x24\xb3\x49\xf8\x72\xba\x92\x37\xb4\x18\x9c\x3e\x93\x7c\xa5\x0c\xfe\x4a\xe4\x79\x14\x93\x8a\xf3\x78\xc8\xa6\xb1\xa0\x91\xd2\xdd\x5b\x25\xb8\xb9\x4f\x46\x39\xea\xbd\x0c\x1e\xc5\xbc\xd5\x4d\x51\xbf\x64\x2f\xfc\xa4\x0b\xdd\x6f\xe0\x90\xa6\x73\xf4\xbc\xc2\x02\x67\x0a\x67\x88\x55\x18\xf7\x97\xee\xa1\xb5\x88\xce\x4b\x47\x7e\x0e\xb4\x83\x58\xb9\x45\x9f\xaa\xb8\x0e\xf0\x2c\x2b\x04\x5f\xe5\xa0\x03\xe3\x8c\xc9\xaf\x63\x73\xff\x00\x19\x1c\xa3\x9a\xc5\x17\x5b\x2d\x1a\xb6\xf2\xed\x6d\x3f\xa4\x6d\xf9\xaf\x75\xca\x7f\x49\xee\x16\x6a\x52\x6e\x5c\x31\xdc\xd3\x87\xa9\x2a\xdf\xfd\x5e\xa7\xb1\x4d\xda\x94\xea\x75\x13\xdb\x60\x80\xc9\xf6\x62\xfe\xf4\x17\xf3\x15\x1d\xbe\x8a\x28\xf3\x04\x33\x56\x55\x4e\x6a\x13\x97\x8e\x56\x65\xb3\x5c\x6f\xa4\x16\x52\x9a\x4a\x47\x31\x79\x87\xc7\x55\xea\xf0\xa6\x34\x3a\xdd\x6d\x5d\x2a\x51\x23\x3c\x46\xb3\x8e\xbc\x16\x20\x5f\x4e\xd4\xed\x96\x04\x93\x9a\xe7\x4d\xd8\x67\x88\xdd\xb6\x55\x3b\x33\x41\x39\xa1\x68\xcc\x01\x93\xe3\xda\x34\x11\x76\x7d\x9c\x9a\x01\x3a\x83\xfe\xbd\x4e\x4b\x29\xe0\x96\xce\x4b\xd5\x6d\x27\x7d\x28\xa0\x75\x8b\xa6\xb3\xec\xf1\xf4\x9b\xd2\x77\x8b\xdb\x4d\x66\xec\x20\xcd\xb3\xcd\x76\xcd\xec\x48\x67\xd0\x0f\xb1\x6b\x92\x63\xe1\x6c\xf5\x80\x8f\xb6\x4b\x23\xf8\x64\xf4\x3d\xbf\xb1\x50\x4c\x2c\x09\x88\xc1\x90\x67\x5f\xfc\xd1\xfa\x19\x5a\x5f\xf7\xad\xff\xf7\xe5\x3f\xff\xf3\x5f\x1b\xb9\xa0\xa4\x73\x96\xdb\x10\x28\x26\x38\xaa\xe8\x4d\xb8\x79\x04\xe9\xea\xed\x23\xc8\x47\x98\x04\x8e\xd3\x9b\x60\x62\x9b\x88\x24\x7b\x43\x17\x38\xad\xae\xa8\xd5\x57\x91\x50\x08\xdd\xa7\x46\x90\xa7\xc1\x70\x9c\x9e\x8b\xbf\xda\xea\x78\x65\xdf\xdc\x70\xc7\x19\x60\xff\xf5\x06\x08\x28\x84\xae\x9a\xb0\xa4\xdc\x16\x0d\x29\x3b\x41\x67\x23\x5a\x41\x44\x6d\x9b\xa1\xda\x5d\x2c\x1f\xd1\xd8\x8a\x19\xed\xdd\xc4\xc5\x2b\x8d\x20\xf1\x71\x05\xab\x7f\xa0\xa1\x76\x5e\xc6\xfd\xf5\xc5\x1f\x9c\x9d\xcb\xd4\xda\x11\x4d\x3d\x33\xfd\x2a\x53\xf5\xe6\xb4\x08\x3d\x67\xf9\x01\x89\x5c\x84\x88\x6e\x1a\xb6\x51\xd4\xe0\xa1\xbc\x0a\x28\xe4\x70\xe6\xa5\x65\xdc\x63\xca\x04\x0c\x35\x56\x32\x40\x1e\x0d\x49\x73\x4e\x32\x9f\xbc\x02\x5b\x0a\x05\x26\x6c\xbe\x15\x84\x49\x06\xf4\xe9\xe4\xe4\xf8\xf3\xe1\xb1\x75\x76\xfc\xf9\x97\xe3\xcf\xd6\xd9\xa7\x37\xe7\x5f\xf6\x3f\x1f\x3f\x82\x81\x51\x43\xb0\xe2\xde\x73\x82\x3d\x46\x39\x1d\x0a\xb0\x7f\x76\xda\xfb\x78\x7c\xbe\x59\xc3\x88\xba\xd7\xec\xf3\xf8\x23\x12\xd6\x2f\xda\xaf\xad\x02\x12\x7b\x2f\xbb\x15\xb7\x9f\xfd\xb3\x53\xc7\x51\x00\x66\x8f\x55\xba\xd0\xef\x5d\x2a\xaf\xaf\x7f\x79\x7f\xfc\xe5\xec\x7c\xff\xfc\x78\xc3\x0e\xf4\x39\x18\x05\xe6\x98\xf7\xef\xcf\x9a\x99\x63\x56\x66\x06\x83\x75\x8d\x30\x57\x9d\x41\x3f\xdb\x7b\xab\x3b\x78\xff\xfe\xac\x30\x0c\x14\x3c\x3f\x2b\xcb\xe2\xba\x4a\xc2\xfb\xbe\x60\xe2\xd3\x09\x5f\x3c\xc9\x0b\x4f\x56\x07\xfa\x15\xa4\xe6\x97\x2c\x08\x94\xef\x03\x58\xdc\x8b\x2f\x98\xfc\xf4\xe3\xec\x0b\x26\xff\xfd\x9f\xf7\x47\xfe\xfd\x87\x03\x46\x29\x19\x2a\x1d\x78\xa2\x37\x4c\xd7\xda\x7a\x68\x84\xa0\x64\xfa\x18\xf3\xb5\x2d\x6c\xf2\x7d\xc8\x1b\xda\x30\xb4\x34\x30\xc5\xc2\x4a\xef\xf5\x51\x0c\x7f\x7b\x77\x7e\x7e\x5a\x47\xcc\xac\x99\xc5\xb5\x34\xc7\xa3\x66\x73\x55\x60\x4a\x22\x77\x54\xa1\xd6\x13\x8f\x21\x81\xfe\xeb\xb1\x3c\x12\x66\x40\xdb\x33\x13\xdb\x37\xbc\xc2\x49\xba\x94\x13\x90\x02\x0c\x2c\xf0\x20\x2f\xc5\xd6\x1c\xd9\x48\xda\xd6\x39\xcf\xeb\xe4\x06\xa5\xdb\x59\x6e\x27\x27\x04\x79\x15\xee\xfd\x07\x96\x06\xba\x32\x93\x3c\x51\xc5\xc8\x6d\x6d\xa0\xf5\x6a\x03\x49\x0e\xc7\x11\xf1\x46\x30\x84\x2e\x37\x11\xea\x9a\x5a\x8a\x89\x49\xc0\x8d\xd5\x4d\xfb\xce\xb2\xcc\x6d\x2c\x5f\x60\xf8\xfc\x1f\xa7\x9f\x42\x1c\x8c\xc4\x4c\xcf\xde\x55\xc1\x46\x96\xb5\xb7\x14\xd5\xab\x5e\xed\x0c\xfa\x62\x1a\x53\xfd\xbc\xa7\x9f\x5f\xbb\x5b\xd9\x0a\x37\xaf\x1c\x10\x7c\x8a\x11\x01\x67\x34\x91\x57\xb0\xc3\x93\xb3\x2d\x03\x7d\x2e\x47\xa0\xb1\x3f\x47\x6e\x67\x05\xf3\x14\x88\x60\xbf\x75\x92\x7f\x38\x31\x66\xa0\x6d\xab\xb6\xfc\x1e\xe8\x50\x6d\x65\x55\x57\x02\xf5\x1c\xc7\x55\xc1\x53\x4f\x46\x8d\x1c\x73\x15\x02\xf3\xcb\x72\x6b\xd3\x35\x28\x73\x9b\xb7\xd4\x28\x6f\x29\xd7\xcd\xe9\xdf\x15\x5d\x8d\xba\x40\x81\x3a\x0a\xf6\x57\x44\x02\x44\x04\x56\x83\x22\x62\x05\x6e\x7e\xe8\xf4\x30\x96\x9e\x56\x71\x40\xef\xaa\x8f\x6b\xa6\xc9\x1c\x84\x34\x78\x94\x9a\xdb\x2b\x4e\xaf\x04\x15\xe4\x74\xaf\x43\x1a\xfa\x12\xfa\xe6\x67\x77\x5f\xee\x84\x7a\xff\x8d\x42\xfe\xc6\x8d\xb6\x87\x94\xde\x62\x73\x0e\xce\x3f\xfd\xf5\xf8\xe3\xeb\x06\x66\xd8\xef\x2c\x0b\x9c\x9d\xef\x7f\x3e\x07\x7a\xf4\x73\x18\xa8\x70\xd0\x61\x54\x69\x84\xcd\x0d\x63\x7b\x43\x55\x3c\x6a\xf5\x3b\xed\x19\x66\x97\x0e\x7a\x39\x4e\x41\x8d\x03\x0f\x1a\xd8\x68\x9f\x99\x99\xb5\x74\xb4\x16\x18\x04\x68\x90\xa2\xdb\x2e\xf7\x31\xb7\xb3\x80\x6a\x36\x23\x5c\x7a\x57\xad\xa7\x8a\x61\x12\x6e\xea\x6e\x96\xde\xae\x8c\x33\xb9\x3b\x50\x95\xbd\x4d\xc4\xbe\x4f\x27\x24\xa4\xd0\xe7\x33\x1c\x49\xe2\x9a\x8d\xb1\x8f\x28\x97\x37\xaf\xce\xa0\xef\x89\xc1\x50\x3f\xa8\xcb\x81\xcc\xbc\x0c\x5a\xed\x62\x2b\x0e\x13\x29\x66\x61\xeb\x86\x1a\x67\x53\x57\x18\xf6\x4c\x56\xe3\x87\x2a\xaf\xfd\xa6\x12\xfc\x32\x08\xb4\x7b\x5e\x30\xe8\xdd\x72\x64\x3a\xeb\xd8\xea\xa3\x2d\x38\x22\xfe\x06\x8a\xa2\x49\x44\xa5\x13\xf6\xb0\xb0\x17\x80\x29\xc6\x59\x1c\x23\x16\x61\x52\x11\x28\xd3\x4a\xbd\x25\xc9\xb3\xe7\x13\x82\xfc\x98\xd3\x65\xad\x73\x1b\x94\xf9\x3b\xbf\x3c\xa5\x64\x60\x05\x9a\x00\xca\x9d\x1a\x3c\xa2\xfc\xd1\xe3\x4c\x52\x0a\x49\x67\xbf\x1e\x26\xc4\x13\x98\xaa\xe4\x92\xd6\x6b\x1a\xa6\xb3\x94\x15\xb5\xa5\x82\x12\x81\x9f\xe3\x45\x52\x03\xb6\x3d\x8d\xdf\xe4\x69\xd4\x32\x56\x6d\x61\xb9\x80\x4d\xbc\xd1\xd1\xc1\x66\x5c\x8a\x6a\x68\xbb\xaa\xaa\xf2\xa3\xa8\x1c\x9e\x04\xc6\x77\x7b\x8b\x15\xc2\x0b\x30\x42\x44\x55\xc7\xb3\xe7\x9b\xb4\xad\xdd\x51\x09\x11\x3d\xdd\x69\x72\xf5\x12\x27\x2e\x7d\xd4\x22\xa1\x57\x7a\xca\x27\x72\x4b\x1d\xb3\x10\x92\xe0\xf1\x79\x92\x9e\xb7\x03\x3e\x9d\x9f\x4a\x1d\x5d\x9d\x83\xfd\x83\xcf\xd6\x37\x53\x3d\x4d\x32\x11\xc4\x9a\xf6\x40\x23\x98\x20\xf9\x1f\xef\xa1\xa4\x90\x04\x19\x1c\x8a\x16\x72\x08\x57\x30\x77\xc7\x71\xb3\xd1\x1d\xc7\x7d\x1a\x92\xfb\x07\xc6\x4f\x90\x39\x98\x1b\xb7\xb0\x10\x6a\x16\x9f\x3d\xc5\x3a\x04\x53\x4e\x6c\x6e\x33\xce\x8e\x2a\x8a\xe8\xec\xa0\x3b\x81\x18\x81\xa1\xb3\xb3\xf7\x0f\x8c\xe7\x00\xaa\x32\xf7\x0b\xa2\x55\xc7\xce\x01\x31\x8d\x91\x9c\x00\xfb\x3e\x22\xce\x0e\x18\xc3\x30\x91\x5f\x5c\xe8\xb0\xf8\x3f\x5a\x3f\x5f\xfe\xf3\x3f\xff\xf8\x2f\x67\x07\x98\x08\xbb\x7f\xbc\x7f\x7f\x7d\x78\xf6\xf9\xcd\xb5\xb2\x12\x39\x3b\xc0\x71\xec\xe5\xa1\xbf\x73\x9c\x8b\xc3\xa3\xfd\xf3\x7d\xc7\xb9\xf8\xc7\xfb\xf7\xd6\xc1\x87\x4f\x87\x7f\xb5\x3a\x83\xfe\xbb\xe3\xfd\xa3\xd9\xc1\xa7\xa3\x7f\x58\x07\xc7\x6f\xdf\x7f\xd4\x7f\x1e\x7f\x3c\xea\x3a\xce\x65\x23\x53\x91\xbe\x0d\xdb\x79\x28\xff\xfc\x2f\xc7\xb1\xa7\x18\x2b\x7d\xf0\xfb\x45\x88\xcc\xf7\x9d\x41\x7f\x0c\x43\xec\xab\xf8\xd0\x19\x54\x7d\x83\xde\x50\x16\x75\x73\x3a\xe4\x32\x00\xdb\xfc\xcc\x6d\x7e\xe6\xaa\x57\xd3\xe8\xb1\xfc\x69\x6c\x94\xa8\xe9\x49\x5e\xe7\x45\xbc\x54\xcb\xd3\xfc\x30\xbd\x4a\xb5\x71\xe3\x2a\x65\xbc\x66\x84\x27\xe3\xbe\xcb\xd2\xa5\x78\xb9\x60\x41\xca\x2c\xef\x55\x61\x64\x72\x0d\x89\x03\x9e\x69\x7a\xf7\xa2\x64\x2a\xc7\x0c\x28\x93\x50\xa0\x0d\x73\xf5\xd2\x5c\x8f\x24\xb5\xc0\x66\x25\x17\x68\x43\x7a\xad\x42\xef\x4a\x29\x06\xd6\x90\x64\x60\x0d\x69\xb6\x0a\xa0\xcd\xc5\x63\xdf\x97\x72\xa0\x92\x54\x41\x4d\x69\xb7\x62\x25\xa0\x5c\xea\x81\x66\x92\x0f\x34\x97\x7e\xe0\xdf\x22\x84\x7b\x85\x94\x04\x4d\xc3\xb5\xab\x24\x26\x68\x22\x7a\x97\xa5\x27\xa8\x2f\x72\x8d\x90\xab\x90\xbb\x5f\xa7\xe0\x38\x08\x9e\xa6\x30\x9a\xfa\x46\x32\x49\x09\x06\x0a\x02\xc3\x2f\x63\x79\x22\xb2\x84\x4c\xc7\xf1\x5f\xaa\xff\x6d\xc2\x00\x6a\x26\x2e\xc7\x11\x16\xa8\x22\xac\x6b\xf3\x05\xf3\x95\x13\xcd\x83\xca\xdd\xac\x00\xd2\xb8\x9a\xc9\x6b\xfb\x95\x7d\xe9\x38\xbd\xee\x20\xfb\x81\x20\xd1\xb5\xe7\x26\x11\xdb\xd6\x25\xe8\xcc\x9b\xaa\xd0\xfe\x0d\xb7\x43\xdf\x0e\xfd\x0d\xa0\x54\xcd\x51\x8a\x50\xca\x39\x38\xc5\x77\xa8\xc2\x07\xb9\x21\xb2\x13\x50\x11\x1a\xe3\x3c\xbe\x33\x7e\x45\x9b\xff\xb0\x01\xea\xf2\xa1\x80\x3e\xe2\xb7\xe9\x5c\xe5\x48\x99\xc6\x82\x5a\x1f\x28\xad\x08\xc0\xde\x58\xf2\xef\x95\xed\x69\x18\x42\x4a\xc5\xbc\xda\x41\x8e\x8e\xae\xec\x09\x72\x4d\xe6\x7d\xcc\xe8\xc2\x2f\xfa\x5d\xf3\xea\xe2\x8f\xb6\xc7\xc2\xd6\x3b\x32\x69\x36\x97\xc1\x5b\x9e\x75\x9e\xb8\x68\x73\x85\xeb\xcc\xeb\x9d\x41\x7f\x85\x72\xa8\x8b\x1e\x24\x2e\xf2\x74\x17\x2f\x79\x64\x77\x63\xf5\xed\x5e\xee\xf1\x14\xc2\x75\x1c\x91\xf7\x9a\x7c\x9a\xd9\xb6\x8e\x91\x67\x62\xa4\x5c\xc7\x31\x62\xf6\xb0\x94\x63\x24\xc3\xaa\x14\xe8\x37\x94\x08\xa0\x4b\x3b\xb7\x1d\x75\x20\x27\xb7\xa6\x09\xde\x84\x50\x96\x63\xf7\xf8\x88\xfe\x46\x43\x28\x4a\xf5\x97\xa3\x9f\x9e\xba\x31\xa1\xff\x93\x2e\x1d\x03\xc6\x52\x45\xd9\x68\xb1\x4e\x29\x51\x7e\x2a\x6f\xbf\x77\xf4\xee\xfc\xe4\xc3\xaf\xb5\x71\xf2\x01\xbb\x0c\xae\x11\x5b\x51\x81\x14\x5f\x3e\x78\x27\xd9\x29\x6d\xbd\x96\x86\x44\x82\x1a\xbe\x94\x2c\x4e\xc0\x29\x0d\x69\x4c\xab\xdc\x64\xeb\x47\x39\xa9\xaa\x33\xf9\x02\xf1\x33\x1c\x05\xfa\x13\x67\x5e\xf7\xb5\xb3\x63\xc7\x06\x84\xeb\xe1\x72\xee\x5d\xfb\x06\xa5\x6d\x0c\xf4\xfd\x18\xe8\x1a\x7c\x16\x0a\x74\xb7\x18\x89\xe7\x47\x56\xba\x6f\x85\xd4\xf5\xf1\xe3\xc3\x9d\x42\xf3\x38\x54\x9f\x90\xeb\xf7\xfc\x84\xba\x38\x44\xaf\xe7\x5a\xd3\xd1\xc7\x8f\x9f\x12\x11\x27\xe2\x50\xf5\xdc\x5e\x2a\xe8\xfa\xab\x75\x48\xa3\x98\x21\xce\x33\xd1\x78\x44\xc5\x47\x24\x3e\x26\xb7\xa8\xc0\xa6\x99\x92\xee\x77\x96\x25\x75\x8e\xf9\xf3\xe0\x90\xb2\x98\xb2\x7c\x4d\x8d\xf4\xb9\xa3\x8f\x1f\xc1\x69\x08\xc5\x90\xb2\xe2\xfa\x16\xe6\x05\x79\xa7\xf0\x09\xf1\xa8\xea\x91\x9a\xaf\xa1\x65\x7e\x69\xda\xa1\xae\x68\x45\xdb\x7a\x10\xdb\x7a\x10\x4b\x63\x6c\xeb\x41\xdc\x7f\x72\x5b\x0f\x62\xe9\xf9\x6d\x3d\x88\x87\xd4\x83\xf0\x09\x91\x6b\x99\x54\x14\xee\x3e\x3a\x7f\xbb\x29\x9d\x4b\x57\x18\x3c\xc3\x02\x81\xdc\xdd\x59\x4e\xb8\x61\xed\xea\x84\x12\x65\x35\xdb\x80\xb7\x6e\x2d\x7d\x2a\x37\x59\x44\x09\x55\x11\xd8\x88\xad\xbe\x7a\x2c\x0f\xd3\x1e\xa3\x3f\x81\x82\x46\xb4\x19\xaf\x5f\x32\xe9\xad\x00\xb0\x10\x53\xa0\x0d\x66\xbd\x04\x4e\x8c\x27\xf8\x56\x21\x6b\x96\xfe\x19\x8f\xe2\x35\x5c\x4a\x00\xec\xc0\x38\x0e\x91\x85\x45\x42\x10\xb7\x60\x1c\x4b\x14\xc1\x38\xb6\xb0\xff\xfa\x4f\x3f\xfd\xe9\xc7\x1f\xfe\xfb\xcf\x7f\xfe\xd3\x7d\x1f\xd7\x82\xc2\x23\x45\x97\x42\xea\xec\x54\x42\xd3\x05\xd6\x42\x06\xb9\x14\x94\x73\x04\xde\x1f\x8a\xd2\x20\x44\x56\x1c\xc2\xe9\xd2\xfc\x94\x05\x72\x65\x66\x85\x91\x52\x35\x7f\x2c\x17\x83\x0f\x89\x34\x90\xf3\xe4\xe3\xb1\x40\x53\xce\x14\x99\xf3\x66\x32\x5c\x41\x1d\x9f\x87\x7c\xcf\x17\x41\x8f\x14\xf6\xbb\x39\x82\xc4\x6b\xb3\x04\xf4\x82\xa8\x3b\x45\x2c\x04\x7a\x86\x5c\x5a\x41\x4e\x5f\x5f\xd4\xfa\x56\x3f\xbe\x9a\xfd\x6d\x98\xb9\x49\x50\x1e\xdf\xea\xe7\x38\xae\x9c\xd8\x71\xdc\xce\xa0\x0f\x06\xf6\x60\x3c\xf8\xd6\x3a\xb7\x22\x16\x36\x89\x3a\x94\xcf\xfb\x6a\xc7\x4b\x2d\x38\x90\x10\x44\x37\x13\x78\x78\x78\x72\x06\xcc\xf8\xed\xd3\xdc\xbd\xcb\x5b\xb6\x90\x07\x25\x77\xef\xab\x78\xe8\xfa\x77\xb7\x7b\x17\x89\x46\x54\xd9\x19\xf4\xf5\x84\x9d\x41\xff\xfb\xd9\xfc\xd2\x30\xbb\xb8\xb2\xad\xcb\xee\xcc\xf4\x1b\x76\xbb\xef\xce\xcf\x4f\x8f\xba\xdf\x02\x99\x2e\x06\x94\x83\x8a\xe4\xf0\xad\x07\x60\x23\xac\xa2\x99\x07\xc0\x57\x47\xa7\x54\xc9\x86\x6c\x82\x2b\x8c\xff\x05\x97\xbb\x5a\xd2\x6c\x61\xfc\x15\x9b\x60\x7e\xdf\xa4\xbc\xaa\x92\xf7\x34\x46\x84\x2b\xbd\xa8\xa7\x34\xaf\x72\x74\x09\xe8\xd3\xa8\x22\xb0\xb0\x41\x95\x7d\x85\x12\x39\xea\x11\x8d\xee\xd9\xe6\x72\xb6\x88\xf4\x91\xef\xf3\xa8\x4c\xbf\xb4\x0e\xdf\x1f\xad\x55\x31\xd5\x8c\x74\x95\xf9\x41\x85\xe3\xf4\x7c\xb3\x46\xdd\x9e\xe8\x02\x5a\x5f\x2f\x4d\x5c\x55\xbb\x78\x4f\xe7\x91\xb3\x94\xe1\xfb\x03\x1e\x22\x70\x4c\x82\xca\x34\xcf\xa7\xc8\x1d\x5b\x06\x70\x0d\xad\x69\xcb\x28\x1f\x81\x51\x96\x48\xaa\xad\x62\xd0\xbe\x62\x50\x79\xf6\x43\x64\x11\x34\xe1\x3d\x56\x98\x37\x23\x4f\xd6\x39\x74\x43\x54\x51\x7a\xe8\x11\xbc\x90\x19\x24\x2d\xb7\xbe\xbd\xf9\x5b\x82\xd8\xb4\x1e\xe5\x15\x2f\xb3\x0e\x09\x3e\xd4\x38\x74\xf3\x9b\x84\xf4\xa2\x67\x5d\x1a\x82\x7d\xe1\x38\x7e\xf7\xe2\xca\xbe\x7c\xb1\xe8\xa1\x51\x4f\xcf\xa9\xda\xd6\x2f\x16\x36\x45\xc9\x0d\x6e\x70\xab\x1e\x1d\x8c\x95\x11\x8a\x63\x4a\xba\x83\xd7\x2b\xaf\x73\x1b\x23\x68\x49\x9e\x06\xa2\x06\x3e\x40\x49\x23\x42\xd3\x48\x49\x2a\xf8\x11\x9c\x56\xf6\x05\xdd\x20\x3d\xb7\x2b\xbf\x03\x2c\x46\x89\xab\x2c\xd2\x18\x46\xb7\x09\xb1\x7d\x38\xbd\x29\xac\x4d\x74\x84\x5c\x0c\x37\xa9\x69\xe6\xc7\x5f\x21\xc5\x3a\x83\xbe\x7e\x64\xe6\x53\xe1\x23\x77\xd6\x89\xa9\x80\x82\xce\x26\x94\xfa\xd3\x19\x87\x2c\x40\x33\x24\xbc\xd1\x2c\x44\x84\x4c\x67\xfc\xb7\x04\xa1\xaf\x68\x36\x19\x21\xf4\x75\x3a\xbb\x41\x9c\x63\x34\xe3\x82\xa9\x67\xdc\x84\x0b\xc4\x66\x1c\xfb\xdd\xa7\xcb\xf6\x55\x9c\x54\xad\xa9\xd4\x10\x80\x7c\xf4\x88\x2d\x0c\xcd\x80\x3e\xf2\x11\xbc\x81\x77\x6d\xf1\xca\xad\x9a\xf4\x1c\xee\x93\xc8\x47\x15\x09\x58\x47\x98\x21\x4f\xec\xfb\x51\xd5\xad\xf2\x1d\xe5\xea\xa4\x9f\x42\x82\xc2\x46\x07\x7d\x3e\x03\x38\x82\x28\xa2\x24\xd7\xff\xb2\x22\x62\x41\x3b\x7e\xf6\x72\x43\xec\xda\x70\x4f\xd9\xc3\x0f\x29\x11\x8c\x86\x1a\x9e\x4d\xfb\x81\xb6\xd4\xbc\x55\xfa\xff\xed\x94\x7e\xe5\x58\x51\x27\x0f\xca\x93\x57\xce\x45\xb8\x47\x13\xc6\xab\x0c\x2d\x88\x73\x18\x20\x70\x40\x21\xf3\x1f\xbf\x86\x61\x06\xa5\xf1\x3c\xa4\xfb\x2a\x75\xe3\xe5\x46\x02\xb5\xd8\xc2\xe7\xc4\x9d\x02\x4a\xc0\x67\x88\xc3\x9a\xb5\xc5\x1f\x10\x66\x34\xa7\xcf\x88\xfa\xd7\x9d\x41\x9f\xc9\x69\x67\x0c\x7a\xb7\x15\xa1\x46\x2b\x9e\x7f\x18\xc9\xe6\xe6\x4a\xf3\xfb\x60\x1c\x87\xd8\x53\xa1\x64\xd6\x05\xb4\xbe\x3a\x8e\x7f\xf9\xcf\x9f\x7e\xfc\x97\xdd\xc4\x25\x2d\x51\xc7\xd9\xd0\x8a\x21\x83\x91\x62\x6b\xaa\xeb\x1f\x11\xd8\xc3\x62\xaa\x3b\xa9\x7e\xbf\x1a\xf6\x46\x1e\x6c\xb9\x71\xcf\x23\x55\xbd\x33\xe8\x9f\x50\x12\x30\x14\xce\xbe\x1c\x1f\x30\xec\xdd\xce\x24\x70\xdd\x67\x1d\xab\x54\x3a\xda\x7d\xde\xc3\x12\x77\x6a\x2d\xd7\xea\x00\x4d\x7c\xc3\x72\x04\x4a\x14\xfd\x36\xb3\x5b\xa4\x27\xbe\x54\xe1\xc6\xdc\x4b\xbe\x7e\x07\x2a\xc2\xa7\x9f\x01\xf3\x52\x50\x16\x37\x3f\xd9\xaa\x32\xd9\xaf\x4f\xad\xca\x94\x11\x66\x26\x5a\xe5\x86\x96\x9a\x1f\x30\xff\x2d\xa9\xaa\xe4\x4d\xa3\x08\x11\x51\xef\x02\x5e\xa8\x67\xa7\x2d\x5b\xb1\xff\xda\xd9\xf1\xd5\xb4\xd7\x62\xc4\x10\xf4\x4b\xfa\x56\x9b\x97\xcd\xe3\x09\x0b\xdb\x76\x38\xa8\x81\x4b\xd5\x8e\x1b\x48\x82\x8a\x0c\xcd\x36\x7a\x7b\x77\x06\xfd\x38\x9f\xe2\x65\x6e\x25\x6a\x76\x90\xa3\xd4\x5d\xc7\xb1\xe1\xde\x6c\x1e\xa5\xfb\x42\x45\xe9\x5e\x38\x3b\x7f\xb8\x94\x72\x2d\xc2\xbe\x1f\xa2\x09\x64\x48\x49\x32\xf5\xbd\x7a\x6c\x6f\xf3\x85\x28\xa7\x62\x54\xb7\xca\x72\x9b\x27\xdf\xa8\xca\xbc\xab\x01\x28\x8c\xc8\x7d\xc6\x47\x5b\x01\xde\x4c\xec\x28\xca\x88\x19\xbd\x41\x5e\x69\x2a\x97\x21\xa1\x47\x34\xf7\xb4\x44\x4e\x0b\x27\xaf\x82\x9c\x36\x55\x48\xea\xd1\x4e\xe5\xc6\x28\x70\xbe\x98\x02\x3d\xf5\xde\xa9\x2d\x47\x35\xd8\xb0\xa6\x5a\xeb\x24\x83\x6f\x5e\x5f\xbd\x7f\xe2\x41\x13\x65\x75\xe5\xe9\x07\xf5\xf9\x86\xe5\x45\xe5\xb9\x7e\xd4\x4b\x38\x4c\x58\x95\x6a\x20\x9f\x93\xba\x81\xba\x93\x81\x73\x4a\xab\xcc\x76\x1b\xd0\x5a\xaf\xe6\xc0\xae\xd3\x03\xe9\x33\x82\x5e\xcd\xe0\xeb\x9c\x1f\x66\x13\xcc\x66\x77\xde\xd2\x9e\x29\xa0\xaa\x38\x43\xee\x5d\xf5\x42\x67\xd0\xb7\x26\x58\x8c\x2c\xe8\xfb\x94\xf0\xee\x60\x0d\xef\x5c\x3a\x50\x89\x73\x4e\x3d\xb2\xe0\xf7\x5c\x86\xb1\x75\xef\x9b\x9a\x72\xa9\xad\x58\x89\x29\x6f\x82\xdc\x18\x7a\xb7\xf5\x76\x75\x45\x68\xce\xda\xbb\xf9\xf4\xda\x84\xb2\xaa\xe9\xe5\xf7\x34\xc2\xec\xfa\xbc\x21\x3b\x48\x3d\x5c\x12\x80\x43\xbd\xdb\xaa\x50\x67\xd5\xa2\x0c\x93\xca\x3a\xa9\xa5\x79\x84\xe7\x23\xcc\x81\x67\x2e\x1f\x98\x03\x74\x17\x23\x4f\x68\x51\x2c\x46\x08\xf8\x0a\x10\xf0\xee\x78\xff\xc3\xf9\xbb\xc3\x77\xc7\x87\x7f\x05\xc0\xb2\xf6\x5a\x52\x73\xab\x90\xa5\xee\x57\x0a\x82\xd2\xfa\x10\x47\xf4\xa6\xe2\xf6\xb0\xf9\x68\x85\xf9\xe9\xf6\xe9\x0d\x55\xff\xd3\x27\xfc\xce\x6f\x3f\x93\x5c\x13\xd2\x0d\x15\x94\x86\xb7\x58\x94\x4b\x99\x5b\x44\x2b\x24\xcc\x87\x35\x62\x91\xcd\xb8\xa5\x14\xa6\x4a\x6a\x30\x01\xc3\xb9\x56\xa7\xde\x9a\xfd\x1f\x1c\xc5\x94\x09\xe0\xec\x5c\x5c\x39\x3b\x97\x2f\x7d\xf5\xf5\xb5\x1b\x26\xa8\x8d\x0a\x1a\xab\x60\xdb\x1a\x55\xb2\x5f\x9f\xda\xa8\x52\x22\x54\x7e\x55\xbe\xeb\xa6\x8a\xc2\x13\x44\xe6\xdc\x49\x40\xaf\x3d\xca\xd0\xe3\x09\xe9\xbe\x6d\xab\x69\xad\x54\x27\xad\x29\xa9\xb7\xf1\x4e\xb9\xc1\x9f\x79\xbc\x53\xc9\x36\x1e\xfe\xf5\xd8\xc7\x92\xc3\xd5\xda\xc8\xcf\xd8\x1b\x81\x73\x74\x27\x80\x7e\xeb\xdb\xd7\xba\xfa\xb6\xed\xdd\x22\xb5\x98\x66\xb7\x31\x25\x0d\x4a\xcd\x37\xf4\x36\xf9\x82\x6f\x2b\x7a\x64\xc8\x27\xda\x31\xcc\xd2\xdb\x64\x82\x6f\xf1\xf5\x75\xbe\xb1\xad\x96\x90\xa6\x22\xe9\xff\x9e\x3f\xd3\x82\x3c\xbc\x4a\x17\xd8\x01\x9f\x51\x88\x20\x47\x40\xd2\xba\xb5\x75\x3d\x3c\x23\xda\x6e\xda\x12\x5c\xeb\xc6\x9a\x4a\xca\x15\x40\xe1\x85\x08\x56\x5d\x26\x6a\xa9\x80\xe9\x58\xd6\x99\xaa\x47\x68\xe5\x4b\x9b\xac\x3e\x00\xcd\x89\x77\x4b\x8a\xdf\x14\x29\x2a\x06\xab\xa9\xa2\x9c\x0a\x13\x37\x44\x87\x21\xf6\x6e\xc1\xbe\x0f\x8e\xef\xbc\x11\x24\x01\x02\x9d\x7d\xff\xd7\xee\x53\xd7\xe6\xd4\x89\xd7\x7c\x4a\x7c\x13\xf9\x90\xab\x63\x0a\x7d\x9b\x8f\xe8\xe4\x1a\xfa\x7c\xa9\x28\x8e\x88\x3d\xc7\xe9\x15\xbd\xcb\xe1\x10\xa9\xea\xb1\xf3\x17\x0a\x1e\xed\xbd\x80\x6e\xb0\xa1\xde\x6e\xbe\x42\xbb\x27\xd1\xee\x4e\xf5\xfc\x1a\x3a\x1a\x26\xaa\x29\x95\xed\xe3\x00\x0b\x18\x5a\x11\x64\xb7\x48\x60\x12\xd8\xd0\xb7\x90\xd9\x9e\x92\x1b\xf7\x7c\x3b\x0f\x61\x14\x43\x1c\x10\x70\x02\x09\x0c\x10\x03\x9d\xa3\xc3\x93\x27\xdf\xd3\x1f\x23\x9f\xe8\x4a\xaa\xcf\x03\xab\x9e\xc1\x92\x15\x69\x2c\xd5\x42\xed\x9b\x90\x52\x5f\x75\xb8\x7f\x6a\x74\x9a\x66\x90\xb6\x3d\x0c\xe5\x39\xc8\x61\x60\x13\x48\xe6\x49\x1c\x53\x26\x7a\x39\xdc\xfa\xdc\x86\x84\x4f\x10\xb3\xff\xfb\x8f\x3f\xfe\xfc\xa7\x1f\x7e\x1a\x8c\xc2\xd7\xc5\x0d\xb4\xf3\x58\x1c\x52\x06\x4e\x13\x37\xc4\x7c\x84\x18\x07\x9d\xa3\x37\xa7\x4f\x4e\x9e\x7a\x65\x02\x06\x1c\xb1\x31\xf6\x10\x4f\xdb\x3d\xc2\xc0\xbe\xe1\x76\x10\x8b\xce\xa0\x7f\xad\x2b\x3d\x6c\xa4\x12\xa1\xee\x39\x3a\x47\xef\x30\x2e\x46\xe5\x18\x09\x88\xc3\x2f\x9f\x4f\x37\x55\x18\x26\xc4\xe4\x56\xb9\xea\xd2\x8a\xfc\x8e\x63\xe7\xa6\x75\x9c\xe2\xfa\x7b\x66\x88\x8a\x17\x1e\x50\x3a\x66\x5b\x86\xec\x69\x7c\xa6\xdb\x32\x64\xb9\xe7\xb6\x65\xc8\xb6\x65\xc8\x7e\x27\x65\xc8\xb4\x02\xa5\x59\x35\x26\x02\x31\x82\xca\x63\x6b\xe8\xdd\x34\x40\x15\x59\x2d\x0f\xf7\x91\x9b\x81\x3a\x83\xbe\xf2\x8b\xbd\xd5\xf6\x13\x53\xa1\x4c\x83\x30\xaf\x83\x32\xcb\x24\xd6\x4b\x5f\xff\x26\xc5\x37\xe7\xed\x38\x2f\x96\x26\x2b\xb6\xd0\xd4\xc0\x34\x17\xd0\xbb\xed\x91\xd0\xfe\xff\xf8\x38\xc2\x82\x61\xdb\x00\x5c\xac\x7f\x32\x04\xa3\x2f\x08\x8e\xab\xfc\x8f\x0b\x76\xc5\x75\x9c\x8f\x4a\xe2\x77\x06\xfd\xf7\x84\x0b\x48\x3c\x74\x80\x02\x4c\xe4\xa8\xd0\x0d\xd1\x4c\xc1\x31\x51\x70\x74\x14\xa6\xbb\x02\xb2\x00\x89\xd9\xd1\x97\x0f\x70\x4a\x13\x71\x84\x86\x30\x09\x85\x4a\x11\x7e\x8c\xbe\xe2\x19\xbd\xdf\x6f\xff\xee\xcf\x61\xcd\x1a\xbf\xaf\xc6\x6d\x12\xc3\x87\x36\x6e\x97\xa8\xbf\x8b\x31\x53\xaf\xed\xfc\xf0\x33\xf8\x48\xc7\xe0\x87\x9f\xff\xf4\xe7\x85\x1a\x15\x6a\x26\xab\xa0\xf6\xec\xdb\x45\xeb\xa0\x7a\x56\x59\xc4\xf9\x3d\xf9\x56\xb8\x81\xa6\x80\xf2\x8c\x8b\x69\x88\xba\x6a\x87\x9c\x1d\x5b\x22\x8c\xdb\x9d\x41\xdf\xd7\xbb\x33\x83\x61\xd8\x95\x9f\xc5\x08\x45\x88\xcf\x22\xea\x27\x21\xe2\xdd\x4a\x85\xce\x57\x40\x35\xad\x38\x5b\x6f\x2d\x5b\xd3\x52\xf6\xeb\x73\x36\x2d\x29\xe3\xbd\xda\xcf\x52\xcb\x92\x7a\xa2\xdd\xde\x73\x85\x44\xaf\xc8\xbc\x33\xe8\x2b\x3b\xbe\x1b\x52\xef\xf6\xe2\xda\xba\x4c\x07\x55\x7f\x43\x26\xd2\x7f\x67\x5e\x08\x39\x7f\xed\xec\xe4\x1f\x30\x4c\x43\xfe\x39\xc4\x28\xf4\x37\x1e\x32\xbc\xc0\x74\x2a\x68\xb9\x69\xa3\xba\x0a\x46\x04\x2a\x99\x11\x68\xcc\x90\x0a\xe9\x3b\x37\x60\x3b\xcc\x69\x19\x07\xab\x7d\x96\xf7\x18\xd5\xf2\x6b\xab\xb2\xa3\x1a\x30\xad\xe5\xf5\x6e\x5b\x79\x3e\xbf\xdb\x56\xe9\x68\xed\xb6\xf2\x5c\x64\x88\xa0\x8e\x79\x5e\xbd\x91\x75\x13\x2b\x63\xa5\x53\x02\x23\xec\x4d\x90\x5b\xad\xa2\xbc\xba\xcf\x52\xb3\xaf\x1e\xaf\x79\xf9\x3d\xe5\x39\x5b\xc1\x83\x8a\x16\x6e\x8d\x3e\x5b\xa3\xcf\xd6\xe8\xb3\x35\xfa\xe4\x9e\xdf\x1a\x7d\x1e\x6c\xf4\xc9\x78\x73\xcf\xbf\x2d\x13\x41\x82\xc1\x2a\x3d\xbe\xa6\x84\x51\x2c\x53\xce\x7b\x2e\x87\xb4\xfe\x72\x66\xed\x07\x88\x88\x87\x14\x6d\xf4\x05\x94\x43\xac\x57\x92\xac\x4a\x52\xa7\x8b\x2f\xb3\x87\x1d\x43\x3e\xad\x53\x96\xf1\x08\x8d\x8d\x21\x2c\x93\xcb\x0d\x6b\xbf\xdc\x59\x26\x2f\xcb\x72\xb5\xb4\x99\x4f\x0d\x3a\xbd\x17\xdd\xef\x0b\x2c\x04\xcd\xc5\x78\xb3\xfb\x4c\x3e\x36\xbe\x18\x01\xea\x97\xe5\x18\xf9\x65\xea\x5e\x2b\x85\xe4\xc1\x71\xf3\xcb\x50\x3c\x7e\xf2\xc2\xfd\xb8\x7a\x50\xe7\x36\x8e\x20\x9f\x22\x45\x00\x3d\x4c\x0b\x09\xf4\xd0\x3a\xfc\xfb\xc1\xf1\x66\x2e\xe2\x15\xa7\x13\x79\x5e\xe2\x2e\x77\x2f\x9a\x60\x72\x4d\xe3\x56\x4b\x08\x6e\x8d\x43\xad\xd3\xe5\x7a\xd9\xf7\xc8\xb3\xe4\x8e\x97\xa5\xdf\x1f\x87\x48\x9e\x52\x5a\x61\xce\xfe\x00\x89\xaf\x99\x63\x80\xc0\x41\x82\x43\x7f\xfd\xcc\x1a\x1f\x8f\x81\xb6\xfd\xc8\x4b\xfd\xce\xec\x0f\xdd\x8b\x2b\x67\xe7\x0f\x97\x2f\xd0\x12\x2c\xf2\x61\x8e\x3c\xe5\xb5\xa8\xfd\xc2\x62\xdf\xba\xc2\x37\x4c\x45\x97\xda\x2f\x26\x71\x48\xa1\xcf\xed\xf9\x00\x1e\xe7\x55\x66\xda\xe5\xd9\xec\x1b\x6e\x5f\x5c\xd9\x97\xba\xd9\xb3\x0e\xb1\x9e\x47\x56\x37\xad\x65\xac\x27\x59\x71\xfe\xbe\x50\xe6\x9f\x32\xc4\x6b\x56\xeb\xc9\x5f\x58\xd5\x17\x07\x21\x0d\x9a\x1d\x43\x89\x3b\x39\x33\x43\xe1\x6b\x27\x63\x88\x30\xc6\x8e\xd3\x9b\x38\x8e\x4a\x02\x4b\x8b\x2c\x2c\x1f\x85\x95\x52\x44\x6d\x86\x1c\x4d\x25\x2a\x2b\x43\x15\x1f\x21\x24\xd4\x47\xb5\x4b\x2f\xed\x49\x6c\x75\x06\x7d\x8f\x12\x81\x88\x98\x61\xe2\x85\x89\xaf\xec\x53\x0b\x8b\x49\xa3\x8b\x5f\x68\x0a\x52\x03\xc0\x28\xb6\x26\xb1\xb5\xf4\x60\xe6\xb5\xe2\xa6\x1f\xf7\x24\xd6\x71\x27\x4d\x0c\x5e\xc5\x50\xd5\x63\x12\xf7\xcc\x5f\xd9\x66\x82\x4a\x7e\xb5\x35\x7e\x3d\xbf\x5b\x67\xe9\x68\xf5\x8c\x5f\xaf\xaa\x37\xf3\x64\x7a\xf6\xb7\x0f\xcd\xb6\xf3\x08\x0a\xe8\x42\xbe\xee\x16\x7e\xdb\x68\x8e\xa6\xfc\xb7\x70\xf1\x68\x37\xb9\xe2\x51\xe6\xab\xee\x94\x8d\xea\x65\x64\xc2\xa0\xf4\xfe\xf2\xe1\x53\x45\x83\xb1\x06\x75\xfc\x0b\x25\xb0\xc0\x22\x44\x7b\x72\x2e\xf0\x81\x06\x2e\xa5\xb7\xe0\x0c\x85\x5a\xd2\xee\xda\xfa\xd7\x47\xf0\x19\xab\x8b\xbc\x0f\x79\x2f\xe6\xb8\xe7\x8d\x6c\x14\xd2\x42\xeb\xab\x02\xf6\xdd\xf9\x79\x45\x94\xdd\x3d\x8b\x57\xc9\x0d\x6e\xce\x82\xb2\xc1\x53\x1e\xbb\x22\xf9\xe3\xb1\x2e\x70\x39\x0a\x28\x5e\x67\x11\x25\x2c\x13\xef\x5a\x37\xb8\xfa\xd4\xb1\x3c\xdd\x63\xab\xc4\x2b\xa9\x07\xd4\x50\x8e\xeb\x93\xdd\xa9\x92\x72\x6d\xdc\xd6\x56\x48\x32\x14\xcb\xd1\xc1\x7f\x57\x04\x13\xcc\x15\x66\x67\xe7\x80\xde\x65\xd7\x76\x67\xe7\x91\x4e\xa9\xba\x4b\x28\x58\x4b\x33\xcd\x8f\x4f\xf1\x82\xcd\xf4\x19\xb5\xc4\x98\x83\xd6\xfc\x44\x6e\xfd\x1d\x5b\x7f\xc7\xd6\xdf\xb1\xf5\x77\xe4\x9e\xdf\xfa\x3b\x1e\xe2\xef\x40\x31\xd6\xb0\x97\x6a\xc2\xa7\x0c\x13\x51\x21\x7a\x1f\xa2\x0c\xb7\x21\x54\x14\x88\x0f\x72\xa6\x6f\xfb\x53\x3e\x4e\x7f\x4a\xad\xc2\xa8\xfd\x2a\x0b\xf2\x38\xf6\x03\x74\x08\x79\x45\x46\xd9\xe1\xd1\xc7\x06\xb7\x8b\xab\xe3\xc3\x23\xc7\xe1\x8e\xd3\x71\x9c\xb3\x97\x8e\xf3\x74\xbd\x39\x52\x3c\xf8\x01\xf2\x20\x2f\x0d\x2c\x3f\x0e\x3d\xea\x57\xe4\xfc\x37\x51\x7c\xcd\x78\xdb\x6c\xe8\xdc\x63\xbf\x47\xaf\xc4\xab\xe2\x0d\x38\x9b\x46\x43\x4a\x6a\x96\x5a\x69\xb5\x9e\xe6\x66\x31\x35\x87\x7a\x6b\x6a\xfd\x86\x6c\x80\xeb\xc6\x19\xf6\x6d\x9b\x6b\x4a\x6e\xd2\x8b\x0b\x29\xfe\x57\xe6\x18\x0e\x11\x24\x94\x3d\x8f\x32\xb9\xf7\x34\x1d\x0d\xdc\x36\x51\xe0\x99\x72\xdd\x4a\xe2\x53\xdb\xa7\x6a\xae\x16\x37\x37\x34\xae\x60\xf0\xf7\xf7\xb5\xcb\x05\x3e\xb4\xe6\xf8\x6e\x67\xd0\xf7\xf1\x78\xe6\x26\x42\x50\xd2\xcd\xac\x5c\x28\xb4\x3a\x83\xbe\x4a\x75\xb2\x3c\x1a\x26\x11\x99\xe9\x0f\x43\x1c\x0a\xc4\x66\x31\x8d\x63\xf9\x0f\x0c\x30\x51\x59\x6d\xf2\x4f\xc4\x66\x5c\xd9\x29\xad\x80\xd1\x24\x9e\x0d\x29\x8b\xd4\xff\x2c\x2c\x50\x34\xf3\x68\x48\x99\x15\x33\xe4\xa3\x21\x26\xc8\x7c\x1e\x25\xc8\xe2\x21\xf6\x11\x33\x5f\xf0\x71\x0c\x09\x0a\xcd\x27\x18\xc6\x23\xb8\xf8\x80\xcf\x68\xec\xd3\x09\x49\x07\xc4\xde\x2d\x62\x33\x17\xfa\x01\x9a\x09\x86\xf4\xff\x2c\x42\x7d\x64\xc0\x99\x45\xba\xa9\xc2\xcc\xc7\x30\xa4\xc1\xcc\x1b\x21\xef\xd6\xa5\x77\xd9\x1f\x96\x5e\xfe\xfc\xb3\x86\xdf\x4b\xed\x7c\x33\x2e\x50\xcc\x67\x1e\x64\x34\xe1\x28\x9c\x45\x88\x24\xea\x7f\x7a\x61\x3c\x71\x17\xbf\x31\xef\x9b\x51\xf5\x3f\xe9\x98\x90\xf9\x1a\x95\x29\xae\xb2\xe5\x30\x3a\x91\xbf\xf0\x19\xa1\x02\x0f\x4d\x11\x8e\x19\x83\x3e\xa6\xb3\x58\x1e\x4e\xc4\x79\xf6\x87\xe5\x42\x36\x13\x30\x90\x3b\x41\xc7\x88\xcd\x04\xa5\xa1\xc0\x72\x02\xee\x41\x85\x2c\xf3\x87\x25\xc1\xe2\x8b\x1f\x67\x02\x47\xc8\xe2\x31\x26\x6a\x75\x8b\xff\x5a\xfa\x93\x60\x90\xf0\x61\xee\x0f\x4b\xef\x0b\x83\x02\xcd\xcc\x86\x64\xb0\xa7\x7f\x98\xd1\xd1\x9d\x80\x0c\xc1\x99\x32\x6e\xe9\xff\x9b\xf5\xc7\x34\x4e\x62\x2b\x86\x0c\x11\xa1\xd7\x66\x7e\x88\x20\x26\x33\x97\x21\xe8\x7b\x2c\x89\x5c\x0d\x21\x83\x24\x40\xe9\x0e\xfb\x50\x2c\x7d\x33\x45\x90\x59\x1a\x99\xea\x47\x5d\x7e\x6b\xa6\x9f\x31\x1f\x16\x56\xaa\x9e\x32\x2f\xab\x1f\xf4\x92\xd4\xd7\x7a\x9c\x88\x12\x31\x32\x7f\xeb\x07\xad\x8c\x1c\x43\x18\x73\x94\xfd\xa1\xf7\x1e\x86\x88\x89\x7b\x5b\xb9\xf4\xf9\xfa\x1a\x45\xb1\x98\xde\xff\x7a\xc2\x60\x7c\xff\xdb\x10\x73\x31\xe3\x1e\xa3\x61\x28\x77\x99\x4f\xb0\xf0\x46\x73\xea\xd3\x91\x10\xe6\x1f\xcb\x67\x30\x90\x27\xcf\x7c\x54\xef\x9a\xbf\x3d\x45\x18\x90\x63\x1f\x99\x3d\x20\x49\xe4\x22\x36\xd3\xdc\x21\x3d\x16\x96\x3c\x0a\x43\x4a\xe5\xb9\xd6\x3b\x62\x08\x57\x52\xfd\x0c\x26\x82\x7a\x34\x8a\x43\x24\xd0\xc2\x07\x8b\x27\x41\x80\xb8\x22\x52\x39\x1d\x26\x41\xba\xaf\xe9\xc7\x08\xf2\xdb\xec\x43\xba\x07\x1b\xcf\x28\xfa\x25\x41\x8b\xd5\xf5\x9e\x45\x3d\xe7\x71\x67\xd0\x4f\x50\xd7\x6a\x12\xcb\x30\x4e\x50\xdd\x6a\x83\x4b\x86\x51\x7b\x9c\xa0\x7b\xf5\x06\x1f\x45\x32\x8e\x13\x74\xb3\x96\x5f\xb6\xa7\xfe\x2d\x2b\x3b\x7c\x1c\xd2\xdf\x12\x58\x61\x90\x4a\x4b\xda\x80\xfd\x44\xd0\x48\xf1\xd0\x96\x83\xf8\xc2\xdf\x0e\x87\x9b\xa8\x88\x84\xd4\xf2\x4a\x6d\x02\x91\x8b\x7c\x15\xdb\xb9\x1f\xc7\x95\x79\x30\xeb\xb9\x62\x4f\x5c\xe4\x8b\x11\xe6\x96\x9e\x62\xa5\xc1\xfd\x69\x2c\x27\x28\x32\xa0\x29\x1b\x2c\xd4\x18\x28\xc1\x14\x6b\xd2\x55\x77\x6d\x45\x6a\xc3\xcc\xec\x1d\x24\x7e\x88\x5c\xc8\x9e\x11\x43\x7b\x21\xa6\x31\x7a\x2d\xff\x92\x82\xde\x71\xec\x3b\x6b\x94\x81\x69\x09\x14\xc5\x21\x14\xa8\x09\xa3\x9b\xbf\xae\x99\x16\x4b\x88\x14\xcf\xdd\x41\x67\xd0\xb7\xb2\x3e\x9e\x83\xae\xfa\xe2\x09\x98\xda\x1c\xbe\x1b\xde\xe8\xd2\x29\xc9\xf0\xa6\xb4\xba\xe7\x31\xe1\x38\x18\x89\xaa\x12\x12\xe7\x30\x48\x2b\x98\xb5\x5c\xeb\xc9\xb6\x09\xba\x4b\xb8\xe3\xf4\x50\x0a\x8a\xa9\xf6\xd4\x22\x7b\xe3\xaa\x6c\x96\xe7\x21\xce\xe7\xd3\xa8\x49\x46\x9e\x8d\x88\x95\x14\x76\x88\x3e\x26\x63\x3a\x2d\xc7\xcd\x67\x24\x79\x1c\x02\xa7\x8c\xde\x4d\x9b\xd8\x85\x91\x1c\xfb\xfb\x79\x08\xea\x9d\xa5\xbe\xb1\x92\x98\x0b\x86\x60\x64\x99\xf2\x57\x96\x24\xc6\x47\x30\x9d\x56\x61\x50\xd9\x8e\x25\x84\xb1\x5c\x69\xa9\xa8\x24\xd3\xfa\x35\xe7\x73\x8c\x22\x43\xc5\x89\xaa\xed\xd5\x3e\x5b\x34\xc3\x23\x32\xa5\x9b\x10\xa1\x64\x4a\x2b\x8e\x5b\x4c\xbd\x51\x6d\xcc\xbc\x65\x30\x1e\x35\xcf\x14\x35\x03\xcd\x03\x5a\x07\xa6\x70\x98\xae\x27\x8f\x24\x0c\x8b\x8c\xac\x4e\x2c\xf3\xfd\xb7\xda\xcb\x18\x38\xfa\xa9\xb1\x78\x59\xc4\xcd\x32\x0f\xdd\x44\xfd\x6d\xdb\xff\x49\xaf\x1f\x8c\x1d\xc7\x7f\xf9\x54\xc2\xc0\xff\xa9\xa1\x82\x3b\x84\x5c\x84\xd3\x9e\x69\x8d\x8f\xa9\xad\xb6\xb2\x84\x42\xa3\x27\xab\xff\xd7\xee\x69\x8c\x69\x54\x7a\x16\x59\x08\x49\x50\xbe\xd6\x12\xab\x64\xbd\xc8\x42\x35\x47\x07\x7c\x3a\x3f\xb5\x3b\x83\xbe\xd2\x24\xf6\x0f\x3e\x5b\x4f\xae\xcd\x2a\x5e\xce\xf2\xfd\x4a\x57\xa2\x48\x8c\x10\x8b\xa1\x5f\x21\x00\x57\x57\x53\xaf\xe9\x1c\x4d\xa7\x58\x4f\x7b\x40\xf1\x35\x32\x23\x58\x21\x16\xa8\xb5\xd2\x86\x1f\xa9\x5f\xff\x1e\xff\x40\xe3\xf5\xf3\x35\x34\x13\xea\x37\xbe\x4f\x9b\xed\x28\xa5\xab\xbb\x11\x76\x71\x85\x97\xfd\xe1\x82\xb0\x4a\xa4\x69\x28\x36\x92\xad\xca\x71\x84\x43\x64\x4d\xb0\x1f\x20\x1d\x72\x60\x9b\xe9\x8a\x95\xa6\xbb\x38\x97\xb4\x53\xaf\xd3\xe7\xab\xfc\xf7\x75\x6f\xdb\xf7\x3c\x20\x66\xe6\x27\x4a\x59\xdd\x9e\xb4\x35\x4e\x9a\x3c\x68\x7a\xd7\x2a\x14\x4e\xfd\x10\xa6\xa4\x4e\x5a\xf4\xb3\x6b\xc1\xb9\x75\x1f\x6e\x82\xd6\x9a\xba\x0f\x33\x1a\x32\xe9\xcd\xa5\xf4\x26\xfe\x52\xe1\xc0\xde\xa4\xd9\xcb\x4c\x81\xee\x84\xe5\x42\x8e\x36\xd1\x5a\x4c\x15\x8e\x44\xc4\x1b\x95\xda\x4a\xdf\xfc\x17\x38\xc0\xc1\xfb\x8a\x74\x95\x66\xa6\x03\x3e\xd7\x9a\x5c\x1c\x58\x03\x1c\x6f\x96\x63\xd7\xc1\xc4\xf0\xbf\x16\x8b\x3d\x4a\xc0\x70\x9c\x5a\x2e\x0a\xcd\x2a\x6f\xf6\xcf\xce\xc1\xf1\x59\x05\x7a\xce\x10\x64\xde\x08\x68\xce\xb5\xee\x2d\x78\x48\x59\x34\x6f\x2f\x23\x2f\x43\x5c\x0d\x5b\xd2\xf4\xbb\x55\x4d\x60\x31\x76\x17\x11\x81\x58\xcc\x30\x47\x1a\x8a\x52\x04\x99\xf5\x0f\x29\x03\x67\x23\xc8\xd0\x29\xc5\xa4\x42\x69\x6a\x05\x65\xf3\xa4\x00\x60\xb2\x02\x4e\xa1\x3c\xa9\x82\x61\xef\x2c\x03\x7b\x93\x7c\x7f\x9e\x73\x71\x6f\xe1\xc5\x8b\x07\x60\x8d\x22\x7d\xd9\x4c\xf3\x89\xce\x11\x8c\xce\x52\x02\x56\x89\x00\xbd\x97\xdd\xef\x17\xc5\xc0\xd9\xe9\x67\xf4\x5b\x82\xb8\x78\x9b\x60\x7f\x55\xd9\xbe\xf9\x70\xef\x10\x0c\xc5\xe8\xcc\xa3\xac\xa0\xbe\x5f\xd9\xa3\x8f\x21\x4d\x96\xa3\x7d\x56\x22\xbf\x15\xd9\xa3\xec\xb0\x72\xcc\x58\x8e\xd9\x5b\x38\x1a\x75\x42\x08\xb7\xa9\x38\x4f\x14\xc2\xb8\x4d\xc5\xc9\x3d\xb7\x4d\xc5\xd9\xa6\xe2\xfc\x4e\x52\x71\x8a\x98\xb1\x76\x8a\xa5\x6a\x9d\xed\xc1\x18\xba\x38\xc4\x02\x23\x6e\x6b\xc5\xc5\x56\x99\xb1\xf6\x1b\xc8\x85\xa5\x75\x02\x39\xf7\x5d\xa1\x3e\xf3\xa5\x42\xd7\x7b\x68\xf1\xe1\xef\x2c\xcb\x71\xf8\xcb\x37\x93\xd3\x45\xba\x7f\x50\xdc\xeb\x9b\x2f\xa7\xe0\x6c\x44\xe3\xe2\xc0\xd7\x0a\xfc\x0e\x27\x31\x1f\xd1\xb8\xcc\x16\xf6\x06\x7a\xc8\xa5\xf4\xb6\xaa\xbf\xa2\x32\x24\xb5\xed\x7b\xf5\x28\x21\xc8\x13\x8e\xd3\x1b\x1a\x30\x74\xc7\x22\x55\xa6\xe6\x85\x7d\x01\xad\xaf\x97\x9b\x30\x8f\xa5\xb3\x95\x5e\xa1\xa0\x27\xc0\x1b\x4c\xfc\xaa\x84\xe2\x56\x74\xde\xef\x2c\x0b\xc8\x19\x87\x7a\xc2\x0a\xcc\x9d\xe9\xe0\x33\x89\xb7\x61\xeb\x98\x11\x96\x06\xa2\x1c\x39\xc4\x9b\x1e\xd0\xbb\xa7\xee\xec\xad\xbb\xb4\x2a\xfa\x21\xde\xd4\xa5\x77\xda\x29\x16\x43\xef\x76\xb6\xe0\x1c\x33\x3d\x65\xf3\x8d\x64\x5b\x2b\x78\xb4\xed\xe1\x9b\x1b\xfc\x99\xf7\xf0\xad\x24\x7f\xe2\x4d\x61\x1c\xeb\x28\xab\x94\xa8\x8a\x4f\x01\x17\xde\x46\x6b\xdb\x3f\x5c\x86\x5c\x2d\x00\xb9\x16\x5b\xc8\x8c\x2d\xb9\x91\x54\x73\x44\x97\x95\x61\x26\xac\x08\x5c\xa9\x93\xc6\xa8\xc7\xb1\x8e\x90\x9b\x04\xd6\x11\x96\x4c\x6f\xb9\x63\xc6\x2f\x90\x4d\xe7\x53\x5a\x67\x67\x1f\xf2\x21\x2d\x63\x0c\x2d\xed\x81\xee\xdf\x6f\xb5\x61\x5e\x31\x57\x69\xeb\xfd\xd1\x33\x89\x72\x31\x2e\xf3\x52\xfe\x2b\xac\x37\x0c\xe5\x42\x54\x1a\xf9\x6d\xaa\xd0\x7e\xef\x42\x79\x7f\xbe\x27\xf2\xd6\x6c\x2d\xf2\xad\x73\xca\xe6\x16\xf9\x21\x14\x43\x86\x74\x0b\x55\x49\x0b\xa5\x94\xea\xe2\xaa\x34\xfa\xc7\x63\x8e\xeb\x1e\x48\xc1\xe0\xd4\xa3\x2c\xae\xe2\x7a\xc8\xa7\xac\x22\x44\xbb\xe0\x7e\x5c\x2b\x5a\xc1\x8c\xff\x54\xd8\x90\x3b\xaf\x20\x88\x19\xbd\x41\x9e\x28\xbd\x56\x60\x12\x28\x4b\x33\x11\x0d\xe2\x90\x37\xa4\x25\x0e\xe7\xc0\x74\xa4\xc2\x53\x10\x3a\xd5\x1a\xc9\x8c\x61\x38\x46\xb9\x88\xa7\x61\x1e\x19\x3f\x16\x7a\xe0\xdf\x60\x86\x5c\xc8\xab\x6a\x47\x2f\x56\xce\x6b\xed\x32\xd6\x19\xf4\xe7\xfa\x5e\x77\x30\x34\xc0\x6c\x18\x55\xe9\x34\xa5\x2c\x44\x3e\x13\x42\xbf\xca\x7b\xdc\xa8\x2c\xc2\xd0\xe5\x4f\x7a\x90\xd2\x35\x95\x2e\x3c\x84\x2c\xa9\x08\x87\x3b\xd1\x69\x4c\xe0\x80\x42\xe6\x3f\xa4\x26\xae\xf6\x4f\xa9\x19\x2d\x93\xb5\xd4\x9a\x8f\x4a\xcf\xb3\x15\xe7\xcf\xaf\x2a\xc2\x72\xe9\xce\x92\x2d\x58\x51\xb2\xf3\xdb\x8d\x77\xb9\x57\x82\xb3\x52\xe7\x51\x27\x43\x45\x52\x95\x1c\x57\xde\x4c\x0f\x7f\x95\xff\xbe\x79\xb6\xd2\x17\xc4\x6e\xbf\xa2\x24\x30\xf4\xe9\x38\xbd\xa7\x2b\x1c\x79\x3a\x15\x23\x4a\x1e\xff\x30\x77\x06\xfd\xab\x99\xe3\xf0\xae\x06\xa0\xd0\x2f\xf3\x7c\x49\x31\x56\x80\x37\x09\xbd\x1a\x4a\x42\xeb\xc5\xd4\xa3\xb4\x54\x07\x0b\x61\x75\x3a\x7d\x93\x9b\x61\x65\xa8\xbf\xe9\x11\xae\xbc\x88\xc3\x10\x0a\x2b\xc1\xf5\x23\xfd\x5b\x22\xc4\x03\x4a\x05\x17\x0c\xc6\x4f\x50\xe9\x25\x37\xee\xae\xaa\x1a\xbe\x67\x3b\xce\x8b\xef\x1c\xe7\x05\xc8\xc0\x02\xe3\x8e\x3e\xab\xd9\xff\xbb\x45\x95\xc0\x07\x06\x9b\xce\xce\xa5\xed\x71\x9e\xb3\xc7\xb9\xe9\x68\x8e\xd3\xeb\x0c\xfa\x11\x26\x8e\xd3\xeb\x0e\x3c\xce\xcb\xc7\x32\x49\x18\x2f\xb2\xf7\xef\x6f\xcf\xe2\xfb\xa6\x7c\xf9\xcb\xb4\x34\x82\x7e\x3d\x08\xa7\xf1\x08\x7b\x94\x80\xec\xaf\x46\xa9\xbd\x62\x82\x85\x40\xcc\x71\x8c\x92\x6c\x52\xae\xdc\x15\x5b\x07\xc0\x4e\xf6\xf5\x9a\xe9\xc0\xf2\xe3\x0d\xef\x0e\xec\x82\x55\x3f\x4a\xea\x84\x54\x76\x03\x24\x32\x10\x6a\xcb\x1e\xd5\x58\x0e\x71\x1c\x90\x88\xfa\x34\x77\xab\x90\xc7\xdb\xfa\xfb\xfb\x12\x71\x84\xee\x0e\x4f\x2a\xfc\x5e\x75\x3a\xda\xfe\x6a\xc9\xa1\x2c\xc9\xa9\xef\xdb\xd0\x16\xb5\x25\x33\x67\xef\xa5\xe3\x5c\x8c\x1d\xa7\x57\x54\x9c\xae\xa8\xe5\xf0\x4b\x33\xc0\xc5\xd5\xde\xf8\xf2\xe5\xaa\x11\x1e\x68\x0c\x35\x38\x29\x64\x2b\x15\x9c\x57\x59\xe7\x42\x74\xe7\x45\x6a\x03\x6d\x2f\xe2\xf6\x88\x46\xa8\x6c\x0f\xce\x54\xb5\x88\x27\xf0\xaf\xcd\x9d\x23\x21\xba\xd3\x35\x2b\x96\x09\x7f\xeb\x03\xd9\xfa\x40\x56\x14\x98\xa7\x79\x33\xbf\x3d\x27\x9f\x12\x5e\x83\xbd\x5b\x2c\x2a\x2c\xfe\x9b\xb7\xf4\xd8\x43\x03\x88\xf1\x03\xde\x06\x9b\xb7\xf6\xa4\x53\xf6\xe4\x48\x43\xfc\xf5\xab\xc4\x76\x09\xa6\x92\xbb\x83\x83\x47\xb8\xd3\xc7\xfa\x46\x6f\xda\x84\xb9\x53\x67\x67\xef\xe2\x6a\xf7\xf2\xe5\x2e\x04\x46\x15\x50\x0b\x18\xa8\x15\x24\x77\xae\x9b\x35\x4a\x69\xab\xac\xb7\x86\x64\x7b\xeb\x7f\x86\x46\x7c\x9e\xed\x7a\xf9\x05\x62\xca\x63\x06\x2b\x4e\xf5\x7b\xce\x13\x04\xce\x19\xf4\x6e\xd7\xee\xca\xd4\x19\xf4\x77\xa1\x52\x00\xf6\xcc\x46\x00\x77\x0a\xd2\xf9\x67\xbb\x11\x4c\xc9\x59\x9b\x9e\x75\x10\xd0\x90\xb2\x68\xe3\x05\x75\xb6\xc4\xfa\xd4\xc4\xaa\x68\x55\x53\x42\x29\xb5\x52\x22\xc0\xfe\x04\x71\xa9\x8f\x95\x52\xac\x7a\x52\x0b\xa2\x36\x6f\xbc\x4b\x16\xf4\xce\xa0\x2f\x2f\x6d\xdd\xc1\x90\x12\x61\x41\x0d\x58\xf1\x7d\x6b\x67\x57\xef\x81\x1e\x95\x33\xcf\x5c\xa3\x29\x11\x0b\xef\xca\x9b\xcc\xe3\xa4\x0e\xe4\xa6\x2e\xa9\x1c\xf9\x86\xb2\xdb\xe7\x59\x36\xf2\x2a\x05\xed\xfb\x62\x7d\x5f\xbf\xb7\xe2\xd8\x6f\xcb\xc5\x82\xea\x85\x83\x1a\x7c\x6f\x09\xd2\x42\x5c\x98\x9f\xb6\xe5\x62\x9f\x41\xb9\x58\x75\xcd\xa5\xec\x56\x95\xec\x2c\xeb\xb9\xf2\x86\x32\x91\x10\xf4\xd3\x46\x83\x6b\xa5\x72\xb0\xd4\x1e\x51\x19\xa2\x1c\x75\x65\xb3\x1d\x47\xc2\x2b\xaf\xb9\x06\x18\x6d\x4d\x72\x1c\x5b\x1b\xab\xe4\xda\x18\x14\xc8\x71\x6c\xfd\x8f\x64\xbb\xb3\x9c\x9a\xb1\x0b\x97\x47\xde\x91\x7c\x37\x3f\x58\xb1\x96\x61\x60\xf4\x20\x13\x37\x5c\x5e\x39\x46\xb1\xe3\x48\xde\xdf\x7b\xf1\x1f\xdd\x01\x7f\x7d\x71\xf5\x1f\x97\x2f\xa2\x69\x3a\x9c\x7c\x2e\xdf\xd6\xaf\x3d\x5e\xad\xc7\x2f\x75\x27\x52\x3e\xc1\xb7\x15\x55\xd5\xbf\xe0\x5b\x5c\x27\x40\xc8\x0c\x06\x55\x5f\xa6\x15\xa1\x55\xfa\xe7\x84\x95\x17\x5d\x37\xcf\x2f\xf4\x18\x1a\xea\x57\x3b\x83\xfe\x21\x8d\xa7\x0c\x07\x23\x31\x3b\x85\x01\x9a\x9d\x40\x4c\xba\xeb\x5d\x4f\x00\x48\x87\xed\x9d\x1d\x7f\xfe\xe5\xf8\xf3\xf9\xfb\x93\xe3\x65\xa8\xd3\x27\xbe\xbc\xff\xeb\xfb\x8f\xfb\xd9\xef\x6b\xa8\x8c\xdb\xae\x0a\x8f\xd2\x55\x21\xdd\xb0\x32\xa5\x90\x21\x74\x70\x76\xb4\xc1\x98\x1c\x3d\x81\x44\xf9\x33\xa9\x14\x38\x64\x08\xb9\xbc\xb4\x00\x86\x04\xfa\x1c\xdd\x89\xca\x08\xee\x86\xb5\x55\x8a\xce\xb7\x6d\xf2\x23\x5e\x38\xce\x0b\xf9\x4f\x6e\x7e\x5d\xcd\x08\x38\x4e\xa7\x73\xd1\x73\x1c\xff\xf2\x65\x57\xea\xd1\x83\x7e\x6f\xe6\x38\xa4\xfb\x72\xb0\xf4\xea\x07\xec\x21\xc2\x11\x38\x9f\xc6\xa8\x0f\x3a\x47\x98\x0b\x86\xdd\x44\x15\x62\x3d\x65\x74\xa8\x33\xc5\x61\xd8\x35\x0f\x76\x07\x36\xde\xf4\x15\x71\x9b\x17\xf8\x44\xba\xea\x36\x2f\x30\xf7\xdc\x36\x2f\x70\x9b\x17\xf8\x3b\xc9\x0b\x94\x22\x4c\xa0\x3b\xe1\xd2\xbb\x52\x85\x96\x21\xc4\x63\x54\x61\xed\xd9\x27\x30\x9c\x8a\xd6\xcb\x37\xc1\x74\x58\x79\xf3\x30\x80\x18\x3f\xf6\x0d\xb7\xd1\x9d\x40\x8c\xc0\xd0\x1e\xf2\x45\x17\xfd\x46\x0a\x82\xa4\xf3\x57\x20\x8b\x8f\xbc\x11\xac\xa8\xe0\xf0\x01\x8f\x11\x38\x94\x8f\xb5\x8a\xad\x89\x9c\x59\x63\x4a\x43\x31\x47\x95\x2e\x52\xb5\xa9\x5a\x29\x6a\x42\x25\x60\xd5\x7c\x21\x1e\x23\x4b\xce\x6f\xc9\xc3\x32\x81\x0c\x15\xdf\x6d\xe5\x9b\x91\xaa\xa8\x5d\xe5\xbe\xdd\x10\x89\x79\x3e\x49\x51\x96\xc2\xd1\xfa\xdd\xb1\x00\x4f\x51\x5a\x49\xdc\x82\x59\x25\x71\xdb\xa3\x64\xac\xf5\x0d\x4b\xde\xa4\x2d\x1a\x0b\x1c\xe1\xaf\xfa\xc7\x62\x34\x52\x18\x42\xa3\x3f\x3e\x8a\xb6\xb9\xbb\x22\x62\xa5\x33\xe8\x0f\x99\x35\xc6\x68\x32\x1b\x32\xcb\xa5\x77\x1b\xf7\x1b\x6c\x3d\xe2\xb9\xc1\x9f\xb9\x47\xfc\x55\xf1\x36\xae\xf0\x2a\x54\x6c\xe6\x7d\xef\xc2\xda\x9b\x98\x1b\x75\x03\x1e\x07\x3d\x6e\x53\xaf\x43\x09\x4d\x6d\x7e\xff\x56\x7a\x25\x40\x2d\x8d\x42\x72\x21\xad\x1c\x4d\x39\x9e\x4c\x03\xd3\x22\xa5\x84\x6d\x11\x71\x0a\x83\xaa\xc4\xce\xb5\x18\x55\x73\xd6\x63\x6c\x45\xef\x7d\xed\xd8\x48\x81\x73\x9c\xde\xdc\x81\x54\x50\x45\x27\x7b\x58\xed\x24\xef\x74\x06\x7d\x53\x96\x0f\x74\x07\x45\x26\x8b\x06\xf6\x06\x3a\x1c\x62\x0f\x2d\x15\x70\x18\xca\x49\x63\x89\xbe\x22\xfc\x26\x92\x01\x80\x7d\xbf\x22\x3b\x68\x83\xe5\x7e\xcd\x0c\x57\xc6\x94\x6c\xdb\x17\xd0\xbb\xd4\x22\x57\x01\x07\x7d\x6e\xca\x11\x74\x06\x7d\x18\x63\x3b\x7f\xd6\x5a\x36\xdf\xa6\x13\xa6\x5a\xf2\x6a\x94\x89\x84\x21\x5d\x11\xa2\x9c\x28\x5b\x4e\xa8\x33\xc3\x0e\xd5\xfc\x96\xaa\x28\xd1\x7a\x55\x86\x4c\x0f\xc9\x4d\x72\x13\x17\xa1\xe2\xad\xf5\x65\xff\xe3\x26\x1a\x80\xe8\x81\xdb\x3a\xb5\x6b\x50\x42\x30\x81\xa4\x4c\x8d\x7f\xfb\x2b\xf8\x82\x5c\xd3\x0c\xa1\x6d\x9f\xab\x79\x31\xad\x66\x92\xf3\x91\xbc\xfd\xb5\x85\xf0\xcb\x05\xd8\x4b\x2d\xa6\x0d\x43\x32\x83\xbb\x54\x9b\x37\xd5\x63\xe6\x15\x01\x27\xc8\xb5\x3c\x4a\x04\x22\xc2\x8a\xd4\xcc\xaa\xdd\xce\x48\x14\x63\x18\x86\xe1\x5c\x73\x2b\xaa\xcc\x3d\xa2\x82\x02\xfd\x68\xf3\x28\xb6\x14\xcf\x59\xde\x55\xc0\x3f\xc2\xf1\x01\x64\xce\x4e\xe6\x06\x09\xbc\x03\xca\x7c\xc4\x7e\x70\x76\xf6\x72\x41\x02\x0b\xd1\x5b\x92\x5e\x34\xb4\x8e\xd3\xe3\x34\x61\x1e\x1a\x52\xa6\xc4\x02\x41\xc2\xd9\xd9\xdb\xc5\x51\xa0\x04\x7a\x7e\x2b\xd3\x37\xf8\x0b\x6d\x69\x1d\xcf\x8c\x15\xb1\xab\xbe\xbb\xf8\xa3\xf5\x73\x7e\x4f\x36\x4e\xf1\x1a\x9e\x5c\x52\x69\xe1\x5d\xe2\x2d\x8c\x5c\x5c\xd1\xda\xa1\x25\x67\x63\x4e\xd5\x72\x76\xd2\xae\x2e\xdc\x0e\x14\x04\xf6\x4c\x47\x2b\x19\xc2\xca\xdc\x7e\x1e\x7d\x7f\xf4\xda\x71\xfc\x99\xaa\xe7\xa2\x9f\x05\x88\x0e\x81\x65\xed\xa9\xef\x2e\x1c\x87\xbf\xbe\x7c\x29\x59\x78\x4a\xb1\x7a\x4b\xd4\x93\x6f\x23\xf7\x1d\x70\x9c\x8e\xe7\x38\xdd\x4a\x8f\x63\x10\x5d\xdf\xc0\x31\xd4\x3f\x28\x6e\xac\xc1\x68\x2b\xa2\x77\x1b\xf7\xb4\x09\xed\xb5\x61\xdc\x93\x26\xa1\x9e\x5f\xa8\x46\xbd\x85\x82\xbb\x15\xbc\xea\x4c\x40\x81\x3d\x70\x86\x05\x02\x6f\x33\x7e\x9c\xb1\x94\x36\x1b\x6a\x66\x0c\xed\xfa\xfa\x3a\x50\xa0\x2d\x72\x2f\x95\x14\x63\x58\x9e\xfa\xd9\xc2\x24\xc4\x04\xf9\x96\xc7\xf9\xda\xae\xdd\xc5\xa8\x1f\x8d\x12\x2d\x5e\x32\x56\xb6\x4e\x08\xd0\x67\x04\xbd\x9a\x65\x3f\x1f\xaf\x93\x1e\x53\x40\x55\xd1\x72\xee\x5d\xf5\x42\x67\xd0\xb7\x26\x58\x8c\x2c\xe8\xfb\x94\xf0\xee\x60\x8d\xdb\x7e\x3a\x50\xc9\x65\x5f\x3d\xb2\xa0\x1b\x2e\xc3\xd8\xfa\x6d\x5e\x4d\xb9\x54\xb9\xbc\xe4\x3a\x3f\x41\x6e\x0c\xbd\xdb\x7a\xbb\x7a\x82\xb9\x87\xc2\x10\x12\x44\x93\xe7\x1d\xd5\x55\x17\x5b\x66\xf9\x3d\x8d\x30\xbb\x7e\x4a\x80\x54\xb2\xd4\xb9\xca\xbd\xb9\x9a\x1f\x25\x92\xfb\x3f\xaa\xb5\xb4\x3d\x6d\xc4\x24\x69\xf5\x02\x98\x04\xbd\xe2\xf2\xd1\x6f\x11\x11\xb4\x42\x0d\x69\x1e\x53\xb0\x2c\xde\x02\x3d\xcb\x53\xe1\x22\xdd\x75\x05\x45\x59\x30\xc1\x5b\xc4\x58\x55\x33\x8d\x23\x34\x06\xe7\x94\x86\x6b\xca\x95\x3d\x3d\x07\x38\xa4\x3e\x02\x9f\xd1\x18\xa3\xc9\xae\x0d\xf7\x54\x58\xc0\x8e\x0e\x0e\xe8\x64\xcc\xde\x71\xba\x39\x89\x63\x5a\x3c\x2b\xc1\xd3\x35\x92\x47\x0d\x76\x5d\xa5\x60\x5d\x99\xe7\x12\x6c\x67\x7f\x95\x28\x57\x00\xec\x08\x2c\x42\xa4\x65\xd0\x3d\x78\xd7\x11\x40\x52\xaa\xfc\x9b\xf7\xa5\x90\x2a\x6e\x5d\x53\x6d\x80\x6b\x4a\xeb\x25\x6a\x7c\x62\x0c\x2d\x2b\x30\x8e\xe3\x06\x58\x64\x82\x57\x25\x08\xb7\x85\xce\x00\x0b\x8b\x7b\x51\xd3\x58\x50\x4d\xff\x1e\xf5\x11\x53\xc4\x5c\x6a\xa0\x40\x02\x9c\x41\x81\xf9\xd0\xc4\x06\x96\x32\x86\x07\x25\x8f\x3c\x9a\x40\xe0\xb9\xf5\xe8\xbc\x4f\xca\xe2\x62\x19\x88\xc4\x99\x9c\x00\x3d\xcf\xb0\xf8\x0c\xbc\x35\x38\xd2\xf6\x52\xf8\x1c\x2e\x85\x48\x58\x5c\xed\x60\x0f\x93\x61\x61\x62\xc6\xdb\x11\xe5\x15\x62\xf9\x20\xa4\x41\x1d\xc5\x44\x0d\x65\x1d\x42\x6f\x84\x2c\x79\x9b\x4c\x78\xcb\x95\x12\xef\xd1\xa8\x9c\xd0\xf8\x2b\xea\xd8\x08\x0b\x09\x76\xdb\xdd\x69\xbd\xee\x4e\x81\xdc\x80\x52\xad\x0f\x8b\x83\xca\xb2\xd1\x47\xd4\x4b\x22\x44\x84\x8a\x10\x58\x4b\x01\x6c\x81\x94\x34\xa0\x9d\x41\xbf\xf7\x60\x73\x73\x76\x15\xc2\xa2\xaa\x7c\xf4\x5b\x2c\xde\x25\x2e\x50\x65\xca\xdb\xac\x5b\x76\xa5\x07\xd6\x71\x20\xb9\xee\xbd\xbf\x5a\xfa\x87\x79\x35\x53\xff\x11\xaa\x99\x16\x9e\xbb\xcf\x89\x3b\x05\x94\x80\xcf\x10\x87\x35\x4f\xdf\x03\xc2\x61\xe7\xe8\x89\xa8\x7f\xdd\x19\xf4\x99\x9c\x76\x26\xb5\x8a\x8a\x90\xd8\x15\xcf\x3f\xec\x3c\xe7\xe6\xb2\x21\xe7\x48\x70\x1b\xc6\x71\x88\x3d\x75\x06\xac\x0b\x68\x7d\x75\x1c\xff\xf2\x9f\x3f\xfd\xf8\x2f\xbb\x89\x9d\x44\xa2\x8e\xb3\xa1\x15\x43\x06\x23\x45\x08\x30\x11\x23\x44\x04\xf6\xb0\x98\x5e\x0b\x7a\x8b\xc8\xf7\xd5\x7a\xe2\x7c\xb8\x82\x90\x5a\xb9\x71\xcf\x23\xff\xab\x33\xe8\x9f\x50\x12\x30\x14\xce\xbe\x1c\x1f\x30\xec\xdd\xce\x24\x70\xdd\x67\x1d\x53\x5b\x3a\xda\x7d\x36\xcb\x12\x77\x6a\xe5\xdb\xa0\x66\x73\xd4\xe1\xec\xdc\x8c\x40\x89\xa2\xdf\x46\x7d\x32\x63\xb9\x4b\x69\x35\x99\xb2\x14\xaf\xb7\x58\x7c\x80\x6e\x23\x2d\x3e\x63\x4a\x0f\xbc\xf6\xef\x4a\xf4\x03\xe3\x64\xc9\x17\x2c\xb8\xb8\xb2\x2f\x5f\xa6\xc7\x2b\xc0\x22\x84\xee\x75\x48\x03\x6a\xe5\x2e\xfe\x7a\x92\xcc\x9d\x46\xe0\xd8\x85\x0c\xe8\x7f\xac\x21\xbe\x43\xbe\x25\x68\x9c\x7e\xa1\x07\x01\xca\x46\x3a\xa2\x0c\x7f\xa5\x44\xc0\xd0\x22\x70\xbc\xb6\x55\x9a\x06\x7d\x89\xf4\xeb\x14\x91\x57\x1a\x93\x6b\x59\xa2\xb7\xdc\x74\xcb\x4d\xc1\x8a\x95\x95\x6c\x23\xd8\x72\xd3\xf9\x1c\x9b\xe6\xa6\xd0\xa5\x89\xe8\x69\x26\x52\xa1\x15\x7e\x80\x2e\x38\xac\x28\xca\x77\x90\xe0\xd0\x07\x87\xef\x33\xdb\xf1\x83\x39\xea\x5a\x1c\xcc\x47\xfa\x49\x93\x60\x94\x02\x4f\x89\xc0\x24\xa1\x09\x07\xef\x89\x40\x01\x53\x67\x71\xcb\xd5\xb6\x5c\x6d\xcb\xd5\x0a\xa7\xfc\xe6\xb8\xda\x0a\xa6\x66\x34\x2d\xcb\xc3\x25\xec\x0d\x55\xd4\xbf\x7f\xa8\x4a\x98\xcf\x14\x4f\x30\x08\xd1\x50\x38\x3b\x7b\x8e\x43\x1c\x87\xbf\xfc\xbf\xfe\xff\x40\x81\x00\x4c\x40\x53\xff\xa1\xd5\x03\x6f\xd1\x74\x42\x99\xcf\xd5\x61\x0a\xe8\xab\x00\x8b\x57\x1c\x85\x43\x6b\x44\xb9\x40\xbe\xfc\x8c\x60\x89\x46\x57\x25\x37\xd4\xfb\x25\xc5\x55\xde\x62\x81\xc3\x2a\x03\xc2\x03\x51\xba\x58\x09\x21\x5f\x1b\x4c\xd9\x1a\x19\xc3\xc2\x71\x7a\x01\xa5\x41\x88\x74\x8c\x99\xc9\xdd\x09\x34\x70\xb6\xb3\xb3\x67\xe0\xdc\xdd\x74\x52\xc5\xd6\x23\xb6\xf5\x88\x3d\xb9\x47\x4c\x9f\x89\x85\x13\xb1\x70\x1e\x0a\x0f\xb3\x64\x5b\x6f\x30\x1f\x6d\x24\x70\x39\x1d\xbc\x33\xe8\x9b\xd7\x55\x42\x08\xf8\x14\x23\x02\xce\x14\x94\x2a\xad\x4a\xa5\xdd\x98\x0a\x11\x8f\x55\xb1\xa0\x90\x40\xb7\xe7\xf9\xfe\x79\xae\xa2\x3e\xb9\xd1\x43\xcc\x47\x3d\xf5\x66\x49\xbe\xc0\xdb\xb4\x36\x73\x85\xfc\x78\x78\x39\xb4\x15\x41\xb3\x2a\x62\x2d\xab\x0e\xcd\xef\x65\x21\xcd\xd2\xd0\xe4\x5c\xd6\xd1\xfc\xf1\xb6\xb2\xf3\x2a\x71\x99\x4e\x58\x7a\x69\xab\x08\xf5\x29\x21\xcd\xe7\xe6\xca\xa6\x79\x3d\x72\xf5\x5a\xf7\x25\xac\x1b\xe1\x50\x66\xe8\xa7\x42\x40\xdf\xb6\x51\xe4\x22\x5f\x8c\xb0\xce\x71\xcd\x72\x04\x02\x0a\x25\x64\x36\x26\x3e\xba\xeb\x29\x90\x0a\xd1\xf3\x97\x0a\x8f\x7e\x2e\x12\xf4\x2d\x83\x92\xba\x9e\x2b\x29\xdc\xa8\x64\xa3\x62\x69\x45\xcf\x7e\x4b\x20\x43\x15\xb4\x30\x0f\xe6\xcb\x6c\x14\xf3\x14\xf2\xec\xab\xc3\xcf\x27\xcf\x12\x0b\xca\x99\x47\xb9\x5e\x68\xb9\x19\x9c\x9e\x09\x58\xd5\xc9\xec\xf9\x46\x36\xaa\x1d\xe7\x72\x05\x15\xab\x0c\x36\x7b\xd9\xa8\xbc\xbf\x39\x8e\xff\xcf\xff\xfc\x17\x90\x80\xac\xb8\xc5\x29\x7f\x6a\x3f\x67\xe5\x77\x13\x21\x28\xc9\x8f\xe8\x42\x8e\x3d\xe0\x85\x94\x20\xa0\x7f\x75\x76\x74\xdc\x1f\x43\x31\xb5\xd4\x0f\x16\xe7\x23\x67\x07\xa8\x80\x6a\x29\xb7\x5e\x3b\x3b\x01\x0d\xf8\xff\xa9\x0a\x09\xbc\x91\xbc\x22\xe0\x55\xb9\x6f\x4b\x17\xc7\x80\xbe\x02\xf2\xe2\x08\xf2\x37\x47\x10\x64\xa1\x17\x6b\xed\x65\xc0\xcb\xae\x8d\x4a\x35\x05\xfb\xfe\x19\x22\x55\xfd\xc4\x36\x9f\x60\x69\x14\xe5\x29\xf1\x8d\x85\xcb\xdc\x1f\xe7\xdb\x08\x7d\xf9\x15\x74\x9c\x9e\x4f\x13\x37\x44\x5e\x88\x3d\xd3\x0a\x7a\xfe\xd0\x8f\x91\xca\xcd\x5c\xf8\xae\xec\xc5\x4d\x70\x0a\xb9\x90\xde\x90\xd9\xd0\xe7\x12\xb3\x36\x17\x90\x95\xb1\x50\xbd\x0d\x4b\x2c\xb2\x65\xe6\x91\x1e\x05\x18\xc5\x56\x56\x94\x44\x57\x34\x14\xd3\x18\xbd\xbe\x70\x76\xfe\x70\xa9\x41\xcf\x7e\x56\xdf\x55\x66\x18\xa9\x77\xac\x7c\xa1\x13\x5d\x57\xb1\x33\xe8\x07\x70\x96\x30\x6f\x84\xc9\x2c\xfb\xb9\xbb\x81\x46\xdd\x06\xe3\x92\x5a\x60\x1e\x3d\x75\xb0\x0d\x8e\xc9\x08\x12\x0f\xf9\x00\x1d\xd6\x6a\xd2\xbb\xa1\x2a\x1e\x85\x68\x8c\xc3\x24\xc0\x84\x3b\x8e\x9d\x40\x8d\x55\xe4\xcd\x50\x9a\xbe\xb6\x16\x3a\xf5\x94\x2b\x2e\x5a\x05\xa4\x58\x8c\x8d\x55\x18\x01\xeb\x5e\xb4\x72\x43\xae\x4b\xa6\xcb\x73\xaf\x36\xa7\x3f\x80\x64\x97\x27\x68\xfb\x9e\x57\x44\xca\xa0\x8e\x7f\xc9\x47\x63\x14\xd2\x18\x31\xde\x5b\x35\x8e\xfc\x3d\x48\xb0\x8f\xb8\xed\xd1\x30\x44\x2a\x50\x75\xfe\xf3\x0d\xb7\x91\x39\x0b\x16\xca\xa7\x47\x96\x1d\xa3\x38\x36\x2d\xed\x37\x73\x17\x50\x93\xa8\xd2\x04\x88\x3c\xe9\x9d\xc0\xa3\x3e\x5a\x40\x6a\x1c\x23\xbd\xee\x72\x04\x1d\x8e\x20\xab\xd2\x07\xbf\x85\xcb\x40\x21\x75\x79\xa3\x1a\xb2\xed\x30\xa4\x49\xc5\x0d\xa1\x4e\x7c\xdb\x2f\x18\x2a\x13\xfb\x0f\x8e\xd3\xfb\x01\x68\x20\x36\xdb\x61\xba\xea\xc4\x79\x72\x61\x39\x74\x54\xe1\x81\xfa\x08\x9c\x32\x24\x04\x1e\x56\xa4\x63\xae\xc8\x2a\x7b\x5e\xe4\xb0\x7c\x22\x62\xc3\xbb\x2c\xf9\x83\x15\xa7\x8b\x2c\x47\x88\xae\x9b\x73\x5a\xe1\xe8\xde\x50\x25\xfe\x21\x25\xc2\xd4\x5d\x33\x12\x25\xc6\x7c\xa6\xff\xec\xae\x2a\xa1\x55\x24\xb5\xe5\x7b\x46\x5b\xed\xbd\x9c\x20\x57\x0e\xbc\x31\x0d\x47\x41\x5d\x81\xd6\x13\x18\x57\xf0\x1c\xf5\x44\xab\x0a\x4c\x67\xd0\x8f\x60\xcc\x33\x27\x90\x41\x88\xfe\x6e\x30\xc4\x21\x7a\x0d\x63\xdc\x19\xf4\xff\x63\x9c\x2f\xe7\x34\x2b\x7a\xc9\x86\x31\x96\x9a\xb3\xc0\x5e\x04\xe3\x5c\xb2\x96\x6d\x2f\xbc\x92\x43\x7e\xf6\x5a\xdb\x0a\xa6\x1c\xb8\xfe\x29\x97\xb7\xcf\xb3\x18\x55\xd9\x44\x54\xa0\xbc\xb9\x25\xbf\xba\x2f\x28\xc1\xf1\x9d\x40\x44\xde\x6c\xeb\xc4\xde\x9f\x50\xdf\x52\x51\xc4\x6a\xde\x3e\xd8\x99\xbb\x34\x5f\xcd\x9f\x92\x4f\x58\x67\xd9\x23\xbd\xc2\x86\x6b\x4f\x28\x50\xd4\x0a\x74\xe0\xa1\xfa\x2b\xa2\x7e\x15\xbe\xc3\xa4\x82\xda\x37\xd2\x38\xcd\x10\xde\x02\xe1\xde\x70\xfb\x02\x5a\x5f\x2f\x5b\x2f\x7c\xd3\xb7\xe5\x45\xa0\x01\x11\x9e\x61\x51\x19\x52\xfe\x08\x69\x45\x6b\xac\x53\x7e\x6c\xb0\xd0\x73\x18\x80\x5a\x85\x6e\x72\x0f\xae\x29\x45\x34\x4c\x02\x06\xba\x44\x8c\x29\xb3\x68\xcb\xcb\x99\xb2\x07\xeb\xd6\x44\xbb\x36\x1e\x32\x18\xa1\x7c\x45\x83\xef\x2c\x0b\x74\x06\xfd\x63\xe2\x83\xee\xe0\x3e\xe0\xc0\xb2\xda\x6a\xa7\x55\x81\xdc\x9c\x81\x42\x82\x3e\x5f\x4b\x05\x96\xbf\xc0\x30\x44\x15\x79\x42\xa7\x70\x1a\x21\x22\xc0\x29\xa3\x1e\xe2\xbc\xbd\x82\x67\x66\x7c\x6f\x84\xbc\x5b\x9a\x88\xa5\x43\x37\x47\xf3\x44\x01\xb9\xfc\x73\xbb\xf8\x53\x53\xd4\xa7\xce\x39\x3f\xdf\xc4\x05\x29\x98\x3c\x69\x7b\x7a\x44\x7a\x13\x7c\x8b\x63\xe4\x63\xa8\xf2\xfd\xe5\x27\x5b\xaf\xfc\xfa\x0b\x72\xaf\xcf\xb2\xd2\xc2\x15\x28\x92\x52\xf0\xb6\x2a\x3d\xfc\x41\x4d\x88\x9b\x23\x44\x1e\xf8\x89\xe8\xc7\x4c\x8a\x28\x31\x5d\xbb\xcf\xc3\xd6\x7b\xdd\xd8\x7b\xbd\x5a\x33\x98\x20\xd7\x12\x86\x50\x8a\x48\x8a\xc1\xe1\x10\x0b\xfc\x98\x39\xb5\x66\x40\x3b\x30\x73\xd7\xb0\xfb\x2f\xa6\x9e\xe5\x60\x06\x1d\xdd\x57\xa6\xbb\x46\xa0\xec\xb6\xbf\xc0\x66\x68\x7c\x0e\x46\x41\xa0\xeb\xb6\xbf\x40\xee\xb9\x6d\x7f\x81\x6d\x7f\x81\xdf\x49\x7f\x81\x94\xa1\xeb\xf6\x66\x3e\x8a\x43\x54\xda\x68\xe0\x2d\x83\xe3\xa7\xbf\x71\xad\x10\x2f\xe3\xc3\x93\xb3\x36\xd2\xe4\xb7\x75\x1d\x36\xa1\x1c\x35\xaf\xeb\x10\x30\x38\x2e\x0d\xcf\x62\x70\x0c\x05\xac\xb8\x72\x3c\xc4\xc2\x6c\x86\xd0\x15\xe7\x02\x33\x9d\xb9\x0e\xeb\x0f\xf6\x23\xc5\xe6\x99\xb9\xab\xce\x25\x16\x53\xf0\x86\xb2\xa8\xca\x2c\xda\x02\x4e\xe6\xb1\x2b\xf2\xcc\xed\xcc\xfe\xd0\x55\x86\xe7\x60\x48\x59\x74\x3d\x61\x30\x8e\x73\xd2\xab\xe2\x71\x97\xfa\xd3\xdc\xb3\x49\xa8\x5d\xa1\x85\xcf\x0f\x31\x0a\xfd\x7c\xb7\xd8\xa5\x8e\x88\x0b\xaf\x4c\xe2\xb4\xe6\x6d\xea\x64\x56\xe8\xc4\x62\x2a\xc7\xe2\xb6\xc7\x79\xf1\x2e\x9a\x09\xec\xaa\x41\x6e\xb8\xce\xc3\x35\xb5\x47\x07\x63\xc4\xe6\xe6\xdf\xef\xdb\x72\x5c\x7f\xa1\xcc\x3f\x65\x88\xd7\xf4\x58\xcf\x6f\x07\xe6\x8b\x5c\x89\x93\xe5\x03\x5b\xc0\xa0\x24\x62\xe5\xcc\x0c\x85\xb9\x44\x08\x18\x4b\xf5\x7f\x92\x35\x49\x6f\xa0\xe1\xaa\x9d\x92\xa3\x29\x77\xb6\xaa\x44\xc6\x47\x08\x09\xf5\x51\x6d\xe1\x4b\x89\xec\xce\xa0\x6f\xf0\x3d\xc3\xc4\x0b\x13\x1f\xf1\xae\xbd\x54\xac\xdf\xc7\xe3\x1c\x99\xa8\x01\x60\x14\x5b\x93\xd8\x5a\x7a\x30\xf5\x8a\xbc\xe4\x8e\xe3\xcb\x2d\x9a\xc4\x79\xa3\xc9\x32\x2a\x0a\x92\xd3\x0a\xa1\xaa\xc7\x3e\xef\x95\xe6\xcc\x36\x13\x54\x72\xf2\x6d\xcf\xda\xe7\xa7\xd0\x37\x54\xec\x56\xf5\xac\x7d\x55\xbd\x99\x27\xd3\xb3\xbf\x7d\x68\xb6\x9d\x47\x50\x40\x17\xf2\x75\xb7\xf0\xdb\x46\x73\x34\xe5\xbf\x85\x8b\x47\xbb\x89\xf6\x4c\x99\xaf\x9a\x41\x34\xaa\x0e\x94\x93\x03\xe5\xf2\x19\x21\x02\x7e\x81\x61\x88\xa6\x9b\x30\xdc\x98\x17\xb3\x2a\xe7\xb6\xcf\x59\x96\xa3\x36\xa0\xee\x0d\xf2\x04\xf6\x5f\xaf\x97\xfb\x77\x74\xd8\xc3\x3e\x22\x02\x0f\xb1\x3e\xdc\xf6\xbc\xdc\xf7\x0d\x8f\xef\x4f\xd0\x48\xae\xed\xc7\xc6\x49\x18\x79\xb0\x66\x92\xd6\x4a\xe3\x42\x23\x8d\xfb\x4a\xcf\x6a\x1d\xd2\x29\x15\x68\xd9\x96\x50\xaa\x7f\x3b\x8e\xab\x61\xd5\xfd\x65\xad\xe7\xd2\x5a\x76\x0e\x72\x01\x3b\x59\x32\xd2\x96\xe3\x18\xb4\x2c\x1c\xbe\x6d\xce\xb2\x6c\xdc\x05\x75\x19\x4b\xdf\xb6\x85\x22\x96\x1e\x54\x04\xd7\x84\xb7\x28\x3f\x96\xe4\x1b\x63\xc5\x36\x7a\x24\xb4\x4f\x13\x37\xc4\x9e\x7d\xaa\xf3\x35\x10\xb1\x0f\xf5\x41\xbc\x3e\xc9\x1a\x3a\xd8\x86\x79\xac\xe6\x42\xd8\xcf\xb5\x6f\x6a\x54\x25\xbd\x19\x3f\x5a\x8b\xcf\x2c\x55\x2f\x37\xc0\x82\xf1\xb2\x2e\xdd\x88\xbd\xfc\x92\xd4\x2f\x7b\xf7\x78\xc5\xcb\xc7\x9d\x41\x3f\x41\x5d\xab\x89\x12\x3a\x4e\x50\xdd\x62\xe5\x4b\x0c\xcd\x1e\x27\xe8\x5e\xbd\xf2\x87\x71\x9b\x9a\xc4\x3f\x4e\x9a\x94\xdb\x53\xe9\x38\x66\xdb\xcb\x2f\xff\x74\x82\x49\xf0\xfe\xd3\xd3\xf4\xbb\x54\x85\x2a\x1c\xa7\x17\x68\x30\x30\x35\x56\x81\x79\x85\xf8\x00\xd3\xcd\x34\x05\xca\xa6\x2c\x4d\x5e\x79\x77\xfc\xf9\xf8\xb1\x63\xa3\xb2\x92\x54\xaa\xfd\x86\xa7\x52\xe5\xf5\x55\x71\x84\x58\x1a\x3b\xc2\xd1\x1c\x49\x8e\x63\xb7\xeb\xb7\xce\x3c\x6a\x6a\xc6\x32\x6d\xec\xdd\xbb\x5f\x4e\xda\xf3\x55\x2f\x2b\x08\x72\xf4\xfb\x37\x99\xc7\x4e\x28\xde\x1a\x32\x37\xc1\xcf\x1a\x1a\x32\x47\xa3\x71\x54\x4a\x88\xa7\xe0\x70\x04\xf1\xe6\xa2\x27\xde\x9d\x5a\x72\x82\xce\xa0\xaf\xbf\x9b\x9d\x7d\x3a\xee\xae\x74\x9d\x3d\x4d\x78\xc5\x28\xae\x42\x0f\x8d\x62\xe8\x89\x0d\x06\x98\xbc\x3b\xbd\x36\x93\x98\x58\x8e\x6f\x09\x3d\xa7\x8c\x1e\x26\x6c\x5c\xa1\xd1\x99\x6c\x36\x70\x84\xc6\xd8\x7b\x86\x19\xd9\xd9\x4a\x6d\xa2\x21\xc5\xa4\x50\xf6\xbf\x3b\x35\x7e\x4d\x30\xd7\x79\xcb\x57\xff\x19\x45\x54\x20\xb0\xef\x79\x88\x37\x23\x8c\x15\x33\x3d\x39\x8a\x4a\xf0\x82\x3f\x54\x28\x44\xf9\x03\xf2\xea\xe1\xe8\xb1\xf0\x87\x4f\xd6\xb7\x76\x66\xce\xcf\x4f\xed\x1f\x5b\xf3\x90\x28\xb1\xf6\x06\x33\x34\xa4\x77\xd6\x59\xec\x2b\xc1\x36\xfa\xf1\x49\x13\x36\xe4\xbf\x3f\xa6\x05\x4d\x8b\x53\x63\xdf\x41\xdf\xa7\xde\xc6\xeb\x56\x9b\x81\x76\xe3\x3d\x7d\x6b\x5d\x55\x5f\x49\x2b\xb7\x52\x55\x84\xfc\x16\x85\xa1\xf1\x2d\x8c\x34\x84\xb6\xb3\xb3\x67\x80\xdd\xb5\xe1\x1e\x30\x9d\xb7\xe7\x4d\xad\x76\xed\xb8\x38\xb4\xd4\xcc\x6f\xc6\xb2\x12\x81\xc3\x0d\x04\x2e\x4b\xdd\xdc\x00\xbf\x00\x7a\x31\xf2\xa3\x08\xb1\xf9\xbd\xad\x3a\x6d\x6a\xa9\x1d\x76\x6b\x6a\xbb\x02\x64\xf5\xe5\xb0\x3d\x8a\x54\x93\xdc\xf0\x3a\x44\x49\xfc\x10\xb9\x90\xd5\x47\xcc\x83\x5b\xb5\xe5\x32\x2f\xaf\xf6\x2e\x05\xba\x13\x8e\x63\xdf\x59\xa3\x0c\x14\x2b\xed\x7a\x58\x4d\x64\xe9\x2b\x1a\xa1\x2c\x21\x02\x47\x48\x55\x19\xb2\x32\x33\xc6\xa0\xab\xbe\xd8\x14\xc2\x15\xbe\x53\x38\x6e\x4a\xad\xd2\xef\x20\x83\x63\x58\xd1\x98\x64\x43\x3d\x74\x47\x7a\xee\xcd\xf5\xcf\x4d\x27\x28\x5d\xbf\x3a\xb1\xbf\x87\xa2\x36\x72\xd1\xf8\x16\x2f\x30\xa1\x74\x79\x45\xab\x47\xd0\x6f\x50\xc4\x65\x5d\x06\xb4\x70\xcc\x94\xf1\x4b\xbe\x72\xc3\xad\x90\xa6\x35\x70\x4a\xa8\x04\xa9\xc2\x06\xca\xf7\xca\xd0\x4c\xbe\xd2\xdd\xf0\xd9\x51\xc0\x95\x52\x0d\x82\x15\x6d\xa7\x37\x64\x78\x1a\x21\x18\x5b\xc6\x8b\xbd\x81\x65\xc7\x59\x9a\x74\xf9\xea\xc3\x90\x82\x83\xaa\xc8\x9b\x8d\xa4\x23\x8d\xe4\xdc\xae\x8a\xc2\x69\x7f\xfd\x7a\xe8\xf2\xa5\xdf\x55\x14\xc0\x6a\xc3\x74\x9e\xb2\x9d\xc2\x62\x94\x03\x05\xee\xff\xc3\xde\xdb\x6f\xb7\x8d\x63\x89\x83\xaf\x82\xf2\xa9\xb3\x25\xc5\xa1\x58\x95\x74\xcf\x4c\x6b\x92\xe8\xe7\xd8\x4e\x55\xaa\xe2\xc4\x6d\xb9\x3a\x35\x6b\xda\x3e\x20\x09\x49\xb0\x48\x82\x45\x80\x92\x95\x56\xed\xd9\xd7\xd8\x47\xd8\x3f\xe7\x19\x76\x9e\x64\x9f\xe4\x77\x00\xf0\x4b\x1f\x24\x48\x99\x94\x94\x2e\xcd\x39\x53\x1d\xdb\x20\x70\x71\x71\x71\xef\xc5\xfd\x7c\x24\x86\xd1\xc1\x44\xef\x19\x47\xe2\x66\xbd\xe1\xd0\xbd\xda\x24\x24\x69\xd9\xf2\xce\x27\x6a\xf5\xba\x60\x92\x5f\x15\xaf\x92\xf0\x47\x8f\xa4\x48\xe2\x63\x38\x85\x6c\xa4\x28\x96\xba\xe1\xd3\x3e\x9a\x3b\xe3\x42\xd8\xe9\xcb\x24\x02\x47\xe3\x7f\x12\x40\x16\x19\xb9\x7f\xc2\xc3\x91\xb5\x95\xd4\xfa\x98\x43\xd3\x89\xac\x43\xf7\xe6\x95\x8d\xa8\xf5\xe6\x34\x40\x90\x21\x5b\xb4\x02\x00\x29\x34\x71\x0d\xa2\x67\xca\xc6\xcc\xa3\xe4\x9b\x06\xa5\x7c\xba\x46\xd1\xcd\xc5\xc3\x91\x83\x87\x23\xa6\xd4\xb8\x9b\xc8\x49\x8f\xa6\xd6\x5b\xbd\x6e\x84\xbb\xf6\xb1\xde\xee\x8d\x62\xa0\x9a\xd6\xbe\xe3\x75\x14\x1d\x4b\x39\x92\x28\x53\x3e\x09\xb7\x47\x6f\x02\x98\x6a\xe4\x26\x3e\xb9\xe9\x68\xb7\x92\x77\x19\x46\xe4\x28\xab\x9f\x00\x23\xc4\xa6\xb4\x97\x56\xd1\x4b\x00\xc9\xc7\xb4\xf7\x05\x82\x13\x7b\x22\xab\xf2\x94\x8a\x07\x49\xec\x35\x75\xeb\xe3\xe5\x05\xc3\x88\x83\x6d\xb9\x05\xc5\xba\xe4\x57\x6b\xbc\x11\x1f\xb8\x32\x8e\x9c\x66\xd3\x75\xf6\x31\xe8\xe2\x10\x90\x97\x1d\xb5\x2f\xf1\x1c\xeb\x02\xf2\xd6\x12\xc2\x1a\x76\xea\x48\x52\xae\x92\xeb\x27\x2f\x0e\x29\x92\x50\x6f\x1d\x42\xdc\x00\x41\x4b\x51\x13\x79\xf3\xd0\x30\x19\x17\x66\x62\x4f\x3c\xe2\xb8\x30\x92\xcd\x83\xda\xcf\xe2\xe0\xe6\x21\x74\x1c\x14\xcc\xb6\x11\xd6\xbe\x54\xca\xc9\x4c\x76\x5f\x28\xc5\xc9\x10\x7a\x55\x6c\x66\xb5\x67\xd2\xc6\xc4\xce\x01\xd1\x4a\x04\x63\xa4\x25\x2a\x74\xf1\x4d\xc3\x92\x9e\x4d\x31\x63\x28\x48\xcd\x6c\x72\xd5\xce\x43\x7e\x29\xee\x9f\x88\x8b\x1c\xe8\xa9\xea\x78\x64\x05\xd0\x05\xa2\x14\x0e\x11\x78\x4b\x60\x60\xef\xc6\x12\x22\x21\x59\x23\x67\x0e\x9d\x54\x0e\x9d\x54\xd2\xff\xdb\x48\x50\x1e\x3a\xa9\x2c\x4f\x52\x67\x7f\xa8\x21\x62\xa3\x88\xe5\x14\xb3\x7a\x06\x83\x70\x3f\xdb\x46\x67\x60\xab\xce\xa0\x0e\x61\x39\x95\xf4\xeb\x32\xe4\xb8\x41\x58\x8e\x38\x41\xcb\xa5\x85\xf6\x17\xc2\x1e\x54\x56\xce\x86\x0c\xbd\x77\x7a\x54\x15\xcc\x30\x3a\x23\x01\x46\x14\x3f\x67\xe9\xf2\x47\xad\x09\x4b\x8a\x98\xb9\xf0\x52\x86\x66\xdf\x27\x8a\x68\x8b\x0b\x18\x8c\x91\x48\x3f\x3f\x09\x19\x71\x33\x2d\xd1\x2a\x6b\xac\xdf\x68\x1a\xe8\x33\x18\x30\x40\x06\xe0\x84\xce\x3c\x0b\xc4\x20\xd4\xa3\x46\x94\x42\x4b\x68\x52\x9f\xb0\x62\xbc\x0c\xb7\x67\x17\xf6\x17\xec\xc2\x69\xda\x63\xe2\x49\x1f\x85\x43\xd2\xa1\xfe\xe0\x87\x97\x85\xd5\xfd\x2a\xf0\xbb\x70\x48\x40\x5e\x38\x63\xa5\x02\x7f\x02\xb4\x02\x53\xf0\xcc\x0c\xb0\x42\xbb\xdf\xd4\xfa\x11\xd3\x94\x7c\x05\xb5\x7a\x5d\x3a\xa3\xf7\x2e\xa4\x0c\x05\xf3\xd1\xcc\x0c\xe6\xf7\x21\xe6\xbf\x0e\x10\xf5\x89\x47\xf1\x04\xe9\xc2\x4b\x6b\x23\x3a\x66\xc4\x9f\xf3\x65\x09\xd7\xdf\x75\xec\x72\x4e\x3c\xd7\xb1\x3b\x6c\xf7\xda\xed\xba\x5e\x4b\x12\xc0\x35\x12\xe3\x50\x5b\xa7\x5a\x6d\x1d\x61\xf2\x14\x94\x54\x74\x67\xdf\xbf\xbd\x00\xa7\x24\x40\x2e\x62\x41\x63\x05\xc9\x15\x6c\xde\x72\x6d\xc8\x20\x83\xc3\x90\x61\x47\x59\x4b\x7f\x83\x1b\x87\x4d\x57\x86\x5d\x93\x01\x9b\xc2\x00\xe9\x6e\xcc\x9c\x35\x4a\x9c\x90\xb3\x66\xaa\x5b\x19\x2c\x14\x20\xeb\x0c\x32\x28\x14\x05\x55\xb0\xdd\x04\x05\x14\x81\xcb\x80\x3c\xce\x4a\xc4\x4e\xbd\x85\xd6\x98\x62\x1b\x69\xd7\x01\xf4\xa8\x4f\x02\x16\x15\xa1\x7a\x9e\x8e\xf9\xd1\x21\x26\x74\xe4\x08\x28\x2a\x3a\x6b\xef\xcf\xb6\xd0\xd3\xbd\x8c\x78\x88\x51\x9c\x18\x7f\xf9\x91\x0a\x1e\xad\x0d\x21\x43\x53\x98\x5b\x1e\x96\x23\xf5\xa7\xeb\xeb\xcb\x06\x43\x5b\xdf\xbf\xbd\xb8\xe7\x4b\xec\x5f\x60\xeb\x0a\x65\x26\x2e\x31\x2a\xe2\xd7\x68\xba\xd7\x3c\xdc\x5d\xe3\x09\x71\x30\xe8\x33\x12\xc0\x21\x2a\x57\x1f\x71\x33\x34\x5e\xf7\x25\x1a\xf7\x0f\x7f\x45\xf8\xf9\x8c\xcc\xbe\x3f\x42\x01\x02\xe5\x8a\xfe\x3f\x51\xb2\x46\x4a\x88\xb0\x70\xe9\x73\x21\x66\xdb\x53\x64\x42\xdf\x37\x0c\x7d\x6a\xd1\x95\x74\x8f\x83\xa4\xdc\xb5\xa4\x5c\x77\x0f\x87\xc8\x8b\xef\x61\x4c\x0f\x11\x6b\x73\x0a\x2a\xc1\x2f\xd2\xdb\x25\x09\x18\x54\x44\x55\x29\x1f\xee\x40\xcc\xaa\x7d\x46\xe6\x0b\xed\x03\x91\x66\xac\x65\xd1\xf0\x9e\x3d\x6a\x91\x3a\x8d\x6c\xed\x1a\xbb\x88\x32\xe8\xfa\x5b\x10\x0d\x07\x1a\x6c\x90\x06\xf9\x10\x41\x49\xba\x2f\x29\x29\x8f\xea\xde\x2b\xac\x43\x9b\xb1\xfb\xb2\xc5\xe6\xb6\x4d\x5a\xb9\xc5\xe1\x0a\x88\xac\xa0\x18\x5c\x25\x0b\xd2\xda\xa2\x6f\xfb\x4b\x82\x25\x8a\xb4\x95\xc9\xbe\xce\x14\x63\x5b\x4b\x7f\x1f\xdf\x7d\xf2\x1c\x65\x63\x90\xa6\x8c\x44\x49\x30\x58\xec\xca\x33\x8c\x4e\xbb\x87\x5b\xbd\x2e\x81\xee\x7c\xd2\x9e\x9a\xe4\xd1\x30\x3a\x36\xaa\x33\xcf\x32\xd1\x7b\xbd\x01\x11\x7b\xef\xd8\xf9\x52\xe1\xe3\xb5\xa2\xeb\x41\xaa\x73\x24\x3c\x7d\x5f\x1b\xe4\x99\x33\xec\x31\x5c\xa8\x74\x5d\xbe\x55\x98\xc6\x9e\xe2\x38\x8b\xe6\x48\xeb\x19\x61\xdf\xbc\x17\xff\x90\x6d\x44\x55\xd1\x31\x0f\xf2\x57\x54\xc7\x3e\xbd\xaf\x48\x10\x72\x8a\x83\x35\x7b\x47\xd6\xec\xe7\xf9\x07\xb0\x5c\x22\xa8\xa8\xb3\xf5\x6a\x69\xa0\xaf\x57\xc7\x58\x29\xf5\xa3\x34\x09\x61\x6f\x82\x29\x26\x1e\xe7\x38\xa1\x87\xd9\xac\x30\x83\xfe\xbd\x8d\x20\x97\x1f\x3b\x49\x8d\x30\x8c\x8e\x3b\xc3\x11\x04\x71\x8b\xc0\x06\x58\x78\xb4\x42\x21\x4f\xb3\x49\x1f\x39\x0e\xe8\x8f\x88\x22\xe6\x7d\x7b\x61\x69\x9b\xef\x97\x50\xe4\x38\x85\xdb\x75\xdd\x90\x41\xd3\x41\x7b\x90\x99\x75\x87\x63\x60\x64\x02\x84\x8b\x3d\x21\xe0\x1f\x68\xd5\x92\x82\x2a\xe4\x0c\xa0\x85\x4c\x42\xc6\x99\xb8\x91\x64\x6d\xad\x20\x76\xe4\xbd\x2b\xea\x65\x6d\xbf\x0e\x79\x54\x7f\x4f\xe7\xb2\x10\x3d\x32\x14\x78\x50\x99\xd4\xb7\xec\xd5\xc8\x00\x7f\x90\x7b\xfb\xc1\xd4\x2b\x7a\x71\xc5\x9d\x96\xa7\xa8\x70\xe5\x46\x67\x2d\x9a\x07\xed\x5f\x3c\x41\x16\xba\x56\xaf\x0b\x4e\x2f\xfa\xed\x5e\x0b\xc4\x61\xcf\x87\x2a\xc6\x5f\x29\x7d\x46\xb4\x29\xda\x3c\x15\x52\xa7\x27\xbb\xc9\x34\x15\xf8\xf9\x8d\xa6\x81\xd3\x7e\x1f\xc4\xeb\x48\xfb\x1c\x74\x80\x8d\x06\x30\x74\x58\x61\x67\x9e\x68\x12\x33\x40\xd8\xd3\xb1\xe7\x8b\x19\xf4\x68\xb3\xf1\xff\x0e\x42\xcf\x62\x18\x35\xe1\xc2\xe2\x97\x5c\x2c\xde\xf1\x1c\x9d\xf8\x0e\xa1\x14\x7b\xc3\xd8\xdd\x12\x03\x90\x8f\x5a\x0b\xfa\x34\x74\x14\xd9\x45\x65\x1a\x33\xfe\xa6\xf1\x51\x0b\x73\xee\x4a\xa1\x49\x9e\xe0\x11\x20\x85\x1a\x8d\x10\x95\x5c\x92\xd7\xd7\x78\x46\x60\x23\x9d\x38\xd3\x1a\x63\x97\x1e\x39\xa1\xe3\xc7\x30\x15\xa3\xc4\x46\x8f\x23\x6c\xaa\x7a\xf1\x6c\x7e\xe3\x5a\xbd\x2e\x57\x4d\xe6\x32\x6f\xaf\x2d\x2b\x1b\x7b\xf6\xe3\x17\x8d\xb2\xd0\x96\x01\x10\x4f\x15\x1a\xe9\x26\x0e\xe2\x61\x3f\xc5\x43\xc1\xb3\x5d\x56\x1f\xdd\x56\xb1\xd3\x56\xaf\x2b\x17\x6c\xf5\xba\xdf\xce\x53\x43\xf6\xfc\xe6\x4e\xd7\x6e\xdb\xf3\x56\xaf\x7b\x37\x37\x0c\xb3\xfd\xd3\xf5\xf5\xe5\xd9\x53\x23\x91\xb7\x82\xeb\x35\xd5\x34\x0b\xd0\x7d\xbe\x70\xdd\x15\xf8\xce\xcd\x6f\x2b\x83\xf7\xa7\xc6\x6e\x47\x77\x7a\x21\x5d\xad\x69\x5c\x52\xec\x62\x07\x69\x53\x99\xb1\x2d\x52\x04\x23\x30\xf4\x4a\xaa\x78\xc2\x91\x8a\x95\x1d\x1b\x5b\x8a\x68\xb9\x8d\x19\x6f\x9a\x33\x6d\x18\xf4\xb8\xd5\xeb\x9e\x9e\x5f\x7d\x04\xed\xde\x4a\xb5\x99\x56\xaf\x6b\xd9\x74\x0a\x65\x61\x42\x14\xf0\x97\xb5\x35\xd2\xb1\x00\x4e\x9f\xcb\xff\xd5\x62\x87\x94\x2c\x47\x33\x5f\x1e\xd7\x36\x8e\xde\xf0\x35\xce\xfa\xa0\xdd\x93\xfb\x92\x5a\xbb\x61\x64\x78\x56\xf3\x32\x6f\x01\x56\x05\xee\x15\x1d\x99\x37\x0c\x33\xf1\xec\xd9\x3e\xc5\x46\x78\xf6\xcc\x0f\xc8\x03\xb2\x14\x94\x38\x40\x81\x47\x2a\x54\xc0\xd8\x62\x63\xbe\x4d\xcc\x38\x58\x6e\xe8\x41\xf1\xd8\x18\x84\xa2\xe1\xae\xd2\xb8\x59\x63\x20\xaf\xe8\x6c\x26\x63\x55\xa3\xd6\x66\x38\x03\xc6\x7d\x54\xd0\xc9\x38\x92\x43\x26\xd0\x09\xf9\x98\x96\xf8\xa9\x1d\xff\xda\x30\xf4\x6c\xbb\xd1\xcc\x9c\x39\x1f\x94\x58\x4a\xcc\xb9\x25\xa2\x4c\xa1\x28\x56\x4e\xa9\x8f\x2c\xa6\x6c\x44\xba\xa9\x53\x31\x46\x5f\xd4\xad\xf5\x2d\x1a\x62\x6f\x7e\xee\xd9\x6d\x90\xae\x0c\xce\x5d\x13\xd9\xb2\x93\x7b\x89\xb7\xa1\x65\x73\xb6\x88\x93\xcf\x6b\xef\x49\x9a\xfa\x1c\xe3\x25\x8a\x6d\xf7\x1e\x65\xd0\x54\xc5\xa9\xc7\x05\x4a\x9e\xaf\x62\xf4\x79\xe1\x15\x48\xfe\xfa\x01\x4f\x10\x38\x1d\x65\x8a\xd6\x1f\xfd\x4a\x51\x00\x3e\x79\x26\x81\x81\x1d\xd5\x30\xac\xcf\xea\xa9\x07\x64\x4c\xe2\xcd\x35\x91\x3e\x88\xa3\xb9\x3b\x98\x14\x23\xd7\x63\x7b\x99\x98\x93\x81\x2d\x17\x07\xf2\xbb\xc3\x9b\x68\x3f\x4d\x66\xd1\x2d\xe7\xa7\x68\xb9\xb4\x13\x84\xf9\x64\xc8\x90\x03\x4e\x2c\xc6\xef\x60\x5a\x9e\x13\x5c\x23\x6b\xe4\x11\x87\x0c\x2b\x28\x3a\xc9\xed\xdd\xb4\x12\xa6\x00\xc6\x30\x5a\x57\x86\xd1\x2e\x84\xa9\xd5\xeb\x82\xbd\x51\x92\x18\x2a\x76\x85\x79\x0c\x79\x14\x9d\x21\x13\x32\x85\xed\x48\xc4\x97\x7a\x6c\x31\xc4\xa8\x3e\x5f\x8f\x80\xc3\x16\x70\x34\xd1\xee\x7a\x61\x01\x15\x46\x82\xb4\x19\xf7\x76\xa3\x7d\x5a\xbd\xae\xac\xa6\x8e\x23\x28\x64\xf5\x27\xe8\xe3\x79\x92\x2a\x16\xff\x49\x0a\x64\x2e\x22\x33\x83\x27\x3f\xb4\x1b\x11\xc9\x72\x01\x25\xde\xe8\xd6\x1d\xc8\x29\xe2\x30\xd5\x4c\xec\xcd\xdf\x7f\xbc\x3e\xbf\xea\xff\xf4\xe9\xb2\x4e\x44\x44\x04\x24\xf6\x57\x8c\x84\x09\xf2\xb0\xe2\xe1\x1b\x57\x5b\xcd\x72\x8f\xcd\x2e\x55\xba\xf9\xf4\x4d\x3c\x97\x53\xb5\x57\xde\xc6\xfc\x35\x2c\xda\xa8\x19\x47\x03\x42\x18\x0a\x8c\x23\xd0\xee\x95\x79\x31\x8b\x7c\x28\xb9\xb3\x76\x6f\x1e\xfd\xab\xe8\xdd\x2c\x87\xf2\xcf\xda\xbd\xc5\xe7\xb3\xf8\xcb\x2b\x1d\xbe\x31\x0c\xfa\x6c\x92\x96\xef\xd9\xe2\x43\x7a\x11\xfa\xe2\x57\xd4\x14\xb9\x2a\x2b\x46\x30\xf3\x19\x71\xb1\x87\xea\x6a\x9e\x12\x4d\x9c\xc4\xfd\xc5\x8a\x37\x87\x25\xb9\xf0\xd1\x0f\x32\x5a\xa0\x01\x15\x91\xcf\x5f\xac\x7e\x13\x0f\x5b\xf5\x39\x1b\xb6\x1d\x65\x7b\xe2\x0d\x43\x07\x96\x0c\xaf\xdd\x4a\xc3\x18\xe0\x0d\xb5\xe8\xdd\x2a\x1e\xb9\x71\xff\x8c\xa3\xf5\x0b\x6d\x55\xc5\x13\x0d\x21\x25\xc6\x3a\xb1\x5f\xa3\x4c\x30\x16\xa7\x91\x41\x8c\xb1\x42\xbe\xc9\x47\x12\x4f\xe1\x1c\x7d\x47\x38\xa7\x94\x01\x8e\x4f\x8c\xab\x7c\x26\x4d\x85\x32\xc4\x32\x5a\x7c\xb1\x6c\x4b\x51\xb0\x65\xbd\x1c\x29\x5a\xbe\x08\x3f\x3f\x9f\x38\x66\xa8\x50\x49\x2e\x47\x84\x11\xf0\xa3\xa8\xee\x53\x5f\x98\x52\xfe\x56\x97\xdf\x65\x12\xc6\x38\xa2\xa1\x30\x85\x58\x7e\x7e\xc8\xab\xa8\x23\xaf\xe2\x01\x72\xb4\xf3\xe7\x97\x8e\xbc\x5c\xfa\x79\x4b\x28\x05\x27\x69\xa9\x98\xda\xd3\x11\x97\x9f\xb1\x62\xc5\xb5\x8d\xf3\x76\xf3\x12\x7a\x30\x89\xec\xf9\x28\xff\x05\x69\x47\xac\x5f\x88\xaf\xcf\xc8\x6c\x18\x41\x9f\xd1\xfa\xe6\x82\x5b\xcf\x67\x2a\xa6\x0f\xc5\x5d\xdc\xd4\x77\x59\x9e\x66\x96\xef\xd5\x9e\x5d\xd9\x5c\xda\x02\x65\x2e\xf0\xe2\xd7\x53\x64\xe6\x52\xe5\xf9\x35\x38\xe7\xcf\x10\x3f\xc0\xb4\x9e\x3c\xcf\xb4\xd4\x04\x5f\xe0\x01\x31\x0d\xa5\x0b\xec\xf0\xba\xf2\x57\xe7\x03\x62\x28\xde\x05\xe7\x88\x1d\x33\xc8\x55\x47\x7f\xee\x83\xd3\xad\x54\xd1\xcd\xdb\x7d\xb4\xc0\x03\x6d\xaa\x14\x6e\x8c\x14\xaa\xae\x83\xfb\x73\xff\xdc\x22\x58\x51\xf0\xbf\xb1\x87\xcb\x5d\xab\xd7\x15\x1a\x60\xbb\xdb\xd3\x75\x87\x88\xd2\xee\x0f\x14\x71\x90\xa2\xf7\x0b\xff\x65\xdd\xb1\xee\xd1\x0a\x85\x8f\x96\x9f\xaf\x3f\x34\x18\xe3\x1e\x7d\xde\xea\x75\xa5\xdb\x4a\xc6\x03\x49\xf7\xd4\xcf\xd7\x1f\xfa\x3f\x7d\xba\x9c\xa7\x3e\xea\x07\xe6\x18\x46\xc7\x1f\xf9\xf9\x15\x6f\xeb\x27\x1e\xe6\xa4\x0f\x5f\x1b\xc9\xf8\xba\x31\x43\xba\xf8\xc3\x88\xf8\x2f\x73\x31\x07\x47\x18\x82\xb3\xdf\x2a\x14\x10\x3c\x43\x93\x27\xb5\x92\x91\x88\x00\xd8\x7e\x6d\x1c\x49\xe3\xd7\x09\xa5\x88\x9d\x0c\x87\x01\x1a\x42\x86\x6c\x7e\x99\xc5\xa0\x2d\x22\x90\xa3\x41\x90\x98\xfd\x98\x8f\x2a\x07\x93\x3d\x0c\x40\x8e\xe0\x7a\xda\xf6\xf9\x14\x85\xac\x27\x55\xe0\xbf\xf6\x46\x1b\x59\x45\x3c\x6f\xab\x42\xed\x51\x7a\x71\x2b\x47\x5d\x2e\xaa\x45\xd1\x1a\xad\x5e\xb7\xb3\x7b\x45\xf1\xf0\x40\xab\xfa\x40\xe3\x17\x87\x04\xd0\x92\xe1\xaa\x3a\x43\xd6\x28\xea\x7f\x27\xa6\xd2\x45\x44\x95\xf6\x40\x7d\xed\x87\x97\x7f\xfd\xcb\xbf\xff\xb5\xf8\x61\x92\x2a\x30\xef\xbd\x01\x99\x60\x2a\x78\xec\x58\x15\xe5\xda\xbc\xe2\x83\x39\x81\x6a\x33\xab\x99\x9a\xb7\xfe\x08\x3b\x64\x68\x66\x72\x97\x1e\xa2\xc0\xb5\x5c\x2c\x89\x07\x09\x78\x07\x95\x31\xf7\x4f\xbc\x9e\x3f\xf7\xdf\xed\x45\xe1\x80\xc3\xd5\xdc\xc0\x76\x32\x81\xb2\x5e\xc0\x80\x93\x49\x47\x7c\x5f\x50\x0a\x20\x43\x57\x25\xb2\x8c\x9e\x4c\x57\x5b\x2d\x45\x74\xa0\xab\xfa\xe8\x4a\xc5\xf2\xf9\x7f\x10\xd2\x1f\xa8\x2f\xb9\x7f\x31\xcb\x47\xe3\x99\xaa\x7b\xd9\xd6\x3a\x12\x3d\x08\x60\x02\x53\x3e\xe4\xe2\xa6\x44\x12\xc4\x57\x7a\x26\x70\x4f\xa4\x23\x65\x5b\x7d\xc8\x31\xe0\x23\x99\x02\x6d\x69\x9c\x08\x4f\x8b\x07\xf4\xcf\x3f\x01\x06\x87\x35\x24\x4f\x44\x13\xb6\x26\x4f\x2f\x6a\x19\x6f\xbb\x50\x1f\x44\xde\x18\xab\x5c\x18\x6f\x43\xec\xd8\xe0\xf4\x7d\x39\x87\xaf\xe0\x0a\xf1\xbc\xdd\x4c\x9d\xf9\x1c\x4e\x10\x23\x95\xfa\xd0\x03\xb1\xb3\xf7\x41\x7e\x7f\x3f\x41\x81\x71\xf4\x66\xe9\x4c\xc5\xde\xc4\xdf\x65\xa4\x81\x71\xf4\x26\x5a\x0f\xf0\xf1\x46\x07\x2c\xad\x79\xe0\x33\xfb\xc2\x67\x32\x67\x57\x14\xca\xf7\x33\x62\xcd\x45\x65\xc4\x04\xd7\xea\x75\x6d\x3c\x99\x43\x8a\x6d\xd4\x96\x4f\xf6\x07\xb9\xae\x66\x06\xd0\xb3\xb1\x37\x34\x8e\xb6\x14\x83\x1b\x2d\xdc\xa1\xb9\xf9\x82\x3f\x23\xc6\x1a\x09\x91\x17\x13\x0b\x37\x62\x6b\xa1\x31\xd1\x41\x78\x7f\x2d\x97\x2a\x12\xde\xc8\x72\xb0\x4f\x91\xb4\xca\x0b\x6a\xc9\x23\x25\xec\xda\x4f\xcd\x73\x91\x5c\x9e\x4f\xa4\xc9\xd8\x56\x0b\xad\x56\x47\x95\x7f\xff\x8c\xed\x3d\xa9\x88\xfa\xc0\xe1\x29\x14\x87\x38\x80\x83\xd2\x35\xb3\x92\x9d\xd6\x90\x8f\x90\x87\x84\x68\x05\xfd\x41\x40\xd6\xc4\x2b\xd5\x26\x16\xed\xc8\xe9\x8b\x51\xa3\x6a\xe5\x5f\xb5\x37\xf9\xcf\xef\xff\x71\xae\xfd\xda\x3f\xbf\x4a\x4b\xe6\x66\x69\xa7\xff\x61\x1d\x41\x4d\x90\xf6\xce\x21\x53\xed\xbd\x9d\xf3\xd7\x2b\xf4\x7b\x88\x28\x5b\x33\xe0\x51\x7b\xe0\x03\xac\x51\x40\x5c\xa4\x4d\x03\xe8\xfb\x68\xd7\x94\x99\x10\xe6\x04\x25\x36\xee\xa2\x43\x20\xa6\x89\x82\xb7\x50\xe5\x48\x6b\xa2\x55\x60\xfe\xa6\x57\x74\x58\x01\xa5\x09\x97\xbd\x71\x87\x60\xf7\xe4\xaf\x5f\x41\xb0\xfb\x43\x72\x8a\xc5\x14\x49\x5c\x65\x0d\x83\x52\xc2\xe4\x54\xb6\xfa\xd2\xce\x3d\x8b\xd8\xa9\x41\x41\xcc\xff\xcd\xb2\x46\x5f\xe4\xc7\xb2\xf1\x44\x86\x48\x71\x8d\x4e\x5e\xf3\xe0\x3e\x30\x8e\xe6\x49\xf2\xbb\xc4\x7e\x5b\x8c\x6a\xf5\xba\x03\x84\xec\xb9\x45\x5c\x9f\x78\xc8\x63\xb4\xad\x5b\xc4\xbd\x9f\xbf\x12\x45\x86\xc4\x98\xf8\x55\xe2\x63\xc7\xd9\xe4\x49\xb1\x7a\x41\xc4\xa6\x0a\x63\xee\x0f\x97\xe5\xab\xb8\x2c\x89\x6a\x21\x8e\xb4\x28\x30\xf7\x97\x17\xcd\xf1\xec\x68\x8a\x57\xdf\x68\x1a\x27\x2a\x49\x5f\x9f\x49\x30\xa6\xc0\x38\xfa\xe5\x85\x71\x34\x8f\xba\x95\xfc\xf2\xa2\xf9\x27\x71\x96\x23\x28\xc8\x32\xe1\x0c\x25\x89\xb0\x22\x97\xc8\x25\xb3\xcc\x94\x5b\xe3\x18\x4f\xa6\xea\x2a\x5c\xa4\xe8\x16\xa4\xf3\x65\xe7\x3e\x34\x06\xcd\x81\x74\x5f\x1a\xa3\x3d\xa5\x31\xe8\x2a\x87\x02\x65\x18\xdb\x10\xb1\xf1\x8b\x42\x9e\xf6\xbe\xdf\xdf\x49\x9b\x92\x6a\xbc\xaa\x0c\x03\x1f\x63\x4a\xa3\xad\x14\xe9\x3b\xbf\xc0\x07\x68\xe2\xaf\xb9\xde\xa4\x87\xa6\x63\xb1\x89\xe2\x6d\xba\xfe\x4c\x55\x98\x69\xcd\x4b\xf8\x3d\xa5\x21\x02\xd7\x01\xb4\xc6\x4a\x2b\x54\xd5\x37\xb0\x35\x30\x8c\xce\x58\x02\x16\xc5\x64\x8d\xef\xcd\x90\x31\x52\x7b\x3e\x49\x4c\x13\x72\x31\x05\xa2\x54\xc1\x23\x0d\x65\x8f\xc9\x14\x1b\x77\x36\xe6\x10\x18\x46\x07\x07\x05\x08\x58\x96\x1d\x37\xa7\xbf\xdc\x0a\xc8\x37\x26\x23\xb1\x6c\xb4\xe8\x5a\xc4\x20\x57\xd5\x68\x61\xc9\xd7\xf8\x3c\xfb\xfb\x4d\x23\x94\xe5\xb2\xbb\xba\x5f\x1c\x2f\x1c\x00\xab\xb0\x59\xdd\x2f\xc8\xb3\x09\xf8\x55\x51\x80\xfc\x49\x99\x48\xd1\x1c\x69\xea\x88\x61\xd0\xe3\x24\x7b\xe4\x19\x65\x33\x07\x51\x7d\xcc\x01\x91\x57\x49\x76\x10\x5b\x4c\x25\x11\x43\x6b\xab\x42\x21\x41\x5a\xa3\x30\x3e\xfc\x3d\x44\xc1\xac\x72\x56\xd3\x52\x6d\x5b\xd0\x94\xbd\x37\xb3\xfa\xc3\xef\x1c\xd2\x12\xcd\x95\xc5\xe8\xd4\x0b\xae\xcb\x0f\x57\x5a\x2c\x3f\x5f\x9d\x3c\x8a\xc1\x15\x43\x7b\xb2\x31\x12\xc5\xc4\x6b\xf7\x5e\xaf\xd5\xf1\x1a\x7b\x06\x09\x47\x8d\x84\xa8\x8a\x6f\x87\x73\x4d\x86\x1c\x14\xe0\xb1\x64\xd0\x9c\xc2\xb4\x10\x17\x5c\x05\x86\x2d\xb2\x9f\xdd\x54\x33\xc0\x45\x1a\xf6\xd5\xed\x31\x30\x8c\x96\x29\x1c\xa2\xd1\x79\x18\x46\xbb\xfd\xa4\x00\xc5\xb1\x5c\xa6\x98\x63\x50\x16\xa0\x12\xec\x74\x83\x96\x22\xf1\xdc\x4d\xf2\x4c\xb9\xea\x9a\xbb\x9f\x34\x33\x01\x27\xfd\xcb\xce\xc7\xf3\xeb\xc6\x1b\x63\xff\xa6\x9d\x50\xff\x23\x62\x99\xd2\x90\x47\xad\xce\xb1\xa2\x29\xf6\xdd\x49\xff\xd2\x30\x04\x80\xc5\xdc\x24\x33\xc7\x6a\x40\xf5\xfd\xfd\x3f\xde\x9f\x7f\xee\x5f\x9f\x5c\x9f\xd7\xf4\x26\xdc\xf8\x8d\x97\x36\xa8\x51\xa3\x1b\xac\xa5\xae\x35\xf0\x15\xe0\x1d\x80\x8d\x1a\xd9\xac\xd9\x27\xd8\x83\xa7\xdb\xe2\xbe\x96\xf7\x59\xa6\x31\x4e\x32\xb2\x10\xeb\x62\x44\x41\xa3\x9c\x9c\x0d\x81\xe2\x73\x00\x40\xdd\x40\x27\x07\x0b\x20\x1f\xf9\x79\x70\xe4\x1d\x42\xde\xf8\xb5\x87\x91\x07\x4a\xee\x7b\x3a\x6f\xf6\x8d\x1a\xf2\x24\x10\x14\x52\x91\xb2\x51\xcf\xea\x34\x25\x9e\xed\x90\x56\x33\x46\x0a\x77\xde\xe2\x9e\x90\xa7\x85\x54\x87\xd4\xf7\x10\x13\x3d\x35\xf5\x41\xe8\xd9\xd0\x45\x1e\x83\x0e\xd5\xe3\xa6\x6a\xe3\x54\x10\xac\x97\x41\xb3\xd3\xb3\x8f\x4f\xaf\x56\x9c\x61\x02\x63\x34\xb3\x6c\x4f\x43\xde\x10\x7b\xe8\xdb\x5d\xa7\x6e\x49\x68\x0a\xc5\x30\x36\xa1\xa7\x78\xed\xf5\x11\x0c\xac\x11\x38\x17\x7b\xca\x3c\x6a\x2a\x07\x33\x03\x70\x34\x36\x3d\x2d\x5e\x7b\x9c\x5d\x3b\xfa\xdb\x24\x15\x61\x77\x09\xeb\xcc\xc3\x63\xf4\xdd\x2b\x86\x99\x83\xde\xc8\xad\xbc\xd2\xe5\x4f\x4d\xab\xf8\x1f\x89\x8d\x16\x15\xde\x3f\x63\x50\x87\x47\x6c\x94\x96\x40\x04\x65\xc3\x3a\x1c\x48\x19\xb6\x3a\x16\x49\x3b\xb9\x46\xc4\x90\x4b\xa6\x1e\x62\xd8\xaa\x50\x3e\xb2\x99\xf0\xfa\xb1\x84\xa3\xd5\xeb\x6a\x93\x5e\x46\xb4\xaf\xbc\x83\xea\xbb\xca\xc2\x6a\x29\xc3\xed\x05\xe7\x0b\xb0\x65\x07\x64\x8a\x1c\x47\x4f\xb0\x92\x1b\x75\xf6\x8b\x03\x03\x0f\x82\xd3\x11\xb2\xc6\x24\x54\x24\x27\x5c\xc2\x99\x28\x82\x23\x0b\xd7\x53\x92\xad\xd6\xb5\xa6\x77\x58\x5c\xd3\xaf\x1e\x04\xd7\x8b\x30\xc1\xfc\xc4\xde\xd3\xca\x4c\x9e\x08\x62\x81\x4e\x3e\xb2\x3c\x22\xb0\x54\xa5\x1d\xcd\x1e\x57\x29\xed\xea\xfa\x38\xda\xd1\x43\xb1\x01\x98\x28\x04\x40\x13\x56\xad\xbb\x31\x81\xcd\xca\x47\xb9\xf0\x81\x75\xe7\xd0\x46\x75\xd6\x3d\x26\x50\x49\x48\x0e\x4c\x29\xa5\x42\x92\x6a\x2d\x96\xc1\x6f\x34\x4d\x3c\x4d\xaf\x47\x98\x82\x08\x7c\x80\x29\xf0\xd3\x50\x7a\x09\xe1\xc2\x6a\x60\xc5\x0e\xb3\x91\x65\xe5\xae\x70\xea\x43\x08\xc4\xce\xe9\xbd\x62\xbc\xd0\x98\x1f\xa7\x96\x56\x4d\x2a\x72\x19\x12\x13\x5f\x40\xca\x54\x75\x5c\x76\xeb\x44\xcb\x25\xb7\x83\xc1\xea\x60\xb0\x3a\x18\xac\x0e\x06\xab\xcc\xf8\x83\xc1\xea\x49\x06\x2b\xf1\xfa\x20\x26\x76\x85\x50\x10\x35\x73\x58\xbe\x57\x99\x8c\x14\xea\xf7\x87\x8d\x5b\x23\xc9\xb2\xfd\x11\xe7\x1b\x93\x11\xbc\x77\xc8\x10\x7b\xf7\x16\xf1\x18\x7a\x64\xc6\x11\x88\xab\xf9\x63\x8f\x05\xd0\x43\xfc\x57\x6c\xe6\xf3\xdf\x8c\xb0\x6d\x23\xcf\x38\xca\xb6\x01\x48\x92\xd6\x74\x6b\x88\x35\x13\x7b\x3a\x9f\x54\xaf\x47\x7d\x1a\x41\x90\x67\xf1\x29\xa7\x36\xa1\xc0\xd9\xbe\xde\x64\x18\x26\x5f\xd8\x30\xcc\x56\xaf\x0b\x7a\x7a\x6f\xc1\x2e\xf0\x55\x28\x46\x28\x70\x2a\xc5\x50\xf1\x33\xd7\xd2\xce\xbe\x24\x18\xe6\x3f\xa9\xc9\x48\x69\x5e\x7c\x62\x7e\xb6\x5c\x22\xa3\x74\xab\xe2\xaa\x1b\x56\xa7\x0e\xda\xfb\x5e\x68\xef\x9c\x28\x4a\x6a\xef\x63\xa4\xaa\xd1\xb5\x31\x03\xe6\xbf\x00\x0e\xf4\x86\xaf\x8d\x23\xce\x4c\x93\x6c\xe0\xb1\x46\x49\x18\x58\x48\x43\x94\xc2\x19\x05\x63\xcd\x41\x1e\x8d\x7e\x5a\x64\xba\xdf\x68\x9a\x61\xd0\xe3\x5f\x3e\xfd\x72\xfe\x11\x9c\x9d\xbf\xfd\xf5\xc7\x1f\xdf\x7f\xfc\x31\x97\xe7\x46\x9f\x8d\xf9\xb6\xa4\x59\xf2\x81\x1a\x46\x7a\xdc\x73\x9d\x32\x12\xc0\x21\x2a\x2a\xcc\xbd\x12\x37\xc0\x67\xcb\xbb\x59\x87\x6b\xb1\x37\xd7\xe2\x79\xfe\x01\x1c\x9a\xd7\x97\xe6\x1d\x63\xe4\x75\xdc\xdc\xb4\xe5\x5f\x88\x83\xcd\x00\xd7\x10\xe0\xb3\x56\x9a\x89\xc9\x6b\x13\x5d\xab\x17\x79\xcd\xfc\x15\x2c\xca\xd0\xc1\x90\x46\xc5\xa7\xd7\x23\xc7\x25\xf6\x9e\x06\x3f\xdd\x65\x80\x3b\x30\xb0\xfd\xb8\x9d\x1b\x64\xf1\x8d\xc5\x29\x5a\xae\xc2\x14\xed\x31\x38\x66\x78\xa0\x2a\x30\x50\xab\x13\x2b\x9a\x54\x34\x70\x98\x4e\xa7\x86\xd1\x69\xf7\xc6\x09\x24\x51\x84\x37\x72\x4d\x64\x37\xe1\x25\x94\xc8\x89\x57\x2b\xc6\x0e\x51\x45\xfc\x3f\xa9\x03\xf6\xcd\xdd\x2f\x6f\xb4\xdb\x63\xb1\x0c\x68\xdd\x40\xed\xcb\xe6\xc5\x4b\x56\x39\x18\x87\x7d\x53\xfe\x65\x07\x08\xba\x7f\xe9\xd8\x48\xb7\xa4\x11\x27\x0f\x3d\xa4\x0e\x26\x26\x2e\xa9\x9c\xed\xf4\xa2\x9f\x35\x77\xde\xb5\x3a\xf9\x71\x0d\x2a\xf2\x92\x33\x56\xa4\x1e\xf9\xf1\xc1\xfe\x7b\xb0\xff\x1e\xec\xbf\x4b\x23\x0f\xf6\xdf\xa5\xf1\x07\xfb\xef\xc6\xf6\x5f\xf1\x80\xe0\xec\xb9\x58\xfe\x32\x4c\xf1\x24\x1c\x13\x4f\xd5\xed\xad\x36\x3d\x39\x9a\x70\x9c\x59\xba\xc1\xa8\xa5\x44\x1d\x49\x57\xeb\x0c\xf2\x73\x26\x42\x13\x05\x1e\x62\x88\x82\x33\x48\x47\xa2\xa3\xa7\xa2\x79\xd7\x13\xab\x5a\x0b\xc3\x8c\x37\xd4\xa0\xef\xbf\x36\x8e\xc6\xc9\xfa\xc9\xf2\xdb\xa9\x93\x25\xcc\xa9\xc9\xe2\x45\xf5\xc3\x3e\x9c\x5f\x5e\x7f\x52\xc5\xaa\xee\xe0\x51\x15\xc1\x55\x5d\xed\x38\xbc\xa7\xf6\xe4\x3d\xe5\x20\x9f\x11\x4f\xe3\x0f\xaa\x02\x33\xe9\x07\x68\x72\xc5\xa9\x89\x7c\x9d\x68\xea\x44\xf9\x90\x8d\xe8\x76\xdb\xa7\xc6\xc3\xb2\x43\x02\x34\x27\x18\x4d\xf3\x91\x12\xc0\x49\x99\x24\xa6\x7d\xed\xbf\x76\xb8\x84\xbb\xbe\x84\x5c\x02\x38\x92\x8c\x8a\x34\x86\x0f\x90\xa1\xc0\x87\x0a\x6b\xc6\x9a\x08\xde\x5a\x95\x87\xb4\x19\x22\xf1\x3c\x64\x31\x12\xb8\x53\x6c\x18\x1d\x27\x82\xce\x30\x44\x03\xac\xd6\xcd\xf7\xda\xdf\xf8\x69\xf1\x97\xff\x89\xf6\x45\xbb\x7d\xa6\x3b\x78\x82\xf4\x1b\xc3\x98\x6a\xb7\xc7\x2a\x65\x03\x80\xa3\x78\xc6\x6e\xb2\x52\xd7\x82\x8e\x63\x42\x6b\x4c\xbb\xc4\xbb\x0f\x29\x0a\xee\x47\x90\xde\x43\xd9\x3f\xb8\x0b\x8e\x6c\x44\xcc\x41\x48\x39\x66\xae\xd1\x23\xdb\xcc\x44\x10\xab\x7c\xf1\xfa\x62\x3f\xf9\x87\xf2\x65\x56\x25\x58\x78\x29\xbf\xb7\xae\x53\x71\xe0\x97\x28\x11\xd7\x0c\xc8\x94\xa2\xa0\xd1\x78\xf4\xae\xae\xdb\xd0\xc3\xc8\x61\x90\x44\x3c\x52\x62\x21\x0f\x49\x08\x0e\x94\xad\x19\x2e\xa0\x5f\x37\x52\xe4\xb2\x8d\x34\x00\x8a\xe6\x2e\x0e\x86\xfd\xc0\xc9\xb2\x78\xd3\x35\x94\x47\x8a\xb3\xe1\x8f\x41\x80\x1c\xd1\xa1\x65\xe6\x20\x3a\x42\x88\xe9\x0e\xa2\x34\x6e\x5b\xd9\xb4\xb8\xe4\x6b\x59\xb4\x58\x81\xc0\x03\x14\xa8\x18\x58\x19\x8b\x5a\x34\x91\x76\x49\x02\x06\x39\x36\x8e\xa4\x81\x91\xde\x1e\x6f\xc7\xf5\x5f\x8a\x7f\x48\x20\x0b\x09\x04\x0f\xea\x6e\x58\xc2\xa7\x5c\x31\xaa\xed\x44\xb1\xe8\x5b\xb0\x6c\xc5\xac\x7f\xd9\x08\x7b\x4e\x07\x94\xe3\x41\x73\xa0\x37\xac\x12\x69\xef\xe0\x01\x9b\x22\xb3\xa8\x07\xc2\x07\x3c\x1c\xb1\x0b\xe2\x45\x69\x79\x0d\x1a\xf3\x81\x58\xca\x4d\x96\x02\xa7\xc4\x9f\x05\xfc\x77\xc9\x5f\x6a\x30\xeb\x2f\xef\xa7\x3a\xc9\x1d\x74\xd9\x5d\xeb\xb2\x82\x72\x25\x45\x74\x82\xb0\x90\x72\x4d\xf2\xd8\xb8\xda\x14\xd3\x30\x97\x91\x40\x54\x7e\x89\xa2\x17\x6f\xee\x8c\xa3\xdb\x63\x27\x82\xa3\x7c\xbf\xe1\x68\xc2\xf8\xc3\x66\x74\x0b\x32\x46\x74\x64\x8f\xe0\x18\x8a\xc8\x51\xdd\x0f\xc8\x03\xb2\x18\xd5\xe3\x65\x5f\xe4\xeb\xa3\x7c\x04\xf5\x11\xb2\x01\x3a\x25\x8a\x3e\xc5\x4f\xad\x35\xcf\x39\x83\x61\xdc\xf4\xaf\x4f\xae\xae\x0d\xe3\x16\x7c\x67\x3a\xc4\x1a\x53\x9d\x4f\x60\x18\x9d\x00\x62\xef\x3b\xa0\x69\xf9\x56\xb4\x84\xdc\x65\x60\x01\xa5\x88\x51\xc3\xe8\x70\x94\x8c\x88\x0f\x7d\x5f\xfa\x4b\xeb\x37\x4a\x3a\x09\x9a\x46\xbf\xc7\x28\x96\x49\xa8\x49\x9f\xcb\x62\x14\x97\x28\x5a\x7f\x78\xf0\x1f\x98\x64\xbe\x86\x38\xc4\xcc\x81\x32\x9f\x57\x10\xe3\x4c\x4f\x42\x15\xf3\x09\xcf\x45\xfd\x30\x98\x20\x05\xf1\x55\x2b\x59\xbe\x24\x82\x93\x25\x9a\xa4\x4d\xa5\x04\x71\x11\x15\x50\x14\x46\x16\x7f\xc0\xde\xb8\xef\xc2\x40\xa1\x42\x9f\xd8\x13\x14\x30\x4c\x39\x19\x7f\x94\xbd\x7f\x6a\x7e\x68\xde\xa5\x46\x11\x51\xbe\x8e\x4b\x1b\xca\x01\x8b\x9b\xac\xc6\x3f\xdf\xc7\x34\xdd\x6b\x37\xfb\x3c\x4f\x56\x2c\x7e\x7d\x78\x63\x64\xab\xba\xd3\x36\x14\x1e\xe3\x3b\x90\x0d\x48\xe0\x46\xe8\xe2\x70\x44\xd8\xc2\x4d\x14\x3e\x8c\xd7\x28\xc6\x07\xad\x94\xf8\xdd\x90\x2d\xe7\xce\xc1\x94\x53\x4e\xab\xd7\x75\x05\x2e\xda\xbd\x07\xfa\x6d\xdd\xe8\xa0\x2a\xdb\x05\x66\xa8\xcf\x05\x64\x23\xe5\xb9\x92\xd9\x77\x5a\x1f\xc5\xc1\x0c\x09\x25\x80\x21\x6b\xa4\x40\xc6\x08\x87\x0a\x6d\x6a\xe3\x17\x16\x78\x05\x85\x6e\x7a\x9c\x69\xf8\x15\xaf\x78\xd0\x05\xfe\x04\xba\x80\xd4\x48\xc5\x81\x17\x53\xe1\x04\x9d\x0c\x91\xa7\x90\x77\x7c\x18\x38\x1d\x41\xb6\x13\xb5\xb3\xd4\x5e\xa1\x8d\xe8\x58\xb5\x55\xb1\x85\xad\xee\x34\xbe\x05\x91\x10\x9f\x20\x6b\x04\x19\xf6\xac\x48\x30\x75\x9e\xb1\x00\x5a\x63\xec\x0d\x1b\x11\x50\xc9\x72\x2a\xc4\xfc\x84\x1c\x45\x13\xb1\x14\x31\xcf\x13\xee\x74\x75\xd1\x94\xd2\x23\xa3\x74\x22\x94\x8d\x90\xc3\x5f\x6d\x98\xe9\x53\xa1\x38\x3c\x50\xfd\xe6\x7b\xed\x6f\xb7\xff\xfc\xeb\x1f\xd1\x3f\x7e\x78\xfe\xf2\x8f\x86\x62\x4d\x62\x00\x3a\xb8\xc0\x68\x36\x41\x3f\x93\x30\xf0\x54\x75\x7c\xdf\x3a\x64\xb8\x97\x15\x5d\xe2\x8d\x3e\xc8\x5d\xa8\xa8\xe5\x12\x05\x94\x28\x74\xbc\x86\x2e\x52\x4a\x22\x8e\xcf\xe0\x30\xa2\x10\x5f\x00\x14\xf9\x09\x19\x1c\xea\xf2\x4f\x8d\xc4\x42\xa7\xeb\x89\x1b\x5c\x84\xa7\x3e\x0b\x10\x62\xcd\xa4\x74\x2c\xcd\xdf\x24\xf1\x48\x08\x0e\xa2\x7e\x0f\x45\x7d\xc4\xe5\xa9\x20\x04\x45\xf2\x02\xa7\x98\xc1\x2c\x50\x19\xf5\x89\x2b\x9c\xfd\xa5\x5a\x98\xe6\x9a\xf1\xe2\xfe\x1f\xd8\x9e\x8b\xdc\xc4\xf6\x6b\xe3\xc8\x89\xd7\x57\x5c\xf1\x78\xdc\x3d\xf6\x30\x6b\x48\x2c\xf2\xf9\x55\xb8\x92\xe5\xbd\x54\x3e\xe6\x4d\x1b\x36\xc4\x98\x92\xfb\xbf\xb9\x7b\xf5\xe6\xf6\xd9\x9b\x9b\xbb\xdb\x7f\x7e\xff\xfc\x87\x17\xff\xf1\x47\x8f\x06\x96\x61\xd0\x67\xaf\xf9\x7f\x6e\xbe\x33\x8e\x6e\x75\xdd\x22\x21\x07\xc9\x30\x3a\x33\x68\x07\xc4\x30\x3a\x41\xa8\x8f\x44\x6b\xf6\xff\x34\x8c\xfe\x31\x7f\xf6\xf7\x5a\xbd\x2e\x33\x0c\xfb\xd8\x30\x3a\xfc\x7f\xfe\xb3\xdd\x0b\x16\xb3\x3e\xf9\xd6\xde\x47\x5b\x03\xd1\x94\x9a\xb6\x94\x1a\xaa\x67\x47\x2d\xfe\x75\xa1\xc5\xac\xe4\x89\x11\x17\x8e\x11\x26\x01\xb3\x1c\x6c\x8d\x0b\x3c\xc5\xd1\x7c\xfa\x03\xd5\xa1\xa3\xcb\xca\xea\x71\x5a\x29\x97\xea\xf7\xb7\xc7\xf5\x9f\x7b\x0c\x22\x07\x50\x86\x5e\xe7\x33\x70\x62\x41\xe7\x1d\xb1\x42\xc5\xeb\xbd\x0f\xe1\xc6\x85\x14\x84\x7d\x4e\xdc\x15\x87\xaf\x36\x10\xab\x35\xcc\xb4\x4f\xbc\x61\xe8\xc0\xa0\x72\x19\xf9\x4d\xb2\x32\x94\x89\x14\x32\xc8\xc0\x1b\xc6\x15\x3a\x5f\x1b\x69\x1b\xe4\x98\x76\x96\x17\xda\x2a\x3f\xe6\x52\x1f\x4a\x8c\x45\xf9\x89\x40\x91\x11\x7b\xf6\xb2\x32\x6a\x17\xab\x4a\x6e\x8c\x58\x15\xb6\x32\x8b\xeb\xf6\x4b\x69\x2d\x04\x13\xd1\xc1\x36\x27\xb4\xa7\x51\xd4\x76\x75\xdd\x7e\x59\xa1\x56\x5c\xa2\x80\x25\x57\xa5\xe3\x39\x3a\xf2\x8a\x6e\x30\x71\x09\x53\xf6\xe3\xdc\xdc\x8f\x9d\x74\x8d\xd0\x39\xa0\x54\x8f\x72\xd2\x6e\xff\xf9\xe2\x2f\x7f\xe8\x6c\x84\x5c\xa4\xa7\xa1\x33\x8d\xdf\xec\xab\xd0\x9c\x01\xe2\x81\x2b\x88\x9d\x92\x75\x08\x9f\x90\x6e\x95\x6a\x64\x2e\xb1\xef\x5b\xbd\x6e\xc0\x97\x9d\xf3\xe7\xac\x22\xe5\x6a\xcd\xf8\xfa\x08\x5b\x7a\xfc\x74\xe8\xfb\x0e\xb6\x44\x6d\x4e\x2d\x39\x95\x97\x2f\xfe\xd0\xab\x50\x37\x47\x1d\x0d\x06\x9a\x0f\x03\xe8\x0a\x75\x12\x86\x6c\x24\xba\x10\x60\x36\xbb\x67\x64\x8c\xbc\x6f\xd7\xc3\x5e\x29\x65\x8b\x1f\xdc\x7e\x34\x12\x6b\xf5\xba\x17\xc4\x1b\x06\xc8\x99\x7f\x3e\x7f\x1b\x60\x6b\x3c\xe7\xc0\xb5\x17\xa7\xde\xb3\x9c\xad\xc2\xd9\x56\x99\x4e\x10\x9a\xb3\x95\xd8\x19\x50\x25\x7b\x87\xcf\x40\x3c\x41\xbf\x0b\xdc\xab\x40\x2a\x70\xac\x92\xb3\xb7\x7f\xc2\x4a\x09\x9c\x65\xbb\x7c\xf7\xb6\x59\xb5\xa2\xb3\x93\x30\x70\xd5\xd3\x86\xd8\x90\x8e\x76\xed\x65\x71\x04\x14\x8d\xc4\x6f\x48\x6c\x88\xe9\x0b\xd1\x30\xc4\x0c\x59\x23\x70\x81\x6c\x0c\x17\x53\x13\x4b\x38\x5b\x12\x1d\x3f\xfb\x79\xa5\x94\x8b\x75\xeb\xb7\x7a\x5d\x90\x6d\x99\xbf\xeb\x1c\x0c\x41\x8f\x33\xfa\x7b\x88\xd0\x17\x64\x92\xc7\x62\x84\xb2\x90\x82\x33\xe2\x62\x4f\xd1\x07\x7e\xc3\x1c\x15\x3e\xbf\x16\xcd\xdf\x24\x4e\xe4\xca\x6b\xf8\x12\xbf\x15\x7f\xf2\x80\xca\x07\x38\x81\x55\x2a\xb9\x4c\xa7\x53\xed\xfb\x1f\x3a\xd8\x14\x9e\x15\x3d\x6e\x47\xae\x3b\xfc\x30\xd3\xa8\x1f\x3b\x39\xd5\xb5\x94\xf5\xe9\xd7\x62\x82\xfa\x95\xa2\x00\x7c\xf2\x44\xd6\x20\xf6\x86\x8d\xb8\x23\x48\x08\x29\x95\x9e\x61\x8b\xb8\xcf\x9a\x30\x96\xc6\x4b\x14\xde\xb2\x50\x51\xcb\xad\x80\xf4\x2a\x58\x4c\x0d\xc3\xfc\x10\xc2\xc2\x5e\xd2\x3b\xb0\xba\x87\x85\xbd\x90\x3f\x84\x16\x52\x85\xe1\x2e\x69\x0b\xfb\x12\x13\x76\xe0\x2c\x95\x39\x8b\x23\x4e\xbb\x03\x7d\x68\x8d\x90\x88\x53\x12\x4d\x68\xf2\x89\x03\x0f\xf1\xff\xff\x7f\xff\x3f\x14\xbc\x55\xc5\xbd\xae\xe9\x40\xba\xd4\x7f\x65\x17\xb4\x53\x8a\x83\xf0\x4d\x52\x85\x9c\xbe\xe8\x5c\x75\xc0\x7b\xcf\xe2\x88\x98\x7d\x52\x78\x5b\x72\xaa\x2e\xec\xd1\xde\xbb\xba\xee\x06\xd8\xb3\xa6\x24\x70\xec\x52\xfb\xee\x63\x86\xc4\x3b\xbe\xd6\xd4\x8f\x45\xfe\x79\x61\x18\x9d\x2b\xc3\x58\x5e\xf0\xeb\x41\xd2\x67\x64\xd2\x8a\x2a\xb1\x0a\x43\xa9\x2a\xb7\x80\x9d\x74\xa5\x9d\xb0\xde\xf5\xd7\x21\x7f\xcb\x45\xd7\x62\x99\xcb\x7d\x5d\x1c\x78\x95\x42\x40\x09\x3e\x5c\x92\xae\x7e\x22\xde\x49\x60\x29\x84\x33\xb1\x42\xe1\x4f\xbb\x80\x1e\x1c\xa2\x1a\x5c\x6b\xdf\x68\x1a\x88\x96\x06\x93\x24\x27\xb6\x30\x26\xbe\x76\xad\xc5\x1d\x11\x0f\x06\x56\x07\xe6\xc6\x44\x5c\x7c\x3a\xfb\xad\x7e\xa7\xf7\x9d\x98\xb6\x18\x3f\x50\xf8\x14\xde\x64\x02\xdf\xf8\x47\xaf\x74\x98\x75\x62\xb5\x7a\x5d\x07\x7b\xe3\xb9\x44\x52\x5b\x7c\x12\x59\x0e\xa9\x87\x7d\x9f\xff\x23\x33\x7c\x40\x02\x57\x8c\xc1\xf6\x6b\xe3\x08\x3e\xc0\x47\x29\x3d\xef\xf9\x1f\x32\xe3\xd2\xba\x50\xcb\x03\xc5\x5f\x6a\x48\x68\xe2\x7b\xb9\xb9\x93\x3d\x75\x97\xdd\xda\x07\xdf\xfd\x0a\x44\x7b\xee\xbb\x77\x89\x5d\xac\xd7\xfc\xd7\xe5\xc9\x8f\xe7\xe0\x32\x0a\x6c\xae\xd0\xfa\xa6\x64\x1e\x8e\xfc\xec\xc9\x25\xe4\x6a\x92\x5b\x8b\x85\x2f\x14\x74\xb9\xa9\xeb\x62\x07\xc4\x93\x42\x9d\xe3\x00\x58\xbc\x8c\xc5\x1b\x07\x25\x2e\x25\xd8\xd4\xfe\x5f\x74\x41\x41\xb5\x4b\x0a\xbe\x7a\x47\xc1\xd2\x65\x06\x55\x5c\x04\x4b\xc5\x37\x40\x59\xe7\xa6\x3b\xf3\xe1\x10\x75\x26\x5e\x1e\x4b\x78\x4b\x98\xe9\xa0\x72\x31\x65\x07\x6e\x70\xe0\x06\xab\x90\xe6\xe2\x22\xfa\xd3\x81\x1b\xec\x05\x37\x30\xc5\x45\x2f\xd4\x0e\xa0\x7d\x62\x53\xe1\x6b\xd9\x9f\x74\xb5\x56\xaf\x0b\x6d\xaa\x99\xb3\xb9\x8f\x1f\x91\xd3\x36\x8c\x8e\x0b\x6d\x68\x53\x97\xc3\x19\x85\xbd\xd7\xeb\x1c\xcb\xcc\x5f\x8c\xae\x21\xf2\x98\xc2\x8f\xf3\xd4\xfc\x65\x89\x1a\x99\x7b\x63\x43\x06\xb5\x00\xfd\x1e\xe2\x00\xb9\xc4\x0e\x1d\xf4\xda\x38\x72\xe1\x10\x65\x9f\x16\xca\x0f\x22\xb0\xef\x57\xbf\x89\x5a\xea\x30\xf4\xc8\xf4\x47\xcd\x95\xe3\x34\x11\x35\x59\x50\x66\x30\x9a\xe4\x81\xea\xfc\x8b\x74\x56\x3a\xc6\x9e\x3e\x08\x88\xc7\x90\x67\xf3\x63\xb4\xd1\x00\x86\x0e\x9b\xb7\x90\xc7\x50\xe0\x07\x98\xa2\x76\x3b\x33\x9e\x41\x86\x2d\xfd\x3e\x82\xb7\xb2\xc7\x53\x4e\x73\x78\x99\xec\xe8\x65\xf2\x3c\xff\x00\x0e\x3d\x1d\xca\xf2\xe8\xe8\xce\x15\x73\x1d\xec\x9c\x8e\xb0\xab\x48\xaf\xb9\x80\xc1\x18\x09\xeb\xdb\x49\xc8\x88\x2b\x02\x97\x36\x64\x41\xfc\xa5\x28\x0b\x53\x08\x7e\xe2\x42\xec\x58\x1c\x00\x2d\xcc\x74\xb2\xca\x0e\x13\xb6\x0a\xd7\xd2\x44\x09\x79\x1b\xd9\x1a\x0d\x4d\x8e\x7b\x13\x69\x22\x9d\xf6\x68\xed\x57\x51\xe5\xeb\x92\xdf\xc9\x9e\x61\x4b\xcb\x41\xec\x2c\x0c\xfa\x46\xd3\xc0\x5b\x34\xc4\x1e\x48\xb0\x06\xfa\x78\xe8\x85\x3e\x78\xc7\xd7\x2d\x51\xf7\x81\xbe\x34\x8c\x0e\x74\xe1\x17\xe2\xc1\x29\x8d\xc4\x8d\x4d\xa6\x9e\x43\xa0\x4d\x85\x30\x8a\xd0\x11\xfd\x8d\xf3\x41\x4b\x9b\x40\x07\xdb\x90\x21\x19\xba\x91\x40\x64\xd9\x9e\x86\xf9\x21\xaf\xfb\x52\x6c\x66\x5d\x6d\x8f\xa7\xcb\xb3\x68\xa1\x62\xba\x1a\xa3\xfe\x88\xf8\xbf\x90\x00\x29\xc4\xff\x6e\x3b\x84\xaa\xee\x90\x78\xf5\xc0\x31\xa2\x23\xc2\x37\xdc\x19\xe7\xf6\xb4\xbb\x80\xae\xa9\x10\xdf\xbb\xa8\x55\x2b\xc1\xda\xfc\xb0\x5d\x93\x68\x03\x12\x7a\xb6\xb8\xf4\x45\xde\xe0\x0b\xe8\x31\x4c\xdf\x5e\x17\xe3\xe0\x3d\xa5\x21\x02\xd7\x01\xb4\xc6\x4a\x17\x46\x7e\x68\xba\x3b\x94\xb1\xd9\xd0\x61\xaf\x8d\xa3\xac\x35\x55\x00\x01\xde\x86\x43\x26\x97\x68\x3a\xac\xf5\xa0\x0f\x6c\x43\x1f\x50\x90\xa9\xbc\xa5\xfc\xe4\x4d\xa6\x20\xd1\xd9\x29\xf1\x18\xb4\x98\x22\x6f\xa2\x91\x22\x10\x86\x91\x94\xfc\xd1\x1f\xf8\x7f\x5c\xe8\xcd\xac\x08\x1e\x19\xe0\xde\x50\x74\x5e\x76\xa1\x62\xbe\x1d\x60\x98\x04\x82\xee\x65\x48\x87\x5a\xeb\x09\x30\x4c\xa3\x39\xf3\x76\x49\x3c\xc4\x18\xaa\x52\xfd\xa2\xf6\xf2\x46\xd1\x12\x26\xb4\xc6\x26\xf1\x90\x10\xe2\x09\x60\x1b\xc5\x69\xca\x19\xd7\x70\xa9\x5f\x3d\x0e\xa2\x45\x02\x94\xd5\x20\xca\x66\x80\x2c\x45\xa4\x2e\x5f\xdf\x26\x52\x40\xc2\x14\x60\x89\x09\x91\x02\xd2\x9b\xa0\xe0\xf5\xda\x66\x1d\x4d\x32\xa3\x14\x96\x87\xd2\x11\xd5\x6f\xa3\x43\xdd\x04\xdd\x5b\xb3\x14\x66\x96\x8f\x89\x70\x81\xee\x36\x46\x6c\x3a\x71\x8e\x11\x31\x8f\x1e\x8b\x91\xb4\x8c\xa8\x35\x74\x09\x2a\x58\x13\x9f\x6c\xf1\x5b\x82\xac\x2a\xcd\xae\x9b\xbd\x69\x3b\x61\x1e\x2d\x83\xb2\x06\xc3\xae\xae\xc7\xb4\x52\x31\x3b\x2a\x65\x6e\xc5\x85\x78\xc4\x63\x53\x51\x85\xa7\xf9\x70\x79\x0e\xee\x18\xd9\x0d\x56\xad\x8a\x70\x32\x46\x76\xe7\xa1\xb0\x8a\xb0\x7c\x7d\x2b\xde\x16\x35\x3c\xd1\x15\x18\x71\x43\xcf\x1a\x8d\x85\x8a\xe2\x4a\x88\xa2\x92\x06\x99\x3f\x34\x52\xd3\x20\x5a\xad\x98\x66\x18\x0a\x30\x74\xc0\x19\xa2\x78\xe8\x81\x0f\x7c\x9a\x62\x05\xef\x29\x05\x0b\xa3\x39\xd2\x0c\x3b\x90\xad\xb2\xf9\xcc\x8d\xa0\x91\xc4\x73\x63\x18\xd3\xdb\x63\x4d\xfe\xcf\x72\x2e\x63\x89\xd2\x9b\x4b\x0d\xaa\xf4\xc5\xd9\x1b\x23\xcd\x21\x62\xae\xed\x14\xb4\xbd\x8c\x71\x8e\xbf\x20\x70\xda\x57\x38\xfc\xb6\x81\x6e\xfc\x05\x55\x46\x6e\xee\xb7\xf5\xe9\x5e\x0f\x7f\x0f\x51\x30\xfb\x1a\x94\xae\x87\xdf\x39\xa4\x37\x1d\xed\x36\xa2\xb7\x67\x86\x61\xb7\x6f\xee\xf4\xdb\x67\x8b\xa6\x27\x31\x3a\x25\x4a\x5d\x7e\xb8\x82\xc0\xe7\xab\x93\x2f\x4b\xc6\x56\xaf\x4b\x31\xf1\xda\xbd\x2d\xab\x75\x9c\xc2\x23\x88\xaa\x84\xde\xa5\xd4\x62\x51\x85\x10\x63\xa3\x9f\x61\xf9\x7a\xbf\x8b\x49\xd6\x75\xb1\xec\xf4\xd1\xcf\x41\x1f\x3d\xc0\xc7\xe6\x78\xb4\x98\xbe\x58\x8c\x31\xe2\x2a\xa4\xd8\xa6\xf5\x2a\x14\x78\xf0\xf1\x14\x8f\xc5\xd6\xe7\xf1\x3f\xfd\x91\x5f\x80\x06\x00\x8e\xa0\xef\x3b\x48\xc3\x2c\xf4\x10\xd5\xa0\xef\xf3\x2d\x40\xdf\xd7\xb0\xfd\xfa\xdf\x5f\xfe\xfb\x8b\x1f\xfe\xed\x3f\xfe\xe3\xdf\x53\x5b\xec\x82\xcd\xad\xd5\xeb\xca\xcd\xce\x2f\xf9\x6a\x6d\xa0\x81\x4f\x3e\xf2\x40\x5f\xb4\x3f\x07\x9c\x17\xae\x09\x79\x1f\x12\x32\x74\x90\xe6\x3b\x70\xb6\xb4\x1e\x09\x86\x1c\xe2\x08\x72\x97\x98\xd8\x41\x2f\x36\xb6\xe9\x89\x79\x14\x07\xc5\x50\xe0\x12\xaa\x6a\x60\x81\x28\x85\x43\x04\xde\x12\x18\xd8\x9b\xf2\x74\x8f\xc8\xe3\x7a\x03\xae\x09\x08\x29\x02\xe9\xea\xcf\x81\xef\x20\x48\x11\x40\x1e\x34\x1d\x04\xd2\xeb\x62\x18\x1d\xf0\x4a\x4f\x3e\x6d\xda\xc6\xf7\x23\xf9\x33\xa7\x8a\x08\x65\x80\xac\x94\xf3\x2f\x78\xfc\x5e\x21\x68\xb1\x3d\x2a\xe1\x11\x79\xae\x39\x50\x15\xa4\xa1\xf8\xa0\xd5\xeb\x6a\x53\xcc\x46\x1a\xb4\x6d\xe2\xd1\x76\x6f\x03\xe9\x18\x4f\x54\x20\x1c\xc5\x90\x7a\xde\xde\x65\x8f\x54\x2c\x59\xf1\x09\x07\x4d\x12\x32\xce\xeb\xa3\x1b\x5a\x2c\x01\x43\x86\x15\xf1\xe7\xcd\x3f\x5a\x6e\xee\xa0\xf6\xe5\xd6\x65\x56\xfd\x89\xcf\xa9\xe8\xe3\x1b\x2d\x2c\xdc\x7c\x01\x1f\x4f\xcf\x54\x8d\x0c\xcf\x3e\x56\x48\xe8\xb8\xfb\x88\xd8\xd9\xc7\x93\x54\x7a\xfc\xa6\x9d\x9e\x7d\xd4\xde\x91\x60\x0a\x03\x5b\x8c\x70\xe1\xa3\x65\x7b\x3b\xad\x37\x2b\xd1\xc3\xc1\x28\xa6\x94\xc7\x3e\x66\x25\xa3\x06\xeb\xa1\x8e\x42\xc9\xbf\xe4\x3f\x4b\x81\xab\x2e\x39\x0e\xde\xa1\xda\x79\xd7\x06\x71\xec\xf0\x51\x7b\xf9\xfd\xf7\xdf\x17\xd2\x20\x82\x1e\x7f\xeb\x96\xd5\xd7\x2b\xb5\x28\x8a\x31\xce\x69\x28\x5a\xcb\x30\x92\x82\x4d\x5b\xcf\xbf\x3a\x14\xfb\x58\x2a\xf6\x51\xa0\xca\x9c\x3f\xfa\x41\xda\xd4\x4c\x81\xac\x25\x23\xc7\xf3\xe5\xbf\xad\xf4\x31\x2f\x71\xad\x57\xee\x6b\x04\x51\x4e\x65\x9f\x7d\x89\x12\xfe\x48\xec\xea\xa6\xfd\x1a\x23\x85\xbf\xee\x68\x5e\x8f\xd8\x4f\xb0\xcf\x23\x49\x21\xa9\x95\x1d\x28\x88\xfc\x50\x74\xaf\x86\xa2\x7b\x2a\x21\x14\x09\x98\x5c\xf9\x63\x63\x78\xee\x88\x14\x4c\xa5\x53\xfa\x1f\xd8\x46\x04\x5c\x3a\x70\x56\x3d\x84\xa6\x1e\xe1\xa2\xd8\xac\x60\xb4\x7c\x47\x48\xee\x48\xe1\xf0\xe1\x23\xaf\x89\x6b\xaa\xcc\x0d\x9b\xd5\xfd\x49\xa6\x5f\x32\xa4\xef\x52\x31\x16\xc8\x61\xc4\x35\x3b\x96\x55\x88\x96\xcf\x78\x8c\x55\x91\x2a\x63\xbc\xa9\xf1\xc5\x24\xf6\x4c\xdc\x37\x51\x06\xf7\xb5\x71\x24\xe0\x9a\xe2\x31\x5e\x88\x7c\x6c\xf5\xba\x70\x8e\xdd\x61\x7b\x35\x79\x35\x86\x71\x29\x83\x55\xa6\xb9\xea\x7d\x1f\x59\x18\x3a\xdd\xcf\x23\xc8\x3e\x60\x6f\x4c\x7f\x42\x51\xc5\x88\x27\x6a\xe5\x77\xc9\xc2\xa0\xb7\x26\x09\xe8\xa0\x9b\x27\x7f\xdd\x67\xdd\x9c\x66\x59\x05\xa7\xba\x42\xab\x24\xb2\x95\x1d\x49\x4a\x94\xb0\x5f\x9b\xb7\x2d\xa6\xae\xfa\x52\x4e\x10\xc8\x3f\xde\xa8\x6f\x5b\x2e\x69\xae\x6a\x4f\x7f\x3a\xab\xe3\x5a\x2d\x48\x1d\x63\x20\x0e\xa3\x58\xd8\x20\x55\x98\xee\x66\xb1\x7f\xd1\xc7\xad\x5e\x37\xaa\x9c\x0c\x64\x28\x39\x5f\x4f\x93\xbf\x31\x8e\xe6\x62\x79\xc3\x68\x7d\x67\x13\xf7\x0a\x41\x7b\xf6\x9d\x61\xb4\xdb\x35\x19\xb0\x4b\x49\x65\x64\x16\x7b\xd2\x91\x83\x29\x38\xbd\xe8\x83\x7f\xbc\xa8\x3f\x87\x33\x57\x12\x7d\xa3\x69\xe0\x0a\x79\xb6\x10\x2c\x53\xcc\x46\x60\x01\x90\x8d\x24\x86\x1f\xdd\x72\x33\xbe\xe5\xd1\x84\x1b\x7b\x4e\x24\x02\x1d\x4c\x19\xb2\x46\x1e\x71\xc8\x70\x56\xd8\x65\xe1\x02\x05\x2e\xc4\xe5\x43\x59\x36\x74\x02\xc6\x38\xb4\xf1\x44\xe6\x30\x48\x69\x7e\x63\x1c\x7d\x77\xeb\x4a\x10\xc4\xbf\x95\xe9\x09\x7a\x34\xba\xe9\xa0\x17\xb9\xca\x03\xed\x0c\x31\x1b\x85\x66\x07\x93\x02\x14\x32\x44\x14\x25\x71\xea\x88\xd1\x8c\x71\x98\xf4\x16\xbf\xbf\x77\xc5\xd2\x9a\x45\xe9\xfd\x7d\xd3\x1e\xa6\x83\x4d\xa6\x82\x4d\xe6\x20\x1d\x37\x94\x8e\x92\x7f\x71\xaa\x2e\x96\x00\x6c\x44\xec\x46\xbb\x6b\x47\x4b\x80\x30\xc4\x76\x17\x18\x47\x37\x50\x1b\x18\x86\x7d\x7b\x6c\x1c\x81\xde\x66\xb5\x82\x00\x38\x42\xc4\xf5\x45\x7f\x7c\x0d\x0b\x0f\x88\x61\xd8\xc7\xa9\x84\xca\xfc\xd5\xa3\x0c\x7a\x16\x2a\x1e\xe5\x40\xca\x7e\xf5\x6d\x28\x50\xe8\x85\x8e\xb3\x6e\x0c\x91\x73\x44\x1a\xfa\xba\x69\xf8\x16\x8f\x64\xbf\x7e\xb9\xc5\xcd\xa4\x4f\x7c\x7c\x08\xdb\x24\x4d\x26\xce\x67\x9b\xd8\x0a\x08\x25\x03\x06\x4e\xfa\x97\x9d\x8f\xe7\x8a\xa4\x9c\xca\x4d\xf7\x4f\xa8\xff\x11\xad\xb6\xdd\x7f\x9e\x8e\x58\x52\xb1\x4f\xfa\x97\x86\x21\x00\x29\xa6\x8e\xb4\x46\x51\x94\xc1\x77\x7f\xff\x8f\xf7\xe7\x9f\xfb\xd7\x27\xd7\xe7\x4d\x33\xe1\xf7\xef\x4b\x16\x23\xdb\xd4\x96\x9b\x71\xdf\xb5\x7a\xdd\xe4\x8c\xb4\x76\xef\xfd\xfb\xfe\x5a\xeb\xc4\x32\x9f\xd8\x17\xf3\xee\x67\xec\xd9\x64\x4a\x17\x8b\x38\xab\x31\x27\xfe\x5a\x50\xca\x0d\x54\xb0\xf0\x2e\xe0\xf3\x33\xf6\x5e\xbe\x98\x7f\xc6\xde\xbf\xfd\x65\x71\xb6\xaf\xdb\x0e\xec\xc6\x24\x22\x2e\xfb\x54\x22\x3d\x5b\xbb\x2f\x5a\xb1\x82\xac\xc5\x98\x56\x7e\x9f\x43\x9a\xbc\xe9\x8b\x99\xcd\xf9\xa3\x85\x14\x5d\xe6\xce\x6d\xcc\xc8\xa6\x89\x7f\xfc\x71\xc5\x7f\x27\xd5\xdc\x47\xd7\xf1\x68\x77\xfa\xda\x38\x0a\x03\xaf\x4b\xad\x11\x72\x21\xd5\x12\x9c\x69\x16\x71\xbb\x64\x30\xc0\x16\xea\x22\x0e\x98\x71\x34\xe7\xd2\xc7\x30\xe8\xb3\x56\xaf\x2b\x9a\xb8\xcf\xcf\x3f\x9e\xb5\xc1\xa7\x77\xe0\xd3\xaf\xd7\x97\xbf\x5e\x83\x77\x57\x9f\x2e\xc0\xf9\x6f\xa7\xe7\x1f\xc0\xe5\xaf\x6f\x3f\xbc\xef\xff\x04\x4e\xfa\xe0\xf3\xf9\x5b\x20\x4a\x5d\x7d\x7e\xff\x7f\x9e\x5c\x9d\xf1\x09\x34\xed\xcd\x3c\x55\xb8\x1f\xbb\x7e\x68\x3a\x98\x8e\xa8\x88\xf0\x7a\x6d\x1c\xf5\xce\xe5\x8a\xbd\x4d\xde\x76\x91\xda\xf2\xde\x8e\x1c\x3e\x7c\x26\xa3\x93\x13\x6c\xb6\x84\xfd\x16\x28\x51\x77\x4d\x75\xe8\x12\x6b\x9d\x45\xf2\x13\x38\x54\xd3\xc0\x4f\xd7\xd7\x97\x27\x97\xef\x1b\xa9\x62\x99\x1c\x6d\xb4\xc8\x3e\x59\x74\xb3\xb8\x52\x63\x49\x88\xc7\x4b\x82\x55\xbd\x4d\x77\x73\x5d\xc4\xcb\xd9\xe7\xd0\xf1\x3b\xc3\xdf\x44\x20\x40\x0e\x27\xeb\xcb\x00\x51\xe4\x31\xd9\xba\xe4\xb7\x8b\x0f\xc6\x51\x2f\x0e\xc6\xee\x89\x68\xec\x63\xc3\xe8\x3c\xba\x9c\xf2\xdf\xcc\x5f\x91\x6e\x76\xfc\x3b\x12\xb8\x90\xbd\xb9\xb9\x7b\x75\x7b\xfc\x4a\xcf\xf9\xdb\x37\xb7\xc7\xaf\x48\xb7\xef\x60\x1b\xd1\x37\x5c\x37\xe3\x23\xa3\x1f\x5b\xbd\x6e\x3c\x20\xd2\x3c\xde\x24\xa7\xcf\x87\xc5\xbf\x6c\xd7\x70\xeb\xd2\x03\x2a\x73\xf5\xd2\xd1\xa0\xb9\x0b\x98\x9e\x4a\x09\xfa\x92\xfc\x48\x55\x4d\x76\x47\xe4\x15\x03\x17\x71\xe4\x1b\x3c\x00\x7e\x68\xde\xbe\x79\xf5\xe8\x3a\x6f\x6a\x38\xbb\x64\xfe\x32\x47\x17\x0f\x6e\xf0\xe0\x92\xc3\x50\x9e\x5b\x7f\x04\x03\x54\x82\x2f\x94\xa9\x4f\x9a\xcc\x9a\x4e\x7a\x8d\xa0\xcb\xf9\x29\xb6\xc4\x74\x71\x05\xb5\x04\x47\xfd\xcb\x2b\xf4\x7b\x88\x28\xfb\x51\x3e\x5b\x16\xde\x3d\xe9\x34\x3f\x21\xe8\xb0\x51\xdf\x22\xc1\xca\xe3\xe8\x37\xad\x68\x58\x5d\x0c\x3a\xff\x38\x33\x08\xdc\xf8\x10\x29\x9f\x43\x5c\xb4\x4e\x45\xb6\xfe\x99\x04\x0a\x73\xdf\x6e\x6e\xdc\x94\x04\x36\xbf\x6c\xd3\x2e\x87\x30\x2e\xfb\x9b\x55\x61\x62\x0f\x60\x8f\x0f\xe8\x23\x8b\x33\xe5\x1f\x6e\x8c\x23\xf0\xe6\x76\xfe\x4a\xb4\x09\x13\xc3\xde\x88\xff\xfe\x2f\x1f\x0e\x11\xc8\x8e\x7c\xfa\xa5\xe5\xb3\x95\xb9\xaf\x7c\x5c\x73\x57\x95\x23\x2a\xff\x98\x11\xa3\x23\xa2\xa8\x95\xf3\x54\x4b\xf8\x42\x07\x4b\x41\x8d\xd4\x11\xd6\x58\xb9\xb8\x61\x74\xec\x1a\x1c\xa8\xc9\x5e\x36\xd5\x77\x84\x2d\x29\x9a\xa4\x63\xe7\xb7\x01\xb8\xc0\x8e\x83\x87\x01\x54\x78\xee\x6a\xcd\x0f\x3b\xee\x65\xf3\xc3\x8e\xdd\x18\x84\xf2\xd9\x61\xf5\xa9\x86\xc2\xe0\x1d\x03\x90\x1a\xbc\xf3\xd1\xe5\xa1\x80\x74\x2c\x45\xe0\xf4\x69\x30\xf3\x19\x71\xf9\xe0\x7a\xe3\xa5\x39\xb0\x1c\x02\x8e\x1f\x4b\x77\xb0\x19\xfd\xdc\xea\x75\x35\xf1\xaf\xb9\x36\xc2\xb6\x8d\x24\x0a\x9b\x29\x58\xe1\xc6\x38\x28\x20\x2a\x0f\xbf\x55\x54\xa9\xa8\x25\x3b\x05\x82\x25\x4a\xf2\xb0\x69\x46\x0a\x2c\x94\x9a\xac\x61\x78\x5c\x87\x39\xf7\x6c\x40\x06\xc0\x22\xfe\x2c\xc0\xc3\x11\x03\x9c\x18\xb7\xe8\xd7\x13\x90\x15\x0b\x29\x0f\x73\xd1\xdf\xcc\xc3\x4c\xce\x6d\x18\x51\xb8\x82\x6c\xa5\xb5\xd3\x07\x99\x7c\x8a\x0f\x48\x30\x44\x22\x4b\xd9\x0f\xc8\x03\xb2\x18\xe5\xe4\x85\x29\x47\x44\x3e\xa2\x1a\xea\xaa\xac\xb8\x7b\x2e\xf6\x98\x6e\x18\xbd\xda\x1d\x6e\x23\x38\x41\x90\xcf\x5e\x4c\x1f\x6c\x14\x60\x85\xf5\x66\x0b\xe5\x50\x5c\x09\x47\x23\x88\x88\xe6\x56\xe5\xdb\xe3\x47\x1f\x7a\x2a\x43\x56\x43\x44\x00\x7d\x2c\x18\xab\x04\x21\x2a\xe6\x26\x6a\x59\xd5\x8e\x0b\xb9\x44\x21\x4d\x8c\xcf\x88\xa5\x08\x46\x8c\x95\x49\xf1\x8c\x07\xd7\x84\x38\xcd\x87\x24\xae\x84\x83\xb9\x63\x9b\x58\x54\x4b\xac\x01\x4f\x53\x6b\xc4\x64\xc5\x09\x3d\xc4\xc4\x83\x99\x42\x00\x89\x3c\xcd\xc6\xee\x89\xae\xcb\x3e\x69\xae\x00\xa5\xfe\xd2\xb5\x89\xc3\x50\xcc\x5f\x48\x25\xc4\xc4\x01\xa6\x0a\x8f\xe1\x07\xe8\xd9\xd8\x1b\x82\x4b\x21\x94\x43\xec\xd8\x9b\xd7\x7f\xfb\x46\xd3\x44\x8f\x1f\xe0\x42\x1b\x45\xe1\x22\x11\x10\xa2\xbb\x0d\xff\x53\xb4\x04\x98\x2c\x11\xc5\x13\xe3\x0e\xe3\x65\x96\xa7\xdd\xe8\x0a\x46\x93\x15\x23\xd7\x1a\xe1\x5f\xb0\x42\x22\x35\x5f\x0f\x25\x86\xa3\xe9\xd8\x10\xbe\x4c\x26\x2e\x44\xfc\x3c\xc6\xac\xe0\x26\x5a\x23\xfc\x19\x29\x62\x87\x37\x54\x6b\xa2\xb9\xf7\xc5\xcc\x2c\x32\x71\x25\x6a\xf8\x65\x17\xb8\x91\xff\x9d\x22\x33\x1f\x41\x36\x0a\x3c\xfc\xa5\x7c\x10\x4d\x43\x14\x94\x2d\x46\x10\xc3\xd4\xea\x75\x3b\x19\xcc\x36\x93\xa4\x99\xac\x56\x7c\xcf\x6c\x3c\xc0\xaa\xba\x43\xdb\x2b\x56\xba\xcc\x79\x0c\xa3\x65\x19\x46\x1b\x98\x33\xe0\x46\xa0\x02\x74\x1a\x41\x03\xfa\x23\xe2\xc7\xad\x3e\x81\x26\xfe\x0f\xa4\x12\x4d\x48\x09\xf9\x8d\x16\xd9\x10\x92\xb0\x91\x4d\x65\x64\x76\x3e\x85\xa8\x1c\x86\x0c\x76\x1a\x69\x09\xa1\xb6\x04\x88\xe7\x9b\x88\x7e\x8b\x5e\x70\x43\xad\xd5\xeb\x5a\x24\x40\x73\xdf\x09\x87\xd8\xa3\x73\x86\x5c\xdf\x81\x0c\xd1\x76\x7e\x64\x7a\x34\x6f\x95\xaf\x0f\x25\x45\xf7\x2b\x5e\xaa\x6a\x60\xba\x2b\xe9\x36\x08\xf3\x09\x1b\x7b\xfc\xff\xeb\x4f\xcf\x50\x51\x21\xc1\x5e\xab\xd7\xbd\x97\x35\xee\x5b\x86\x61\xb7\xd3\xff\xcc\x3b\xc7\x6d\x9d\xdf\x1e\xe2\xe9\x0f\x34\xfa\x57\xad\xf5\x8c\x2e\x67\x6c\x44\xbc\xed\x53\x68\xab\xd7\xbd\x9b\x1b\x06\x6d\x4b\x00\xf6\x29\x5e\xa6\x2c\x09\x0a\xc0\xab\x05\xb4\x13\xec\xb9\xa4\x83\x73\x3b\xee\x5c\x90\x07\xe2\x60\x0b\x93\x50\x55\xd1\xb6\x5a\x8c\x17\x4d\x63\x95\xdc\x95\x25\x00\x38\x7a\xd4\x16\xc3\xab\xb3\x83\x9a\xd4\x8f\xf2\xc9\x12\x05\x25\xbb\xf1\xd4\x49\x94\x86\x61\xf2\x85\x0d\x83\x2b\x89\xa0\xa7\xf7\x26\xbd\xaf\x8d\x28\x51\xe0\x94\x26\x49\x41\x91\xd1\x41\x77\xe4\x49\xaf\x27\x4a\xc7\x21\xaa\x86\x70\xd0\x67\xd6\x08\x3e\xb5\x04\xb8\xd0\x6d\xf8\x6a\xeb\x32\x70\xd6\xf1\x4e\x3e\xb6\xc1\x47\x8c\xc0\x10\x5f\xa3\x58\xd9\x14\xad\x36\xaf\xb1\x8b\xbe\x10\x55\x07\xed\xe6\x35\x73\x57\x80\xa3\xb1\x08\x9c\x56\xaf\xab\xd9\x90\xc1\xe5\x5a\x85\xf5\x89\x10\xb9\xff\xaf\xa4\x14\xb1\xc4\xce\x7a\x54\x2c\xcf\x50\x7b\xa2\xb1\x5c\x7c\x29\x95\x5b\x49\x7f\xe9\x37\x7a\x7c\xa8\x05\xfa\x79\xa9\x9c\xe3\x6d\x51\x61\xe3\xf6\x85\x12\xf9\xc8\xc4\xb3\x09\x28\xd1\xb9\x6a\x77\xaf\x41\x09\x62\xff\x29\x0e\x5c\x97\x4f\xa1\xc9\x50\x72\x1b\x15\xe0\x02\x05\x91\xa3\xb1\x98\x9d\x6f\xec\x91\x8c\x26\x88\xb2\xe6\x04\x53\xa7\x81\xf5\xfa\xc6\x30\xbe\x33\x8e\x6e\x93\xd6\x5d\x6e\x0a\x89\x61\x74\x02\x62\x8d\xa9\x1e\xff\xe4\x8f\x7c\xc3\xe8\xf1\x0d\x62\xfb\xf5\xb6\x9c\xba\x29\x3c\x11\x34\x05\x38\xcc\x24\xf7\x7c\x75\x45\xf5\xd7\x27\xe9\xe4\x6d\x34\x50\xf9\x4d\x36\x35\xc5\xc9\xa9\x9b\x44\x82\x5c\x74\x8d\xb8\xba\x0a\xcd\x92\xf5\x5b\x6b\x7e\xef\x44\xbb\x9e\x7f\x3e\x7f\x1b\x60\x6b\x3c\xe7\x80\xb4\xbf\x06\xa5\x32\x08\xcd\x99\xb6\x52\x32\x50\xcd\x91\xf8\x6e\x5f\x28\x48\x6c\x8c\x66\x22\xc8\x78\x31\xf9\xa0\x6e\x6a\x1b\xa3\xd9\xaa\x85\x63\x47\xa1\xc5\x02\x1a\x2d\x72\x60\x2b\x24\x97\x2a\xb7\xb9\x6a\xa6\xcf\xa2\xe1\x47\x2c\xb0\x5b\x54\x90\xb2\x88\x10\xd6\x9e\x62\x99\x55\x5b\x3d\xb9\xf8\x1a\x11\x8f\x44\x7d\x87\x1a\x53\x9d\xb3\x55\x74\xf3\xf7\x26\xfe\xb2\xe4\xa2\x5e\xbe\xb6\x4d\x28\xcb\x45\x95\x76\x97\x67\x58\x93\x3d\x53\xad\xea\x2e\x58\xd1\x8b\x36\xaa\xbc\x2b\xa7\xd9\xa4\xfa\xee\x32\xc6\x36\x79\x7e\x67\xab\xf1\x82\xd2\x59\x9c\x11\x7d\xeb\xa8\xc0\x30\x44\xa4\x33\xbe\xac\x5a\xdf\x58\x40\x07\x21\x8c\x03\xd2\x94\x73\x27\x9a\xbd\x28\x41\x89\x10\xdb\x51\xb9\xa7\x37\x4e\x68\x8d\x2d\x11\xae\x58\xc5\x21\x43\xb2\x99\xa3\x79\x8c\x66\x53\x12\xd8\x34\xb2\xb9\x09\x90\xab\xf3\x87\x83\xf3\xa0\x09\x7d\xa6\x6a\xc5\x49\x71\x7a\xc5\x7a\x0c\x51\x38\x0e\xb6\x70\x33\x39\x9c\xde\xb2\xa0\xfa\xb6\xe6\x0b\x3a\x36\x03\x3a\x5a\xf0\xe8\x13\xaf\xe0\xa9\xc4\x48\x03\x65\x60\xa3\x0f\x65\x26\x93\x88\x60\x4f\xc2\x3a\x45\x97\x4c\xdd\x65\x9a\x25\x7a\x0b\x33\xc3\xc8\x6f\x9d\xb9\x0e\x83\x4c\xc3\x9e\xe5\x84\x36\xa2\xfa\x03\xd5\xa3\xfd\xb7\x7a\x5d\xd9\xe8\x4d\xe0\xb4\xd5\xeb\xd6\xaf\x07\x1c\xee\xf9\x36\xee\xf9\xf3\xfc\x03\x88\x8a\x26\xfe\x5c\x32\x67\xbc\xe9\xb2\x89\xad\x5e\xd7\xc6\x93\x39\xff\x9b\x2c\xd3\xe6\x0d\xb9\x1e\xf3\x7a\xf1\x8c\x5e\xc9\xdf\xae\x5f\x77\xbd\x46\x17\x95\x3a\xac\x5c\xf7\xbc\xd5\xeb\x6a\xbd\xc0\xba\xe9\x18\x86\x7d\xfb\xac\xfd\xac\x1d\x17\x4d\x2c\xae\x84\x1e\x57\x56\xdc\x6a\x2d\xf4\x68\xd1\xf2\xed\xdd\x0e\x3d\x5d\x32\x93\x7f\xe5\x3d\x5d\x08\x23\x96\xab\x30\x08\x87\x14\x81\x77\x0e\x99\xee\x24\x24\x38\x8e\xf3\x0c\x29\x1a\x38\x64\xba\x51\xa9\x39\x95\x94\x4e\x66\x2f\x2e\x2b\x42\x26\xa2\x13\xc7\xf5\xcc\xaf\xbd\x3e\x4c\x1e\x12\xf2\xf7\xb9\x6a\x14\xcf\x40\xb7\x11\x26\x04\x22\xc4\x24\x6c\xe6\x2b\xf4\xb7\x2f\x30\xb0\x41\x3f\x54\x36\x1a\xdb\x12\x26\x60\xc8\x46\x31\x1a\x38\x68\x4f\x40\x00\xff\xbc\xe3\xe5\xa7\xfa\x87\x01\x2c\x57\xab\x3f\xbe\xaa\x65\xea\x32\x36\x41\x0f\x11\xa0\x40\xb0\xa1\xc2\x48\x5d\xf9\xf9\x3a\x29\x6f\x13\x13\x81\x53\xe2\xd8\xef\x42\x51\xea\xa6\x14\xcb\x5f\x67\x65\x5b\x66\x5e\x39\xca\xd6\x29\x21\x63\x2c\x96\x3e\x7d\x77\xfd\xe9\x97\xf3\x8f\xaf\x15\x7a\x53\xe6\x5b\x19\x17\x7d\x7d\x72\x75\x0d\xe4\xec\xd7\x70\x28\xba\xcc\x0f\xdc\x2a\x12\x5f\xb7\x06\xf0\x01\x3e\xea\x4f\x64\xd9\xe9\x8c\xd9\x0d\x66\x70\x7b\xfa\xee\xe2\xc3\xa1\x40\x38\x55\xcf\xb6\x7a\x49\x21\x27\x4b\x99\x5b\x1e\x10\x3b\xb4\x18\xd5\x2d\xe2\xd8\x03\x41\xa3\xda\x00\xba\xd8\x99\x75\xc4\x96\x32\x33\x95\x2d\x13\x53\x79\xf2\x32\xed\xb9\x87\x88\xb9\x61\x00\x0b\xc5\x6c\x48\x19\xb4\x46\xe5\xc3\x21\x1a\xb3\x58\x45\x80\x34\xed\x8b\x8e\x96\x29\x91\x15\x3a\xdb\x46\xb6\x63\xab\xd7\x7d\x25\x91\x01\xa2\xd0\x1a\x7a\x1c\x15\xcc\x39\x76\xa0\x37\x34\x8c\x8e\x47\xee\x3d\x34\xbd\xf7\x09\x65\x74\x2e\xab\x3c\x3f\x03\x0c\x33\x07\x65\xda\xf0\xbf\x9d\x01\x0e\x70\x5d\x55\x4d\x25\x74\x87\x27\xf0\xfe\x3d\x81\x2f\x66\xfd\xbf\x7f\xf8\x13\xd6\xac\x74\x67\xf4\x77\xa7\xf4\x1b\x43\x5c\xf5\x99\x22\x39\x77\xc6\x55\xa4\x0f\x64\xd8\x44\x65\x62\x05\xb7\xf3\x43\x93\x73\xb9\x99\xe9\x90\xa1\x43\x86\xb5\x3f\x2f\x62\x97\x45\xbc\x40\x31\x1e\x52\x8a\xfa\x1a\x03\x25\x16\x28\x63\xfd\x06\x3d\xc4\x2c\xa8\xa8\x21\xb1\x93\xd7\x53\x0c\xd9\x53\x0e\x59\x4e\xa1\x59\x01\x12\xe9\xa0\x9d\x41\x6e\xc9\x9b\x8f\xe7\x9f\x80\x06\x3e\xb9\x1e\xb6\x46\xd0\xf3\x90\x03\x92\x64\x96\x4b\x07\xb2\x01\x09\x14\xf1\xa3\x25\x83\xaf\xd2\x3a\xd0\xc2\x6e\x83\x98\xe6\xa1\x9d\xba\xb0\x39\x9a\x1e\x10\x43\x31\xf8\x9c\x5a\x3a\x66\x90\xfb\xe6\xfe\xf8\x8f\xb3\x97\xdb\xaa\x20\xbd\xd4\x60\x59\xda\x11\x27\x86\xd1\xb1\x5f\x56\xee\xac\xbc\xf6\xbb\xfa\xec\xdf\x09\x52\xf2\x11\x53\x8c\x1c\xb0\x05\xe3\x9b\x1e\xef\x1f\x4c\x0c\xc3\x5e\x69\xfe\xbd\x15\xfb\x66\x57\xd7\xed\x97\x15\x0a\x05\x77\x75\xdd\x9b\xd8\x2f\x8b\x8c\x1f\x1f\xe1\x04\x0d\x15\xa2\xaa\x21\x5b\x18\x83\x42\x0d\x85\x13\xdb\xf5\x1b\x31\x84\x09\x2e\x26\xf6\x57\x68\x09\xfb\x88\x08\xad\xa1\x61\xa1\xd0\x19\xdf\x39\x64\x1a\x2b\x8e\xf1\xdc\xba\x68\x6b\xd2\x6b\xb6\x7b\xa3\x84\x60\xcd\xe5\x12\xbb\xcb\xd8\x3b\xf3\xb7\x28\xfe\xf2\x04\x73\xc7\xea\xee\xf9\xcf\x8b\xbb\x5f\xbe\x01\xfb\x52\x04\x77\xf1\xfd\x51\x8c\x23\x50\xe2\x1d\x02\x2a\x98\x2f\x4a\xbf\x49\x40\xb5\x77\x09\xf8\xea\x4d\x23\x4b\xef\x17\x50\xd6\xf0\xc1\x2f\xbf\x30\x80\x7b\x88\xd0\xb2\x8d\xbd\xf8\x47\x99\xf1\xb9\x9c\x42\xed\x3b\xa8\x1c\x98\xa7\xbe\x37\x5b\xcf\xc0\x3a\xbc\xc7\xf7\x20\x6f\x75\x99\x86\xd7\xd3\xa4\x4f\x15\x81\xa2\xe5\x44\xd7\x52\x67\x53\x39\x6f\x93\xc4\xa7\xd2\x5c\x38\x00\x45\xef\xaf\x8f\x88\x39\xca\xca\x2d\xd9\xa0\xe1\xe7\x09\x42\x4a\xf4\x67\xfe\x4d\xfb\xf8\x4e\x8b\x2a\x68\x6a\xef\xcf\x56\x0a\x68\x2e\xb4\x6f\x16\x70\xec\x0a\x57\x34\x7d\xb0\x39\x51\x79\x97\x02\x5d\x87\x29\x88\xa5\xee\x44\x98\x68\x5a\xe9\xcc\xe5\xfa\x1e\x62\xa4\xb9\x06\xde\x62\x76\xfe\x0a\x83\xb9\xb9\xde\x1f\x11\xa3\x6a\x57\xdf\xf6\xf2\x81\x36\xba\x1a\x72\x0b\x8a\xdb\xc1\x32\x15\x8b\x1a\x0d\x25\xbf\x5b\x5e\x6c\x3d\x6e\xa2\xaf\x33\x8d\x21\x44\x37\x7f\x8f\x7f\xac\x05\xa1\x83\x32\x57\xf4\x95\x8d\x27\x62\x88\xe8\xbc\x45\x3d\xec\xfb\x88\x69\x99\xbf\xa7\x93\x88\x11\x83\xc0\xd5\x0e\x46\xea\x3f\x83\x50\x14\xe4\x52\xf8\xa4\x45\x53\x70\x85\x1c\xac\xa8\xbb\x59\xf7\xab\xb6\x5e\x66\xe6\xa1\x69\xc0\xf7\x50\x7c\xc5\x1f\xd5\xc9\x9e\x39\x8d\xac\x2b\x65\xd3\x2c\x17\x08\xb8\xe3\x2b\x0b\x16\x0e\x7a\xad\x9b\xef\xb5\xbf\x35\x9f\x57\x23\x21\x59\x73\x29\xaf\x10\xb4\xd8\x7e\xc4\xed\xa5\x2c\x2d\x10\x40\xa9\xae\x57\xe6\x5b\xf1\x41\xab\xd7\xd5\xa6\x98\x8d\x34\x68\xdb\xc4\xa3\xed\xde\x06\xa1\x5e\xf1\x44\x05\x91\x5e\x62\xc8\x76\x83\xf2\xc4\x92\xe5\x43\xf2\xa6\xc8\xf4\xa1\x35\x2e\x77\xaa\x17\x98\x5a\xc8\x71\xa0\x87\x92\x7a\x15\x1b\x9f\xe6\xee\x19\xa2\x50\x64\xe4\xf6\xa3\xd2\x96\x7a\x19\x8c\x1d\xfa\xa8\x6d\xd8\x47\x2d\x53\x83\xed\x0b\xc2\x4c\xf7\x22\x9e\x5a\xc0\x72\x7f\x3c\xff\x08\x7e\x84\x8e\x93\x46\x8d\xe6\xb0\xde\xcb\x11\x61\x24\x1a\x5a\x3d\xc5\x3e\x9a\x44\x04\x02\xbd\xe2\x98\x02\x51\x1b\xad\x08\x06\xe3\x08\xac\x6d\xab\x0e\xf4\x37\xa0\xa8\xef\x5a\x34\xaf\xd8\xe9\x10\x79\xda\x50\x6e\x45\x7f\xa0\xb5\x55\xbd\xfa\x4c\x02\xfb\x32\x40\xb4\x24\x3d\x66\xe3\xcb\xc4\x2f\x32\x31\x66\x65\x08\x12\x80\x23\x51\xa1\xb9\xcb\x79\x9b\x93\x29\xb7\x2e\x0b\xb0\x4e\x65\x8d\x34\x3d\xdb\x71\x5e\xc9\xcb\x93\x46\x29\xa2\xe0\x98\x28\x9a\x4f\x47\x08\x31\xf1\xa3\x8c\xa9\xd0\xa7\xbe\x2c\x21\x26\x92\x0d\xe6\x71\xee\x40\x5b\x5f\x8a\xd3\x8e\x34\xd9\x6c\x2b\x4f\xe8\xfa\xda\xd4\xd7\x96\x06\x26\x95\xce\xa8\x61\xd8\xc7\x1c\xf2\x05\xbb\xf8\x32\x2a\x72\xfc\x14\xb9\x50\x95\xbb\x82\x2b\x65\x32\x93\xc3\x04\x4a\xc5\xf2\x60\x72\xfd\x97\x34\xb9\x3e\x57\x1f\xe6\x72\xf8\x08\x50\x1f\xe7\x9a\x30\x92\x75\x20\xfe\x6b\x06\xfd\xad\x84\x9d\x80\x2a\xb6\xed\x29\x09\x6c\x9f\x5f\xc9\xca\xc9\xa6\xd8\x85\x43\xe4\xcc\x92\xfe\x18\x62\x96\x58\x04\x68\xb2\x10\x62\xae\xec\x1b\x62\xef\x71\x03\x6b\xdb\x15\xe2\x3f\x23\x70\x19\x90\xc7\x99\xf2\xad\x91\xde\x4b\x8f\xaf\xb7\x5c\x1a\x2e\x99\xf5\x37\xed\x1d\xa4\xcc\x1a\x62\xed\x54\x04\x1a\xd6\xdb\x94\x66\x13\xa3\x0c\x07\x57\x08\x9a\xfc\x44\xde\x45\x35\x2d\x4f\x6d\xc8\xe7\x73\x7b\xf4\x48\x5d\x51\xb7\xf2\xf6\x5b\x67\xb8\xe3\x5a\xdb\x93\x58\x63\xc3\x8e\xff\x77\x62\x13\xa6\x99\x69\x80\x61\x18\xbd\xba\xf4\xa0\x83\x56\xbe\xa1\x56\x2e\x0f\xa5\x98\xb2\x4a\x38\xe1\xca\xda\x37\x32\x05\x12\x3d\x32\xfd\x36\x5b\x19\xd1\x23\x53\x8d\x05\xd0\x42\xcb\x0e\x00\xf9\xa7\xd5\xce\x5a\xf2\xf7\xd6\x1e\xf0\x24\x8e\x48\xfe\xa6\xe9\x58\x44\xf7\xc8\x34\x0f\x93\x9f\x3e\x7f\x00\xa7\x30\x20\x21\x55\x96\xfb\xd9\x28\x64\x31\xfa\x78\x5d\xc2\xae\x71\x74\x7b\x4c\xa6\xa2\x33\x42\x04\x40\xe5\xd0\xa4\xc5\xcf\x37\x73\x2c\xc8\xa9\xd6\xdc\xdf\x43\x6a\x60\x66\xf2\xaf\x38\x35\x50\xb4\x08\x9b\x3a\x31\x95\xbc\xc8\xa4\xb0\x7f\x9a\x3a\x31\xf1\xbf\xc8\xf5\x9a\x7d\xfa\xed\xfd\x19\x40\xfd\xc6\xfb\x85\x7d\xa3\x69\x37\x77\xda\xed\xb3\xcc\x7a\xdb\x61\x13\xc8\xeb\x90\x47\x6c\x6b\x88\x42\x07\xc9\x62\x83\xc8\xd3\x47\xc4\x45\x49\x72\xc8\x5a\xbc\x58\x8c\x98\x28\xa8\x21\x78\x6a\xdb\x56\xe3\x0f\x30\x80\x13\x54\xb2\xc8\xec\xa6\x31\x51\x87\x40\xa7\xc3\xab\x7b\xdd\x6c\xf5\x06\x3a\x39\x92\x94\xab\xe4\x49\x13\x79\x6f\x15\xa9\xd2\xfc\x76\xfb\x19\x2b\x5e\xce\xdd\xee\x8b\xd2\xe5\xb2\x87\xcb\x8f\x89\xe5\x68\x23\x06\x18\x67\x1d\x99\x33\xb0\xd8\x3b\x31\x82\x59\x40\x23\xad\x79\xc6\x91\xda\xc2\x99\xfd\xa4\x50\x29\x58\xb6\x79\xa5\x3b\xaf\xce\x59\x7e\x46\xe3\x99\x53\x92\xb1\x14\xa0\x6e\xf9\xe0\x37\x71\x48\xe5\xa2\x53\x14\xe1\x7c\x10\x80\x06\xa6\x34\x31\x46\xf5\x53\xde\x48\xf0\x5f\x2d\xdb\x30\xbf\xd1\x34\x70\x1a\x20\xc8\x90\x2d\x7b\xf4\xc8\x71\xe0\x23\x99\x82\x65\x33\x26\x1f\xfb\x16\x0d\xb1\x17\x0f\xea\x9f\x7f\x02\x0c\x0e\x95\x56\xcc\x72\xac\x6f\xf9\xb0\xa2\x45\x5a\x93\x0a\xc6\xc9\x32\x77\x2b\x83\xa2\xaa\x77\x6b\xc1\x36\xb3\xf6\x6a\xd9\xa4\x44\xcc\xd6\xf3\xba\xf4\x8a\x75\xb9\x00\xfa\x14\x99\xba\x45\xa9\xde\xea\x75\xa7\x88\x53\x81\x2c\x73\x73\x1f\x35\x1c\x98\x47\x5b\x4b\xff\x30\x08\x84\x4d\xd9\xd6\xf3\xb3\x01\xa3\x05\xc5\xdc\x0f\x35\x4c\x5d\x7c\x4b\x39\x12\xab\x5f\xd0\x43\xcf\x83\x4d\xaf\xc3\x9a\x9e\x07\xcf\x0b\xf0\x4c\x28\x1b\x06\xe8\xcf\x99\x56\x38\x9d\x4e\x3b\xbe\x44\x00\xfd\xdd\x39\xb8\x71\x9f\x6a\x30\x2a\xc0\xd9\x87\xd2\x7e\xc6\x3a\x23\x05\x32\xd3\xa6\x7e\x3b\x20\xdd\x8f\xa9\xb7\x50\x77\xb8\xf6\x71\x54\x93\xe4\x7b\x22\x5a\x39\x2c\x56\x05\x9f\x01\x17\x66\x36\x29\x0c\xc1\xfd\xe4\x40\x55\x68\xe1\x07\x3c\x41\xe0\x74\x04\x59\xbd\xf1\xa4\x77\x91\x1e\x63\x18\xba\x61\xe8\xb2\x7d\x0e\xd7\x0c\x39\x40\x91\x46\x63\xe8\x0f\xd4\x72\xb0\x2c\x04\xe7\x10\xbe\xde\x0f\x4d\xc5\x9d\x8a\x75\x0b\x23\x6f\x3f\x79\xe8\xe4\xf2\xe2\x6b\x0d\x49\x8b\xb7\xe9\x21\xe8\x17\xb6\xc3\xf8\xe4\x21\xae\xd5\x7e\xfd\xfb\xe4\x24\x55\xb8\x51\x1f\x79\xe0\xc4\xee\xb3\x00\xa9\x1a\xa2\x9f\xd8\x13\x14\x30\x4c\x39\xa3\xfe\x88\x58\x9d\x55\x2e\xea\xa7\xe4\x47\xf8\x88\x8b\x9f\x85\x7c\xe3\xa7\x0e\xa4\x54\x74\x8d\x53\xbc\x0e\xb7\xda\x57\x21\x2d\x9c\x44\x7c\xe4\x69\x56\x0a\x64\x14\x47\x91\x68\xd4\x49\x6f\xef\x75\x3b\xca\x0f\x6a\xac\x40\x46\xcb\x10\x28\x51\xfa\x33\x09\x03\x0f\x3a\xa0\x3f\xa3\x0c\xb9\x25\x3b\xf5\x82\x0b\xe8\xc1\x21\x12\xff\x8c\x3f\xdc\x02\xa2\x17\xf5\xf0\x35\xd0\xb7\x7a\x5d\x90\xdb\x66\xf3\x10\x6c\x9d\xfc\x75\x9f\x83\xad\xf9\xf0\xb1\xdf\xa1\x83\xb0\x63\x41\x9d\xe4\x07\xc5\x09\x02\x58\x57\x8f\xbb\x66\xf6\x1f\x7d\x2e\x0c\x0c\xad\x5e\xb7\xcf\x60\xc0\xe6\xe7\x9e\xdd\x06\xab\x10\x80\x6b\x59\xbb\xbd\x30\x14\xae\x7e\xb9\xe1\x23\x6f\x8a\x4c\x18\x43\xa1\xbc\xf4\x0d\x3a\x15\xea\xe0\xa0\x28\x6a\x31\xaa\xe0\x9d\x62\x17\xe5\xb8\xa6\x9c\xe3\x70\xdf\xf7\xf3\xbe\xa7\x87\x5e\xac\xcb\xfa\xc8\x3b\x85\x81\x42\xcb\xdb\x6d\x0a\xd5\x81\xd0\xf6\x99\xd0\x62\x66\x69\xc1\x40\xa9\x65\x9f\xaa\x94\xa1\x32\xf9\xad\xe9\x51\xc4\x53\x16\xcb\x18\x11\xad\x10\x99\xcc\xc5\xb5\xb0\xdc\xe5\xf8\xe8\x35\xc1\x08\x72\x5c\x5d\xe1\x07\x3f\xc3\x09\xfc\x93\x9b\x82\x1e\xe0\x04\x56\xb1\xbe\x27\x74\xe5\x16\xdb\xdf\x7d\xe4\xfd\xd8\x3f\x6f\xa2\xdf\x10\x9f\x76\x27\x7c\xed\x40\x2d\x95\xa9\xc5\x22\x36\xea\xc8\x6e\x31\xb2\x46\xa6\xb8\xe9\x43\x9a\xdb\x42\x4f\xd0\x4d\x40\x14\x26\xaf\x35\x76\xc6\x2d\x3f\xc7\x38\x8c\xfc\x09\xb6\xa6\x83\xed\x81\xa6\x9a\xa5\xa9\x51\x68\x0a\x0e\x44\x89\x03\x03\x2c\x33\xb8\x4c\xec\xe9\x13\x8c\xa6\xfa\xa5\x6c\x77\x75\x2c\xc8\x2c\x20\x63\xfd\x33\x32\x7f\x22\x6e\x21\xbd\x7d\x80\xb3\x34\x9c\x3a\x8f\xe2\xa0\x5f\x73\x71\x26\x0e\xa1\x23\x57\xae\xc6\x9f\x8a\xd0\x43\x23\x15\x53\x4e\xac\x62\xd0\x1f\x91\x0b\x6b\x90\xfc\xcb\xda\x55\x3a\x75\x93\x37\x70\xcd\x0a\x1b\xbc\x25\x3d\x3e\x8b\x4a\x3f\xba\x42\x94\x55\xa8\x82\x51\x5e\x94\x71\x08\x02\x3e\xf9\x5a\x5f\xe5\xb6\xe5\x5b\x36\xfd\x20\x7f\xab\x2b\xdb\x5d\xd0\xb4\xd7\xa4\x22\x2c\x73\x00\xa5\x2a\x5f\x98\x96\x10\x51\x5c\x6e\x6a\x02\xd8\x6b\x5e\xb7\x9c\xc2\x00\x54\xae\xb7\x70\x3b\xd2\x61\xcd\x2d\x36\x0c\xf3\x43\x08\xd7\x9a\x19\xf7\x1b\xc7\xfc\x6a\x3b\x21\xac\xe4\x85\x8b\xef\xa1\x8a\x63\xf6\xfb\x8a\x82\xab\xe9\xc5\x00\xe7\x8f\x0c\x79\x14\x13\xaf\x0a\x47\x88\x16\xc9\x52\xff\x0d\xd4\xbe\xdc\xf6\x9a\xe6\x0a\x25\x30\x44\xa9\xa3\xc2\xcf\x35\x7a\x64\xc2\x4c\xd8\x27\x4e\xc8\xc4\xde\x9f\x26\x5e\x8a\x23\x79\xdf\xdc\x1e\xfb\xa1\xe9\x60\x3a\x92\x41\x58\xc2\x56\xb6\x06\x86\x86\x19\xe7\x05\xb6\x02\x42\xc9\x80\x81\x93\xfe\x65\xe7\xe3\xf9\xf5\x16\x2a\x01\x9e\x50\xff\x23\x62\xda\x3f\x64\x82\xb2\x08\x7f\xe9\x1c\xb7\x15\x66\x8f\x93\xfe\xa5\x61\x08\x00\x8b\xef\x71\x66\x8e\x4c\x19\x98\x28\x3b\xfa\xfe\xfe\x1f\xef\xcf\x3f\xf7\xaf\x4f\xae\xcf\x1b\x76\xbb\xa7\x60\xe4\xc4\xda\xbe\x7f\xdf\xaf\x16\x6b\xbb\x22\xa6\xd7\xc0\x57\x80\x77\xb0\x78\x59\xef\x5a\xbd\x6e\x72\xf6\x5a\xbb\xf7\xfe\x7d\x3f\x37\xe8\x08\xec\x5f\x08\xed\xe2\xbe\x96\xf7\x99\xcd\x36\xc7\x9e\x4d\xa6\x74\xb1\x87\xf0\xc2\xc8\x42\xac\x8b\x11\x9f\x7c\xae\xbe\x71\xf1\xb4\xe0\x45\x2b\xd8\x10\x28\x3e\x07\xb0\x78\x16\x9f\xb1\xf7\xf2\xc5\xfc\x33\xf6\xfe\xed\x2f\xab\x33\xff\xb1\x6e\xe6\xb5\xc8\xcf\x83\x23\xef\x10\xf2\xc6\xaf\x3d\x8c\x3c\x50\x72\xe3\x9a\xf3\x66\x5f\x93\xee\x1a\x93\xa1\x4c\x3c\x95\x07\x16\x65\x5d\x2d\x7d\xff\x47\x21\x15\xad\x97\xa7\x18\xd3\x8d\xc3\xa7\xf9\xf7\x90\x56\x33\x5e\x22\x93\xc6\xcc\x5b\xa8\xe9\x0c\x3d\x2a\xad\x98\xbf\xbe\xff\xeb\xce\x9b\x61\x50\xe8\x6b\x21\xd6\x2c\x12\xa0\xba\x03\x61\x22\x21\x1c\xe2\xbf\x26\xc1\x6e\x79\x98\xf8\x6d\x67\xd1\x12\xd1\x0a\x49\x08\x74\x9c\x1f\xc5\x21\x7f\x94\xb5\xe9\x52\x37\xd7\xca\x28\x41\xaf\xb6\x39\x8b\x47\xd7\x5e\x5a\x5f\x4c\x5c\x4c\x47\x23\xa8\xc8\xd1\x5b\xd3\xab\xe9\x79\x56\xfb\x7b\x42\x95\x37\xb1\xba\xd0\xb8\x3b\x5c\x78\x00\xc3\x68\x11\xfe\xab\x28\x20\xdf\x68\x57\xd4\x00\xa3\x65\xe2\x39\xaa\x45\xe8\x57\x83\xa5\x5c\x4e\xd0\xe1\x35\xb3\x9d\xd7\xcc\x08\x2a\x34\x75\x86\x5d\xfc\x05\x39\x0a\xa3\x46\x43\x65\xc9\x49\xb2\xbc\xbc\xe3\xcd\x15\xab\x4c\x57\x2a\xbc\xf4\x01\xb4\x1c\x04\x4e\x7c\xdf\xc1\x96\x68\xc5\xb0\xa8\xe9\xd4\x6a\xf2\x91\x8b\xdd\x68\xe0\x36\xb3\x1e\xff\x51\x0e\xe1\x14\x7a\x4a\x3c\x06\xb1\x87\x02\x0a\x06\x24\x00\x3f\xbf\x38\x3f\x17\xc5\xf0\xf9\xa8\x96\x61\xd8\x9c\x80\xa1\xf6\xa5\xa3\x37\x6f\x2b\x52\xc9\x69\x8e\x63\xb1\x21\xa1\x76\x30\x64\x8d\x3c\x29\x50\x74\x17\xdb\xb6\x83\xa6\x30\x40\x3a\x86\x54\x27\x13\x14\x08\x03\x2d\xf6\x6c\xf4\x58\x9c\xf3\x28\x8f\x23\xee\x70\x51\x57\x63\x8b\xdf\xb4\x93\xeb\x1f\x17\xde\x4b\xbd\xee\xc9\xf5\x8f\x71\xff\x8c\x12\xe6\xb7\x68\x22\x59\x85\xee\xde\x9e\x79\xd6\x08\x06\x14\xb1\x2d\x46\xbf\xa4\xb8\x86\x29\xf5\x50\xdd\x0a\x29\x23\x2e\x0a\x34\xf4\xe8\xa3\x00\x23\xcf\x42\x7a\x8c\x98\x6c\x5f\x32\xf9\x1b\xcd\x8f\xf7\x5c\xf9\x30\xc0\xa9\x43\x42\xbb\xae\x23\x91\x93\xc7\x73\x8b\xa9\xb3\x07\x74\xc7\x45\x4f\x5e\xd9\x89\x85\xc3\x10\x05\x4a\x25\x72\x34\xcb\x8a\xc3\xc1\x9b\x3e\x11\x8b\x03\x9c\x3d\x93\x04\xc1\xe2\x2f\x0a\x94\x9e\xcd\x3c\xe8\x62\x0b\x5c\x10\x0f\x33\x12\x88\x97\x11\xbf\x23\x2a\x8a\xaf\xe0\xfb\x12\xc5\x12\x22\xb4\xd8\x2e\xd5\x90\x95\x54\x53\xd8\x21\xd3\x48\x11\xa6\xc0\xd0\x4f\xd7\xd7\x97\x8d\x73\x62\x8d\xaf\xa2\x25\xcc\x77\x6b\x56\xf8\xba\x90\x74\x25\x7b\xe3\x78\xb6\x64\x05\xe0\x93\x07\xce\x90\x0b\x3d\xc5\x25\x6d\x48\xb4\x43\x36\xa4\x13\x8b\x76\x8e\xa3\x7f\x34\xf0\x08\x5a\xe2\x83\x21\x4d\x19\xdc\x22\x4f\x8c\xf9\x5f\xb0\x88\x21\x8d\x78\x9a\x2d\x30\x54\x9e\xfd\x71\x02\x3b\x55\x77\x5b\x14\x43\xc0\x35\x21\x4e\x75\x3a\xe4\xd2\xa8\xdf\xee\x71\x19\xff\x19\x99\xfc\x7f\x4e\xa3\xae\x8a\x37\x1a\xd0\x65\xd1\x89\xfd\x10\xfa\x65\x48\x93\x4b\x46\x7b\x3f\xaa\x16\xac\x3c\x6c\x24\x70\x1b\x3c\x5f\x0e\x66\xde\x83\x99\xf7\x60\xe6\x3d\x98\x79\x33\xe3\x0f\x66\xde\xa7\x98\x79\x89\x64\xc5\xbe\x8c\xd9\x89\xbf\x5c\x2b\x51\x42\x66\x06\x10\x7b\x3b\xe8\xf8\x39\x95\x93\x1a\x46\x87\x44\x40\x48\xab\x85\x9e\xf9\xb1\x19\xe3\x45\x34\x7f\xa1\xa4\x0d\x99\x43\xc8\x58\x66\x66\xf8\x8a\x3c\x07\x3e\xe8\x02\x62\x67\x43\x8f\xab\x83\xbd\xb1\x61\xd0\x95\x02\x63\xcf\xd2\x10\x38\x9d\x8d\x90\x8b\xa8\x1e\x20\x4a\xc2\xc0\x42\x54\x27\x53\x38\x20\x1e\x53\x94\x1a\xab\x86\x3c\x09\xd0\x41\x40\x1f\x04\xf4\x41\x40\x2f\x8d\x3c\x08\xe8\xa5\xf1\x07\x01\xfd\x14\x01\x3d\x42\x8e\xcf\xe5\x10\x97\x31\x45\x62\xe8\xf2\xe4\x63\xff\xfd\xb5\x22\xda\x7f\x17\x8f\xbd\x18\xb0\x8d\x04\x71\x57\xd7\x7d\xe8\x4d\x30\x67\xec\x1d\x1b\xe9\x97\x01\xb1\xc3\x31\x43\xfa\xa9\x2c\xfd\x7e\x9f\xa6\x06\x47\xa6\x04\x48\xfd\x5c\x14\x9d\xbd\x53\xd6\x25\x7e\x4a\x58\x7b\x34\xc5\x2b\xc3\xd0\x6d\x3c\x79\x63\x18\xf4\x99\x48\xe3\x24\x21\x43\x41\xe2\x51\x00\x9a\x26\xff\x64\xe3\x09\xff\x5f\x61\x38\xf5\x03\xec\xb1\x64\x88\x71\xf4\x26\x9e\x64\x0b\x66\x54\xd1\xef\x9d\x7c\xc1\x8e\x03\x33\x35\x23\x7d\x7b\xd0\x89\xba\x25\xac\xc5\x65\x92\x60\x56\x5f\x81\xe7\x52\xc9\x64\x25\x13\xc9\x76\x63\x97\xc9\x24\x88\xe5\x20\x4d\x7b\x17\x0a\x25\xe5\x69\xd7\x74\x0d\x1a\xd2\xb9\x41\x09\x9b\xfd\xda\xaa\x69\x32\x98\x6f\xe4\x6b\x03\x09\x63\xc3\xaa\xe2\x21\x47\xb1\x92\x06\x58\x46\xc6\xac\xc9\x51\x7c\x9e\x7f\x00\xcb\xfd\x0c\xfe\x2c\x75\xab\x56\xfa\x12\x94\xea\x2a\x90\x5e\x8c\x8e\x45\x3a\xe1\xb8\xe8\x92\x7f\x0c\xc7\x2a\x3b\xf5\xc6\x51\xb7\xe2\x96\x66\xee\x6f\xb2\x5e\x0d\xa2\x7a\x61\xaa\xc3\x65\xde\x39\xa5\x56\xad\x64\x31\xf2\xbd\x70\x5c\xd8\x37\xf0\xf2\xa7\xcb\x33\x64\x86\xc3\xb7\x50\xe1\xd0\x3b\x43\x93\x32\x6e\x94\xaa\xa6\x1c\x9b\x2f\x6e\xc2\xa0\xfe\x80\x13\xb9\xff\x64\xfe\xa2\xd4\xfe\x53\x64\x61\x45\x88\x59\x1d\x05\x5f\x9f\x7e\x21\xef\x04\xa4\xf3\xcb\x9f\x2e\x09\x71\xd0\xb7\x9b\xe9\xd0\x9c\x81\x59\x7c\x9e\x0e\xf4\xf3\x75\x63\x38\x44\x63\xac\x28\x76\xb0\x93\xe7\x43\x04\xd8\xc6\x34\x21\xbf\x2f\x7c\x3a\xc1\x21\x9a\x40\x57\x61\xba\x2b\xa7\x94\x09\xe6\x64\x4b\x8e\xe3\xc7\xf3\xd6\x85\x9b\x4d\xcd\x98\x31\x20\xc5\x48\xf0\xd8\x08\xa9\x34\xd3\x4b\x08\xd5\x58\x78\xd4\xfc\x68\x32\x8d\xb2\xd9\xa3\x36\x22\x94\x45\xcb\xe4\xb5\x97\x4e\x56\x6f\x12\x55\x72\xd9\x83\xf0\xda\x3f\x4d\xf4\x90\xf6\xd8\xf8\x69\x54\x4d\x7b\xbc\x80\x01\x86\x67\x6f\xff\x6c\xcf\x03\x61\x0c\xe1\x5b\xb7\xcd\x4a\xcd\x7c\x62\x8e\xd7\xc1\x24\xdf\x66\x02\x7d\x14\x28\x2d\x50\x99\x14\x89\x1f\x03\xe8\x8f\xf6\xb4\x82\xa4\xcf\x37\x93\x69\xde\xba\x7e\xc3\x81\x4d\x14\x3a\xc5\x05\x0c\xc6\x48\x18\xc3\x4f\x42\x46\x5c\x11\x20\x54\xc6\xf8\x21\xa6\xd6\x3e\xbc\x5d\x16\x2a\xc9\x9f\xae\x48\xc8\x56\x44\x4e\xfa\x57\xea\xef\x41\xe7\x22\x29\x9c\x39\x40\xc5\xa2\x39\xa0\xe0\xdc\x41\x16\x71\xc1\x25\x09\x58\x99\xf4\x88\x12\x18\x5c\x34\x1f\xad\xae\x51\x17\x62\x96\xab\xa9\xa9\x96\x3a\xf8\x19\x0f\x7e\xc6\xa5\x39\x0e\x7e\xc6\xd5\x91\x07\x3f\xe3\xd2\xf8\x83\x9f\xb1\xd0\xcf\x58\xa0\xeb\x2d\xde\xe9\x92\xca\x77\x15\xde\xb9\xd9\xfd\xdd\x81\x8a\x98\xc2\x9c\xc3\xfe\x0a\xef\xa5\x82\x13\x2a\xee\xe3\x46\xfc\x30\xf7\x0e\xee\x19\xe3\xab\x78\x01\x4a\xde\xad\x52\x17\x62\xfd\x7d\x2a\xba\x0f\xb9\x87\x5c\x70\x35\x0a\x0e\xb7\xd2\x05\x59\x7b\xa0\x7b\xfa\x58\x2a\x77\x50\x4a\xab\x35\x0c\x28\x12\xea\x60\xa1\xef\x94\x8f\xea\x6c\x3b\x5d\x32\x5f\xab\x7d\x82\xba\x2f\xf7\x91\xbb\x4f\x36\xa2\x3b\x7c\x24\x46\x0b\xf8\x1c\x8c\x95\x8e\x99\xf5\xa2\x23\x0a\x36\x10\xa9\x74\x9e\x1d\x20\x38\x40\x41\x80\x18\xc3\xba\x58\x5d\xcb\x2f\xc6\x7d\x09\x67\x97\xaa\x57\xd0\x25\x9c\x89\x8a\xf1\x97\x01\xb1\x10\xa5\x44\x95\x98\x94\xeb\x70\x4b\xb5\xf1\x7b\xaa\x3d\x5a\x0e\xb6\xc6\xb9\xbe\xb6\x04\x7b\x33\x1f\x3a\xc4\x7c\x40\x16\x8b\xca\xf2\xeb\xb5\xa3\x4f\x2e\x52\xf8\x70\x44\x0e\xb6\xa0\xc2\xa4\x5b\x63\x6b\x3b\x3f\x37\xaa\x60\x88\x98\x2f\x81\x59\x2e\xb4\xbd\xf6\x9b\xa4\x2c\x41\xfa\x91\x47\x98\x3b\xc3\x36\x82\x32\xfb\xbd\xf1\xe0\x84\x43\x03\xad\x0d\x25\xc2\x9a\x06\x5a\x2a\x4a\x36\x1d\x32\xec\xa4\x24\x52\xe8\xbb\xbb\x44\x9e\x85\x9d\xb7\x4e\x58\xc2\xc1\x9e\x10\x99\xac\x11\x51\x48\xd3\xeb\x6c\x22\xe9\x52\x4d\x4a\x8d\x5c\x1a\x3c\x34\xca\xda\xa0\xb3\xba\x60\x1a\xfc\xdc\x4c\x27\x2c\xf6\x84\x23\xcf\x56\xf4\x27\xfc\x95\xa2\x00\x7c\xf2\x4c\x02\x03\x1b\x7b\xc3\x7a\x25\xac\x65\x73\xb6\xe6\x73\x28\x0c\xa3\x83\x49\x23\x15\x18\xc4\xf4\x1d\x4c\xf2\x91\x10\x58\xc4\x83\x8a\x50\x80\x45\xeb\xfe\x3e\x2a\x55\x72\x1b\xc5\xb2\x30\xb0\x42\x5a\x43\xec\x5d\x71\x60\x8e\x68\x86\xf3\xda\x38\xe2\x10\x69\x01\x1a\x62\xe2\x6d\x94\x0b\xbf\xec\x06\x6f\xf5\xba\xe9\x0e\xe6\x57\xa3\x19\x1b\xb9\xb3\xc7\x82\x0a\x30\xca\x3b\x12\x4f\xa6\x40\x9a\x4a\xcb\x7a\x62\x98\xa7\x61\x98\x7c\x11\xc3\x30\x5b\xbd\x2e\xe8\xe9\xbd\x82\x6a\xce\x5b\x76\x70\xa0\xa0\xb0\x82\xcb\xe5\x08\x9a\x01\xb6\xc4\x01\x15\xa2\xe8\x3d\xa5\x21\x8a\x5b\xb5\x64\x2a\x15\x6d\x1a\x61\xb3\x40\x6f\xad\x5e\x57\x90\xdc\x1c\xdb\x6d\x4e\x76\x29\x50\x9a\x4a\x49\xd5\x33\x83\xf5\x1b\xa8\x0d\xbe\xd7\xfe\x76\xfb\xcf\xff\xf8\x43\x0f\x68\x60\xe9\x0f\x54\xf7\x47\x21\xe6\x7f\xf8\xa2\xdd\x1e\x0b\xe6\xf4\x6d\x45\xee\x24\x17\x3a\x38\xdc\xf7\x35\x5a\x0c\x5a\xd8\xc1\xac\xb0\x30\xcf\xe5\x08\x52\x55\xed\x87\x26\x8b\xba\xd5\x2c\x0e\xc5\x6e\x8a\xe4\xe1\x08\x79\xc4\xc5\xd6\xd6\x9e\x4c\x99\xe2\x29\x7e\xb4\x76\xab\xd7\x0d\x08\x61\xed\x5e\x81\xe0\x88\xbe\xd6\xe3\x6f\x0c\xa3\x63\x06\x64\x4a\x51\x60\x18\x9d\xf8\x2e\x1f\x6f\xa2\x51\xc8\x99\xd7\xdc\xd9\x2b\x04\x2d\x56\xee\xd6\x16\x10\xc4\x32\x15\x6f\xd4\x8b\x54\xe0\xcc\x86\x0c\x6a\x81\x00\x4a\x75\xfb\x32\xdf\x8a\x0f\x5a\xbd\xae\x36\xc5\x6c\xa4\x41\xdb\x26\x1e\x6d\xf7\x6e\x3a\xda\x6d\x74\xd7\x9f\x19\x86\xdd\x8e\xab\xe1\x3d\x2c\xc5\x98\xa4\x6f\x31\x3d\x9e\x68\xd1\x40\xf2\x7c\x65\xad\x85\x40\xc7\x65\x18\x6b\x0f\x5d\x10\x4b\x56\xd0\x96\xe5\xa5\x90\x34\x54\x18\xba\x30\x92\xa9\x05\x97\x90\x52\xe4\x0d\x9b\xa9\x06\xb3\xb2\x48\x5a\x28\x2e\x3f\xfd\x63\xf5\xa3\x7d\xc8\x05\x91\x78\x15\x90\xf9\x31\x60\x85\x6c\x16\xbb\x16\x09\xaa\x3c\x69\xd3\x12\x4f\xcf\xb3\x58\x7f\x42\xe9\xc3\x3b\x5f\x02\x91\x97\x3a\x52\x0f\xd6\xe4\xe2\x07\x9d\x60\x4f\x75\x02\x49\x02\x85\x7a\x2f\xf6\x18\x0a\x10\x55\xc4\xf5\x34\x52\x08\x40\xd7\x65\x2f\x7d\xfe\x70\x8e\xc1\x48\xcc\xab\xba\x8f\x3d\xcc\x9a\x08\x2b\x8f\x97\x2a\xbc\xc1\x0e\x4c\x2b\xab\xe6\xe0\xe4\x1d\x42\x36\xb8\x8a\x70\xd0\xb4\x7e\xb4\x12\x4b\x2e\x21\x2c\x2c\xb0\x55\x05\x29\x62\x36\xf9\xdf\x42\x72\x89\x0a\xdd\x7d\x2a\x51\x87\x28\xe1\x64\x65\xc3\x8c\xa3\xeb\x69\x46\xd7\x33\x59\xaa\x59\x16\xa6\x62\xfc\xc2\x22\x11\xc1\xa2\x91\xc2\xd6\x26\x31\x76\x3a\x74\x54\x4f\xe0\x75\xbc\xac\xe5\x84\x94\x09\x1e\xb7\x10\xff\x96\x19\xe1\xc7\x7e\x91\x82\x31\x81\x48\x5d\x2d\x18\x40\x63\x4e\xba\xeb\x48\x3a\x3f\x83\xc8\x7c\x5c\x97\xe8\x20\xb3\xe3\x17\x8c\x84\x64\x8d\x78\xec\x5b\xd0\xf9\xb3\xb7\xa9\xe2\x17\x8b\x72\x3c\x68\x0e\xf4\x86\x95\x94\xdc\xe8\x4e\xce\x06\xf1\xf1\x16\xdf\x4a\xe4\xb1\x99\x2b\x22\x53\xf7\xa9\xf5\xf7\x02\x3f\xf7\x17\x80\xdc\x9c\x8d\x67\x66\xe9\xa0\xb0\x00\x25\x54\xd1\x11\xee\x27\x42\x45\xfc\xc3\x25\xf4\x50\x89\xea\x7b\x2b\xda\x95\x58\xa2\xd5\xeb\x7e\x98\x7f\x6e\x63\x6f\xbd\xc6\xaf\x49\x38\x92\xe1\x15\xf9\x7c\x7c\x75\x88\xeb\x12\x59\x34\xc8\x30\x7a\x7e\x3a\x51\xcd\x22\x00\xd1\x71\xb1\x2f\xcb\xc1\xc3\x61\x53\xe6\x68\xea\x43\x2f\x35\x2e\x3c\x4e\x08\x43\x54\xfb\xbe\x06\x53\xb4\x84\x7a\x73\x82\xc3\xc3\x61\xf1\xe5\x23\x5e\xed\xc9\xb3\x75\xec\x9a\x83\x55\x9d\x6f\x1f\x1c\xc9\xeb\x29\x64\xf9\xeb\x27\x3b\x92\x05\x6d\x11\xaf\xf8\xed\xe2\x10\xa6\x0a\x21\x6a\x3c\xb4\x26\x09\x6e\x88\x3c\x80\x0e\xe1\x8f\x16\x67\xc6\xc1\x67\xce\xac\x2e\x7b\xdd\xd9\xcb\xca\xc6\xba\xc5\xfd\x96\x21\xb9\x8d\xa8\x27\xb3\xb8\x6e\xbf\x94\x36\x34\x30\x31\x0c\xfb\x58\xd4\x58\x5f\x13\x72\xd4\x34\xa9\xd9\x2f\xab\xda\xcc\x1c\xc2\xf8\x91\x3d\xc0\x09\x94\x3b\xcb\x67\xf2\xa4\xb3\xdd\xb7\x72\x7d\x92\x2c\x16\x64\x7c\x07\x05\xfb\x73\x66\x03\xec\x28\xfc\x84\x19\x2a\xfb\x80\xcd\x00\x8a\xbf\xd7\x7a\xad\xee\x96\xef\x55\x04\x97\x70\xae\xeb\xa9\x3a\xa1\x67\xfe\xe0\x36\x53\x3a\x30\x5e\xa1\xc8\xd1\x40\x9c\x99\xbb\x0d\xc7\x4a\x34\x55\xab\xd7\x7d\xe5\xcb\x35\x65\xd3\xb6\xb9\x28\x26\x28\xfe\x19\x20\xe7\xb5\xc1\x37\x48\x02\x16\xc5\x6b\xe9\xd1\x58\xc3\x10\xb5\x99\x8d\xa3\xb6\x32\xe4\x2d\xf9\xa0\x76\xf3\x4b\x04\x76\x5c\xa3\xb2\x88\xbf\x13\xfe\xe8\x25\x61\x89\x96\x77\x09\x3d\x94\x88\x0d\xca\xd5\xb1\x6c\x3c\x01\x89\xc7\x3f\x59\x7b\x2b\x17\x33\x59\xae\x50\x97\x22\x94\x0d\x03\x94\x96\x79\xf9\xea\x22\x3c\x12\x16\x24\x36\x42\x7f\x77\x8a\x13\x0e\xf9\x43\x61\x08\x15\x99\xfc\x9b\xbe\xd9\xe2\x53\x87\xe2\x8a\x30\xcc\x1c\xf4\xda\x38\xba\xfc\xf4\xf9\xfc\xea\xc7\x93\xd4\x78\x1d\x45\x8c\x02\x36\xf3\xf9\xdf\x47\xd8\xb6\x91\x67\x1c\x81\x28\x97\x8b\x8e\x88\x8f\xed\x6d\x35\x48\xf0\x23\x8c\x74\xec\xdc\xfe\xcb\x97\x01\x32\xb1\xaa\x56\x7d\xe3\xfd\xaa\x74\x5f\x80\xb1\xe8\x46\x3b\x82\xb6\xf7\x18\x07\xd0\xca\x4a\xa7\xad\x5e\x57\x8e\x9c\xeb\xbe\x29\x46\xb7\x6b\x66\x37\x62\xf6\x42\x2e\x13\xa0\x01\x7e\xd4\xde\x05\xa8\xbe\x3e\x11\x95\xe3\xb3\x05\x0c\x83\x00\xd5\xde\xe4\x8c\x0b\x30\x07\xc1\x09\x67\x2e\xd9\x7a\x70\xc9\x82\xf9\xb7\x2f\x40\x94\xc1\xfe\x88\xd4\x73\xff\xd2\x32\x65\x89\x85\x40\x2c\xc0\x2f\x50\x95\xf2\x66\x86\x41\x8f\xc5\x8d\x7d\xb3\x02\x60\xd4\xc5\x14\xe8\x6f\x1d\x62\x8d\xc1\x0d\xd4\xbe\x80\xdb\x63\xe0\x12\x3b\x74\x10\x68\xf5\xba\x3f\x9d\x9f\x9c\x9d\x5f\xcd\xaf\x3f\x5d\x72\x7d\x94\xf6\x34\xed\xcd\xf2\xa7\x17\x72\xf0\xe2\x0c\x7c\x5c\xf5\xfb\xbd\xf2\xe6\x4c\xe1\xcd\x3d\x4a\xf9\xdd\xc1\x9f\xb6\x7f\x45\x2d\x0e\xe5\xd5\x62\x24\x95\x11\xf0\xc9\xd5\x2e\xd4\x67\x02\x4c\xdd\x1d\xf2\x5c\x4c\xdd\x26\x14\x5c\x3e\xef\x43\xb1\x22\x27\x75\x5f\xf0\x99\x78\x36\x0a\x06\xa1\xe2\xb5\x55\x83\xb8\x8e\x66\xe2\x2a\x6e\x26\x44\x69\x7a\x0f\x6d\x93\x3c\xde\xab\x5e\x03\xe9\x63\xac\xd5\xeb\x4e\xa7\x53\xc3\xe8\xb4\x7b\x91\x02\x3f\x8d\xf7\x10\xc9\x75\x2e\xd1\xa7\x50\xe0\x75\x3e\x44\xe2\xe9\x36\xf2\x6b\x17\xea\x8b\x4b\x97\xc0\xf5\x25\xc1\x8a\x57\x44\x30\xf3\x19\x71\xb1\x87\xea\x2a\x0c\x16\x23\xaf\xd5\xeb\x0a\xfc\xb5\xbb\x3d\x5d\xf7\x7d\x82\x65\xce\x8b\xee\x60\x53\xaf\xfb\xa9\xea\x13\x5c\xac\x52\x73\x6c\x50\x32\x50\x98\x30\xea\xf6\x83\x24\x57\x2e\x5a\xbd\xa9\x0a\xff\xc9\x02\xa2\x0b\x22\x0b\x0a\xd0\xc0\x08\xd7\xe8\x77\xde\xdf\x56\x28\xc0\x11\x30\x73\xf1\x2f\x68\x85\x0e\x09\x69\xbb\xd5\xeb\x6a\x71\x14\x1b\xff\x9f\xdb\x76\xbb\xd7\x48\x9d\xbd\x08\x71\x12\x86\xd4\x72\x96\x8b\xb7\x09\xde\x79\x3a\x63\x04\x46\x23\xe8\x70\x4d\x42\x19\xb1\xc6\x0b\xda\x72\xb4\xed\x7c\xb4\x3c\x62\x17\x53\xf0\xc9\xf5\xb0\x35\x82\x9e\x87\x14\x2c\x7d\x4d\xbc\xd7\xe6\xbe\x21\xfe\x8b\x34\x88\xd2\x1b\x6a\xd0\xf7\x5f\x1b\x47\x57\x26\x3d\x1d\x41\x6f\x88\x4e\x7c\xbf\x96\xac\x85\xb5\xdb\xcc\x45\xb3\x9c\xe1\xa0\xca\xee\x9f\x2a\x7b\xe2\x0d\x43\x07\x06\x3f\x97\x2c\x13\xd1\x74\xfc\x6f\xab\xd7\xb5\xf1\x64\xce\xff\xd6\x96\x65\x72\x24\x05\x2f\x9e\xd1\x2b\xf9\xdb\x2a\xd1\xc1\x50\xee\xb3\x72\x38\x30\xe7\xbc\xbd\xc0\xba\xe9\x70\xae\xfb\xac\xfd\xac\xad\x47\x33\x15\x07\x08\x47\x83\xb6\x1b\x22\x1c\x2d\x5a\xd1\xe1\x11\xb1\x7c\x71\x9b\x15\xca\x93\xbc\xf1\x9f\x91\x09\x18\x01\x7d\xa6\x0c\x6b\x8d\xbd\x1f\xcf\x1b\xd6\x25\x64\x17\x21\x2d\x9e\xbc\xe1\xe4\xf6\xd2\x08\x0b\xcd\x0b\xc8\x54\x19\x07\x3b\xe8\x17\xef\x87\xa6\xcb\x01\x6b\xa2\x09\xbc\x40\x4e\x34\x7f\x71\x84\x44\x68\x3a\xd8\xaa\xa1\x8b\xe1\x02\xcf\x96\xb3\xf2\x6f\x54\x2d\x6e\xab\x8b\xc0\x4a\x82\x8d\x33\xca\x3f\x79\x4c\xd7\x03\x9c\xc0\xca\xc6\x02\x71\x7e\x96\xab\xb8\x58\x01\x02\xa7\x7d\x05\xe1\x3c\x29\xe4\x2f\x9a\x23\xf5\xa4\xb5\x7a\xdd\x48\x24\xb4\x8f\xf5\x76\xcf\x0f\x03\xce\x66\xb4\x98\xc9\x58\x34\xc3\xe5\x92\x97\x75\xec\x40\x92\xf6\x6d\xfe\x8d\x16\x6a\xad\x5e\x97\xba\xda\xdc\xb5\xb5\xb9\x33\xd4\xe6\x8f\x8e\xc6\x67\xb0\x35\xc3\xb0\xb7\xe4\x38\x08\x03\x64\x51\x5a\xe4\xc2\x9c\x0d\x5d\xe4\xa9\x02\xe4\xea\x68\xa8\x92\xe0\xd7\x8f\x96\x94\xb8\xbc\x31\x8e\xbe\xbb\xdd\x12\x36\xa2\x85\x0b\x9f\x3a\xb3\x80\xd4\xc4\xa8\xfa\x2c\x40\xd0\xa5\xda\x19\xa6\x2c\xc0\x66\xc8\xa2\x7a\x83\xf1\x12\x3b\x61\x56\x1f\x60\x00\x27\xc9\x33\x25\x7f\x8f\xb9\xf7\x6a\x99\x19\xec\x09\xa3\x4a\xa1\xce\xbe\x04\x72\x9f\x1f\xc5\x1b\x07\x25\x98\xf5\x12\xa4\xb9\xb8\x88\xfe\x54\xee\x49\x02\xaa\x3d\x4b\x96\x10\x92\x83\xef\x75\x90\xee\x4b\x89\xb0\xa5\xe7\x0b\x00\x15\xea\xe3\x39\x92\x94\xab\xc8\x1d\x7f\x16\x10\x95\xc4\xc9\x86\xfa\x35\x94\x2f\x5f\x26\xac\x6f\x37\x6e\xfa\xc5\x70\xbd\x75\xf8\xf9\x7b\x08\x3d\x66\x41\x55\x34\x54\x43\x7d\xd1\x0d\xa3\xf3\x3b\x07\x40\xa4\x2c\x44\xa6\x67\xf1\x8b\x86\x0c\x64\xbf\xc7\xbb\x2d\x22\x99\xbf\x87\x88\x72\xe6\xfe\xe2\xc4\xa3\x53\x55\x38\xd0\xa9\xe8\xa1\xce\x16\x0b\xcc\x55\x16\xa6\xdf\x68\x1a\xc8\xf8\x47\x97\x21\x50\x63\x51\xff\x1d\x6a\x96\x6c\xbf\xc6\xff\xe9\xc3\x21\x8a\x43\xa9\x5b\x37\xdf\x6b\x7f\x13\xf9\x9a\x35\xc9\x9c\x83\xe5\xa7\x09\x9d\xbb\x62\x52\xa0\xa4\xe6\x88\x4c\xa0\x20\x93\xe2\x6b\x8e\xad\x71\xa7\x81\xc6\xf3\x31\x05\x2f\x96\x16\x23\x3e\xf2\x28\x71\x84\x8e\x14\xf9\x49\x8c\xa3\x37\xa7\x17\x7d\x60\xce\x6a\x30\x61\x8a\xdd\x18\x06\xdf\x4f\xab\xd7\x05\x93\x3a\x72\xe7\xb2\x20\x97\xc0\x24\x0c\x1a\x72\xbe\x54\x41\x68\xab\xd7\xed\x8f\x88\xef\xf3\x0b\x66\xc1\x80\x01\x73\x36\xef\x8f\x1d\xe4\x03\x91\x1d\xe9\x21\x46\xa6\xb3\xfc\x28\xc1\x0d\x10\x0e\x03\xb6\x0b\x8c\xab\x22\x59\xaf\xb0\x35\x02\xd7\xe8\x91\x81\x73\x1b\xb3\xea\x65\x05\xb7\x22\x8c\x7f\xe7\xfb\x28\x76\x29\x5f\xbd\xed\x03\x69\xed\xdf\x28\xeb\x7c\x33\x4a\x4b\x1c\x0f\x8f\xae\xe3\xd1\xae\x25\xd6\x7f\x5d\x03\xd5\x64\x76\x73\x90\x32\x5f\xad\x94\x09\x4c\x2a\x49\xa2\x33\xc8\xf5\xc4\x5e\x35\x20\x59\x9e\x4e\x7f\x77\xad\x5e\x97\x43\x36\xbf\x42\xd0\x71\x66\xa7\x17\xfd\xcd\x6b\x73\x09\x4c\xf0\xb7\xc6\x00\xe7\x22\xe1\x0c\x88\x22\x34\xaa\xe7\x46\xf5\xee\x1a\x55\xd5\x6a\xfb\xe5\xcb\xbf\x3a\x61\xe8\x87\x43\x3a\x7b\xc1\xd5\x6a\x87\x84\xf6\x20\x90\x0d\xe7\x3d\xc4\xf4\x07\xaa\x3b\x84\xaf\xa1\xc9\x8f\xa9\xde\x79\xa6\xc9\xdf\x34\xa0\x79\x07\x36\x95\x78\x11\xfe\x7c\x33\x9f\x8a\xce\x88\xc2\xe4\x7e\x46\xac\x90\xab\xdb\x62\xb6\x27\x15\xea\x4a\xcc\x57\x0b\x2d\xfb\x03\x9b\x58\x1a\x65\x33\x07\x2d\x5b\x06\xa3\x92\x42\x71\xfc\x62\xf6\xd3\xc4\x4a\xcf\xbf\x36\x8c\x4e\x10\x9a\xb3\x01\x09\x84\x06\x4e\x82\xa1\x0c\x73\xe4\x7b\x7b\xa5\xc3\x37\x20\xeb\xc3\xaa\xc9\x4e\x26\x41\x5c\xc3\x43\xaf\x42\x73\xb6\x93\x2c\xb7\x0b\xe2\x0d\x03\xe4\xcc\x3f\x9f\xbf\x0d\xb0\x35\x9e\x73\x40\xda\x5f\x03\x7f\xe4\x67\x57\x3d\x91\x39\x53\x34\x99\x9f\xb3\xf8\x4f\x2e\x91\x43\x6b\x2c\xdb\x54\x15\xf3\x4b\x3e\xa4\x0c\x85\x0b\xc1\xc4\x27\x5d\x6d\x7e\xb5\x6d\x63\xe4\x81\xdc\xb6\x4e\x6e\x01\x17\x20\xb3\x31\xd1\x03\x4e\x01\x96\xa0\x80\x5c\xc2\xc3\xde\x07\xa2\x8a\x03\xff\x8c\x4c\x70\x01\xb1\x53\xc1\xfc\x75\x97\xcc\x5c\x8e\xe5\x46\x6c\x33\x80\xd8\x73\x08\xf1\xf5\x89\x9e\x98\x28\x74\x21\x28\x2c\x1d\xfa\xbe\x83\x34\x46\x42\x6b\xa4\x61\x4b\x3c\x79\x7c\x6f\xa8\xe7\xc7\x6f\x47\x6b\xdc\xad\x9d\xb4\x40\x9a\x01\x70\x14\x38\x27\xbe\x9f\xe9\x1f\x74\x97\x7c\x58\xd0\xdb\x33\xf7\x0e\x1c\xd4\xd6\x5d\xab\xad\xb1\xa3\x3f\x26\x05\xa1\xf0\xe4\x5f\x8a\x71\xc8\x90\x07\xce\xde\x9e\x2a\x63\x22\x76\x57\x56\x62\x2d\x94\xd9\x41\xdd\x25\x7c\x21\x8b\xa2\x60\x82\x2d\xae\x82\x04\xf2\x63\x69\x5d\x15\x35\x09\x37\x56\x82\x93\x69\xe3\x49\x55\x0a\x5d\x0c\x37\x1e\x62\x06\x1d\x70\xba\x18\x2e\xb7\x73\x3c\x6f\x12\x43\x62\xcb\xbd\xc4\x30\x96\xc6\x84\x6b\x2b\x2a\x3a\x37\x9f\x8d\x1b\x70\x20\x1a\x89\xb6\x14\x33\x2b\x4c\x1b\xd0\x1f\x41\x55\x40\x65\xe3\x31\xa7\x81\x84\x22\x13\x95\x7b\xdc\xce\x4b\x70\xaf\x8f\x64\x5c\xcc\x82\x99\x09\x03\xe8\x91\x09\x1d\xe3\x59\x26\x28\x35\x02\xa8\x80\x41\x51\xdf\xc4\xaa\x7e\x11\x39\x8d\x6e\x4a\x89\xef\xa5\x15\xd6\x48\x8c\x64\xc4\x2e\xef\x9d\x64\xe9\x12\x90\xc2\xe0\xf8\x2b\x38\xb3\x46\x50\x61\x9d\xfd\x80\x27\x08\x9c\xf2\x61\xb5\x92\x17\xf4\x7d\xc1\x71\x05\x04\x32\xc9\x3d\x7e\x60\xd7\x4e\x55\xd1\x2a\x05\xc1\x20\x57\x70\xa6\x28\xa6\xbf\x13\xab\x8c\x00\x2b\x77\xdb\xf2\x93\x43\xbc\xeb\x9f\x30\xde\xf5\x79\xfe\xe1\x1f\x5a\x8b\xee\x28\xce\xe6\xd0\x5a\x34\x33\xee\xd0\x5a\xf4\xd0\x5a\xf4\x6b\x69\x2d\x5a\xa4\x3e\x24\xea\xd4\x8c\x74\x70\xfe\x9b\xc5\x1e\xd4\x1e\x37\xb5\x43\xe5\x71\xfa\x52\xa8\x8d\x57\x67\xef\xf2\x55\x47\xb4\x45\x0f\x44\x80\x6c\x62\x01\x95\x45\x4b\x17\xc3\x0c\xa3\xd3\xea\x75\x65\x65\xa4\x76\xef\xd0\x3f\x60\xf1\xdb\x43\xff\x80\x42\x8f\x04\x32\xb1\xe3\xcc\x74\x49\xdc\xb9\x94\x9f\x12\x42\xf3\x85\xaf\xd6\x1f\x76\xc1\xab\x7d\xd3\x03\x2e\x7f\xb8\x6b\x0e\xb6\xbe\x97\xda\xe2\x01\xae\xc7\xbf\x0d\x7e\x52\x3d\x5a\x9f\xf4\xca\x5f\x58\x60\xdd\x23\x3f\x1a\xb0\x2b\x36\x2d\xf0\x64\xf3\x07\x6d\x91\x11\x09\xd9\x36\x56\x60\xe9\x02\x51\x0a\x87\x08\xbc\x25\x30\xb0\x9f\x50\x99\x4d\xfa\x78\x33\x51\x99\x72\xf1\xf9\x42\x73\x4c\xe9\xdc\x0d\xc4\x5f\x5e\x35\xee\xcf\x3d\xd4\x2d\x5d\x4f\x52\xcb\x5f\xd7\x51\xb7\xd4\x22\x36\xea\xc8\x83\x55\x50\xa4\x8b\x55\xb5\x71\x97\xba\x8d\x6d\x44\x92\x97\xb9\x7d\x5c\x63\x10\xaa\x13\x1f\x00\x47\x36\x92\x23\xa3\x17\xee\xc2\x5c\x95\x9d\xbf\x80\x78\xe0\x0a\x62\xa7\x64\x7b\xcc\x27\xbc\xce\x53\xda\x74\x89\x7d\xdf\xea\x75\x03\xbe\xec\x9c\x63\x58\xf1\x42\x5f\x33\xfe\x69\xf4\x9b\x59\x2b\xea\x84\x21\xdc\x97\xd8\x12\x2a\xa2\x76\x03\xb5\x2f\x86\x61\xdf\xfe\xf3\xe5\x8b\x3f\xf4\x2a\x6a\x03\x47\x1d\x0d\x06\x9a\x0f\x03\xe8\x8a\xb7\x30\x0c\xd9\x08\x79\x0c\x5b\x98\xcd\xee\x19\x19\x23\xef\xdb\xf5\xb0\x57\x7a\xe1\x2f\x79\xed\x8b\xcf\x0c\x94\x60\x2d\x60\xd3\xc7\xbe\xda\x93\x0f\xf6\xef\x89\x5f\x38\x5b\x49\x8f\x3f\xa8\xf2\xd8\xe3\x33\x10\x4f\xd0\x6f\x15\x7e\x26\x1e\x7c\xf2\x76\x17\x2b\x22\xd8\x9b\xe0\x21\x09\x20\x53\x30\xb5\xfd\x6d\xeb\x9e\xec\x36\xdd\x4a\x51\x17\xfb\x2b\xf4\x7b\x88\x03\xf4\xb3\x22\x82\x71\x0b\x65\x64\x02\x09\x49\x33\x2e\x3a\x39\xb7\x4a\x0d\xa5\x58\xe1\x6a\xda\xac\x8d\xd8\x9d\x98\x59\x28\x0e\x86\xd1\x7f\xd6\x74\x5a\x94\x5c\x7f\x8d\x94\x3a\x24\x77\x57\x4e\xee\xb6\x60\x68\x8d\x48\xb1\x0e\x34\x41\xd0\x49\x5f\x53\x3b\xad\xc3\x74\x37\xd7\xdb\x81\x00\xe8\x89\x5e\xdd\x5c\x1a\xfa\x09\x0f\x47\x0e\x1e\x8e\x58\xe9\x3e\xe0\x6b\xf2\xad\x1b\xa3\xa1\xcc\xb2\xfa\x52\xea\xfb\x28\x06\x7c\x07\x05\xdd\xb9\xe4\x4a\xd6\x97\x4c\x48\x2f\x4b\x81\x0e\x34\x3b\x23\x38\xc6\x6e\x87\x22\x5d\x9e\xad\xf6\x90\x5b\xc6\x89\x53\xa3\x22\xcc\x60\x9f\x7b\x0a\xfd\x48\xfe\xcc\xfc\x49\xd8\xae\x48\xe5\x88\x48\x4e\x14\x4e\x1a\x55\x51\x40\x1a\xd4\xc1\xb6\x2a\xa7\xb3\xce\xf2\x08\xcf\xe4\x53\xed\xc6\x30\xbe\x33\x8e\x6e\x6f\xee\xbe\xbb\x3d\x0e\x62\x28\x6e\x74\xc3\x98\xca\x3e\xce\x16\xa5\x86\xd1\x9b\xa0\xe0\x75\x12\x7d\x18\x7d\x52\xc2\x40\x1c\x4d\xa7\xc7\xf3\x55\x8f\x35\xc8\xa5\xc6\xcf\x24\xb0\x2f\x03\x44\x4b\x32\xba\x6c\x5e\x92\xf8\x45\xa6\x90\x7b\x19\xaa\x04\xe0\x88\x23\x8e\xaf\x2c\x0b\xe0\x27\x0e\x63\x1f\x1b\x46\x67\x9a\xa4\xbe\x55\xf0\xae\xf2\x09\x01\x9f\x4d\x94\xa6\x10\xc1\xfd\x74\x84\x10\x13\x3f\x02\x59\x59\x7f\xea\x6b\xad\x5e\x37\x4a\xa0\x9d\x63\xcf\x72\x42\x1b\xd1\xb6\xbe\xe4\xcc\x8f\xaa\x83\x3c\x93\xd5\x41\xc4\x04\xd0\xf5\xb5\xa9\xaf\x2d\x0d\x4c\xb2\x0b\xa8\x61\xd8\xfc\x7c\xa7\x7e\xb6\x64\xcf\x32\x2a\x72\xd8\x77\x2e\x54\xe5\xee\xe1\x4a\xe0\x45\x72\x98\xab\xed\x58\x97\x31\x79\xa8\xc5\xf0\xd5\xbf\x34\xd7\xd5\x62\x78\xae\x3e\xcc\xe5\x0a\xc9\x40\x7d\x9c\x6b\x2a\x25\xaf\x03\x31\xef\x08\xbf\x6e\x34\xaf\x54\x56\x06\x95\x3c\xb7\x24\xb0\x7d\x7e\x25\xab\x8a\xbb\x38\x6f\x96\x8d\x90\x8b\xfc\xd0\xb3\x46\x85\x35\xbb\xae\xb0\x35\xa6\x23\x38\xdd\x75\xf8\x65\x04\x46\x53\xaa\xf9\xa1\xbf\x52\xf9\x77\x60\xa5\xfe\x4a\xb1\x41\x9c\x8e\x42\xc6\x50\x20\x4b\xab\x8a\x04\x94\xe8\x44\x0b\x28\x6f\x38\x62\xfb\x60\x5b\xe1\x70\x34\x61\x57\x49\x1f\x34\xf9\x28\x20\x5b\xf0\xad\x2a\xf7\x4f\xe4\xbb\xef\xd8\x22\xae\x8f\x1d\x14\x34\x1e\xf7\xcc\x97\xec\x64\xde\x7a\xeb\x71\xc3\xd0\x7e\x26\x0f\x47\x90\xb5\x7a\x5d\xd0\xea\x14\x17\x3a\x90\xdf\x1f\xb2\x81\x76\x94\x0d\xf4\x3c\xff\x00\xfa\x7f\xff\x80\x19\xfa\x13\x36\x7c\x10\x6d\x79\x7f\x77\x30\x43\x55\x78\x7c\x80\x19\x52\x14\xd4\xba\x22\xd0\xc6\x5f\xca\x55\xff\x8c\x21\x2c\xd3\xcf\x6b\x5d\x48\x42\xba\x54\x73\xb7\x5c\xae\xd1\xba\x81\xda\x97\xef\xb5\xbf\x19\x06\x35\x0c\x7e\x11\x80\x06\x0e\xf7\x7d\x4f\x68\xb9\xca\x7d\x9f\xb9\x03\xe2\x95\xcc\x42\x3e\xd4\x43\x3c\xbc\xc1\xf7\xb3\x1e\x62\x57\xd7\xa9\xa4\xe4\xaa\x65\x78\x03\xc1\xcf\x8a\x6c\xa0\xc4\x54\xb9\xf8\xf6\x37\x01\x33\xd9\xa4\x89\xbd\xd1\xef\xc5\x62\xca\x1a\x5f\x6d\x20\xa3\x92\x1f\x1b\xac\xd2\x52\x49\x3c\x59\x63\x30\x59\x2a\xe1\x57\xcd\x82\x9b\x97\x59\x50\xc0\x18\x0b\x32\x09\x2a\x49\xa6\xb5\x19\x03\xfb\x2b\x67\x4a\x44\xf8\x17\xc9\x9e\xc5\x54\x19\x85\xdc\x59\x49\x91\xa9\x84\xd8\x2a\x69\x31\xfb\x28\xad\x0a\xd3\x5d\x14\x82\x4b\x91\xe6\xb2\x91\xd0\xca\x4d\x6d\xf9\xba\xc5\x4f\xc9\x94\x95\xb2\x22\x69\x4d\x9a\xca\x21\x67\xef\x90\xb3\x27\xff\x74\xc8\xd9\x5b\x1d\x79\xc8\xd9\x5b\x1a\x7f\xc8\xd9\x7b\x72\xce\x1e\xb1\xc6\x81\xca\x42\x13\x7a\xf6\x69\x68\xaa\x5a\xfc\x94\xaa\xf4\x94\x1b\xe0\x20\x5a\x6d\xbf\x49\xd7\xaa\xae\xec\x1e\x2c\x2b\x2b\x10\xed\xda\xb2\xa2\x20\xc0\x80\x9f\xb6\x15\x9a\xc5\xe1\xb4\xa1\x89\x2d\xe2\x81\xa8\x65\xe7\xde\xf5\x50\x0a\x24\x7c\x51\xf7\xd1\x26\x3a\x29\x2d\xae\x50\xec\x97\x4d\x83\xef\x9b\x6b\x4e\x50\x10\x54\xbf\xa3\x44\xaf\xe5\x20\xf8\x3c\xd4\x2c\x27\x94\xd4\x10\xcf\x57\x36\x79\xa4\x74\xe2\x48\x55\x82\xac\x92\x24\x92\x67\x24\x28\x9b\x18\x52\x39\x83\xe7\x50\xbe\xb1\x0a\xfb\xdc\xac\x7c\xe3\x9a\x04\x8e\xf5\xf4\xff\xa8\x4a\x75\x6c\xa8\x2b\x47\xc0\x57\x86\x43\xe4\xb1\xda\x9d\xe5\x7c\xe6\x42\xdd\xe5\x71\x1f\x42\x05\x1e\xa5\x63\xdc\x30\xa6\x49\x74\x86\x45\x5c\x1f\xb2\xb9\x61\x74\x86\x0e\x31\xa1\xd3\xa8\xf3\x3c\xce\x19\xc6\x13\xf4\x58\x60\xca\xed\x77\xde\x86\xd8\x51\x86\xb3\xee\xc2\x7b\xde\x37\x8c\x04\xb8\x4d\x51\x20\x5c\x98\xa6\x9c\xa4\x13\x84\xb9\x58\x38\xb9\x6c\x22\x63\xa5\x7f\x72\xc9\x35\x92\xcf\x08\x4e\x50\x00\x4e\x52\x66\x1d\xbf\xf7\x76\x28\x3f\x29\x2c\xec\xc8\xdf\x3f\xfb\x00\xae\x03\x6c\x2b\x2b\x91\x6f\xde\xee\x03\xbb\xb2\xae\xf5\x3d\xb3\x5c\xc3\xb0\xff\xf9\xe2\xf9\xcb\x3f\x34\xfe\x8f\x7f\xfb\xc3\x30\x3a\x35\x3d\x06\xca\xd0\x87\x2d\x62\xef\x74\x3f\x20\x76\x68\x31\xaa\xb3\x68\xdf\x79\xa8\x41\x1e\x25\x01\x05\x67\x90\x29\xca\x31\x36\xc4\x5b\xa9\x04\xc0\xe6\xeb\xd7\x87\x8d\xf8\x41\x99\x99\xbd\x63\x15\x21\x81\x05\x0a\xb5\xb3\x8e\x94\xe5\x57\x72\xfb\x22\x64\xfa\x8d\x61\xd0\x67\x57\x70\x12\x15\x22\xf5\x06\x78\x68\x18\xad\xef\x6e\xee\xbe\xbb\x7d\xf6\xdd\x73\xf0\x4f\xc3\xa0\xc7\x01\x72\x10\xa4\xa8\x0b\xbe\x13\x0e\x0f\xe9\x88\xff\x6e\x0b\xc4\xc4\xd1\x47\x05\x52\x3a\x98\xe4\xea\xea\xfd\xf7\x17\x14\xab\xb2\x22\xeb\x63\xb6\xd1\x84\x3a\xc5\x6e\xab\x27\x0a\xbc\xce\x2d\x12\xa0\x76\x71\x74\x3f\x10\x70\x76\x5c\x64\xe3\xd0\x5d\x57\x0c\xbc\x0a\x9b\xc1\xee\x30\x20\xc8\xef\x78\x8e\x1e\x37\x94\xd1\x7d\x12\xb0\x01\x71\x30\x89\xfb\x4d\x99\x68\x84\x50\x70\xff\x97\x1f\xfe\xed\xc5\xcb\x7c\xdc\x5d\xbc\x6b\xbe\x6a\x83\x15\x20\x1b\x33\xaa\xf7\x8c\x23\x20\xcc\x13\xaf\x8d\xa3\x3e\xdf\x2f\x02\x17\xd0\x1a\x61\x0f\x51\xf0\x8e\x04\xa1\xcb\xff\x0e\x83\x21\x62\xaf\x8d\xa3\x7b\xd3\x81\xde\xd8\x38\x02\x71\xcb\x4f\x0f\x4d\xef\xa7\xd8\x33\x8e\xde\xf4\x2f\xde\x81\xc4\xf5\xf6\x4a\x87\xf9\xe5\xa7\xab\x91\xa2\x04\xf7\x60\xe4\xd8\x4f\x23\x87\xe0\xa3\x82\x6a\xdc\x88\x68\x8a\xb4\xf4\xfe\xa7\xb7\xef\xc1\x8b\xc6\xf3\x89\x5a\xbd\xae\x68\x12\x47\x19\x0c\x18\x20\x03\xd0\xc7\x43\x8a\x43\xf0\xc9\x73\xb0\x87\xc0\xdb\x90\x72\x40\x29\x78\xef\xd9\xe8\x71\x9e\x24\xaa\x24\x44\x4d\x89\x89\x5f\x34\x5e\x9f\xe4\x67\x42\x5c\xa7\x64\x7e\x6d\xc2\x28\x4b\x12\xec\x6f\xda\xa9\xe4\x37\xda\xb9\x67\x11\x3b\xa1\x48\xb9\xe6\x37\x20\xa1\xc9\xf2\x4e\x1c\x8e\xd4\xb8\xe1\x2f\xb6\x5f\x1b\x47\xd3\x00\xfa\x3e\x0a\xee\x03\xe3\x68\xfe\xaa\xd5\xeb\x3a\xd8\x1b\xcf\x25\x9a\xda\x71\x2f\xe1\x01\x42\xf6\x9c\xbf\x06\x88\x87\x3c\x46\xdb\xba\x45\xdc\xfb\xf9\x2b\x06\x4d\x07\x2d\xb4\x0e\xf6\xb1\xe3\xb4\x6b\x72\x05\x2d\xab\xd7\xd1\xa6\x45\x6c\xaa\xca\xe7\x7b\x08\x23\xda\x3f\x1f\x53\xe1\x6c\xf5\xb6\x55\xe5\xec\xec\x41\x90\x4b\xd5\x6a\x11\x54\xb0\x18\x51\x3e\x5f\xb0\x8f\x8e\x40\x5a\x1e\x1f\xbc\x3c\xfb\xaf\xda\xb8\xa0\x38\xde\x77\x38\x40\x03\xf2\xa8\xf5\x7d\x5b\x1c\xb0\x61\xd8\xe2\x4d\x6e\xef\xf2\xe5\x65\x8d\x02\xe2\xe2\xd0\x15\x31\xed\x94\x43\x96\x8b\x8f\xf7\x8a\x67\x69\x99\xae\xd3\xa7\x9c\xcf\xd0\x84\xc6\xf9\xa4\xe9\x85\x07\xff\x2b\x6b\xac\xec\xfb\xd8\x6f\xa4\x05\xcc\x32\xef\x49\x8a\x41\x71\x68\x84\x11\x84\x66\x4a\x10\x8b\x00\xda\x1b\x99\xf5\x78\xdb\xee\x1d\xc2\xe6\xbf\x6e\x3d\xc8\xc7\x7e\x91\xaf\xa7\xff\xf7\x0f\xe0\x6d\x68\xdb\x8a\xc7\x64\x1c\x59\x0f\x2e\xa0\x07\x87\x1b\xbf\x27\xb9\xbc\x96\x6e\xc7\x64\xdd\x57\xba\xfc\xc5\x5c\x96\x14\x24\x9e\xe5\x60\x6b\xcc\x15\x1e\x6c\xa3\x0b\x88\xbd\x53\x47\x74\xae\x6c\x19\x47\x23\xe2\x22\xc3\xe8\xf8\x23\xbf\xf9\x3a\x6d\x07\xfa\xdd\x13\xfa\xfd\xdd\x31\x39\x99\x14\x5a\xcb\xb2\x29\x22\x0a\x02\xde\xdb\x5a\x47\x8b\xa9\x1e\x6b\xb7\xf9\x6b\xff\xbc\xb9\xf2\x92\x7c\xf6\x56\xaf\xab\xf7\x0c\x83\xf6\xb4\x5e\x56\x29\xcd\xc8\xa8\x45\x1a\x2c\xf8\x66\x87\xd8\xa4\x21\x45\x85\xe4\xf2\xf9\xdd\x27\x53\xed\xd8\x7e\xca\x9b\x2f\x6f\x9f\xf1\xbd\x9b\x0e\x88\x80\xa0\x99\x6a\xa5\x99\xd2\xb1\xc9\x4a\xe9\xbf\x72\xd1\x02\x31\x04\x97\xa7\x67\x0a\x9d\x87\x78\x2c\x20\xce\x26\x04\x16\xcd\x2f\x4a\xda\xe8\xd2\x5d\xba\x07\xc4\x02\x31\xd4\x7c\xcb\x2e\x24\x18\xe1\xe8\x53\x15\x47\xaa\xe8\xc2\x5e\xe1\xe7\x62\x95\x56\xaf\xfb\xed\xfc\xe6\x4e\xa6\x32\xdd\x36\xeb\xe2\x97\x80\xac\x11\x7e\xe7\x8f\x7e\xf9\x82\x21\x4b\xdb\x7e\xbe\xfc\xb7\x4d\xc2\xa4\x57\x70\x13\x41\x94\x53\x2c\x71\x5f\x62\xa2\x3f\x12\x1b\x2d\x97\x9b\xde\xe6\xf3\xfb\xeb\x7e\x22\x7b\xc4\x46\x8b\xf9\xec\xa0\x42\x64\x33\x92\x14\x92\x76\xb0\x02\x25\x14\x0c\xca\xaf\x5c\x71\xe6\x77\x1f\x3a\x88\x0e\x88\xb2\xe5\xd9\xe9\xd5\xc5\x86\x2e\x16\xa1\xf6\xbe\x36\x8e\xcc\x00\x7a\xf6\xdf\x43\xc8\x50\xe0\xc1\x60\xf6\x6e\x18\xd0\xb8\x4a\x4e\xe3\xae\x13\xa1\x80\x24\x3b\x2d\xe6\x85\xf1\xa8\xa4\x17\x1c\x38\x75\x48\x68\xd7\x92\x90\xb4\x20\x30\xce\x90\x0b\x3d\x7b\x0a\x03\x04\x50\xb2\x56\xa1\xeb\x76\x01\xa5\x76\xf2\xb9\x61\x74\x90\x3d\x44\x34\xe4\xbb\x56\x88\x66\x7d\xe1\xb3\xa8\x99\x65\xbd\x81\x01\xe9\x0a\xc5\x88\x0e\xc6\x50\xab\xc1\x08\xd1\x44\x6c\x40\x02\xdb\xa2\xf5\x60\x33\x5b\x41\x66\x8f\xf9\xfb\x5c\xdc\xeb\x32\x3f\xc8\x91\x23\x65\x8d\x2f\x00\x14\x18\x60\x40\xb3\xd2\xa6\x1e\xe3\xcc\x32\x90\x07\x93\xf1\x57\x2f\x0f\x9f\x90\x79\xba\x6c\xf8\x01\xa5\x64\x21\xbf\xd3\x4a\x73\x11\xfc\x82\x99\xa2\x25\xdd\xee\xba\xac\xde\x45\xe0\x6d\xca\x99\xa9\xf8\xbc\x90\x2b\x5b\xd0\x51\x84\x9d\x7c\x1d\x3d\x75\x62\x2a\xe1\xfb\x51\x06\xf2\xf6\xad\x11\x71\xb0\xa5\xd8\xf8\x87\x12\xe6\xf0\xdf\xb4\x78\xae\x4c\x86\x57\xd3\xed\xce\x55\xa7\x1e\x41\x54\x7c\xee\x18\x79\x0c\x0f\xb0\x05\x3e\x60\x2f\x7c\x6c\xd0\x0e\xb3\x7e\xa5\x75\xd6\x97\xe5\x91\x3b\xc5\x61\x0c\x8b\xc3\x41\x29\x24\x26\x04\x5d\x07\x51\xba\x9f\x35\x83\x62\xe8\x0c\xa3\xd3\x5b\x29\x5b\x52\xf5\x72\x45\x73\x29\xea\xb1\xf4\xd1\xd0\x45\xde\x6e\x02\x85\x2d\xdb\xe3\x6a\xae\x84\x40\x06\xc9\xea\x30\x5e\xaa\x09\xdb\x54\xb4\x54\x31\x3e\x1c\x64\x31\x45\x44\x46\xf3\xbd\x96\xa9\x04\x23\x09\x12\x9e\x1b\x46\x67\x10\x3a\x4e\xcd\x05\xef\x1e\xfe\x1e\xa2\xa0\x64\xd4\x7e\xfe\xa6\x41\x09\x85\x78\x23\x25\x36\xb3\xfa\xc3\xef\x1c\xd2\x0d\x9a\x72\xc9\x0f\x8b\xbb\x72\xc9\x31\x91\x3d\x54\x0c\xed\x4d\x50\x20\xc2\xe6\x88\xd7\xee\xbd\x5e\x1b\x24\xd1\x98\xaf\x82\x53\x6a\x04\x51\x59\x73\x82\x24\x6e\x41\x32\x85\xe5\xd2\xfa\xfc\xf5\xc7\xb0\xa5\x85\xb8\xc1\x12\xd7\xd1\x1c\x99\x32\xb6\xd1\xb2\xe9\x29\x44\x35\x8b\xf3\x4d\x0c\xf1\x41\x66\x3e\xdd\x62\xcb\x6d\x9a\xe2\xa9\x98\x59\x78\xd6\x08\x82\x6b\x12\x5a\xa3\x4d\xd2\x0e\x12\xe9\x7a\x41\x4c\xec\xa0\xc6\x32\x12\xa8\x80\x53\x63\x1c\xce\x46\xba\x43\xc8\x05\x96\x42\xa9\x05\x56\x72\x31\x17\x20\xcf\xc6\x3e\x66\x0a\xdf\x6f\xd5\x3a\x60\xf5\x88\xe5\x25\x5d\x27\x05\x16\x4c\x0c\xa3\x93\xc6\x8a\x3d\x5f\x2b\xc7\x33\xe3\x5b\xbd\xee\xe2\x27\x87\x80\x86\xaf\xd5\x21\x4c\xff\x36\x2b\xd4\x2c\x47\xd0\x26\x8a\x02\xb9\x4f\xf4\xd2\xc8\x25\x92\x09\x76\xe3\x9f\x39\xd0\xe2\x3e\xd0\xa2\xa0\x04\x8d\x21\x6b\xe4\x11\x87\x0c\x31\xe2\x4a\x7e\x27\x1c\x17\x50\xa7\x8f\x2c\x5a\xa3\x17\x51\x29\xf5\x9f\xa5\x5d\xe6\x8c\xa3\xdb\x67\x34\x82\x60\x51\x72\x73\x90\x54\xc2\x2b\xfa\xf0\x46\xeb\x54\xe9\xd7\xb9\x7e\xbd\xec\xe0\x78\x44\x33\x7e\xf0\x64\xf6\x22\xfd\x61\x04\x03\x74\x3d\xc2\xaa\x63\xc1\xf6\x10\xb1\x9a\x75\x82\x29\x7f\x7a\xf1\xf5\xd9\x08\xd3\xe8\xf1\x55\xb3\x52\x10\xcf\x5e\x8c\x02\xe4\x38\xef\xbd\x93\xb7\x44\x61\xd6\xb8\x42\x2e\x61\x08\x9c\x58\x16\x5a\xa6\x99\x8a\x45\x27\xc4\x92\xe0\xbd\x07\x4e\xc0\x5b\xf2\x18\x87\x80\xa5\x74\xe1\x86\x94\x01\x13\x01\xe4\x41\xd3\x41\x36\x18\x90\x00\x64\xc0\x7c\xa5\x7b\x44\xa2\xb3\xae\x84\x0e\x25\x1e\x91\xe3\x60\x0f\x9a\xe4\xb1\x18\x93\xd8\x53\x28\x53\x7b\xd0\xb8\x46\x7d\x6d\xb0\x37\xeb\x04\x94\x85\x36\x2e\x36\x86\xf2\x81\x7d\xa6\x6a\x7a\xbb\xa9\xe9\x22\x26\x99\x28\x53\xf1\x99\x61\xd0\x63\x1a\x58\xaf\x6f\xbe\x33\x8e\x6e\x7b\x49\x55\x88\xe9\x54\xde\x23\xec\xcd\x28\x83\xb1\x11\xc3\x1a\x62\xcd\xc4\x9e\xbe\xf0\xfb\x21\x36\x8c\xde\xcd\xdd\x77\xc6\x91\x61\xd0\x37\xb7\xcf\x6e\xe4\xbf\xf4\x37\xca\x1e\x2d\x77\xc9\x72\x16\xb1\xb1\x85\x5a\xbd\xae\x19\x65\x6c\xcc\x29\x75\xe6\x7e\x40\xe6\x98\xfa\x82\xc1\xe5\x81\x32\x44\xcc\x22\xb6\x84\xa3\xee\x6b\x1e\xad\x58\x7c\x5a\xc4\x87\x2c\x50\x65\x94\x6e\x6a\xbd\x8f\x4f\xcb\x24\xf6\x2c\x4d\x5a\x59\x5a\x33\x9b\x78\xea\x22\x1b\xc3\xc8\xfe\x14\x0f\x5b\x57\xcb\x63\xf5\x30\xca\x7e\xb9\xfc\xa4\x18\xa3\xd9\x94\x04\x36\x5d\xc4\xc6\xa6\x48\x47\x31\xa2\x52\x20\x54\xe8\xb7\xa0\xad\xb0\xa1\xef\x75\xe1\x4e\x1a\x6d\x41\xb5\x4d\x55\x3a\xfb\xfe\x6e\x32\x89\x85\x10\xbb\xe8\xf8\xf9\xc9\x12\x62\x80\x82\xd7\x97\x0f\x7a\xf8\x4d\x8b\x27\x6c\xde\x1b\x22\x97\x3c\x3c\x2b\x76\xf4\xac\x78\x9e\x7f\x00\x87\xd2\xd7\x40\xbd\x71\x50\x82\x02\xc1\x21\x00\x21\x1e\xb4\x3f\xa5\xaf\x0b\x08\xff\xdc\xe1\x5a\x55\x39\xba\x5f\x62\xaa\xcb\xb0\xe4\x46\x94\x46\x6b\x6c\x2f\xb0\xe7\x70\x1b\xfe\xe5\x6f\xc3\x73\xf5\x61\xae\xf2\x74\xa0\x3e\xd0\x3c\xde\xbe\x0e\xd2\xaf\x24\x18\x78\x71\x87\xcb\x44\x57\x48\xfd\x40\x89\x30\x50\xf2\x16\xac\xd9\x09\x28\xbe\x0d\xa0\xda\x8d\x00\xd5\x6f\x05\x58\x45\x1c\xc8\x3f\xaf\xbc\x1d\xec\x7b\x19\xd7\x35\xb7\x07\x80\xea\x25\x5b\xd7\xc8\x15\x50\x25\x48\x5c\xc8\x80\xa8\x1e\x17\x28\x65\xe4\x15\x5a\xb9\xea\xc1\x33\xa8\xe7\x55\xb7\x36\x2b\x64\x44\xfc\x77\x27\x69\xd4\xea\xb7\xb5\xb9\x1c\x56\x43\x5f\x8a\x97\xaa\x66\x42\xe2\x48\x51\xa1\x0d\x0f\xea\x79\x40\xa9\xbd\xdf\xd2\x66\xa4\xeb\xa9\xd1\x00\x0f\x66\x0a\x63\x43\x3e\xe6\x36\x7c\x3a\xe3\x81\x92\x90\x1c\xec\x29\xf2\x0c\xb6\x1a\x5c\x49\x86\x5d\xec\xc2\x61\x7a\xb4\x86\xd1\x35\x0c\xdd\x30\x74\xec\x0e\x23\x44\x72\x90\xa1\xbf\xd0\x1c\x77\x53\x8a\x89\xa6\x2a\x2c\xb7\xca\xb1\xc4\x90\xc2\xe2\x58\x07\xdd\x88\xbe\xc2\xcf\x22\xff\x49\x6a\xfd\x13\x04\xe4\xce\xa8\x04\x63\xad\xd1\xbe\xd0\x7a\x58\xe2\xfb\xe5\x53\x98\x22\xf3\x1e\x86\x6c\x94\xb9\xa6\x2c\x66\xa5\x87\x07\xfe\x0a\x44\xbb\x7e\xe0\x2b\x38\x41\x6c\x5e\x62\x88\x75\xac\x2f\x45\x64\x3e\x85\x41\x43\xcc\x20\xa6\xf3\xd8\x19\x23\xd7\x92\x9c\x3f\x6a\x9e\x76\x73\xf7\xea\xf6\x58\x45\xd7\xad\x5e\xb7\x45\xa3\xaf\xdb\x73\x7d\x8a\x4c\xdd\x82\xd6\x08\xe9\x37\xdf\x6b\x7f\xbb\xfd\xe7\x0f\xdf\xff\x71\xdf\x39\x6e\x2f\x3b\x08\x65\x60\x55\xc4\x3e\xa2\x44\x1b\x17\x7b\xcb\xc3\x90\x37\xc4\x1e\xd2\x63\xe8\x14\x97\x24\x5b\x8b\x77\x05\x89\x87\xab\xb2\x9f\x57\xa5\xc0\x24\xb0\xdc\xc4\xfa\xcf\xd2\xf5\x71\xa5\x19\x75\x11\x92\x0e\xb1\xa3\x99\xc9\xbf\xe2\xd8\xd1\x48\xff\x51\xa6\x04\xe2\xa1\x07\x1d\x45\xd5\x82\xe6\x9a\x9e\xc5\x87\xa3\x53\xc3\xe8\x98\x4c\xe6\x46\x46\x1a\x0c\x83\xc3\x65\xfe\x2d\x86\xb1\x11\x32\x45\x7f\x65\x39\x80\x0f\xc5\x83\x00\xba\xc8\x30\x7a\x35\x47\x7b\x44\x05\xaf\x3c\xc8\xaf\x4f\xbe\xfe\x88\x9d\x89\xe2\xb5\x56\xa6\xac\xd3\x4a\x5c\x18\x9f\xb6\xce\x36\xa7\x9b\xbc\x31\x38\x0c\x96\x5b\x9c\xce\x8c\x9d\x09\x0a\xfa\x2c\xc0\x7e\xed\x65\x50\xa3\x0f\x23\xc4\x00\x73\x06\x5e\xc1\x34\x00\xe9\xcd\xed\xf1\xc2\xe2\xd5\x51\xb2\xfa\x60\x5d\x99\xef\x20\xe3\x57\x20\xda\xb5\x8c\x2f\x77\x6d\xf9\x41\x52\x71\x90\xc5\xd4\xeb\xfa\x0e\xfa\xe9\xfa\xba\x99\x7a\xe1\xc9\xec\x6b\x9b\x5d\xed\xe6\x52\xa3\x47\xc8\xa1\x2a\x16\x0b\xae\xef\xfc\x7f\xff\xaf\xa9\x48\xcb\x2c\xc7\xd6\x04\x3a\x6c\x49\x7e\xb2\x86\xaa\x49\x76\xb6\x7f\xba\x50\xcc\xd5\x24\x9d\x41\x90\x8f\x04\x86\xc0\x05\x62\xaa\xd8\x83\xa6\x8a\x82\x63\x86\x5c\xbe\x7a\x24\xe4\x1e\xa8\x6e\x91\xd0\x93\xbf\x78\xa0\x86\xd1\xe3\x23\x5e\xd7\x1b\xf6\x23\x51\x13\x2d\x5c\x4c\x21\x0c\x9d\x42\x06\x9d\x19\xdd\x4d\x9a\x99\x4e\x6f\xee\xb5\x5b\x8b\xd8\xa8\x91\xdc\x07\xd1\x89\xcb\x26\x26\x92\x35\x8f\x88\x13\x72\xb5\x87\xea\x36\x1e\x62\x06\x1d\xcd\x8d\x95\xa2\xe2\x02\x98\x98\xa1\x73\x5b\xd5\xb1\x63\x27\xf5\x16\x62\xc8\x9e\x4a\x29\xc8\xc6\xac\xa8\x11\x03\x66\xc8\x22\x2a\xab\xc3\xd3\x7b\x0e\xd0\xc0\x8a\x74\x82\x67\xfa\xff\xa5\x8b\x70\x2e\x5d\xfc\xc1\x30\x3a\x90\x8e\x1e\xb7\x64\x93\x8d\x76\x5b\x98\xef\x8e\x19\x1a\x60\xaf\x54\x8e\xcc\xb6\xa9\xe2\x2e\x05\x0e\xb4\x3a\x85\xf6\x7a\xf9\xf9\xba\x67\xf6\xa1\xc3\xe7\x6e\x9c\xfc\x87\x0e\x9f\x99\x71\x87\x0e\x9f\x87\x0e\x9f\xff\x42\x1d\x3e\x69\xc2\x97\x8b\x95\xb2\x49\x38\xc1\xd8\x86\xe3\xf1\x53\x35\xf7\x46\x54\x8e\x0c\x74\x9b\x4b\xd8\x74\x92\xce\x00\xe7\x63\xe2\x8b\x8b\xc6\x8d\xb7\x99\x8c\x66\x6a\xf5\xba\xaf\xe0\x82\x77\x4f\x98\x21\xa3\xf6\x92\x9c\xf0\xb0\x37\xd4\xe8\x2c\xce\xc8\x99\x73\xb5\x45\x8e\x8f\xf5\x96\xa2\xe1\xf9\xa5\x89\xa3\xf5\x57\x3f\xa9\x39\x19\x40\x60\xb3\x90\xf2\x1c\x6c\x29\xd0\x5d\x43\x79\x85\x68\xa6\xd4\x99\x7a\xbc\x20\x61\xf4\x76\x8f\x72\x38\x34\x36\x42\xa2\xac\x73\x89\x3c\xb4\x25\x11\xa5\x8b\x09\x9e\x98\x87\x2e\xa7\x3e\x18\xd7\xff\x65\x8d\xeb\xfc\x93\x31\xf2\xa6\x23\x84\x1c\x14\x74\xa2\xe2\xb8\x98\x48\xea\x29\xb8\x23\xae\xa9\x4a\x88\x6b\xe2\x96\x2c\x30\x25\x2a\xa1\x68\xf5\xba\x5a\xc0\x9c\xd2\xe9\x9a\xf2\xab\x5a\x6f\xc3\x05\x21\xd7\x84\x38\x25\x8b\xc2\x16\xb4\x78\xdc\xc6\x85\x70\x09\x61\x1c\xd8\x05\x63\x43\xe3\x74\x99\xac\x5a\x51\x5d\x88\xac\x14\x18\x76\x4c\xa4\x73\x45\x48\xb8\xbb\xa3\x43\x54\xd0\xa7\xaa\x71\xd1\xb6\x28\xf4\xc5\x66\x24\xfa\xe2\xc0\xb1\x0f\x1c\x7b\xa3\x7b\xf1\x22\xf7\x62\xb8\x30\x60\xe0\xc4\x5e\x7c\xf2\x35\xae\x55\xa6\xb6\x2d\xbe\x3e\xb4\xe5\x03\x46\x2a\x78\x86\xa1\x5b\xd0\x71\xb6\x64\xd5\xca\xae\x5f\xa8\x05\xf2\x81\xfd\x06\xda\x21\xc6\x18\x11\xe8\x90\xf0\x70\x18\x0d\xa3\xd3\xea\x75\xed\x29\x9d\x53\x3e\xb4\x6d\x18\x3d\x6c\xbf\xde\xcc\x0f\x98\x86\xc3\x5d\x21\x1b\x5a\x0c\x85\x01\x10\xfb\x79\xef\x51\x06\xbd\x27\x1a\x4a\x91\x87\x2c\xd8\xf1\x1c\xdd\x0f\x4d\xbd\x1f\xc3\x9f\xfe\x4b\x4b\xff\x85\x7f\xf3\x51\x50\xd4\xad\x54\x8c\x64\x4a\x9b\x6a\xdd\x61\x9d\x31\xf7\x71\x1f\xa8\x83\xcd\x26\xca\x0d\x25\x1b\x2b\xa4\x31\x0f\xfa\x95\xf2\xe1\x9f\x67\x7f\xbf\x81\x0b\xcf\x83\xbe\xde\xba\xe9\x18\x86\x9d\xf4\x91\xdb\x76\x1a\xe5\x4f\x90\x8e\x91\xe3\x6c\xc5\xb5\xbc\xbf\x6e\xe0\x29\x1e\xe3\xce\x48\xa2\x42\xd4\x43\x8b\xd1\x52\x52\x00\x50\x0f\xfa\x83\x98\x2e\x54\x24\xd6\xa1\x93\xe1\xce\xcb\x05\x7a\xd0\x37\x0c\x0e\x09\x57\x85\x9a\x6a\x2a\xce\x57\xa1\x93\x61\x51\x4b\x71\x8f\x10\x53\x51\x5a\xae\x29\x27\xa8\x58\x3a\x32\x6a\xf0\x1f\x7c\xd9\xad\xa9\x01\xaf\xa7\x58\xa9\x90\x2c\x88\x89\x2f\x03\x85\x61\xad\x89\x16\x2f\x35\x71\x91\x43\x23\xce\x43\x23\xce\x43\x1a\x67\xce\x92\x5f\x55\x23\xce\xa5\x26\x9c\x7e\x40\x8a\xa3\x10\x88\x35\x46\x8c\x33\xf8\xb2\x02\xad\xb1\x92\x95\x02\x12\xc3\xe8\x60\xb2\x59\xd4\x86\x9c\x67\x0d\x73\x5b\x6d\x94\xf3\x67\x54\x91\x56\x1b\xde\xa8\x0b\xcc\x26\xc4\x91\x4b\x3d\x03\x76\xbd\x37\xe5\x57\x16\x5e\x6a\x12\x34\x70\xae\x5d\x63\x0b\x06\x88\x01\xfe\xf6\xa4\x18\xfc\x17\xfc\xf2\x3f\xff\xed\xfc\xcf\x7f\xbb\xff\xf3\xdf\x4f\x7b\xb6\x91\x01\x63\xc5\x2f\x5e\xe2\x10\x1b\x4d\x9e\x1e\x26\x47\xe5\x44\xf7\x14\x51\x65\x09\xfb\xe8\x13\x2e\x1d\xa3\xb2\x48\x37\xc6\xd1\x77\xb7\xf6\xcc\x83\x2e\xb6\xce\xf0\x44\xfc\x08\xb0\x1d\xfd\x3e\x6a\x91\x2b\xff\x2b\x0d\x3d\x86\x61\xb7\x7b\xe2\xaf\x87\xfe\xf5\xff\xf2\x31\xb1\x09\x35\x0b\x12\x53\x90\xb3\xe2\xa6\xef\xb6\xe9\x65\x2e\x91\x7d\x08\x2d\x94\xe4\xb9\x2a\xe8\xec\xeb\x48\xee\x49\x01\xce\x51\x06\xb9\xb8\x3e\xf4\x85\xdb\x48\x63\x7b\x80\x13\xb8\x69\xc2\xbf\x23\x28\xad\x03\x7d\x68\x8d\x44\x64\xb9\x6e\x91\x24\x95\x50\x7d\x11\x57\x3f\xa7\xc4\x09\xf2\x73\x4b\x88\x13\xd2\xdf\x43\x18\x20\xf0\xc9\xf5\x70\x03\xfd\xd1\x1a\x89\x22\x4c\xa0\xde\x24\x80\xf0\xc4\x26\x26\x02\xa7\xc4\xb1\xdf\x85\x42\x16\x96\xba\xd5\x4f\x88\x1f\x3c\x25\x64\x8c\xc5\xd2\xa7\xef\xae\x3f\xfd\x72\xfe\xf1\x75\x85\x88\xc0\x6f\x34\x0d\xf4\xaf\x4f\xae\xae\x81\x9c\xfd\x1a\x0e\x45\x10\xc6\xc0\x55\xbe\x2e\x33\xd3\xe8\xd6\x00\x3e\xc0\x47\xfd\x89\xde\x93\x74\xc6\x1c\xa6\x71\xfa\xee\xe2\xc3\x81\x69\x6c\xc4\x34\xd2\x68\xf0\xa4\x18\xbe\x45\x1c\x7b\x20\x68\x54\x1b\x40\x17\x3b\xb3\xe4\x19\x96\xac\x5f\x92\xab\x54\x9e\xbc\x5c\x12\x4c\x72\x0f\x15\x42\x7f\x82\xc0\x05\xb2\xb1\x2a\x8b\x0d\xfa\xcc\x1a\xc1\x8c\x49\xbd\x06\x67\x57\x1e\xbb\x89\x56\x48\xeb\x1c\x40\x1f\x1b\x06\xdf\xd2\x04\x89\xf0\xee\x66\x22\x9d\x92\xe9\x8b\x31\xe6\xc1\xe0\xef\xa1\x89\x14\x25\x9e\xcf\xd0\x04\xc8\x10\x87\x6a\x38\x89\x3e\x97\xfe\xf1\xc8\x35\xae\x5b\x94\xea\x94\x2f\x1c\x75\xd9\x30\x7a\x93\xd7\x6b\x7a\x16\xc4\x19\xf8\x31\x88\x49\x25\x64\x15\xa2\xf5\x07\xaa\x9b\xa1\x67\x3b\x28\x5d\xe7\x81\x66\x17\xf9\xb6\x00\xd7\x39\xf9\xf2\x77\x29\xaa\x36\x11\x05\x4b\x3a\xce\x9f\xf1\x49\xbf\xac\xab\x94\xbc\xf8\x1e\x0c\x7e\x0f\x4d\x54\xdc\x37\x86\x84\x9e\x7d\x01\x3d\x38\x54\x39\xb5\x1b\xf4\x75\xd4\x7a\x7d\x65\xff\xb9\x11\x76\x1c\x17\x7a\x38\x69\x5d\xf2\x80\x38\x43\xa5\x7c\xbb\xae\xdc\x6e\xbe\xaf\xdf\x1f\x61\x4f\x11\xa3\x75\x46\xac\xd0\x45\x1e\x13\xa4\xfe\x94\x0b\x7e\x1a\x20\xc8\x90\x0d\x42\xc1\x40\xd3\xd4\xd6\x84\xe7\x51\x01\x8d\x66\x13\xcb\x30\xc4\x51\x1a\x47\x6f\x24\x84\xaf\x74\xf8\x06\xb4\x6e\xbe\xd7\xfe\xc6\x6f\xa6\x61\x74\xb6\x14\x0a\x20\x16\xef\xf8\xc4\x22\xa4\x30\xad\xd2\xc7\x36\x0a\xe2\xce\xee\xd8\xc3\x1f\x55\x05\x76\x2a\x75\x81\x6f\x40\x69\x95\x30\x82\x05\xc0\x37\x8f\x52\x16\xb3\x58\x72\x16\x61\xa7\xc5\x1e\x2e\xca\x09\xf2\xb1\xb7\x17\xcd\xeb\x0e\x96\x9d\x15\x88\xbe\x06\xcb\x8e\x8f\xbd\x8e\x35\xcd\xa7\x2e\x27\xf4\x14\xd1\xd9\x4f\xf1\x5b\x46\x53\xbc\xf2\x93\x22\xed\x03\x42\x18\x0a\x8c\xa3\x37\xff\x87\x45\xfc\xd9\x7f\x82\x1b\x4d\x84\x4f\x00\x09\x09\x78\xef\x59\x32\x74\x27\xfe\x45\x5a\x26\x88\xff\xd2\x0c\xb1\x63\x83\xf8\x57\x22\x8e\xad\xd7\x6e\xf7\x6e\xee\x5e\xdd\x3e\x7b\xa5\xfb\x9b\x58\x2c\x17\xcd\xc6\x09\x14\x4f\xb8\xe0\x7c\x8a\x42\x1d\x51\x8c\x50\xbc\xd1\x37\x0c\x49\x89\xa6\xde\xa1\xa0\x2d\xb3\xff\x00\x35\x5b\x6b\xaa\xd5\xeb\xa6\x6d\x69\x74\x48\x29\xe2\x42\x9e\x91\x00\xe9\xd0\x71\xb4\x1b\xa8\x7d\xe1\x54\xf7\xcf\x97\x2f\xfe\x90\x2a\xb3\x70\x00\xbf\x99\xbf\x8a\xfa\x7c\x18\x06\x7d\x26\xa0\x94\xb4\x18\x90\x90\x21\x3a\x67\x01\xf4\xa8\x23\x44\x3b\x9d\x43\x1f\xdf\x8f\xd1\xac\x9d\x9f\x81\x51\x13\x2b\xbd\x0a\xcd\x19\x20\x1e\xb8\x82\xb8\x6c\x40\xf4\x13\x0c\x1e\x29\x29\xb9\xc4\xbe\xe7\x7b\xe7\xcb\xce\x03\x68\x8d\x15\x49\x93\x6b\xc6\x3f\x8d\xa5\x66\xd6\x8a\x8f\x30\xfb\x8e\xc8\x1e\xa2\x5e\x25\xf2\x9a\xa3\x8e\x06\x03\xcd\x87\x01\x74\x85\x28\xe0\xf7\x1f\x79\x0c\x5b\x98\xcd\xee\x19\x19\x23\xef\xdb\x1a\x5c\xf2\xfc\xe0\xf6\xc3\x27\xdf\xea\x75\x2f\x88\x37\x0c\x90\x33\xff\x7c\xfe\x36\xc0\xd6\x78\xce\x81\x6b\xff\x2b\xb9\xda\x83\xd0\x9c\x2d\xb4\x96\x4e\xd6\x28\x23\x5c\x69\x34\x03\xf1\x04\xfd\x56\xf2\xb8\x73\x3e\x91\x74\xe7\x28\x62\x7b\xbf\x07\x08\x29\xfa\xa1\x54\x90\xb5\xf2\x0a\x06\x84\x21\x8b\x65\x2a\xb8\x8a\x45\xea\xab\xdb\x5a\x55\x00\x08\xbf\xb1\x80\xa1\xc8\x6f\x2c\x0c\x4f\xd4\x87\xd6\x53\xc3\x81\x57\xab\x88\x64\xa6\x2e\x56\x49\xbe\xd1\x34\x70\x3d\xc2\x14\x60\x0a\x32\x00\x19\x46\x07\x68\xda\x96\x3a\x3d\x09\x0d\x2d\x5d\xbb\x98\x7a\x42\x1c\x04\xc8\xb9\x80\x58\x51\x94\x8b\x73\x7f\x31\x6a\x33\x55\x8d\xba\xd0\x71\xde\x64\x97\x03\x13\x99\x3c\x0f\x92\x58\x57\xa9\x6f\x99\x01\x38\x78\x88\xff\x04\xef\x08\x1a\xd1\x82\x0b\xb1\x53\xf8\xb2\xff\xdf\xec\xbd\xed\x7a\xdb\x36\xb6\x30\xfa\xff\xbd\x0a\x54\xa7\xcf\x44\x8a\x43\x71\xa6\xe9\xcc\xde\xd5\x76\xa2\xc7\x71\x9c\xd4\xd3\x3a\xf1\x8e\xdd\x24\x6d\x90\xf8\x01\x49\x58\x82\x45\x12\x2c\x01\x4a\x56\x46\xfd\x77\xae\xe4\x5c\xda\xb9\x92\xf7\x01\x40\x52\xd4\x07\x09\x52\x16\x65\xb9\x65\x7f\x34\xb6\x49\x02\x0b\x0b\x0b\xeb\x0b\xeb\xe3\xf7\x88\x7c\x05\x67\x88\x87\x44\xe3\x26\xd9\xa8\xe8\x59\x76\x74\xed\xd1\x86\x90\x1d\xbc\x8b\x7c\x5f\xd0\x83\x10\x7f\xd3\x0b\x1a\x85\x36\x9e\xc9\x51\x3a\x0b\xc3\xdc\xc9\x37\x90\x0f\x55\x43\xdf\x0f\x89\xbe\xbf\x16\xd6\x65\xe1\xc8\x1e\xb9\x9a\x2b\x90\x5a\x9a\x36\x2a\xbd\x37\x2e\x24\xae\xa0\x48\xb3\x7c\xe2\xab\x0e\xf3\x86\x41\x68\x4e\xe4\xec\x10\x9a\xed\x7e\x4f\x68\xc6\x47\xc6\x6f\xb1\x03\xb0\x96\xc0\x70\x05\x4a\x71\xed\x6c\x8e\x42\x57\xe7\xda\xdb\xcc\xca\xfd\x72\xee\x22\x7b\xd4\xeb\xfd\x88\x7c\xc7\xc5\x61\xaf\x97\xcc\x55\xa7\xd6\xa3\xa0\x58\x77\x86\x71\xb8\x9b\xfc\x8b\x05\x1c\x40\x68\x89\x89\x21\xb4\xda\xfd\x1e\xe8\x9b\xfd\x71\x5f\x1b\x51\xbc\x67\xa7\x14\x87\x6e\x15\x45\x5b\xcc\x6f\x07\xc8\x97\xde\xee\x80\x3a\x66\x66\xd7\x73\x08\x90\xc7\x65\xd1\xee\x27\x41\x61\x3e\x7f\xd2\x9b\x51\xfd\x96\xfc\x5b\x43\xa6\xc2\x7c\xca\x42\x5d\x92\xd3\x10\xa7\xee\xf4\x3a\x1d\x31\x17\x43\x1a\x30\x39\x0f\x18\x53\x3f\xbd\xc7\x50\xb9\x8b\x02\x0a\x43\x3d\x65\x10\x76\x1d\x0c\x5b\x33\x08\xbb\xde\x84\x5d\x59\xf4\xf6\x92\x06\x3b\xba\xb8\xc8\xc2\xd1\x75\x70\x21\xd6\x1c\x9d\x05\xb7\x79\xf7\x8d\x74\xfc\x3a\xb9\x58\x11\x2e\xd2\x2b\xc2\x18\x10\x1d\x09\x4d\x2d\x97\x6a\x7c\xc7\xf7\x51\x4c\x86\xa5\xa0\xdd\x19\x0b\x72\x98\x62\x34\x84\x28\x70\xe8\xc4\x9f\x07\x98\xdf\x63\x08\x7d\x02\xcc\x56\xc3\xe7\x5f\x50\xca\xe5\xc8\xf7\xd0\x29\x30\x33\xee\x21\xe3\x53\x17\x3f\x37\x21\x7c\xfc\x0d\x84\x8f\x41\x0a\x16\x18\xb7\xd3\x08\xe6\x38\x7c\x79\x51\xa3\x9d\x37\x66\xe9\xc7\xcc\x07\xb6\x3e\xcb\x98\x89\x79\xa6\xbb\x95\x8c\xa6\x1c\xbe\xaa\x41\x40\xa7\x3f\x6f\xa0\x9e\x33\x56\xd2\xec\x3c\xfd\x7e\xb5\xdb\xf9\xe2\xf7\x49\xda\x52\x72\x1d\xa2\x3e\x1f\xb8\xd3\x60\x48\x6c\xea\x83\xf4\x27\xa3\x4a\x8c\x18\x9f\x10\xae\xc4\x8c\x2a\x38\x12\xcb\x1b\x6b\xcd\xd6\x01\xd0\x4a\xff\x5c\xb6\x24\xc0\x52\x25\x1c\xf1\xeb\x0d\xeb\xf4\xcd\x9c\x55\xef\xac\x06\xc6\x00\xf3\x14\x84\xb2\x55\xec\x5f\x53\x3a\x70\x31\x38\xa6\x0e\x06\xe7\x21\xe6\x7c\xde\x17\x08\x14\x53\xf6\x1a\xdf\xdc\x32\x8c\x0f\x2b\x7e\x44\x56\x48\x1d\x48\x7c\xa8\x70\x08\x53\xfd\x62\x88\x07\x46\x90\x20\xa7\xa4\x7a\x96\x72\x9f\x9b\xe2\xfe\xef\xe2\x35\x4d\xc6\xe8\x26\xee\x81\x2f\xf1\xc0\xf7\x29\x3c\x25\x0a\x48\x91\xe3\x93\x0b\x29\x55\x6f\x9b\x95\x85\x50\x91\x79\xc7\x72\x39\xb3\xd4\xb2\x4c\xd8\x02\x1c\x85\x03\xcc\x9f\xc1\xd6\x95\xe5\x22\x7f\x04\x5b\x3b\x6b\x6d\x4f\x83\x18\x16\x0d\x91\x68\x4b\xc6\x9f\xa3\xa9\x87\x7d\x0e\xce\x43\x6a\x63\xc6\xa8\xce\x82\xcc\x45\xd8\xbc\x70\xa7\x83\x38\x32\x58\x71\xc5\xf8\xf8\x2b\x61\x74\x77\xd5\xab\xda\x66\xe0\x1b\xe9\xa4\xb2\x3a\x79\x11\x8a\x22\xcb\x25\x1e\x7e\x4f\x1c\xac\x21\x28\xf9\x0a\x38\x77\xd1\x54\x6b\x65\x57\xd5\x38\x62\xc7\x84\x02\x65\x2c\xe6\x81\x50\xc6\xb4\xdc\x30\x33\xb9\x9f\x3b\xa8\xc3\xff\x90\x99\xb1\xd0\x71\x13\x59\x21\xd1\xf5\xa0\xaf\xc6\x6d\xc4\xdb\xf3\x91\xc1\x36\xbb\x30\xac\x06\xcb\xc7\x93\x34\x8e\xc5\x3d\x91\x98\x55\x1d\x8b\x6a\xff\x8a\x8f\xb1\x1d\x85\x3a\x71\xf8\xf2\x8d\x96\x40\x6f\x0d\x26\x47\x32\x64\x4b\xac\x5e\x9c\x24\xf8\x64\xe5\x31\x71\x76\xd0\xf4\x5d\x2b\x27\x25\x2c\x92\x51\xe4\xe3\xc5\x8d\xb6\x71\x6c\x5f\x2f\x38\xeb\x23\x37\x32\xfb\xed\xee\x41\xa7\x5f\xef\x0d\xa9\x9a\x7e\xcd\xb1\x6c\x9a\xae\x03\xfd\xc2\x41\x09\xbe\x04\x36\x8d\x85\x68\xea\x13\xe4\xf3\x33\x50\x21\x2d\x65\x5d\xd3\x75\x2d\x3b\x74\xa3\x22\xe5\x38\xf2\xe8\x99\x46\xe9\x4b\x2e\x59\x52\xc6\x56\x7f\x07\x2a\x97\x22\x47\x2a\x39\x1e\xf5\xea\xd1\xf5\xe4\xc8\xc5\x42\xc2\x7f\xab\x89\x00\xce\x29\x1b\x5e\x2e\x68\x50\x0c\xdf\x06\xf3\x7e\x8c\xf3\x53\x21\xdf\x72\x31\x37\x54\x7f\xc4\x9c\xb7\xef\x4b\x96\xf4\x4c\x93\x86\xc8\x76\xd3\x4e\x1c\x28\x24\x2c\x1f\x89\x01\x0e\x19\xf9\x8a\x35\x81\x97\x19\x47\xdd\xeb\x10\x05\xc3\xed\xdf\x0b\xa4\x80\x28\xb7\x49\xe2\x81\x11\xff\x7c\xee\x74\xfa\xb5\x34\x2d\x91\x81\xb3\xc4\xe7\x38\xf4\x31\xcf\x26\x3f\x98\x73\x70\x72\x31\x37\xb6\xb8\xab\x39\x98\x2f\x5c\x3a\xd8\x7d\x64\xfe\x17\x05\x9a\x3a\x95\x77\xe8\x20\x2d\xfd\xbd\x72\xa8\xc2\x63\x38\xc1\x98\x1f\xb9\x38\xd4\x5c\x6a\x6e\xb9\x0c\xea\xaa\xbb\xf1\x80\x09\x48\x0c\x24\x40\x59\x75\x38\xea\xc8\x2f\xef\xdb\xad\x92\x9c\xc0\x2a\xff\x9e\xff\x33\x5b\x01\x59\x4c\x2c\xe7\xcd\xd7\xfa\x52\x0c\xef\xb6\xd2\x6c\x1e\x8a\x25\xb4\xdf\x6d\x86\xe2\xec\xb7\xc8\x75\xa5\xdb\xb4\x4e\x6c\x67\x26\xcd\x20\x3d\x1f\xd3\xe4\x9a\x97\xba\x9e\x2f\x7b\x5b\xb1\x46\xdd\x5e\x9c\x63\xdd\x95\xd7\x9a\x37\x92\xbf\x19\xc7\xc2\x92\x29\x53\xed\xa4\xa0\x07\x60\x32\xfe\xdd\x59\xcd\xe2\x50\x8d\x2d\xbe\x02\xd1\xbe\xdb\xe2\xf1\xfe\x15\x06\xb0\x89\x77\xa6\x3a\xc7\xe3\x05\x46\xa1\x3d\x04\x27\x52\x23\xda\xb6\x6e\x10\x43\x10\x5f\xe0\x60\xcf\xc2\x8e\x64\x16\x45\x09\xb6\x9b\xe8\x9c\xf1\x3c\x85\xe2\x6e\xc1\x56\xdd\x02\x87\x68\x92\xd0\xfe\x8a\xe7\x6e\xd1\x4e\xcc\x21\xb4\x40\x17\x5c\x77\xb7\x98\xe6\xd5\xf4\xd9\xf8\x56\x44\xcc\xac\xf2\x67\x61\x4b\x66\xfd\x64\x64\xc9\x85\x7a\xc8\x1e\x1f\x9a\x68\xc3\xc4\xb2\x25\x65\x55\x0c\xb8\x49\xae\x7b\x13\x61\xb6\x83\x08\xb3\xd4\x0e\x10\xbb\x54\x9c\x1c\x3f\xf5\xa9\x4b\x07\x53\xf0\x92\xb0\xd1\x85\xca\xf5\x2e\x26\xdf\xb8\x00\x06\xb8\xe0\x34\x44\x83\x4d\x6f\xf6\x7c\x1a\xa7\xa7\x65\xca\xbb\x3d\x62\x53\x9f\x1a\x3e\x35\xd4\xa3\x47\x3a\xd5\x74\x82\x2d\x14\x10\x13\xfb\x3c\x9c\x0a\x21\x33\x20\x10\xf6\x51\x40\x9e\x5d\xfc\xfa\xe6\xad\x0a\x7e\x38\xa6\x21\x9e\xbd\x22\x2e\x66\x6a\x69\x1d\x08\xbb\x2f\x31\x1b\x71\x59\x61\xb7\x40\x0e\xe5\x14\x7a\x28\x40\x17\x00\x2d\x07\x2b\x20\xe3\xe6\x7c\x5f\x32\x2f\x81\x20\xa4\x63\xe2\x60\x06\x10\xb8\x8e\x5c\xd7\xb8\xc6\x88\x47\xe2\x74\xfa\x31\x3e\x11\xe7\x42\x49\x74\x00\xcb\x22\x76\x13\x79\x18\xc3\x58\xcc\xa6\x7c\x8e\x6e\x7f\x24\x83\xa1\x4b\x06\x43\x6d\x54\xe1\x36\xd2\x66\xdb\xfd\x9e\xc2\xce\x4c\x58\x29\x1d\xd5\x16\x6a\x98\xec\xd1\x8b\x30\x62\xc3\xd9\xe5\x10\x7b\xf8\x25\xbe\x46\x91\xcb\xb7\x95\x8c\xa8\x3b\x27\xb1\x7d\x21\xbd\x2e\x12\x2b\xc3\x0c\x56\x72\xd0\x77\xf9\x81\x8c\x34\x17\x1d\xe2\x8d\x3b\x74\x44\x50\xfd\x42\xda\xfd\x9e\x2c\x6b\x32\x43\x2e\xef\x3c\x83\x2d\x99\xe3\x23\x16\x00\x08\x03\xc1\x9c\xbd\xf3\x21\x06\x12\x26\x60\x53\xd7\x45\x16\x0d\x63\xa2\x73\x11\xbf\xa6\xa1\xa7\x3b\x49\xed\x7e\x4f\x7e\x2e\x4c\x51\xf5\x94\xcd\xf8\x84\x8c\x88\x4b\xac\x65\x53\xaf\xb3\xad\x60\xac\x46\x12\xec\x26\xd6\x58\x6e\x64\x91\xa9\xc0\x11\x71\x27\xc4\x77\xe6\x11\x5e\x75\x28\xc8\x09\x71\xe7\xf8\x28\x32\x40\x94\xf7\x51\x6c\x97\x13\x64\x40\x28\xcc\x06\xb8\xfc\xf5\xfc\xed\x53\x50\x43\x21\x8b\x15\x14\x25\xaa\x1e\x9f\x06\xf4\x69\xbb\xdf\xb3\xa9\x7f\x3d\xe3\xd8\x0b\x3a\x66\xa6\x14\x52\xd2\x40\x05\xa8\x2e\x7b\xeb\x5e\xd6\x9c\xff\x2f\xda\x6f\x0a\xb5\x41\x89\x10\x08\xd9\x81\xe0\xe6\x67\x17\xe2\xa7\x4e\xc6\x12\x90\xfb\xc9\x0e\x8e\xcf\x2e\x96\xfd\xeb\x8d\xe9\x93\x3e\xdd\x67\xd3\x47\x1e\x0e\x41\x21\x85\xaa\xe4\x25\x22\x03\x8d\xe9\x73\xca\x58\x84\xc1\x65\x88\xec\xd1\xf6\xe2\x77\xb6\x24\x8e\x5e\xde\x20\x7f\x40\xef\x37\x30\xb8\xdd\xef\x05\x59\x07\xa0\x2a\xe5\xa0\x20\x03\x19\x02\x3b\x84\xd0\x44\xcf\x67\xf3\x70\xaf\xc7\xb2\x4f\xb3\xac\x74\x6c\xb3\xf0\xda\x23\x8e\xe3\xe2\x09\x0a\xb1\xac\x00\x20\xff\x2e\x5f\x7b\xbe\xad\x66\x00\x9b\x5f\x9e\x4f\xf9\x70\xa1\xd8\x65\x31\xaa\x41\x89\x83\x0d\x36\xbd\x3f\x6f\xf7\x7b\x5f\x66\x10\xb2\x8e\x02\xea\x21\xf5\x71\x2e\x1c\x6d\xcd\x69\x97\x0b\xdc\xb8\x9c\x80\x23\x29\x30\xbe\x65\x2b\x1b\x9d\x7c\xe4\x0f\x22\x17\x85\xff\xbe\xb8\xbf\xce\x87\x99\xe1\x85\xfd\xe1\x90\xf1\x4c\x76\xd0\x52\xad\xcd\x07\x06\x0a\x82\x67\x4b\x41\xed\xea\xaf\x55\x02\xd6\x91\x5a\x67\xe5\x9e\x74\xed\x7e\xcf\xe8\x87\xb6\xca\x43\x7f\xdc\x79\xdc\x31\xe3\x91\x8a\x9b\xd4\xc5\x2f\xed\xb6\x43\x63\x3c\x69\x85\xa2\xfb\x4a\x9f\x1a\xa0\x82\x20\x89\x4b\x34\x19\x75\x75\x21\xc4\x3f\x93\x31\x06\xc7\x43\xc4\xb7\xeb\x9c\x36\x53\x7f\x34\x47\x93\x91\xf8\x87\x6e\xd7\x2b\xcd\xe3\xc5\xe5\xad\x1d\x23\x17\xa3\xeb\xdd\x26\xf3\x6d\x75\x81\x93\xc9\xa4\xcb\xd5\x2a\x8a\x3c\x0d\x62\xa1\x24\xf2\x6a\xef\x26\xa8\xd9\xef\x2f\xed\x7e\x2f\x76\x98\x76\xfa\x62\x77\x64\xf9\x62\x4e\x7e\x57\x61\xbf\x52\xdd\x4f\x35\x6a\x93\x2b\xa0\xcd\x88\xa3\x41\x1d\x57\x16\xf1\xf8\x1a\xbc\x79\xc7\x84\x6b\xae\x2c\x5e\xc8\x1a\x62\xc7\xa7\x1b\xd6\x13\x8c\x47\x39\x64\x01\xf2\xd3\xaa\x66\x71\x85\x8c\x4b\x34\x80\xad\xe7\x4b\x8f\x3e\xd0\xd0\x81\xad\xe7\xef\xd5\x2b\x87\xa6\x78\xfa\x3c\x53\xd7\x6c\x13\xa7\x4d\x8e\xab\x2d\x45\x40\x75\x55\xee\x48\x96\x1d\x07\x97\xd4\xb3\x11\x2f\xaf\xd1\x2d\x24\x6f\x2f\x33\x45\xad\xc5\xf0\x45\xcd\x6a\x1c\xd3\x29\xe5\x78\x59\x97\x28\xb4\x1f\x20\xb4\x14\xac\xca\x2b\x61\xec\x8b\x43\x62\x0e\x72\x8e\x32\xd7\x14\xe7\xbf\x97\xe2\xfc\x5c\x12\x4b\xa6\xba\x7e\x19\x75\xac\x29\x32\xbc\x5a\x64\xb8\x00\x5d\x4d\x4b\xe4\xcc\xe0\x7b\xde\x12\xb9\x60\x1b\xcf\xa8\x87\x7d\x5e\xba\x65\xd6\xfd\xee\xa4\x27\x81\xbd\xa7\x04\x54\x35\xf9\x3c\xe1\x51\x87\xd9\xf3\x90\x72\x9a\x89\x30\x29\x8f\xd9\xfb\x68\x6c\xdf\xee\xf7\x82\x04\xe0\x99\xfc\x09\xd9\x91\x4b\x23\xd6\xc9\x08\xdc\xf5\x61\xab\xb5\x62\x3e\x56\xa4\x53\xd8\x96\x2c\xac\x02\xfc\xbf\xc3\xc8\x2e\xa9\xdc\xd4\x6d\x5a\xcf\x13\x0e\x43\x09\x54\x85\x7d\x91\x1f\x88\x2d\x98\x10\x3e\x34\x90\xe3\x50\x9f\x75\xfa\x1b\x30\xb3\x64\xa0\x02\x5e\x26\x5f\xd9\xad\xdd\x2c\xa7\x2c\xbf\xa7\xbf\xf8\x02\xf9\x36\x0d\xcb\x37\xf9\xbb\x5f\x8e\x15\xcd\x01\x5e\x12\x11\x3b\x96\x0c\x3d\xd3\x9c\xc3\x52\xb1\x37\xa0\xec\x4e\x89\xb9\x15\x22\xe2\xab\x9b\x1f\x8e\x91\x67\x13\x3e\xcd\xf7\x75\x63\x17\x33\x9b\xea\xc2\xeb\xf6\xae\x96\xf9\x19\xe6\x98\x86\xfb\xc1\x35\xd2\x5b\xae\xab\x2b\x4f\x82\x65\xd8\x8c\x5d\x5d\xdd\xb7\x83\xfa\x8c\xfa\x03\xfa\xf2\x45\x35\xb3\x66\x4d\xb3\x35\xf0\x97\x31\x65\xc4\xf9\xf1\x04\xd6\x1c\x6b\xc5\xc3\xfc\x44\x8f\xf0\xd5\x9e\xa6\xa0\xb1\x23\x73\x46\x2b\xd3\x0b\x15\x54\xf1\xec\xcb\xbd\x93\xa7\xaf\xac\xde\xd7\xe8\x1d\x0f\x54\xef\x28\x12\x83\xd2\x27\x19\x8b\x34\x14\x04\x45\xd1\x22\x97\x6f\xc0\xc9\x6d\x10\x62\xc6\xc0\x07\x6c\x3d\x30\xf9\x77\x89\x19\x23\x3c\x0a\xcb\xf6\x87\x7f\x77\xb6\x45\x72\xfd\xc6\x30\x24\xc5\xa6\x40\x80\xd8\x8f\xda\x03\x6d\x08\x9d\xc7\xaa\xe2\x53\xf2\x4f\x9e\xda\xb4\x43\x49\x48\xec\x90\x32\x7a\xcd\xc1\xd1\xc5\x79\xf7\xcd\xc9\x65\x35\x16\x9d\x77\x49\x0e\xaa\x5d\xd7\x7e\x34\x8e\x58\xf0\x06\x73\x23\x46\x96\xbc\xb8\xed\x1e\x74\x4a\x64\x3b\x1f\x5d\x9c\x43\x28\x01\x2f\xc7\xf0\x97\xc6\xcb\x54\x53\x91\xd7\xeb\xb0\x75\x75\xf5\xfe\xf4\xe4\xc3\xc5\xe5\xd1\xe5\x49\xf1\x7a\x6a\x93\x0e\x8b\x20\x2e\x23\x2b\xb3\x79\xa7\xa7\x17\x2b\x18\xd2\x6d\x19\x00\x79\x9e\xf0\x1c\xf8\x41\xf1\xde\xc9\xc7\x19\x0f\x79\xbb\xdf\x4b\x69\xca\xe8\xf4\x4f\x4f\x2f\x0a\xaf\xdc\xd7\xe0\x02\xe4\x0b\xe8\x3c\xf0\xf2\xb6\x22\xef\xfd\xb5\x5b\x92\x07\x4a\xfe\xd6\x80\xb5\xdb\x03\xb2\x5b\xf4\x81\xf8\x0e\x9d\xb0\x18\xdb\x6b\x76\x0b\x94\xda\x31\xf9\x56\x4e\xce\x74\x89\x05\x03\xfd\x1e\x82\xc5\x7d\xfc\x40\xfc\xa7\xdf\xcd\x3e\x10\xff\x5f\xdf\xaf\x9f\x65\x0d\xa6\x40\xf1\xc6\x15\xc1\x56\xb4\x81\x45\xdf\xe5\x6e\x64\x11\x88\xb9\x9a\x98\x6e\xb6\x35\x35\x5e\x13\x52\x97\x26\xe5\x44\x6d\x36\x53\x58\x5c\x33\xc6\x1f\xa5\xa8\x73\xbd\xf6\x4d\x08\x5b\xae\x7d\xb0\x3a\xa4\x46\x97\x4c\xb4\x41\xc4\x56\xeb\x28\x94\xd0\xe2\x57\xf9\x4d\x09\xf1\xb0\x96\xcf\x6c\x14\xca\x53\x95\xb7\xec\x99\xd2\xbf\xb8\xae\x02\xb6\xae\xe5\x19\x25\x38\x7c\x09\x5e\x71\x27\x3e\x5f\xc8\x1f\x1e\x10\x43\xdf\xf0\x40\x56\x38\xf7\x95\x0e\x68\xfe\x59\x2f\x73\x3e\x0b\x09\x47\x73\x54\x35\x04\xb3\xd1\x81\xcd\x25\x92\x3d\x3b\x99\x15\x77\xa8\xe4\xe6\x57\xb2\xcf\x79\x62\x2f\xc4\xa9\x42\x95\xfb\xa4\xe6\x0d\xb0\xde\xb3\xb9\x68\x21\xe5\x59\x76\x89\x65\x54\x39\xd6\x64\x33\x2b\xa8\x46\x97\x68\x81\x95\x53\x4f\x5f\xb2\x52\x16\x4d\x29\x6b\x46\x6b\x72\x96\xb3\x60\xee\xc7\xbc\x6c\x34\x86\xcc\x7b\x8d\xc6\xd0\x68\x0c\xbb\xd4\x18\xaa\xc8\x9f\x25\x8b\xa0\xc8\x39\xbc\x78\xa6\xeb\x8d\xb7\xab\x70\x7e\xf7\x31\x76\xae\x51\xc8\xc0\x43\x53\xc8\xd6\x9f\xa7\xa2\xf3\x90\xbb\xc9\x05\x47\xa3\x60\x73\x2b\x1d\x90\xb5\x1b\xba\xbf\xc1\x79\x25\x36\xaa\x06\x65\x57\x95\x02\x2c\x54\x75\x37\xeb\xeb\x94\x0c\xbd\x5e\x19\xde\x8e\x4e\x5b\x84\x0e\x79\xa3\x23\x41\xe8\x72\x44\x2d\x54\xd8\xc7\xfe\x12\xdf\x72\xe4\x6a\xfa\x9f\x6c\x5a\xcc\xbd\xfa\x5a\x57\xf2\x4b\x15\x78\xe0\x03\xb6\xd8\x30\x69\xa5\xb3\x49\x86\xaf\xa2\x0f\x39\x58\x97\xe5\x36\xc7\x11\xd3\x05\x88\x73\x1c\xfa\x75\x64\xf9\x6e\x07\x1f\x31\x80\x4d\x3e\xed\x9e\xb0\xaf\xe5\x7c\xda\x02\xa1\x70\x36\xbd\xf8\xdf\x9f\xcb\x6d\xc1\x9a\xb8\x92\x87\x1b\x7e\xed\x4d\xd9\xef\x6e\x95\x22\xbb\x7c\x4e\xe7\x85\x5c\x7c\x88\x5d\xa2\xf3\x57\x9c\xcd\x15\xd3\x3b\xb7\xa5\x68\xf7\x7b\x2e\xf1\x47\x33\xd9\xc6\x67\xa6\xd0\xa8\x72\x0a\x93\xe6\xde\xd7\x21\xf5\xf9\xdb\xeb\x6b\x62\xe3\xfc\xe4\xfb\x6a\xcc\x3e\x97\x9c\x9a\xf3\x7c\xcf\xe7\xb9\x29\x84\x0e\xf4\x0b\x07\x25\x28\x10\x6c\x6a\xbb\x34\x85\xd0\xf3\x29\x17\x54\xb0\x6e\x36\x28\x84\xce\x25\xf3\x2d\x6a\x5c\x72\x39\x24\xfe\x68\x4e\x2a\xdb\x2a\xcf\xba\xb8\xa1\xe9\x24\xdb\xd2\xb9\x1a\x16\xbc\x02\xd1\x7d\xb3\x60\x0d\x25\x4a\xe5\x5e\x90\x81\xf8\xce\xf6\x73\xc9\x91\xd8\xc4\x43\x9a\xa6\xde\xdb\xb6\x75\x12\xc2\x90\xc9\xc6\x5c\x41\x90\xa4\x1d\x57\xa3\xc1\x22\x24\xa4\x26\x8e\x9a\xa0\x50\x67\x22\x36\xd7\x76\xdf\xbf\xab\x9e\x94\x44\x78\x3f\xe6\x6a\xb6\x75\x1d\x07\xea\xab\xe6\xa4\xe6\x2c\x46\x82\xe3\xb8\x53\x7d\x2d\xb7\x05\xe5\xf1\x0c\x33\x86\x06\x18\xbc\xa0\x28\x74\x32\xdd\x1b\x5e\x52\x3b\xf2\xb0\x1f\x97\xfb\xbb\xa4\xd4\xcd\x3c\xbc\x4b\x35\x38\x85\xc1\x69\x80\x65\xab\x59\xa1\x13\x43\x68\x8e\x7d\x59\xbb\x40\x2e\x60\x22\x16\x50\x1d\xa9\x39\x79\xd7\x5f\xe6\x58\xf9\x76\xbe\x00\x9b\x06\xd3\x90\x0c\x86\x7c\xe9\x15\x60\x87\x18\x71\x55\xaf\xe6\xdf\x38\xc4\xde\x14\xbc\x8b\x18\xcf\x56\x45\x5c\x2c\x1c\xba\x76\xf4\xf9\x3a\x8c\xf1\xfc\x4e\xec\x4b\xbb\x7b\xd0\xd9\xb0\x12\xbe\x24\x80\x64\xd0\x62\x1a\x18\x11\x20\x57\x72\x7c\x76\x01\x5e\x87\x34\x0a\x26\x28\x2c\x91\xd7\xa1\x25\x08\xb5\xe7\xe9\xaf\xaa\xab\x40\xfa\x6b\x2d\xa5\x90\xe2\xb1\xdb\xfd\x9e\x39\xbb\xea\xf0\x15\xba\x28\xae\xe9\x7a\x99\xbe\xbf\x11\xb6\x8b\x8b\xcc\x5d\x12\xd7\x29\x61\xa3\xdd\x91\xd1\x64\x5a\x32\x73\x31\x61\xbb\xdf\xb3\x1d\x7f\x06\x61\x77\xc2\x66\x86\xe5\x52\x7b\xb4\x5c\xc0\x70\x15\x7d\x65\xbf\xdc\x02\x8b\x72\x1d\xd4\xb5\xed\x7c\x9c\x79\x38\x70\x69\xf9\x16\x0d\xf5\x34\xf9\xe0\x31\x18\xb5\x34\xf3\x90\x65\x6a\x89\x47\x5c\x6c\xa8\xbe\xff\x32\x68\xdd\x4c\xe6\xcc\xcf\xc0\x22\xfe\xf4\xec\xf8\xa4\x18\x35\xef\x88\x3d\x04\x97\xf8\x96\x83\x13\x87\xf0\xea\x6d\x15\x35\x88\x31\x39\xf1\xa7\x57\x7d\xcf\xc6\x35\xf6\x43\x90\x74\xe2\x4f\x3d\xbb\xb0\xc0\xf9\x25\xe1\x48\x53\xb8\xb7\xc6\x22\x2b\x5b\x5e\x2d\x47\xfe\xd3\x7f\xfd\xbd\x70\xb9\xd4\x43\x9c\x1e\x23\x5d\xfb\x92\x7b\xf4\x59\xcf\x21\xdc\x18\x11\x72\x08\x1b\x85\xbc\x18\x15\xa1\x8f\x1c\xba\x70\xc3\xb4\xdd\x9b\x8c\xec\x04\x6b\xaf\x58\xef\x89\x4e\x14\x5c\x13\x6c\x15\xca\x1d\xca\x91\x7b\x4c\x1d\x8d\x44\xdf\xbc\xd5\xfd\x97\x74\x86\x7a\xfb\xf0\x95\xe0\xa3\x5c\x40\x22\x3b\x21\x17\xd1\x4b\x88\xec\x1a\xab\x34\xc6\x63\x1c\x22\x40\x9c\x67\xb0\xc5\x43\x64\xc7\x25\x0d\xe7\xba\x4f\x4e\x93\x13\xd8\xfa\xfc\x58\x96\x1c\xe2\x21\xf5\x07\xcf\x05\x00\xed\x7e\xef\x13\x30\x3f\xaf\x50\x5c\x8d\xa6\xfc\x72\x7d\xc2\x1d\x59\xf3\x65\x6b\x11\xee\xb1\xb9\xbe\x52\x63\x50\xeb\x3b\x0a\x91\xdd\xc5\xce\x00\x4f\x90\xeb\x16\x1e\x62\x41\x8b\xff\xd6\x54\x27\xde\x76\xb5\xb4\x78\x58\xae\xce\x41\x1d\x62\x5d\x8c\x5c\xdc\x61\xfc\x32\x44\x3e\x23\xd7\x58\xe3\xae\x28\x48\xb4\xdc\x23\xd9\x9e\xba\x27\x92\x45\x69\x57\xee\x46\x36\xd1\x71\xee\xed\x5d\xc0\xc6\x03\xaa\x49\x21\xec\x12\x9f\xdc\xb5\xad\x98\x1a\xb2\xf1\x18\xee\xdf\xa5\x4d\x53\xdf\x29\x33\xf8\x9e\xd7\x77\x2a\xa3\xfa\xcc\xd9\x45\xe1\x5d\x44\x88\x34\x15\x15\x6b\xf2\xfc\xf2\xb4\xce\x24\x84\x5d\x2b\xac\xc3\xe9\x1b\x22\x89\xb5\x78\xf4\xb5\xab\x8f\x3c\xcb\xd5\xd8\x08\x25\x9a\x5f\x4a\x66\xa1\xc6\x32\x7e\x61\x92\xb5\x68\xfb\xda\x1d\x92\x6b\x21\x98\xe2\x12\xf5\xca\x41\x23\x47\xd8\x99\x4f\x38\x41\x93\x9c\xb5\x50\xf2\x4c\x30\xf6\xcf\x74\x37\x04\x75\x8a\xdc\x78\x8a\x04\x90\x7a\x9d\x0b\x83\x10\x63\x9f\x51\x7b\xa4\x2a\xdf\x88\x39\x3d\x74\x9b\x8f\x1c\x22\x7b\xa3\x6c\x21\x30\x6a\x41\xe6\x88\xb7\x97\x87\xbf\x2f\x3d\x25\xa1\x94\x18\x16\xdb\x2b\x56\xd1\x26\x84\xf1\xda\xaf\x37\x93\x49\xea\xc4\x89\x9a\xbb\x51\x56\xf6\xf3\x7a\x93\x0b\x12\x90\x57\x9b\x1a\x5a\xc4\x8e\xb6\x2e\xc7\x86\x8e\xa0\x74\xf4\x7d\xf2\x02\x29\xa0\x3c\xc4\x43\x72\xab\x58\x58\x88\x6c\x73\x42\x46\xc4\xcc\xa0\x23\x1f\x61\x5c\xdb\xfd\x2a\x69\xd4\xbe\x55\xe6\x6e\x9a\x49\x73\x26\x08\x05\xab\x11\x60\xc4\x37\xc2\xb1\x1f\xbc\x0e\x8b\x53\xcd\xa3\x21\x21\xf1\x0a\x38\xf1\xe8\x0d\x01\xed\xcb\x09\x16\x3f\x74\xea\xeb\x0f\xa6\xc1\x13\x57\x00\xd4\xdd\xde\x39\x46\xcc\xbc\xd9\x70\x3c\x6f\xfe\xfd\x43\x8c\xa7\x57\x52\x46\x3c\x14\xf3\x5c\x01\xd4\x98\x43\x7f\x5a\x73\x48\x7c\x72\x2d\x49\xf2\x86\x95\x68\x9d\x9d\x50\x31\x9f\x06\x18\x89\xdd\x9a\x63\xa4\xbe\x6e\xe5\x9a\x13\xdf\xee\xf7\x52\x70\x66\x96\x4b\xa9\x33\xa4\x91\xef\x74\x54\x47\x47\x85\x8f\x99\x15\xf9\x8e\x8b\xef\xdc\x88\xbc\x39\x0f\x7f\x81\xf3\xb0\x86\xb9\x67\xc9\x3d\xef\x68\x4c\x03\x7c\x8e\x9c\x3b\x5b\xcb\x35\xdc\x2d\x0a\xf0\x03\xe4\x6c\x7c\xb1\x28\xad\x1b\x35\x46\xa1\x2a\x30\x0d\xb0\x3d\xd4\xf4\x67\xb9\x1f\x0c\xc4\xa0\xb5\xc1\x92\xc6\xdf\x98\x35\x2b\x10\xed\xbb\x59\xa3\x76\xb2\xb8\xb3\xdc\x34\xc0\x23\xa2\x51\xb2\x5e\x51\x9f\x03\xc5\x86\xb7\x2c\x90\x22\x86\x85\x8e\xae\x80\x58\xe7\xaf\xba\xb3\x5a\xae\x86\x2e\x3a\x8b\xbf\x9c\xfe\xa4\x43\xc0\x56\xba\x73\x4a\xcf\x5c\xd2\xee\x45\x5d\xc9\xb6\xfb\xbd\x68\x64\xd8\xd4\xe7\x88\xf8\x38\x9c\x45\x23\x83\x61\x5b\x76\x53\xd6\x45\x52\x45\x44\x2c\x6b\xeb\x61\x43\xb2\x6d\x2f\xe6\x6a\xf4\x22\xa4\x9d\x9d\x76\xb7\xe4\xa7\x7a\xad\xb8\x4f\x7a\x32\x7f\x39\x3b\x85\xb0\x5b\xb7\x97\x4a\x4d\xdf\xb0\xae\x3d\x64\x5d\x89\xdb\x3d\xf2\x88\x61\x7b\xac\x1b\x46\xb9\x54\xf8\xe6\xf4\x63\x31\x09\xe6\x64\x7c\x97\x72\xca\xfc\xe2\x93\xdb\xfb\xf4\xbf\x44\x3e\xb9\x2d\xba\xba\xff\xc5\xc2\xa1\xbd\xf3\x38\xad\x78\xd8\xc8\xbe\x12\x73\x9b\xf1\xbf\x57\x32\x8c\x73\xab\x76\xc2\xcb\x30\x0a\x50\xc9\x36\xd6\x29\xb7\x58\xa6\xc4\x9c\x93\x77\x72\x1b\x90\x50\x0e\xd3\xfa\xc7\x0f\xe0\x0d\x1d\x83\x7f\xfc\xf0\x5f\xff\xbd\x7c\xec\x14\x04\xc6\x31\xb2\x87\x38\xbe\x89\x59\x7a\xe3\xf5\x42\x94\xaf\x7a\x5f\xf5\xe4\x2d\x1f\x61\x92\x19\x70\x31\xfd\x51\xe5\x3d\xc2\x96\x29\xe8\x82\x99\xed\x7e\xcf\x51\xfd\xdb\x67\xc8\x75\x3b\xe2\x77\x3e\xc4\x1e\x66\x33\x8f\x3a\x91\x8b\x59\xd2\x58\x78\x19\x07\xeb\x8d\x1b\x47\x02\x5b\xa5\x72\xf2\x4a\x54\x73\xa5\xf5\x36\xe9\x6f\x7f\xd9\xf4\x37\xd9\xe7\x54\xd2\x4a\x95\x10\x26\x29\x02\x62\x16\x57\xcc\x06\x23\x9f\x47\x35\x8a\x81\xec\xf8\x6b\x76\x3c\x7e\x7e\x8f\x82\x42\x61\x4a\x40\x21\x9d\xdc\xf3\xca\x1e\x6b\xd1\xe5\xf2\x10\xd5\x17\xde\x1b\x7f\x7e\x78\x4d\x43\x0f\xc8\xc4\x01\x24\x15\xda\x79\x18\x22\x34\xed\x01\x31\x2c\xe2\x43\x68\xfe\x72\xac\xa2\xc7\x21\xec\xb7\xfb\x3d\xf5\xc2\xdf\x3a\x7d\x31\xf1\x10\xf9\xfc\xd4\x79\x26\xff\xa6\x53\x86\xb3\x03\xce\x5d\x46\x10\xf6\xb7\x6b\x4c\x44\x02\x75\xba\xa0\xe1\x5f\x3c\x2b\x44\xb6\xc6\xb4\x2f\xa7\x19\xc7\x43\x65\x8b\xd9\xad\x4b\xdc\x59\xc6\x7d\xb0\x1a\x03\x2a\x25\x49\x14\x43\x56\x9d\x26\x97\x39\xff\xc2\x48\x95\x84\x7a\x53\x12\xb0\x1e\x35\x7c\x0e\x46\x8e\x44\x6d\x4a\x02\x66\xde\x6b\x4a\x02\x36\x25\x01\x1f\x4a\x49\x40\x9d\x4c\x52\xac\xb8\x50\x22\xf9\x16\x8d\x7c\x5b\x97\xa6\x11\xa7\x71\xa5\x7a\xce\xcf\xc8\x77\x04\x11\x9e\xcb\x0c\xcc\x88\xb8\x4e\x99\x98\x86\x8f\x46\x32\x9d\x21\x3e\x3c\x75\x0a\x43\xd8\x34\x72\x3d\xb2\x92\x56\xe2\x5b\x77\x0c\x46\x31\x94\xc5\x88\x5b\xed\x19\x77\x6f\x77\x77\x55\xfa\xc1\x6d\x0f\x49\xab\x7d\xdf\xd6\x22\x8a\x21\x8b\xb8\xae\x26\x09\xb5\x96\xbc\xdc\xed\xae\x37\x5e\x47\x11\x51\x44\x2c\x8e\xf2\x28\x5c\xeb\xa6\x19\x13\xf1\xe7\x87\x0e\x19\xab\xaa\x47\x32\xd5\x27\xc2\x57\x2a\x72\x05\xb6\x76\x10\xce\xc9\x24\x26\x8a\x63\x59\x7e\x61\x38\x7c\x1d\x11\xc1\x20\x8a\x11\x21\x5e\x04\x6f\x7d\x8b\xa2\x30\xf3\x72\x7d\x39\x25\x8c\x23\x4e\x6c\x08\xbb\x62\x05\x03\x05\xe1\x46\x5e\x72\x35\xde\x1a\x15\xb6\xe9\xd2\xf5\x40\xbb\x74\x3d\xc9\xdf\xd3\x09\xb6\x02\x64\x8f\xca\xed\xea\x9a\x90\xac\x8d\x77\x53\xb7\x63\xbb\xc1\x56\xbc\xfc\xae\x42\x98\x59\x06\x63\xab\xad\x05\xff\x8a\x6d\xcf\x57\x5b\x04\x6a\x6f\x36\x32\x7c\x49\xc7\x60\x7f\x26\x23\x8d\xd2\xf6\x33\x19\x63\x70\x3c\x44\x7c\xeb\xd7\xb2\xa1\x4b\x46\x58\x9d\xe9\xc5\x13\x9d\x3e\x74\x89\x95\x7d\xbe\x65\x41\xac\xe6\xd0\x61\xe8\x5d\xe4\x62\x8d\x66\xb6\xff\x5a\x87\x74\xdf\x31\x1c\x86\x62\x31\xba\x15\xbf\xa7\x44\xa7\xc9\xef\xff\x8a\xc5\x6a\xc7\x62\x21\xc5\xab\x1d\xa2\x21\x71\x2a\x15\x10\x42\xc1\xb6\x63\x89\x6f\x98\x19\xc5\x80\x48\x3a\xff\x76\x5b\x6a\x44\x73\xcf\x5c\x07\x43\x6e\x6a\xc5\x6a\x51\xb4\x5a\x2b\xb6\x00\x49\x6f\x03\xec\xff\x8c\xa6\x38\x2c\x29\xe9\xe7\x27\xb0\x36\x24\x65\x66\xa3\x01\xf6\x5d\x05\x5d\xfd\xa8\x13\xc2\x7b\x3e\x61\xe5\x9b\xac\x98\x89\x14\x71\xbc\xf7\xa7\xef\xdf\xbf\xbd\xff\x9a\xd8\x1b\x30\xf4\x31\x19\x8f\x69\x51\x76\xe8\xfb\x73\xe3\xe8\x42\x93\xc8\x75\xd7\x2b\x27\x24\xcd\xa1\xe7\x49\x09\x8c\x17\x53\xa0\x66\x05\x17\x43\x1a\x04\x82\x7d\x1e\xa3\x90\x1f\x9a\xe8\xb9\xee\x3e\x69\xcc\x9e\xfe\xf3\xef\x5b\x35\x1a\x9b\x7b\x8f\xe6\xde\xa3\xb9\xf7\x68\xee\x3d\x32\xef\x37\xf7\x1e\x77\xb9\xf7\x10\x9f\x8c\x03\xf1\x51\x91\x40\xbd\x3c\xd1\xc4\x07\x96\x2f\x4e\x95\x39\xd3\x62\x58\x70\xfa\x36\x53\x65\x33\xa9\xc3\xd4\x03\xad\x31\xc7\xf7\x17\x35\x28\x90\x29\x00\x48\xcb\xdf\xe6\x61\x05\x9c\xfa\x1c\x0f\x64\xd8\x2d\xb8\xe0\x54\x57\x95\x73\xf3\x1a\x5e\x02\x1a\x83\xa4\x93\x19\x4c\x4e\x76\x8f\xaa\x8a\x4b\x6f\x50\x0c\x8f\x83\x34\x55\x1d\xde\x23\xe4\x10\x4d\xed\xbf\x3b\xc5\x86\xeb\xf4\x10\x39\xff\x0b\x4a\x39\xe3\x21\x0a\xb2\xd7\x3e\x77\xb8\xf4\x51\x83\xaf\x51\x52\xfe\x8d\xc6\x25\xbb\xf7\xff\x69\x1d\x7a\x37\x68\x8c\x2a\x65\x40\xa9\x2d\x2a\x64\x42\xc8\xd7\xdf\x8d\x2d\x55\xba\xad\x7a\xca\x92\x39\xd6\x53\x59\xfc\xd1\xa1\x45\x9d\xa9\xaa\x1d\xd7\xee\xf7\x5e\x12\x66\x47\x4c\x68\x86\xec\x1c\x0d\xf0\x6c\xac\x86\xc8\x4f\x3c\xd8\x12\x91\x35\x7e\x8f\x3a\xe8\xb6\x62\x6a\x50\xbc\xd9\xd7\x34\x8c\xbc\xc2\x9b\xdd\xf7\x28\xf4\x09\x1b\x6a\x8c\x52\x64\x0f\x71\x5c\x97\x5b\x47\xb9\xef\x09\x92\x62\x41\x8d\xdb\xee\xf7\x00\x84\xed\x78\x96\xb9\x5a\x0c\x61\x27\xb3\x4b\xad\x8f\x46\x02\xc7\x62\x14\xf6\xfc\x81\x71\x24\xa3\x0c\x0b\x9e\x0f\x96\x43\xb8\x33\x0f\xd7\x45\x78\x67\x1e\xff\x48\x19\x8f\x11\x52\x3d\x94\x61\xab\xc2\x4b\x6a\x3d\x31\x54\xb6\x00\xba\x70\xef\xb0\xaf\xab\x0b\x5d\x45\xae\x8f\xc5\x70\xc6\x90\x70\xb2\x59\x4c\xc7\x56\x11\x21\x81\x29\xe4\xba\x98\xde\x6a\x16\xbf\x85\xaa\xbd\xf1\x48\x87\xc4\x1b\xa8\x60\xd7\xa4\x08\x13\x6c\x7d\x3e\x90\x45\x08\x89\x3f\x80\xb0\x3b\x16\xd0\x68\xea\x31\xc5\x63\x95\xf9\xea\xee\xd8\xa3\xb7\xc5\xd8\x23\x0e\xa6\xff\xd6\xa4\x77\xc9\x97\xc0\x79\xd6\x01\xb7\x71\x9c\x43\x92\x17\x37\x16\x63\x1a\x37\xec\x00\xb6\xb4\x5e\x9a\xaf\xd8\x57\xa5\xbf\x7c\xcc\x4d\xdb\x94\x9f\xd6\x70\x17\x25\xc7\x2d\xae\x25\xf9\x9e\x0c\x7e\x26\xbe\xa6\xc9\x5f\x8d\x45\xa2\xe3\x19\xda\xfd\xde\x17\x79\x63\x6f\x9a\xf2\x9f\x31\x19\xb8\xc4\x1f\xc5\xb5\x4f\x50\x40\xcc\xd9\x78\xe0\xfa\x2a\x4d\x67\x9b\x15\xd7\x25\x96\xe4\x5c\x1a\x2c\x59\x77\x8d\x8b\xce\x25\xa4\x75\x75\xea\x55\x54\xc2\x58\x4c\xab\x70\x30\x83\xb0\x3b\xa0\xc1\x90\x72\x3a\xc1\x96\xfa\x9b\x36\xd3\x72\x93\x51\xee\x6e\xc4\x89\xe9\x34\xc8\xf4\x31\xe7\x5b\xaf\xe0\x99\xe0\x53\x22\x50\x1c\xc8\x81\x6f\xf4\xf1\x2d\xdf\x41\xdc\x51\x22\xdf\xe2\x95\x15\xaf\xde\xc3\x1a\x52\xda\x02\x77\x6a\xf7\x7b\x87\xed\x7e\x2f\x40\x21\xf2\x66\x32\x12\x51\xa5\x64\x8d\xc5\xec\xf1\xa9\xf2\x28\x1d\x20\x97\xd2\x60\x16\xd7\xe1\x13\x6f\x04\x72\x56\x49\x35\xe9\x9b\xdb\x52\xac\xb5\xe7\xd0\xc3\x1a\xc2\x09\x79\x84\xcf\x6a\x4f\x00\x71\xc8\x58\xd9\x1a\x63\xef\x0c\x11\x5f\xd8\x18\x75\x9b\x16\xff\xa6\xd4\x73\x4b\x5a\xb0\x55\x33\x06\x3f\x1a\xc7\xd4\xe7\xd8\xe7\xc6\x89\x6f\x53\x27\x35\x16\xd4\x9c\xdf\x80\x54\x75\x2d\xef\x37\x17\xe4\x95\xc8\x3f\x89\xa9\x49\x88\x82\x00\x87\x57\x21\x6c\xcd\xe6\xa9\x80\x99\x1e\x88\xed\x7e\xef\x1a\x63\x67\x66\x53\x2f\xa0\x3e\xf6\x39\xeb\x98\x36\xf5\xae\x66\x87\x1c\x59\x2e\x5e\x90\xa4\x01\x71\xdd\xce\x96\xbc\xef\xcb\xf9\x1f\xf1\xa2\x85\xf6\xde\xe4\xfd\x35\x79\x7f\x79\x9f\x26\xae\xdd\x1b\x49\x2e\x55\x6e\x4c\xc7\x92\x49\x79\x28\xe4\x85\x97\x8a\xe2\x2d\xca\x34\x82\x60\xe9\xf2\xbe\x94\x8b\x37\x19\x59\xec\xe8\xdf\x8d\x1f\xd6\x94\x41\xb9\x5b\xc5\x95\xe3\x85\x9e\x4f\xe9\x6f\xe0\x6f\x36\x0d\xa6\xff\x03\x20\x74\xfe\xf3\xfd\x1f\x40\x5e\xbe\x13\x7f\x04\x2e\xe8\x35\xcf\xb4\x4e\x02\xa0\xf5\x13\x9e\x4e\x68\xe8\x30\xf9\x7d\xfa\x5e\x02\x36\xb8\x08\x50\xf8\xbb\x9b\x9f\x9f\xa5\x57\x3f\xd4\x40\x5d\x79\xe1\x4d\xfc\x11\x9b\x14\xbb\x94\x09\x8b\x90\x0b\x3e\x60\xeb\xf5\x2f\xa7\xf7\xe7\x2f\x95\x8a\x2d\x84\xdd\xc9\xe0\xb6\x28\x4e\x67\x25\x91\x79\x11\xfc\xea\x82\xa7\xb9\xdd\x6d\x6e\x77\x9b\xdb\xdd\xe6\x76\x37\xf3\x7e\x73\xbb\x7b\xd7\xdb\xdd\x01\xf9\xea\x51\x75\x93\x19\x84\xd4\x89\x6c\xce\xcc\xb1\x64\xd4\xc6\x04\x5b\xc6\x20\xca\xaf\x20\x3a\xe7\xe7\x62\x5c\xf0\x36\xe0\xc4\x23\x5f\x75\xc5\x68\xef\x9a\xb3\xf3\x8d\x61\x80\x76\xbf\x77\xc1\x51\xc8\x67\x27\xbe\xd3\x01\x79\x70\x80\xa3\xfe\xc5\xd4\xb7\x87\x21\xf5\x69\xc4\xc0\x31\x75\x30\x30\x0c\xad\xc3\xcb\xc1\x63\x69\x53\x8a\x41\x63\x9c\xd1\x64\xc8\xad\xbb\x09\xa5\x1a\x30\xa1\x25\xc4\xfe\x39\xe2\x9a\x0b\x82\x9a\x72\x7d\x14\x26\x02\xc4\x87\x49\x9e\x8a\x74\x9e\x32\xcc\x21\xec\x12\x2e\x9d\x60\x07\xaa\x17\x8c\x49\x7c\xdb\x8d\x64\xef\x91\x3a\xda\xec\x25\xf3\xaa\xc3\x87\x2d\x03\x25\x2b\x36\x58\xac\xbb\x99\x73\x68\x73\xd1\x49\xdd\x48\x08\x73\xd0\x7e\xff\x0f\x4d\x59\xe0\x6d\xf4\x8f\x55\x1e\xeb\x85\x1e\x51\xe6\x98\x05\xd7\xc4\xc5\x2c\xbf\xb5\x7e\x3c\x82\x39\x8e\x81\xcd\x5c\x4a\x27\x99\x43\x5b\xbc\x92\x6e\x34\xab\x46\xb3\x6a\x34\xab\x46\xb3\xca\xbc\xdf\x68\x56\x1b\x6b\x56\xc9\x37\x09\xef\x2e\xf4\x13\xcf\x85\xd1\x77\x35\x0b\x23\x19\x97\x22\x85\x91\xcc\x65\x1d\xfb\x46\x80\x06\x58\xb5\xcd\xce\x13\x43\x5b\x92\x2f\x47\xfe\x20\x72\x51\xf8\xef\x8b\xfd\x48\xe8\x6d\xf7\x7b\x0e\x19\xcf\xc4\x33\xe5\xf1\xf5\x07\x06\x0a\x82\x67\x8b\xc2\xe7\x50\xfd\x55\x2b\x22\x32\x9f\x20\xb5\xce\xca\xf9\xbd\xed\x7e\xcf\xe8\x87\xf6\xa7\x2e\x84\xce\xe7\xc7\x9d\xc7\x1d\x33\x1e\xa9\x38\xe3\x37\x7e\x69\xb7\x39\xbf\xf1\xa4\x15\xf3\x31\x4b\x9f\x87\xa8\x52\x15\x88\xed\x94\x8b\x55\xe7\xa1\xdd\xef\x45\xb8\x63\x68\xb3\x16\x22\x5c\x62\x7f\x5b\x4b\x72\xd4\x1c\x47\x35\xf7\x58\x1e\x47\x9a\xf2\x11\x6f\xa2\x5b\xbe\x53\xdc\x3a\x64\xac\xf8\x8d\xbc\x76\xb9\xba\xf2\xa3\x5b\x0e\x5b\x73\x14\x1d\x2a\xe4\xaa\x77\x9e\x2b\x96\x0f\x61\xf7\xea\xea\xcd\x2f\x1f\x2f\xaf\xae\xb4\xba\xb1\x1c\xd0\xdc\x96\x0e\xbc\x40\x79\xf9\x18\xd2\x61\x69\xf9\x04\xdd\xb1\xe2\xc0\x22\x55\x2e\x0f\xbe\x9e\x0b\x95\xa3\xd0\x6a\x54\xba\x3c\xf3\xb6\x23\xeb\x16\xa8\x17\x94\xe1\x28\x62\xf3\x8b\x09\xfe\xc3\x53\x20\x5b\x1b\x03\x15\x78\x56\x48\xf7\x55\x82\xeb\x96\xad\x92\xc5\x79\xca\xb5\x16\x4a\x76\xfa\x1b\xc3\x90\x9b\xbd\x04\x6b\xcd\xf2\xf8\x03\x0d\x9d\xf3\x10\xb3\x92\xe4\x9e\xcd\xfe\x95\x7f\xc8\xd4\xcc\x5f\xde\xda\x1c\x7b\x4f\x58\xc2\x62\xe6\x10\xbb\xcf\xe0\x5c\x96\x04\x04\xc2\xae\x38\xf4\x34\x1c\x98\x49\xd9\x95\x65\x72\x5a\x7b\x44\xa4\x69\x2d\x46\xfb\x04\x5b\x8f\x3e\xcb\x4a\xae\x6c\x88\x31\x97\xbf\x02\x55\xd5\x65\x12\x18\xed\x7e\xcf\x56\x37\xca\xb3\xd8\x37\xc1\x3a\xe6\x92\x9c\x8f\x6f\x87\x1f\xab\x3b\x5d\x39\x00\xf2\x02\x63\x12\x18\x4b\x2f\x26\xd1\x2f\x07\x0c\x42\xe7\x40\x40\x1e\x64\x1d\x42\xcb\xa8\x58\x7f\x3a\xf3\xa1\x2a\x77\xd0\x56\xae\x55\xd2\xcd\x04\xda\x68\xda\xe6\x96\x78\xff\x8c\xd8\xc2\xd1\xca\xdd\x12\x3f\xd1\x6f\xe6\x72\x1a\x36\xd0\x6f\xe7\x9a\x74\xec\x75\x20\xe6\x6d\xe1\xc3\x46\xf3\x4a\xfa\x36\xa8\x64\x31\xd2\xd0\x09\xc4\x91\xac\x9a\xbf\x3c\x79\x6a\x60\x67\x80\x95\x0d\x9c\x0c\x62\x04\x6e\x34\x20\x3e\x33\x27\x4f\x0d\xd9\x21\x5f\x05\x25\xe7\x8b\xbd\x63\x1a\xf9\xda\xa6\x70\x35\x79\x8b\x27\x4f\x6d\x35\x7b\x1c\xb9\x55\x5f\xf7\x71\x85\xaf\x78\xb6\x22\x9b\xe2\xc3\xc9\x8b\x8f\xe7\x47\xbf\xd6\x6a\x5d\x9f\xaf\x56\x83\x85\x59\xe3\x47\x08\x0b\x6c\xdd\x06\x68\x1a\x37\xd1\x6d\x3d\x8f\xc1\x3a\xdc\x41\xc4\x5a\x5c\x7d\x48\x4c\x5f\x88\xa8\x1f\xcf\x8e\x35\xd1\xc8\xdb\xae\x73\xbf\x7d\x8a\x18\x7a\x76\x61\x14\xf1\x87\x73\xf0\x8e\xda\x23\xac\x09\xcc\xbb\x93\x42\x98\x4c\xb1\xac\x0b\x3e\x99\x7f\xa1\x5e\x30\xde\x0c\x88\x7f\x6b\xbc\x98\x06\x88\xb1\x52\x6d\x88\x53\x85\x31\x5d\x46\xa3\x2b\x36\xba\x22\x58\xdd\xe4\xf8\x6f\x8d\xae\xb8\xf8\x6a\xa3\x2b\xce\x7f\x69\x74\xc5\xf9\x4b\x0f\x4c\x57\x0c\x8c\x50\x72\xff\xae\x97\xaf\x07\x9e\x83\x13\x7f\x40\x7c\x8d\xe7\xe3\x1c\x21\x7d\x95\xfc\x49\x80\x0d\x4b\x28\x72\xfe\x2e\xb2\xd2\xd4\xa4\x8d\x2c\x6a\x64\x51\xfc\x61\x23\x8b\x1e\x3c\x93\x6c\x64\x11\xf8\x33\xca\x22\xf9\x55\x80\xa5\x9c\x29\xb4\xba\x50\x18\xe8\x23\xd6\xab\xb7\xf4\xff\x22\x06\x36\xdb\x82\xaf\xa9\x8b\x0b\xf1\x53\xe7\xa0\xd3\xcf\xeb\xde\x52\xb3\x84\xfa\x11\xb1\x11\x76\x4b\xf6\x53\xfb\xd3\xd6\xf7\x98\x90\x11\xe9\x0e\x15\x2a\xa4\x88\x4c\xd0\x52\x56\xbd\x99\x4c\xba\x8c\x23\x7b\x84\x06\x32\xf7\xde\x0c\xd4\xcf\xe6\x44\x90\x51\x1e\x89\x61\xeb\xbb\x60\xba\xbd\xb4\x88\x35\x0c\x7a\xa2\xa6\xa8\x46\x59\x73\x2c\x7d\x17\x4c\x75\x7e\xb0\x55\x29\xb9\x66\xca\x72\x15\x40\xa6\x7c\x48\xfd\xdd\x17\x01\x69\xf7\x7b\x5f\x66\x10\xb2\x8e\x02\x20\x37\x9e\x6c\x7f\xa9\x37\x90\x80\x97\x2d\x6a\xde\x34\x5a\xcf\x0c\xfe\x80\x1b\xad\x2b\x17\xe9\x77\x41\xb1\x87\xb4\x44\xea\xd5\x2e\x8a\x87\xae\x63\x13\xaf\x7f\x39\x5d\xce\x8f\xad\xc6\x30\x70\xb8\x1b\xb9\xb5\xc0\x2e\x20\xb4\xc4\xc4\x10\x5a\xed\x7e\x0f\xf4\xcd\xfe\xb8\xff\xd0\xd8\x05\x0e\x2b\x77\x5c\x9c\x60\x6b\x10\x91\xc2\xe0\x05\x6c\x9d\x47\x96\x4b\xd8\x50\x77\x91\x73\x1f\xf4\xf6\xe1\xe4\x05\x84\xb3\x39\x80\xb9\xd4\xa6\xc1\x05\xb3\x91\xef\x63\xde\x75\x46\x05\x78\xb8\x20\x1c\x83\x8f\xff\x2c\xd7\x58\x69\xd7\x98\x38\xf5\x6d\xea\x61\x87\x20\x30\x87\x14\xb4\x21\x9c\x1c\x00\xfd\x59\xd4\xf3\x23\xf1\x97\xdb\x7f\x6a\x58\x92\x83\xc7\x5b\x40\x8e\x52\x8f\x5e\xe2\xf1\x45\x68\x97\xbd\x8a\x00\x9f\x90\xf1\xf5\xc8\xf8\xed\xef\xc6\x0f\x57\x9f\xd5\x8a\xcd\xf8\xdf\xde\xe7\x03\xa0\xc6\x03\x10\x3a\x10\x3a\x73\xe6\x54\x98\x85\x53\x85\xe9\x9d\xbc\x78\x79\xf2\xfe\xdb\xcd\xf0\x9b\x44\x25\x4e\x88\xef\xe0\x71\x92\x52\xe2\xe0\xb1\x29\xfe\x70\xdb\x95\xcb\xcd\xc7\x39\xb9\xad\x3f\x90\x2e\x0f\x45\xf1\x14\x10\x5a\x13\x01\x48\x1d\x77\xab\x62\x5c\x0d\xd5\x79\x5a\xd7\xe2\x71\x38\x0d\x38\x15\xef\x85\x1b\x86\x10\xce\x8b\x7d\x1c\xb0\xd0\x7e\xf6\x09\xc2\x47\xb0\xf5\x39\x73\xa5\x29\x06\x87\xb0\x6b\x7f\x35\xe5\x34\x10\xf6\x47\x78\xfa\x6c\x77\x57\xaa\x9e\xb4\x7b\xbf\xe6\xe6\x6e\x7d\xc0\x96\x26\xe2\xb2\x4c\x93\xd2\x4c\x96\x00\xb6\x58\x7c\xb3\xae\xe4\x24\x84\xdb\x4d\x9b\xdf\x90\x4d\x69\x68\x85\x95\xb8\x71\x5d\xd3\xf0\xa7\x32\xbd\xa4\x9e\xce\xd4\xc3\x3a\xc1\x96\xa1\x66\x97\xbf\x66\xa2\x4f\x93\xd2\x1f\x48\xc5\x80\xab\x82\x46\xf2\x13\xc6\xfa\x3d\x33\x3f\x37\x6b\xbb\x44\x84\xfd\xae\x30\x97\x03\x21\x43\xa4\xad\x2b\x7e\x33\x85\x30\x49\xaf\x77\xf3\x90\x7a\x2e\x90\xa5\xbb\xc7\xbe\x0f\xed\x60\x0e\xdb\x5d\x68\x2a\x90\x43\xe8\x28\x0b\xb9\xdb\xa9\xaa\x7b\x7f\x67\x07\xb9\xb8\xeb\xe4\x5f\xe3\xc4\x69\xad\xc7\x21\x96\xf8\xdd\xcb\xdd\xce\x00\x08\xac\x29\x18\x52\xc6\x39\xb6\x87\xf3\xd3\x36\x61\xf6\x55\x88\x7d\x07\x87\x1e\x75\xd6\x96\x78\x6c\xca\x9a\xa6\x4f\xef\xbb\xac\x69\x81\xb7\xa3\x69\xe7\x52\x02\x49\x4d\x2a\xc1\xc3\x4a\x25\x10\x66\x40\xc2\xb2\xba\xf6\x30\xb1\xbb\xec\x98\xe3\x16\x33\xe6\x17\x68\xb4\x97\x16\x7a\x16\xba\x86\xd1\xee\x07\x17\x69\x18\xed\x06\x8c\xb6\x84\x06\x45\x38\xb6\x04\xa1\x7f\x27\x15\x68\xec\x9b\x43\xea\xe1\x6e\x30\x2c\xba\x2b\xe2\x21\x1e\xe9\x6a\xc9\x6e\x39\x44\x3a\xff\xf4\x6e\x1a\xec\x1a\x2f\x43\xa3\x23\x73\xa1\x79\x69\xcc\xd0\xbb\x96\x65\x21\xde\x60\x5e\x62\xf1\xe5\xf1\xc5\xe9\xd9\x6b\xd8\x92\x7f\x99\xa4\x00\xec\x06\x2f\x34\x74\x9d\x09\x71\x70\x37\x9d\xb8\x18\x3d\xd8\x72\x35\xb7\x86\xdb\xe3\xdf\xc9\x59\x76\xfc\x38\x12\x07\x3b\x84\x7b\x53\xb1\x84\x8d\x4a\xcb\xa8\xf1\x1a\xfe\xdd\xf0\xef\x7d\x41\x51\x35\xfe\x9d\x3a\x61\xe5\x29\x2c\x3e\xa7\x03\x97\x6e\xcf\x83\x04\x92\x11\x8d\xcb\x10\xf9\xcc\x45\x1c\x6f\x16\xd9\x98\xec\xae\x2c\x91\x3e\x91\x43\xc6\x27\x79\x6e\xfb\x06\x46\x1c\x35\x67\xa6\x99\x3c\x6a\x35\xdb\x63\x7c\x73\x44\x8a\x81\x35\xf2\xe0\x6b\x89\x6e\xaf\xdb\xe7\x77\xdd\x7f\xf1\x21\x84\x5d\x12\x56\x0c\x7f\xf8\x4a\xee\xe0\xe0\x17\x9f\xc7\x53\xae\x45\x06\x19\x11\x7f\x30\x08\xa3\x20\xc0\x9a\x0e\x44\x77\x2d\xbf\xf1\x8d\x61\x80\x0f\xa7\x3f\x9d\xbe\x79\xfd\xfa\xdd\x2f\xe7\xe7\x27\x6f\x76\xe5\xa4\xce\x2e\xb1\xcb\x70\xbe\xab\x9a\x8c\x46\x48\x20\x44\x13\x42\x43\x46\x64\x43\x25\x61\x6d\x92\x94\xca\x66\x49\xe7\xae\x8e\x94\x15\xcb\x67\x71\xac\x4d\x54\x08\x31\x82\x8c\x61\x2a\xba\xa0\x8d\x6b\x0a\x1d\x9f\x14\xe3\x2b\xa7\x6e\x50\x29\x6f\x3f\x84\xd6\x07\xe2\x1f\x9f\x40\x68\xdd\xa7\x83\x7f\xa1\x36\x8f\x0e\x1f\x0b\x35\x96\x6a\xc0\xc9\x4a\x9d\xa4\x3d\xc0\xc9\x6a\xbd\xa2\x1c\x0c\x69\x0c\x8e\x33\x6a\x11\x17\x67\x1d\x41\xa9\x10\xd9\xc1\x7d\x62\xbb\xdf\xbb\x12\x7a\x88\x79\xc3\x4c\xf1\xef\x6c\x42\xfc\x51\x67\xa1\xba\xcc\xd6\x94\x74\xe2\x8f\x38\xa5\xee\x88\x70\xcd\x19\x63\x9c\xfa\x58\x12\x95\x8b\x39\x38\xa6\x3e\x47\xf2\x3e\xb1\x98\x47\x6d\x14\x4b\xba\x32\x5b\xbb\xdf\x4b\x27\x9c\xa9\x5c\x8a\x0e\x18\xaf\xd3\x70\x57\x53\x00\xe3\xb1\xda\xfd\x5e\x57\x5f\x0d\x62\x27\x14\x3b\x89\x41\xea\x32\x1a\x85\x36\xbe\xa6\xe1\x00\x17\xd5\xfd\xfe\xa0\xbb\xe2\x2e\x73\x73\xf9\xd1\xf8\x40\x6e\x8d\x77\xd2\xf3\x8f\x43\x23\x45\xf6\x52\x0f\x28\xf5\xd2\xef\x11\x66\xdc\x38\x75\xd6\x3f\x57\xdf\x1a\x47\x21\x27\xd7\xc8\xce\xbc\xb7\x11\xb1\x33\x8e\x38\xb1\x85\xce\x46\x6e\xd3\x9f\xb7\xde\x02\x68\xa2\xb9\x45\xa7\xee\x35\x98\x67\xa9\x6c\xbb\xbd\x48\xbb\xdf\x5b\x92\xb3\x13\xea\x5e\xdb\x1e\x53\x89\x2b\xaa\x91\x73\x02\x83\x78\xd9\x44\xcf\x3b\x7d\x40\x7c\x46\x1c\x3c\xbb\x1c\x22\x7f\x04\xa6\x34\x02\xd7\x34\x04\x91\xec\xa8\x93\x74\x7f\x4e\x3e\x6a\xfa\xd8\xfd\x29\xec\x58\x1d\x15\x0b\xc3\x42\x51\x4e\x21\xb7\xa6\x2e\x77\x91\x05\x8e\xa9\xe7\x45\x3e\xe1\xd3\xb9\xa4\xaa\xb1\x58\x7d\x3c\xc6\x18\x85\xe0\xc3\xf1\xab\xab\xf3\xa3\xcb\x1f\x25\x8d\xe6\x52\x66\x32\xe9\xf1\x2b\x08\xbb\x9b\x49\xb7\x86\x5c\x1f\x00\xb9\x0a\x5a\x2c\x66\xbe\xf4\x38\x31\xe9\xea\xb7\xfc\x56\x26\x5b\x48\x06\x7c\xf4\xe9\xcb\xa3\xcf\x07\x8f\x00\x71\x9e\x3d\x9a\x50\x9a\x4c\x68\xb4\xfb\x3d\x17\x4d\x69\xc4\x67\xcc\x43\xae\xcb\xec\x10\x63\x7f\xa6\xcc\x1d\xb7\x63\xd8\x8c\x3d\x02\x8a\xc1\x3f\x92\xf6\x5e\xbf\x67\xc6\xf5\x98\xd7\x39\x1d\xe6\x03\x9b\x88\x31\xcc\x99\x69\xb3\x85\x3f\xb7\xfb\x3d\x23\x9e\xcf\xc8\x4c\x28\xaf\xef\x6c\xc6\xfa\x63\x1c\xce\x03\xb9\x1f\xe9\x4e\xd8\xd2\xc8\x0b\x57\x81\x69\x84\xf8\xb3\xb4\xf5\x47\x61\x39\xe3\x15\x23\x6f\x8e\xcf\x3b\x05\x3d\x37\x19\xa5\xd9\xa1\x9a\x8c\x52\x90\xf9\xaf\xc9\x28\x5d\x0b\xe9\xbe\xa4\x3a\x36\x19\xa5\x60\x17\x68\xbe\xaf\x4a\x58\x94\xf2\x21\xf6\x30\x8b\x6b\x61\xd1\xac\x10\xce\x11\xe7\x41\xa8\xeb\x45\x5b\x4f\xcd\xab\xb9\x2d\x29\x61\xa8\xc5\x90\x54\x28\x09\xc2\xc2\x86\xb2\x1f\x28\x65\x1a\x14\x64\x25\xd8\x0e\x43\x23\xd7\xf0\x6a\xca\xd0\x5d\x42\xf7\xc5\xf7\x5d\x3f\x3f\x44\x7f\x49\xb0\x97\x41\x47\x46\x98\x17\xb8\x34\xaa\x08\xf0\xc2\x40\xe5\x3b\x0b\xed\x52\x02\x5b\x2b\xac\x8b\x14\xb8\x42\x01\x5d\x76\xa3\x73\x85\x72\x63\x5a\xad\x40\x74\xdf\xa6\xd5\x93\xfc\x0d\x68\x6e\xb4\xcb\x88\xaf\xb5\x42\xaf\x90\x41\x81\x8b\x28\xc0\xe1\xb6\x8b\x14\x7f\x38\x37\xe4\xb8\x0b\x5d\xf7\x8b\x79\xd2\xbc\xb4\xdc\xc2\xa7\xd5\x99\x7d\x63\x81\x35\x16\x58\x63\x81\x35\x16\x58\x63\x81\xcd\x5f\x7a\x58\x16\xd8\xd7\x1f\xba\x84\x0a\xee\xc0\xa4\x20\x90\xf5\x86\xf3\x03\x47\xe8\xe4\x2b\x02\x67\x32\x09\xba\xcc\xed\x7f\xf6\xcd\x4d\xc3\x4d\x39\xe1\x2e\x7e\xbe\x3a\x33\x10\xdc\x10\xb4\xa5\x7b\x71\x72\x00\x4e\x1c\xc2\x09\xf5\x41\xa7\x1f\x73\xc9\x34\x65\xb2\xdd\xef\x01\x2b\x22\xae\x23\x0b\x06\xf5\x97\x9d\x8f\xb5\x46\xed\x0a\xa8\x8b\xcc\xb8\x8f\xc6\x71\xdd\x8d\xe3\x33\xa1\x38\x6a\x36\xd9\x65\x5c\xe2\xa2\xd3\x4f\xae\xf9\x32\x65\x8c\xd3\x2a\xc6\xb7\x86\x8d\xc2\x38\xc4\xcd\x54\xb7\x8a\x8f\x9f\x67\x8c\x8d\x85\x2f\x55\xbb\x3b\xe5\xeb\x9d\xfd\xad\x73\x2b\x3e\xbd\xba\xa6\xa1\x77\x45\x9c\x67\x9f\x90\xf1\x15\x42\xe7\xf3\x7f\x9e\x7e\xf7\x47\xbb\xdf\xfb\xdb\xec\xdb\xfc\xdb\xc4\x78\x74\x93\x8d\x88\x6f\x8a\x95\x53\xff\x4a\x75\xd0\xf3\xa8\x13\x89\x7f\xcf\x55\xf3\xca\xab\xb7\x81\xd8\x70\x66\x5e\x47\xbe\x5d\xb5\xd8\xce\x2a\x26\x1a\x3b\x65\x4f\x94\xf0\x8a\x57\x40\x8a\x4a\x0b\xcf\xd8\xd1\xd9\xf9\xf9\x96\xa2\x48\x34\x5c\x4a\x4e\x25\xa8\x2a\xee\x75\x08\x32\x89\xdb\x9d\xfe\xa1\xa9\xde\xda\x2c\xf2\x0d\x45\x7c\xa8\x88\xf7\x27\x44\xc0\x5b\x36\x41\xae\x03\x2e\x30\x71\xdc\x8d\xd2\x7e\x8e\x82\x8c\x15\xa2\xa1\xdf\xb5\xad\x08\x2b\x51\x6d\xbb\xdf\x53\x13\xb6\xfb\xbd\x6f\x67\xf3\xec\xb1\xd9\xa7\x2f\xa6\xf1\xb9\x33\x8b\x4b\x49\x59\x9d\x1f\x2f\x2f\xcf\x5f\x76\x1e\x02\x9d\x22\xb9\x9e\xb2\xb5\xa3\x1a\x93\xba\x04\x92\x1a\x86\xba\x0b\x86\x5a\xb4\x01\x4d\x61\xa8\x9d\x15\x86\x52\xfc\xe3\x3a\x24\x32\x6f\x29\xce\x66\xbb\x45\x5e\x10\x14\x16\xa4\xf9\x78\xf6\x42\xa7\x73\x33\x86\x06\x18\xbc\xa0\x28\x5c\xce\xc4\xaa\x14\xc8\x90\x55\x1c\xcf\x5e\xec\x4e\x69\xbe\xf5\xac\x6b\x1a\x46\x5e\xa1\x4c\x7f\xfb\xf6\x7c\xeb\xb1\x74\x1b\x89\xe5\x45\xb5\x52\x82\x55\x5d\x18\x37\x8c\x6f\x1f\x34\x49\x4a\x83\x42\x3f\xee\xc7\x77\x78\x70\x72\xab\x29\x6e\x9b\x5f\xf8\x71\x5b\x77\x90\xb7\x21\x1e\xe0\xdb\xa0\x4c\x6b\xca\x4c\x65\xc7\xf8\xab\xdc\xd2\x8e\xc9\xb0\xb5\x04\x81\x27\x63\x17\x9d\xe8\x5a\xf3\x51\x0e\xc5\x1f\x84\xbd\x2a\xeb\x23\xdc\x4e\xc8\x88\x48\x0f\xf8\x86\xba\xb8\xf8\x5e\x00\x2d\x07\xda\xe0\xbc\x0b\x22\xf9\x8b\x17\x0e\xbe\x41\x63\x54\x29\xf9\x5b\x48\x06\x5d\xa6\xce\x47\x74\x83\xca\x57\x6b\xab\xeb\x7c\x0a\x20\xae\x6c\x1a\xe2\x7a\xce\x92\x18\xde\x08\x42\x7a\x83\xed\xc2\x94\x8a\x8f\xc8\x47\x21\xa1\xfb\x52\x32\x69\x59\x56\xde\x2a\xf0\x00\x1b\xd2\x80\xd1\x6b\x3e\x41\x21\xde\x38\x8f\x2b\x1e\xac\xa0\xb8\xd2\x47\xec\xbf\xa2\xa1\x06\x1b\xdb\xd0\x9d\xda\xfd\x9e\x2a\x14\x0c\x61\x17\xdf\x72\xec\x3b\x10\xb6\x79\x18\xe1\x27\x20\x86\x61\xf6\x4a\xa8\x37\x20\x59\xb4\x54\xb0\xd4\x93\xff\xff\xff\xfd\xff\x66\x42\xf7\xfa\xf8\xaa\x67\x85\xc8\x77\x88\x3f\x98\xa5\xac\x4b\x25\xdc\xc7\x6f\xc2\x56\x27\xe3\x8a\x13\xaf\x00\xf5\xf8\x15\x6c\x81\x26\x54\xff\xcf\xa0\xae\x34\xde\x84\xbb\x97\x0c\xb9\xc5\xfe\x35\x0d\x69\xa1\xe2\x81\xa9\x2e\x98\x6c\x29\x6b\xe1\x49\xf6\xef\x89\x5f\x2c\xfd\x63\x01\xcd\x17\x5c\xe2\xcf\xe9\x5c\xc2\xb3\xd0\xce\x67\x0d\x7d\xcb\x97\x8e\x23\x0b\x6b\x82\x8f\x88\x1f\x44\x1c\xf0\x69\x80\x9f\xc1\xd6\x90\x38\x0e\xf6\x61\x0b\x88\xa5\x3f\x83\xad\x2b\x36\x65\x57\x16\xf1\x9d\x2b\x79\x65\xd2\x52\x1c\x64\xe5\xaf\x66\xbe\x92\x14\x4f\x63\x5e\x59\xe4\x8a\x05\xec\x6a\xdc\x3d\xa8\xa9\xfd\xe3\xad\x58\x70\xa1\xb4\x23\x1c\x79\x3a\x05\x72\xa3\x64\x41\x35\x72\xb9\x66\xcf\xdb\x61\xbc\x3a\xa2\x96\x00\x15\xd2\x34\xf5\x89\xbd\xab\x5b\xa5\xc5\x2e\x98\xf3\xeb\x23\x01\x83\xc1\xa8\x1b\xc9\x6b\x1a\x08\xbb\x0e\x56\x05\x77\x21\xec\x06\xc3\x00\xb6\x00\x47\xe1\x00\x73\x41\x73\x96\x8b\xfc\x11\x6c\x3d\x5f\xfa\x08\x5c\x64\x94\x83\x43\x13\x69\x29\x51\x68\x5d\xe6\x0d\x73\x89\x15\x17\x85\x4f\x00\x91\x64\xa9\x26\x2e\xbe\x26\x1a\xe1\xe9\x84\x0a\xe1\x2f\x54\x94\x45\x68\x36\xd6\x4e\x24\x01\x2f\x8e\x55\xa4\xa9\xc8\x3b\xdc\x32\x0d\xbd\xee\xc5\xc5\x91\x85\xee\x4e\x08\x91\x03\xcd\xfb\xc9\xe4\x5e\x3a\xff\xaa\x2b\xc3\x5f\xbf\x4a\x2f\x0e\xff\x34\x22\xe6\x6c\x1a\x11\x08\xbb\x53\x34\xa4\x14\x05\x24\xae\xcf\xdb\xd9\x2e\xbb\x9b\x46\xc4\x95\xcb\x28\xac\x92\xf2\xeb\x2f\xa7\xe0\x25\xd5\x1c\xf2\x97\xd4\x8e\x3c\xec\x73\x24\xaf\xc6\x4b\x84\x93\x15\xe5\x97\x26\x7a\xe8\x63\x30\x87\x30\xbe\x1d\x0e\x9d\x6b\x33\xe6\x8d\xa6\x42\x51\xe8\x5c\xcf\x0e\x2d\xea\x4c\xa5\xe6\xaa\x62\x9c\x60\x6b\x1a\x91\xa7\x06\x1b\x11\xdf\x60\x68\x19\x6d\x75\x71\x4b\x07\x8f\xb1\x4b\x03\x1c\xaa\x6d\x93\xf0\x8a\xbd\x9c\x46\xc4\xa1\x76\x2e\x7a\xd1\x8b\x1d\xf8\x59\xf3\xcb\x64\x4c\x91\xa5\xbc\xa0\xbb\xf3\xbb\xa6\x53\x16\x92\x9d\xc0\x21\x38\x72\xc6\x38\xe4\x84\x11\x7f\xa0\x09\xc3\x9f\xbf\x08\xde\x60\x7e\x87\xac\xcf\x6c\x81\x70\xe4\xa8\xf2\x0b\xc9\x51\x5c\x2e\xc3\x93\x96\x28\xb3\x5d\x62\x8f\xc4\x29\xb5\x30\x0a\xc4\x3f\xf6\xd2\x27\x9a\x73\x8f\x1c\xe2\x73\x1c\xa2\xdb\x3a\x02\xfd\xd1\x1c\x37\x73\xd2\x2c\xc4\xfb\x37\x60\x2e\xb5\xb7\x21\xdc\xa5\x62\xf9\xf1\xdd\xcb\x0b\xe3\x67\x6a\x4b\x1e\x21\xa6\x32\xa7\x8c\x0b\x31\x5a\x22\xae\x5d\x85\x25\x8a\xb7\x33\x78\xf5\xb7\xd6\xa9\x58\x83\x40\x99\xb7\x68\x45\x8c\xf8\x98\xb1\xcc\xe9\xc6\xba\x04\x92\x18\x99\x97\x68\x00\xce\x90\x8f\x06\xba\x30\xa6\xcc\x8b\x77\xb9\x50\x69\xf7\x7b\x27\xbe\x03\x3a\xfd\xd5\xf9\x0b\xfb\x24\xc4\x63\x58\x02\xc7\x37\x1c\x0d\x84\x2e\x13\x98\xea\x3c\x6c\x91\x24\x59\xcf\x34\x39\x1a\x78\x0a\xa2\x14\x9f\x62\x2e\x0d\x22\x85\x5e\x3d\x4f\xb8\x29\x66\x08\xf5\xe4\xe5\x38\x02\x37\xc4\x1b\xc4\xf2\xc8\x23\xe6\x74\x82\xea\xe9\x96\xd0\x45\xc9\x12\xca\x9c\x5a\xd9\x60\xe2\x25\x09\xb1\xad\x89\xf2\xda\x22\xa7\x9c\x22\x8e\x06\x20\x95\xb9\xc8\x08\x50\xc8\x7d\x1c\x5e\x5d\x21\x87\xc1\x96\x96\xd0\xd2\xfc\x62\xe4\xcb\x63\x1d\xeb\xec\x61\x54\x94\x0d\xb2\x01\x32\x63\xb0\xe2\x19\x4a\xe0\xf1\x0c\xf3\x90\x8c\xee\x27\xf3\xcb\xb3\x17\x71\x01\xa1\xe9\x29\x70\x20\x34\x27\x88\xdb\xc3\xa5\xfb\x14\x55\x09\xed\x86\x39\xd8\x25\xe3\x50\xf1\x45\x08\x4d\x3f\xf0\x20\x34\xd5\x40\x86\x1c\xc1\x46\x46\xfc\x7d\x76\x9c\xad\x62\x3a\x86\xb4\x14\xa6\x27\x9a\x13\xbc\x99\x11\x2d\xc6\x95\xe1\x76\x3b\x32\xa1\x15\x00\x6b\x5c\x67\x27\xa1\x8b\x52\xad\x25\x7f\x99\xf2\xc9\x36\xdd\x97\x6a\xde\x36\x78\x7b\x79\x6e\xb6\xfb\x3d\x89\x86\xa3\x17\xef\x8c\x07\x13\xe3\x20\x54\x44\x2c\x17\x51\x25\xd2\x61\x8a\x26\xac\x3b\x9c\x5a\x38\x2c\x72\xde\xfc\x4a\xb0\xeb\xb8\xc8\xaa\x9d\x45\x6a\x0e\xf9\x97\x94\xf5\x89\x2d\xfa\x62\x7e\x3e\x80\xb0\xdb\xe9\x4f\x63\xf0\xd4\x21\xde\x32\x17\x4c\x06\x2f\xf0\x08\xfc\x4a\x4a\x38\xb6\xee\x5a\x56\x45\xef\xd1\x99\x12\xa5\x73\x88\x49\xd2\xb0\x60\xa0\x72\x6b\xf0\x2d\xc7\xa1\x8f\x5c\xd8\x7a\xfe\x2b\x21\x73\x60\xa4\xd3\x26\xa3\x98\xaf\xf5\x47\x8e\x91\x1b\x89\x3f\xcc\x5b\x4c\x7d\xfe\xcf\xf7\x7f\xff\x63\xee\xa9\xfc\xf5\xf4\xf4\xea\xf8\xe2\xdd\xab\xab\xcb\xb7\x3f\x9d\xbc\x81\x2d\x00\xa1\x99\x1d\xf6\x1b\x08\x3f\x1d\xbf\x3c\xba\x3c\x82\xf0\xd3\xaf\xa7\xa7\xc6\x8b\x9f\xdf\x1e\xff\x64\xb4\xfb\xbd\x1f\x4f\x8e\x5e\xce\x5e\xbc\x7d\xf9\xab\xf1\xe2\xe4\xf5\xe9\x1b\xf5\xe3\xc9\x9b\x97\x1d\x08\x3f\x6b\xbd\x9a\x71\xe5\x8c\x2c\x54\xff\xfd\x87\x60\xde\x84\x48\x1e\xfd\x6d\xe6\xa2\x5d\xfd\xad\xdd\xef\x8d\x91\x4b\x1c\xa9\x4b\xcf\x90\xcd\xc9\x18\xbf\xa2\xa1\xd7\x69\x4a\xcf\xac\x61\x42\xfb\xc5\xdf\xaa\x44\x8b\x24\x35\x58\xb3\x27\xa2\x50\xaa\x52\xc4\x38\xb8\x38\x79\x5b\x7c\x8c\xc5\x0b\x9b\xa9\x7c\xc2\xbc\xb8\x1c\x12\x06\x64\x7f\x16\xc2\x00\x0d\x38\xf1\xc8\x57\xec\x80\x09\xe1\x43\xc0\x87\x18\x28\x20\xda\xfd\xde\x3c\x75\xab\xd3\xbf\x38\x79\x0b\x54\xd5\x18\x30\xce\x74\x6d\xab\xfb\x06\xb1\x49\x05\xcc\x0e\xd5\xa4\x02\x82\xcc\x7f\x4d\x2a\xe0\x5a\x48\xf7\x25\x47\xad\x49\x05\x04\xbb\x40\xf3\x3d\xa4\x02\x4e\x85\x80\x28\x2c\x3a\x72\x6e\x5c\x70\xc4\x09\xd3\xfb\x79\xb6\x70\x27\x13\x8f\x24\x65\x5b\x6a\xd4\x0b\xf5\x74\x01\x8c\x46\x6c\x35\x62\x6b\xdd\x20\x8d\xd8\x6a\xc4\x56\x23\xb6\xfe\xf4\x62\x4b\x7e\x15\x18\x2c\x95\x07\xc5\x66\x58\x74\x19\x22\x5b\x53\xa3\xf6\x94\xb1\x08\x03\xf9\xe2\xc6\xf7\x3d\x3e\x35\x64\xae\xe7\x33\x98\x4e\x0a\x5b\x19\x8f\x85\x0c\x43\x0f\x31\xb2\xb9\x8c\xb9\x92\xd9\xd3\x07\xb0\xf5\x7c\x1a\xbf\x0c\xd2\x5a\x99\x87\xf3\x8f\x62\xb7\x09\x0a\x02\x97\xa8\xeb\x3a\x93\x06\xd8\x67\x18\x85\xf6\xd0\xc1\x8a\x04\x08\xf5\x21\x3c\xb8\xf5\x5c\xd8\x02\xab\x20\x14\xc4\x72\x55\x13\x91\x1a\x55\x42\x18\xc7\x37\x98\x5b\x21\x22\xbe\xaa\xeb\x36\xa5\x11\x17\x30\xe4\xdf\x25\xd1\xe8\x32\xb2\x34\x17\x9b\xef\x89\x83\x29\x38\x77\xd1\x74\xf3\xab\xb8\x76\xbf\x17\xa0\x10\x79\x33\xec\x59\xd8\x99\x29\x0b\x5e\x75\x06\x16\x40\x46\x96\x2c\x8e\xea\x53\x9b\xd2\x11\xc1\xaa\x22\x2a\xf5\xcc\x76\xbf\x37\x56\x9f\xec\x28\x70\x41\x3a\x18\x14\x40\x45\x44\x4d\x4e\x3e\x9e\xdc\xda\x43\xe4\x6b\xaf\x31\xef\x23\x5c\x68\x01\xba\xea\x0a\x57\xe3\xea\x5a\xcb\xb4\x77\x17\x69\xdc\xa4\x89\xef\x34\x4d\xfc\xc8\x1f\x44\x2e\x0a\xcb\xe1\x7b\x27\x0d\x65\x81\x3f\x30\xc6\xaa\x9a\xc1\x33\xd8\x4a\x77\x24\x6b\x73\xdc\x1b\x7a\xa5\x29\xa4\x30\xd6\x25\xb4\xb4\xbe\x80\x13\x86\xd4\x25\xf8\x56\x68\x6a\x45\xec\xf5\xb7\x9f\xea\xbf\x7a\x91\x26\xee\x6f\x3f\x81\x4f\x5d\x59\x2d\x82\x7d\x3e\x28\x11\x0e\xf2\x75\x84\x2a\x5f\xc8\xe7\xd2\x5d\x93\x90\x56\x39\x21\xed\xeb\x88\x16\x97\xfd\xfb\xed\x97\x77\x2f\xc0\x2b\x1a\xf9\xea\x3a\x68\x07\x64\x94\xda\xd9\xd7\xe9\xac\x9f\xbe\x3c\x17\xaa\xa5\x9d\x71\x59\x48\xc3\x1d\x64\x2c\xf7\x4c\xf5\x1e\x19\x52\x35\xf3\xb0\x43\x22\x6f\xe6\xa2\x70\x80\x3b\x06\x84\xce\x7f\xfe\xf1\xe4\xbb\x3f\x80\x4d\xdd\xc8\xf3\x77\xd4\xdc\x73\xbe\x84\xee\xd7\x28\x2c\x2c\xef\xff\x1b\xb2\x2c\x5d\x07\x9b\x0a\x0d\x03\x73\xd1\x9b\x86\xb3\x7e\xb5\x6e\x8f\x91\xeb\x9e\x53\xc6\x15\xff\xdd\x04\x27\x00\xb4\x8e\xd2\x92\x2e\xbf\x1d\xbd\x78\x71\xfa\x11\x5c\x9c\x1e\x35\x4a\xd2\x9e\xf0\x83\x8a\xd9\xe3\x5f\x25\x11\x16\x93\xa9\x4f\x35\x54\xba\xcd\x70\x59\x6f\xa0\xce\x38\x0b\xed\xd4\xc2\x44\x0e\x84\xdd\xaf\x02\x8e\x72\xf1\xaf\x99\x57\x4d\xf5\x94\x99\xc9\xdf\x6e\xd8\xb7\xdb\x0d\x84\x90\x03\x17\x22\x10\xfb\xa0\xbe\x72\x69\x1b\x9d\xe0\x05\x5b\x27\x85\x6f\x53\x0c\x08\x8b\xef\x2b\xf6\xb5\x15\xad\x7e\xc3\xbe\xb3\xbd\x20\xad\xe5\xc3\x2a\x46\x6f\xf7\x7b\xea\xf3\x4e\x5f\x05\x2b\x31\xf3\x73\x7f\xa5\x7f\xc7\xfd\xa4\x3f\x7d\xc5\xbe\xa3\xc3\x0e\x66\x23\x70\x3c\x44\x1a\x52\xf9\x99\x8c\xb1\x7a\x6d\x3b\xa4\x12\x0f\x3b\xfe\x4e\x9c\x32\x1a\x10\xaf\x8e\xb0\x71\x39\xb0\x66\xfd\xd7\xd4\xd5\x25\x7e\x9f\x0f\x29\xa7\xe0\x35\x72\x5d\xbc\xc5\x7c\x99\xed\xad\x94\xa9\x9d\x96\x2b\x29\x5e\x6d\xc0\x35\x4b\xad\x3f\x3f\xe8\xab\x00\xa2\x96\x6c\x7f\x39\xf2\x4d\xa1\x57\xf3\x37\x1c\x31\xbb\xee\x2a\x92\x87\xd7\x34\xf4\x92\x70\xac\x40\x55\x60\x84\x2d\xe0\x61\x3e\xa4\x8e\xf8\x13\x65\xe2\x77\x64\x73\x65\x28\xc6\x1e\x4d\xd8\x77\xe8\x33\xe4\x38\x9c\x0a\x08\xff\x26\x3e\x24\xce\x33\x95\x58\xda\xee\x7f\x73\x08\xa1\x29\x06\x7e\xde\xed\x1c\x14\xe7\xaa\xa6\x63\x64\xc2\xc5\xd4\x30\xdb\xf2\x68\xaa\x85\x36\xea\xd5\x9e\xaa\x57\x31\x91\x17\x1e\x04\xe2\xfb\x44\x13\x1d\x5e\xa2\xf1\x43\x1d\x9a\x81\x82\xac\x3a\xed\xbd\xbc\x41\xfe\x80\x96\x77\xbf\xd5\xe1\x07\x6a\xf7\x7b\x41\x36\x36\x54\xf5\x85\x54\x90\x65\xaf\x4e\xc5\x61\x46\xcf\x67\xea\x1c\x4b\xd5\x53\x9e\x5d\x79\x2f\x6c\xb3\xf0\xda\x23\x8e\xe3\xe2\x09\x0a\x31\xa7\x23\xec\xcb\xbf\xab\xa2\xb2\x9d\x9a\x7d\x49\xf3\xc5\xe4\x5d\xd9\x4e\xf9\x30\xb5\xd0\xf5\xa8\x06\x25\x4e\x3b\xd8\xf4\xd6\x36\xf6\x71\xb2\x8e\x02\x6a\x6d\xde\xf9\x9a\x05\x83\xf5\x47\x7c\x1d\x18\xfb\x72\x57\x18\xc8\x05\x66\x5d\xa3\xa0\xca\x65\xa1\x23\x29\x30\x29\x84\x53\xc1\x73\xa3\x3e\x34\x2c\x97\x0e\x8c\xaf\xf2\x64\x16\x72\x15\xea\xe3\x79\x62\x87\x5e\xc5\xd8\x7a\xcf\xe6\xed\xea\x54\x03\xc2\x87\x91\x74\xa7\x24\xae\x53\xf3\xab\x5a\x61\xee\xcd\xdc\x6f\x34\xd0\x5c\xcb\x6d\x96\x13\xf2\x45\x0c\x9c\x97\x66\x58\x3f\x36\x94\x2e\x8d\x8b\x5c\x78\x48\x12\x09\xb0\xbd\x12\xbd\x85\x76\x2d\x53\x32\xb0\x35\x3a\xcd\x83\xd4\x69\x64\x15\x50\xb9\x8b\xb6\xc7\xba\x37\x41\x2e\x15\x4a\xa5\x7a\x88\x43\xaa\xe5\x43\x0b\x4d\xae\xca\x94\xad\xd1\x9d\xd6\xe5\x6d\x99\xc3\x02\x8e\xce\x4f\x2b\x9e\xdd\x78\xcc\xf9\x18\xc7\x2e\xc1\x3e\xdf\x6a\x72\xc4\x1b\xea\xe0\x6c\x1e\xde\x5f\xf3\x32\xc3\xa7\x0e\xbe\xa9\xdc\x89\x6f\xbe\x31\xc5\x16\x27\xf2\x8e\x87\x28\xe4\xe5\x65\xe2\xeb\x10\x05\xc3\xad\xa7\x64\x22\xcf\x96\x60\xd4\x62\x78\xa7\x83\x17\xe1\xc1\x27\x1e\xe2\xb8\x6b\xeb\x7a\xcf\x6d\xed\x76\x47\x05\x68\x2e\xe8\x84\x66\xa7\x1f\xc3\xa1\x12\x90\x3c\xe2\xcb\xa4\x35\x01\xd4\x6e\x54\x0a\x07\xf9\xd8\xc1\x7e\x37\x56\x2d\x08\x35\x33\x88\xc9\xd5\x2a\x2c\xc4\x46\x98\x57\xd1\xac\x6a\x72\xde\xc4\x80\x6c\x9d\x88\xe4\xed\xb4\xe3\x4c\x29\xf3\x90\x4f\x32\xd8\x49\x57\x9e\x8b\x1b\xfb\x1c\xf9\xd8\x2d\x46\xcc\x8f\x94\x71\xc1\xb7\xe4\xab\x55\xd4\x2e\x3b\x60\xe1\xd8\x31\x73\x7a\x29\xaf\xbd\x9b\x8e\xe1\xa9\x9f\x9c\x62\x46\x64\x07\x62\xbe\x44\x96\xae\xc5\xd0\x80\x68\x1c\x5e\xdb\xb8\xf1\x4b\xca\x2e\x3e\x12\xd3\x3d\x9a\x4b\xd0\x58\x11\x5b\xcc\x96\x7c\x14\x2f\x60\x40\x84\x44\xfb\x7a\xfb\x9d\xfd\x7d\x7c\x75\x22\xbe\x36\x91\x45\x23\x6e\x3e\x7a\x2e\x7e\x49\x82\xd6\x91\xf1\xd5\xf8\x7c\xd0\x29\xac\x6c\x95\x8f\xdc\x95\x88\xe6\x35\x43\x17\xf4\xdd\x54\x43\xac\x11\xa1\x73\xd4\xe6\xa3\x57\x3e\x79\x89\xc7\xd9\xa2\x42\xe0\x7e\x45\xe6\x32\x3a\x20\xb4\x04\xde\x13\x3a\x87\xd0\xc9\x09\x18\xda\x40\xbe\x0e\x08\x37\x98\xed\x55\xb1\x3a\x07\x84\x2b\xa2\x48\x49\x22\x97\xb6\xa9\x77\x61\xd3\x50\x63\x73\x6d\x5a\xe7\x20\xa1\xed\x79\x3d\x9d\xc7\xa0\xdd\xef\xc9\x38\x54\x9b\x7a\x4c\xcc\x0c\x5b\x33\x66\x87\xcf\x54\x0d\x9d\xf8\x6f\x9d\x19\x84\x5d\xf9\x93\x8d\x42\x27\xc4\x2a\xec\x34\xa6\x70\x0b\x23\x9b\xaa\x7a\x07\xb3\xe3\xb7\x67\x17\xc7\x6f\xdf\x9d\xa8\xb2\x3b\x36\xf5\x75\xb7\x8c\xdb\x19\xf7\xae\x7c\x27\x59\x67\x91\xc0\x77\xb0\x15\x69\xd8\xce\x66\xa6\xb0\x1c\x18\xc2\xd8\xe0\x51\x0d\x63\xee\xf5\x92\x4d\x70\x61\x2b\xa4\x7c\x88\xc3\x62\x84\xe0\xe0\x8c\xf8\xda\xc8\xd3\x70\x1a\x70\xea\xc9\x17\xb7\x2a\xbb\xd3\xf9\xeb\x10\xde\x19\x57\x89\x98\x67\xe2\x9b\xf3\xe5\xe6\xe0\x03\xff\xe3\xef\xff\x75\x47\x57\xc1\x1a\xa3\x4b\x8e\xba\x11\x7e\x3e\x7d\x89\x5b\x6f\x89\x21\x9a\x24\xf4\xf8\xbf\xfd\xb5\xdb\x2a\x7a\x10\xc4\xae\x16\xf9\xaf\xf0\xc5\xd4\x77\xc8\xb1\xee\x1e\xbc\x1c\x4d\xbe\x0c\xb1\xcd\x69\x38\x35\x2e\x12\xa4\xb4\xbe\xcc\x27\xd8\x16\xaf\x5a\x51\x6a\xd2\x29\x40\xe3\xe7\x7a\xa0\x54\xca\xc4\x0e\xda\xa8\xf0\xf2\x0e\xff\x06\xce\x23\xcb\x25\x6c\x58\xc1\xc3\x55\xa5\xce\xdd\x12\xda\x33\xf3\xd5\x45\xba\x79\x53\x34\x94\xfb\x60\x28\xf7\x6b\xd7\xa7\xb9\x14\x7b\x7d\xaf\xd7\x42\xf1\x14\x26\xbe\x6e\xf7\x7b\x86\xd4\xd0\xfb\x69\x77\x97\x99\x2c\xb7\x3c\x4e\x9a\xbc\x6c\x4f\x2f\x12\x48\x11\xeb\x2e\x94\x3a\xbe\x13\x6d\xcb\x57\xbd\x64\x62\x56\x3a\xe1\x12\x8c\x6c\xa4\x62\x4d\xaa\xb4\x02\xa0\xf1\x45\xe7\xd0\x4b\x75\x5f\xb4\xda\xb9\x62\x1f\xb4\xd6\xff\x53\x92\x72\x6a\xe0\xfb\xb9\x8e\x87\x0d\x0e\xdb\x92\xa3\x21\x07\x13\x2e\x61\x35\x61\x23\xfe\xfc\x30\x78\x7e\xbe\x1a\x03\xf2\x9a\xf0\x9f\x09\xe3\x60\xc9\x95\xd8\x84\x62\x3d\x6c\xa1\xf8\x24\x7f\x03\x1a\xd7\x60\x4d\xae\x41\x71\x82\x8b\x64\xea\x80\xf0\x09\xd6\xd4\x47\xbc\xeb\x19\xff\xc6\x30\xc0\x80\x70\x30\xc1\x16\x90\x75\xaf\xaf\x91\x8d\xc1\x38\xdb\xca\x38\x43\xd3\xf9\x2a\x89\x4c\xc6\xb7\x4d\x05\xb2\x36\x53\x60\x79\x6f\xd4\x67\xd9\x2b\x2e\xb1\x3d\x9b\x35\xe8\x6e\xda\x99\x6e\xa7\x9d\x69\xc3\x11\xee\x82\xce\xcd\x38\x82\x56\xec\xd3\xf1\x5c\x83\xde\xa3\xb8\xa4\x97\x61\x14\x20\x57\xb1\x8b\xcf\x07\x1d\x00\x61\x5b\x2e\x0a\x42\x13\x42\xd3\x91\x4f\x55\xe1\x22\x08\xc5\xd3\x03\x10\xaf\xa4\xfa\xf9\x56\x53\x95\xaf\xc5\x54\xe5\x3c\x9f\xdc\x06\x24\x94\xc3\xb4\xfe\xf1\x03\x78\x43\xc7\xe0\x1f\x3f\xfc\xd7\x7f\x2f\x0b\x7f\x05\x81\x71\x2c\x93\xe4\x7b\xc0\x8f\x5c\x77\xe9\x8d\xd7\x0b\xce\x2c\xf5\xbe\x34\xd3\x58\x79\xa6\x90\x19\xf0\xb0\xdd\xef\xb9\xc4\x1f\xcd\x64\xe5\x26\x55\xb5\x02\xb6\x4c\x41\x3e\xcc\x6c\xf7\x7b\x0e\xbe\x46\x91\xcb\x67\xc8\x75\x3b\xe2\x77\x3e\xc4\x1e\x66\x33\x8f\x3a\x91\x9b\x5f\x2c\x69\x7d\xc5\xa5\x74\xaf\x6e\x72\xce\xac\xbe\xc6\x52\xa5\xf5\x36\x55\x96\x1e\x7e\x48\xef\x9a\x2a\x4b\x65\xb8\xa4\x0c\x23\x91\xb4\x52\xad\xf2\xcf\x64\xd2\x1d\xd0\xb1\xed\x31\xf1\x4f\x17\x45\xb9\xcc\x32\xf2\x89\x4d\xc3\x12\x49\xd8\xd5\xef\xee\x92\xb1\x77\xd9\x21\x4c\x01\xb1\x4e\xe5\x59\x8e\x64\xdf\x91\xd2\x53\x36\x6a\x7d\x8f\x95\x9e\x95\x68\x74\xad\x90\x8e\x37\xbe\x48\x6f\x1f\xa2\x80\x68\x3d\x61\x77\x0e\xd4\x6c\x1c\x5b\xf7\x41\x31\xd5\x1d\x5b\x82\x1a\x8a\xdd\x5a\xe4\x98\xce\x13\x7e\xf6\x21\xc3\x39\x06\x48\xe9\x36\xd5\x29\xe5\xc8\xa1\x16\x06\xc7\xd4\x75\x5e\x45\x8c\x94\xe5\x4c\x9b\xa6\x32\x09\x3d\x4f\x56\xed\x12\x53\x1f\xbf\x92\xd5\xe2\x9f\x55\x50\xae\x84\x05\x7c\x71\x79\xf4\xee\x12\xa8\xd1\x2f\xd1\x40\xf6\x78\xbb\xae\x56\xa9\xd2\xbe\x46\x37\xe8\xb6\x6c\x61\xca\x8d\x55\xa0\xe3\x57\x67\x15\x4b\x13\x6e\x51\x07\x7a\xd8\x7a\x0a\x12\x64\x29\x23\x3b\xe2\x5c\x56\x66\xda\xd4\x75\xae\x25\x8d\x1a\xd7\xc8\x23\xee\xb4\x2b\x97\x54\x55\x93\xd9\x64\x70\x2d\xdb\x98\x5a\x21\x51\x65\xf4\x88\x2d\x8f\x63\x1e\xf7\x38\x0f\x31\xe3\x9a\x14\xc8\x9d\xb2\x0f\x07\x33\x32\xf0\x95\x8e\x90\x40\xb7\x81\x56\xd3\xf8\x7d\xef\xd7\xef\xab\x76\xee\x62\x48\x83\x72\xfb\xb0\x44\x63\x25\x71\xbf\x84\x52\x35\x29\x1b\xd2\xe0\xdb\xf2\x3c\x7c\xe1\x9a\x02\x42\x76\xa0\xae\x2a\xd6\x2e\x20\x61\xf9\xe6\x0b\x97\xda\x23\xf0\x09\x19\x5f\xc1\xe7\x03\xa0\x8c\x65\x10\xb7\x0f\x39\x79\x37\xbb\x7c\x7b\xde\xe9\x43\xc8\xfa\x86\xf1\x7c\xdd\xe7\x67\xea\x83\xc5\x51\xd2\x12\x5e\x77\xde\xe6\x65\xab\x3a\xb3\x96\xbb\x0b\x91\xc6\x8e\xce\xbe\xb5\x2f\xf2\xa9\xa9\x56\x0c\x76\x81\xe6\xcd\xab\x15\xc7\xce\x87\x20\x65\x51\x55\xbc\xbb\x44\x7d\xd6\x25\xb9\x71\xa4\xe4\x83\xee\xb2\xe7\xc4\x21\x9c\xee\xc0\x2e\x5c\x71\xe9\x09\xd0\xda\x60\xe9\xd4\x6d\x92\x60\x15\x04\xae\xd2\x92\x88\x4b\xae\xb1\x49\x26\xd8\xca\x45\xc7\x88\x4c\xc8\x48\xd7\x04\x8b\x8c\xc8\x9d\x72\xab\x54\x8d\x7d\xe4\xca\x4e\x56\x1c\xc3\x16\x58\x53\x71\xf9\xd6\x10\x90\x64\x8a\x2b\x8b\x8d\x00\x7c\x48\x18\x08\xd0\x40\x7c\x94\xb6\xf1\x85\xad\xcf\x8f\xcd\x18\x74\x61\x45\x0c\x48\xa6\x06\x5f\xda\x62\xcb\x6c\xf7\x7b\xf6\x80\x18\x16\xf1\xcd\x4e\x7f\xe1\x75\x55\x3e\x65\x37\x69\x37\xf1\xc4\x5d\xe2\x5f\xe7\xab\x97\x5e\x80\x43\x82\x40\x0d\xf7\x0e\x09\x5a\x88\x17\xf4\x5c\x32\xc6\x86\x80\x03\xb0\x29\x53\xf5\xb1\xff\x6e\xfc\x80\x8c\x6b\xe3\xb3\x2c\x1b\xd3\x03\xc2\xf2\xbe\x4a\x9f\x98\x9f\x1f\xc3\x56\xa7\x0f\x1e\xcb\xae\x60\x1b\x91\xf8\xeb\x93\x37\x27\xef\x8e\x2e\xdf\xbe\x93\x24\x7e\x7a\x76\x7e\xf2\xee\xf4\x28\x29\xc2\xfd\x9f\xef\x9e\xfc\xd1\x39\xc8\x74\xfb\xfa\x68\x9c\x2a\x4c\x18\x3f\x0b\x50\x4f\x05\xca\x36\x35\x90\x9b\x3b\xca\x2d\xdd\x51\x16\xd1\x77\xe2\x2b\x0e\x48\x48\x85\xb0\x33\x62\x4a\x8e\x63\xfb\x95\x9d\x36\xe2\xe9\x0f\x11\xb6\x70\xc8\x88\x3d\xe4\x66\xfc\xa6\x11\xe7\xd9\xaf\x3d\x16\xf4\xfb\x3a\x8e\xc4\xdd\x79\xf5\xeb\xb7\x9f\xc0\xec\xf3\xc1\xf1\xd9\x05\x38\xf1\x39\x0e\x83\x90\xb0\xcd\x9b\xf6\xfb\x94\x63\xdf\xa2\x1e\x0e\xbb\xbe\x6b\x9e\x2b\xd3\x16\xfb\xe6\xb1\xea\xc8\x71\xa5\x1a\x07\x7b\xd8\xe7\x26\xa1\xdf\x5f\xcd\xae\x8a\x50\x16\x18\x2e\xb2\x74\x29\x8e\x35\xb5\x72\xb5\x5d\x6a\xb1\x3a\x9a\x03\x4f\x26\xdd\x64\x61\x45\x3e\xbe\x9b\xff\xbd\xa4\x91\xad\x89\x73\x3e\xa3\x16\x71\x71\x6d\x61\xa3\x37\xbf\x73\x01\x43\x2d\xa9\xd3\xc9\xd8\x85\x28\x88\x70\x38\xbd\xef\xcc\xdf\x9b\xdf\x05\x14\x9f\xba\xc6\xe7\x98\xf9\x3d\x86\xd0\xe9\x7c\xfa\x22\x04\xca\x62\x33\xdf\x4c\xba\xb5\xfa\x28\x8d\xb4\x4d\x42\x6c\x9f\x2c\x0e\x1a\x23\x56\xbe\xd6\x1f\xe3\xb0\xdd\xef\x31\x42\xfd\x4e\xff\xd9\x0a\x9f\xdd\x0a\xda\x99\xc4\xbb\x9c\x59\x8b\x76\x70\x46\x06\x21\xe2\x9a\xfc\xc2\x5d\xa2\xdf\x53\x00\xb5\xfb\x3d\x23\x83\x9e\x65\x1c\xa7\xc8\xbc\x03\x0e\xd5\xd4\x6b\xe4\xf0\x02\x45\xe6\xa3\x45\x83\x1a\x50\xd7\x85\x46\x66\xf6\xd2\x54\x5b\x91\x72\x37\xa3\xde\x65\x98\xb7\x5e\xe0\x7d\x91\xaa\x41\x19\x51\x9f\x49\xdc\x53\x5f\xc7\xff\x18\x31\x9d\xe9\x8e\x87\x64\xbd\xf7\xcc\x9e\xd3\xa3\x21\x27\xca\x9c\x8c\xe6\x60\x34\x07\x63\xd3\x83\xa1\x3e\x51\x24\xa5\x97\x13\x46\x70\x83\x74\x65\xd5\xef\x78\x0c\xe2\x61\x92\x56\x72\x07\xb2\x2f\x92\x98\xd6\xb0\xa9\xcf\xd1\x6a\xc2\x70\xd1\x51\x11\xdf\x6d\xf7\xa0\x00\xd0\x92\xc0\x70\xe2\x61\x1a\xf1\xa5\x08\x2f\xf5\x2c\xc4\x81\x8b\xec\xe5\xe8\x2f\xf5\x2c\x88\xd8\x70\x63\xcb\xb0\x39\x78\xe0\x4f\x73\xf0\x16\x52\xc9\xaf\x23\x7f\xc4\x13\x91\x24\xcf\x58\xb1\x3c\xba\x08\x50\x38\x72\x89\x8f\xef\xbb\xe0\x92\x02\x19\xc2\x2e\x4b\x20\xda\xcc\x7e\x68\x48\xfe\x4f\x4e\xf2\x3d\xd3\xa4\x9e\x4f\x02\xca\xb1\xcf\xbb\x3e\x4e\xa8\x7d\x4e\x38\xb9\xa5\x8f\x62\x9a\xff\xe5\x74\x3f\xac\x13\x23\x22\x65\xec\xc3\xe5\xfd\x35\x22\xa2\xb3\x10\x8d\x88\x34\xa7\xa7\x39\x3d\x79\x2e\x14\xf1\x45\x44\x0a\x95\xb4\xdf\xcf\x5d\xaa\x49\xfb\xdb\x81\x48\x08\x5c\xba\x61\xf1\xb4\x86\x90\xff\xfc\x84\x2c\x1b\x81\x2a\x1a\x29\x20\x65\x97\x58\x93\xc9\xc4\x08\x70\xe8\x1a\x0e\xc2\x5e\x99\x6e\x59\xd5\x03\xb5\x57\x67\x49\xc3\x94\x55\xc5\xa5\x7b\x0b\xda\x6e\xee\x80\xea\xbf\x03\xea\x99\xa6\x98\xdf\x0e\x90\x0c\xd8\x36\x03\xea\x98\x3f\x5e\x5e\x9e\xf7\x7a\x2f\x15\xc5\xe5\xd2\xe6\x40\x7c\xbe\xc5\x86\x3b\x59\x8a\x54\x63\xef\x32\x75\xa0\xc4\x81\x4d\xc0\x2a\x2a\xc0\xe8\x21\x3e\xdc\x83\xe2\x9d\x02\x8c\x15\x2e\xba\x55\x84\x88\x19\x8a\xeb\x5d\x78\xc4\x27\x57\xb5\xd1\xc8\x7c\xf4\x3d\xa2\x12\x64\x7b\x2a\x76\x83\xd1\x6b\x3e\x41\x21\x36\x33\x48\xc8\x43\x13\x75\xae\x50\xc4\x87\x57\x01\xf2\xca\x22\x0a\x9c\xdc\x72\xec\x0b\x79\x57\x09\x65\x99\x99\xf6\x84\xc5\x37\x7d\x74\xef\xc8\xeb\xd7\xf4\xd1\xd5\xd0\x68\x80\xbc\x2e\xa3\x51\x68\xe3\x6b\x1a\x0e\xb0\x34\x43\x17\x68\xb0\x80\x4e\x1d\x34\xae\x97\x44\x95\xd4\x15\x53\x75\xfa\x2f\x8f\xde\xab\x5f\xef\x3f\x7d\xac\x21\xd3\x9d\x93\xe9\x04\x5b\x0e\x1a\x4b\x95\x24\xa1\xbc\x02\xc2\xbc\x46\x8c\xcf\x03\xc9\x6a\xe4\x9f\xf1\x44\x0d\x55\xfe\x45\xa9\x72\x32\xe9\xc6\x24\x20\xe5\x7c\x86\x24\x4c\x87\xda\x2c\xfb\x87\x34\x97\x25\x8f\x68\x6f\x46\xf5\xd3\xeb\xcd\x68\xaf\x24\x3d\xb8\xa4\x9e\x8d\x4a\x96\xc6\xb8\x3b\xcd\x7e\x51\xb3\x1a\xc7\x74\x4a\x65\x1c\xc5\xc2\xa1\x5d\xf0\x2a\x2c\x87\xd1\x43\x68\x29\x58\x95\x0c\x32\xf6\xc5\x06\x9c\x83\x9c\x13\xec\xbe\xd4\xb3\xbc\x18\xc7\xa0\x84\xf5\xbc\x04\x6a\x01\xe6\x1f\x7a\xdc\xfb\x72\xaf\x73\x50\x21\xec\x9d\x4b\x62\xe9\xae\x61\x29\x4f\x1a\x0e\x9e\xbf\x83\x3b\xe7\xe0\x2b\xfb\x14\xff\xc5\x78\xda\x7d\x6a\x38\xd4\x36\x15\xd7\x34\x86\x74\xc2\xa9\x96\x85\x07\x73\x17\x55\x8d\x4c\x5c\xcc\xb2\x27\x6c\xbc\xf1\xc9\xd5\x5f\x3b\xaa\xe1\x0a\xbb\x37\x8a\xc5\xf6\x2c\x7e\x96\x7b\xe4\xb3\xa5\x44\xea\x3c\xf4\xf9\x15\x43\x76\x7e\xe8\x9b\xea\x29\x9b\x1e\xfb\xd5\xea\x29\xcd\xc1\xdf\xa7\x83\x2f\x0c\x3a\x8f\x3a\x8b\xfb\x94\x77\xf4\x43\x64\xef\xc0\x64\x13\xb3\xe4\x99\x2a\x2b\x66\x4a\xde\x07\xf7\xc2\x27\xde\x45\xd6\x14\x50\x1f\xbc\x43\xc4\x2d\x59\x05\xe7\x0e\x25\x4d\x16\xb1\xd6\xee\xf7\x42\x31\xed\x4c\x60\xa3\x53\x6c\xdb\xad\x79\xff\x6e\xc4\x9d\x99\xcb\x44\x8c\x61\xce\xcc\x4c\x72\xa4\xf1\x29\xee\x36\xf2\x9f\xa7\xdf\xfd\x61\x56\x2c\x1c\x67\xb3\xf0\xda\x08\x50\x88\x3c\x69\xd0\xa2\x88\x0f\xb1\xcf\x89\x4d\xf8\xf4\x4a\x36\x8c\xce\x29\x02\x50\xc9\x66\x14\x1b\xb7\x1f\xe9\xee\xed\x7e\xef\x8c\xfa\x83\x10\xbb\xb3\x0f\x27\x2f\x42\x62\x8f\x66\x02\xb8\xce\xe2\xd0\x0f\xdb\xcc\x0c\x23\x6b\x6a\xb8\xc8\x1f\x6c\xdc\xe3\x59\x8c\x40\x7d\x49\xbf\x8d\x6c\xd9\x5b\xd9\x12\x0c\x65\x69\x9b\x40\x70\x04\x7f\x50\xdc\x31\x4a\xf1\xf1\x39\xdb\xac\x55\xbe\x10\x97\x55\x14\x30\x6b\xbe\x68\x24\x4c\xe6\xcd\x46\xc2\x24\xff\x35\x12\xa6\x91\x30\xc9\x93\x46\xc2\xec\x99\x84\x61\x6c\x07\xde\x4a\xc6\xdc\xbd\x90\x15\x0d\x3d\xee\x9c\x1e\x3d\xea\x30\xe6\xea\xcc\xe8\x09\xdb\xc5\x4d\xbd\x98\xa5\x92\x96\xb3\xee\x83\x7b\x21\xdc\xc6\xdd\xb6\x29\xc5\x36\xee\xb6\x7d\x66\x10\x4c\xb6\x13\x76\x70\x77\x40\xe9\x20\x2e\x72\x15\x08\x9e\x21\x19\x42\x0e\xc3\xf0\x69\x70\x9c\x14\x4d\x2c\xe4\x19\x9b\xd6\xef\x8c\x3f\x6f\xf7\x7b\x87\xdf\x18\x46\xa6\x4e\x62\x66\xe6\xd9\xfc\xcf\xbd\xa4\xcd\x93\x4f\x83\x64\xc6\x4d\x1a\x3c\xad\x14\x10\xcb\x4c\xb7\x49\x77\xf2\x33\x62\x87\x94\xd1\x6b\x0e\x8e\x2e\xce\xbb\x6f\x4e\x2e\x6b\xb7\x8e\x3e\x1a\x47\x2c\x78\x83\xb9\xf1\x5e\xb5\xc2\x91\x44\xde\x3d\xd0\x58\x46\x5f\x8e\x2e\xce\x21\x94\x00\x16\x53\x78\x66\x8c\x43\xe2\x07\x11\x57\x58\x47\x1e\x7e\x06\x5b\x57\x57\xef\x4f\x4f\x3e\x5c\x5c\x1e\x5d\x9e\xac\x87\x79\x77\xf1\x16\xa7\xa7\x17\xd5\x0c\x9b\xb5\xac\x04\x6c\x6a\xce\x7c\x11\xf6\x4c\xb2\xf7\x46\xa7\x7f\x7a\x7a\x91\xcb\xb8\xc1\xfe\x19\x36\x8b\xeb\x5a\x5e\x67\x06\xcd\x1f\x88\xef\xd0\x09\x8b\x31\xb7\x52\x06\x53\x87\x75\xf9\xc6\xdb\x40\x9c\x37\x21\x2a\x2f\xa6\x8c\x63\x6f\x09\xff\x6b\x16\x04\x8a\xf7\x01\x2c\xee\xc5\x07\xe2\x3f\xfd\x6e\xf6\x81\xf8\xff\xfa\x7e\x75\xe4\x3f\xd6\x8d\xbc\x16\xf9\x79\x70\xe4\x6d\x42\xde\xfb\x6b\x37\x23\x0f\x94\x5c\x6b\x33\x6f\xf4\x35\xda\x5f\x42\x86\x92\xb3\x4f\xd4\x86\x31\x85\x9d\xa5\xef\xff\x28\xa4\xa2\x9c\x1a\x52\x84\x6d\xdc\x44\x43\x7c\x8f\x58\xa5\x26\xa7\xe2\x93\x0c\x8f\x2f\x32\xad\x68\x80\xfd\x13\x7f\x40\x7c\x8d\x88\xda\x51\xb1\xb3\x0c\x38\x9a\x22\x0b\x1a\x14\x88\x81\xb0\x1c\xa8\xeb\x60\x53\x00\x65\x06\x42\xbf\x34\x9d\xfc\x44\x65\xca\x8e\x2f\x34\x35\xdf\xee\x28\xa9\x0f\x2d\xea\x4c\x01\xf5\x5d\x8a\x9c\x67\xb0\xa5\xe8\x4c\x36\x76\x95\x3d\x7e\x2e\x38\xe2\x11\x7b\xf6\x88\x32\x9b\x31\xc0\xb1\x17\xb8\x88\x63\xf6\xe8\x7f\x60\x6b\x03\x31\xbd\x61\xaa\x90\x9c\xbc\xc8\x0e\xa2\x6c\x27\x5a\xcd\xa1\x15\x02\x73\xb1\x47\x65\x52\x60\x53\x1e\x8d\xbe\x82\x17\x42\x01\x71\x3c\x13\x84\x92\xd6\x53\x5b\xe9\xb0\xdd\xef\x49\x01\x3c\x43\x9d\xac\x0c\xa6\xec\x98\x11\x07\xb6\x16\xdf\xe4\xe1\x8c\x3b\x33\x8e\x2c\x17\x77\x6c\x17\x31\x96\xd6\xfc\x24\xfe\x35\x7d\x41\x6f\x7f\xc4\xc8\x21\xfe\x60\x4b\x7b\xa1\x66\x5e\x67\x44\x35\xb5\xd1\xef\xb7\x36\xfa\x72\xd1\xe5\xa2\x1e\x78\xab\xc5\x96\x75\x68\xdf\x5f\x14\xad\x14\x4f\x2e\x55\x82\x73\x7e\xfc\x0a\x05\x0d\xbb\x24\xf6\x08\x6b\xb2\xdb\x4f\x19\x8b\x30\xb8\x0c\x91\x3d\xda\xbb\x16\x39\xcd\xb1\x6c\x8e\xe5\xbd\xa0\xa8\xda\xb1\x14\xca\x0f\xe3\xf2\xac\x15\x9e\x47\x1e\x6a\x2e\x6c\xef\x74\x16\xe3\x31\x0e\xbf\x31\x0c\x08\xd9\xc1\xdb\xcb\x77\x17\x3d\x70\x4c\x83\x69\x48\x06\x43\x0e\x20\x74\x0e\x0c\xf1\xbf\x27\x40\x3c\x02\x47\xaf\x73\x85\x6a\x3c\xd2\x17\x53\x40\x6c\x4c\xb0\x65\xde\x30\xb3\xb5\xad\x33\xdd\xc4\x04\xef\xae\x56\xb3\xd8\xc0\x42\x92\x9c\xf8\xc7\x2e\x8d\x34\x39\xd7\x67\x84\xd9\xd8\x75\x91\x8f\x69\xb4\x29\x55\x2e\x6a\x92\xe2\xc4\x4c\x7c\x5b\x4c\xad\x14\x48\xd8\x02\x1c\x85\x03\xcc\x9f\xc1\xd6\x95\xe5\x22\x7f\x04\x5b\xcf\x13\xe8\xc0\xa9\x6f\x43\xd8\x3d\x34\xd1\xf3\x43\x2b\x34\x9f\xff\x4a\xa3\x10\xc8\x27\x4f\x80\xfc\x59\xf0\x9e\xf8\xc7\x0f\x68\xfa\xcd\x66\x0e\x36\x59\x4b\xdf\x20\x3c\xf2\x31\x33\x50\x10\x08\xfc\xa0\x20\x30\x88\xf3\xec\x9f\xdf\x3f\xfd\xd7\x7f\x7d\xf7\x8f\x7f\xfd\xb0\x81\xab\xad\x91\x61\xbb\x90\x61\xba\xc3\x90\x50\x5b\x91\x95\x15\xa0\x00\x4d\x6b\x2d\xcc\xef\x12\x7f\x24\x8c\xa2\xc7\xa6\x9a\xcb\x50\x9d\x65\xcd\xc6\xbc\xf9\x2b\xd0\x60\xbc\xe7\xb6\x57\xc8\x92\x03\x14\x72\x62\xbb\x98\x55\x29\x0c\xb2\x61\x5d\xaa\x84\x2e\x1d\x32\x06\xb2\x13\x44\x3a\xb9\x71\xc3\x60\x2b\xbf\x0f\x44\xfc\xa1\x99\xbe\x5f\x63\xf9\x10\x81\xba\x31\xf1\x6d\xec\xf3\x01\x0a\x43\x8c\x22\x75\x17\x94\xc1\x53\xae\x7f\xe9\x7c\x48\x39\xbd\x18\x62\x97\xa7\x5e\xe0\xad\x1f\xea\x58\xdf\xfa\x7f\x0e\x40\xc6\x73\xc2\x87\x18\x64\x27\x07\x2f\x30\xf2\x40\xe0\x22\x7e\x4d\xc3\xac\xbb\x24\x6d\x9a\xf2\x09\xb6\x1e\x7d\x76\x7c\x66\x04\x21\xbe\xc6\xdc\x1e\xca\x3f\x00\xe9\x43\x09\xc4\x48\x4c\x8d\x34\xd7\x4a\x1b\x7e\xf1\xb0\xf9\x45\x63\x77\x95\xb0\xbb\x0a\x90\xd4\x54\xb8\xcb\x0c\xfe\x80\x2b\xdc\xa5\x8d\x65\xd6\x70\xb9\xb5\x12\x72\x18\x1c\xb9\x56\xa4\xa9\x7f\x24\xb9\x2f\x78\x8d\x5c\x17\x6f\x50\x31\x2b\x1e\x44\x30\xf7\x60\x18\x20\x31\x1d\x68\x7f\xea\xca\x8c\x5a\xf6\xf9\xa0\x93\x36\x26\x6c\x98\xf0\xc3\x66\xc2\x45\x84\xa9\x5e\x97\x9b\x5f\x54\xc5\x2d\x18\x06\x2f\x5e\x68\x2c\x68\xcc\x18\x1a\x60\xf0\x82\xa2\xd0\xd9\x90\x16\x17\xee\x65\x62\xb5\x60\x3e\x71\xa2\xc6\xa9\x5b\x94\x60\x18\x58\xd6\x95\x9d\x38\x7f\x9e\x67\x5e\x92\x5f\x32\x3e\x75\x31\x33\x05\x93\x88\xac\x59\x10\xd2\x0e\x23\xee\x18\x87\xa6\x34\x48\x32\x6f\x13\x6f\x20\x3f\x20\x57\xc4\xa6\xfe\x95\x47\xfc\x6c\xff\x35\x79\x71\x03\x16\x2f\x6e\xae\x69\x18\x79\x2e\xf1\xf1\x66\x56\x78\x0a\x73\x8a\x59\xf0\x3a\xa4\x51\xd0\x58\xde\x0f\xf0\x00\x31\xf5\xbe\x65\x69\xd8\xf9\x5e\x34\xfe\x6a\x68\xeb\x41\xd1\x96\x7a\x5d\x18\xd3\x4e\x6e\x2f\x9a\x60\x18\xbc\xa4\x76\xe4\x61\x9f\x53\x8d\xfd\x97\xbc\x27\x33\x64\xc0\x25\xa5\xee\x1d\x54\x06\xf0\x5a\x05\xeb\x29\x6e\xbd\x08\x45\x43\x98\x7f\x72\xc2\x9c\xab\xb3\x81\x43\xed\x42\x87\xe3\x30\x38\x9b\x1e\x39\x1e\xd1\x14\x88\x48\x4c\x3c\x70\x26\xbb\x03\x6e\x7a\x33\xd4\xee\xf7\x00\x84\x33\x30\x9f\x16\xa4\xbb\x70\x08\xa1\x29\x9b\xb1\x3e\x9f\x9d\x9f\x1d\x5d\x31\xec\x3b\x3f\xca\x61\x7f\xa6\x2a\x69\x0c\xc2\xf6\x4c\x39\x2b\xa4\x03\x73\xa1\x3f\x6b\x30\x0c\xbc\x29\x12\x03\x42\xd8\xb5\x19\x83\x50\x2c\x7e\x93\x38\xd7\x86\xd4\xf7\x8e\xd4\x1b\x2f\xc5\x96\x82\x36\xe6\xa7\x44\x63\x4c\x9c\x0f\x76\xca\x13\x0e\xd5\xb9\x9f\x4f\x7c\x98\x70\x82\x43\x16\x20\x3f\x55\xef\x51\x10\x08\x20\x61\x2b\xf3\x6a\x73\xc6\xff\x14\x67\x5c\xaf\x67\x05\x03\x45\xb9\x4b\xb5\x80\x0b\xa8\xf8\xe2\x7f\x7f\x26\x1c\xef\x85\x66\xbf\x92\x3d\x91\x05\x4f\x48\xc5\x76\xf7\xa0\xd3\xe9\x6f\x92\x48\xd1\xd0\xe7\x3d\xcb\x20\xb5\x8f\x7f\x41\x21\x24\x44\x0a\xfb\xdd\x25\xbc\x62\x0e\x70\xa0\x3e\x12\x86\x53\xf1\x01\x16\x6f\xa1\x9d\x09\x22\x25\x6b\x88\xf3\xec\x91\x4b\x07\xf4\x91\x90\x31\x62\x63\x63\x81\x24\x1e\x3e\x07\xf3\x77\xc6\x2a\x89\xe8\xd1\xf3\x71\xdc\x5e\xfe\xa0\x73\x98\xf1\x4b\x09\x13\x6c\x1e\xf9\x24\xde\xf8\x7c\x00\xb2\x23\x82\x76\xbf\xb7\x14\xd2\x9c\x5d\x31\x84\xb2\xd6\x63\x07\x18\x46\xc6\x75\xb6\x26\x30\xfa\x91\x7e\x90\x47\x8d\x88\x6c\x58\x50\xfa\xe4\xaf\xce\x82\x32\xca\x70\x7a\x50\xe4\x31\x29\x60\x44\x13\xe2\x6b\x02\xd4\xe6\x5a\xc6\x96\x7c\xed\xed\x7e\x2f\x26\xe1\xd9\x31\x75\x70\x67\x31\x19\x42\x5a\xbd\x07\x31\x64\x10\xce\xe9\xe4\xce\x5a\x89\x18\x50\x28\x24\x8a\xad\x19\x6b\xd2\xca\x1b\x96\x70\x6f\xe4\xbe\xa1\x13\x48\xec\x69\xa1\xa8\x25\x21\x95\x02\xb4\xc6\xc0\xb3\x76\xbf\xa7\xb0\x35\x73\x89\x3f\x92\x89\x39\xa6\x00\xd7\xfc\x84\x8c\xaf\x7f\x37\x7e\x30\xbb\x57\xc6\xe7\x03\x33\xc4\x4a\xbf\x3f\x46\xf6\x10\x3b\x8b\x0f\xb3\x97\x42\x69\x82\xad\x6d\x91\x9e\x69\x9a\xb6\x57\x7f\x0c\xdb\x52\xa5\xf2\xfa\x88\x7a\x7f\x09\x70\xb9\xe2\x78\x39\x0a\x8c\xc9\xcb\x20\x5e\x80\x43\x82\xe2\xfe\xb9\x66\x10\x52\x27\x1a\xf1\xf4\x87\x08\x5b\x42\xa9\xb2\x87\xdc\x4c\x3f\xb1\x55\xd2\xeb\x5a\xaa\x0d\x31\xe7\x53\x79\xc9\x5e\x7b\xfb\xaa\x78\xa4\x76\xbf\x97\xeb\x81\x9c\x43\xb3\x18\x2c\x61\x33\x36\x3b\x44\xea\x8b\x10\xbb\xcf\x60\x16\xf0\x7c\xef\x45\x3c\x63\xda\xab\x37\xf3\x51\xd3\xa4\xb1\x09\x61\x59\x77\x38\x7d\x6a\x78\x28\x1c\x10\xdf\xb8\xa6\xa1\x81\xc3\x90\xaa\xd0\x7b\x71\xbe\x6e\xb0\xcd\x99\xa9\x88\x48\x46\xb9\x18\x71\xfb\x5a\xd9\x24\xce\xa2\xb7\x86\xed\xd2\x82\x7e\xbe\x41\xe4\xef\x3c\xc2\x60\xae\xec\x3c\xff\x7c\x10\x44\xbe\x65\x35\x86\xcc\x9f\x5d\x6b\x11\xaf\x8b\x9d\xee\x12\xff\x9a\x86\x5e\x71\x18\x56\x88\x8f\x8f\xce\x2f\x8f\x7f\x3c\xd2\x28\x2d\x28\xe0\xf6\x10\xdd\x21\x34\x59\x05\xa0\x38\xcf\x60\x2b\xc4\xb6\x1a\xed\x8a\x78\x68\x80\x97\xc2\x68\xe5\x7b\xe9\x2b\x8b\x71\x31\x4a\x5b\x89\x3d\xd9\x03\x23\x7d\xab\x20\xcd\x3b\xfe\x1a\x05\xc4\x60\xd8\x8e\x42\x0c\x61\x77\xfe\x9d\xd2\xfa\xd3\x39\xe6\x90\xa1\x1b\x74\xbb\xd4\xab\xda\x4c\x9f\x9a\x28\x20\x75\xc4\x47\x0b\x41\x9f\xa9\x93\x33\x9f\x2f\x6f\xf3\xd8\xe9\xab\x77\xc5\xfb\xf6\x8a\xfa\x1c\x28\x79\xb3\xe5\x76\x93\x8c\x5c\x87\x75\x21\xc1\x23\x23\x4c\x7c\x27\x62\x5c\xac\x47\x22\xc3\x72\xe9\xc0\x14\x73\xe6\xe2\xe2\x0d\x9e\x68\x42\xed\xef\xc3\x4b\xad\xc0\xda\x18\x1b\xcc\xc7\x13\xa6\xc9\x35\x50\x70\x75\x91\x1d\xb9\xb4\x1b\xdd\x7b\x1f\x52\x33\x35\x53\x24\x44\x11\x9b\x05\x21\xe5\x34\xfe\xa5\xa3\x74\x88\x1b\x36\x33\x3b\x5b\xa6\x9c\x65\x3c\xe4\xa3\x8b\xba\x6e\x88\xc7\x18\x69\x2a\x23\x6e\x4f\xe9\x55\xf1\x7a\x0e\xe2\xc8\x60\x42\x23\x32\x88\xa3\x55\x5b\xb3\x70\xce\x15\xaf\x04\x7d\x35\x20\x2f\x9d\xad\xb8\xc7\x2b\x1b\x12\x1f\xef\x20\xad\x45\x87\x1e\x01\x46\xcd\x09\x2b\x16\x19\xb0\x00\xd9\x98\x0d\x49\xd0\x1d\x10\x3e\x8c\xac\x2e\xa1\x66\x82\x81\x7c\xbe\xcc\xa7\x2e\x76\x0c\x9b\x7a\x01\xf5\xb1\xcf\xcb\xe3\x2a\x53\x97\x2b\x15\x3c\x2f\xf1\xf8\x4e\xa1\x4c\x12\x1a\x69\x3b\x29\x02\x94\xc0\x09\x22\x9c\xc3\xd7\xe9\x7f\x82\x90\xc1\xd6\xe7\x8c\xbc\x4d\x3f\x3b\xc8\x7c\x66\xc4\x3e\xfb\x67\xb0\xd5\x56\x1e\xf9\xce\xd6\x6a\xac\xa8\x89\xd7\xa8\x94\xef\x30\xb2\x4b\xf6\x8f\x5b\x8f\xca\xb5\x4a\x56\x69\xfb\x2a\x33\x7c\xe6\x1c\x87\x12\xa8\xb5\x23\xaf\xb7\xbd\xe4\x07\x02\xed\x13\xc2\x87\x06\x72\x1c\xea\x0b\xbc\x57\xb7\xc5\x92\x81\x0a\x4c\x31\xf9\x4a\x6c\x89\xed\xc8\xd6\x92\x53\xde\xb0\x4a\x3e\xe4\x95\x73\x52\x28\xe6\x26\xe4\x9a\x77\xf1\x36\x6a\x2e\xad\x31\x0a\xe4\xe8\x10\x26\xe3\x6f\x4b\x1d\xa8\xca\x75\x64\x49\x2d\xce\xb1\xdd\x0d\x23\x33\xae\x6e\x94\x87\x10\x3e\x0c\xf1\x1e\x30\x62\x09\x46\xcd\x8c\x58\xce\x51\x2c\x96\x78\x6d\x6d\xc7\x21\xb4\xf8\x5e\x75\x1d\x67\x6b\xdb\x8e\xf3\xc2\x96\xe3\x9c\x72\xe4\x6a\x89\x65\x5d\x69\xc8\x0a\x87\xe8\x8b\x9c\x25\x43\x00\xbb\x0e\xe8\x7e\x43\x1d\xbc\xc8\x0c\xff\x8a\x1e\x66\x9f\x3a\xb8\x22\x27\x96\x1b\x77\x53\xc8\x7f\xa3\x63\x1a\x96\x60\xbc\x4f\x36\xa1\xa5\x1a\x0c\x2f\x09\x2e\x38\xff\xf1\x7c\x0e\x44\x73\x07\xb7\x27\x04\x5a\xd1\x9b\x15\xd9\x34\xc4\x5d\x42\x0b\x28\xf3\xeb\xfd\x5b\xfe\x9b\x70\xf1\xc8\xa6\x5f\xbb\x61\x94\xbb\xb0\x9f\x7c\x3a\x49\x2f\xcc\x36\xb8\x36\xcf\x3b\x8c\x73\xdb\x22\x4e\x69\x88\xe3\x7e\xe4\x8f\x0b\x85\x52\x0b\xb9\xff\x31\xf5\x39\xf6\xb9\x71\xe2\xdb\xd4\x49\x29\x32\x86\x7a\x1e\x96\x9e\x23\x0b\xe2\x61\xe2\x34\xb8\x6c\xc5\xc2\x78\x84\x43\x13\xe9\x8b\x17\x78\xd8\x21\xc8\xb4\xa9\x3f\x36\x6f\x98\x99\xde\xf5\x14\xaa\x20\x2b\xbc\x22\x81\xb8\xdf\x03\xb9\x02\xbe\xe1\x17\xe9\xd3\x7d\xe6\x17\xf2\x58\x8d\xe4\x7e\x16\x49\xb3\xf1\x8b\xc8\x75\x31\xd7\xc5\xc5\x6d\xe3\x42\x66\x5e\x98\x23\x4d\x8b\x84\xad\x2c\xb9\xcf\x61\xb9\xbb\xd8\x4b\xc7\x02\x2b\x7b\xdc\x10\xf2\x83\x23\xe4\xc9\x64\xd2\x1d\x5b\xf1\x8e\x16\x92\x33\xb1\xb0\x5d\x6b\x75\xd9\x8d\x48\xd3\xc1\x8c\x0c\x7c\xb5\xb9\x6b\x40\x5c\x21\xde\xec\x2b\x0d\xcd\x3e\x3c\x9a\x95\x45\x7e\xe6\x7b\x28\x28\xb6\x6b\xe5\x5e\xd9\xbc\x27\xe1\x80\x52\x8d\x13\xfe\x85\x4b\x07\x1b\x58\xa2\xf1\xd8\xdf\xde\xab\x91\x3e\x56\x40\x14\xa8\xae\x6c\x48\x03\x1c\x5e\x6b\x54\xbc\x92\x67\x76\x1d\x1e\x2e\xe2\x09\xee\x17\x11\xc9\x32\x8b\x58\xd8\x04\x5b\x27\x0e\xe1\x44\xd7\x68\x7b\x47\xb7\x78\x2f\x8f\xbb\x32\x81\x6a\x2d\x68\xcb\x8c\x2b\xf3\xc2\x46\x38\x12\xac\x1e\x5b\x58\x0d\xd1\x75\xb0\x89\xfd\x02\x34\x05\xda\x9e\xc4\x77\xa9\xf5\xb8\x1b\x8a\x88\x97\xd1\x55\x7e\x8a\xfc\x98\x19\x14\xda\x58\xc3\x21\xf6\x7f\xb1\x6a\x15\x9a\xa5\x4e\x82\xe3\x4c\x6f\xa2\xdc\x38\x0c\x7b\x88\xcb\xdc\x06\xad\xe1\x05\xf1\x04\xe5\xbc\x98\xf1\x18\x87\xdf\x18\x86\x34\xcf\x12\xe8\x36\x3a\x4b\x8b\x67\x65\x61\x9d\x00\xb4\x46\x78\x3a\xa1\x42\xbb\xce\x3c\xac\x2e\xfc\x3f\xd0\xd0\x39\x0f\x31\x2b\xe9\xfc\xcb\x5a\xcd\x60\x49\xce\x80\x72\x3a\x80\x4b\xfc\x91\x0a\xa1\x71\x33\x15\x51\x55\x6c\xc8\x24\xce\x1b\x49\x2e\xc7\x40\x99\xcb\xa5\xc5\x2a\x72\xaa\xba\xcb\x10\x63\x9e\xa9\x21\x67\x4e\x02\xa3\xdd\xef\xd9\xca\xf0\x9e\x11\xdf\x76\x23\x07\xb3\x8e\xb9\xb8\x98\x24\x58\xe6\xb1\x0a\x96\x91\x03\x20\x2f\x30\x26\x81\xb1\xf4\x62\x1a\x72\xc3\x20\x74\x0e\x04\xe4\x41\xdc\x0c\x40\xa7\x7c\x64\x46\xc9\x87\xaa\x9c\x86\xb2\x12\x44\x9f\x6e\xe6\xaa\x15\xb3\x8c\xc9\x4a\x8d\x7b\x16\x35\x44\x50\x48\x22\xa0\x84\xa6\xb8\xb4\x32\x90\x4f\x2d\xa0\xbc\xd6\x08\xaa\x69\x8e\x4b\x08\x01\xeb\x35\xc8\x75\x90\xee\x4b\xeb\xd2\x25\x0d\x73\x65\xf4\x9c\xcd\x5c\x4e\x5c\x07\xfa\xed\x5c\x93\xb8\xb3\x0e\xc4\xbc\x2d\x7c\xd8\x68\x5e\x49\x78\x07\xa0\x4a\x3b\x1d\x1a\x3a\x81\x38\x92\x65\x5b\xed\x35\xd6\xd8\x7d\x5b\x63\x72\xdb\x02\x5b\x36\xec\xb3\x73\x4d\x90\xdb\xe3\x21\x0a\x2b\x84\xa8\xdc\xb1\x4a\x6d\x5a\x3d\x79\x21\x0f\xeb\xf1\xad\x2d\xa1\x58\x89\xfb\xd7\xf9\x7f\xe3\xef\xb6\x1a\xcf\xff\xf2\x69\xe5\x60\x93\x45\xa4\x94\x21\xdb\xbb\x86\xf2\x9b\xce\x53\x85\x2c\x30\x16\x92\xbb\xd3\x5f\x7f\x05\x5f\x37\x3d\x3a\x4f\xab\xde\x34\x62\xff\x16\x87\x99\x68\xaa\x78\x07\x73\xf5\xe2\x5b\xbe\x9b\xb6\x49\xf3\xba\x7b\x8b\x8e\xda\x4f\x5f\x0e\x3f\x1f\x24\x97\x14\xb7\xbc\x77\xbc\x76\x9a\x8d\x54\xe1\x95\xd1\x36\x0d\x98\xbd\xe5\x46\x99\x5e\x32\x3f\x22\x57\x93\xe7\xb3\x70\x99\x54\xc2\xf5\x52\x78\xcc\x75\xba\xec\x10\xb9\x54\xe8\x8d\x16\xb2\x47\xd8\x77\x66\xd7\xa1\x54\x20\x9d\x99\x58\x0b\xf5\x3b\xf9\xd9\x68\xc9\x19\xa8\x38\x42\x93\xb7\x96\x3c\xdc\x45\xde\x5a\x7c\xc4\x65\x28\x7b\x44\x2c\x64\x4d\xe5\x7e\xe5\x11\xe7\x3b\xaa\x6f\x73\xb4\x40\x9e\xe5\xbd\x62\xb7\x46\x10\x0b\x7e\x4b\x09\x7e\x35\xd7\xb3\x34\x76\x4d\xfe\x6a\x7b\x4c\xf0\xa3\xfb\x89\x5c\x59\x74\xeb\x68\x68\x6c\x8d\xc7\xe3\x01\xd3\x56\x8e\x3b\x08\x68\xf4\xcb\x26\xd6\x27\x27\xd6\xa7\x00\x67\x4d\xad\xaf\x12\x48\xfa\xb9\xb4\x13\x69\x9b\xe7\x30\x33\xec\xdc\x29\x03\x94\x6f\x69\x2e\x3e\x4d\x17\x33\x96\x75\x2a\xdd\x27\x56\x05\x2c\x36\xab\xa6\xfe\x65\x59\x6d\x9e\x24\xf8\x8d\x04\x23\xdd\xc5\xfc\x91\x8f\xdc\x29\xd7\x9a\x21\xd2\xea\x7b\xf1\xd4\xb8\x0c\x91\x8d\x4f\x9d\xb8\x39\xea\x93\x85\x67\x17\x01\xf2\x73\x1e\x9d\xa3\x10\xfb\xbc\xe0\x85\x0b\xe4\x05\x2e\x5e\xff\xec\x95\x8b\x06\x6c\x5d\x3b\xd6\x1d\x7b\x9f\xbf\x4a\x74\x26\xa2\xed\x8f\xff\xf3\xf9\xff\xfc\xdf\x00\x00\x00\xff\xff\x4c\x0f\x44\x38\x5d\x59\x09\x00")
+var _staticWappalyzer_fingerprintsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xec\xfd\xed\x76\xdb\x38\xd6\x3f\x0a\x7e\x7f\xae\x02\xad\x93\xf9\x97\x95\x84\x62\x57\xd2\xfd\xf4\xd3\x7e\x12\xeb\xef\xb7\x24\xae\x8a\x13\xb7\xe5\xae\x54\x9f\xd0\xf6\x02\x49\x48\x82\x45\x12\x2c\x00\x94\xac\xb4\xea\xac\xb9\x8d\x99\x6f\xf3\xf1\x7c\x3c\xb7\x30\xf3\xbf\x92\x73\x25\xb3\x36\x40\x52\x2f\x96\x48\x4a\x26\x25\xb9\xa2\x5e\xab\x2b\x96\x44\x02\x1b\x1b\x1b\xc0\xc6\x7e\xf9\xed\xaf\xff\x81\xd0\xbf\xff\x03\x21\x84\x6a\x01\xf6\x49\x6d\x1f\xd5\x7e\x3c\x36\x8e\xa8\xe4\xf4\xbe\xf6\x52\xff\xe0\x60\x49\x3a\x8c\x53\x22\x6a\xfb\xe8\xab\xfa\x0e\xa1\xda\xf1\x79\xab\xa6\xfe\xbe\x8e\x1f\xeb\x12\xec\x12\x0e\xcf\xfc\x3b\x79\xa6\x45\xa4\x71\xcc\x58\x8f\xaa\x86\x8f\xce\xae\x2e\xcf\x7e\xbd\x8d\x9b\x45\xa8\xf6\xab\x71\xc1\x06\x84\x13\xd7\x80\xc6\xe0\x09\xd5\x2f\x6a\x51\x49\xd0\x39\x0e\x70\x87\x70\xdd\xc7\xef\x49\x1f\xd2\xf7\x26\x89\xd8\x6b\xee\xbf\xf1\x68\xd0\xfb\x7a\x73\x70\xfd\xc2\x61\x7e\xc8\x02\x12\x48\x61\xda\xaa\xa1\xd1\x5e\x73\x5f\x70\x67\xd4\xe5\xa4\x5d\x7f\x6b\xd5\xe2\xaf\xcd\xbd\xe6\xfe\x9d\x18\x49\xe2\x87\x1e\x96\x44\xd4\xeb\xd3\x03\x11\x0e\xa7\xa1\x9c\xec\xe6\x47\xc7\xd0\xaf\x4e\x3f\xe8\x13\x89\xd3\xe1\x26\x24\x52\x3f\xf4\xa6\x59\x95\xb0\x63\x82\xc7\x17\x1f\x2e\x52\x36\x2c\xe2\xb0\xfa\xe5\x82\xb3\x0e\xc7\xbe\x4f\x83\x0e\xfa\x88\x83\x4e\x84\x3b\x44\xd4\xd2\x27\xae\x27\x1a\x79\xc8\xff\x78\x0e\x78\x9f\x70\xe8\x34\xec\x86\x66\x73\xef\xab\x65\xb9\x8d\xeb\x17\xf5\xe6\x04\x01\x53\x73\x71\x34\x84\x87\x6f\x66\x9f\x4e\x1f\xfe\x7d\xb2\xcf\x64\x3e\xe6\x52\x34\xc1\xc8\xb9\xbf\x4f\xf1\x6f\xb6\xe9\x49\x3e\xce\x7d\x7b\x40\x6c\x41\xa5\x62\x67\x57\xca\x70\xdf\x34\xc3\x6e\xd8\x08\x88\x4c\x48\xfd\x7d\x6a\xb2\x1e\x3e\x3e\x18\x0c\x1a\xe9\xcc\x36\x78\x54\xfb\x8f\x98\x82\x99\x05\xf1\xf7\x1f\x0f\xc3\x30\x7b\x31\x9c\x3a\xcc\xf7\x09\x77\x48\xf6\x92\x78\x20\xc6\x39\x72\x07\x84\x0a\xcb\xda\xb7\x2c\xd3\xb2\x4c\xc9\xb1\xd3\xb3\xac\xc6\xdf\x7f\xc4\x61\x68\x59\x0d\xca\x26\xbe\xbc\x13\x96\xd5\x5c\x52\x3c\xb3\xf8\x23\x62\x06\xa9\xce\x1a\x0e\xf3\xcd\x45\xec\x79\xed\x1e\x63\x2e\xf3\x37\x8b\x97\x4b\x32\x6b\x8e\x50\xbe\x3e\x39\x3e\xbc\xbc\xaa\xad\xc4\xcb\xbd\xe6\xbe\x1c\x78\x5d\xf8\x07\x58\x56\x6f\x5a\x56\x03\x8b\x70\xf4\xda\xbd\x8d\x42\x41\x3c\x4f\x31\xb1\x5e\x22\x0b\x63\x0e\xbe\x76\x1d\xcc\x25\xb0\x70\x11\x07\x0f\x8d\x77\x1c\xfe\xcc\x64\xe1\x4f\xb8\x8f\x5b\x6a\x54\xe8\x3d\xc7\x61\x97\x3a\x62\x49\x61\x8b\x1b\x7a\x83\x0d\xe1\x90\x80\x7c\xbd\x79\x73\x70\xfd\xfc\x20\x6f\xef\x9b\xdc\x04\x4c\xdc\x06\x42\xf7\x9a\xfb\x96\xd5\xf0\x69\xa0\x98\x78\x27\xca\xda\x15\x65\x97\x13\x02\xed\x15\xda\x1a\x17\x32\xa4\xc8\xc6\xb8\xd2\x26\x36\xd1\xb9\x22\x75\x3e\x1f\x2a\xdd\xe5\x60\x59\xaa\xbe\xef\x44\x83\xf1\x4e\xa1\xad\x0e\xde\xd1\x13\xd7\xa0\x6c\xa1\x0c\x9e\xa0\xd3\x23\xda\xca\x96\xc1\xc3\x00\x7b\x43\xb9\xba\xe4\xfd\xc9\x30\x54\x2f\xc8\x61\x81\x84\x83\x1a\x49\xdc\xa9\xbd\x9c\xfc\x5d\xfd\xfc\xe0\x5b\x74\x85\x3b\x93\xf4\xcd\x34\xe6\xfb\x2c\x50\x2f\x2d\x92\xe5\xd2\x17\x35\xb1\xa9\x68\x04\xa4\x71\x17\x2e\x64\xe8\xe7\x8f\x42\x1f\xbd\x99\x2c\xfd\x42\x6c\xa4\x8f\x68\x51\x40\x9d\x4a\x8e\xf2\xb4\xf1\x87\x47\x74\xe1\x7d\xb1\x54\x9e\x60\xe6\x69\x82\x32\xf7\xb9\x2b\x74\x16\x48\xc2\x03\x22\x91\x12\xa5\x6f\x79\xdc\x59\x55\xe0\xd6\x33\x66\x49\xe3\xd1\xa8\xf3\x91\x04\x45\xc6\xfd\x2b\xbd\xa2\xeb\x1d\x73\xdc\xec\x3d\x95\xd4\xb2\x14\xa5\x5d\x2a\x2d\xab\x01\x5f\xd4\x36\xc4\x90\x2f\x2d\x89\xa5\xd8\x96\xb9\x47\xa8\xd6\x21\x01\xe1\x58\x32\x3e\x41\x1e\x4a\x96\xd6\x5e\x73\x1f\x59\xd6\x9e\x1d\x51\xcf\x45\xf1\x77\x96\x55\xaf\x37\xeb\xb5\x15\x54\x7f\xc2\xbd\xf5\xeb\xfe\x96\x65\x43\xc7\x96\x65\xc3\x60\x9a\x66\xb3\x3f\xde\x38\x9e\x88\x72\x4f\xb8\x57\xf8\xc8\x03\x61\x1c\x08\x98\xc4\x86\x60\x11\x77\x48\x9b\xf1\x0e\x49\x2e\x07\x73\x45\xd2\x71\x88\x07\x22\x40\x5c\x74\xce\x6c\xea\x11\x74\xa1\xf8\x5d\x54\x29\x53\x2a\xdc\x80\xf1\xde\xaa\x87\x23\x7c\x01\xf7\xd8\xe7\x68\xaf\xb9\x8f\xfd\x70\xf4\x7f\xff\xbf\xff\x3f\xf5\x35\x9c\x68\x89\xa6\x8f\xfd\x30\xe4\xec\x8e\x38\x32\x61\xf3\x5c\x3e\x7d\x8b\x78\x8e\xa6\x7a\x81\x71\xbe\x69\x00\x43\x3b\x06\x27\x1d\xca\x82\xb8\xed\x20\xf2\xbc\x97\xd3\xbf\x03\x9d\x19\xbf\x7a\x4c\x2e\xfe\x15\x8e\x54\xca\x82\xf8\xc7\x4d\x9d\x13\x4a\xf9\x02\x7a\x1a\x3e\x75\x38\x13\xac\x9d\x7d\x15\x80\x47\xd1\xf1\xc9\xa7\x9c\xfb\xd4\xc9\xa7\x5c\x0e\x8b\x74\xf1\xdf\xec\x35\xf7\x4f\x8f\x0f\x1d\x67\x74\x7a\xdc\x1a\x9d\x1e\x9f\xd4\x27\x6d\x30\xa7\xc7\xc6\x09\xb1\xa3\xce\x76\x32\xca\x24\x81\x11\x09\x13\x06\x43\x1d\x22\x4c\xc7\x0d\xcc\xc5\x6b\xf8\xb7\x88\x62\x74\xec\xb1\xc8\x2d\x41\x44\x7f\x35\x0e\x3f\x18\xa7\x41\x9f\x72\x16\xf8\x24\x90\x8a\x95\x96\x35\x78\xf1\xac\x52\x4d\x6b\xe1\xc9\x71\xc2\xa3\x10\x17\x3c\x3b\x52\xeb\x5c\xc1\x93\xe2\xf4\x3e\xa4\x5c\x35\x53\xfb\xf1\xef\xe8\x13\xeb\xa3\x1f\xff\xfe\xb7\xff\x9a\x35\x11\x69\x0a\x8c\x63\xec\x74\x67\x17\x5d\xfc\xc4\xfb\xc9\x73\xf4\x46\x3f\xaf\xae\x49\xa2\xf8\x71\x33\xd1\xe0\x9b\xbd\xe6\x3e\xd8\xf7\x46\x42\x0e\x3d\x52\x57\x66\x3e\xab\x66\x82\xdc\x08\x30\xe3\xb9\xa4\x8d\x23\x4f\x8e\xb0\xe7\xd5\xe1\xb3\xec\x12\x9f\x88\x91\xcf\xdc\xc8\x23\xa2\x6e\xd6\x96\xb8\xd2\xb9\x8a\xd8\x65\x2e\x72\xb3\x7a\xc3\x72\xe3\x7d\x38\xe7\xd3\xf3\x9e\x61\x30\xcc\x9e\xfc\x22\xca\xc3\xcc\xd8\x32\x44\x63\x09\x23\xe2\x92\x86\xc4\x19\x86\x2c\xd0\x39\xe6\x51\x3a\x4f\xf7\x98\xf7\xdc\x9c\x19\x7b\xd8\xe5\x5c\x5d\x64\x5e\x6b\xb9\x06\xc7\xb1\x5a\x52\xe0\x16\xaf\xc5\x6d\x4a\xa3\x79\xb9\x78\xed\x1f\x86\x6a\xcd\x15\x5a\xfb\x0f\xae\x94\x4b\x6b\x8b\x7b\xcd\x7d\xdd\xe1\x5e\x73\xff\xd9\xc8\x4c\x27\x6f\xf4\xf5\xc6\x34\xae\xeb\x60\x57\xbf\x19\x59\x96\x5d\xff\x70\x75\x75\x71\x52\x7f\x0a\xea\x23\x56\xe3\x29\xca\xee\x0b\xc2\x1d\x16\xe0\x62\xfc\x3e\xc1\x12\xdb\x58\x90\xaa\xcd\x4f\xeb\xb1\x2e\x81\x2a\x18\xea\xe1\x27\x8a\x4a\xae\x70\xfa\xf8\x1b\x0b\xd0\xe9\xf1\xab\x75\x09\xa8\x65\xed\xe9\x4e\x2d\x6b\xb3\xd2\x97\xbb\x71\xc7\xbc\x49\x86\x0c\x3a\xcc\x92\x1b\x79\xaa\xaa\x2c\xbb\x6f\x3f\xe1\xbd\x55\xe8\x0b\x5c\x03\x2b\xee\xa5\xee\x87\xa5\x36\xd9\x87\x4d\x10\xe7\x95\x59\xd8\x62\xaa\xae\x44\x4a\xa1\xcc\xf4\x7e\x1c\x3a\xd2\xf8\x1c\x64\x6b\x9b\xe7\x98\xf7\x88\x84\x93\xf8\x30\x92\xcc\xc7\x12\xae\x25\xdb\x68\xd0\x72\xa4\xa1\x59\xb5\x70\xb4\xee\x59\xd0\xa6\x01\x95\xc3\x1c\xdb\x8d\xdb\x27\x5c\x52\x01\x63\xfe\x44\xe4\x2a\xd7\xe2\x1c\x73\x16\x76\x69\x4c\x89\x36\x6a\x81\x37\x27\x2a\xd9\x9c\x95\x76\x01\x1d\xc4\xcd\xcf\xe7\xca\x25\xcd\x35\xf4\x96\xc0\x92\x09\xa7\x37\xf1\x6d\xe2\x2a\x75\x58\xfb\xb8\xdf\x5a\x5a\x70\x9b\xfb\xa6\xe9\x77\x2d\xcb\x55\xee\x2d\x97\x03\x5d\x96\xd5\xe0\x91\x39\x6a\x7b\x58\x74\xfb\x98\x8b\xb7\x56\xed\xeb\x8d\x55\xbb\x7e\xbe\xd7\xdc\x57\x43\xfd\x7f\xbc\x3e\x34\x41\x7b\xc6\xee\x48\xbf\x5b\x7f\xf0\x72\xfc\xe9\xd6\xc6\x41\x40\x78\xbd\x3e\x02\xcd\x7c\xaf\xb9\x4f\x95\x5f\x61\x44\xfd\x8e\x56\xce\xc1\xdd\x8e\xd5\x5f\x89\xd3\x3d\x25\x0b\xbb\xb3\xad\xe6\xfa\xdd\x15\x4d\xc9\x0b\x0e\xe3\xc4\xb2\x1a\x96\xe5\x2a\x1d\x7d\x94\x36\xbc\xd7\xdc\x8f\xfd\x09\x23\x18\x01\x16\x92\x70\x18\x46\x3d\xa7\xbf\xc7\xcb\x87\x6a\x3b\xc3\x73\x7c\xe8\x5e\x32\xcf\xdb\xf4\x52\x01\x2e\x8e\x44\xcc\x0d\xa6\x1c\x9d\xe9\xc1\x5e\x22\x2f\x98\xe7\x65\x6f\x1d\x0e\x16\xdd\x4d\xf3\xe2\xe6\xeb\x8d\x65\x99\xd7\xcf\x95\xd4\xe8\xbf\x5f\x58\x56\xa3\xde\xc4\x8a\xbc\xd8\x40\x0e\x6b\x4a\xbd\x3a\xc2\x6e\x72\x89\x2c\x8f\x53\xd0\x51\x36\xa7\xdc\x56\x97\x85\x21\x8c\x33\xdb\x77\x44\xdd\x0e\x91\x25\x73\xc8\x71\x03\xcb\x6a\x88\x2e\x83\x10\x83\x90\x98\x03\xd5\x89\x59\xbe\x5f\x1c\xbb\xae\x88\x87\x99\xc3\x8d\xab\x2e\xdd\x04\x27\xb0\xeb\xca\x2e\x15\xb1\x4c\xdc\x89\x6a\x78\x00\x5d\xe4\x8d\x9f\x1d\x06\xc3\x0d\x31\x80\xe1\x20\x3e\x61\x4d\x9f\x04\x91\x19\xe2\x0e\x59\xc5\xe9\x5f\x90\x19\xd0\x5d\x36\x37\x7c\x1a\xe4\x1d\xb5\xc9\x85\x2c\x89\x21\x5b\xf1\x9c\x8d\xfb\x7a\x63\xe2\x03\xf4\x46\x84\x38\x40\x8e\x87\x05\x1c\x9f\xb1\x69\xd9\xaa\x1d\xa4\x57\xe3\x37\x26\x3c\x71\x30\x36\xac\xb2\xc0\xf1\xa8\xd3\x7b\x6b\xd5\x6c\xe6\x0e\x8f\xe1\x6f\xcb\xda\x23\x7d\x12\x48\xcb\xaa\xff\xb7\x55\x43\x2c\xf0\x18\x76\x75\x73\xb4\x3d\xfc\x25\x6e\xd4\xda\xfb\x21\x6d\xf5\x07\xfd\xe8\x41\xad\x62\xd3\xe2\x2e\x1e\x6d\x4b\xe2\xd1\xb0\x96\xb9\x4c\x0f\x8c\x1b\x90\x0e\x2e\xfd\x24\xd5\x06\xef\xf1\x6b\x31\xdf\xb1\xee\xcd\xb2\xc6\x23\x59\xe8\xc1\xd2\xca\x20\x52\x4e\x2c\xad\x93\x2a\x3d\xf3\xc5\x9c\x36\x16\x6f\x3a\x5f\x6f\xb0\xf1\xed\x3a\x7e\xa5\xf1\x5c\xed\x35\xcf\x4a\x0e\x6c\x8b\x59\x98\xe9\x0f\x74\x99\x4d\xd0\x31\xf3\xdc\x77\x91\x72\x23\xe5\x46\x71\x14\x75\x00\x22\x54\x1b\xc7\xc4\x1e\xbf\xbb\xfa\xfc\xf3\xe9\xa7\xb7\xb5\xfc\xc0\x99\xd6\xd5\xe1\xe5\x15\xd2\xad\x5d\xe1\x8e\x3a\x97\xda\x7e\x6e\xf8\x96\xd3\xc6\x77\xf8\xde\x2c\x2b\x46\xeb\xf8\xdd\xf9\xc7\xb5\x6c\x15\x5b\x6c\x4a\x04\xd1\x50\x27\x62\xc8\x99\x1b\x39\x52\x98\x0e\xf3\xdc\xb6\x92\x13\xa3\x8d\x7d\xea\x0d\x1b\x8a\xe4\xa2\xae\xea\x65\x1a\x5c\x2c\xac\x27\x57\xe7\xd9\x52\x0a\x41\x54\x2b\x1e\x89\x79\x52\x66\x62\x21\x88\x14\x0d\x35\x14\x57\xfa\x13\x26\x9b\xd2\x56\xad\x9f\x98\x51\x1a\x63\x86\x71\xa2\xfd\xfb\xc2\xec\x12\x2f\x34\x49\x70\xfb\xcf\x96\xe9\x4a\xdf\x74\x6e\x59\x1f\xec\x6d\x64\x50\x80\x73\xa7\xf7\x21\xe1\x94\x04\xce\x38\xec\xfc\x71\xb1\xef\x0b\x17\xb2\x4b\xfb\xa9\x16\xa1\x2f\xe1\x21\xe6\xa0\xab\x4c\x04\xb6\xb9\xb4\xaf\x6e\xd0\x2e\x96\xd8\x48\x83\xda\x8d\x10\xcb\x6e\xfc\xd6\x8b\xeb\x3b\x87\xef\xe7\x2e\x7d\x22\x1d\xd3\x25\x82\x76\x02\x51\xda\xfa\x87\x88\x87\xef\x7c\xfd\xdf\xe1\xfe\xb4\x69\xbc\xd0\xb9\x9e\x48\x2c\x8e\xc6\x82\x6c\x38\xe0\xaa\x36\x09\x44\x4d\x85\x9c\x0a\x62\xc4\x86\x0c\xc3\x57\x62\x08\x5e\xe7\x02\xe2\xfb\x9e\x7d\xa4\xfd\x9c\xa0\x8c\x53\x97\x4a\xc6\x37\x10\x4b\x35\x41\xe1\x02\xc7\xe8\xca\xd7\x85\x07\xbb\x66\x87\x79\xc0\x88\x4c\x66\x9d\x47\x62\x4b\x59\x75\x03\xa4\x29\x8f\x1b\x04\x68\xed\x59\x96\x9b\x72\x6a\x65\x46\xf9\x91\x20\x63\x4e\x65\x73\xe6\x92\xd9\xec\x03\xf1\x72\x52\x1d\x4e\x98\x13\x81\x5c\x2a\x2b\x36\xba\x62\xcc\x2b\xdf\x5c\x35\x80\x30\xfd\x48\x52\x4f\x8c\xfa\x84\x8f\x42\xce\xee\x87\x23\x0f\x07\x9d\x91\x64\x21\x75\x46\xbe\xe8\xd4\x47\xa4\xeb\x85\x6e\x57\xfa\xf5\xbc\xeb\xe8\x03\x3e\x4f\x0f\x17\x02\xe2\x94\x4c\x3e\x4e\x24\xe7\x88\x23\x67\x36\x83\x63\x29\x7b\x09\x9f\x5c\x7c\x3c\xfc\xb4\x91\x90\xd0\x9b\xd4\x84\xfa\xf5\x46\x85\x34\x36\xb0\x1b\x7a\x38\xf8\x5b\x7c\xe1\xaf\xbd\x7c\xf8\xe4\x5e\xf3\x4f\x0c\x0c\x66\x10\x07\xa3\x5e\xe8\x77\x80\xff\xa1\x59\x41\xee\x49\x4c\x4d\xb6\xff\xc5\xed\xe3\xc0\x21\xae\xf6\xb0\x55\x17\x55\x1a\xbf\x8e\x07\xa0\x77\x0b\xee\xa0\xb7\xe8\xeb\xcd\x9b\xeb\x17\x0e\x0e\xbb\x43\x16\x18\x78\x7e\xbb\xe5\x5f\xd5\x77\x07\xf0\x8a\x07\xb0\x96\x13\xf8\x55\xc5\x85\x66\x6e\x87\x70\x0b\x46\x2d\xc9\x09\xf6\xb7\xc1\x7a\xaf\x3d\x27\xd0\x93\x50\x34\xe9\xf5\x39\xc2\xee\x7d\xec\x14\x71\x98\x5f\xaf\xc2\x20\x37\xee\x32\x9b\x5f\x43\x12\xe4\x05\xdc\x0d\xe1\xd0\x40\x17\x9c\x39\x44\x88\x75\x1c\xaf\xab\xef\x3a\x43\x92\xe3\x04\xfd\x46\x78\x6f\x5d\xde\xbe\x0c\xab\x0a\x90\x51\xcc\xa8\x32\xf9\x2c\x9c\x54\xa2\x02\x13\xae\xee\x23\x93\x71\xa4\x43\x70\x36\xdf\x8e\x3c\xd6\x11\x4b\xe7\x05\xde\x9c\xbe\x42\xaa\x71\xd4\x07\xb5\xe9\x45\x7d\x43\x11\x9a\x3b\x33\xea\x3a\xcc\xa8\x19\x91\x48\x77\xff\x88\x08\x1f\x2e\x9d\x3f\xf8\x91\xda\x1c\xab\x67\xd6\x96\x40\x78\xf7\x1b\x50\xfa\xb5\x61\x5c\xc7\x93\xf1\x1c\x3c\xd6\x5f\x6f\xcc\x6b\x6d\xf2\x9c\x9e\xc6\x71\x08\x9e\xa9\x5f\x7c\x90\x7a\xf8\xf2\x61\xe3\xb1\xf1\x54\x3d\xda\xec\x13\x0e\x5e\x4d\xca\x82\x7a\xf3\xed\xdc\xd8\xd4\x4a\xa3\xca\x62\x8a\x96\x38\xb4\x6d\x8f\x75\x48\xd0\xa1\x01\xc9\x72\xb0\xb7\x99\x90\xac\x94\xdc\xec\x39\x8b\x45\xb7\x8e\x5a\x18\xb7\xd0\xd1\x2f\x1b\x4d\xaf\x53\x94\x64\xee\xad\x6d\x49\xf8\x51\x34\xac\x26\x4f\x3d\x35\x4d\xb9\x07\xe0\x8c\x45\x9f\x03\x8f\x06\xa4\x25\x19\x27\x88\x0a\x64\x73\x16\x75\xba\x12\x49\x86\x86\x2c\x42\xf6\x10\xfd\x42\x0f\x0d\xfd\x10\x7a\xef\xdb\x1f\x90\x22\xcf\x8e\xc0\x95\x88\xce\x82\x36\xe3\x3a\x0a\x0a\xe1\xc0\x55\xc9\xa0\x9c\xda\x91\xfe\x42\xa2\x89\xc3\x18\xf4\x9e\xf1\x9b\x2e\x79\x63\xba\x6e\x6e\xc6\x32\x78\x95\x0d\x50\xf2\xa8\x33\xfb\x7e\x05\x3a\x52\xdc\x7c\xdc\xf8\xdc\xa9\xe9\xb2\xe1\x53\x4d\x73\x84\x79\xe8\x50\xd9\x8d\x6c\x6d\xbc\x0a\x7a\x38\x20\x26\x86\x11\x2d\x1a\x2d\xe5\x0e\xce\x0b\x7a\x01\x6b\x10\x3a\xee\x62\x59\xd5\x85\x56\x47\x31\x60\x4d\x8b\xc2\x4a\x28\x3b\x8c\x43\x37\x9d\x95\x51\x4d\x39\x21\x8f\x4f\xe3\x19\x1f\xf4\x37\xba\xc5\x0d\x6e\x43\xf7\x81\x61\xfc\xd7\x9f\xf1\x6f\xce\x2b\xdc\x80\xbf\xc3\x1f\x31\x5d\x38\xfc\x1e\xf6\x31\x7d\xfc\xf8\x7f\x35\x74\x4b\xc6\x15\xc7\x81\x80\x9d\x83\xb8\x1b\x4e\x55\x82\xe9\x57\x34\x65\x6e\xc8\x3d\x1c\x05\x60\xe4\xcd\x59\xfa\x8e\xc3\xe2\xe7\x0a\x71\x22\x7e\x16\xc4\xe1\x1d\x27\x04\x8d\xdf\x47\x2d\xd6\x96\x03\xcc\xc9\xb3\x1c\x67\x62\x0a\x98\x83\x93\xe6\x8c\x0e\x27\x04\x16\x8c\x23\xc6\x9a\x44\x2d\x3e\x0c\xd1\xd1\x10\xa5\x1d\xef\xa3\x37\x58\x5f\x45\x20\xbe\x6f\x32\xbc\x0f\xcc\x76\x6a\xc3\xae\x37\xd3\x76\xf5\xcd\x54\xf5\x55\x79\x08\xc3\x47\xcc\x71\x9f\x78\xc5\x23\xd0\x67\xfd\xb5\x5b\x6a\x13\x19\x53\xbd\x4b\x0d\xfa\x4e\x52\x83\x3c\x2d\xca\x85\xb5\x65\xa1\x37\x24\xbd\xe6\xb2\xf7\xa4\x1e\x46\x90\xb3\xb3\x54\x34\xc3\xcb\xc7\x61\x55\xe0\x5e\x0f\x1b\x40\xe6\x5e\x73\xdf\x5c\xe8\xfc\x59\xf7\xf6\xdd\xc3\x59\x47\xb7\x07\x9e\x24\x8c\x2e\x09\xf6\x24\xf5\x09\x6a\x11\xcc\x9d\x9c\x90\x1b\xfd\x0c\x3a\x55\x77\x96\xad\x04\xac\x50\xfa\xaa\x1e\x5a\xa6\x94\x78\x1e\xa2\x01\xfa\x1c\x10\xd4\x3a\xfd\x8c\x2e\xb0\x93\x63\xec\x6a\x9d\x7e\x7e\x04\x20\xcc\x9c\xee\x52\xe4\x85\x3a\xaa\xfa\xd8\xf8\xc2\xb8\x7b\xc1\x89\x10\xc5\xf3\x6a\xa7\xae\xda\x13\x06\xab\x82\x96\x1b\x38\x7e\xa1\x67\x4e\xbc\xe4\xfc\x54\xf9\x69\x80\xce\x01\xe7\x27\xe3\x1d\xd3\xaa\x2d\x91\x23\x0b\x0d\x22\x68\xed\xab\x55\xfb\xe1\x5a\x65\xca\x8a\x2e\x21\x52\x7d\x54\x87\xf5\x0b\x73\x10\x1a\x13\x61\xf2\x34\x70\xbc\xc8\x55\x09\xb2\x53\x83\x49\x42\x0f\x9e\xeb\x10\x05\xd5\x00\xf6\x43\x63\x10\x1a\xb5\x97\x0f\xfa\x54\x2d\x0b\xb0\xb7\x01\xe5\xe1\x64\x58\x79\x31\x1b\xc8\x62\xaa\x56\xcc\xbf\x4d\x27\x13\xe5\x9a\xb8\x76\x47\xec\x1f\xf2\x88\x7d\x99\x3f\x99\xe7\xc3\xd6\x3f\x3e\x2e\x37\x9d\x73\x32\x3c\xd1\xf7\x91\x88\x07\x21\x04\x43\xf1\x9b\x37\xbd\xb4\x97\xd0\x65\x06\x8c\xbb\x21\x2c\xc9\xa5\xe0\xca\xa6\xde\x32\x43\x2f\xea\xd0\x40\x98\xd8\xf3\x0c\x1a\x18\x2c\x20\x86\x20\xcc\x08\xb1\xd3\x33\x33\x0e\x31\xd2\xe1\x0c\x5d\x32\xff\x22\x3f\xa0\x6b\x45\x14\x2e\xdd\x87\x91\x5c\xbc\x8c\xa4\xb3\x6d\xd2\x74\x34\x8d\x63\x38\x0d\x48\x1c\x73\x89\x6b\x0c\x88\x6d\x68\x88\x10\x43\xbc\xca\xe0\x22\x1b\xfe\xf3\xac\x7a\x10\x9c\x65\x0d\x3b\x40\x57\x94\xe0\x59\x95\x15\xdb\x76\xc4\x18\x78\x3b\x71\xb8\x81\x4b\xe4\xe4\xe1\xaa\x8e\xf0\x03\xd3\xb2\x9e\xff\xc9\xb2\x9e\xa3\x94\x2c\xed\xdd\x4a\x73\xe0\xc0\x61\xb0\xe8\x58\x6e\xaa\xeb\xb9\x72\x14\x9a\x8e\x10\x13\xfe\x03\x3b\x69\xcd\xb2\x1a\x7b\xcd\x7d\x9f\x06\xea\xce\xee\x08\x91\xdd\x56\x12\xaf\x98\xbe\x3f\xed\x82\x78\xf8\x7e\x12\xc6\x38\x1d\xee\xd8\xf1\x86\x00\xd5\xc8\x02\x94\xfe\x65\x2c\xa3\x33\xc8\x01\x95\x52\x25\xf4\x69\xb3\x64\x2c\x02\xf6\x9c\xa9\x43\xa8\x96\x7e\x5d\xd4\xd1\x32\xb3\x72\xe1\xe3\x9d\xa8\x37\xcd\x05\xa3\x5e\x1b\xe6\x63\x87\xc8\x94\x84\xa2\x69\xf9\xff\x4a\x17\x2e\xda\x66\x4f\x18\xf0\x78\x18\x51\x73\xa4\x17\xf4\x10\x77\x19\xc3\x21\x15\x53\x61\x0d\xa8\x62\x1f\xe3\x30\xa2\x9e\x1a\xfa\x70\xe9\xc0\x12\xbd\x15\x65\x5e\xa8\x7c\x3c\xc4\x5b\x1a\x9e\x09\xa4\x41\x04\xdc\x2f\xa9\x92\xf8\x15\xf2\x2f\x1e\x1b\x9c\x39\x78\xad\x4e\x6e\x3d\xf2\xc5\x6c\x01\x68\x04\x05\xd7\xd4\xe6\x2c\x90\x8f\x37\x16\xff\x42\x71\x0c\x0f\xa1\x5a\x7d\x07\xad\x5a\x56\xfd\xd9\x24\xde\xd5\xa1\xff\xcd\x38\x6e\x1b\x67\xeb\xb0\x22\x2f\x5c\x9b\xd9\xb0\x10\x59\x91\x03\x53\x70\x10\x4f\x18\x67\x64\x01\xbc\x43\x01\x58\xbf\xc9\xd7\x9c\x54\x78\xcc\x1c\x31\x1b\xa3\x93\x94\xaa\x03\x3e\x44\x22\xd9\x89\xd2\xd3\x14\xa5\x04\x1d\x24\x43\x86\xe6\xf0\x78\x65\x80\xb9\x4d\x41\xec\x3d\xe4\x55\xe6\xa2\xc9\x41\x84\x3e\xcb\x1d\xe7\xb4\x0b\x13\x1a\xdc\xad\x92\xad\x5a\x25\x19\x1a\xe4\x09\x73\x7a\xe9\xd5\x39\x87\x4f\xc7\x2c\x90\x98\x06\xe9\xee\x59\xca\x4d\x48\x41\x7f\x77\xa9\x50\x18\xdf\x24\x90\x88\x0a\x44\xee\x43\xe2\x48\xe2\x22\x7b\x88\x64\x97\x20\x57\x11\x89\x3e\x9c\x1e\x7e\xbc\xfa\x70\xfc\xe1\xf4\xf8\x67\x84\x0c\xe3\xa0\xb6\x3d\x70\x5a\x9a\xc2\xe2\x7b\xd3\x9c\xd9\x22\x1e\x16\x92\x3a\x90\x30\x6e\x63\x0f\x07\x0e\x0d\x3a\xb9\x8b\xf7\xe3\x51\x4e\x14\x06\xc3\x2e\x3a\x52\xcd\x25\x85\x56\xd6\xbc\x5b\xed\x96\x6b\xa9\x87\x5a\x49\x82\xd3\x7a\x9d\x83\x67\x45\x85\x43\x3c\x0f\x07\x84\x45\x62\xa9\x00\x16\xd5\x7e\xeb\xf5\xb3\xdd\x19\xf0\x94\x35\x25\xf1\x3a\x43\x82\xec\x22\xb6\xd6\xc7\x7a\x97\x1f\xb8\x39\x54\xbf\xcf\x6a\x1b\xd5\xac\x80\x84\x76\x32\xb0\x4c\x04\x07\x9f\xc8\xa1\x28\x3b\xc5\x38\x2f\x05\x40\x75\xba\x6c\xae\x1c\x20\x81\xaa\x17\x47\x87\xc1\x10\x6c\xdc\xe8\x8a\x38\xdd\x80\x79\xac\x43\x89\x58\x05\xff\x7e\x97\xbf\xb4\x74\xfe\x92\x9e\xba\x6c\x89\xa2\x9c\x35\xc6\xce\xe9\xca\x85\x6a\x19\x0b\x13\xe5\xac\xb6\xcb\xa5\x78\x92\x90\x34\x18\x26\xcf\xf1\xb3\xb3\xe5\x80\x0a\x19\xb9\x64\x23\x39\xac\xb1\x3f\x28\xa1\xa1\x74\x6c\x3b\xbd\xb3\xc7\xad\x67\x5f\x99\x61\x80\x62\x28\xd0\x61\x6e\x72\x58\x35\xac\x48\x08\x78\x87\x83\xce\xb7\x2e\x8b\x6e\x7f\x6a\xdd\xb6\x4e\x7e\xd6\xee\x8a\xa4\x44\x5c\x7f\x9c\xfc\x51\x36\x97\x78\xaf\x81\x63\x12\x1a\xce\xe2\xda\x2f\x81\x4e\x87\xdb\x74\x5e\xa5\x4e\x10\x30\x62\xf9\x89\x89\x82\x48\x71\x5e\x49\x2a\x73\xdc\x41\x83\xf2\xc5\x8c\xe9\x44\x1e\xe6\xeb\x2b\x38\xa2\xbc\x73\x28\xe8\x24\x95\x32\xde\x5a\xb5\x54\x36\xac\xda\x74\xa3\xd5\x69\x4d\x7a\xd4\x59\xc1\x78\xfa\x09\x74\x8e\x25\xe1\x14\x7b\xe5\x21\x31\x2d\x8d\x72\xa3\xd9\xc3\x1d\x43\x17\xc3\xd3\x84\x19\x7e\x4c\x58\x55\x65\xf1\x62\x06\xfc\xd4\x5a\xda\x9b\x57\x89\x77\x1a\xca\x2e\xd0\xfe\x08\x7e\xd3\x98\xaf\x41\xc7\xc0\x61\xf8\x76\xc6\xfd\xab\xbf\x5d\xc6\xb5\x1b\xf3\x73\xe9\x9c\xb8\xbd\xe6\xbe\xd1\xe4\xce\x57\x70\x88\x5f\x3f\xaf\x3f\xaf\x27\x33\x93\x9d\x24\x97\x48\xde\xf3\x75\xfa\x71\xe3\x4e\x97\xac\xde\x97\x48\x58\xe3\xc1\xeb\x19\x0b\xe6\xa7\xd6\xfa\xb6\x92\x5c\x99\x98\x96\x87\x8c\x5b\x4a\x61\x19\x78\xcc\xfc\xcf\x9d\xfb\xb2\xb7\xb4\xbc\x49\x92\xe8\x44\x61\x33\xad\x77\xc3\x7f\x9e\x84\x63\xe0\x40\x42\xf0\xa5\x2d\x83\x91\xc3\xbc\x11\x67\x83\x91\x87\x87\x2c\x92\x23\x9b\x13\xec\x3a\x3c\xf2\xed\x11\x40\x7e\x8e\x42\xdc\xa1\x81\x4a\xd7\x1b\x09\x49\x42\x31\x12\xc4\x23\x8e\x1c\x39\x58\x38\xd0\xf3\xc8\xe9\x12\xa7\x67\xb3\xfb\x91\x83\x3d\x12\xb8\x98\x8f\x20\x4d\x67\x44\x83\x30\x92\x46\x10\xc1\xbd\x58\x7f\x80\xf6\x54\x3b\x1c\x4b\x32\xe2\xd8\xa5\x6c\x24\x3c\x0a\x6d\x88\x01\x95\x4e\x77\x24\x39\x21\x46\xdc\xbe\xa4\x3e\x31\x42\x0a\xb6\xd3\x91\x54\xc9\x3f\x84\x8f\xa2\x10\x4c\x59\x23\xdc\xc7\x12\xf3\x91\x8d\xdd\x0e\x19\x39\x98\xbb\xf0\x1f\x16\x09\xe2\xc1\x68\x3c\x1c\x0a\x32\xf2\xa8\x90\xa3\x90\x85\x00\x04\x36\x92\x8c\x79\x92\x86\x23\x89\x6d\x8f\xc0\x7f\xc5\x48\xe2\x8e\xea\x03\xb2\x14\x55\xc7\x23\xec\x11\x2e\xa1\x7a\x0c\xf6\x46\x3e\x11\x02\x77\xc8\x28\x60\x92\xb6\xa9\xa3\xc7\x1f\xc2\x5d\x83\x08\x01\xcd\x3a\x2c\x68\x53\xee\x8f\x44\x48\x83\x11\x0e\x9c\x2e\x03\x7a\x9c\x9e\x21\x59\x38\x72\x69\x5f\x0d\xcb\xe5\x78\x40\xf8\x44\xbd\xa5\x37\x14\x4d\xcc\x80\x0a\x84\x89\xff\x35\xaa\x0e\x75\xbe\x24\xd8\x91\xdb\x71\x86\x8c\xf1\xcd\xb8\x22\x6a\x89\x53\x42\xbd\x00\x4b\x7e\x40\x65\xd7\xc0\xae\xcb\x02\x51\x6f\xae\x90\x48\x9d\x34\x94\x71\x44\xa8\x47\xd6\x7b\x40\xa8\x2e\x97\x2d\xee\x1a\xc8\x86\xc6\x79\x5b\xb8\xdb\x4c\x96\x8f\x29\xd5\xff\xbd\x52\xa9\x98\x0d\x85\x42\x4e\x95\x80\x59\xcc\x26\xf4\xe1\x08\xe7\x61\x84\xcd\x44\x04\x2f\xbd\x15\xab\x10\x3f\x1d\x40\xaf\xee\x21\x66\x17\x5a\xdb\x61\x07\x6d\x9d\xed\x4d\xcd\x4b\xa3\xb8\xe8\x60\x97\xb1\x70\xdd\xb2\xa3\x3b\x5d\xcb\x22\xd2\x7d\x15\x67\xc8\x4f\xad\x8b\x2f\xb4\x47\xf3\xd0\xcb\x7b\xf4\xf1\x65\x33\xef\x7d\x2f\x10\xfb\x77\x22\x1c\xd0\x1e\x7d\x9b\xa7\x69\xc6\xcf\x95\x76\x49\xd3\xc3\xbd\x62\xbe\x83\xe5\xba\xca\x20\xdd\xe8\x5e\x8d\x63\x36\x64\x92\xcc\x46\x9d\x67\x1a\x2f\x2d\xcb\xd6\xb4\xea\x92\xb0\xc6\xb6\x54\x83\x1d\x93\xbc\x20\x7d\x63\x66\x0f\x43\x6b\x4d\xc6\x79\xda\x99\x1c\xb3\x7b\x1f\x5a\xa2\x94\x92\x54\xc2\xd2\x98\x53\x46\x2d\x67\xc3\x88\xd7\x59\x81\xad\x62\x6a\xed\x94\xaa\xa4\x14\x5b\x27\xcb\xaf\x91\x75\x3b\xb0\x77\xe7\xf7\xd2\xe7\xf7\x5c\xb9\xcd\x12\x42\x8e\xdb\x6d\xea\xc4\x42\x56\x49\xd6\xd0\x55\x6b\x5b\xaa\xf6\x4b\x3d\xd8\xb8\x72\xff\x98\x47\x66\x0e\x93\xbe\xc0\xa5\x5c\x6e\xc0\x10\xbb\x5b\x47\x9b\x5a\x47\x03\x35\xe5\x85\xd6\x11\xb9\x2f\x50\x39\xfd\x23\x0e\x5c\x60\x2a\x3c\x8a\x8e\xa0\xb2\xfe\x2a\x71\x1f\x2d\xec\x11\xc0\x84\x71\x62\xc7\x1f\x1a\xf7\xbe\x11\xc1\x1a\xd3\x53\x30\x68\xf2\xf2\xbc\x6c\xab\x0a\x14\x9c\xe1\x38\x70\xff\x11\x81\xd1\x38\xc0\x7c\xf8\xae\xc3\x85\x55\x9b\xdf\xcf\x46\xe2\x21\x45\xca\xa5\xa5\x20\x2e\x5c\xd2\x27\x1e\x0b\x09\x9f\x69\xc0\x74\x99\x23\x4c\x2c\x3d\x2c\x1a\xaa\x5a\x78\x03\x87\xe4\xde\x61\x2e\x69\x00\xf9\x66\xf2\x49\xfd\x71\x4b\x03\xc9\x19\x20\x32\x2f\x16\x60\x26\x24\x67\x61\x97\xa0\x0a\x02\x2a\x1e\x5a\xc0\x70\xc8\x84\xc1\x49\x9b\x13\xd1\x05\xe3\x24\xfc\x52\xb5\x21\xe0\x13\x30\xe7\x4e\x7c\xe7\x7b\x60\xc0\x5c\xb2\x84\xa9\x4d\x99\x90\x12\xd9\x70\xfc\xf4\xc5\xf9\x42\x14\x7e\x22\xf7\x91\x58\x1b\x4a\xec\x9c\x5a\x8c\xd8\x0d\xee\x85\xce\xf2\x0c\x88\x1c\x3d\xc4\x08\x9e\x87\x13\x9b\xf3\xca\xe3\x6d\x70\x61\x00\x7c\xc9\x0c\x28\x09\x43\x27\xca\x3b\x3f\xfe\x29\x08\x47\x9f\x03\x9b\x61\xee\xe6\x82\x67\x2d\xeb\xe8\x6d\x63\x21\x21\x2a\x40\x13\x02\xb4\x56\xe3\x28\x1a\xb7\xbf\x90\x17\x1c\x0b\x29\x71\x59\x08\x97\x49\x73\x70\x27\xda\x6b\xbc\x98\x49\x8c\x4b\x7f\x5c\x9c\x16\x97\x6c\x09\xfa\x14\x46\xf6\xb0\x10\x1e\x98\x6e\x58\x63\x9b\x68\x34\xb0\xa4\xaf\x3c\x79\x4c\xdf\x2c\xcf\x9d\xbe\x0b\x2c\xdb\x86\xc0\x32\x3d\xb1\x99\x5b\x28\x77\xde\x9f\xb5\xd0\xe1\xc5\x19\x6a\x33\x8e\xc6\x2e\xa9\xbc\x62\xcb\x61\xc9\x61\x1f\x10\x37\xd5\xc0\xdc\xe9\x24\x39\xc9\xe3\x45\x03\x66\x5c\x1f\x87\x0f\x1e\x28\x77\x9f\x48\x15\x1f\x11\xf7\xa2\x0b\x52\xe2\x3e\xd6\xc4\x2e\xbe\xae\x71\x49\xdb\xd8\x91\x8c\xe7\x00\x90\x9e\x90\xfe\x4a\xa5\x3c\x52\x93\xf4\xfc\xea\x88\x13\xfd\x43\x8e\xf1\x05\x67\x2a\xa5\x1e\x29\x71\x57\x48\x4f\xfa\xf3\x03\xc3\xca\xe2\xc9\xd0\xf7\x90\xb4\xee\x93\xc9\x78\x47\x31\x3f\xed\xc8\xb2\x1a\xe5\x1e\x59\x77\x6d\xce\x14\xaa\x9a\xc9\x42\x12\x18\x71\xbf\xff\x1b\x13\x06\xf4\x5a\x80\xf5\x68\x6c\x27\xa8\xc4\x9e\x30\xc5\x64\x73\xe3\x46\xaa\x87\x42\x97\xb3\xcf\xce\x08\x5f\x29\x57\xa1\xb2\x05\x12\x15\xf2\x4d\x17\x17\xce\xca\xaf\xf7\xb9\x42\x5b\xc8\x76\xbb\xa2\xe8\xf7\x71\xa0\xf2\xef\x1f\x9f\xd0\x7f\x78\x99\x9c\x8d\x47\xc3\xb4\x6d\x0d\x19\x80\x2c\x6b\x0f\xc3\x47\x95\x03\x9e\x54\x81\xdf\xa8\x87\x19\x2e\xb7\xe3\xd1\x67\xea\x75\xc2\xa1\xf4\x84\x39\xf9\xd7\xcb\x97\xb3\x10\x11\x2f\xff\x23\x5f\x3f\x59\x67\xd5\xaa\x64\x28\x68\x26\xb0\x78\x45\xfe\xf9\x44\x76\x99\x0b\x47\x5c\x23\xf8\x66\x62\x68\xdc\x65\x4e\x26\x1f\x03\xe2\xe7\x68\xc8\xbf\x50\x97\x30\x74\xe1\xe1\xe1\xca\xd5\x80\xdf\xe0\xa4\x2b\x23\x54\xed\xe4\xea\xae\xc9\xf3\x31\xa6\x5f\xd9\x97\x87\xa4\xf9\x6c\x83\x2d\x58\x45\x04\xc5\x01\x3a\xa2\xd2\x8e\xf2\xcd\xb6\x8f\xd5\x04\x3c\x7a\x30\xa7\xcf\xb8\x7e\xb2\xa4\xd2\x23\x00\x05\x84\x8d\x6f\x7f\x36\xfe\x7e\xfd\xc2\xaa\x21\x0a\x15\x90\xe3\x92\x59\x46\xba\x53\xa3\xa4\xf8\xa1\x4f\x25\x75\x67\x5e\x51\xbf\x89\xa1\x90\xc4\x37\x6c\x30\x1d\xc6\x41\x66\xd3\x8f\x1d\xa0\xfe\xb8\x3a\x73\x6d\x25\x41\xc7\x61\xe8\xc5\xa1\x5f\x46\x0a\x12\x95\xf2\x71\x85\xab\xc7\x50\x76\xd3\x52\xb2\x6b\xbc\x7d\xc4\x41\x38\xa2\xae\x09\x98\xab\x26\x6c\xf9\xf5\x42\x11\xbe\x8c\xa9\x46\x45\xdd\x27\x92\xa8\xd3\x16\x63\x98\x36\xd3\x4e\xa6\xd0\x4c\x6a\x84\x16\x58\x3f\xc7\x2c\x68\x7b\x11\x09\x1c\xf2\xe8\x58\x06\x75\xe5\x1b\xb7\x67\x5c\x92\xdf\x22\x22\xa4\x71\x45\x7d\xb2\xfc\xed\x3b\x46\xfa\x02\x83\x4f\x42\xac\x65\x4d\x0f\xd8\x49\xfb\xda\x6b\xee\xc7\x17\xf0\x39\x03\x53\x95\xce\x8b\xe8\xe2\x99\x8b\x66\xdc\x99\xc1\xe3\x81\xc9\x05\x03\xdb\x39\x6b\x2a\x72\xd6\x2c\x96\xfd\xf1\xec\x8c\x85\x5f\x12\xec\x1b\x2a\x6c\xd6\x66\x5c\xef\x76\xc9\xf3\xf9\xeb\xe2\x1d\x15\xdd\xd3\x21\xa9\xf6\x54\x51\x07\xc7\xc1\x5e\x73\xff\x23\xeb\x20\x1a\x20\xc9\x50\xbd\x19\xf7\x8c\xa0\x42\x5a\xe0\xa2\x63\x1e\x39\xd4\xf6\x08\xaa\x4f\x98\x4e\xde\x98\xfa\xd5\xda\xda\xf4\xbf\x05\x7c\x6f\x53\xd1\x25\x43\xb2\xcc\x8e\xf3\x13\xe5\x39\x6a\xcd\x99\x10\x91\x72\x59\x43\x8c\xf4\x8a\xbc\x1d\x6f\x26\x96\x25\x5e\x64\xee\x27\x50\x40\x28\x19\xcf\x1d\xe5\x78\x04\xff\x31\xec\xa8\x63\x48\x20\x01\x90\x05\xea\x33\xbb\x0b\xb4\xf9\xd3\xd9\xe5\xa1\xda\x77\xfa\xd7\xcf\xfb\x7b\xcd\x7d\x7d\xc6\xef\xc3\x3c\xc5\xe0\xc1\xf0\x8f\x8e\xc4\x85\xbf\xea\xcd\x95\xf7\x1e\x7c\x27\x12\x1d\x22\xd6\x09\xe6\x99\x3a\xe7\x1d\xeb\x40\xe4\x2e\x9b\x79\xc3\x9b\x13\xc8\xd3\xb2\x2b\x04\xe9\x45\x70\xcc\x3c\x48\x1a\x60\x7c\xa9\x15\xf3\xf2\xb1\x1b\x54\x9e\xe9\x10\x16\x08\x85\x3e\x0d\x27\x21\xd0\xd0\x70\xb6\x13\xe2\x38\xb1\xcc\xe0\x05\xb5\xda\xe2\x87\x2d\xab\x11\x3f\xbe\xae\x8d\xaa\xe0\x1c\x44\x9c\x78\x14\xaf\x39\x59\x11\xeb\x5e\x55\x6a\x11\x7c\x31\x91\x56\x31\xf6\xa7\xfa\x98\x06\xea\xd7\xe4\xe9\x14\x38\x33\x24\x7c\xf6\x0d\x1c\x19\x12\xf3\x0e\x91\x06\x75\xd5\x4b\x96\xe5\xe6\x5e\xec\x74\xbb\x8f\x4c\xf7\xcb\x33\x90\xeb\x4e\xb2\xf2\x22\xfb\x38\xe8\x60\x49\xaa\xad\xf8\x95\xa0\xc0\x3e\x47\xb1\x83\x67\x0c\xc5\x4b\xdc\x0e\x84\x5d\xe0\x98\x8e\xb8\xba\x64\x1e\xf7\x96\x7b\xff\xd1\x6c\x8c\x1b\xcf\xb4\xc5\x0c\x88\x60\x7e\xe8\x11\x49\x2a\xac\xf0\x30\xcb\xd0\x17\x29\xaa\xee\xc1\xf5\x73\x3c\x26\xe1\x21\xac\x6e\x5e\x56\xea\xc4\xcb\x49\xc1\xbf\x67\x23\xcb\x6a\x96\x9d\xe0\xed\x11\xdc\x27\x9c\x45\x31\xf2\x2e\xd4\xd4\x9a\xe8\x7a\xe1\x56\x71\x74\x7a\xbe\xe6\x6d\x42\x6d\x03\x36\xf1\xd7\xa3\xf4\x91\xa0\x61\x13\xbf\x41\x83\xf6\xc2\xa5\x7a\x74\xf6\xfe\xf0\xf8\xb4\xa2\x50\x92\xbd\xe6\xfe\x9c\x0b\xa1\x16\xac\x17\xba\xe7\x11\x00\xa9\x81\x2a\xd6\xa2\x92\x20\x2a\x10\x8f\x82\x00\x14\x11\xfd\x73\xbd\xf6\x78\x33\xa0\x6e\xa9\x88\x0d\x70\xe7\x98\xdd\x66\xc7\xac\x4d\x3b\xd8\x21\x19\xd5\x0c\x8f\xb0\xed\x51\xa7\x58\x86\x0b\x3a\xc7\x01\xee\x4c\x29\x5a\x1f\x98\x90\x3a\xee\x2f\x20\xde\xf6\x16\x81\xb6\xd5\x28\x33\xf1\x3e\x8e\x30\x24\xbd\x06\x13\x71\x54\x9b\xc3\xc7\xb7\x13\x5a\x9e\x97\x1a\x2a\xf1\xcf\x00\xa8\x82\x1a\xe3\x85\x83\xc5\x36\x8b\x25\x1e\x8d\x09\x9e\x29\x7e\xbb\xe6\x9a\xb7\xfb\xa6\x39\xa6\x65\xb9\x18\xb3\x64\x2e\xb3\xf3\xc7\x41\xfa\x5c\xce\xc2\xb2\x0f\x95\x12\x4e\x82\x98\x32\x88\x9e\x54\xbe\x54\xc8\xc7\xcd\x82\x13\xdf\x1d\x08\x5b\x7d\x20\xc4\xd3\x99\x13\xf0\x08\xb3\x9e\x1f\x67\x52\xac\xe0\xe7\x34\x8f\xd3\x96\x37\x79\x26\xd8\x31\x11\x59\xf7\x88\x23\x1c\x88\x2e\x21\x4b\xb8\x74\x1f\x95\x23\x11\xb7\x01\xb1\xf3\x12\x45\x21\x0b\x90\xec\x92\x59\xfd\xcf\xd6\x34\x19\x61\x37\x4c\x4b\x8b\x1d\x28\x84\xff\x17\x60\xed\xdf\x6b\xee\xf7\x1f\x6d\xf0\x9f\x59\xfd\xaa\x43\x04\x6b\x77\xb7\xe2\x9f\xe4\x8a\xd7\xfa\x4f\x2a\x37\xd9\xab\x5e\x90\x62\xf5\x24\x97\x8f\x6b\x4a\xda\xb6\xac\x98\xdf\x96\x55\x7d\xc2\xd4\xce\x75\xbb\x05\xae\x5b\xc8\xe7\x68\x8c\x5f\x33\x5f\x99\x71\x6d\x16\x13\x2e\x15\xf0\x50\x9c\xb1\xa6\x06\xba\x48\x34\x69\xe7\x08\x07\x9d\x56\x97\x85\xa5\x58\xc8\xd4\x5e\xd0\x3a\xbd\xfc\xe5\xf4\x32\xbe\xef\x1e\x1d\x7e\x7a\xdf\xfa\xf0\xf9\xa2\xb6\xf1\xcb\x0a\xed\xd8\x38\xe8\x40\xdd\x7b\x38\x9f\x1a\x36\xcf\x60\xca\x49\xe4\x87\xcb\xde\xde\x56\xb3\xc5\x00\x78\xfb\x9b\xee\x8f\x07\x71\xa7\xfb\xa8\x25\x71\xa7\x03\x7b\x29\x52\x35\xf8\x10\x7c\x8b\xce\xfc\x90\x71\x49\x38\xea\xab\xaa\x4e\xb1\x05\xc1\x7e\x80\x4f\x58\xfe\x92\x9e\x2d\x04\x98\x15\xa2\xf8\xb0\x00\xe0\xd3\x75\xd8\x3c\x28\xe8\xf7\xfb\xcb\xdd\x89\xbc\xf5\x27\x32\xfb\x46\x38\xeb\x37\x5c\x08\x5f\xe9\xb8\x91\x1f\x36\xc2\x6e\x98\xb1\xce\xd3\x5d\xad\x72\xf7\xc0\x84\xc7\x58\xa1\x69\x2a\x97\xae\x3d\xa6\x60\x6e\x95\xbe\x05\x48\xae\x85\xde\x2d\xc3\xfb\x35\xd1\x47\xa6\x4e\x4f\x3b\x2a\x0c\xa2\x4a\x26\xee\x35\xf7\x4f\x28\x11\x64\x3a\x98\xc7\xd6\x3d\x5b\x56\xc3\x25\xa3\xa9\x5f\x4c\xf0\x70\xdd\xc6\xbf\xdf\xc6\x3c\x0b\xbb\x61\xe5\x1b\xe6\x6e\x2f\xd8\x0e\x03\x2d\xcc\x7b\x96\x85\x96\x4a\x79\xe8\x8a\x4d\x03\xec\xda\x54\x4a\xec\xc6\x19\x31\xe6\x9d\x80\xb0\x3b\xa9\x8c\x72\xcf\xca\x5d\xce\x71\x47\xd9\xcb\xf8\xdb\x80\xd8\xd5\xac\xe2\x75\xea\x79\x30\x8a\x46\x7f\x21\xcc\xdb\x91\x87\x5d\xb2\xfe\xea\x0b\x36\x74\xab\xd0\x51\x06\x1b\xbc\xa1\xed\x42\x71\x96\x0b\xc5\xd1\x2e\x5d\x29\x0c\x35\x7d\x99\xab\xc7\x23\xa2\xa4\x1c\xd4\xcd\xe5\x65\xd8\x6a\x10\xd9\xc3\xa4\x61\x43\xf6\xab\xcf\x24\xd8\x6b\xee\x87\x98\x63\x7f\xa4\x8a\x31\x8f\x74\xde\xb6\x8e\x9c\xb3\x3d\x0a\x86\x3a\xd9\x37\x21\xc9\x60\x3d\x0e\x6c\x3b\x1e\xf7\x42\xb6\xb0\x4e\x6e\x01\x6d\x78\x68\x03\xb9\x27\x31\x6d\xcf\x76\xb1\xf8\x5b\x1f\x8b\x6f\xeb\xa9\xca\x5e\x80\x11\x81\x38\xd9\xed\x2c\x95\x9b\x50\xa7\x5c\x8a\x62\x61\x9a\xe5\x12\x0b\x4f\xe7\xd3\xb5\x19\xef\x10\x15\xfa\x14\x72\x76\x47\x1c\x29\x4c\x3b\x61\xc4\x22\x46\x31\x5c\x89\xc5\x95\xe1\x49\x63\xab\xf2\x12\x6c\x16\xa0\x4a\x09\x0e\xcb\x4c\x13\x3f\x62\x36\x5e\xc6\x05\x3f\xe3\x95\x2e\x4d\xdb\x65\x36\xae\xaa\x00\xc0\x7b\xc6\x3a\x1e\x41\xe3\xb2\x21\x85\x36\xad\x99\x2a\x23\xa8\x8c\xb4\x5a\xec\x87\x06\x4e\xda\xd5\xb8\x0f\x72\x18\x92\xb7\x5f\xad\xda\x0f\xd7\x1d\x45\x66\xfa\xb3\xfa\x6e\x99\xec\x59\xfd\xfe\xb8\xfd\x24\xa5\x13\xe2\xce\x3b\x78\x14\x71\xa7\xab\x90\xb6\xe3\x9f\xeb\xeb\x82\x65\x86\xf2\xeb\x8a\x34\x75\x91\xc1\xb3\x6c\xcd\xbd\xb0\x81\x80\x8a\x10\x3b\xe4\xc7\xbf\xfe\x39\x73\xfb\x63\x9e\x8b\x8e\xbb\x79\x10\x88\x1f\x69\x9f\xe8\xc7\x4a\x15\xe0\x71\x5c\x66\xdf\x17\xc0\x7b\x9b\x79\xae\xd3\x05\xf0\x43\x3d\x6e\xea\x9a\x96\xe5\xfe\xfb\xc7\xff\xfa\xdd\xb4\x1d\xcb\x6a\xf4\x7d\xf1\x17\x78\xb6\x0a\x64\x14\xbd\xee\x75\xff\xd9\x41\x38\xcc\x73\xdf\x73\xea\x2e\xe1\x74\x5d\x45\x55\x99\x32\x77\x71\xe2\x69\x81\x57\x40\xbc\xe0\x26\x93\xea\x23\xd2\x1a\x1c\xf3\xdc\xce\x98\xa2\x82\x6f\x01\xae\x90\x81\x03\xd7\x08\x71\x87\xe8\xa4\x46\xc2\x67\x9a\x50\x0f\x8a\xc4\x46\x16\x77\x33\x0f\x70\xe2\xe1\xe4\x9a\x83\xd0\x70\x58\x20\x49\x20\x4d\x1d\x4f\x2e\xcc\xf9\x5d\x96\xb4\x65\x7d\x61\xdc\xbd\xe0\x44\x14\xdc\xab\x26\x27\x68\x76\x92\x0a\x6a\x58\xc0\x21\xe8\x19\xf8\x6c\x4d\x40\xec\x50\xcb\x6a\x0c\x52\xdf\x77\xad\xf8\x5e\x57\x60\xd6\x80\xad\x7b\xcd\xfd\x98\xb3\x23\x1a\x38\x5e\xe4\x12\x51\x37\x67\x6a\xa1\xb8\xb4\x3f\x51\x7b\x41\x35\x00\x3b\xe9\x20\x34\x6a\x2f\x1f\xf4\x39\x35\xcd\x83\x70\x72\x82\x8b\xed\xa3\x8b\xa9\x2a\xb6\x57\x3e\xd0\xb2\xd3\xc9\x44\xb9\xa6\xb5\xa5\xa0\x7c\xa7\xed\x89\x68\xad\x48\xbe\x85\x6d\x8c\x4b\xda\x19\xd1\x93\x47\x09\x9e\xb1\x47\x3e\x68\x7d\xc1\x64\xce\x7a\xd3\x0a\x4c\xe7\x1c\xaf\x1a\xfa\x6e\xc0\x98\x1f\x78\xe1\x50\x51\x34\x66\x75\x40\x32\xee\x86\xb0\x24\x97\x2a\x14\x91\x1c\x1a\x39\x5a\x88\xdc\xc2\xa0\x42\xa0\x6a\xe7\xb1\x78\x9a\x1e\x0b\xe6\xc9\x86\x93\x21\x70\x50\x47\x87\x54\x88\x26\x9c\xcc\xf1\x64\x96\xc4\x64\x0a\xce\x3c\xcc\x3a\x87\xda\x9a\x2e\x7d\xfe\xaa\xe3\xfb\x20\x26\x15\x80\x25\x6e\xde\xac\x21\x42\xe1\x98\xb9\xe4\xac\x13\x50\x59\xb4\x08\xff\x3c\x36\x95\x72\xf1\x53\xe5\x9b\x14\xcf\x80\xb6\xb7\x56\xcd\xa1\xb7\x8e\xe0\xed\x5b\xc9\x7a\x24\xa8\x1c\x5d\x76\xa7\x4a\xfc\x61\x54\x89\x82\x7b\x0c\x00\xf6\x52\x2d\xfa\xcb\x24\x17\xa7\xcb\x36\xfb\x88\x8b\x13\x42\xab\xdf\x73\x74\xb1\x96\x03\xd3\xb2\x9e\xff\xc9\xb2\x9e\xa3\xb4\x6b\xd4\xdf\xd3\x56\xb6\xf4\xbf\xf5\x79\xd7\xbd\x66\xec\xf6\xb7\x6a\xd7\xa6\x23\xc4\x44\xf9\xaa\x34\xab\x55\xc3\xb5\xea\x12\xaf\xf5\xa6\x23\xc4\xe2\x76\x20\x28\xd8\xaa\x5d\x3f\x4f\xdf\x7d\x98\x6c\xf8\xf2\x3f\x66\x2e\x2d\x2f\x12\x14\x35\xfd\x6a\xc7\x1b\x86\x5d\x55\xb6\x2c\xfd\xcb\xc8\xbb\x7d\xca\x01\x95\x52\x45\x77\xe9\xcc\xc1\xd8\xa2\x60\xcf\x4c\x83\xb2\xa2\xc5\x5f\x15\x29\x00\x38\x63\x3a\x87\x8f\x77\xa2\xde\x34\x17\x8c\xae\x74\x23\x45\x87\xc8\xb4\xab\x42\xe2\x86\xae\x00\xdf\xb9\x72\x4b\xe5\x83\xc9\x9f\xcc\x34\x4d\x29\x36\x54\x25\xbc\xa5\xb3\x4d\x33\xdf\x2f\xcf\xe4\x79\xf4\x40\x36\x36\x73\xee\xad\xb2\x7c\xcb\x5c\xc2\x8f\x5d\xc6\xab\x2d\xe5\x62\x76\x86\xc2\xcb\x7a\xe9\xa5\xfd\x98\xe5\x8d\xaa\x46\xb0\x9f\xb7\xec\x51\x4e\x14\xe5\xdd\x3f\x22\x52\x14\x0e\x73\xb3\x19\x74\x77\xbf\x01\xa5\x2b\x94\x53\xd4\x2f\x66\xd7\x53\xd4\xcf\xcc\xa6\xe6\x01\xac\x0c\x65\x41\xbd\xb9\xe6\x1c\x3d\x98\xcc\x98\xa2\x25\x34\x8c\x99\x0d\xb0\x31\x20\xc1\xb7\x2e\xbd\xa7\x01\x68\x3a\x0d\x27\xc8\x30\x55\x47\x81\x43\xd0\xe9\xbd\xd3\xc5\x41\x87\xe4\xa1\x18\xf3\x1e\x51\x89\xb2\x87\x91\x64\xbe\xc2\xaa\xa9\xca\xde\x2f\x71\x47\x19\x94\x81\x3c\x12\x53\x57\x5d\xdc\xe5\x54\x37\x99\xc7\x26\xc7\x50\xab\x21\x2f\xa1\xea\x02\x0f\x7d\x12\x48\xc0\x72\x75\x88\x10\xeb\xf0\x0c\xaf\xec\xd1\x48\x46\x14\x6a\x9a\xb3\x43\xd6\x38\xed\x74\xa5\x08\xd9\x63\xad\x31\xf3\xee\x1c\xe3\xc6\x9f\xed\x82\xb5\x9e\x4a\xb0\x96\x16\xa1\x64\xe2\xb2\x65\x87\x0d\x04\xe1\x15\x94\x2f\x29\xc1\x92\x97\xd2\xf6\x28\xb0\x88\xcb\xc8\x1e\x6e\x24\x78\xe7\x9c\x05\x1d\x4e\xbc\xd1\x97\xd3\x23\x4e\x9d\xde\x08\x08\xa9\x3f\x05\xab\x1d\x8f\xec\xa1\xe1\xe1\xa0\xb3\x54\x32\xba\x9e\xad\xbc\xe4\xdf\xc8\xce\xbd\xd8\x4c\xf9\x60\x33\x00\x22\x1e\x1d\xa0\xba\xee\xad\x6c\x57\x4b\x67\xb5\x5a\x3a\xb6\x12\x9a\x86\x06\x4b\x9d\x2f\x55\x9d\x56\x80\x3b\x39\x71\xec\xd3\xe1\x18\x65\x69\x47\xa6\x1d\x75\x44\x80\x3b\x8d\xe7\x15\x60\x7b\x25\x6d\x67\xed\xe0\x51\xe7\x1b\xf5\xbc\x35\xe0\x3f\xc6\x37\x4a\x12\x48\xc2\x6f\xed\x08\x54\x41\xa7\x43\xad\xda\xc1\x84\x29\x08\x12\x4e\x34\x50\xb3\x1d\xd3\x65\xd8\xcc\x1d\x5a\xb5\x89\x67\xd2\xbc\xf3\x54\xaf\xd4\xa6\xf4\xe4\x8d\xd8\x05\xaf\x6a\x75\xed\x7d\xfd\xb3\xf1\x77\x75\x6b\xd0\x70\x91\x1f\x88\x17\xbe\x99\x68\x4b\x81\x45\xab\xfe\x00\x55\x89\x6b\xf5\xd7\xaa\xa5\x80\xfe\x7a\x6c\xb7\xd8\x75\xbd\x5b\x78\xc2\xaa\x1d\xc4\xa8\x8f\xe8\xb1\x90\xcf\xd3\x67\x55\x4c\x3b\x5a\x58\xaf\xb1\x98\x17\x8a\x70\x6f\xfd\x67\x95\x65\xd9\xd0\xb1\xae\x5d\x8a\x9a\x66\xb3\xdf\x7c\x6a\x81\xa6\x84\x7b\x4b\x87\x99\xc6\x53\x96\x7d\x5c\x79\x3e\x5e\x83\xed\x77\xbe\xd9\xe6\x85\x0d\xdd\x17\x37\xbc\x95\xbc\xf5\x78\x7e\x16\xa8\xe0\x51\xc4\x35\x30\x18\xd4\xd5\xca\xb9\xa2\x12\x21\x54\xf1\x46\x78\x74\x55\x16\x4d\xa3\x55\x0c\x98\x27\x3d\x6c\x27\x2e\xb7\x37\xd7\x2f\xde\x40\xb9\xb5\xa0\x73\x30\x4d\xd7\x2e\xcf\xed\x0f\xe0\x35\x7e\x99\x15\x3d\xa6\xe4\x00\x1d\x33\xdf\x8f\x02\x2a\x87\xe3\xb5\xb8\x59\x7b\x70\x1f\x73\xf4\xe5\xf8\xdd\xed\xc5\xe1\xd5\x07\x25\xb1\xcb\xd8\x4b\xbf\x1c\xbf\xb3\xac\xc6\x94\x46\x81\x76\x0e\xd1\x9d\x43\xb4\xd8\xf2\x81\xc3\x2d\xde\x1f\x97\x45\x5b\x9e\x79\x6d\xfe\xbe\x2f\x68\x40\x84\x40\xc7\x58\x62\x6f\x28\x64\x55\x75\x4f\x01\x14\xe2\xe8\xf8\xf6\xf3\xd1\xa7\x2f\xc8\x30\x0e\xf2\xdc\x4d\x09\x39\xda\x20\x5e\xba\x1e\xae\x07\xed\xc4\x9d\x64\x33\x68\xd8\x22\x9e\xb7\x05\x79\xb5\x63\xc3\xad\xd0\xce\x27\x3b\x1a\x0a\xe2\x79\xe3\x5c\xdb\xb2\xb9\x94\x34\x9f\xc5\x9f\xe3\x93\x4f\x7f\xfb\xdb\xe3\xc1\xbf\x26\x6a\xf6\xab\x16\x8d\xab\x88\xdb\xec\xd9\xc6\x21\x56\x1c\x37\xf8\xdb\xdf\x32\xc7\xff\xee\xfc\x63\x8e\xa1\x7a\x23\xe5\x9a\x56\x98\x70\xec\x32\x5b\xe7\x3a\xc4\x65\x79\x84\xe9\x30\xcf\x6d\x47\x0a\x56\xbf\x8d\x7d\xea\x0d\x33\xa1\x77\x8e\x7f\xd6\x39\x5a\xd9\xfc\xb8\xa4\x4e\x17\x5d\x91\x7b\x89\xd6\x95\xd1\xb5\x02\x2f\x9c\x1e\x51\xc4\x65\x4e\xfd\x79\x0b\x9d\x63\x97\xa0\x16\x34\x4c\xb6\xcf\xe4\x3a\x4b\xe0\x4e\x23\x7e\x92\x71\x94\x8e\x2f\x7c\xec\x12\xa1\x26\x31\xeb\x7e\x7b\x7c\xbe\xc5\x92\xa8\x28\xdb\x43\x8f\xb2\xa7\xec\xa4\x71\x3b\x30\x89\x1c\x7f\x2c\x8c\x26\x59\x08\x4b\x71\x7c\xf1\x1e\x9d\x70\xdc\x81\xd2\x43\xc3\x0a\xbc\x99\x69\xdb\x28\x7d\xbd\x0a\xd9\x9d\x1a\xc5\x4e\x6a\x9f\xa4\xd4\xba\xc9\x04\xe6\x78\xb4\x8e\x5b\xe8\x18\x73\x59\x29\xee\xd3\xff\x08\x6c\x11\xfe\xf7\x44\x54\xfa\x5e\x73\x7f\x0a\xe8\xc9\x11\x86\x83\x79\x9c\x80\x39\x3a\x6e\x19\x40\xd2\x44\x98\x28\xfc\xe0\x1b\x01\xd3\x3c\x57\xaf\xbc\x31\x75\xc4\xda\xce\x44\xf6\xbd\x6c\xc1\x5a\x46\x32\xf5\x53\xec\x74\xc9\x3b\x6f\x58\xee\xed\xec\x5d\x0b\x8d\x25\xf1\x57\xe3\xf8\xdd\x8f\x71\x85\xbf\xa9\x2f\x5f\x65\xd6\x33\x5c\x1b\x86\x80\x03\x2c\x68\x7b\xc3\x6c\x36\xb9\xee\xb0\x0a\x58\x85\x1b\xd5\xf2\x86\x02\x6d\xde\xb3\xef\xd9\x31\xad\xe2\x35\xd9\xb2\x41\x0f\x0e\xcc\x57\x0c\x31\x9b\x29\x30\x3d\x32\xde\x07\xab\x70\x24\xad\xa4\xb4\xcc\xab\x6e\x97\x90\xba\xdb\xe8\x9f\xe6\xcd\x0f\xf7\x48\x0e\x12\xf7\x31\x0e\xa5\xd3\x45\xe7\x79\x97\x3e\xf5\x18\x9e\x88\xab\x59\xa7\xc1\x52\x67\xe3\x3b\x8a\x06\xbf\x9a\x82\x5f\x49\xe3\xd9\x0b\x97\xdb\x2c\x40\xeb\xb0\xe5\x26\x66\x6f\x0d\xb9\xaf\x11\x53\x54\x60\x83\xa3\x68\xc0\xae\x50\xb8\x0c\x98\x06\x84\x5b\xb5\xe9\xc6\x1f\x72\x52\x65\x28\x12\x55\x7e\x6c\x24\x78\xbf\xae\x98\x19\xb7\x93\xa2\xa5\x94\xcd\xcf\xb8\xfd\x44\x5e\x17\x30\xb4\xc3\xaa\xf2\x18\x80\x17\x5d\xc3\x2d\xa8\x6e\x50\x9b\x90\xdc\x12\x7d\x40\x76\x87\x59\x56\xa3\x96\xb9\x55\xaa\xa7\x6e\x55\x85\xd8\x95\xcb\x13\xef\x36\xc8\xad\xd8\x20\x79\x87\xc5\x45\x33\x69\x3f\x67\xf1\x4b\x9b\x70\x3e\x5c\xb1\x6c\xd3\x4a\xb5\x3b\xe6\x4c\x4a\x42\x46\x6d\x17\xb3\xf8\x84\x62\x16\x9d\x44\x78\xb2\x4e\x62\x12\xc8\xcf\x39\x81\xd7\x9f\x43\xb0\x2b\x01\x43\x5b\x43\x21\x89\xbf\xcc\x75\x62\xaa\xfd\x79\x92\xa5\x7f\xdf\xa4\xd3\x84\x04\x92\x65\x9b\x57\xba\xd8\x27\x1e\x61\x41\x36\x9b\x36\x61\xa2\x76\x12\xd2\x0c\x27\x99\x96\xa5\x96\xd6\x61\x08\x77\xcd\xe2\x61\x2a\x53\xf7\x49\xb4\x42\x40\xba\xee\x50\x15\xfd\x1c\xa7\x63\x8d\xbe\xde\x98\xc6\x75\x7d\x14\xa3\x4d\xda\x75\xa8\xa4\x72\xf2\x24\xe2\xd4\xb1\x1a\xcf\xd4\xaa\xdc\xd5\x27\xd8\xf2\xe3\x37\x5d\x33\x42\x6d\x67\x19\xe0\xe4\xb0\xf2\xa9\x97\xa3\x2c\x7e\x5c\xc1\x0b\x10\x37\xbc\x28\xa9\x64\x56\xad\xb4\x6a\x07\x0f\xde\x80\x8a\x58\x25\x44\x0d\xe7\x50\xb2\xd3\x2a\x9f\x94\x7d\x55\x4f\x66\xce\x61\xc6\xe5\x32\xea\xe4\x7b\x8e\x21\xdd\xba\xec\x94\x05\x45\x87\x8e\x2b\xb6\xa3\xc0\xf5\x48\xbd\x39\x1d\x65\x7c\x37\xa1\xbe\x3a\xf0\xf0\x9d\x48\xa2\xf2\xa9\x90\xe9\xe1\xb1\xd7\xdc\x37\x20\xe1\xf7\x45\xbd\x39\xf2\xb1\x90\x84\x8f\x3c\x2c\x89\x90\x75\xdd\x45\x63\x16\x88\xc1\x71\x03\xd5\x92\xe3\xb1\xc8\x6d\x7b\x38\x01\xcd\x31\xf1\x1d\xbe\x87\xda\x4d\x42\xbf\xa8\x5e\x9b\xd7\x4d\x46\xc3\xea\x1b\x97\x78\xb4\xcf\x63\x7b\xc1\x5e\x73\x3f\x08\xfd\x51\xa7\x6b\xc6\x63\xa8\xeb\x3f\xd4\x93\xff\x73\xde\x20\x12\xea\xd5\x28\xa7\x7a\xaa\x20\x50\x48\xd3\x94\x2b\x2f\x36\xc1\x72\x23\x99\x2d\x4e\xd2\x7b\x05\x89\x2d\x69\xdb\x99\x17\xb0\x2e\xe1\xac\x47\x48\x35\xa6\xf6\xb8\xf1\xb9\xb0\xda\x1b\xf4\x3f\xc4\x64\x19\x31\xc4\x73\x1e\x7f\xf8\xf0\x62\x58\x7d\x1d\x87\x89\x3b\x45\xdc\xe7\xae\xd6\xde\xf7\x08\xcd\xee\xa8\xd9\x0f\xb3\x6f\xb5\x5d\xd2\x27\x9c\xc8\x1c\xcd\xed\xa2\xcb\x24\x43\xef\xb1\xe7\x91\xd5\x91\x6b\xe6\x96\xb3\x4f\x8d\xc8\x4e\x42\x49\x6c\xf1\xac\x1d\xe4\x5b\x03\x27\x5e\xc9\xdc\xf3\x1e\x40\x9f\xa6\xc3\x46\xcd\x34\x69\xad\xf9\x6c\xa7\xd0\x3d\x41\x85\x4e\x4c\xca\x4e\x26\xa8\xf4\x71\x97\x4a\xda\xc3\x9b\x0e\xf4\xd6\xdf\x28\xc5\x4a\x13\x54\x8d\xc3\x44\xb7\x9d\x79\x22\xf5\x70\xf0\xf8\xb0\xad\x43\xc7\x21\x42\x18\xc7\x2c\x90\x9c\x79\xc6\xa1\xe7\xb1\x81\xf1\x21\x7d\x0e\xc2\x05\x7e\x3e\xfc\x64\x1c\x5e\x9c\x19\x3f\x9f\xfe\x6b\x7c\xa2\x7d\x8c\x81\x9d\xdf\xa4\x21\xb9\x38\x88\xd5\xd6\x83\xff\x56\x00\xcd\xa2\xcb\xb8\x54\xf8\xcf\x55\x19\x85\x6e\xa0\xd3\x89\x2d\x60\x57\x70\x64\xc3\xa7\x5a\x86\x45\xa8\xc5\x3c\xfe\x47\xaa\xea\x39\x26\x78\x41\xaa\xd7\xc7\xc8\x21\x01\xf9\x9e\xa1\x97\xa7\x07\xf7\xef\x99\xcf\x8b\x61\x67\x8a\x31\x0b\x15\x4c\x8f\x9b\x33\x94\x4c\x26\xce\x19\x55\x06\x33\x17\xb5\xbe\x88\xa9\x8b\x9e\x9f\xcb\xdc\x45\xa4\x2c\x4c\x75\x5b\xd4\x7a\x3e\xcc\x4d\xda\xdd\x92\xa9\x73\x9e\x92\xf2\xc6\xd8\x3a\x6c\x3a\x8c\x13\x73\x95\x54\xba\x87\x4d\x09\xe6\x71\xb3\xc8\xe6\xb2\xc3\x2d\x7a\x88\x5b\x94\x65\x9d\x67\x42\x76\x38\xf9\x3e\xeb\x2c\xc3\xd5\x2a\xd4\x0c\x00\xa8\x77\x90\xb3\xc2\x06\xf5\x1e\x0e\xd2\x17\xe6\xaa\x64\x1e\xe6\x54\x0e\xd7\x00\x62\xe0\x78\xdc\x00\xfc\x8f\x71\x9c\x4a\xed\xe5\xc3\xd8\x8c\xe7\x53\xc0\x94\xf0\x4e\x44\x97\xc6\x16\x85\xd7\x60\xa5\x78\x98\xeb\x77\x23\xdf\x9d\x63\xc3\x2c\xc9\xca\x70\xa8\x7b\x5a\x1a\x94\xb1\x12\x9c\x51\x15\xdf\x82\x82\x8e\x11\xe3\x98\xbc\xb5\x6a\xa9\x92\x56\x39\xba\x76\xd1\x48\xca\x78\x6e\x62\xf8\x88\x62\x17\x2e\x2d\xa7\x0d\x48\x5b\xea\x04\x19\xe2\x4c\x9d\xde\x87\x8d\x99\x44\xa1\xf7\x2e\xc1\x2b\x9a\x85\x77\x77\xfe\x6d\x77\xe2\x78\xd8\x16\x3e\x71\xa9\x3a\xb7\xcc\x74\xba\x4d\x1a\xb8\xe4\x3e\x3b\x95\x15\x9e\xbd\xc2\x1e\x59\xaf\x60\x96\x6f\x80\x86\x71\x48\xec\x65\x87\x46\xc1\x43\xc3\x8d\x2c\x41\x21\xb1\xa4\x50\x55\xac\x43\xa4\xa2\x75\x38\xaf\xb0\xd5\x63\x39\x91\x36\x9e\xc3\x05\x12\x48\x72\x4f\x9c\x4a\xb3\x7f\x9c\xb4\x1b\xcb\x6a\xa8\x23\xd4\xb2\x44\xf3\x2d\xfc\x47\x7d\xfa\xef\xda\x3a\x85\x23\x26\x25\x87\x2f\xa1\x0d\xf0\x3b\xb9\xae\xce\x73\x2a\x1c\x40\x0c\x08\x08\x8b\xca\xdf\xaa\x35\x11\xe0\xdf\x9b\x46\x87\xae\x12\xea\x3d\xed\xf6\x4e\x64\x5b\x0e\xc1\x03\x5a\x5d\xe2\x58\xae\x9d\x1b\xba\x87\x74\x20\xa8\xff\x85\x85\x20\x52\x8c\x84\x64\x1c\x77\x48\xdd\xcc\x36\x7b\xe3\x48\x76\x13\x9b\x77\x32\x08\xf4\xf1\xe3\xf1\xb3\x95\xab\x9b\xa6\xc4\x64\x0b\x15\x8b\xdc\x77\xe0\x32\x2e\x35\x3b\x69\xec\x89\x7e\x36\xe1\x4d\x6e\x1b\x2a\x0f\xc8\x80\xcd\x26\x12\xb3\xe9\x4a\x4e\xdb\xe0\x78\xb8\x25\xf9\x4a\x29\xfd\xb9\xcc\x73\x18\x0d\x72\xd6\xe3\x31\x1f\x86\x92\xf9\x4a\x77\x2f\x55\xe0\xc6\x3e\x19\xe5\xa8\x77\x52\x7a\xe0\x03\x53\x37\x45\xfd\x92\x39\xf5\x93\x06\xba\xaf\x60\x91\x26\x7d\x34\x1c\x96\xc9\x33\xc2\x73\x8c\xfb\x33\xf7\xd0\x42\x42\xe7\x24\x2d\x6f\x83\xec\x10\x9e\x6d\xd1\x67\x2a\xae\x03\x6d\x25\x42\xf0\xcd\x04\x75\xa8\x9f\x6e\xf2\xab\xd8\xdc\x3f\x62\x8e\xfb\xc4\xdb\x00\x68\xd8\x86\x6d\xc2\x3b\xf8\xaf\x22\x36\xd2\xa7\x02\xff\x05\xbb\x9b\xa7\x45\x79\x69\xc4\x70\x47\x2f\xa6\xbc\x7c\xf7\x07\x95\xc6\xaa\xb4\x29\x15\xab\x26\xb6\xcb\x5e\xff\x63\x07\x0d\x3f\xac\xf0\xb5\x48\x32\xcf\x29\xe7\x8c\xaf\xff\xd2\x51\xae\xa3\x9d\xb9\xc4\x57\x03\xc9\x4c\xa5\x63\x34\xf8\x40\xfb\x64\x43\x1a\x9d\xae\xb6\x0e\x4a\x54\x97\xf6\xc9\x68\x0f\xae\x05\xc4\x85\x8e\xea\xf5\x8c\x60\xd2\xf8\xf9\x38\xec\xd3\xa3\x76\xd9\xaa\x5d\xdc\x41\xb6\xa0\x68\xce\xa1\x38\xc7\x75\xd9\x44\xd8\xd5\x79\x1a\x37\x00\xf0\x1d\xb3\x95\x96\x12\xc2\x0d\x9d\x97\xaa\xcb\x4e\xba\x58\x62\xa3\x47\x86\xa3\xf4\xf1\xe4\x9b\xcc\x77\xeb\xb5\xa2\xea\xf8\x78\xd6\xe2\x19\xd9\x6b\xee\x7b\xd4\x8e\x93\x63\xf1\x68\x7e\x83\x6b\x9b\xa5\x2e\xde\x98\x7c\x8f\x6f\x2c\x8c\x06\x06\x10\x12\x73\xc8\x31\x21\xb6\x03\x1b\xdf\x0e\x8d\xff\xfd\xfa\xdf\x7f\xf9\xbd\x92\x0b\x4a\xd2\x67\xb6\x0d\x81\xd1\x80\xfa\xe1\xa6\x19\xa4\xd1\xdb\xbb\x58\x74\x69\x00\xa0\xf0\x03\x1a\x98\xfa\x71\x61\x56\x74\x81\xd3\xea\x8a\x1a\x7d\x9e\x08\x79\xd8\xde\x06\x09\xd2\x60\xd5\x36\xfd\x66\xaa\xe5\x95\x7e\x03\xe1\xf0\x4d\xea\xbe\xad\x40\x80\x00\xc8\x15\x3a\xcc\x80\xdb\x62\x1e\xe3\xe7\xa4\xd5\x65\xe1\x7a\xcd\x50\xe5\x0e\x56\x74\x59\x08\xf1\xd2\x8d\xbb\x70\xf1\x48\x7d\x1c\xb8\x14\x57\x6a\xa8\x1d\xc3\xb8\xbf\xfd\xfa\x03\x14\xf1\x8b\xad\x1d\xfe\xd0\x89\xbb\x9f\x67\xaa\xae\x4e\x8b\xd0\x7d\x66\x2f\x10\xdf\x26\x24\xd0\x45\xc3\x2a\x65\x0d\x6d\xc3\x55\x40\x31\x47\x70\x27\x81\x71\x0f\x19\x97\xd8\xd3\x5c\x49\x09\x59\x1b\x93\xc6\x3b\xc9\xb8\xf3\x1c\x6e\x29\x16\xc4\x61\xf3\xa5\x30\x0c\xa1\xda\xf1\xe7\xf3\xf3\xd3\xcb\xe3\x53\xa3\x75\x7a\xf9\xcb\xe9\xa5\xd1\xfa\xfc\xee\xea\xcb\xe1\xe5\xe9\x1a\x0c\x8c\x0b\xef\x3d\xe7\xd4\xe1\x4c\xb0\xb6\x44\x87\xad\x8b\xc6\xa7\xd3\xab\x6a\x0d\x23\xea\x5e\x73\x28\xc2\x4f\x44\x1a\xbf\x68\xbf\xb6\x0a\x48\x6c\xbc\xa8\xe7\xdc\x7e\x0e\x5b\x17\x96\xa5\x08\x2c\xee\x42\x7f\x70\xa9\xbc\xbd\xfd\xe5\xec\xf4\x4b\xeb\xea\xf0\xea\x74\xd3\xe5\xa9\xcf\xce\x5a\xcb\x99\x63\xe6\x66\x06\xaf\x6c\x84\xb9\x81\xba\x55\xc9\xdc\x1b\xf5\xe6\xd9\x59\x6b\x61\x18\x28\x7a\xc2\xe1\x7d\x5f\x68\xe0\xb2\x81\x98\x5e\xc9\xc5\xb9\x8e\x32\x52\xf3\x33\x06\x94\x33\x0f\x33\x73\xf1\x85\x06\xaf\x5f\x8d\xbe\xd0\xe0\x3f\xff\x52\xfb\x0e\xc3\x01\xfd\x44\x0c\x95\x0e\x3c\xd0\x13\xa6\xb1\xb6\x1e\x1b\x21\x08\x9b\x3e\xa5\x62\x65\x0b\x1b\xbc\x8f\xc5\x92\x36\x0c\x7d\x1a\xe8\x01\x64\xdf\xeb\xfd\x10\xff\x06\x59\xf8\x45\x8e\x99\x15\xb3\xb8\x66\xfa\x58\x6b\x36\x57\x01\xcf\x44\x37\x47\xad\x0f\x1c\x4e\x24\xf9\xeb\xba\x3c\x12\x71\x83\xa6\x13\x77\x6c\xde\x09\x73\x99\xdc\xa0\xe4\xbd\xbf\x22\x03\x3d\xca\x4b\xb1\x33\x47\x6e\x45\x6e\x50\x32\x9d\xd9\x76\xf2\x20\x20\x8e\x2c\xcf\x46\x3e\x8f\xd9\x71\x27\xcf\x76\xd8\x40\x4f\x09\x1b\x08\x76\x38\x41\x02\xa7\x8b\x21\xdc\x2c\x8e\x50\xd7\xd2\xb2\x58\x98\x24\x66\x15\x56\x5a\x89\x6f\x63\x93\x00\xc3\x57\xff\xba\xf8\xec\x41\x0d\xd9\x91\xee\xbd\xae\x82\x8d\xa0\x12\xcb\xcb\x87\x17\xde\xbd\xe6\xbe\x1c\x86\x4c\x3f\xef\xe8\xe7\x57\xae\x56\x36\xc7\xcd\x0b\x0d\xa2\xcf\x21\x09\x50\x8b\x45\xdc\x21\xe0\xf1\xdd\x6d\xa0\x4f\xd6\x9f\x03\xd3\x99\xb3\x79\x4a\x12\x50\x97\x6d\x61\x61\x82\x84\xb4\x1d\x6a\xcb\x1f\x41\x0e\xd5\x54\xe6\x55\x25\x50\xcf\x09\x2a\xb6\x55\x1a\x05\x15\xb0\x21\xa2\x5f\x66\x4b\x9b\xae\x20\x99\xbb\xbc\xa5\xa5\xf2\x96\x26\xaa\x39\x7d\xaf\xec\x5a\xaa\x0a\x54\x21\x05\xfb\x1b\x09\x3a\x24\x90\x54\x35\x4a\x02\xa3\x63\x4f\x36\x9d\x2c\xc6\xcc\xd5\x2a\x8f\xd8\xfd\x12\x65\xc6\x8f\x3c\xd6\x11\x1b\x5a\xbd\x40\x2a\x9a\xd0\xbd\x8e\x99\xe7\x02\xf5\x2b\x24\x2a\xc1\x4c\xa8\xf7\xdf\x29\xe6\x57\x6e\xb4\x3d\x66\xac\x47\xe3\x75\x70\xf5\xf9\xe7\xd3\x4f\x6f\x97\x30\xc3\x42\x81\xbf\xd6\xd5\xe1\xe5\x15\xd2\xad\x5f\xe1\x8e\x0a\x07\x6d\xfb\xcb\xd4\xca\x34\x9d\xb6\x02\x8f\xaa\xba\x4c\xe6\xcc\x42\x47\x6b\x0d\x94\x7b\xda\xc1\x6c\x4b\x6f\x10\x68\x89\x14\xdd\x72\x77\x9f\xf8\x76\x06\x10\xc1\x6a\x69\xda\xec\x3e\x5f\x4f\x95\xed\xc8\xab\xea\x6e\x96\xdc\xae\x62\x67\x72\xbd\x69\x9a\xe3\x88\x7d\x97\x0d\x02\x8f\x61\x57\x8c\xa8\x0f\xc2\x35\xea\x53\x97\x30\x01\x37\x2f\x88\xcd\x91\xcd\xb6\x7e\x50\xc3\x81\x8c\x9c\x94\x5a\xed\x62\xab\xaf\x31\x71\x24\xed\x3a\xc7\xb0\x17\x67\x35\x7e\xc4\xf6\x46\xb2\x8b\x9c\x94\x02\xed\x9e\x97\x50\x31\x5f\x90\xb8\xb2\x8e\xa9\x3e\x9a\x52\x90\xc0\xad\x00\x14\x0d\x18\x95\x74\xd8\xa0\xd2\x9c\x22\x66\x31\xcf\xc2\x90\x70\x08\x5f\x58\x03\xde\x12\xec\xd9\xe3\x0e\xd1\x64\x9b\xc3\x59\xad\x73\x17\x94\xf9\x07\xbf\x3c\x25\x62\x60\x74\xb4\x00\x64\x3b\x35\x84\xcf\xc4\xda\xe3\x4c\xd2\x55\x1d\xf7\x7e\xdb\x8e\x02\x07\xdc\xfc\xa2\x0a\x4c\xc3\xa4\x97\x2c\x50\x5b\x26\x59\x20\xe9\x36\x5e\x24\x35\x61\xbb\xd5\xf8\x74\x01\x68\xf5\x14\x66\x1f\xb0\x91\xd3\x3d\x39\xaa\xc6\xa5\xa8\x9a\x36\xf3\x50\x95\xd7\x14\xfb\x14\x39\x5d\xd7\x6e\x4c\x23\x84\x2f\xe0\x48\x20\xbd\xe1\x53\x4d\xda\xd6\xee\xa8\x28\x90\x0d\x5d\x69\x72\xfe\x10\x07\x36\x5b\x2b\x48\xe8\x8d\xee\x72\x43\x6e\xa9\x53\x0e\x65\xbc\xd6\xbf\x27\xe9\x7e\xf7\xd0\xe7\xab\x0b\xd0\xd1\xd5\x3a\x38\x3c\xba\x34\x9e\x0c\x7a\x1a\x6c\x22\x84\x2f\x5b\x03\x2d\xa0\x01\x81\xff\x8b\x06\x89\x16\x8a\x20\xc7\x6d\x59\x42\x0e\xe1\x9c\xcd\xdd\xb2\xec\xb4\x75\xcb\xb2\x37\x23\x72\xff\xa2\x74\x03\x99\x83\x93\x72\xbc\x08\x08\x35\x8d\xcf\x1e\x52\xda\x4e\x3a\x8e\x6f\x33\x56\x4d\x81\x22\x5a\x35\x72\x2f\x09\x0f\xb0\x67\xd5\x0e\xfe\x45\xe9\x98\x40\x05\x73\xff\xf2\x61\xec\x1c\x92\xc3\x10\xe2\xe6\xba\xd4\x75\x21\x0b\x0b\xf5\xb1\x17\xc1\x17\x5f\x75\x58\xfc\x9f\x8d\xbf\x5f\xff\xfb\x2f\x7f\xfe\xdd\xaa\xa1\x38\xc2\xee\x5f\x67\x67\xb7\xc7\xad\xcb\x77\xb7\xca\x4a\x64\xd5\x90\x65\x99\xb3\x4d\xff\xc9\xb2\xbe\x1e\x9f\x1c\x5e\x1d\x5a\xd6\xd7\x7f\x9d\x9d\x19\x47\x1f\x3f\x1f\xff\x0c\xf9\xe9\x1f\x4e\x0f\x4f\x46\x47\x9f\x4f\xfe\x65\x1c\x9d\xbe\x3f\xfb\xa4\xff\x3c\xfd\x74\x52\xb7\xac\xeb\xa5\x4c\x45\xfa\x36\x6c\x4e\x52\xf9\x5f\xbf\x5b\x96\x39\xa4\x54\xe9\x83\xcf\xa6\x29\x8a\xbf\xdf\x6b\xee\xf7\xb1\x47\x5d\x15\x1f\x3a\xc2\xaa\x6e\xd0\x3b\xc6\xfd\xfa\x84\x0e\x89\x76\xf9\x99\xbb\xfc\xcc\x02\xbb\x6c\x12\x3d\x36\xb9\x1a\x97\x4a\xd4\x74\x60\xaf\x83\x14\xcd\x2c\x2d\x4f\xef\x87\xc9\x55\xaa\xa4\x30\xe5\xc5\x1b\x6f\xdc\xc2\xc6\x76\xdf\xd9\xd3\x25\x67\x0f\x4e\x4f\x19\x54\x34\x32\xb9\xc0\x89\x83\xb6\x34\xbd\x7b\xfa\x64\x42\xc5\xe2\x89\xe7\x9d\x50\xa5\x98\xab\x67\xb7\xaa\xf5\x9c\x5a\x15\x9f\x5c\xa5\x9c\x5e\x39\x9b\xdd\x4c\x6f\x4b\x9e\x64\xab\x9c\x66\x68\xad\xf1\xd8\x0f\x4f\x39\xb4\x51\xb4\xd5\xa5\x4e\xbe\x15\x4e\x3f\xf4\x5d\x84\x70\xcf\x39\x25\xd1\xb2\xe1\xda\x79\x27\xe6\x52\x47\xef\xec\xe9\xb9\xc4\x91\x1b\x1f\x72\x39\xe7\xee\xb7\x21\x3a\xed\x74\x36\x03\x8c\xa6\xbe\x81\x4d\x12\xc8\x20\x9d\x4e\xbc\x5f\x86\xb0\x22\xd2\x84\x4c\xcb\x72\x5f\xa8\xff\x54\x61\x00\x8d\x3b\xce\xe6\x11\x95\x84\x6d\x1a\x30\x5f\x39\xd1\x1c\xac\xdc\xcd\x8a\x20\xcd\x2b\x28\x82\x07\xa5\x80\x2c\xab\x51\x6f\xa6\x3f\x04\x44\xd6\xcd\xb1\x49\xc4\x34\x35\x04\x5d\xfc\x26\xfc\x0c\xf1\xe0\x9e\x6b\x7a\x6e\x05\x2c\x55\x7d\x64\x32\x94\x09\x81\x2e\xe8\x3d\xf1\x36\x22\x76\x12\x2b\x41\xe3\x42\x84\xf7\xb1\x5f\xd1\x14\x3f\x56\x20\x5d\x90\xf9\xee\x12\xd1\x4b\xfa\xca\x66\x0a\xe4\xf4\x1a\x1f\x19\x93\x1b\x4a\xfe\xbd\x31\x1d\x4d\x83\xc7\x98\x1c\xa3\x1d\x4c\xc8\xd1\x8d\x39\x20\x76\x9c\x79\x1f\x72\x36\xf5\x8b\x7e\x37\x7e\x75\xfa\x47\xd3\xe1\x9e\xac\x24\xe7\x7c\x4c\x6f\x76\xd6\x79\x64\x93\xea\x80\xeb\x10\x42\x31\x42\xc2\x1c\xe5\x50\x83\x1e\x44\x36\x71\x74\x15\x2f\x58\xb2\x6f\x42\xf5\xed\xc1\xc4\xe3\x09\x85\xf5\x12\x62\x8f\x93\xde\x76\x8e\x91\x27\xec\x18\x89\xe7\x30\x73\xc7\x88\xda\x79\x29\xd0\xef\x58\x20\x91\x86\x76\x2e\x3b\xea\x00\x3a\x37\x86\x11\xad\xe2\x50\x86\xb6\x1b\xa2\xcb\x7e\x63\x50\xdd\x2e\x8b\x07\x27\xaf\x37\x5d\x98\xd0\x7d\xad\xa1\x63\x50\x1f\x54\x94\x4a\xc1\x3a\xe1\x44\x79\x9d\x5d\x7e\xef\xe4\xc3\xd5\xf9\xc7\x5f\x0b\xf3\xe4\x23\xb5\x39\x5e\x21\xb6\x22\x87\x29\x2e\x3c\x78\x0f\xdb\x29\x0b\xaa\x60\x82\x6a\x3e\x53\x2c\xce\xd1\x05\xf3\x58\xc8\xf2\xdc\x64\xab\x47\x39\x29\xd4\x99\x49\x80\xf8\x11\xf5\x3b\xfa\x93\xe0\x4e\xfd\xad\x55\x33\xc3\x98\x84\xdb\xf6\x6c\xee\x5d\xf9\x06\xa5\x5d\x0c\xf4\xc3\x18\xe8\x02\xfb\x2c\x96\xe4\x7e\x3a\x12\xcf\xf5\x8d\x64\xde\x16\x4a\xd7\xa7\x4f\x8f\x77\x0a\x8d\xe3\x50\xdd\x20\xb8\x3d\x13\xe7\xcc\xa6\x1e\x79\x3b\xd6\x9a\x4e\x3e\x7d\xfa\x1c\xc9\x30\x92\xc7\xaa\xe6\xf6\x0c\xa0\xeb\xaf\x06\x24\xc4\x72\x22\x44\x7a\x34\x9e\x30\xf9\x89\xc8\x4f\x51\x8f\xd4\x72\x23\xa2\x40\xe7\x18\x3f\x8f\x8e\x19\x0f\x19\x9f\xc4\xd4\x48\x9e\x3b\xf9\xf4\x09\x5d\x78\x58\xb6\x19\xf7\x73\x4b\xd1\xdd\x09\xd3\x0d\x02\x28\x5d\x32\x83\xa1\x15\xff\xb2\x6c\x85\xba\x45\x23\xda\xe1\x41\xec\xf0\x20\x10\xda\xe1\x41\xec\xf0\x20\x76\x78\x10\xeb\xc3\x83\x70\x83\x00\xc6\x32\xc8\x01\xee\x3e\xb9\x7a\x5f\x95\xce\xa5\x11\x06\x5b\x54\x12\x34\x71\x77\x86\x0e\xab\xc6\x16\x62\x81\xb2\x9a\x55\xe0\xad\x5b\x49\x9f\x9a\x94\x69\x16\x30\x15\x81\x4d\xf8\xfc\xab\x47\x75\x1b\xfd\x39\x96\xcc\x67\xcb\xed\xf5\x33\x26\xbd\x65\x77\xfa\x47\x6f\xd6\x33\xe4\x84\x74\x40\x7b\x8a\x59\xa3\xe4\xcf\xb0\x1b\xae\xe0\x52\x42\xa8\x86\xc3\xd0\x23\x06\x95\x51\x40\x84\x81\xc3\xb0\xb6\xaf\xbe\x33\xa8\xfb\xf6\x6f\xaf\xff\xf6\xea\xc7\xff\xfc\xaf\xff\xfa\xdb\x43\x1f\xd7\x94\xc2\x03\x47\x97\x62\xea\xe8\x02\xa8\xa9\x23\x63\x2a\x83\x1c\x0e\xca\x31\x03\x1f\x36\xc5\x58\xc7\x23\x46\xe8\xe1\xe1\x4c\xff\x8c\x83\xc5\x33\x19\xa1\xaf\x54\xcd\x57\xb5\xca\x22\x0d\xa0\x9f\xc9\x78\xac\xa5\x77\x26\x3f\x5e\x6f\x71\x86\x2b\x2a\x8a\x07\xed\xca\x4e\x23\x58\x58\xef\xe6\x04\x07\x4e\x99\x10\xd0\x53\x47\xdd\x05\xe1\x1e\xd2\x3d\x4c\xa4\x15\xbc\x5c\x14\x8a\x30\xff\xf1\x8d\xc4\x22\x00\x29\xeb\xb7\xfa\x59\x96\x0d\x1d\x5b\x96\xbd\xd7\xdc\x47\x4d\xb3\xd9\x6f\x3e\xb5\xca\xad\x84\x7b\xcb\x44\x1d\xc2\xf3\xae\x9a\xf1\x4c\x0b\x0e\x0e\x02\xc2\xaa\x09\x3c\x84\x84\xeb\xb8\xfd\xf2\x65\xee\xc1\xe5\x2d\x1d\xc8\xa3\x92\xbb\x0f\x55\x3c\x74\xf1\xbb\xdb\x83\x8b\xc4\xb2\xf5\x84\x75\x87\x7b\xcd\xfd\x67\xa3\xf1\xa5\x61\xf4\xf5\xc6\x34\xae\xeb\xa3\xb8\xde\xb0\x5d\x07\x20\xaa\x93\xfa\x53\x10\xd3\xe9\x80\x72\x94\x57\xd4\x72\xe7\x01\xd8\xbc\x07\xc0\x55\x4b\x27\x53\xc9\xc6\x7c\x40\x73\x8c\xff\x0b\x2e\x77\x85\x4e\xb3\xa9\xf6\xe7\x4c\x42\xfc\xfb\x26\x73\x08\x58\x48\x02\xa1\xf4\xa2\x86\xd2\xbc\xb2\xd9\x25\xb1\xcb\x7c\x52\x1a\xca\xbe\x62\x09\xb4\x7a\xc2\xfc\x07\xb6\xb9\x09\x5b\x44\xf2\xc8\xb3\x49\x56\x26\x5f\x1a\xc7\x67\x27\x2b\x21\xa6\x22\x84\x10\x42\xb5\x9b\xd4\x0f\x0a\x9e\x45\x37\x1e\xa3\x2e\x4f\x04\x61\x56\xd7\x71\x5c\x55\xb9\x7c\x4f\xfa\x81\x5e\xb2\xf8\xfd\x91\xb6\x09\x3a\x0d\x3a\xb9\x69\x9e\x9b\xc8\x1d\x9b\x25\x70\xe7\x2a\xdd\xce\x8d\xf2\xe5\x4e\x31\x58\xa7\x62\x90\xbb\xf6\x3d\x62\x04\x64\x20\x1a\x3c\xca\x5a\xfa\x57\xd8\xf6\x88\xd8\xb8\x17\x32\xa5\xa4\xe4\xd2\xb7\x77\xff\x88\x08\x1f\x2e\x5d\x5b\x79\x66\x98\xeb\x30\x0e\xdd\xfd\x06\x94\x7e\x6d\x18\xd7\xb1\xc0\x3e\xb7\x2c\xb7\x0e\xf1\x62\xcf\xa7\x3d\x34\x08\x21\x54\x1b\x4b\xb5\xa9\x5f\x5c\x58\x14\x65\xa2\xf1\x98\xb7\xea\xd1\x66\x5f\x19\xa1\xc0\xf7\x51\x6f\xbe\x9d\x7b\x9d\xab\xb4\x86\x73\x4c\xd1\x12\x3e\x40\x90\x11\xa9\x65\x24\x23\x15\xfc\x04\x0f\x73\xeb\x82\x56\x28\xcf\xe5\x9e\xdf\x1d\x2a\xbb\x91\xad\x2c\xd2\x14\xfb\xbd\x28\x30\x5d\x3c\xbc\x5b\x88\x4d\x74\x42\x6c\x8a\xab\xd4\x34\x27\xdb\x9f\x73\x8a\xed\x35\xf7\xf5\x23\x23\x97\x49\x97\xd8\xa3\xbd\x90\x49\x2c\xd9\x68\xc0\x98\x3b\x1c\x09\xcc\x3b\x64\x44\xa4\xd3\x1d\x79\x24\x08\x86\x23\xf1\x5b\x44\xc8\x37\x32\x1a\x74\x09\xf9\x36\x1c\xdd\x11\x21\x28\x19\x09\xc9\xd5\x33\x76\x04\xf5\xc2\x46\x82\xba\xf5\xfa\x46\xb5\x57\x57\x8d\x29\xd3\x10\x40\x5c\xb2\xc6\x12\x86\xc9\xa6\x49\x5c\x02\xe0\x44\xbb\x32\xe1\x7f\xa4\xfb\x24\x71\x49\x4e\x02\xd6\x09\xe5\xc4\x91\x87\x50\x57\x29\x5b\xe2\x3e\x30\xa1\x56\xfa\x05\x0e\x88\xb7\xd4\x42\x1f\xf7\x80\x4e\x30\xf1\x59\x30\x51\xff\xb2\x56\xc4\xf1\x73\x30\xd1\x04\x64\xcc\x28\x7b\x38\xe0\x19\x71\xe6\x69\x7a\x76\x30\x2e\x3b\xa5\x7f\xa7\xf4\x97\xac\xf4\x2b\xc7\x8a\x5a\x79\x18\x56\x5e\xf6\x2e\x22\x1c\x16\x71\x91\x67\x68\x21\x42\xe0\x0e\x41\x47\x50\x75\x7c\xfd\x18\x86\x29\x95\xb1\xe7\x21\x99\x57\xd0\x8d\x67\x0b\x09\x14\xda\x16\x2e\x23\x7b\x88\x58\x80\x2e\x31\xf5\x44\xe5\x61\x46\x63\xf9\xf4\x99\x7b\xbb\xd7\xdc\xe7\xd0\xed\x08\x7c\xc0\x39\xa1\x46\x73\x9e\x2f\xed\xaa\x91\xe4\xf7\x81\x2d\x8e\x3a\x2a\x94\xcc\x00\x23\x94\x65\xb9\xd7\xff\x7e\xfd\xea\x77\x73\x19\x97\x34\x42\x35\x28\x99\x6a\x84\x98\x63\x5f\x6d\x6b\xaa\xea\x5f\x20\xa9\x43\xe5\x50\x57\x52\x7d\x56\x7b\xbc\x07\x1b\x26\x6e\x3b\x52\xd5\xc1\xf3\xcb\x82\x0e\x27\xde\xe8\xcb\xe9\x11\xa7\x4e\x6f\x04\xc4\xd5\xff\x48\x19\xe8\x3c\xb2\x87\xc6\x2c\x56\x07\x5a\xc6\x37\x0c\x2d\xb0\x40\xc9\xef\x72\x76\x8b\x64\xc5\x67\x2a\xdc\x54\x38\xd1\xb7\x3f\xa1\x5f\xb7\x7e\xf3\x52\x54\x2e\x2e\x7e\xb2\x53\x65\x9e\x8a\x62\xae\x8f\x56\x98\xd0\x4c\xf3\x03\x15\xbf\x45\x79\x48\xde\x90\xbb\x14\xc8\x62\x17\xf0\x85\x7a\x76\x52\xb2\x95\xba\x6f\xad\x9a\xab\xba\xbd\x95\x5d\x4e\xb0\x9b\x51\xb7\x1a\x21\x84\x10\x4a\x1e\x8f\xb8\x57\xb6\xc3\x41\x35\x9c\xa9\x76\xdc\xe1\xa0\xc3\xaa\xaf\xed\xbd\xd7\xdc\x0f\x27\x53\xbc\xe2\x5b\x89\xea\x1d\x4d\x48\xea\x1b\xcb\x32\xf1\xc1\x68\x1c\xa5\xfb\x5c\x45\xe9\x7e\xb5\x6a\x3f\x5c\xc3\xb9\xe6\x53\xd7\xf5\x08\x04\xd2\xa9\x93\x4c\x7d\xaf\x1e\x3b\xa8\x1e\x88\x72\x28\xbb\x2c\x58\xff\xca\x8f\x55\x65\x51\xd7\x04\x2c\x8c\xc8\xdd\xe2\xa5\xad\x08\x5f\xee\xd8\x51\x92\x11\x72\x76\x47\x1c\x99\x2f\xc0\x68\x8d\xe6\x9e\x92\xc4\x69\x6a\xe5\xa1\xcd\x00\x49\xad\x6d\x55\xa2\x8d\x85\xd4\x3f\x58\xb5\x9b\xd5\x54\x0b\xad\xe4\xa7\xaf\xaf\x3e\x5c\xf1\x4b\x29\xab\x73\x57\xff\x12\xfb\x86\x01\x26\xbb\x2c\x7d\x95\x39\x91\xc0\x11\xcf\x53\x0d\xe0\x39\xd0\x0d\xd4\x9d\x0c\x5d\x31\xe6\xad\x5f\x6b\xbd\x19\x13\xbb\x4a\x0d\xa4\x4b\x82\x1d\xb9\xb4\xc3\xad\x8a\xcd\xe6\xcd\xb8\xa4\x3d\x57\x44\x2d\x71\x45\x56\x2f\xec\x35\xf7\x8d\x01\x95\x5d\x03\xbb\x2e\x0b\x44\xbd\xb9\x82\x77\x2e\x69\x28\xc3\x39\xa7\x1e\x99\xf2\x7b\xa2\xaa\xbd\x6f\xaa\xcb\x99\xb2\x62\x19\xa6\xbc\x01\xb1\x43\xec\xf4\x8a\xcd\xea\x9c\xd0\x1c\xf4\x74\x93\x15\x95\x55\x4d\x0f\xbf\xa1\x19\x66\x16\xdf\x1b\xd2\x85\xd4\xa0\x19\x01\x38\x0c\x12\x02\xf2\xae\x0c\x81\xc4\x00\x23\xf1\x08\x64\x75\x74\xd5\xa5\x02\x39\xf1\xe5\x83\x0a\x44\xee\x43\xe2\x48\x7d\x14\xcb\x2e\x41\xae\x22\x04\x7d\x38\x3d\xfc\x78\xf5\xe1\xf8\xc3\xe9\xf1\xcf\x08\x41\x41\xb6\xf5\x78\xd9\xd4\xfd\x4a\x51\x90\x89\x0f\x71\xc2\xee\xd8\xa6\xa3\x15\xc6\xab\xdb\x65\x77\x4c\xfd\x47\xaf\xf0\x7b\xb7\xfc\x4c\x72\x2d\x48\x77\x4c\x32\xe6\xf5\xa8\xcc\x3e\x65\x7a\x84\xe5\x9c\x30\x1f\x57\x88\x45\x8e\xdb\xcd\x87\xd4\x80\x72\xeb\x63\xad\x4e\xbd\x35\xfa\x9f\xd4\x87\x3a\xec\x08\x6a\xb2\x5b\xb5\xeb\x17\xae\xfa\xfa\xd6\xf6\x22\x52\x06\x82\xc6\x3c\xda\x76\x46\x95\xad\x31\xaa\x64\x1c\x2a\xbf\x2a\xdf\xf5\x13\x88\xcc\xb9\x07\x42\x6f\x21\x55\x7a\x7d\x87\xf4\xbe\x69\xaa\x6e\x8d\x44\x27\x2d\x78\x52\xef\xe2\x9d\xd0\x93\x89\x77\xca\xaa\xfb\xf6\xf3\xa9\x4b\x61\x87\x2b\x34\x91\x97\xd4\xe9\xa2\x2b\x72\x2f\x91\x7e\xeb\x0f\x01\x11\xe1\xf4\x88\x1a\xcc\x72\xb7\x31\x75\x1a\x64\x9a\x6f\x58\x2f\xfa\x42\x7b\x39\x35\x32\xe0\x89\x72\x0c\xb3\xac\x17\x0d\x68\x8f\xde\xde\x4e\x16\xb6\xd5\x27\x64\x8c\x48\xfa\xbf\x8d\x9f\xa9\x95\x72\x7b\xd3\x03\xdc\x43\x97\xc4\x23\x58\x10\x04\xb2\x6e\xec\x5c\x0f\x4f\xb8\x24\xb8\xd6\x8d\xb5\x94\x64\x2b\x80\xd2\xf1\x08\xe6\x65\xa4\xa3\x25\x6d\x19\x2d\x85\x47\x68\x4c\x42\x9b\x6c\x26\xf7\x71\x27\x8a\x5b\x10\x9e\x16\x4b\x45\xb6\x14\x46\xb6\x47\x8e\x3d\xea\xf4\xd0\xa1\x8b\x4e\xef\x9d\x2e\x0e\x3a\x04\xed\x1d\xba\xbf\xd6\x37\x8d\xcd\xa9\x13\xaf\xc5\x30\x70\xe3\xc8\x87\x09\x1c\x53\xec\x9a\xa2\xcb\x06\xb7\xd8\x15\x33\xa0\x38\x32\x74\x2c\xab\xb1\xe8\x5d\x81\xdb\x44\xa1\xc7\x8e\x5f\x58\xf0\x68\xe3\x39\xb6\x3b\x15\xd5\x76\x73\x15\xdb\x1d\x60\xbb\x3d\xd4\xfd\x6b\xea\x98\x17\x01\x05\xc2\x74\x69\x87\x4a\xec\x19\x3e\xe6\x3d\x02\x91\x81\x26\x76\x0d\x12\x4f\x8f\x59\x64\x3a\x8f\xb1\x1f\x62\xda\x09\xd0\x39\x0e\x70\x07\xf2\xa1\x4f\x8e\xcf\x37\x3e\xa7\xaf\x7c\x37\xd0\x48\xaa\xdb\xc1\x55\x27\xe6\x92\xe1\x6b\x2e\x15\x62\xed\x3b\x8f\x31\x57\x55\xb8\xdf\x34\x3b\xe3\x62\x90\xa6\xd9\xf6\x60\x1d\x4c\x70\xa0\x0a\x26\x8b\x28\x04\xfb\x40\x63\x82\xb7\xae\x30\x71\x20\x06\x84\x9b\xff\xf9\xe7\x57\x7f\xff\xdb\x8f\xaf\x9b\x5d\xef\x2d\x09\x8a\x70\xb1\xcd\x38\xba\x88\x6c\x8f\x8a\x2e\xe1\x02\xed\x9d\xbc\xbb\xd8\x92\x2d\x47\xe2\x0e\xc0\xc9\x50\x87\x88\xa4\xdc\x23\xee\x00\xd8\x56\x27\x04\xfb\xf0\xad\x46\x7a\xa8\x04\x89\x50\xd7\x1c\x1d\xb3\xb7\x1d\x2e\x66\x65\x9f\x48\x4c\xbd\x2f\x97\x17\x55\x01\xc3\x00\x12\x9f\x72\xd5\x25\x88\xfc\x96\x65\x4e\x74\x6b\x59\x66\x5e\xf4\x42\xce\x0b\x8f\x80\x8e\xd9\xc1\x90\xed\x60\xc8\x76\x30\x64\x3b\x18\xb2\x9c\xc9\x40\x3b\x18\xb2\x62\xb7\x05\xad\x40\xe9\xad\x9a\x06\x50\x4f\x85\x64\xc7\xd6\xb0\xfb\x61\x87\x04\x55\xfb\xc8\x11\x42\xb1\xcb\x42\xf9\xc5\xde\x6b\xfb\x49\x8c\x50\xa6\x49\x18\xe3\xa0\x8c\xd2\x13\xeb\x85\xab\x7f\x83\xe3\x5b\x88\x72\x9c\x17\x33\x9d\xd5\x1e\x73\xc4\x0b\x09\x4e\xca\xc0\x33\xff\x0f\x97\xfa\x54\x72\x6a\xc6\x04\x2f\xd6\x3f\x39\xc1\xfe\x17\x82\xfb\x79\xfe\xc7\x29\xbb\xe2\x2a\xce\x47\x75\xe2\xef\x35\xf7\xcf\x02\x21\x01\x57\xe7\x88\x74\x68\x00\xad\x42\x42\xe7\x48\xd1\x31\x50\x74\xec\x29\x4e\xd7\x25\xe4\x0a\xca\xd1\xc9\x97\x8f\x78\xc8\x22\x79\x42\xda\x38\xf2\xa4\x4a\x11\xae\xaf\xd1\x21\x39\xa7\xfc\xbb\x3b\xa6\x35\x2d\xfc\x3e\x9f\xb7\x51\x88\xbd\xc7\x5b\x62\x4e\xef\x43\xca\xd5\x6b\xb5\x1f\xff\x8e\x3e\xb1\x3e\xfa\xf1\xef\x7f\xfb\xaf\x29\x8c\x0a\xd5\x93\xb1\x00\x7b\xf6\xfd\xb4\x75\x50\x3d\xab\x2c\xe2\xe2\xc1\xf9\x96\x07\xa0\x3c\x12\x72\xe8\x91\xba\x9a\x21\xab\x66\x02\xc3\x04\x94\xba\x70\xf5\xec\x8c\xb0\xe7\xd5\xe1\xb3\xec\x12\x9f\x88\x91\xcf\xdc\xc8\x23\xa2\x9e\xab\xd0\xb9\x8a\xa8\x65\x11\x67\x8b\x8d\x65\x67\x5a\x7a\x32\x56\x4e\x2d\x06\x99\x96\x25\xf5\x44\xb9\xb5\xe7\x16\x0a\xbd\x12\xf3\xbd\xe6\xbe\xb2\xe3\xdb\x1e\x73\x7a\x5f\x6f\x8d\xeb\xa4\x51\xf5\x37\xe6\x32\xf9\x77\xe4\x78\x58\x88\xb7\x56\x6d\xf2\x81\x78\xd3\x80\x3f\xdb\x94\x78\x6e\xe5\x21\xc3\x53\x9b\x0e\x2a\xb7\x50\x5d\xce\x46\x94\xbf\x19\x2d\xbf\x21\x15\xba\xd8\x94\xb2\x39\xa1\x42\x3e\xcb\x07\x1b\x15\x2a\x90\x1d\xd5\x59\x7d\xbc\xbb\x52\x9e\xdf\x75\x29\xcf\xe9\x0d\xb1\x88\xc2\xad\xdf\x48\xab\x89\x65\x6d\xa5\xc3\x00\xfb\xd4\x19\x10\x3b\x5f\x45\x79\xf9\x70\x4b\x4d\xbf\x5a\x5f\xf1\xf2\x07\xca\x73\x3a\x82\x47\x81\x16\xee\x8c\x3e\x3b\xa3\xcf\xce\xe8\xb3\x33\xfa\xec\x8c\x3e\x65\x1a\x7d\xd2\xbd\xb9\xe1\xf6\xb2\x8e\x20\x80\x43\x27\xa5\x54\x16\x54\x5b\x26\xf4\x7b\x05\x4d\x1a\x3f\xb5\x8c\xc3\x0e\x09\xe4\x63\x40\x1b\x5d\x89\xa1\x89\xd5\x20\xc9\xf2\x4e\xea\x64\xf0\x59\xf6\xb0\x53\x2c\x86\x45\x60\x19\x4f\x48\x3f\x36\x84\xbd\x5c\x11\xfb\xe5\xde\x88\xf3\xb2\x0c\x5b\x9f\x36\xe3\xae\xd1\x5e\xe3\x79\xfd\xd9\x66\xf0\xae\xa7\x62\xe3\xf3\xee\x33\x33\x31\xf2\xa8\x8c\x14\x92\x47\xc7\xcd\xa3\x8d\x27\x2f\x3c\x8c\xab\x2f\x74\x1b\x27\x58\x0c\x89\x12\x80\x06\x65\x0b\x05\xf4\xd8\x38\xfe\xe7\xd1\x69\x35\x17\xf1\x9c\xd5\x49\x1c\xa8\xbc\x37\x13\xa8\x31\xa0\xc1\x2d\x0b\x4b\x85\x10\xdc\x19\x87\xb6\x24\xfb\x9e\x38\x06\xcc\x78\x56\xfa\xfd\xa9\x47\x60\x95\xb2\x1c\x73\xf6\x47\x1c\xb8\x7a\x73\x04\x70\x88\x88\x7a\xee\xea\x99\x35\x2e\xed\x23\x6d\xfb\x81\x4b\x7d\x6d\xf4\x43\x1d\xf2\x1c\x7e\xb8\x7e\x4e\x66\x68\x81\x87\x05\x71\x94\xd7\xa2\xf0\x0b\xd3\x75\xeb\x16\xbe\x11\x23\xba\x14\x7e\x31\x0a\x3d\x86\x5d\x61\x8e\x1b\x70\x84\xc8\x33\xd3\xce\xf6\x06\xc1\x0b\x10\xaf\xad\x8b\x3d\xeb\x10\xeb\x71\x64\xf5\xb3\xb2\xd6\xdf\x17\xc6\xdd\x0b\x4e\x84\x28\x6e\xd6\x9a\x5a\x2d\x47\x1e\xeb\x2c\xb7\x0c\x81\x77\xd0\x33\x27\xde\x5b\x2b\xdd\x10\x71\x08\x05\x34\x07\x96\xa5\x92\xc0\x12\x90\x85\x42\xa7\x08\x34\x88\xa0\x35\x95\xa8\xac\x0c\x55\xa2\x4b\x88\x54\x1f\xd5\x2c\xbd\x30\x07\x21\x94\xf3\x77\x58\x20\x49\x20\x47\x34\x70\xbc\xc8\x55\xf6\xa9\xa9\xc1\x24\xd1\xc5\xcf\xb5\x04\xa9\x06\xb0\x1f\x1a\x83\xd0\xa8\xbd\x7c\xd0\xa7\x6a\x59\xc4\xf5\xb8\x07\xa1\x8e\x3b\x59\xc6\xe0\xb5\x98\xaa\x15\xcd\x5f\xe9\x64\xa2\xdc\xfd\x6a\x67\xfc\xfa\x43\x1a\xbf\x5e\xe6\x4f\xe6\xf9\xb0\xf5\x8f\x8f\xcb\x4d\x27\x40\x19\xdb\x58\x90\x4d\xd5\x2a\xda\x28\x9b\xfd\xa1\xf8\xcd\x9b\x5e\xda\xcb\x5c\xf1\x18\x77\x55\x75\xca\xa5\xf0\x32\xd2\xc3\x20\xf3\xfe\xf2\xf1\xf3\xfb\xd2\x70\xfc\x17\x9e\xc0\x92\x4a\x8f\x1c\x40\x5f\xe8\x23\xeb\xd8\x8c\xf5\x50\x8b\x78\xfa\xa4\x7d\x63\xea\x5f\xd7\xe0\x33\x56\x17\x79\x17\x8b\x46\x28\x68\xc3\xe9\x9a\xc4\x63\x9d\x2c\xc6\x20\x40\x22\xcc\x07\xc2\x99\xb2\x78\x15\x42\xef\x4c\x1b\x4f\xf6\xd8\x39\xc9\x1f\xeb\xba\xc0\x4d\x48\x00\x5a\x5f\xda\xf8\xe4\x39\x58\x5c\x3a\x36\xad\x12\xcf\x95\x9e\x22\xca\x71\x71\xb1\xbb\x50\xa7\x5c\x19\xb7\xb5\x39\x27\x19\x81\x18\x7a\x81\xfe\xb3\x56\x54\x61\xb6\x6a\x47\xec\x3e\xbd\xb6\x5b\xb5\x35\xad\x52\x75\x97\x50\xb4\x66\x66\x9a\x9f\x5e\xd0\x29\x9b\xe9\x16\x95\xc4\x18\x93\xb6\xf3\x77\xec\xfc\x1d\x3b\x7f\xc7\xce\xdf\x51\x68\x12\xd0\xce\xdf\x51\x81\xbf\x83\x84\x54\xd3\x9e\xa9\x09\x5f\x70\x1a\x48\x51\x9d\x32\x5c\xc6\xa1\xa2\x48\x7c\x94\x33\x7d\x57\x9f\x72\x3d\xf5\x29\xb5\x0a\xa3\xe6\x2b\x2b\xc8\xe3\xd4\xed\x90\x63\x2c\x72\x32\xca\x8e\x4f\x3e\x2d\x71\xbb\xb8\x39\x3d\x3e\xb1\x2c\x61\x59\x7b\x96\xd5\x7a\x61\x59\x9b\xab\xcd\x91\xf0\xc1\xed\x10\x07\x8b\xcc\xc0\xf2\x53\xcf\x61\x2e\x2d\x4f\xf1\x8d\xdb\xdb\x65\x43\x67\x29\x70\x7f\x6c\xf8\x9a\xd6\xd0\x6f\xb3\x60\xb8\x01\x3c\xcd\x4d\x03\x5c\xee\x4c\xad\xeb\x50\x78\xd7\x14\x67\x08\x19\xb8\x5a\x92\x97\xa9\xc5\x45\xd4\xfe\x97\xe5\x18\xf6\x08\x0e\x18\xdf\x0e\x98\xdc\x07\x9a\x8e\x26\x6e\x97\x28\xf0\x34\x7d\xc1\x44\x4f\x9f\xc2\x5c\xe5\x51\x8e\x2b\x18\xfd\xf3\xac\x30\x5c\xe0\x63\x31\xc7\x21\x14\xdd\xa5\xfd\x91\x1d\x49\xc9\x82\x7a\x6a\xe5\x22\x1e\x38\xd1\x54\xaa\x93\xe1\x30\x2f\xf2\x83\x91\xfe\xd0\xa6\x1e\x94\x38\x0b\x59\x18\xc2\x3f\xb8\x43\x03\x95\xd5\x06\x7f\x42\xe9\x33\x65\xa7\x34\x3a\x9c\x45\xe1\xa8\xcd\xb8\xaf\xfe\x63\x50\x49\xfc\x91\xc3\x3c\xc6\x8d\x90\x13\x97\xb4\x69\x40\xe2\xcf\xdd\x88\x18\xc2\xa3\x2e\xe1\xf1\x17\xa2\x1f\x42\x00\x50\xfc\x09\x7b\x61\x17\x4f\x3f\xe0\x72\x16\xba\x6c\x10\x24\x0d\x52\x88\x14\x19\xd9\xd8\xed\x90\x91\xe4\x44\xff\xc7\x08\x98\x4b\x62\x72\x46\xbe\x2e\xaa\x30\x72\x29\xf6\x58\x67\xe4\x74\x89\xd3\xb3\xd9\x7d\xfa\x87\xa1\x87\x3f\xfe\xac\xe9\x77\x12\x3b\xdf\x48\x48\x12\x8a\x91\x83\x39\x8b\x04\xf1\x46\x3e\x09\x22\xf5\x1f\x3d\x30\x11\xd9\xd3\xdf\xc4\xef\xc7\xad\xea\x7f\x92\x36\x31\x77\x35\x2b\x13\x5e\xa5\xc3\xe1\x6c\x00\xbf\x88\x51\xc0\x24\x6d\xc7\x20\x1c\x23\x8e\x5d\xca\x46\x21\x2c\x4e\x22\x44\xfa\x87\x61\x63\x3e\x92\xb8\x03\x33\xc1\xfa\x84\x8f\x00\x6c\x51\x52\xe8\x40\x38\x58\x31\x2b\xfe\xc3\x00\xb2\xc4\xf4\xc7\x91\xa4\x3e\x31\x44\x48\x03\x35\xba\xe9\x7f\x0d\xfd\x49\x72\x1c\x88\xf6\xc4\x1f\x86\x9e\x17\x8e\x25\x19\xc5\x13\x92\xd2\x9e\xfc\x11\xb7\x4e\xee\x25\xe6\x04\x8f\x94\x71\x4b\xff\x37\x1e\x7f\xc8\xc2\x28\x84\xca\x2b\xe0\x9c\x55\x63\x8b\x7f\xf0\x31\x0d\x46\x36\x27\xd8\x75\x78\xe4\xdb\x9a\x42\x0e\x30\x1f\xc9\x0c\xbb\x58\xce\x7c\x33\x04\xb0\x1f\xcd\x4c\xf5\xa3\x86\xdf\x1a\xe9\x67\xe2\x0f\x53\x23\x55\x4f\xc5\x2f\xab\x1f\xf4\x90\xd4\xd7\xba\x1d\x9f\x05\xb2\x1b\xff\xad\x1f\x34\x52\x71\xf4\x70\x28\x48\xfa\x87\x9e\x7b\xec\x11\x2e\x1f\x4c\xe5\xcc\xe7\xdb\x5b\xe2\x87\x72\xf8\xf0\xeb\x01\xc7\xe1\xc3\x6f\x3d\x2a\xe4\x48\x38\x9c\x79\x1e\xcc\xb2\x18\x50\x28\x2e\x98\x4a\x9f\x8e\x84\x88\xff\x31\x5c\x8e\x3b\xb0\xf2\xe2\x8f\xea\xdd\xf8\x6f\x47\x09\x06\x16\xd4\x25\xf1\x1c\x04\x91\x6f\x13\x3e\xd2\xbb\x43\xb2\x2c\x0c\x58\x0a\x6d\xc6\x60\x5d\xeb\x19\x89\x05\x17\xa4\x7e\x84\x23\xc9\x1c\xe6\x87\x1e\x91\x64\xea\x83\x21\xa2\x4e\x87\x08\x25\xa4\xd0\x1d\x0d\x3a\xc9\xbc\x26\x1f\x7d\x2c\x7a\xe9\x87\x64\x0e\x2a\xcf\x28\xfa\x25\x22\xd3\xe8\x7a\x5b\x81\xe7\xdc\xdf\x6b\xee\x47\xa4\x6e\x2c\x13\xcb\xd0\x8f\x48\x51\xb4\xc1\x19\xc3\xa8\xd9\x8f\xc8\x03\xbc\xc1\xb5\x9c\x8c\xfd\x88\xdc\xad\xe4\x97\x6d\xa8\x7f\xb3\x60\x87\x4f\x3d\xf6\x5b\x84\x73\x0c\x52\x09\xa4\x0d\x3a\x8c\x24\xf3\xd5\x1e\x5a\x72\x10\x9f\xf7\xdb\x71\xbb\x0a\x44\x24\xa2\x86\x97\x69\x13\xf0\x6d\xe2\xaa\xd8\xce\xc3\x30\xcc\xcd\x83\x59\xcd\x15\x7b\x6e\x13\x57\x76\xa9\x30\x74\x17\x73\x0d\xee\x9b\xb1\x9c\x10\x3f\x26\x4d\xd9\x60\xb1\xe6\x40\x06\xa7\xf8\x32\x55\x75\x57\x56\xa4\x2a\xde\xcc\x3e\xe0\xc0\xf5\x88\x8d\xf9\x16\x6d\x68\xcf\xe5\x30\x24\x6f\xe1\x2f\x38\xe8\x2d\xcb\xbc\x37\xba\x29\x99\x86\x24\x7e\xe8\x61\x49\x96\xd9\xe8\xc6\xaf\xeb\x4d\x8b\x47\x01\x1c\xcf\xf5\x26\xe0\xd9\xa7\x75\x3c\x9b\xf5\x7a\x73\x33\x9b\xda\x98\xbe\x3b\xb1\xd4\xa5\x13\xc4\xf0\x2e\x13\xdd\xf3\x34\x10\x80\xa0\x95\x07\x21\x71\x85\x3b\x09\x82\x59\xc9\x58\x4f\xa6\x19\x90\xfb\x08\x10\x9e\x48\x42\x4a\x8c\xf6\x54\x32\x24\xb8\x88\x1c\x07\x62\x76\xd2\x6e\x54\x27\x5d\xc7\x24\x81\x11\x89\xc5\xfc\xe9\xb3\x61\x36\x6f\x2e\x09\xec\x71\x04\x5d\x70\x76\x3f\x5c\xc6\x2e\x4c\xa0\xed\x67\xe9\xc2\xaa\xdd\x1b\xea\x1b\x23\x0a\x85\xe4\x04\xfb\x46\x0c\x7f\x65\x80\x30\xae\xc1\x74\x5a\x04\xdf\x41\x51\x18\xc2\x48\x33\x8f\xca\x60\xc8\x56\xd9\xfb\x52\x56\x9c\x2b\x6c\xaf\xf2\xb7\xc5\xb8\x79\x12\x0c\x59\x15\x47\x68\x30\x64\x39\xcb\x2d\x64\x4e\xb7\x30\x67\xde\x73\x1c\x76\x97\xcf\x14\x7d\x10\xd0\xda\x8c\x81\xc3\x34\x9e\x3c\x01\x1a\xa6\x37\xb2\x22\xb1\xcc\x0f\xdf\x2a\x2f\x63\xe0\xe4\xf5\xd2\xc7\xcb\x34\x6f\xd0\x1a\xf0\xb7\x4d\xf7\xb5\x1e\x3f\xea\x43\x80\xf0\xa6\x0e\x03\xf7\xf5\x92\x0a\x6e\x1b\x0b\xe9\x0d\x1b\x71\x69\x7c\xca\x4c\x35\x95\x19\x12\xea\x6f\x0c\xff\xaf\xdc\xd5\x18\x32\x3f\x73\x2d\x72\x28\xd7\x99\x3d\xd6\x0c\xab\x64\xb1\xc8\x42\xd5\xc7\x1e\xfa\x7c\x75\x01\x50\x0a\x4a\x93\x38\x3c\xba\x34\x36\xae\xcd\xaa\xbd\x9c\x4f\xd6\x2b\x9d\xcb\x22\xd9\x25\x3c\xc4\x6e\xce\x01\x38\x1f\x4d\xbd\xa0\x73\x34\xe9\x62\x35\xed\x81\x84\xb7\x24\x6e\xc1\xf0\xa8\x24\xa5\x41\x1b\x7e\x62\x6e\xf1\x7b\xfc\x23\x8d\xd7\xdb\x6b\x68\x06\x33\xe6\xb2\xf7\xe9\x78\x3a\x32\xe5\xea\xbe\x4b\x6d\x2a\xab\x3e\x08\xf3\x8e\x34\x4d\x45\x25\xd9\xaa\x82\xfa\xd4\x23\xc6\x80\xba\x1d\xa2\x43\x0e\xcc\xb8\xbb\xc5\x4a\xd3\x7d\x38\x91\xb4\x53\xac\xd2\xe7\xcb\x55\x6e\xdb\x0f\x3c\x20\x71\xcf\x1b\x4a\x59\xdd\xad\xb4\x15\x56\x1a\x2c\x34\x3d\x6b\x39\x0a\xa7\x7e\x88\xb2\xa0\x48\x5a\xf4\xd6\x95\xe0\xdc\xb9\x0f\xb7\xc1\x7d\x98\xca\x50\x9c\xde\x9c\x29\x6f\xf2\xa7\xd6\xe6\xcc\x5e\xe9\xce\x2e\x0d\x48\x6e\xaa\xa2\xb4\x98\x02\x8e\x24\x81\xd3\xcd\xb4\x95\xbe\xfb\x2b\x3a\xa2\x9d\xb3\x8b\x32\x4d\x07\x62\xac\x35\xd9\xb4\x63\x34\x69\xf8\xac\xb6\x69\x7b\x40\xfb\xaf\xd3\x60\x8f\x40\x18\x0d\x13\xcb\xc5\x42\xb3\xca\xbb\xc3\xd6\x15\x3a\x6d\xe5\xb0\xa7\x45\x30\x77\xba\x48\xef\x5c\xab\xde\x82\xc1\x03\x3c\x2e\x2f\x03\x97\x21\xa1\x9a\xcd\x28\xfa\x5d\x72\x8a\xd3\x64\xec\x2e\x09\x24\xe1\x21\xa7\x82\x68\x2a\x32\x19\x14\x8f\x1f\x20\xda\x5b\x5d\xcc\xc9\x05\xa3\x81\x5c\x03\xcb\xc6\x49\x01\x28\xce\x0a\xb8\xc0\xb0\x52\x25\xa7\x4e\x2b\x25\xbb\xca\x7d\x7f\x9c\x73\xf1\x60\xe0\xa8\x5c\x90\xbe\xb4\xa7\x71\x47\x57\x04\xfb\xad\x44\x80\x55\x22\x40\x03\xb2\xb3\xa7\x8e\x81\xd6\xc5\x25\xf9\x2d\x22\x42\xbe\x8f\xa8\x3b\x0f\xb6\x6f\xdc\xdc\x07\x82\x3d\xd9\x6d\x41\x39\xb4\xf9\xf8\x7e\x59\x8f\xae\xe3\x34\x99\x8d\xf6\x99\xcb\xfc\xd2\xe0\x35\x04\xb4\x19\x42\x9b\x8d\xa9\xa5\x51\x24\x84\x70\x97\x8a\xb3\x4b\xc5\xd9\xa5\xe2\xec\x52\x71\xf2\x26\x03\xed\x52\x71\x8a\xa9\xf6\x8b\x36\x63\xed\x14\x4b\xd4\x3a\xd3\xc1\x21\xb6\xa9\x47\x25\x25\xc2\xd4\x8a\x8b\xa9\x32\x63\xcd\x77\x58\x48\x43\xeb\x04\xd0\xf7\xfd\x42\x7d\xe6\xcb\x45\xb5\xe0\xc3\x7f\x32\x0c\xcb\x12\x2f\xde\x0d\x2e\xa6\xe5\xfe\x51\x71\xaf\xef\xbe\x5c\xa0\x56\x97\x85\x2b\xa3\xcc\xb7\x07\xa1\xe8\xb2\x30\xcb\x16\xf6\x0e\x3b\x04\x92\xac\xf3\xea\x2b\x2a\x43\x52\xd9\xbe\x57\x87\x05\x01\x71\xa4\x65\x35\xda\x31\x19\xba\x62\x91\x82\xa9\x79\x6e\x7e\xc5\xc6\xb7\xeb\x2a\xcc\x63\x49\x6f\x99\x57\x28\xec\x48\xf4\x8e\x06\x6e\x5e\x42\x71\x29\x3a\x2f\xe0\x96\x41\x8f\x6d\xdd\x61\x0e\xe7\x5a\x3a\xf8\x0c\xf8\xd6\x2e\x9d\x33\xd2\xd0\x44\x64\x33\x27\x70\x86\x47\xec\x7e\xd3\x95\xbd\x75\x95\x56\x25\x3f\x81\x33\xb4\xd9\xbd\x76\x8a\x41\xf5\xf7\xd1\x94\x73\x2c\xae\x29\x3b\x59\x48\xb6\x34\xc0\xa3\x5d\x0d\x5f\xf4\x64\x6a\xf8\xe6\x8a\x7f\xe0\x0c\x71\x18\xea\x28\xab\x44\xa8\x16\xaf\x02\x21\x9d\x4a\xb1\xed\x1f\x7f\x86\xdc\x4c\x11\xf9\x38\x63\xcb\x44\x4b\xaa\x38\xa2\xcd\xb3\x38\xe3\x0d\x1f\x9f\xc6\xa8\xdb\x31\x4e\x88\x1d\x75\x8c\x13\x0a\x9b\xde\x6c\xc5\x8c\x5f\x30\x1f\x8e\xbb\x34\x5a\xad\x8f\x93\x21\x2d\x7d\x8a\x0d\xed\x81\xde\x7f\x58\x6a\x23\x7e\x25\xbe\x4a\x1b\x67\x27\x5b\x12\xe5\x12\xbb\xcc\x33\xf7\x5f\x69\xbc\xe3\x64\x22\x44\x65\x29\xbf\xcd\xd2\x2e\x9a\x87\xfd\x3d\xab\xed\x2c\xf2\xe8\x3b\xb5\xc8\xb7\xb1\x6c\x73\x42\xda\x89\x2c\x64\x4a\xaa\x4d\xa5\xd8\x96\xcd\x71\xd5\x05\x29\x39\x1e\x3a\x8c\x87\x79\xbb\x1e\x71\x19\xcf\x09\xd1\x5e\x70\x3f\x2e\x14\xad\x10\xb7\xbf\xc1\xb8\x8d\xb6\xa2\x20\xe4\xec\x8e\x38\x32\xf3\x5a\x41\x83\x8e\xb2\x34\x07\x72\x89\x38\xe4\x8a\xb4\xc4\xf6\x98\x98\x3d\x50\x78\x9a\x8f\x0c\x24\xcb\x13\x99\x3e\xf6\xfa\x64\x22\xe2\xa9\x3d\xc9\x8c\x57\xe6\x62\x9e\x71\x02\x6e\xa3\x1c\xec\xe8\x69\xe4\xbc\xd2\x2e\x63\x7b\xcd\xfd\xb1\xbe\x57\x6f\xb6\x63\x62\x2a\x66\x55\xd2\x4d\xe6\x16\x02\xcf\x78\xd8\x25\x65\xc2\x22\xb4\x6d\xb1\xd1\x85\x94\x8c\x29\x73\xe0\x1e\xe6\x51\x4e\x38\xdc\xb9\x4e\x63\x42\x47\x0c\x73\xf7\x31\x98\xb8\xda\x3f\xa5\x7a\x34\xe2\xac\xa5\xd2\x7c\x54\xbb\xe3\x7c\x5b\x51\x11\x66\xa1\x3b\x33\xa6\x60\x0e\x64\xe7\xd3\x8d\x77\x79\x00\xc1\x99\xab\xf3\xa8\x95\xa1\x22\xa9\x32\x96\xab\xe8\x55\x1f\x3f\x35\x61\x0b\x27\xbc\xf7\x8d\x44\x9d\x58\x3e\x2d\xab\xb1\x39\xe0\xc8\x8b\xa1\xec\xb2\x60\xfd\x8b\x79\xaf\xb9\x7f\x33\xb2\x2c\x51\xd7\x04\x2c\xf4\xcb\x6c\xf1\x6a\x55\x84\x2f\x13\x7a\xd5\x06\x41\x6b\x84\xcc\x61\x2c\x53\x07\xf3\x70\x7e\x3a\xfd\x32\x37\xc3\xa2\x35\xc2\xe1\xcf\x17\x6d\x0f\x4b\x23\xa2\xc5\x23\xfd\x4b\x12\xc4\x23\xc6\xa4\x90\x1c\x87\x1b\x40\x7a\x99\x68\xf7\x8d\x42\x0d\x3f\x30\x2d\xeb\xf9\x9f\x2c\xeb\x39\x4a\xc9\x42\xfd\x3d\xbd\x56\xd3\xff\xd6\x17\x21\x81\x37\x63\x6e\x5a\xb5\x6b\xc0\x7b\x9f\xb0\xc7\xd9\x49\x6b\x96\xd5\xd8\x6b\xee\xfb\x14\xb2\x96\xea\x4d\x47\x88\xec\xb6\xe2\x24\x8c\xe7\xe9\xfb\x0f\xa7\x67\x2e\x7c\xf9\x8b\x04\x1a\x41\xbf\xde\xf1\x86\x10\x10\xcb\x02\x94\xfe\xb5\x54\x6a\xaf\x1c\x50\x29\x09\xb7\xac\x58\x49\x8e\x53\xae\xec\x39\x53\x87\x50\x2d\xfd\x7a\xc5\x74\x60\xf8\x78\x27\xea\x4d\x73\xc1\xa8\xd7\x92\x3a\x01\xca\x6e\x87\xc8\x94\x84\xc2\x67\x0f\xbc\xe8\x12\x41\x3b\x81\xcf\x5c\x36\x71\xab\x80\xe5\x6d\xfc\xf3\x2c\xe3\x38\x22\xf7\xc7\xe7\x17\x8f\xaf\x68\xfb\xab\x01\x4d\x19\xb0\x53\x3f\xb4\xa1\x4d\x6b\x4b\x71\x9f\x8d\x17\x96\xf5\xb5\x0f\xb9\x2a\x0b\xc0\xe9\x16\x95\x1c\x7e\x11\x37\xf0\xf5\xe6\xa0\x7f\xfd\x62\x5e\x0b\x8f\x34\x86\xc6\x3c\x79\x4c\xde\x42\xdb\x23\xf7\x8e\xaf\x26\xd0\x74\x7c\x61\x76\x99\x4f\xb2\xe6\xa0\xa5\xd0\x22\x36\xe0\x5f\x1b\x3b\x47\x3c\x72\xaf\x31\x2b\x66\x05\x7f\xe7\x03\xd9\xf9\x40\xe6\x00\xcc\xb3\x49\x33\xbf\x39\x16\x9f\x8c\xbd\x86\x3a\x3d\x2a\x87\x9b\xb6\xf4\x98\xed\x98\x90\xd8\x0f\xd8\xeb\x54\x6f\xed\x49\xba\x6c\x40\x4b\x6d\xfa\xed\x1b\x70\x3b\x83\x53\xd1\xfd\xd1\xd1\x1a\xee\xf4\xa1\xbe\xd1\xc7\x65\xc2\xec\xa1\x55\x3b\xf8\x7a\xf3\xe6\xfa\xc5\x1b\x8c\x62\x55\x40\x0d\xa0\xa9\x46\x10\xdd\xdb\x76\x5a\x28\xe5\x60\x77\xeb\xff\x1e\xca\x77\xeb\x59\xcf\xbe\x40\x0c\x45\xc8\x71\xce\xaa\x3e\x13\x22\x22\x08\xaa\xf8\xf5\x56\xae\xca\xb4\xd7\xdc\x7f\x83\x95\x02\x70\x10\x4f\x04\xb2\x87\x28\xe9\x7f\xf4\xc6\xc7\x89\x38\x6b\xd3\xb3\x0e\x02\x82\xd8\xea\xfa\x4e\x58\xbf\x07\x8f\x53\x2c\x09\x99\xd2\xca\x02\x89\x0e\x07\x44\x80\x3e\x96\x29\xb1\xea\x49\x7d\x10\x95\x79\xe3\x9d\xb1\xa0\x43\x1d\x26\x21\xc0\x94\xce\x02\x69\x60\x4d\xd8\xe2\xfb\x56\xed\x8d\x9e\x03\xdd\xaa\xe0\x4e\x7c\x8d\x06\x18\xb6\xc9\x77\xe1\x26\xb3\x9e\xd4\x81\x89\xae\x33\x90\x23\xdf\x31\xde\xdb\x4e\xd8\xc8\x9b\x84\xb4\x67\x2b\x20\x47\xee\xe0\x62\x51\xfe\xc0\xd1\x0e\x2e\x76\x11\xff\xd1\x53\x86\x8b\x55\xd7\x5c\xc6\x7b\x0a\xb2\x33\xab\xe6\xca\x3b\xc6\x65\x14\x90\xd7\x95\x06\xd7\x82\x72\x30\x53\x1e\x51\x19\xa2\x2c\x75\x65\x33\x2d\x0b\xe8\x85\x6b\x6e\x4c\x8c\xb6\x26\x59\x96\xa9\x8d\x55\x30\x36\x8e\x25\xb1\x2c\x53\xff\x03\xdb\xee\x68\x42\xcd\x78\x83\x67\x5b\xae\x5d\xbf\x98\x6e\xac\x9e\x17\x91\xe9\x60\x2e\xa1\xa6\x62\x23\xec\x86\x96\x05\x7b\x7f\xe3\xf9\xff\xa8\x37\xc5\xdb\xaf\x37\xff\xe3\xfa\xb9\x3f\x4c\x9a\x83\xe7\x26\xcb\xfa\x95\xb7\x57\xeb\xf6\x33\xdd\x89\x4c\x0c\x68\x8f\xe6\x59\x22\x7a\xb4\x48\x80\x50\xdc\x18\x56\x75\x99\xe6\x84\x56\xe9\x9f\x23\x9e\x0d\xba\x3e\x61\x66\x4c\xd1\x57\xdb\xfa\xd5\xbd\xe6\xfe\x31\x0b\x87\x1c\xd0\x92\x46\x10\xed\x3d\x3a\xc7\x34\xa8\xaf\x76\x3d\x41\x28\x69\xb6\xd1\x3a\xbd\xfc\xe5\xf4\xf2\xea\xec\xfc\x74\x96\xea\xe4\x89\x2f\x67\x3f\x9f\x7d\x3a\x4c\x7f\xff\x0f\xb4\xab\xaa\xb0\x9d\x55\x15\x92\x09\xcb\x52\x0a\x39\x21\x47\xad\x93\x0a\x63\x72\x74\x07\xc0\xf2\x2d\x41\x0a\x84\xd0\x2c\x5b\xb8\x79\x5c\x01\xc4\x94\xdc\x08\xee\x25\xb1\x55\x16\xad\x6f\x33\xce\x8f\x78\x6e\x59\xcf\xe1\x9f\x89\xfe\x35\x9a\x11\xb2\xac\xbd\xbd\xaf\xe0\x0c\xb9\x7e\x51\x07\x3d\xba\xb9\xdf\x18\x59\x56\x50\x7f\xd1\x9c\x79\xf5\x23\x75\x48\x20\x08\xba\x1a\x86\x64\x1f\xed\x9d\x50\x21\x39\xb5\x23\x05\xc4\x7a\xc1\x59\x5b\x67\x8a\x63\xaf\x1e\x3f\x58\x6f\x9a\x74\x7d\xe9\xa2\xbb\xbc\xc0\x5d\x5e\xe0\x2e\x2f\x70\x97\x17\xb8\xcb\x0b\x7c\x54\x5e\x20\x1c\x61\x80\xae\x6a\xb3\xfb\x4c\x85\x96\x13\x22\x42\x92\x63\xed\x39\x0c\xb0\x37\x94\xa5\xc3\x37\xe1\xa4\x59\xb8\x79\xc4\x84\xc4\x7e\xec\x3b\x61\x92\x7b\x49\x78\x80\x3d\xb3\x2d\xa6\x5d\xf4\x95\x00\x82\x24\xfd\xe7\x30\x4b\x74\x9d\x2e\xce\x41\x70\xf8\x48\xfb\x04\x1d\xc3\x63\xa5\x72\x6b\x00\x3d\x6b\x4e\x69\x2a\xc6\xac\xd2\x20\x55\x55\x61\xa5\xa8\x0e\xd5\x01\xab\xfa\xf3\x68\x9f\x18\xd0\xbf\x01\x8b\x65\x80\x39\x31\x33\x19\xe6\x2b\x44\x6d\xc2\x37\x22\x62\x8e\x1b\x24\x2c\x4b\xe8\x28\xfd\xee\xb8\x80\x4f\x7e\x82\x24\x6e\xe0\x14\x49\x1c\xd2\x35\xfb\x5a\xdf\x30\xe0\x26\x6d\xb0\x50\x52\x9f\x7e\xd3\x3f\x2e\x66\x23\xc3\x1e\x8e\xf5\xc7\xb5\x68\x9b\x6f\xe6\x44\xac\xec\x35\xf7\xdb\xdc\xe8\x53\x32\x18\xb5\x39\x60\xfe\x57\xee\x37\xd8\x79\xc4\xd1\x93\xf1\x88\x67\x04\xc8\xce\xf1\x2a\xe4\x4c\xe6\x43\xef\x02\x2a\x23\x9e\xac\x7c\x8f\x03\x5a\xc9\xeb\xb0\x69\x23\xc1\x43\xaf\x44\x31\x8d\x02\x76\x21\xad\x1c\x0d\x05\x1d\x0c\x3b\x71\x89\x94\x8c\x6d\x2b\x90\x60\x76\xca\x31\x6d\xae\xb4\x51\xad\x64\xc0\x02\x5b\xd1\x99\xab\x1d\x1b\x09\x71\x96\xd5\xa8\xbd\x9c\xeb\xfc\x18\xdf\x49\xd3\x87\xd5\x4c\x8a\xbd\xbd\xe6\x7e\x0c\xcb\x87\xea\xcd\x45\x26\x8b\x25\xec\x0d\xac\xdd\xa6\x0e\x99\x01\x70\x68\x43\xa7\x50\x15\x69\x21\x7f\x23\xd8\x00\xd0\xa1\x2b\x36\x06\xf7\x1b\xf7\x70\x13\x9b\x92\x4d\xf3\x2b\x76\xae\xf5\x91\xab\x88\xc3\xae\x88\xe1\x08\xf6\x9a\xfb\x38\xa4\xe6\xe4\x5a\x2b\xd9\x7c\x9b\x74\x98\x68\xc9\xf3\x59\x26\x23\x4e\x34\x22\xc4\x5a\x13\xea\x12\xfb\xa8\xea\xdf\x50\x88\x12\xcf\x2b\xd3\xd7\x26\x3a\xb9\x0b\x17\xb1\xe2\xbd\xf1\xe5\xf0\x53\x15\x05\x40\x74\xc3\x1b\xb4\xde\x75\x06\x38\xc8\x52\xe3\xdf\xff\x8a\xbe\x10\x3b\x2e\x86\x50\xb6\xcf\x35\x39\x13\x62\x34\x93\x09\x1f\xc9\xfb\x5f\x4b\x08\xbf\x9c\xa2\x3d\xd3\x62\xba\x64\x48\x66\xe7\x3e\xd1\xe6\x63\xf4\x98\x31\x22\xe0\x80\xd8\x06\x94\x32\x23\x81\x34\x7c\xd5\xb3\x2a\xb7\xd3\x95\x8b\x39\x8c\x3d\x6f\xac\xb9\x2d\x42\xe6\xee\x32\xc9\x90\x7e\x94\x92\x47\xe7\x5d\x75\xc4\x27\xdc\x3f\xc2\xdc\xaa\xa5\x6e\x90\x8e\x73\xc4\xb8\x4b\xf8\x8f\xe0\xf1\x18\x07\x09\x4c\x45\x6f\x81\xbc\x68\x6a\x2d\xab\x21\x58\xc4\x1d\xd2\x66\x5c\x1d\x0b\x01\x91\x56\xed\xe0\x0d\xf5\x3b\xea\x40\x9f\x9c\xca\xe4\x0d\xf1\x5c\x5b\x5a\xfb\xa3\xd8\x8a\x58\x57\xdf\x7d\xfd\xb3\xf1\xf7\xc9\x39\xa9\x5c\xe2\x35\x3d\x13\x49\xa5\xe6\xe2\xa9\xf1\x6d\xca\xd6\xe2\x6c\x9c\x50\xb5\xac\x5a\x52\xd5\x45\x98\x1d\x45\x81\x39\xd2\xd1\x4a\xb1\x60\xa5\x6e\x3f\x87\x9d\x9d\xbc\xb5\x2c\x77\x04\x2b\x08\xe9\x67\x11\x61\x6d\x64\x18\x07\xea\xbb\xaf\x96\x25\xde\x5e\xbf\x80\x2d\x3c\x91\x58\x3d\x25\xea\xc9\xf7\xbe\xfd\x01\x2c\xe1\x8e\x65\xd5\x73\x3d\x8e\x1d\xff\xf6\x0e\xf7\xb1\xfe\x41\xed\xc6\x9a\x8c\xda\x2e\xee\xe9\x8f\x13\xf7\xa4\x45\xa8\xe1\x92\xc5\x2b\x42\x0a\x3b\x67\xaf\x6a\x49\x2c\xa9\x83\x5a\x54\x12\xf4\x3e\xdd\x8f\x5f\xce\x89\xca\x7d\x74\x22\x50\xba\xa1\xdd\xde\xde\x76\x14\x69\xd3\xbb\x97\x4a\x8a\x89\xb7\x3c\xf5\xb3\x41\x03\x8f\x06\xc4\x35\x1c\x21\x56\x76\xed\x4e\x47\xfd\x68\x96\xe8\xe3\x25\xdd\xca\x56\x09\x01\xba\x24\xd8\x91\xdb\x56\x49\x8f\x2b\xa2\x96\xb8\xdd\xab\x17\xa0\x92\xd4\x80\xca\xae\x81\x5d\x97\x05\xa2\xde\x5c\xe1\xb6\x9f\x34\x94\x71\xd9\x57\x8f\x4c\xe9\x86\x95\xdf\xe6\x55\x97\x33\xc8\xe5\x19\xd7\xf9\x01\xb1\x01\x1c\xaa\xd8\xac\x9e\x53\xe1\x10\xcf\xc3\x01\x61\xd1\xd3\xcf\x7a\x55\x1a\xb6\x1e\x7e\xe3\x4e\xa4\xe7\x2c\x2a\x94\x12\x00\x4a\x96\x5a\x57\x13\x6f\xce\xdf\x8f\x22\xd8\xfd\xd7\x6a\x2d\x2d\xf7\x12\xd2\x21\xb2\xd1\xc1\x51\xa7\xb1\x18\x3e\xfa\x3d\x09\x24\x63\x65\xc7\x14\xcc\x1e\x6f\x1d\xdd\xcb\x06\xef\x22\x6a\xd6\x15\x15\x59\xc1\x04\xef\x09\xe7\x79\xc5\x34\x4e\x48\x1f\x5d\x31\xe6\xad\x78\xae\x1c\xe8\x3e\xd0\x31\x73\x09\xba\x24\x60\xc6\x7d\x63\xe2\x03\x15\x16\x50\x53\xff\xb5\xf6\xd2\xcd\xde\xb2\xea\xb5\x97\xb3\x25\x9e\xd5\xc1\x53\x8f\x4f\x1e\xd5\xd8\x6d\x9e\x82\x75\x13\x3f\x17\x51\x33\xfd\xab\x96\x79\x0e\x49\x2a\x3d\xa2\xcf\xa0\x07\xf4\xae\x72\x00\xc1\xa9\xf2\x9d\xd7\xa5\x00\x15\xb7\xa8\xa9\xb6\x43\x0b\x9e\xd6\x33\xd2\xb8\x61\x0e\xcd\x2a\x30\x96\x65\x77\xa8\x4c\x0f\x5e\x95\x20\x5c\x16\x3b\x3b\x54\x1a\xc2\xf1\x97\x8d\x05\xd5\xf2\xef\x30\x97\x70\x25\xcc\x99\x06\x0a\x22\x51\x0b\x4b\x2a\xda\x71\x6c\x60\x75\xc9\x23\x6b\x3b\x10\xc4\xc4\x78\x74\xde\x27\xe3\xa1\x99\xc1\x81\x16\x74\x40\xb6\x33\x2c\x3e\x25\xaf\xb6\xbb\x14\x3e\xcd\x4b\x21\x91\x86\x50\x33\xd8\xa0\x41\x7b\x61\x62\xc6\xfb\x2e\x13\x39\xc7\xf2\x91\xc7\x3a\x45\x14\x13\xd5\x94\x71\x8c\x9d\x2e\x31\xe0\x36\x19\x89\x92\x91\x12\x1f\xc8\x28\x74\x18\xfb\x2b\x8a\xd8\x08\x11\xda\x55\x77\x2a\xb5\xba\x53\x07\x26\x20\x53\xeb\xa3\xf2\x28\x17\x36\xfa\x84\x39\x11\x98\x5b\x55\x84\xc0\x4a\x0a\x60\x09\xa2\xa4\x09\x85\x88\xd2\x47\x9b\x9b\xd3\xab\x10\x95\x79\xf0\xd1\xef\xa9\xfc\x10\xd9\x48\xc1\x94\x97\x89\x5b\x76\xa3\x1b\xd6\x71\x20\x13\xd5\x7b\x7f\x35\xf4\x0f\x63\x34\x53\x77\x0d\x68\xa6\x8b\x6d\x27\x91\x3d\x44\x2c\x40\x97\x98\x7a\xa2\xf2\x70\xd8\x31\x7b\x7c\xe6\xde\xee\x35\xf7\x39\x74\x3b\x02\xad\x22\x27\x24\x76\xce\xf3\xa5\xc5\x55\x98\x58\x08\x22\x05\x14\x6b\xf7\xa8\xa3\xd6\x80\x01\x90\xe6\x10\xe5\xfc\xef\xd7\xaf\x7e\x37\x97\xb1\x93\x20\x54\x73\x04\x6f\x1b\x21\xe6\xd8\x57\x82\x80\x23\xd9\x25\x81\xa4\x0e\x95\xc3\x5b\xc9\x7a\x24\x78\x56\x7b\x7c\x48\x2d\x4c\xdc\x76\xe4\x7f\x41\x70\x2d\x0b\x3a\x9c\x78\xa3\x2f\xa7\x47\x9c\x3a\xbd\x11\x10\x57\xff\x23\xa5\x75\xf1\xc8\x1e\x1a\x93\x65\x50\xd3\x3e\x0a\xdb\xbf\x22\x7b\xc8\x02\x25\xbf\x4b\xd5\xc9\x04\xdf\xbc\x48\xd0\x64\xb2\x52\xbc\xde\x53\xf9\x11\xdb\x4b\x69\xf1\x2f\x4b\xba\xf6\xbf\x01\xf6\xa3\xd8\xc9\x32\x09\x58\x00\xd6\xca\x17\xc9\xf2\xea\x50\xe9\x61\xfb\xd6\x63\x1d\x66\x4c\x5c\xfc\x75\x27\xa9\x3b\x2d\xc0\x7d\x1b\x73\xa4\xff\x31\xda\xf4\x9e\xb8\x86\x64\x61\xf2\x85\x6e\x04\x29\x1b\x69\x97\x71\xfa\x0d\xa2\x4c\x3c\x23\xc0\xfd\x95\xad\xd2\xac\xb3\x0f\x4c\xbf\x4d\x18\x79\xa3\x39\xf9\xac\xb6\xdb\x4d\x77\xbb\xe9\x6e\x37\xfd\x43\xed\xa6\xd8\x66\x91\x6c\xe8\x4d\x24\x47\x2b\xfc\x88\x6d\x74\x9c\x03\xca\x77\x14\x51\xcf\x45\xc7\x67\xa9\xed\xf8\xd1\x3b\xea\x4a\x3b\x98\x4b\xf4\x93\x71\x82\x51\x42\x3c\x0b\x24\x0d\x22\x16\x09\x74\x16\x48\xd2\xe1\x6a\x2d\xee\x76\xb5\xdd\xae\xb6\xdb\xd5\xfe\x38\xbb\xda\x9c\x4d\x2d\xd6\xb4\x0c\x87\x66\x6c\x6f\x04\x57\xea\x09\x9a\xca\x14\x8f\x28\xf2\x48\x1b\xe2\x9c\x2c\x2b\x80\x80\xb5\xff\xef\xff\x89\x14\x09\x28\x0e\x68\xda\x7f\x2c\x7a\x60\x8f\x0c\x07\x0c\x30\xb8\x60\x31\x75\xd8\xcb\x0e\x95\x2f\x05\xf1\xda\x06\x98\x47\x88\x0b\x9f\x09\x7e\xb6\xba\x31\x41\xbd\x9f\x01\xae\xf2\x9e\x4a\xea\x11\x51\x29\x4b\xa7\x91\x10\x26\xb1\xc1\x80\x40\x65\xf9\x07\xa0\x4e\xc6\x3a\x1e\xd1\x31\x66\x71\xee\x4e\x47\x13\x07\x88\x61\x31\x9d\x6f\xaa\x4e\xaa\xd8\x79\xc4\x76\x1e\xb1\x8d\x7b\xc4\xf4\x9a\x98\x5a\x11\x53\xeb\x61\xe1\x62\x86\x6d\xeb\x1d\x15\xdd\x4a\x02\x97\x93\xc6\x21\xf0\x49\x7f\xad\x12\x42\xd0\xe7\x90\x04\xa8\xa5\xa8\x54\x69\x55\x2a\xed\x26\x46\x88\x58\x17\x62\xc1\x6e\x3d\x2f\xb1\x9e\xf3\xa4\x0f\x26\xba\x4d\x45\xb7\xa1\xde\xcc\xc8\x17\x78\x9f\x60\x33\x8b\xaa\xe1\xd0\xe6\x04\xcd\xc2\x9f\x2f\x52\x74\x68\xf1\x20\x0b\x69\x94\x84\x26\x4f\x64\x1d\x8d\x1f\xaf\xaf\x29\xf4\x38\xed\x30\xf3\xd2\x96\x13\xea\x93\x21\x9a\xdb\xe6\xca\x66\x93\x7a\xe4\xfc\xb1\x1e\x02\xad\x95\xec\x50\x71\xd3\x1b\x0c\x68\x22\xbe\x4d\x5c\xd9\xa5\x3a\xc7\x35\xcd\x11\xe8\x30\x0c\x94\x99\x50\xf6\xf2\xbe\xa1\x48\x5a\xc8\x9e\x9f\x5a\x85\x31\x6e\xdf\x73\x0c\xd2\xb5\xad\xa2\x70\xa7\x92\x8d\x16\x9f\x56\xac\xf5\x5b\x84\x39\x71\x8b\x06\xf3\xbd\x7c\x98\x42\x9e\x7e\x75\x7c\x79\xbe\xb5\xc5\xbd\x3a\x4c\xe8\x81\x66\x9b\xc1\x19\x38\xbe\x9f\x6c\x64\xa3\x9a\x71\x01\x23\xc8\x19\x65\x47\x6c\xf6\xfe\x66\x59\xee\xbf\xff\xf2\x3b\x02\x42\xe6\xdc\xe2\x94\x3f\x75\x7f\xc2\xca\x6f\x47\x52\xb2\x60\xb2\x45\x1b\x0b\xea\x20\xc7\x63\x01\x41\xfa\x57\xab\xa6\xe3\xfe\x38\x09\x99\xa1\x7e\x30\x84\xe8\x5a\x35\xa4\x02\xaa\xe1\xdc\x82\xa0\x40\xd6\x11\xff\x33\x2f\x24\xf0\x0e\xf6\x8a\x8e\xc8\xcb\x7d\x9b\xb9\x38\x76\xd8\x4b\x04\x17\x47\x34\x79\x73\x44\x9d\x34\xf4\x62\xa5\xb9\xec\x88\xac\x6b\xa3\x52\x4d\xd1\xa1\xdb\x22\x81\x20\x9b\x4e\xb0\x8c\x15\xe5\x61\xe0\xc6\x16\xae\xf8\xfe\x38\x9e\x46\x0c\x95\x30\x1c\x6c\x59\x0d\x97\x45\xb6\x47\x1c\x80\xd4\xd6\x49\x4c\xe3\x87\x5e\xf9\x6e\x30\xfb\x5d\xd6\x8b\x55\xec\x14\x30\x90\x46\x9b\x9b\xd8\x15\xc0\x59\x53\x48\xcc\xb3\xb6\x50\x3d\x0d\x33\x5b\x64\xc9\x9b\x47\xb2\x14\xb0\x1f\x1a\x29\x28\x89\x46\x34\x94\xc3\x90\xbc\xfd\x6a\xd5\x7e\xb8\xd6\xa4\xa7\x3f\xab\xef\x6a\x85\xe6\xcd\x98\x04\x3a\xd1\xb8\x8a\x7b\xcd\xfd\x0e\x1e\x45\xdc\xe9\xd2\x60\x94\xfe\x5c\xaf\xa0\x50\x77\xcc\x71\x90\x16\x3c\xc9\x9e\x22\xdc\x46\xa7\x41\x17\x07\x0e\x71\x11\x39\x2e\x54\xa4\xb7\x22\x14\x8f\x85\x6c\x0c\xbd\xa8\x43\x03\x61\x59\x66\x84\x35\x57\x89\x33\x22\x49\xfa\xda\x4a\xec\x5c\x78\xd1\x5a\x20\x8a\x39\x97\xae\x19\x8e\xa0\x32\x52\x59\x56\x14\xd3\x62\xe6\xf4\x47\x88\x6c\xd5\xf7\xbc\x45\xa2\x8c\x8a\x15\x80\xe9\x13\x8f\x85\x84\x8b\xc6\xbc\x76\xe0\xf7\x4e\x44\x5d\x22\x4c\x87\x79\x1e\x51\x81\xaa\xe3\x9f\x01\x8f\x28\x5e\x0b\x06\x99\x4c\x8f\xcc\x5a\x46\x61\x18\x97\xb4\xaf\xe6\x2e\xa0\x3a\x51\xd0\x04\x24\xd8\xe8\x9d\x00\xa2\x9a\xa7\x98\x1a\x86\x44\x8f\x3b\x9b\x41\xc7\x5d\xcc\xa5\x78\xfa\x97\x81\x85\xd2\xe5\x74\x0b\x9c\x6d\xc7\x1e\x8b\xdc\xc7\xc7\xb7\xfd\x42\xb1\x32\xb1\xff\x68\x59\x8d\x1f\x91\x26\xe2\x59\x6d\x93\x37\x03\x07\x06\x36\xc1\x8e\x3c\x3e\x30\x97\xa0\x0b\x4e\xa4\xa4\xed\x9c\x74\xcc\x39\x59\x65\xdb\x25\x0e\xb3\x2b\x22\x8c\xf7\x2e\x03\x7e\x30\xc2\x64\x90\xd9\x0c\xd1\xb8\x39\x17\x67\x1b\x41\xe2\x07\x8c\x98\x18\x77\x2d\x3e\x51\x42\x2a\x46\xfa\xcf\xfa\x3c\x08\xad\x45\xa7\x36\xbc\xa7\x9f\x37\x1b\x2f\x06\xc4\x86\x86\x2b\xd3\x70\x14\xd5\x39\x6c\x3d\xc7\x61\xce\x9e\xa3\x9e\x28\x55\x81\x01\xf4\x3a\x1c\x8a\xd4\x09\x14\x33\x44\x7f\xd7\x6c\x53\x8f\xbc\xc5\x21\xe0\x35\xff\x8f\xfe\x24\x9c\xd3\x68\xd1\x4b\x26\x60\xa9\x08\x95\x94\xec\xe3\x70\x22\x59\xcb\x34\xa7\x5e\x99\x60\x7e\xfa\x5a\xd9\x0a\x26\x34\x5c\x7c\x95\xc3\xed\xb3\x15\x92\x3c\x9b\x88\x0a\x94\x8f\x6f\xc9\x73\x4a\x7c\xa2\xd3\x7b\x49\x02\xb8\xd9\x16\x89\xbd\x3f\x67\xae\x01\xfd\x0a\xd5\x2f\xf8\xbc\x13\x16\x4f\x06\xff\xc2\x13\x46\x2b\x7d\xa4\xb1\xb0\xe0\xda\x06\x0f\x14\x35\x02\x1d\x78\xa8\xfe\xf2\x99\x9b\xc7\x6f\x2f\x12\x1b\x28\x9c\x16\x0b\xde\x94\xe0\xde\x09\x13\xe2\x32\xae\x9f\x57\x70\xcb\x09\xbd\x68\x09\x21\x84\x3c\x7e\xb1\xf9\xb4\xa2\x15\xc6\x09\x1f\x97\x18\xe8\x15\xee\xa0\x42\x40\x37\x13\x0f\xae\x78\x8a\x68\x9a\x24\xee\x68\x88\x98\x18\x66\xd1\x84\xcb\x99\xb2\x07\xeb\xd2\x44\x6f\x4c\xda\xe6\xd8\x27\x93\x88\x06\x00\xf4\x01\x88\x5a\x81\x8b\xea\xcd\x87\x84\x03\xf2\x47\x6d\x7d\xc9\xba\xe3\xc5\x36\x1e\x4b\x0e\x97\xbf\x00\xfc\x4a\x4e\x9e\xd0\x05\x1e\x42\x1a\x07\xba\xe0\xcc\x21\x42\x94\x07\x78\x96\xdc\x40\xbb\xc4\xe9\xb1\x48\xce\x2c\xba\x31\x9b\x07\x8a\xc8\xd9\x9f\xcb\xe5\x9f\xea\xa2\xb8\x74\x8e\xf7\xf3\x2a\x2e\x48\x9d\xc1\x46\xcb\xd3\x93\xa0\x01\xc8\xfa\x21\x71\x29\x56\xf9\xfe\xf0\xc9\xd4\x23\xbf\xfd\x42\xec\xdb\x56\x0a\x2d\x9c\xc3\x22\x38\x05\x7b\x79\xe9\xe1\x8f\x2a\x42\xbc\x5a\x8a\xd0\x40\xee\x87\x1c\x8e\x28\x39\x5c\xb9\xce\xc3\xce\x7b\xbd\xb4\xf7\x7a\xbe\x66\x00\x20\x5d\x32\x16\x94\x45\x22\xc5\x71\xbb\x4d\x25\x5d\x67\x4e\x6d\xa2\x96\x76\xe2\xbe\x0b\xd8\xfd\xa7\x53\xcf\x26\x68\x46\x7b\xba\xae\x4c\x7d\x85\x40\xd9\x5d\x7d\x81\x5d\x7d\x81\x5d\x7d\x81\x5d\x7d\x81\xbc\xc9\x40\xbb\xfa\x02\xc5\x4e\xa1\x64\x43\xd7\xe5\xcd\x5c\x12\x7a\x24\xb3\xd0\xc0\x7b\x8e\xfb\x5b\x08\xe4\xc0\x71\xff\xf8\xbc\x55\x46\x9a\xfc\x0e\xd7\x61\x3b\x70\x1d\x3a\x1c\xf7\x33\xc3\xb3\x38\xee\x63\x89\x79\x75\x16\xe6\xe4\x58\xd6\x01\x79\x71\x77\xf1\x75\x58\x7f\x30\xd7\x14\x9b\x17\xf7\x9d\xb7\x2e\xa9\x1c\xa2\x77\x8c\xfb\xa2\x7a\x9e\x8c\x63\x57\x60\xcd\xd5\x46\x3f\xd4\x95\xe1\xb9\x03\x75\x88\x6f\x07\x1c\x3c\x47\xbc\xf6\xb2\xd8\xe3\x36\x73\x87\x13\xcf\x46\x9e\x76\x85\x2e\x7c\xbe\x4d\x89\xe7\x4e\x56\x8b\x9d\xa9\x88\x38\xf5\xca\x20\x4c\x30\x6f\x13\x27\xb3\x62\x27\x95\x50\x87\xd0\x17\x26\xa0\xc4\xe7\x19\xc0\xcd\xbc\x46\xee\x84\xce\xc3\x8d\xb1\x47\x01\xd6\x7f\x6c\xfe\x7d\x56\x96\xe3\xfa\x0b\xe3\xee\x05\x27\xa2\xa0\xc7\x7a\x7c\x3b\x78\x08\x71\x52\x70\x83\x02\xc6\x42\xcf\x9c\x78\x13\x89\x10\x38\x04\xf5\x7f\x90\x16\x49\x5f\x42\xc3\x85\x06\x11\xb4\xa6\xdc\xd9\x0a\x89\x4c\x74\x09\x91\xea\xa3\x9a\xc2\x17\xc0\x6c\x40\xef\xd7\xfc\x1e\xd1\xc0\xf1\x22\x97\x88\xba\x39\x03\xd6\xef\xd2\xfe\x84\x98\xa8\x06\xc0\x9f\x3e\x08\x8d\xda\xcb\x07\x7d\xaa\x96\x05\x94\x57\x03\xca\xc3\x49\xa3\x09\x2a\x96\x9c\xb6\x90\xaa\xd5\xe2\xf8\x6f\xd2\xc9\x44\xb9\x3b\xf9\xae\x66\xed\x1f\xb2\x66\xed\xcb\xfc\xc9\x3c\x1f\xb6\xfe\xf1\x71\xb9\xe9\x3c\xc1\x12\xdb\x58\xac\x3a\x85\x4f\x9b\xcd\xfe\x50\xfc\xe6\x4d\x2f\xed\x65\xb4\x67\xc6\x5d\x55\x0c\x62\x29\x74\xa0\x89\x73\x20\xfb\x7c\x26\x24\x40\xbf\x80\x65\x75\x58\x85\xe1\x26\xd9\xed\x12\x94\x73\xd3\x15\x3c\xcd\x51\x6b\x32\x1b\x00\xc5\xa9\xfb\x76\xb5\xdc\xbf\x93\xe3\x06\x75\x21\x79\xb6\x4d\xf5\xe2\x36\xc7\x70\xdf\x77\x22\x7c\xd8\xc1\x52\xe7\xda\x61\x18\x3b\x09\x7d\x07\xcb\xe2\xf6\x9c\x07\xc6\x85\xa5\x34\xee\x1b\xdd\xab\x71\xcc\x86\x4c\x92\x59\x5b\x42\xa6\xfe\x6d\x59\xb6\xa6\x55\xd7\x97\x35\xb6\xa5\xb4\x6c\xee\x76\x32\x63\xa4\x5d\xef\xe1\xf0\xb4\x77\x96\x59\xe3\x2e\x5a\xa2\xea\xb8\x54\xc2\xd2\xc0\x4a\xe0\x96\xd9\x5b\x94\x1f\x0b\xf6\x8d\xbe\xda\x36\x1a\x81\x67\x5e\x44\xb6\x47\x1d\xf3\x42\xe7\x6b\x90\xc0\x3c\xd6\x0b\xf1\xf6\x3c\x2d\xe8\x60\xc6\x9b\xc7\xfc\x5d\x88\xba\x13\xe5\x9b\x96\x42\x49\x5f\xf7\x9d\xfe\x26\x21\x16\xf5\x67\x75\xe9\xa5\xb6\x97\x5f\xa2\xe2\xb0\x77\xeb\x03\x2f\xef\xef\x35\xf7\x23\x52\x37\x96\x51\x42\xfb\x11\x29\x0a\x56\x3e\xb3\xa1\x99\xfd\x88\x3c\xc0\x2b\x5f\xcb\xc5\xbf\x1f\x2d\x03\xb7\xa7\xd2\x71\xe2\x69\xcf\xbe\xfc\xb3\x01\x0d\x3a\x67\x9f\x37\x53\xef\x52\x01\x55\x80\x0b\x56\x93\x41\x59\x6c\x15\x48\x39\x6e\x76\x28\xab\xa6\x28\x50\xda\x65\x66\xf2\xca\x87\xd3\xcb\xd3\x75\xc7\x46\xa5\x90\x54\xaa\xfc\x86\xa3\x52\xe5\xf5\x55\xb1\x4b\x78\x12\x3b\x22\xc8\x98\x49\x96\x65\xd6\xaa\x89\xb5\x51\x3d\x66\x69\x63\x1f\x3e\xfc\x72\x5e\x9e\xaf\x7a\x56\x41\x80\xd6\x1f\xde\x64\xd6\x9d\x50\xbc\x33\x64\x6e\x81\x21\xb3\xdb\xed\xfb\x99\x82\x78\x01\xd1\xd3\xb4\xba\xe8\x89\x0f\x17\x06\x74\xb0\xd7\xdc\xd7\xdf\x8d\x5a\x9f\x4f\xeb\x73\x5d\x67\x9b\x09\xaf\xe8\x86\x79\xec\x61\x7e\x88\x1d\x89\xaa\x64\xd1\x6d\xdc\x49\x1c\xcb\xf1\x94\xd8\x73\xc1\xd9\x71\xc4\xfb\x39\x1a\x5d\x9c\xcd\x86\x4e\x48\x9f\x3a\x64\x2b\x43\xad\xf5\x48\xcd\x40\x53\x4a\x83\x4e\xc6\xa0\xb5\x5f\x13\x8d\x75\xde\x9c\x62\xba\xc4\x67\x92\xa0\x43\x07\x22\xb6\x96\x12\x8c\x39\x3d\x6d\xb3\x30\xd0\x8f\x9f\x8b\x2f\x90\x97\x8f\x67\x8f\x41\x3f\x7e\x36\x9e\xda\x9a\xb9\xba\xba\x30\x5f\x95\xe6\x21\x51\xc7\xda\x3b\xca\x49\x9b\xdd\x1b\xad\xd0\x55\x07\x5b\xf7\xd5\x46\x13\x36\xe0\xdf\x57\x09\xa0\xe9\xe2\xd4\xd8\x0f\x50\x01\xc9\xa9\x1c\xb7\x3a\xb9\xfb\x84\x07\xfa\xd6\x3a\x0f\x5f\x49\x2b\xb7\xa0\x2a\x62\xd1\x23\x9e\x17\xfb\x16\xba\x9a\x42\x80\x55\x8a\x89\x85\x5a\x27\x28\xae\xbc\x3d\x2e\x6a\xf5\xc6\x0c\x0f\xf2\x9c\x38\x71\x5b\x46\x24\xa9\x57\x41\xe0\x32\xe8\xe6\x31\xf1\x53\xa4\x2f\x66\x3e\xe4\x9e\x8d\xef\x6d\xf9\x69\x53\x33\xe5\xb0\x4b\x53\xdb\x15\x21\xf3\x2f\x87\xe5\x49\xa4\xea\xe4\x4e\x14\x11\xca\xc0\xf5\x88\x8d\x79\x71\xc6\x3c\xba\x54\xdb\x44\xe6\xe5\xcd\xc1\xb5\x24\xf7\xd2\xb2\xcc\x7b\xa3\x9b\x92\x62\x24\x55\x0f\xf3\x85\x2c\x79\x45\x33\x94\x47\x81\xa4\x3e\x51\x28\x43\x46\x6a\xc6\x68\xd6\xeb\xcd\x0a\x19\xae\xf8\x9d\xd0\x71\x97\x69\x95\xfe\x80\xc1\xb5\x1c\x6c\xa4\x86\x6e\x57\xf7\x5d\x5d\xfd\xdc\xa4\x83\xcc\xf1\xab\x15\xfb\x47\x00\xb5\x81\x41\xd3\x1e\x9d\xda\x84\x92\xe1\x2d\x1a\x3d\xc1\xee\x4f\xad\xca\x37\xa0\xa9\x65\xa6\x8c\x5f\xf0\xca\x9d\x30\x3c\x86\xdd\xe9\xb6\xe6\x48\x09\x51\xc0\x06\xca\xf7\xca\xc9\x08\x5e\xa9\x57\xbc\x76\x14\x71\x99\x52\x43\x70\xb8\x11\xc3\x53\x97\xe0\xd0\x88\xbd\xd8\x15\x0c\x3b\x4c\xd3\xa4\xb3\x47\xef\x79\x0c\x1d\x61\xbe\x81\x74\xa4\x2e\xf4\x6d\x63\x5e\xc9\xf8\x75\xd3\xd9\x43\xbf\x67\xd5\x9b\xce\x93\x6d\x67\x21\x18\x65\x53\x91\x7b\x0f\x56\x47\xca\xcc\xa6\x55\x53\x2b\xeb\x00\xa8\x7b\x63\x96\x50\x37\x14\x1a\x02\x58\xbc\x7e\xb3\x94\x32\x21\x40\x6a\xd6\x89\x4f\xf1\x00\xcb\x2e\xae\xe4\x6a\x1f\xb7\x3d\xe1\x42\xd8\xe8\xcd\x24\x26\xc7\x80\x9f\x14\x91\x59\x46\xee\x0f\xb4\xd3\x75\xd6\x92\x5a\x9f\xec\xd0\xa2\xaf\x71\xe8\x0e\xde\x00\xde\xf5\xc1\x31\x27\x58\x12\x57\x95\x02\x40\x63\x6a\x12\x0c\xa2\xe7\xb9\x85\x99\xbb\xe9\x3b\x15\x9e\xf2\xe3\x3e\xb2\x56\x2e\xed\x74\x3d\xda\xe9\xca\x5c\x8d\xbb\x8a\x9c\xf4\xb8\x69\x80\xde\x88\x79\x57\x7f\x61\xd6\x9b\xdd\x84\xa8\xaa\xb5\xef\xa4\x9f\x9c\x8a\xa5\xc0\x24\x21\x73\xaf\x84\xeb\x93\x37\x45\xcc\x72\xe2\xa6\x5e\x01\x2f\x99\xde\xbb\x2c\x2b\x76\x94\x35\xaa\xc8\x56\x9d\x96\xbd\x31\x8a\x5e\x4a\xc8\x62\x4e\x07\xdf\x30\x3a\x74\xfb\x1a\x95\xa7\x50\x3c\xc8\xcb\xaa\xf4\xf1\xe2\x07\x43\x17\xc8\x76\x7c\xb1\x82\x17\xf6\x23\x28\xe3\xc4\xab\x36\x5d\x67\x1b\x83\x2e\x76\x01\x79\xdb\x18\xcf\x31\x2f\x20\xaf\x68\x9c\x98\xa7\x45\x79\x99\x5c\x3f\xbd\x70\x58\xd6\x09\x75\xe4\x31\xe6\x73\x82\x9d\x6e\x55\xa1\x61\x3a\x2e\xcc\xa6\x81\xba\xc4\xc1\x61\xa4\x8b\x07\xd5\x9f\x27\xc1\xcd\x1d\x88\x33\xe1\xc3\x75\x84\xb5\xcf\x40\x39\xd9\xe9\xe8\x33\x4f\x71\xd6\xc1\xc1\x32\x36\xb3\xd2\x33\x69\x13\x61\x07\x42\x8c\x02\xc1\x18\x63\x88\x0a\x53\xbd\x53\xf1\x49\x2f\x07\x54\x4a\xc2\xc7\x66\x36\xdd\x6b\xe3\x6e\x31\x14\xf7\x07\xe6\x13\x0f\x07\xee\x12\x07\xd0\x39\x11\x02\x77\x08\x3a\x62\x98\xbb\x9b\xb1\x84\x2c\x3c\x67\x76\x95\x54\x76\x95\x54\x1e\x79\x50\xee\x2a\xa9\x14\x39\x05\x57\xad\x0f\xd5\x21\xb2\x1b\x6f\x39\xd9\x5b\xbd\xc4\x3c\xda\xce\xb2\xd1\x13\xb4\xed\xc2\x72\x9e\x66\x58\x8e\x9a\x41\xc7\x4f\x45\x77\x81\x08\xde\x61\xbe\x11\x43\xef\x8d\x19\xa3\x82\x81\x4b\x54\x91\x11\xc7\xcf\x39\xa6\xfe\x68\x54\x61\x49\x51\x2d\x67\x2e\xca\xc8\x6e\x85\x4c\xe6\x45\x16\xf2\x1e\x51\xe9\xe7\x87\x91\x64\xfe\x44\x49\xb4\xa5\x35\x56\x40\x14\x6a\x49\xcc\x25\x62\x6d\x74\x28\x86\x81\x83\x12\x12\xd6\xa3\xa1\x2a\xb6\x44\xb6\x08\x99\xcc\xe6\x4b\x67\x7d\x76\xe1\x70\xca\x2e\x3c\x4e\x7b\x4c\x3d\xe9\xdd\xa8\xc3\x1a\x22\x6c\xff\xf8\x3a\x13\xdd\x6f\x89\xfd\x2e\xea\x30\xb4\x28\x9c\x71\x29\x80\x3f\x45\x5a\x86\x29\x78\x68\x73\x2a\xaa\xf1\x46\x4e\xdf\x82\xf6\x9a\xfb\x62\x28\x6e\x7d\x2c\x24\xe1\xa3\xee\xd0\xe6\xa3\xdb\x88\xc2\xd7\x9c\x88\x90\x05\x82\xf6\x89\xa9\xbc\xb4\x2e\x11\x3d\xc9\xc2\x11\x74\xcb\x40\x7f\x37\xa9\x0f\x3b\xf1\xc8\xa4\x7e\xa7\xde\xac\xd7\xcd\x5d\xa5\xa7\xca\xb7\xf7\xa5\xb0\x75\x94\xc9\x53\x49\x52\xd6\x9a\x3d\x3b\x3a\x47\xc7\x8c\x13\x9f\x48\x5e\x19\x20\x79\x1e\xd8\x97\x0f\xee\x50\x89\x3b\x10\x94\x22\x2a\x30\x50\x52\xdb\xd7\x61\xd7\xac\x2d\x07\x98\x13\xd3\x4f\x36\x67\x43\x30\x2f\x82\xad\x19\x70\x92\xc7\x5c\xc8\x60\x16\x64\x16\x2a\x45\x21\x2f\xd8\x0e\x7c\x34\x04\x02\x13\xef\x87\x05\x62\xa7\x8e\xb0\xd3\x13\xd4\x25\xc6\x15\xc7\x81\x08\x19\x97\x31\x08\xd5\x64\x3d\x77\xf0\xcc\x79\xfa\x09\xac\x10\x9d\x8d\xb3\x93\x35\xd4\x74\x2f\x72\x3c\x24\x2c\x4e\x8d\xbf\x30\xa5\x6a\x8f\x36\x3a\x58\x92\x01\x1e\x66\x31\x15\xa2\xd1\x2a\x0c\x6d\x3d\x3b\x3a\xbf\x85\x2e\xb6\x2f\xb0\xf5\x81\x64\xa6\x2e\x31\xa1\xe2\xd7\xc4\x78\xac\x8b\x78\x77\x45\xfb\xcc\xa3\xa8\x25\x19\xc7\x1d\x52\x0c\x1f\x71\x35\x36\x5e\xb5\x34\x1b\xb7\x8f\x7f\x59\xfc\xf9\x42\xec\x56\xd8\x25\x1c\x20\x99\x8b\x80\xfe\x3f\xf2\x64\x8d\x95\x10\x65\xe1\x32\x47\xea\x98\xad\x0f\x88\x8d\xc3\xd0\xb2\xcc\x01\x00\xd0\xef\x4e\xca\x2d\x3b\x29\xe7\xad\xc3\x0e\x09\x92\x75\x98\xc8\x43\xbc\xb5\x79\x19\x48\xf0\xd3\xf2\x76\xc1\xb8\xc4\xde\x23\x2f\xee\x48\xb5\x6a\x7c\x21\xf6\x2b\xe3\x23\xd3\x66\xac\xd9\xa3\xe1\x4c\xde\x1b\xb1\x3a\x4d\x5c\xe3\x8a\xfa\x44\x48\xec\x87\x6b\x38\x1a\x76\x32\x58\xa1\x0c\xc2\x23\x4a\x92\xcc\x50\x4b\xd2\x22\xa9\x3b\x6b\x55\xb1\xdd\x17\x05\x9b\x5b\xb7\x68\x2d\x04\x87\xcb\x10\xb2\x0c\x30\xb8\xa5\x2c\x48\x73\x41\xdf\xb6\x57\x04\x0b\x80\xb4\x15\xc9\xbe\x9e\x00\x63\x9b\x2b\x7f\x9f\xde\x7d\x0e\xbc\xdc\xc2\x20\x55\x19\x89\xd2\x60\xb0\xc4\x95\x67\x59\x8d\x7a\x13\x72\xbd\x18\xf6\x47\xfd\xfa\xc0\x66\xf7\x50\x0f\x8a\x98\x15\x58\x8b\x68\xd0\x66\x6a\xec\x0d\x77\xf1\xa9\xf0\xe9\xea\xac\xa8\xce\xb1\xf5\x05\xf2\xec\x21\x0d\x24\xcd\x54\xba\x2e\x8e\x72\x4c\x63\x8f\x71\x9c\x3d\xc0\x33\xa2\xa1\x7d\xab\xfe\xd0\x65\x44\xf3\x2b\xb6\xa9\xaf\x84\x49\x43\x71\x5b\xdb\x25\x99\x3e\x21\x6b\x76\x46\x5d\xeb\x59\x88\xa0\xac\xca\xd6\x0f\xa1\x81\x9e\xae\x8e\xf1\x00\xea\x27\xd7\x24\x44\x83\x3e\x85\xb4\x21\xd8\x71\xa2\x80\xca\x61\x66\x06\xfd\x99\x4b\x30\x9c\x1f\x1b\x49\x8d\x80\x10\x81\x21\x8d\x29\x48\x4a\x04\x56\xb0\x85\xc7\x3d\x64\xee\x69\x2e\x6b\x11\xcf\x43\xad\x2e\x0b\xd7\xcb\x8b\x2a\xc6\xcb\x04\xa4\x46\x64\x0d\xd7\xf7\x23\x89\x6d\x8f\x6c\x41\x66\xd6\x0d\x4d\x88\xd1\x09\x10\x3e\x0d\xd4\x01\x7f\x27\x9e\x95\x2c\x0c\x6d\xec\x10\x9b\xb1\xde\x44\xdc\x48\xda\xb7\x91\x11\x3b\x72\xe6\x2b\xbc\xac\xf5\xe3\x90\xc7\xf8\x7b\x26\x9c\x85\xe4\x5e\x12\x1e\x60\x6f\x59\x48\xf2\x09\xe2\x77\xe7\xde\x93\xf4\xe2\xaa\x35\xad\x67\x31\xc7\x95\x1b\xcf\xb5\x2a\x1e\xb4\x7d\xf1\x04\x93\xd4\x41\xde\xc5\xf1\x79\xab\xde\xdc\x43\x49\xd8\xf3\x0e\xc5\xf8\x89\xca\x67\x2c\x9b\xaa\xcc\x53\xa6\x74\x06\xba\x9a\x4c\x55\x81\x9f\xe0\x46\x3f\x6e\xb5\x50\xd2\x8f\xb6\xcf\x61\x0f\xb9\xa4\x8d\x23\x4f\x66\x56\xe6\x89\x1b\xb1\x39\xa1\x81\x49\x83\x50\xb5\x60\xc6\x83\x4d\xfe\x6d\x47\x81\x23\x29\x11\x15\x25\x56\xab\xce\x01\xfe\x8c\x85\x1e\x13\x50\x9f\x39\x71\xb7\x24\x04\x2c\x66\xad\x83\x43\x11\x79\xf8\xf1\x85\x19\x7f\x35\xe0\xa9\xa9\x36\x37\x99\xeb\xa9\xae\xe0\x31\x21\x99\x1a\x8d\x3a\x2a\xe1\x24\x2f\xaf\xf0\x8c\xe2\xc6\xb8\xe1\x89\xd2\x18\x9b\xf4\xc8\x29\x1d\x3f\xa1\x29\x9b\x25\x2e\xb9\xef\x52\x9b\xca\xaa\x56\xdc\x5e\x73\x1f\x54\x93\x91\xce\xdb\xab\x6b\x64\xe3\xc0\xbd\xff\x66\x08\x19\xb9\x94\x4d\x37\xbb\xda\xa1\x31\x1e\xc4\xee\x78\x78\x72\xd7\x76\x8d\x3e\xba\x2e\xb0\xd3\xbd\xe6\xbe\xee\x70\xaf\xb9\xff\x6c\x34\x36\x64\x8f\xbe\xde\x98\xc6\x75\x7d\xb4\xd7\xdc\xbf\x19\x59\x96\x5d\x07\x27\xe7\x49\xfd\x29\xf0\x7a\x0e\x9a\x66\x06\xbb\x4f\xa7\x96\x3b\x2a\x0c\x02\x39\x9d\xdf\x56\x99\xc5\x64\xa2\xf3\x78\x4d\x4f\xa5\xab\x55\xcd\x4b\x41\x7d\xea\x11\x63\xa0\x33\xb6\x55\x8a\x60\x4c\x86\xb9\x94\x2a\x9e\xee\x48\xd9\xca\x8e\x4b\x1d\x56\xd1\xc6\x3b\xce\x99\xb6\x2c\xf1\x62\xaf\xb9\x7f\x7c\x7a\xf9\x09\xd5\x9b\x0f\xd0\x66\x00\x4f\xc0\x15\xe0\x6c\x02\xf3\x0a\xe1\x70\xb3\x76\xba\x26\x55\xc4\x99\x23\xfd\xaf\x91\x38\xa4\x34\x1c\xcd\x68\xf6\xb9\xba\x55\x3b\x80\x3e\x4e\x5a\xa8\xde\xd4\xe3\xd2\x5a\xbb\x65\x4d\xec\x59\xd5\x9f\x79\x53\xb4\xe6\xf0\x7e\x58\x49\x98\x49\xe0\x0e\xb7\x29\x36\x22\x70\x87\x21\x67\x80\x48\x9d\xcd\x8d\x36\xe1\x01\x5b\x02\x01\x63\x8d\x85\xf9\x56\x31\xe3\x50\x3d\xa0\xbb\x9c\xcb\x46\x3b\x52\x05\x77\x59\x7b\x7d\x81\xbc\xaa\xb2\x99\x8e\x55\x8d\x4b\x9b\xd1\x09\x32\x6e\x63\x40\x27\xab\xa6\x1f\xe9\x63\x2f\x82\x67\xf6\xd4\xa7\x7a\xf2\xb5\x65\x99\x93\xe5\x46\x27\xda\x5c\xf0\x42\x81\xae\x54\x9b\x6b\x12\xca\x31\x15\xd9\xca\xa9\x08\x89\x23\x73\x0b\x91\xae\xea\x54\x9c\xbc\x14\xee\x35\xf7\x8f\x48\x87\x06\xa3\xd3\xc0\xad\xa3\x71\xcf\xe8\xd4\xb7\x89\xab\x2b\xb9\x17\xb8\x1b\x3a\x2e\x6c\x8b\x34\x7d\xbd\xf4\x9a\xa4\x63\x9f\x63\xd2\x45\xb6\xed\x3e\x10\x12\xdb\x4c\x16\x03\x28\x79\xf9\x90\xa3\x2f\x33\x97\x40\xfa\xeb\x47\xda\x27\x00\xdf\x29\xc7\x5f\xfd\x53\x10\x8e\x3e\x07\x36\x38\xf8\x62\x0c\xc3\x12\xf1\x03\x38\xeb\xb1\x64\x70\x55\xa4\x0f\xd2\xb8\x6d\x30\x00\x67\x32\x37\x90\x5b\x99\x98\x33\x41\xdb\xee\x4e\xf4\x74\x4d\xba\x7a\x16\xc1\xa4\xcb\xa3\xc5\x62\x28\x89\x87\x0e\x1d\x49\xfb\x49\xe8\xa7\xaa\xcb\x7c\x45\x9c\x6e\xc0\x3c\xd6\x19\xae\x11\x09\x53\x11\x63\x59\x7b\x97\x96\x55\xcf\xa4\x09\xac\xbb\x5b\xa3\x24\x49\x92\xed\x0a\x83\x9c\x70\x41\x4e\x88\x8d\x65\x8e\xed\x48\xc5\x97\x06\x72\x3a\xc4\xa8\x3c\x5f\x8f\xa2\xc3\x55\x74\x54\x51\xee\x7a\xaa\x83\x3c\x8e\xf0\x71\x31\xee\xf5\x46\xfb\xec\x35\xf7\x35\x9a\x3a\x8d\xa9\xd0\xe8\x4f\x38\xa4\xa3\x34\x55\x2c\xf9\x49\x1f\xc8\x70\x44\x4e\x3c\xdc\xff\xb1\x5e\xc9\x91\xac\x3b\xc8\xe5\x9b\x58\xbb\x03\x79\xcc\x38\x2a\x0c\x9b\x06\xa3\xb3\x4f\x57\xa7\x97\xad\x0f\x9f\x2f\xea\xe5\x0b\x90\x1a\x5f\x36\x13\xfa\x24\xa0\xac\x18\xda\xea\xe4\xee\xb1\xda\xa2\x1a\x0f\x7e\x7c\x27\x1e\xe9\xa6\xea\x0f\xee\xc6\x70\x1b\x56\x65\xd4\xac\x5a\x9b\x31\x49\xb8\x55\x43\xf5\x66\x91\x1b\xb3\xca\x87\xd2\x23\xab\x37\x47\xf1\x5f\x59\xf7\x66\xfd\x28\xbc\x56\x6f\x4e\x5f\x9f\xd5\x2f\x80\xef\x6a\x59\xe2\x79\x7f\x0c\xdf\xb3\xc6\x8b\xf4\x34\xf5\xd9\xb7\xa8\x01\xf1\xf3\xac\x18\x7c\x18\x4a\xe6\xd3\x80\xf0\x8a\x2a\x2d\x24\x8a\x37\xd0\x92\x2e\xf8\xf8\x83\x8e\x16\xa8\x40\x45\x84\xf6\xb3\xd5\x6f\x16\x50\x67\xab\xaa\xdc\x2f\x57\x8e\x2a\xe8\x44\x1e\xe6\x5b\x54\x30\x06\x05\x1d\x23\xbe\xb7\xaa\x4b\x6e\x52\x3f\xa3\x56\xdb\xbc\x8a\x07\x32\x81\x35\xc7\x1a\x94\x15\x52\xf3\x94\x18\x81\x8c\xb4\x13\x8e\x65\xee\x9b\xf0\x24\x0b\x72\x9c\xa3\xef\x18\xec\x94\x6a\xe0\x8f\x8d\xab\x7c\xae\x4d\x85\x8a\xf3\x34\xee\x7c\x1a\xb6\x25\x2b\xd8\xb2\xdc\x1d\x29\xee\x3e\x8b\x3f\x3f\x1d\x7a\x76\x94\xa3\x92\x5c\x74\x99\x64\xe8\xbd\x42\xf7\xa1\x44\xac\xfd\x5e\xa6\x69\x4c\x22\x1a\x5e\xac\x14\xd1\xb0\xcb\xab\x58\x3a\xaf\xe2\x0e\x03\xdb\xe1\xfa\x65\x92\x60\xa1\xfc\x1c\x31\x21\xd0\xe1\x18\x2a\xa6\xf4\x74\xc4\xd9\x6b\xac\xea\x71\x6e\xe1\xbc\xcd\xdc\x84\xee\x6c\xa6\x6b\x3e\xea\xbf\xb0\x68\xa8\xfe\x33\xf9\xf5\x85\xd8\x15\x33\xe8\x0b\xb1\xd7\xc9\xa3\xc5\xeb\x2e\x5b\x3e\x2a\xf2\x5d\x16\x97\x99\x2d\x5f\xb2\x0b\x65\xab\xd0\x02\x9e\x7e\x7b\x40\xec\x85\x52\x79\x7a\x85\x4e\xe1\x1a\x12\x72\x2a\xca\xc9\xf3\x1c\x43\x4d\x40\x07\x77\x44\x1a\x64\xdc\xc1\x86\x23\x5f\xee\x88\x24\xc9\x28\x60\x47\x6c\xd8\x7c\xa1\x3a\xfa\x53\x0b\x1d\xaf\x05\x45\x37\x3f\xff\xa2\x22\x28\xdc\x84\x29\x22\x1f\x07\xf7\xa7\xd6\xa9\xc3\x68\xb0\xa1\x8b\x0b\x24\xd6\x29\x0d\xb0\xbe\xdf\x34\x4d\xc0\x72\x57\xac\x20\x40\x52\x7c\x7f\x81\x2f\xcb\x8e\x75\x8f\x7b\xc8\xbc\xb4\xfc\x74\xf5\xb1\xc2\x18\xf7\xf1\x6d\x5c\xbb\xad\x74\x3c\x90\x76\x4f\xfd\x74\xf5\x11\x6c\x12\xa3\xb1\x8f\xfa\x4e\x42\x30\x73\xd8\x0d\xeb\xb5\xf5\xad\x28\xe9\x8d\x2f\xbe\x2e\xd1\xf1\x75\x3d\x49\x4c\xf5\x43\x97\x85\xaf\x17\x72\x0e\x77\x29\x46\x27\xbf\x2e\x01\x20\x78\x42\xfa\x8f\x2a\x25\xa3\x19\x81\xa8\xfb\xd6\xaa\x69\xe3\xd7\xa1\x10\x44\x1e\x76\x3a\x9c\x00\xe8\x82\x0b\x8b\x59\x3d\xb4\x46\x06\x02\x1b\x94\x88\xb9\xf7\x8b\x59\xe5\x51\xb6\x85\x01\xc8\x31\x5d\x8f\x1b\x3e\x34\x91\xb9\xf5\x8c\x15\xf8\xa7\x5e\x68\x63\x52\x11\x5f\x34\x54\xa5\xf6\xe4\x7a\x71\x97\x8e\xba\x9c\x56\x8b\xe2\x3e\xf6\x9a\xfb\x8d\xcd\x2b\x8a\xbb\x0b\xda\xb2\x17\x34\x58\x38\x8c\x63\x47\x87\xab\x9a\x12\xfc\x44\xba\xfe\x9d\x6a\xca\x54\x11\x55\xc6\x9d\x08\x8d\x1f\x5f\xff\xf5\x2f\x7f\xfb\x6b\xf6\xc5\x64\xac\xc0\x9c\x05\x6d\xd6\xa7\x42\xed\xb1\xbd\xbc\x28\xd7\xea\x15\x1f\x0a\x02\x6a\x0c\x9d\x6a\x30\x6f\xc3\x2e\xf5\x58\xc7\x9e\xc8\x5d\xba\x8b\x03\xd7\x16\x72\x49\x5d\x48\xd0\x3b\x9c\x1b\x73\xff\xc8\xe5\xf9\x53\xeb\xdd\x56\x00\x07\xec\x96\xe6\x0a\xb6\x93\x3e\xd6\x78\x01\x90\x1c\x27\x1a\xea\xfd\x0c\x28\x80\x09\xb9\x2a\x90\x65\xf4\x68\xb9\xba\xd8\xc9\xd5\x1f\x73\xcb\x87\xff\x10\x62\xde\x89\x50\xef\xfe\xd9\x5b\x3e\xe9\x0d\x3d\x6f\x6d\xc8\x93\xd9\x15\x89\xee\x14\x31\xdc\xd6\x17\xb9\xa4\x28\x91\x26\xf1\x8d\x59\x7b\x39\x15\x79\x36\x55\xea\x43\x3f\x83\x3e\xb1\x01\x32\x66\x9e\x53\xe1\x69\xc9\x03\xad\xd3\xcf\x48\xe2\x4e\x09\xc9\x13\x71\x83\x7b\xfd\xc7\x83\x5a\x26\xc3\xce\xd4\x07\x49\xd0\xa3\x79\x2e\x8c\xa3\x88\x7a\x2e\x3a\x3e\x2b\xe6\xf0\x55\xbb\x42\xd2\xee\xfe\x04\xce\x7c\x2d\xe7\xea\x14\xe2\x00\x25\xce\xde\x3b\xfd\x3e\x04\x45\x5a\xb5\x83\x99\x39\x55\x63\x53\xbf\xeb\x48\x03\xab\x76\x10\xf7\x87\xe0\x79\xab\x81\x66\xfa\xdc\xed\x33\xdb\x84\x80\x19\xcf\x5d\x56\x28\xdf\x4f\x44\x8a\xaa\x4d\x1e\x90\x0a\xe5\xd2\xfe\x08\x03\xc6\x63\x5d\x5f\xd9\xef\x74\xbf\x86\xcd\x71\x00\x51\x92\x56\x6d\x4d\x31\xb8\x71\xc7\x0d\x41\x32\x58\x22\x2b\x09\x91\x57\x0d\x2b\x37\xe2\xde\x54\x61\xa2\xdd\xe1\xfd\xc4\x0e\x6f\xe2\x78\x34\x14\x44\x5b\xe5\x95\xb4\x2c\x12\x25\xea\xbb\xec\xf1\x18\x78\xbf\x1a\xaa\x21\x43\xc7\xb6\x3a\xe4\x21\x3a\xaa\xfe\xfd\x0b\x75\xb7\x04\x11\xf5\x0e\xe8\xc9\x3c\x0e\x29\xc7\xed\xc2\x98\x59\x2f\x4b\xcc\x47\xc8\x0b\xb0\xbe\x53\x94\x55\x71\x4b\x75\x99\x23\x1a\xba\xf9\x6c\xd6\xf4\x49\x69\xf5\xe9\xb4\x74\x9c\xfd\x72\x6a\xfc\xb3\x75\x7a\x39\x86\xcc\x9d\x94\x9d\xd6\xc7\x39\x5f\xd2\x3e\x31\xde\x79\x6c\x60\x9c\xb9\x0b\x7e\xbd\x24\xbf\x45\x44\xc8\x39\x0f\xdc\x1b\x77\xf0\x80\xd3\xe5\xcc\x27\xc6\x80\xe3\x30\x24\xee\xe6\x33\x83\xb5\x60\xf6\x49\x6a\xe3\xce\x9a\x04\x66\xdb\x84\x1f\x61\x41\xd6\x5f\x2a\x70\x09\x1d\x56\x51\x09\x80\x4e\xbb\x60\xf7\xa7\x1b\xec\x7e\x97\xce\x62\xb6\x44\x32\xdf\xc3\x65\x1c\x26\xc7\xba\xd4\x97\x71\x1a\x38\xcc\x1d\x1b\x14\x54\xfb\x7f\x42\x05\x6f\x11\xe0\xc7\x72\x69\x5f\x87\x48\x81\x46\xa7\x97\x39\xbf\xe5\x56\x6d\x94\x26\xbf\x6b\xee\xd7\xd5\x53\x7b\xcd\xfd\x36\x21\x2e\xa0\xe8\x87\x2c\x20\x81\x14\x75\x80\x97\xbd\x1d\xbd\x51\x20\x43\xea\x99\xe4\x56\x12\x52\xcf\xab\x97\x71\xc9\xd3\x83\xca\x8c\xb9\xdf\x2d\x96\x27\xb1\x58\x52\xd5\x42\x4d\x69\x56\x60\xee\xcf\xaf\xaa\xdb\xb3\x27\x0c\x13\x20\x54\x5a\xbe\xbe\x80\x05\x0f\x59\xb5\x9f\x5f\x59\xb5\x51\x5c\xad\xe4\xe7\x57\xd5\x5f\x89\x27\x77\x84\x1c\xb1\x4c\x77\x06\x54\x34\xf8\x66\xa9\x5d\x02\x15\x08\x64\x5d\xdb\x8e\xf1\x68\xa9\x5e\x66\x17\x41\xbb\xc2\xa0\xbb\xc2\xa0\x59\x3b\x14\x2a\x58\x11\xad\xf7\x2a\x73\x4f\x3b\x6b\xb5\x36\x52\xa6\xa4\xfc\xbb\x61\x8f\x0a\x11\x0f\x25\x4b\xdf\xf9\x19\xdf\x61\x9b\x3e\x65\xbc\xc9\x80\x0c\x7a\x6a\x10\xd9\xc3\xf4\xc3\xa1\xb7\xfc\x4d\xf8\x4c\x88\x88\xa0\x2b\x28\x1b\x99\x6b\x85\x5a\xba\x26\x4d\xdb\xb2\x1a\x3d\x4d\x58\x1c\x93\xd5\xbb\xb5\x23\x29\x59\x50\x51\xdc\x5a\xdc\x59\x0e\xa3\xfa\x78\xbd\xe2\x90\xb0\x43\xa5\xd8\xf8\xc3\x1e\x50\x00\x86\x70\xbe\x04\xc4\xe4\xd7\xe3\x9f\xaf\x15\xe5\x2b\x8b\x91\xea\x36\xee\x74\x2e\x63\x88\x8f\xbd\x7c\x7b\xe5\x84\xaf\xf1\x65\x19\x11\xca\xba\xdb\x0d\x5e\xe7\x7b\x40\x80\x93\x59\xac\xee\x67\x12\xb8\x0c\xfd\xf3\xac\xc2\x4c\xa4\x44\x21\x4c\x53\x47\x20\xb3\x2e\xcd\x1e\x79\x2e\xe4\xd0\x23\xc2\xec\x01\x21\x7a\x29\xe9\x0a\x62\xd3\xa9\x24\xea\xd1\xd2\x50\x28\x16\x2a\x8c\x77\xff\x88\x08\x1f\x2e\x9d\xd5\x34\x83\x6d\x8b\xd6\x00\x81\x74\xf7\x1b\x50\x5a\xa0\xb8\x32\xfc\xaf\x36\xf6\x82\x9b\xfa\xc5\x07\x25\x96\x5f\x3e\x6c\x3c\x8e\xc1\x55\x8f\x36\x75\x61\x24\xc8\xae\xaa\x37\xdf\xce\xd5\xf1\x2a\xcd\x9e\x8a\x29\x5a\xc6\xb7\x03\xbb\xa6\x24\x90\xcc\xd3\xd3\x1b\x34\x48\x98\x11\xd1\x8c\xa5\x20\xa9\xc3\xb6\xb3\x9a\xea\x04\x71\xb1\x86\x7d\x79\xfd\x02\x59\xd6\x9e\xad\x1c\xa2\xf1\x7c\x58\x56\xbd\xfe\xa8\x00\xc5\x9e\xee\x26\x7b\xc7\x10\x92\x13\xaf\x92\x92\x22\x49\xdb\x1b\x71\xf5\xa4\xc5\x4c\xd0\x61\xeb\xa2\xf1\xe9\xf4\xaa\xf2\xc2\xd8\xbf\x1a\x87\x22\xfc\x44\xe4\x04\x34\x64\x6d\xaf\xf1\x22\xa7\x28\xf6\xcd\x61\xeb\xc2\xb2\x14\x81\xc5\x53\x24\x1f\x04\x54\xdf\xde\xfe\x72\x76\xfa\xa5\x75\x75\x78\x75\x5a\x71\x82\x64\xee\x1d\x6f\x5c\xa0\xa6\xe0\x1d\x6f\x6e\xbe\xcc\xca\x37\xbb\xa2\x85\x6c\xb6\xf4\xea\x36\x3d\xae\xd9\x71\x16\x29\x8c\x53\x8c\xeb\x28\xa7\x50\xce\x82\x01\xe5\xcc\x03\x2a\x50\x40\x67\x01\x17\x32\x98\xbf\x88\x8e\x45\x93\xb0\xe8\xf9\xb9\x93\xb1\x88\x94\x85\xf7\xe9\x45\xad\xaf\x54\x90\xe7\xe1\x7d\xbb\xd8\x95\x7d\xa6\x50\xcf\x4a\xd7\x76\x2c\x96\x33\x46\x2a\x77\xde\xf4\x98\x48\x60\x44\xc2\xc4\x22\x84\x7c\x4b\xa8\xa9\x69\xb6\xa3\xc0\xc5\x80\xe1\x80\x3d\x61\x26\x45\xd5\x7a\xe3\x83\x60\xfe\x19\x34\x04\x98\xe1\x47\xa3\x15\x4f\x6c\x02\x3d\x32\x74\xdc\xc0\x20\x41\x87\x06\xe4\xd9\xa6\x53\xb7\x34\x35\x99\xc7\x30\xb5\x71\x90\x73\xdb\x6b\x11\xcc\x9d\x2e\x3a\x55\x63\x9a\xb8\xd4\x2c\x1d\xcc\x8c\x50\xad\x67\x07\x46\xd2\x77\x6f\xb2\xef\xf8\xb7\xfe\xf8\x08\xbb\x49\xb7\xce\x67\x39\x71\x56\x92\x4a\x8f\x1c\xe8\xa1\xbc\x31\xf5\xa7\xaa\x55\xfc\x4f\xcc\x25\xd3\x0a\xef\xf7\x18\xd4\x11\x30\x97\x8c\x21\x10\x51\xd1\xb0\x0e\x0f\x0b\x49\x9d\x86\xc3\xc6\x95\x5c\x63\x61\x58\x28\xa6\x01\x91\xd4\x59\x02\x3e\xb2\x9a\xf0\xfa\x9e\xa6\x03\x42\xec\xfb\xcd\x89\xa3\xfd\xc1\x3d\xa8\x5c\x03\x57\x1c\x6e\xaf\x76\x3e\x4e\x1d\x97\xb3\x01\xf1\x3c\x33\xe5\xca\xc2\xa8\xb3\x9f\x3d\xcc\x03\x8c\x8e\xbb\xc4\xe9\xb1\x28\x27\x39\xe1\x02\x0f\x61\x03\x45\x1a\xb8\x5e\xb0\x49\xb4\xae\x39\xb5\xc3\x12\x4c\xbf\xad\x35\x7f\xaa\xb1\x8f\x91\x99\x02\x15\xc4\x82\xbd\xc5\xcc\x0a\x98\xe2\xd2\x32\xe5\x68\xb6\x18\xa5\x14\x2c\x37\xf1\x88\xee\xb2\x0d\xc0\x0c\xaf\xdf\xaa\x75\xd3\x63\xf8\xd9\x66\x6e\x68\xbb\xad\x7b\xb5\xad\xbb\xc7\x70\xae\x20\x79\x78\x2c\x29\x4b\x24\xa9\x96\x62\x19\xfc\x93\x61\xa8\xab\xe9\x55\x97\x0a\x14\x93\x8f\xa8\x40\xe1\x38\x94\x5e\x53\x38\xd5\x1b\x7a\x60\x87\x59\xc9\xb2\x72\x93\xd9\xf4\x2e\x04\x62\xe3\xf2\xbe\x64\xbc\x50\x0f\xa6\xd3\x18\xa3\x26\x65\xb9\x0c\x99\x4d\xcf\xb1\x90\x79\x38\x2e\x9b\x75\xa2\xed\x0c\x56\x3b\x83\xd5\xce\x60\xb5\x33\x58\xed\x0c\x56\x95\x1b\xac\xd4\xed\x83\xd9\xd4\x57\x87\x02\x8c\xa9\x21\x17\x7b\x95\x59\x37\x47\xfd\xfe\xb8\x72\x69\x24\xb5\xfb\xa1\x78\xe7\xeb\xb1\x2e\xbe\xf5\x58\x87\x06\xb7\x0e\x84\x9c\xdd\x4b\xab\x86\x12\x34\x7f\x1a\x48\x8e\x03\x02\x5f\xc9\x61\x08\xdf\x74\xa9\xeb\x92\x00\xd2\x85\xc6\x59\x82\x69\xd2\x9a\xe9\x74\x28\xc0\xbc\x9a\xd0\xa8\x59\x8e\xfa\xd4\xc5\x68\x91\xc5\xa7\x98\xda\x44\xb8\xb7\x7e\xbd\xc9\xb2\x6c\xe8\xd8\xb2\x00\x41\x0c\x35\xcd\xe6\x94\x5d\xe0\x49\x28\x46\x84\x7b\x4b\xc5\x50\xc1\x9c\x1b\xe3\xca\xbe\x90\x9c\x93\x25\xdc\x01\xae\x34\x3f\x5b\x77\x31\x6e\x21\x37\xae\xba\x62\x75\x6a\xa7\xbd\x6f\x85\xf6\x0e\x42\x51\x50\x7b\xef\x91\xa0\xaa\xda\x74\xf0\x05\xf2\x70\xd0\x79\x6b\xd5\x60\x33\x4d\xb3\x81\x7b\x86\x60\x11\x77\x88\x41\x84\xc0\x43\x81\x7a\x86\x47\x02\x11\x7f\x9a\xde\x74\xff\x64\x18\x10\xea\xf2\xf3\xe7\x9f\x4f\x3f\xa1\x93\xd3\xa3\x7f\xbe\x7f\x7f\xf6\xe9\x7d\x5e\x9d\x90\x1e\x0c\x4b\x9b\x25\xef\x84\x65\x8d\xa7\x7b\x64\x0a\xc9\x38\xee\x90\xfa\x12\xc1\x55\x8a\x49\x8b\x56\xd6\x6e\x59\x6c\xcd\xb2\xd8\x15\xaf\x7f\x64\xf1\x7a\xb5\x77\xf4\x48\xd0\xf0\xc9\xe2\x0d\xc3\xa3\x36\xa7\x25\x04\xf8\xcc\x3d\xcd\x54\xe3\xb5\xca\x02\x80\xe6\xb5\xbf\x84\x45\x19\x7b\x14\x8b\x18\x7c\x7a\x3e\x73\x7c\xe6\x6e\x69\xf0\xd3\xcd\x04\x71\xbb\x0d\xec\xc9\x66\xf1\xf5\xd4\x2c\x42\xc5\x9a\x4c\x53\x74\x20\x71\x4f\xd2\xf6\xb0\x58\x61\xaa\x72\x53\x9b\xa1\x80\xc3\x60\x30\xb0\xac\x46\xbd\xd9\x4b\x29\x89\x23\xbc\x09\xd4\xfb\xaa\xc2\x4b\xa8\x99\x93\xf4\x96\xcd\x1d\x96\x17\xf1\xff\xa8\x0a\xd8\x5f\x6f\x7e\x3e\x30\xae\x5f\xa8\x6e\xd0\xde\x57\x6c\x7c\x5b\x1d\xbc\xe4\xe1\x0e\x06\xb4\xaf\xba\x7f\xb9\x9c\x60\xff\x2f\x00\xba\xe9\x68\x23\xce\x22\xf6\x30\x56\xd6\x06\xaf\x5b\x3b\x3e\x6f\x4d\x9a\x3b\x6f\xf6\x1a\x0f\x6e\xb9\x85\xc5\x4b\xb7\x58\xdb\xd9\x7f\x77\xf6\xdf\xf9\x66\xb0\x9d\xfd\x77\x67\xff\x2d\x32\x09\x68\x67\xff\x2d\xdb\xfe\xab\x2e\x10\xb0\x3d\x67\x9f\xbf\x92\x0a\xda\x8f\x7a\x2c\x20\xeb\xd2\x93\x53\xbb\xc0\xb8\xeb\x0a\xa3\x96\x52\x75\x64\xdc\x5b\xa3\xbd\x38\x67\x22\xb2\x09\x0f\x88\x24\x02\x9d\x60\xd1\x55\x15\x3d\x73\x8a\x77\x3d\x12\xd5\x1a\xbe\x80\x52\x3f\x38\x0c\xc1\x1a\x93\xf6\x9f\x76\xbf\x1e\x9c\x2c\x65\x4e\x4d\x3b\xcf\xc2\x0f\xfb\x78\x7a\x71\xf5\xf9\xd3\xf6\x5d\xaa\x62\xba\x76\xf7\xa9\x27\x7b\x9f\xf2\x48\x28\x59\x60\xc0\x85\x2a\xc3\x4c\xfa\x11\xdb\xa0\x38\x55\x91\xaf\x13\x37\x9d\x2a\x1f\xba\x10\xdd\x66\xeb\xd4\x04\x54\x57\x48\xc0\x76\x9f\x92\xc1\x62\xa6\x70\xdc\x27\xde\xd3\xad\xbf\xb6\x5b\x84\xdb\x80\xb6\xe2\x69\x31\xca\xd2\x18\x3e\x62\x49\x78\x88\x87\x4b\x47\xf0\x96\xaa\x3c\x8c\x8b\x21\xb2\x20\x20\x8e\x64\xdc\x1f\x40\x99\x54\x2f\xa6\xce\xb2\x60\xdc\xe6\xde\xd7\x3f\x1b\x7f\x87\xd9\x82\x9b\xff\xa1\xf1\xcd\xb8\x7e\x6e\x7a\xb4\x4f\xcc\xaf\x96\x35\x30\xae\x5f\xe4\x29\x1b\x08\xd5\x92\x16\xf7\xd3\x9e\xf6\x1d\xec\x79\x36\x76\x7a\x62\x9f\x05\xb7\x91\x20\xfc\xb6\x8b\xc5\x2d\xd6\xf5\x83\xf7\x51\xcd\x25\xcc\x6e\x47\x02\x38\x73\x45\xee\x65\xed\x51\x76\x94\xa4\x7f\x35\x9e\xc5\x93\xf2\x6d\xb8\x4c\xb0\xf0\x4c\x7e\x6f\x59\xb3\xe2\xe1\x6f\x71\x22\xae\xcd\xd9\x40\x10\x5e\x69\x3c\x3a\x18\x51\x70\x40\x89\x27\x31\x8b\xf7\x48\xcd\x85\x45\x4c\x22\xb8\x9d\x5b\x9a\xe1\x1c\x87\x65\x33\x45\x77\x5b\x49\x01\xa0\xb8\xed\xec\x60\xd8\x8f\x20\x96\x95\xc3\x23\x25\xd9\xf0\x2f\x10\x27\x9e\xaa\xd0\x02\x69\xf0\x5d\x42\xa4\xe9\x11\x21\xac\xda\x74\xbb\x55\x1d\x97\xd0\x97\x23\xb2\x15\x08\xda\x26\x1c\x0f\x1f\xa9\xc1\xa2\xb4\x21\xe3\x82\x71\x89\x81\x1b\x35\x6d\x60\x14\xd7\x2f\xd6\xe3\xfa\x2f\xb4\x7f\x68\x22\x33\x05\x84\xb6\xcb\x2e\x58\x02\x4d\x3e\x30\xaa\x6d\x44\xb1\x68\x39\xd8\xfb\xde\x11\x6f\x41\x0e\x04\xf0\xc1\x80\x08\x80\x65\x22\xed\x3d\xda\x96\x03\x62\x67\xd5\x40\xf8\x48\x3b\x5d\x79\xce\x82\x38\x2d\xaf\x42\x63\x3e\x52\x5d\xf9\x69\x57\xe8\x98\x85\x43\x0e\xdf\xa5\xbf\x94\x60\xd6\x9f\x1d\xcf\x4e\x97\x7d\x92\x17\x4a\x2f\x96\x88\x06\x8f\x32\x25\xd7\x66\xf7\x95\xab\x4d\x93\x67\x24\x52\xc8\x2f\x71\xf4\xe2\xd7\x1b\xab\x76\xfd\xc2\x8b\xe9\x28\x5e\x6f\x38\x51\x2d\xe2\x17\xab\xd1\x2d\x58\x8f\x88\xae\xdb\xc5\x3d\xac\x22\x47\x21\x43\xf2\x8e\x40\x86\x64\xd2\xed\x2b\x33\x93\xb3\x22\x24\xc4\x45\xe4\x98\xf9\xd5\x62\xcd\xc3\xce\x60\x59\x5f\x5b\x57\x87\x97\x57\x96\x75\x8d\x7e\xb0\x3d\xe6\xf4\x84\x09\x0d\x58\x56\x83\x63\x1a\xfc\x80\x0c\xe3\xa0\x56\xe0\x4e\x01\x81\x05\x42\x10\x09\xd5\x0f\x80\x25\x5d\x16\xe2\x30\xd4\xfe\xd2\xf2\x8d\x92\x5e\xca\xa6\xee\x6f\x09\x8b\x75\x12\x6a\x5a\xe7\x32\x9b\xc5\xc3\xdd\x85\x7f\xb7\x49\x3e\xe6\xc2\xdf\xa1\xd2\xc3\x3a\x9f\x57\x09\xe3\xd0\x4c\x43\x15\x17\x0b\x9e\x4f\x5a\x11\xef\x93\x61\x99\x90\xe5\x33\x47\x70\xda\xc5\x06\x2d\x6f\x1e\xf5\x89\x50\x54\x64\x46\x16\x7f\xa4\x41\xaf\xe5\x63\x9e\xa3\x42\x1f\xba\x7d\xc2\xc1\x07\x11\x74\xd0\x27\x5d\xfb\xa7\xe4\x8b\xe6\xcd\xd8\x28\xa2\xe0\xeb\xe0\xb4\x11\x40\x58\x52\x64\x35\xf9\x7c\x9b\xc8\x74\xb3\x5e\xed\xf5\x3c\xed\x31\xfb\xf6\x11\xf4\x88\x4b\x83\x8d\x84\xc7\x84\x1e\x96\x6d\xc6\xfd\x98\x5d\x40\x47\xcc\x2d\x1a\x54\xc4\x10\xe8\x23\x9b\x1f\x42\x6e\x81\x2d\xe7\xc6\xa3\x02\x24\x67\xaf\xb9\xef\x2b\x5e\xd4\x9b\x77\xe2\x59\xd9\xec\x10\x79\xb6\x0b\x2a\x49\x0b\x0e\xc8\x4a\xe0\xb9\xd2\xd6\x9f\x6d\x76\x9f\x91\x44\x29\x01\x50\x16\x2c\x87\x19\x5d\x1a\xf9\x15\xdd\xb0\xd0\x1b\xac\x74\xd3\x17\x13\x05\xbf\x92\x1e\x77\xba\xc0\x77\x02\xb5\xee\xe9\x09\xcf\x96\xc2\x3e\x39\xec\x90\x20\xe7\xbc\x83\xc7\xa0\x04\xbb\xdc\x5a\x58\x0e\x0f\xbb\x44\xf4\xf2\x86\xaa\x86\xb0\xd6\x91\x26\xab\x20\x3e\xc4\xfb\xc4\xe9\x42\xc4\x8f\x13\x1f\x4c\x8d\xe7\x12\x20\x80\xa1\x82\x56\x15\x07\x54\xda\x5d\x1e\x63\x3e\x10\x2f\x2c\xca\x98\x97\xe9\xee\x74\x79\x5e\x95\xd2\xa3\xa3\x74\x62\x96\x75\x89\x07\xb7\x36\x2a\xcd\x81\x52\x1c\xee\x84\x09\xae\xa0\xeb\x7f\xff\xf5\xf7\xf8\x8f\x1f\x5f\xbe\xfe\xbd\xa2\x58\x93\x84\x80\x06\x95\x59\x0c\xfc\x89\x45\x3c\xc8\xc3\xf1\x3d\xf2\x58\x67\x2b\x11\x5d\x92\x81\xde\xe9\x51\xe4\x49\xcb\x05\xe1\x82\x05\x1b\x59\x48\x63\x11\xf1\x42\x89\x3b\xb1\x84\x84\x8a\xa0\xd8\x4f\x28\x71\xc7\xd4\x3f\x55\x12\x0b\x3d\xee\x4f\xad\xe0\x2c\x3e\xb5\x24\x27\x44\x56\x93\xd2\x31\xd3\xfe\xee\xda\xff\xfd\xda\x46\xfb\x0a\x8f\x90\xc8\x9c\xe4\x05\x90\x98\xf6\x90\xe7\x19\xf5\xc1\x56\x15\xc8\x62\x25\x4c\x17\x9a\xf1\x92\xfa\x1f\xd4\x1d\xa9\xdc\xc4\xfa\x5b\xab\xe6\x25\xfd\xe7\x1a\x42\xf5\x73\xb7\x34\xa0\xb2\xa2\x63\x11\xda\xcf\xe3\x95\x86\xf7\x22\xb2\x28\xb6\xff\x6a\x9c\xd2\xe3\xff\x7a\xf3\x06\x4a\xed\x7e\xbd\xb9\xfe\xf7\x9f\x5f\xfe\xf8\xea\xbf\x7e\x6f\x0a\xee\x58\x96\x78\xfe\x16\xfe\xf3\xf5\x07\xab\x76\x0d\xe1\x11\x11\x90\x64\x59\x8d\x21\x76\x39\xe0\x91\xf3\xc8\xec\xaa\xd2\xec\xff\x6d\x59\xad\x17\x70\xed\x07\x1f\xbd\x84\xa2\x94\x96\xd5\x80\x7f\xfe\xbb\xde\xe4\xd3\x59\x9f\x30\xb4\xb3\x78\x68\x28\x6e\x12\x0c\xaa\x53\x0f\x99\x93\x4f\x19\xc6\xdc\x6c\xfd\xf1\xd9\x11\xef\xc2\x09\xc3\x34\x61\x8e\x47\x9d\x5e\x86\xa7\x38\xb9\xb6\xdf\x09\x13\x7b\xa6\x46\x56\x4f\xd2\x4a\xe1\x54\xbf\xbd\x7e\x51\xfe\xbc\x27\x24\x02\x81\x3a\xf4\x7a\xf1\x06\xce\x1c\xec\xbd\x63\x4e\x94\x73\x7b\x6f\x61\xbc\x32\x90\x82\xb2\xcf\xa9\xb5\xe2\x41\x6f\x6d\xd5\x5b\xc5\x9b\xf6\x61\xd0\x89\x3c\xcc\x97\x86\x91\x5f\x25\x2b\x23\x37\x91\x42\x8d\x1d\x22\x66\x63\x84\xce\xb7\xd6\xb8\x0c\x72\x22\x3b\x1b\xdd\x8f\xe1\xd4\xc7\x9a\x63\x71\x7e\x22\xca\xc9\x88\x3d\x79\xbd\x34\x6b\xa7\x51\x25\xd1\x1a\x00\xfa\x4d\xf7\xb5\xb6\x16\xa2\xbe\xaa\x60\xbb\x20\xb4\xa7\xea\xa3\xce\x7d\xbd\x04\x56\x5c\xaa\x80\xa5\x4b\xa5\x11\x78\x26\x09\xb2\x56\x30\xf3\x99\xcc\xad\xc7\xb9\xba\x1f\x3b\xad\x1a\x61\x02\xa1\xc2\x8c\x73\xd2\xae\xff\xfd\xea\x2f\xbf\x9b\xb2\x4b\x7c\x62\x8e\x43\x67\x2a\x5f\xd9\x97\x91\x3d\x44\x2c\x40\x97\x98\x7a\xa2\xf2\x74\xab\xb1\x46\xe6\x33\xf7\x76\xaf\xb9\xcf\xa1\xdb\x11\x5c\x67\x73\x52\xae\xe6\x3c\x5f\x9e\x60\x6b\x8f\x9f\x89\xc3\xd0\xa3\x8e\xc2\xe6\x34\xd2\x59\x79\xfd\xea\x77\x73\x19\xe9\x46\xa8\xe6\x08\xde\x36\x42\xcc\xb1\xaf\xd4\x49\x1c\xc9\xae\xaa\x42\x40\xe5\xf0\x56\x42\x4a\xf7\xb3\x12\xea\x88\xc1\xc4\x6d\x47\x21\x31\x48\xde\x62\x41\x87\x13\x6f\xf4\xe5\xf4\x88\x53\xa7\x37\x02\xe2\xea\x7f\xa4\xfa\x60\x3c\xb2\x87\x0f\x62\x67\xd0\x32\xd9\x3b\xd0\x02\x0b\x94\xfc\x4e\xed\x5e\x59\x38\x09\x2c\xe8\xb0\x93\xa3\xef\x10\x29\x01\xb6\x6c\x1f\x46\xef\xda\xcb\x22\x3a\x7b\xe9\x06\x9e\x77\xb5\x61\x2e\x16\xdd\x8d\x47\xcc\x2a\x2a\x2a\x89\xdf\xd0\xdc\x50\xcd\x67\xb2\xa1\x43\xc1\xef\x80\xce\x89\x4b\xf1\x74\x6a\x62\x01\x67\xcb\xb8\x4c\xf7\xc4\xeb\x4b\xa5\x5c\xcc\xeb\x1f\x00\xac\x26\x4b\xe6\x6f\x3a\x07\x43\xc9\xe3\x50\xfc\x16\x11\xf2\x8d\x40\xbc\x4d\x26\x43\x65\x24\xd0\x09\xf3\x69\xc0\x2a\xc9\x51\x81\xf6\x8d\xb8\xfd\x8d\x98\x70\x60\x55\x7c\xe7\x01\x95\x77\xb8\x8f\x97\x41\x72\x19\x0c\x06\xc6\x9f\x7f\x6c\x50\x5b\x79\x56\xcc\xa4\x1c\xb9\xe9\xc1\x64\x8e\xa3\x7e\xdc\x74\x56\xe7\x4a\xd6\xe7\x7f\x66\x0b\xd4\x3f\x05\xe1\xe8\x73\xa0\xb2\x06\x69\xd0\xa9\xc4\x1d\xc1\x22\x2c\x84\xf6\x0c\x3b\xcc\x7f\x5e\x85\xb1\x34\xe9\x22\x73\x95\x45\x39\x58\x6e\x19\xa2\xb7\x84\xc5\xd4\xb2\xec\x8f\x11\xce\xac\x25\xbd\x01\xab\x7b\x94\x59\x0b\xf9\x63\xe4\x90\xbc\x30\xdc\x19\x6d\x61\x5b\x62\xc2\x76\x3b\xcb\xd2\x3b\x8b\xa7\x66\xbb\x81\x43\xec\x74\x89\x8a\x53\x52\x45\x68\x16\x0b\x07\xed\xd0\xff\xfb\xff\xf9\xff\x12\xe8\x88\xdd\x17\xb5\x52\xbe\x5c\x50\x7f\x65\x6b\x1d\xbb\x30\x48\x91\x73\x4e\x9f\x37\x2e\x1b\xe8\x2c\x70\x80\x11\xc3\xcf\x39\xde\x96\x05\xa8\x0b\xdb\xe5\x91\xf3\x39\x0d\x9c\x01\xe3\x9e\x5b\x68\xdc\x2d\x2a\x89\xba\xc7\x97\x9a\xfa\x31\xbd\x7f\x9e\x5b\x56\xe3\xd2\xb2\x66\x3b\x7c\x3a\x4c\xfa\x42\x6c\xb1\xa4\x4a\x5c\x5c\x95\x9b\xe2\xce\xb8\xa7\x8d\x6c\xbd\xf3\x97\x43\xce\x2e\x9c\x01\x46\xf2\x84\x61\xfa\x1e\x48\x48\x91\x7d\xb8\xa0\x5c\x7d\x60\xc1\x21\x77\x72\x0e\x67\xe6\x44\xca\x9f\x76\x8e\x03\xdc\x21\x25\xb8\xd6\x20\x42\x3e\xee\x1a\xf5\xd3\x9c\xd8\xcc\x98\xf8\xf2\xef\x4f\x5d\x16\x60\xee\x34\xf0\xc2\x98\x88\xf3\xcf\x27\xbf\x96\xef\xf4\xbe\x51\xcd\x66\xf3\x07\x2b\x9f\xc2\xc1\x44\xe0\x1b\xbc\xf4\xc6\xc4\x93\x4e\xac\xbd\xe6\x3e\xd8\x6e\x47\x9a\x49\x75\xf5\x4a\x6c\x39\x14\x01\x0d\x43\xf8\x63\xe2\x71\x88\x2a\x55\xcf\x50\xf7\xad\x55\xc3\x77\xf8\x5e\x9f\x9e\xb7\xf0\xc3\xc4\x73\x63\x5c\xa8\xd9\x07\xd5\x2f\x25\x24\x34\xc1\x58\xbe\xde\xe8\x9a\xba\xb3\x6e\xed\x9d\xef\xfe\xa9\xf9\xee\x7d\xe6\x66\xeb\x35\xff\xba\x38\x7c\x7f\x8a\x2e\xe2\xc0\xe6\x25\x4a\xdf\x14\xcc\xc3\xd1\xaf\x3d\x1a\x42\xae\xa4\x73\x6b\x1a\xf8\xa2\x22\xd7\xc5\x06\x84\x27\xd7\x01\x30\xbd\x18\x37\x68\xff\xcf\x5a\xa0\x4b\x2e\xd2\xa7\xef\x28\x98\x59\xcc\x4b\xb9\x08\x66\xc0\x37\x50\x51\xe7\xa6\x3f\x0c\x71\x87\x34\xfa\xc1\xa2\x2d\xe1\x88\x49\xdb\x23\xc5\x62\xca\x76\xbb\xc1\x6e\x37\xd8\xed\x06\x4f\x74\x37\xb0\xd5\x42\xcf\xd4\x0e\xb0\x7b\xe8\x0a\xe5\x6b\xd9\x9e\x74\xb5\xbd\xe6\x3e\x76\x85\x61\x0f\x47\x21\xbd\x27\x5e\x1d\xa2\x4a\xb0\x8b\x5d\xe1\x03\x9d\x71\xd8\x7b\xb9\xce\xb1\x89\xf6\xb3\xd9\x05\x89\x0e\xac\xda\xfc\x65\xcd\x1a\x9d\x7b\xe3\x62\x89\x0d\x4e\x7e\x8b\x28\x27\x3e\x73\x23\x0f\x90\x62\x7d\xdc\x21\x93\x57\x8b\xdc\x17\x62\xb2\x6f\x1f\xbe\x13\x97\xd4\x81\x9a\x3b\xe6\xbd\xe1\xeb\xe7\x0c\x15\x35\x59\xcb\x4d\x90\xbe\x13\x26\xbc\x31\x6e\x55\xf4\x68\x60\xb6\x39\x0b\x24\x09\x5c\x98\x46\x97\xb4\x71\xe4\xc9\xd1\x1e\x81\xa0\xb9\x90\x53\x41\xea\xf5\x89\xe7\x25\x96\xd4\x31\x6f\x63\x7a\x97\xf6\x78\xee\x6e\x26\xbb\x9a\x0e\x4f\xb9\xa6\x03\xec\xd1\xf1\x9a\xcb\xde\x75\xa8\x77\xdc\xa5\x7e\x98\x07\x55\xc5\x7b\x44\x59\xdf\x0e\x23\xc9\x7c\x15\xb8\xb4\xe2\x16\x04\x37\x45\x0d\x4c\xa1\xf6\x13\x1f\x53\xcf\x01\x02\x8c\x68\xa2\x92\xd5\xe4\x63\xca\x56\xe1\x3b\x86\x82\x90\x77\x89\x6b\x88\xc8\x06\xde\xdb\xc4\x80\x87\xac\xda\xdc\xb7\x62\xe4\xeb\x82\xef\x29\xfb\xc7\x6c\x77\x98\x7a\x53\x0f\x81\x69\xeb\x88\x74\x68\x80\x52\xae\xa1\x16\xed\x04\x51\x88\xde\x41\xbf\x05\x70\x1f\xc4\x6b\xcb\x6a\x60\x1f\x7f\x63\x01\x1e\x88\xf8\xb8\x71\xd9\x20\xf0\x18\x76\x85\x3a\x8c\x62\x76\xc4\xbf\xc1\x3e\xe8\x18\x7d\xec\x51\x17\x4b\xa2\x43\x37\x5e\x4e\xf8\x49\x0d\x0a\x93\x3c\xef\x4d\x35\x98\x79\xd8\x1e\x8f\x3f\xcf\xe2\x8e\xb2\xe5\xaa\x47\x5a\x5d\x16\xfe\xcc\x38\xc1\xdb\x5c\x21\xb4\xd0\xad\x07\x03\x3c\x09\x83\x01\x37\x7a\x7c\xf1\x90\x7d\x9b\x6d\x1f\x56\xad\x26\x6b\xf5\xc9\xf6\x6d\x66\xb4\x59\x14\xb8\x6a\xd1\x67\x79\x83\xcf\x71\x20\xa9\x38\xba\xca\xe6\xc1\x99\x10\x11\x41\x57\x10\x58\x49\xf8\xaa\x56\x66\xea\x77\x94\x4a\x82\xb0\x27\xdf\x5a\xb5\x49\x6b\xaa\x22\x02\x1d\x45\x1d\xa9\xbb\xd8\x25\x14\x7f\x27\x90\xbe\xbe\x9a\x79\x5b\xe6\x88\xe8\xf0\x18\xaa\x85\x38\x52\x6c\x00\x04\xc2\xb2\x52\xc8\x1f\x13\x32\x49\x4c\x1f\x07\x43\x27\xa6\x47\x07\xb8\x57\x14\x9d\x37\xd9\x51\xf6\xbe\xcd\x29\x3e\x39\xca\xe6\xcd\x66\x43\x3a\xf2\xb5\x1e\x4e\xf1\x38\x9a\x73\xd1\x28\x59\x40\xa4\x24\xcb\xa0\x5f\x94\x0e\x6f\x14\x77\x01\xf8\xae\x36\x0b\x88\x3a\xc4\x53\xc2\x56\x8a\xd3\x5c\xb8\x4b\xfd\x33\x00\x12\x21\x5e\x63\x3c\x62\x54\x38\x03\x64\x26\x22\x15\xad\x21\x05\x24\x1a\x13\xac\x39\xa1\x52\x40\x9a\x7d\xc2\xdf\xce\x2d\xd6\x51\xe5\x66\x34\xa6\xe5\xae\x70\x44\xf5\x51\x3c\xa9\xab\xb0\x7b\x6d\x96\xc2\x89\xee\x13\x21\x9c\x92\xbb\xea\x8c\x88\x8b\xe4\xb1\x80\x39\x31\x5b\x2e\x97\xb1\x26\x3e\xda\xe2\x37\x43\xd9\xb2\x32\xbb\x09\x3b\xe1\x22\x59\x2e\x6c\x30\x04\xdb\x5f\x2c\x2b\x4b\x66\x47\x8d\x37\xb7\xbb\xbc\x33\xa8\x97\x87\xc2\x53\x7d\xb8\x3c\x90\xdb\x23\x6e\x85\xa8\x55\x31\x4f\x7a\xc4\x6d\xdc\x89\x9c\xe3\xaa\x47\xf2\x4c\x83\x25\x5c\xd1\x73\x38\xe2\x47\x81\xd3\xed\x29\x15\xc5\xd7\x14\xc5\x90\x06\x13\x3f\x54\x82\x69\x10\xf7\x96\x2d\x33\x92\x70\x8a\x3d\x74\x42\x04\xed\x04\x80\x26\x44\x2a\x04\x2c\x4c\xee\x21\x69\x86\x1d\x9a\x44\xd9\x7c\xee\xc7\xd4\x68\xe1\x01\x00\xf8\xeb\x17\x86\xfe\x67\x36\x97\xb1\x00\xf4\xe6\x4c\x81\x2a\x73\xba\xf5\xca\x44\xb3\x43\xa4\xef\x7a\x19\x65\x2f\x13\x9e\xd3\x6f\x04\x1d\xb7\x5a\x9b\x67\x37\xfd\x46\x96\x66\xee\xc2\x77\xcb\xd3\xbd\xee\xfe\x11\x11\x3e\x7c\x0a\x4a\xd7\xdd\x6f\x40\xe9\xd7\x86\x71\x1d\xcb\xdb\x73\xcb\x72\xeb\x5f\x6f\xcc\xeb\xe7\xd3\xa6\x27\xbd\x43\xa6\x42\x69\xea\x17\x1f\x30\xf0\xe5\xc3\xc6\x67\x4f\xc6\xbd\xe6\x3e\x78\x73\xeb\xcd\x35\xab\x75\x20\xe1\x31\x45\xcb\x84\xde\x8d\xa5\x05\x90\xdf\xb3\x37\xa4\xee\x4f\xb8\x38\xde\xef\x74\x92\x75\x59\x5b\xf6\xf8\xd2\x0f\xa4\x77\xef\xf0\x7d\x75\x7b\xb4\x6a\x3e\xfb\x18\x93\xcc\x67\xd5\xe0\x55\xe4\xf0\x21\xa4\x03\xda\x53\x43\x1f\x25\x7f\x86\xdd\x30\xbb\x1a\x07\xe4\xe8\x12\x83\xca\x28\x20\x02\x4a\x66\xc1\x10\x70\x18\x1a\xd4\x7d\xfb\xb7\xd7\x7f\x7b\xf5\xe3\x7f\xfe\xd7\x7f\xfd\xad\xf6\x72\xae\xcd\x0d\xf2\x54\xd5\x60\x47\x17\xd0\x5b\x1d\x19\xe8\x73\x48\x02\xd4\x52\xe5\xcf\x21\xfe\x16\xcd\x09\x79\xef\x30\xd6\xf1\x88\x11\x7a\x78\x38\xd3\x1f\xe3\x80\x17\x94\x50\xee\x33\x9b\x7a\xe4\xd5\xca\x36\x3d\xd5\x4e\xce\x44\x49\xc2\x7d\x26\xf2\x0a\x58\x10\x21\x70\x87\xa0\x23\xc8\x02\x5a\x75\x4f\x0f\x98\x9e\xae\x03\x74\xc5\x50\x24\x08\x1a\xf7\xfe\x12\x85\x1e\xc1\x82\x20\x12\x60\xdb\x23\x68\xbc\x5c\x20\x92\xf9\x8d\x99\xbe\x5a\xb5\x8d\xef\x3d\xfb\x9e\x53\x45\x94\x32\xc0\x1e\xc0\xf9\x67\x5c\x7e\x2f\x09\x76\xe4\x16\x41\x78\xc4\x9e\x6b\x20\x6a\x89\xd3\x50\xbd\xb0\xd7\xdc\x37\x06\x54\x76\x0d\xec\xba\x2c\x10\xf5\xe6\x0a\xa7\x63\xd2\x50\xc6\xe1\xa8\x1e\x29\xe7\xee\x5d\x74\x4a\x55\x97\x4b\x5e\xe1\xb0\xcd\x22\xd9\xf0\xd3\x15\x9a\x7d\x02\x46\x92\x3a\x9b\xbe\xb4\x7c\xbd\xc1\xc6\xb7\x6b\x5f\x3a\xe5\x27\x3e\x8f\x8f\x3e\x18\x68\x26\x70\xf3\x39\xbe\x3f\x3e\xc9\x2b\x64\x78\xf2\x69\x19\x40\xd9\x4f\x44\x9e\x7c\x3a\x1c\x9f\x1e\xbf\x1a\xc7\x27\x9f\x8c\x77\x8c\x0f\x30\x77\xd5\x13\x3e\xbe\x77\xdc\xe0\xd9\xc6\xb3\x99\x15\x19\xd9\x92\x72\x0f\x09\x3b\xdb\x59\x40\x7f\x92\xb8\x9d\x77\xe8\x69\xc6\xb1\xe3\x7b\xe3\xf5\x9f\xff\xfc\xe7\x4c\x19\x24\x38\x80\xbb\x6e\xd9\xce\x80\x29\x8e\x83\x0c\xc5\x7d\x59\x56\x7c\xb3\xde\x40\xfe\xd5\x0e\xec\x63\x06\xec\x23\x43\x95\x39\xbd\x0f\xf9\xb8\xa8\x19\x5a\x2a\xcc\xf7\xe5\xec\x6f\x0f\xea\x98\x17\xaa\x1b\x3f\xb3\x5e\x63\x8a\x9e\xd5\xb6\x3a\x4a\xf8\x13\x73\x97\x37\xed\x97\x18\x29\xfc\xb4\xa3\x79\x03\xe6\x3e\xc2\x3e\x4f\xb4\x84\x8c\xad\xec\x79\x42\xbe\x03\xdd\x2b\x01\x74\x2f\xef\x10\x8a\x0f\x98\x85\xe7\x8f\x4b\xf1\xa9\xa7\x52\x30\x73\x9d\xd2\xbf\x50\x97\x30\xc8\xbb\x1a\x12\xbe\xb5\x88\xcd\x2a\xe2\x99\xe8\x11\xe5\x38\x7c\xe0\xc9\x2b\xe6\xdb\x79\xe6\x86\xd5\x70\x7f\xd2\xe6\x67\x0c\xe9\x1b\xcd\x0d\x07\x9a\x24\xf3\xed\x86\xe3\x64\xb2\xe5\x0b\xed\xd1\xbc\x48\x95\x1e\x5d\xd5\xf8\x62\x33\x77\xa8\xd6\x9b\x82\xc1\x85\xc0\x47\xe8\x73\x40\x7b\x74\x2a\xf2\x11\xa2\xe6\x47\xd4\xef\xd4\x1f\x26\xaf\x26\x34\xce\x64\xb0\xea\x34\x57\xb3\x15\x12\x87\x62\x6f\xff\x4b\x17\x4b\x28\xbe\x22\x3e\x90\x18\x31\xe2\x91\x5a\xf9\x4d\xda\x31\x6a\xce\x49\x02\xda\xe9\xe6\x4f\x42\x37\x17\x93\x5b\x05\x48\x5d\xa6\x55\x92\xb8\xb9\x15\x49\x0a\x40\xd8\xcf\xcd\xdb\x56\x4d\x2f\x7b\x53\x4e\x19\x08\x2f\xaf\x54\xb7\x6d\xa1\x68\x3e\xd4\x9e\xbe\x47\x80\x9a\x87\x5a\x50\x7e\x8c\x81\x9a\x8c\xec\xc3\x86\xd8\xac\x8a\xd8\xbf\xf8\xe5\xbd\xe6\x7e\x8c\x9c\x8c\x74\x28\x39\xf4\x67\xe8\x6f\xac\xda\x48\x75\x6f\x59\x7b\x3f\xb8\xcc\xbf\x24\xd8\x1d\xfe\x60\x59\xf5\xfa\x1a\xb1\x11\xa0\xff\x6c\xfe\x78\x54\x80\xb5\x03\xfd\xf2\xaa\xfc\x1c\xce\x4c\xdc\x88\x4b\x02\xc1\x29\xc4\x45\x60\x70\x45\x53\x84\xac\x74\x62\x84\xf1\x2a\xb7\x93\x55\x1e\x37\x58\x7b\x1c\x03\xa1\x94\x14\x71\xba\x01\xf3\x58\x67\x98\x59\x65\xe1\x9c\x70\x1f\x53\xb7\x6a\x27\x60\xc2\x43\x97\xf6\x75\x0e\x83\x3e\xcd\xbf\x5a\xb5\x1f\xae\x7d\x4d\x82\xfa\x3b\x37\x3d\xc1\x8c\x9f\xae\x3a\xe8\x45\xf7\x72\x27\x1a\x1d\x2a\xbb\x91\xdd\xa0\x2c\x83\x85\x92\x30\x5e\x7d\x8c\xe6\x6c\x88\xc1\x8b\xdb\x5b\x5f\x75\x6d\x38\x42\xdc\xde\xd6\x76\x36\x99\xed\xb1\xc9\xec\x4e\xc7\x15\x4f\x47\xbd\x7f\x81\x54\x67\x9f\x00\xb2\xcb\xdc\x4a\xab\x6b\xc7\x5d\xa0\x28\xa2\xee\x3e\xb2\xa0\x98\x7d\x1b\x30\xb0\x5f\x58\x35\xd4\x5c\x0d\x2b\x08\xa1\x1a\x61\x7e\xa8\xea\xe3\x1b\xd4\xd5\x90\x8a\xee\x8b\xda\xcb\x39\xbf\x06\x42\xe2\xc0\x21\xd9\x4f\x79\x58\xc8\x7f\x86\x90\x76\x55\xdb\x47\x41\xe4\x79\xf3\x9e\x61\xba\x8d\x58\x43\x9f\xd7\x4c\x14\xc5\x8f\x24\x43\xac\x3d\xca\xd9\x44\xa8\xcb\xc6\xc9\xc4\x8b\xb7\x4d\xea\x70\x06\xd8\x9f\xe8\xb0\x75\xd1\xf8\x74\x7a\x55\x72\xd1\xfd\x43\x11\x7e\x22\x0f\xcb\xee\xbf\x5c\xa8\x62\x1f\xb6\x2e\x2c\x4b\x11\x92\x93\xd9\x93\x62\x14\xc5\x19\x7c\xb7\xb7\xbf\x9c\x9d\x7e\x69\x5d\x1d\x5e\x9d\x56\xbd\x09\x9f\x9d\xb5\x8a\xdb\x79\x57\xb1\xe5\x4e\xb8\xef\x20\x44\x24\x99\x23\xa3\xde\x3c\x3b\x6b\xcd\xb5\x4e\x6c\xab\x79\xf7\x0b\x0d\x5c\x36\x10\xd3\x20\xce\x05\xad\xbc\x19\x50\x6e\x68\x55\x2c\x88\x2f\x34\x78\xfd\x6a\xf4\x85\x06\xff\xf9\x97\x3f\x12\xaa\x83\x9f\x88\x88\x5a\xec\x03\xcd\xf4\x49\xec\xbe\xe5\x0c\xc3\xb0\x81\x50\x2a\x96\xbe\x9f\x63\x91\xde\xe9\xb3\x37\x9b\xd3\x7b\x87\xe4\x54\x99\x3b\x75\xa9\x64\xfc\x11\x97\x2b\xf8\x4e\xab\xb9\xf7\xbe\x17\x88\xfd\xc1\x5b\xab\x16\xf1\x60\x5f\x38\x5d\xe2\x63\x61\xa4\x3c\x33\x1c\xe6\xef\xb3\x76\x9b\x3a\x64\x9f\x00\x61\x56\x6d\x04\xa7\x0f\xd4\x2c\xda\x6b\xee\xab\x22\xee\xa3\xd3\x4f\x27\x75\xf4\xf9\x1d\xfa\xfc\xcf\xab\x8b\x7f\x5e\xa1\x77\x97\x9f\xcf\xd1\xe9\xaf\xc7\xa7\x1f\xd1\xc5\x3f\x8f\x3e\x9e\xb5\x3e\xa0\xc3\x16\xfa\x72\x7a\x84\x14\xd4\xd5\x97\xb3\xff\xfd\xf0\xf2\x04\x1a\x30\x8c\x83\xd1\x58\xe1\xbe\xdf\x0f\x23\xdb\xa3\xa2\x2b\x54\x84\xd7\x5b\xab\xd6\x3c\xd5\x3d\x36\xeb\xab\x9d\x65\xa0\xb6\x9c\xb9\xb1\xc3\x07\x5a\xb2\x1a\x0b\x82\xcd\x66\xb8\xbf\x87\x0a\xe0\xae\xe5\x4d\xba\xe6\x5a\x63\x5a\xfc\x14\x0f\xf3\x65\xe0\xc3\xd5\xd5\xc5\xe1\xc5\x59\x25\x28\x96\xe9\xd4\xc6\x9d\x6c\x93\x45\x77\x92\x57\xf9\x5c\x52\xc7\xe3\x05\xa3\x81\xdc\xc6\xe5\xa2\x6e\xce\x21\x50\x07\x6b\x06\xee\x44\x88\x13\x0f\xc4\xfa\x82\x13\x41\x02\xa9\x4b\x97\xfc\x7a\xfe\xd1\xaa\x35\x93\x60\xec\xa6\x8a\xc6\x86\xd2\x5e\xf7\x3e\x48\xfe\xc1\xe8\x0d\xdb\x9f\x7c\x1e\xb2\xe4\xb1\x3c\xf8\x7a\xf3\xe6\xfa\xc5\x1b\x73\xc1\x6f\x7f\xba\x7e\xf1\x86\xed\xb7\x3c\xea\x12\x71\x00\xba\xd9\x1b\x33\xfd\xb8\xd7\xdc\x4f\x1e\x88\x35\x8f\x83\x74\xf6\xdf\x98\xe3\x2f\xeb\x25\xac\xba\xf1\x04\x15\x59\x7a\xe3\xa7\x51\x75\x0b\x70\x3c\x2b\x05\xe4\x4b\xef\x47\x84\x6f\xa5\x78\x25\xc4\xc5\x3b\xf2\x57\xda\x46\x61\x64\x5f\x1f\xbc\xb9\xf7\xbd\x83\x12\xe6\x2e\x6d\xbf\xc8\xd4\x25\x0f\x57\x38\x71\xe9\x64\xe4\xce\x5b\xab\x8b\x39\x29\xb0\x2f\x14\xc1\x27\x4d\x5b\x1d\x37\x7a\x45\xb0\x0f\xfb\x29\x75\x88\x98\x40\x50\x1b\xc3\x6e\x5f\x5c\x92\xdf\x22\x22\xe4\x7b\x7d\x6d\x99\xba\xf7\x8c\x9b\xf9\x40\xb0\x27\xbb\x2d\x48\x23\x9b\x7d\xe8\x57\x23\xeb\xb1\xca\xa2\xb4\xe6\x31\x70\xe5\x49\x14\xd0\x86\x5a\x68\x8d\x25\xb7\xf5\x2f\x8c\xbb\xdb\xb8\xe2\x06\x8c\xbb\xb0\xd8\x06\xfb\x40\x61\x02\xfb\x7b\x30\x9a\xb5\x19\x5a\xb5\x26\x3c\xd0\x22\x0e\x6c\xca\x3f\x7e\xb5\x6a\xe8\xe0\x7a\xf4\x46\x95\x09\x53\x8f\x1d\xa8\xff\xfe\x4f\x80\x01\x44\x93\x4f\x3e\x7e\xd1\x42\x6b\x45\xd6\x2b\x3c\x57\xdd\x52\x05\x46\x2d\x9e\x66\x22\x01\x07\xa4\x5a\x8c\xae\xa9\x0a\x96\x4a\x1a\x85\xa7\xac\xb1\xba\x73\xcb\x6a\xb8\x65\x20\xf5\x26\x63\x79\x94\x2d\x3c\x6e\xa4\xe1\x92\x0c\x4b\x84\xe7\x51\xb0\xc0\xad\x31\x3f\xec\x45\x73\x32\x3f\xec\x85\x9f\x90\x50\x3c\x3b\xac\x64\x83\x77\x42\xc0\xd8\xe0\xbd\x98\x5d\x01\xe1\x0c\xa2\x02\xb2\xb7\x7f\x3e\x0c\x25\x94\x70\x21\x7c\x49\x5e\xe5\xb9\x00\x4c\xd5\x28\xa4\x76\x3a\x8e\xe9\x51\x3b\xfe\x0c\x71\xf0\xea\xaf\x91\xd1\xa5\xae\x4b\x34\x0b\xab\x01\xac\xf0\x13\x1e\x64\x08\x55\x40\x8f\x8e\xd6\x90\x9d\x82\xd1\x8c\x24\x05\xd4\xb6\x63\x05\x16\x6b\x4d\xd6\xb2\x02\xd0\x61\x4e\x03\x17\xb1\x36\x72\x58\x38\xe4\xb4\xd3\x95\x08\x84\x71\x8d\x7e\x3d\x45\x59\xf6\x21\x15\x50\x38\xfa\xab\xb9\x98\xe9\xb6\x2d\x2b\x0e\x57\xb0\xac\x39\x9b\xf3\x7a\x2f\x64\xfa\x2a\xde\x66\xbc\x43\x54\x96\x72\xc8\xd9\x1d\x81\xd2\x47\xc0\x2a\xb0\xa1\x64\x30\x4a\x6e\x24\x4b\xcd\xa7\x81\x34\x2d\xab\x59\xba\xc3\xad\x8b\xfb\x04\x43\xeb\xd9\xf2\x21\xbb\x9c\x7a\x1b\x87\x43\xf1\x35\x1d\x95\x30\x22\x6e\x3b\x2f\xdf\x9e\xde\x87\x38\x20\xde\x46\x84\x00\x87\x54\x6d\xac\x9a\x84\x18\xcc\x4d\x61\x59\x95\xce\x0b\xdd\x45\xa6\x4c\xf4\x4e\x98\x23\x8a\xd5\x90\x50\xd7\x78\x74\xc5\x98\x27\xd6\x9e\xa4\x71\xe3\xf7\x5c\xe6\x08\x23\xb5\x06\x3c\x4e\xad\x51\x8d\x65\x27\xf4\x30\x9b\xb6\x87\x39\x07\x90\xca\xd3\xac\x6c\x9d\x98\xa6\xae\x93\xe6\x2b\x52\xca\x87\xae\x4d\x1d\x86\xaa\xfd\x4c\x29\x61\x36\xe5\x54\xe4\x78\x0c\x3f\xe2\x00\x4a\xc5\xa1\x0b\x75\x28\x47\xd4\x73\x57\xc7\x7f\x03\x1f\xa2\xca\xca\xf1\xb1\x4b\xe2\x70\x91\x98\x08\x55\xdd\x86\xca\xb4\x0b\xd4\x9f\x11\x8a\x47\xc6\x1d\x26\xdd\xf4\x1f\x29\x6b\x6a\x09\xc6\x8d\x65\x33\xd7\xe9\xd2\x9f\xa9\xdc\x34\x1e\x4a\x42\x47\xd5\xb1\x21\xd0\xcd\x44\x5c\x88\xfa\xdc\xa3\xd2\xcc\xe4\xcf\x17\x62\x57\xa2\xd6\xc4\x6d\x6f\x8b\x99\x59\x65\xe2\x6a\xd6\xc0\x62\x57\xbc\xd1\xff\x1d\x10\x7b\x31\x83\x5c\xc2\x03\xfa\x8d\x6f\x5a\x82\x26\xc1\x08\x12\x9a\xf6\x9a\xfb\x8d\x09\xce\x56\x93\xa4\x99\xf6\x96\xbd\xce\x5c\xda\xa6\xc4\xad\xe6\x72\xff\xf8\x9d\xc7\xb2\xf6\x1c\xcb\xaa\x43\xd0\xb5\x1f\x93\x8a\xc8\x71\x4c\x0d\x02\xac\xd5\xa4\xd4\x27\x32\xd4\xff\xd0\xf8\x44\x53\xa7\x84\x7e\xc7\x88\x6d\x08\x69\xd8\xc8\xaa\x67\xe4\x64\x7b\x39\x47\x65\x27\x92\xb8\x51\x49\x49\x88\x7c\x4b\x80\xba\xbe\xa9\xe8\xb7\xf8\x06\xd7\x31\xf6\x9a\xfb\x60\x91\x1c\x85\x5e\xd4\xa1\x81\x18\x49\xe2\x87\x1e\x96\x44\xd4\xcd\x5c\xb4\x98\x25\xde\xde\x41\x8a\x3e\xed\xc0\x74\x5f\xcb\x2d\x8f\x16\x0b\x36\x0d\xe0\xff\xe5\xa7\x67\xe4\x49\x21\xa3\xc1\x5e\x73\xff\x56\x63\xdc\xef\x01\x0a\xc1\xf8\x3f\xa3\xc6\x8b\xba\x09\xab\x87\x05\x80\x1e\xad\xff\x2a\x15\xcf\xe8\x62\x28\xbb\x2c\x58\xbf\x84\xee\x35\xf7\x6f\x46\x96\x25\xea\x9a\x80\x6d\x8a\x97\x29\x2a\x82\x8a\xf0\xe5\x02\xda\x19\x0d\x7c\xd6\xa0\xc1\x62\x29\xbc\x63\x1e\x75\x28\x8b\x44\xa9\x31\x5e\x62\x1c\xab\xe4\x3f\xe8\x02\xa1\xda\xbd\x31\x1d\x5e\x3d\xf9\xd0\x46\xd2\x9a\x2f\x08\xf7\xd6\x2f\x94\x96\x65\x43\xc7\x96\x05\x4a\x22\x6a\x9a\xcd\x7e\xf3\xa9\x09\x25\xe1\x5e\x61\x91\x54\x12\x19\x4f\x74\x43\xcf\xf4\x7c\xa1\xf4\x3c\x96\x57\x10\x0e\x87\xd2\xe9\xe2\xc7\x42\x80\x2b\xdd\x06\x7a\x9b\x97\x81\x33\x6f\xef\x84\x67\x2b\xbc\xc4\x28\x0e\x41\x1f\xd9\xca\x26\x58\x4c\xd0\x15\xf5\xc9\x37\x16\x90\x4d\x6b\xe6\xbe\x22\xc7\x90\x31\x39\x60\xeb\x07\xc8\x9c\x7a\xf3\x91\x7c\x5a\xb8\x56\xf5\xf8\x9f\x08\x14\xb1\xe6\xce\x7c\x56\xa0\xaa\x13\x8d\x7d\x36\x9d\x3f\x5b\x6c\x85\x8e\xdf\x31\x93\x49\x35\xb3\x65\x71\x19\x20\xec\x4a\xa5\xb0\x72\xfb\x42\x81\x7c\x64\x16\xb8\x0c\x15\xa8\x5c\xb5\xb9\xdb\xa0\x26\xb1\xf5\x18\x07\xae\x0f\x4d\x18\x3a\x94\xdc\x25\x19\xbc\x20\x3c\x76\x34\x56\xe4\x91\x4c\x77\x74\x95\x23\xa7\x36\x75\xc1\x9d\xb7\x5f\x2d\xeb\x07\xab\x76\x9d\x96\xee\xf2\xc7\x94\x58\x56\x83\x33\xa7\x27\xcc\xe4\x53\xd8\x0d\x2d\xab\x09\x03\xa4\xee\xdb\x75\x39\x75\xc7\xf4\xc4\xd4\x64\xf0\x70\x22\xb9\xe7\xc9\x81\xea\xcf\x4f\xd2\x59\x34\x50\x4e\xbc\x7c\x3d\x74\x15\x53\x9c\x6e\x7a\x23\xaa\xe5\x65\x64\x0f\x37\x72\xdf\x89\x47\x3d\xfa\x72\x7a\xc4\xa9\xd3\x1b\x01\x21\xf5\xa7\xa0\x54\xf2\xc8\x1e\x1a\x0f\x20\x03\xf3\x77\x24\x18\xed\xab\x1c\x11\xeb\x91\xa1\x0a\x32\x9e\x4e\x3e\x28\x5b\xda\x7a\x64\xf8\xd0\xc2\xb1\xa1\xd0\x62\x45\x8d\x11\x3b\xb0\x73\x4e\x2e\x56\x72\xa6\xcf\xb4\xe1\x47\x75\xb0\x59\x56\xb0\xa2\x8c\x50\xd6\x9e\x35\xe1\xc9\xa5\x2a\x4c\xc0\xe2\xba\x43\x95\xa9\xce\x93\x28\xba\x39\xbb\xd1\x8c\x8b\x7a\x1d\xca\x72\x16\xd2\x6e\xee\xa6\xb3\x2c\xea\x2e\x2a\x07\x79\x17\xad\x8c\xbe\x5b\xc6\xf5\x7b\x12\x8d\x17\x15\xce\xe2\x8c\xe5\xdb\x24\x19\x86\x21\xa6\x9d\xf1\x1b\x0f\xe8\x60\x4c\x02\x21\x55\x39\x77\xe2\xd6\xb3\x12\x94\x18\x73\xbd\x3c\xf7\xf4\x79\xeb\x91\x96\x08\x5f\xf5\xe2\xb1\x0e\x5b\xcd\xd1\xdc\x23\x43\x88\x3e\x15\xb1\xcd\x4d\x91\xbc\x73\x1e\x3c\x4d\xc4\x49\x35\x7b\xd9\x7a\x0c\x0b\x36\xbe\x32\x81\xce\x60\xf6\xa0\x7a\x56\xf2\x02\xed\xd9\x5c\x74\xa7\x3c\xfa\x2c\xc8\xb8\x2a\x49\x56\x01\x0c\xec\xa4\x5b\x50\x87\xbb\xa7\x61\x9d\xaa\x4a\xa6\xe9\x43\xb4\x3c\xd4\x16\x96\x96\xb5\xb8\x74\xe6\x3c\x0e\x4a\x83\x06\x8e\x17\xb9\x44\x80\xbb\x25\x1e\x3f\x00\x66\xa9\x42\x6f\x8a\xa7\x7b\xcd\xfd\xf2\xf5\x80\xdd\x3a\xdf\x70\x1d\xe2\x18\x34\xf1\xa7\xd6\x76\xc0\x26\x42\x49\x6c\xda\x1f\xc1\x6f\x1a\xa6\x2d\xe8\x80\x1e\xf3\x76\x7a\x8e\xde\xe8\x6f\x97\x81\x41\x8f\xa1\x0e\x97\xc6\x3d\x07\x2b\x72\x93\x3b\x5f\x1b\x80\x7a\xf0\xbc\xfe\xbc\x9e\x80\x26\x66\x23\xa1\xc7\x0f\xad\x17\x0b\x3d\xee\xb4\x78\x79\xb7\x5d\x4d\x17\xf4\x87\xa9\xe9\xc2\x24\x73\xfc\x1c\x83\x70\x24\x08\x7a\xe7\xb1\xc1\x46\x42\x82\x93\x38\xcf\x48\x90\xb6\xc7\x06\x2b\x41\xcd\xe5\xab\xd1\x71\xeb\xd9\xb0\x22\xac\xaf\x2a\x71\x5c\x0d\x43\xb2\x85\x50\xed\x93\xd4\xad\x6e\x61\x50\x8d\xc8\x61\x98\xa3\xbf\x7d\xc3\xdc\x45\xad\x88\xca\xed\xe0\x04\x8e\x64\x97\xf1\x31\x69\x8f\x60\x00\xbc\xde\x08\x16\xa7\xfa\x47\x1c\xa3\xe5\xc2\xb9\x0a\xe0\x32\x56\x21\x0f\x31\xa1\x48\x6d\x43\x99\x91\xba\x8b\x4f\x79\x97\xd9\x04\x1d\x33\xcf\x7d\x17\xc1\xa6\xb6\x12\x10\xf8\x32\xca\xd6\x31\x63\x3d\xaa\xba\x3e\x7e\x77\xf5\xf9\xe7\xd3\x4f\x6f\x6b\xc5\x95\x00\x15\x17\x0d\xb8\x16\x48\xb7\x7e\x85\x3b\xaa\xca\x7c\xdb\x5f\xe6\xc4\x37\x9d\x36\xbe\xc3\xf7\x66\xd5\x45\x40\x8f\xdf\x9d\x7f\xdc\x01\x84\xaf\x04\x0c\x83\x41\x2c\x75\x6e\x39\x67\x6e\x04\x69\x4d\x0e\xf3\xdc\xb6\x92\x51\xa3\x8d\x7d\xea\x0d\x1b\x6a\x48\x2b\xc0\xc4\x2c\xdd\x78\x91\xf2\xdc\x50\x4e\x30\xe2\x38\xf3\x98\x8d\x84\xc4\x4e\x97\x6c\xde\x62\x15\x13\x52\xb5\x2f\x3a\xee\xa6\x40\x56\xe8\x70\x1d\xd9\x8e\x90\x5d\xae\x99\x81\xe2\xd0\x1a\xf1\x22\x06\xcc\x79\x01\xbe\x1c\x28\xf7\xc9\x6e\x03\x32\xb8\x0d\x99\x90\x62\xa4\x51\x9e\x9f\x23\x49\xa5\x47\x26\xca\xf0\x1f\x0d\x11\x10\x5c\xdf\x95\xde\xff\x83\x5f\x81\xcf\x87\xad\x7f\x7c\xfc\x0e\x31\x2b\xfd\xa1\xf8\xcd\x2b\x7c\xc7\x50\x4b\x7d\x98\x93\x9c\x3b\x04\x15\xe9\x23\xeb\x54\x81\x4c\x9c\xb3\xdb\x85\x91\x0d\xbb\xdc\xd0\xf6\x58\xc7\x63\x9d\xd2\xaf\x17\x89\xcb\x22\xe9\x20\x9b\x0f\x63\x89\x7a\x8a\x81\x12\x53\x92\x31\x7f\x80\x01\x91\x0e\x0e\xb7\xf0\xf6\x94\x50\xf6\x98\x49\xd6\x4d\x18\x0e\x27\x2a\x1d\xb4\xd1\x5e\x08\x79\xf3\xe9\xf4\x33\x38\xe7\xfc\x80\x3a\x5d\x1c\x04\xc4\x43\x69\x32\xcb\x85\x87\x65\x9b\x71\xbf\x94\xe0\xab\x31\x0e\xb4\xb2\xdb\x10\x69\x04\x84\x6d\xba\x26\xda\x1d\x91\x24\x21\x1f\xa4\xa5\x61\xf3\x85\x77\xee\x4f\xbf\x9c\xbc\x5e\x17\x82\xf4\x4c\x81\x65\x6d\x47\xec\x03\xe4\xc8\xeb\xa5\x2b\x2b\xcf\x7d\xaf\x3c\xfb\x77\xca\x94\xe2\xd6\xb7\x69\xe6\xac\xc3\xf8\x66\x26\xe3\x47\x7d\x80\x32\x5b\x14\x50\x5b\xf5\x79\xe5\xbe\x5e\x02\x28\x18\xa0\x85\xfb\xee\xeb\x2c\xe3\xc7\x27\xdc\x27\x9d\xce\x46\x6c\x61\x12\x2b\x35\x14\xf7\x5d\x3f\xac\xc4\x10\xa6\x76\x31\x35\xbe\x4c\x4b\xd8\x27\xc2\x44\x09\x05\x0b\x95\xce\x08\x86\xc5\x44\x71\x4c\xda\x36\x55\x59\x93\xe6\xb3\xcd\x04\xbc\xa9\xd1\x4d\xd8\x3b\xab\x33\x77\x3c\x1c\x3d\x7c\x9e\x1e\x3d\xda\x52\x10\xdc\xe9\xfb\xc7\x7a\xcd\x17\x85\xef\x24\x4b\xde\x4b\x9e\xbe\x69\x64\xe6\xfe\x52\xd8\xf0\x01\x8b\x5f\x19\xc0\x03\xc2\x44\xd1\xc2\x5e\xf0\xd2\xc4\xf3\x0b\x77\x8a\x7c\xdf\xc1\xd2\x81\x79\xf9\xeb\x66\xed\x19\x58\xbb\xfb\xf8\x16\xe4\xad\xce\xca\xf0\x7c\x99\x0c\x05\x2b\xe3\xe8\x9a\xa9\x6c\xaa\xdb\xdd\xa0\x72\x1d\x00\x01\x59\xf7\xaf\x4f\x44\x7a\xb9\xc8\x2d\x93\x41\xc3\x2f\x97\xa9\xcf\xfc\xab\xf1\xe9\x9d\x11\x23\x68\x1a\x67\x27\x0f\x00\x34\xa7\xca\x37\x2b\x3a\x36\x09\x25\x11\x5f\xd8\xbc\x18\xde\x25\x43\xd7\x91\x6c\xbd\x89\x30\x71\xb3\xda\x99\x0b\xfa\x1e\x91\xac\xba\x02\xde\xaa\x75\xb8\x85\xe1\x28\x83\x05\x22\xdf\xd5\xb7\xbe\x7c\xa0\x95\x96\x86\x1e\x42\xce\xea\x90\x13\x88\x45\x95\x86\x92\xdf\xcc\x76\x56\xb4\x30\x84\xaa\xe6\x1f\xc0\xcb\x06\x8f\x3c\x32\xb1\x44\x01\xcd\x54\x3d\xa2\x2a\x6f\x89\x80\x86\x21\x91\x46\xed\xe5\x9c\x46\xd4\x13\x6d\xee\x1b\x3b\x23\xf5\xf7\x70\x28\x2a\x71\xc9\xbc\xd2\x92\x01\xba\x24\x1e\x75\xd6\x7b\xab\x2d\x77\x33\x0b\xc8\x80\xc3\x18\xb2\x97\xf8\x7d\x7e\xb2\xe7\x82\x42\xd6\x4b\x65\xd3\xcc\x02\x04\xdc\x40\xcf\x6a\x0b\x47\xcd\xbd\xaf\x7f\x36\xfe\x5e\x7d\x5e\xcd\xc2\x45\x79\x49\xb0\x23\xb7\xa8\xdc\xb1\xda\xd2\xb8\x22\x6a\x09\xeb\x92\x7a\x01\xc2\xec\x00\x00\xcd\xc0\xae\xcb\x02\x51\x6f\xae\x10\xea\x95\x34\x94\x11\xe9\xa5\x1e\x59\x6f\x50\x9e\xea\xb2\x78\x48\xde\x80\xd8\x21\xa0\x24\x16\x9a\xd5\x73\x2a\x1c\xe2\x79\x38\x20\x29\x5e\x05\x7a\xba\x2e\x29\xa5\xc8\xe8\xe1\xc7\xd0\x96\xe6\xae\x8e\x5a\x85\x75\xd4\x26\x30\xd8\xbe\x11\x2a\xcd\x20\xde\x53\x33\xb6\xdc\xf7\xa7\x9f\xd0\x7b\xec\x79\xe3\xa8\xd1\x05\x5b\xef\x45\x97\x49\x16\x3f\xba\x7c\x8a\xfd\x64\x20\xd0\x1b\xe0\x14\x8a\xcb\x68\xc5\x34\x58\x35\x34\xb7\xac\x3a\x32\x0f\x50\x56\xdd\xb5\x64\xcf\x80\x91\x76\x48\x60\x74\xf4\x50\x20\xe4\xbd\x2c\x83\x3e\xe0\xba\x43\x45\x90\x82\xf2\x38\x19\x5f\x86\x66\x62\xcc\x0a\xea\x4b\x0a\xa1\x79\x1f\xd5\x74\x69\x93\x64\x72\x35\x00\xeb\x40\x63\xa4\x99\x93\x15\xe7\x73\xf7\xf2\xb4\x50\x8a\x02\x1c\x53\xa0\xf9\xa2\x4b\x88\x54\x1f\x75\x4c\x85\x39\x08\x35\x84\x98\x4a\x36\x18\x25\xb9\x03\x75\x73\x26\x4e\x3b\xd6\x64\x27\x4b\x79\x62\x3f\x34\x06\xa1\x51\x7b\xf9\xa0\x4f\xd5\xb2\x00\x0f\x03\x50\x3e\x65\x17\x2f\x76\x92\x2c\xa6\xaa\x70\xe2\xde\x34\x4c\x66\x3a\x99\x28\x57\xb1\xdc\x99\x5c\xff\x90\x26\xd7\x97\xf9\x93\x39\x1b\x3e\x52\x60\x3a\xe7\x84\x91\xa0\xef\x26\xe8\xef\x41\xd8\x09\x5a\xc6\xb6\x0d\xb9\x85\x21\x2c\xc9\xa5\x93\x4d\xa9\x8f\x3b\xc4\x1b\xa6\xf5\x31\x54\x2b\xc9\x11\x60\x68\x20\xc4\x85\x67\x5f\x87\x06\xf7\x2b\x58\xdb\x2e\x09\x7c\x26\xe8\x82\xb3\xfb\xe1\x12\x99\xfb\x01\xf4\x37\x0b\x0d\x37\x51\x9c\xe6\x1d\x16\xd2\xe9\x50\xe3\x58\x05\x1a\x96\x5b\x94\x66\x15\xa3\x0c\x90\xab\x0e\x9a\xc5\x89\xbc\xd3\x6a\xda\x22\xb5\x61\xf1\x3e\xb7\x65\x66\xa8\x29\x75\x6b\xd1\x78\xcb\x0c\x77\x9c\x6b\x7b\x52\x7d\xac\x58\xf1\xff\x46\x0d\xc2\xb6\x27\x0a\x60\x58\x56\x73\x57\xfb\x7f\xc3\x5a\xb9\x9e\x94\x6c\xc9\x1a\x94\x87\x16\x32\x01\x90\x18\xb0\xc1\xb3\x49\x64\xc4\x80\x0d\x0c\x80\x81\x78\x50\x1c\x4b\xff\x44\xdd\xf9\xdf\x3b\x5b\xb0\x27\x01\x23\xe1\x4e\xd3\x70\x98\x19\xb0\xc1\x22\x4e\x7e\xfe\xf2\x11\x1d\x63\x0e\x49\x43\x5e\x15\x21\x8b\x53\x1a\xf5\x74\xc2\x2e\xa0\xf8\xb2\x81\xaa\x8c\x10\x13\xb0\x74\x68\xd2\xf4\xeb\xab\x39\x16\x10\xda\xa5\x06\xfe\xa1\x53\x03\xe1\x15\x36\xf0\x12\x29\x79\x35\x91\xc2\xfe\x79\xe0\x25\xc2\xff\x6a\xa1\xd7\xec\xf3\xaf\x67\x27\x88\xb4\x2a\xaf\x17\x06\xf5\x13\x6f\x8c\xeb\xe7\x13\xfd\xad\x67\x9b\x20\x41\x83\xdd\x53\xd7\x20\x02\x7b\x44\x83\x0d\x92\xc0\xec\x32\x9f\xa4\xc9\x21\x73\xf9\xe2\x48\x66\x13\x8e\x2a\x48\xf3\xaf\xd8\x6a\xfc\x11\x73\xdc\x27\x5e\xb5\x31\x51\xbb\x40\xa7\xdd\xad\x1b\x55\x1e\xe8\xe4\x69\x51\x5e\x26\x4f\x9a\xe9\x75\x9b\x93\x2a\x0d\xab\x3b\x9c\xb0\xe2\x2d\x58\xdb\x2d\x05\x5d\xae\x6b\xb8\xbc\x4f\x2d\x47\x2b\x6d\x80\xf1\x84\x23\x7b\x88\xa6\x6b\x27\xc6\x34\x2b\x6a\xb4\x35\xcf\xaa\xe5\x5b\x38\x27\x5f\xc9\x54\x0a\x66\x6d\x5e\xe3\x91\x2f\xbf\xb3\xfc\x44\x7a\x43\xaf\xe0\xc6\x92\xc1\x3a\x54\x82\x43\x6a\x21\x3b\x15\x08\xe7\x9d\x22\x94\xdb\xda\xc4\x18\xe3\xa7\x1c\x68\xf2\xdf\xcc\xda\x30\xc1\x08\x7d\xcc\x09\x96\xc4\xd5\x35\x7a\xf4\x73\xe8\x13\x1b\x20\x63\xce\xb3\x47\xa4\x43\x83\xe4\xa1\xd6\xe9\x67\x24\x71\xa7\x56\xce\xd6\x37\x3b\x59\x71\x27\x7b\xfd\x25\x8c\x93\x05\x6f\x2e\x09\x8b\x96\x5d\x5b\x53\xb6\x99\xb9\x4b\xcb\x65\x6c\x03\x25\x34\x26\x73\x01\xc0\xcb\x64\x3a\x42\x98\x7b\xcd\xfd\x01\x01\x29\xd0\x30\x37\xb7\x71\xc1\x81\x51\x3c\xb4\xf1\x0f\x6d\xae\x6c\xca\xae\x59\xcf\x5d\x79\xd0\xf6\x5d\x09\x4d\x67\xaf\x52\x60\xe2\x0a\x51\x1c\xbb\x9a\x07\xe5\xd5\x3c\xc8\x70\x49\x5e\x30\x21\x3b\x9c\x7c\x9f\x69\x85\x60\x6b\x0d\x35\x03\xc0\xd0\xbb\x73\xe3\x3e\xd2\x60\x94\xc1\xb3\x8f\x85\xfd\x8c\x65\x46\x0a\x4c\x1e\x78\xa9\xdf\x0e\x69\xf7\xe3\xd8\x5b\x68\x7a\xa0\x7d\xd4\x6a\x5b\xc1\x56\xa0\xc5\x11\x4b\x65\x0f\x31\x97\x65\x86\xe0\x7e\xf6\x70\x5e\x68\xe1\x47\xda\x27\xe8\xb8\x8b\x65\xb9\xf1\xa4\x37\xb1\x1e\x63\x59\xa6\x65\x99\xba\x7c\x0e\x68\x86\x40\x50\xac\xd1\x58\xe6\x9d\x70\x3c\xaa\x81\xe0\x3c\x06\xfd\xfd\x58\x55\xdc\xa9\xea\x37\x33\xf2\xf6\x73\x40\x0e\x2f\xce\x9f\x6a\x48\x5a\x32\xcc\x80\xe0\x30\xb3\x1c\xc6\xe7\x80\x80\x56\xfb\xf4\xc7\x09\x22\x95\x39\xd0\x90\x04\xe8\xd0\x6d\x49\x4e\xf2\x0a\xa2\x1f\xba\x7d\xc2\x25\x15\xb0\x51\x7f\x22\xb2\x4c\x94\x8b\xf2\x25\xf9\x1e\xdf\x53\x91\x3b\xf0\x63\x88\x65\x50\x55\xe3\xc4\x16\xd5\x55\x18\x03\x27\xb1\x90\x04\x86\x33\x26\x52\x6f\x09\x63\x8d\x3a\xad\xed\x3d\x6f\x44\x8b\x83\x1a\x97\x10\xa3\x59\x0a\x72\x59\xfa\x13\x8b\x78\x80\x3d\xd4\x1a\x0a\x49\xfc\x82\x95\x7a\xd1\x39\x0e\x70\x87\xa8\x3f\x93\x17\xd7\x9d\x6d\x3e\x8f\x7a\xa8\xa0\xb4\xb0\xcc\xe6\x2e\xd8\x7a\x73\x2a\xfc\x72\xe0\xb7\x61\x2f\x6c\x88\x76\xd4\x70\xb0\xc9\x16\x07\xc5\x29\x01\x98\x87\xc7\x5d\xf2\xf6\x3f\x69\x60\xd8\x6b\xee\xb7\x24\xe6\x72\x74\x1a\xb8\x75\xf4\x90\x02\x74\xa5\xb1\xdb\x33\x43\xe1\xca\x3f\x37\x42\x12\x0c\x88\x8d\x13\x2a\x72\x17\x7d\x85\x4e\x85\x32\x76\x50\x12\x97\x18\xcd\xd9\x3b\xd5\x28\x8a\xed\x9a\xbb\xf5\xbe\xcd\xeb\x7d\x3c\xe9\xd9\xba\x6c\x48\x82\x63\xcc\xe5\x36\xa7\x50\xed\x04\x6d\x9b\x05\x2d\xd9\x2c\x1d\xcc\x73\xb5\xec\x63\x5f\xe4\xdb\x4a\x8b\x87\x95\x25\x4d\xe6\x9b\x4c\x13\x93\xb9\x5a\x16\x8e\x2f\x72\x6b\x0a\xc7\xcf\x95\x15\x7e\x00\x21\x05\xdf\xb9\x29\xe8\x0e\xf7\xf1\x32\xd6\xf7\x54\xae\xfc\x6c\xfb\x7b\x48\x82\xf7\xad\xd3\x2a\xea\x0d\x41\xb3\x1b\xd9\xd7\x76\xd2\xb2\xb4\xb4\x38\x60\x6e\xd5\xd5\x62\x34\x46\xa6\x5a\xe9\x1d\x41\x32\xe5\x86\xb3\x1c\x93\xd7\x1c\x3b\xe3\x9a\xaf\x63\x40\x23\x5c\xc1\xe6\x54\xb0\xdd\xc9\x54\xb5\x32\x05\x71\x45\x20\x45\x02\x4c\x82\x54\x67\x70\xd9\x34\x30\xfb\x94\x0c\xcc\x0b\x5d\xee\xea\x85\x12\x33\xce\x7a\xe6\x17\x62\x7f\x60\x7e\xa6\xbc\x7d\xc4\xc3\x71\x38\xf5\x22\x89\xc3\x61\xc9\xe0\x4c\x40\xa1\xa7\x7b\x2e\xd7\xc6\x34\x6e\x38\x6f\x83\xfe\x44\x7c\x2c\xca\x44\xb6\x38\x1a\x4e\x37\x5d\xe5\x0a\x9c\xd3\xc3\x0a\x77\xc9\x00\x5a\xc9\xd3\x8f\x2e\x89\x90\xc3\x2a\x8e\x32\xa0\x80\x43\xe3\x73\x7d\x95\x6b\x07\xb2\x9a\x48\x3f\x40\xf9\x01\x5b\xb3\x69\x08\x68\x41\x2a\xc2\xd2\xaa\x7c\x66\x5a\x02\xca\x49\x4d\xd8\xee\xbd\x6e\x36\x85\x01\xe5\xb9\xde\x22\xbc\xae\xab\xd4\xec\x2a\xb6\x2c\xfb\x63\x84\xe7\x9a\x19\xd1\xd6\x7b\x86\xbd\x08\x2f\xe5\x85\x4b\xd6\x61\xde\x8e\xd9\x6a\x7d\x2c\xba\x0f\xa0\xd3\x7b\x49\x02\x08\xe0\x15\x4b\xde\x9d\x5a\xad\x8f\x93\xd2\xff\x15\x1b\xdf\xae\x9b\xf5\xcd\x96\xd5\x54\x07\xae\xf0\xf2\xf8\x73\x45\xee\xa5\x32\x13\xb6\x98\x17\x49\x35\xf6\x8a\xaa\x53\xa9\x48\xde\x83\xeb\x17\x61\x64\x7b\x54\x74\x75\x10\x16\x90\x80\xe6\xd0\x50\xf1\xc6\x79\x4e\x1d\xce\x04\x6b\x4b\x74\xd8\xba\x68\x7c\x3a\xbd\x5a\x03\x12\xe0\xa1\x08\x3f\x11\x69\xfc\xa2\x13\x94\x55\xf8\x4b\xe3\x45\x3d\xc7\xec\x71\xd8\xba\xb0\x2c\x45\x60\x71\xdf\xfb\x18\x06\x26\xce\x8e\xbe\xbd\xfd\xe5\xec\xf4\x4b\xeb\xea\xf0\xea\xb4\xb6\xe1\x58\xdb\xb3\xb3\xd6\x72\xb1\xb6\x0f\x8e\xe9\x39\xf4\xa1\xc2\x11\xb6\x37\x50\x41\x33\x99\x7b\xa3\xde\x3c\x3b\x6b\x2d\x0c\x3a\x42\xdb\x17\x42\x3b\x3d\xae\xd9\x71\x4e\x66\x9b\xd3\xc0\x65\x03\x31\x5d\x43\xb8\x38\xd7\xe1\x7f\xb0\x3b\x70\x2c\xe1\x78\x9a\xf2\xa2\x65\x0c\x28\x67\x1e\x66\xe6\xe2\x0b\x0d\x5e\xbf\x1a\x7d\xa1\xc1\x7f\xfe\xe5\x61\xcb\xbf\xcf\x6b\x79\x2e\xf3\x17\xd1\xb1\x68\x12\x16\x3d\x3f\x77\x32\x16\x91\xb2\x30\xae\x79\x51\xeb\x73\xd2\x5d\x13\x31\xd4\x89\xa7\x7a\xc2\xe2\xac\xab\x99\xf7\x7f\x5f\x32\x74\x5a\x65\xb5\x52\xb1\x72\xf8\x34\xbc\x8f\xc5\x72\xc6\x4b\x62\x8b\x64\xf3\x56\x6a\xba\x04\xd0\x88\x1c\x2d\xfd\x9f\x67\x7f\xdd\x78\x31\x0c\x81\x43\x23\xa2\x86\xc3\x38\x29\x3b\x10\x26\x3e\x84\x23\xfa\xd7\x34\xd8\x6d\x11\x27\x7e\xdd\x58\xb4\x44\xba\xb6\xe2\x10\xe8\x24\x3f\x0a\x28\xbf\xd7\xd8\x74\xb5\x97\x0b\x9f\x52\xf2\xea\xda\xc3\xe4\xe9\xd2\xa1\xf5\x55\xc3\xd9\x72\xd4\xc5\xde\xd2\xb5\x9a\x5e\xfe\x47\xc6\x89\xbe\xd4\xc5\xb9\x8b\x3d\xa5\x71\x37\xe0\xf0\x40\x96\xb5\xc7\xe0\xab\x38\x20\xdf\xaa\xd7\x56\xb4\x34\xe8\x36\x96\x8b\xd0\x5f\x8e\x96\x62\x39\x41\xbb\xdb\xcc\x7a\x6e\x33\x5d\x9c\xa3\xa9\x4b\xea\xd3\x6f\xc4\x1b\x6e\x04\x96\x9c\xa5\xdd\xeb\x35\x5e\x1d\x58\xe5\xb8\xa7\xcc\x45\xcf\xb1\xe3\x11\x74\x18\x86\x1e\x75\x54\x29\x86\x69\x4d\xa7\x54\x93\x8f\xee\xec\xab\x81\xae\x27\xfa\x83\x8f\xfa\x11\x90\xd0\x63\x16\x48\x4c\x03\xc2\x05\x6a\x33\x8e\x7e\x7a\x75\x7a\xaa\xc0\xf0\xe1\xa9\x3d\xcb\x72\x41\x80\xb1\xf1\xad\x61\x56\x6f\x2b\x2a\x62\x45\x53\x03\x52\x6a\x87\x24\x4e\x37\xd0\x07\x8a\xe9\x53\xd7\xf5\xc8\x00\x73\x62\x52\x2c\x4c\xd6\x27\x5c\x19\x68\x69\xe0\x92\xfb\xec\x9c\x47\xd5\x60\x5a\xe1\xa2\xac\xc2\x16\xbf\x1a\x87\x57\xef\xa7\xee\x4b\xcd\xfd\xc3\xab\xf7\x49\xfd\x8c\x02\xe6\xb7\xe4\x46\xa4\x2e\x43\xb7\xee\x30\x70\xba\x98\x0b\x22\x6b\x9b\xe0\x35\x1e\x4b\x8f\x30\x9d\x48\x48\xe6\x13\x6e\x90\xfb\x90\x70\x4a\x02\x87\x98\x09\x63\x26\xeb\x92\xe9\x6f\x8c\x30\x19\xf3\xd2\x93\x81\x8e\x3d\x16\xb9\x65\x4d\x89\x6e\x3c\x69\x5b\x35\x3d\x39\x41\x37\x70\xf4\x3c\x2b\x32\x19\x0a\xa0\x54\x33\xc7\x70\x1c\xab\x36\xdd\x73\x55\x33\xe2\x00\xc1\x93\x73\x92\x32\x58\xfd\x92\xc3\xd2\x93\x61\x80\x7d\xea\xa0\x73\x16\x50\xc9\xb8\xba\x19\xc1\x1a\x71\x48\x69\xbe\x2f\x05\x96\x10\xb3\xc5\xf5\x85\x41\x9c\x14\x4d\x61\x93\xa6\xa4\x94\x61\x39\x1c\xfa\x70\x75\x75\x51\xf9\x4e\x6c\x40\x2f\x46\xba\xf9\xae\xcd\x0a\x5f\x16\x93\x2e\x75\x6d\x9c\xc0\xd5\x5b\x01\xfa\x1c\xa0\x13\xe2\xe3\xc0\xdd\xc8\xd1\x8e\x65\x47\xf4\x1d\xd1\x78\x11\xff\x51\xc1\x25\x68\x66\x1f\x8c\xc4\x78\x83\x9b\xde\x13\x93\xfd\x8f\x4f\x73\xc8\x60\x81\xe1\x2a\x0e\x15\xdf\xfe\x40\xc0\x8e\xf3\xab\x2d\xaa\x47\xd0\x15\x63\xde\xf2\x72\x08\xa7\x51\xab\xde\x84\x33\xfe\x0b\xb1\xe1\x9f\xe3\xb8\xaa\xe2\x57\x03\x99\xd7\x7b\xdb\x73\xe8\x17\x11\x4d\x38\x19\xdd\xed\x40\x2d\x78\x70\xb1\xd1\xc4\xd5\x76\x66\xde\x9d\x99\x77\x67\xe6\xdd\x99\x79\x77\x66\xde\x8d\x99\x79\x99\xde\x8a\x43\x1d\xb3\x93\xbc\x39\xf7\x44\x89\xa4\xcd\x31\x0d\x36\x50\xf1\x73\xa0\x1b\x05\xbb\x57\x4c\x84\xb6\x5a\x98\x13\x1f\xab\x31\x5e\xc4\xed\x67\x9e\xb4\x91\xf4\x18\xeb\xe9\xcc\x8c\x30\xcc\x57\x93\xcf\x31\xf5\x1e\x81\x71\x60\x59\xe2\x01\xc0\xd8\xf3\x71\x08\x9c\x29\xbb\xc4\x27\xc2\xe4\x44\xb0\x88\x3b\x44\x98\x6c\x80\xdb\x2c\x90\x39\x50\x63\x3b\x3f\xec\xee\x80\xde\x1d\xd0\xbb\x03\x7a\x77\x40\x6f\xd7\x01\xdd\x25\x5e\xd8\x60\xfa\x8c\xc9\x3a\x86\x2e\x0e\x3f\xb5\xce\xae\x4e\xb7\xef\xb2\x97\x10\xb6\xea\x5d\x37\xc4\x41\x9f\xc2\xc6\xde\x70\x09\xc4\x16\xbb\x51\x4f\x12\xf3\x58\x43\xbf\xdf\x8e\x53\x83\x63\x53\x02\x16\xe1\x42\x16\x9d\xbc\xcb\xc5\x25\x7e\x4c\x58\x7b\x72\x4c\x58\x96\xe9\xd2\xfe\x81\x65\x89\xe7\x2a\x8d\x93\x45\x92\xf0\xd4\xa3\x00\xc9\x9a\xea\x27\x97\xf6\xe1\x5f\x65\x38\x0d\x39\x0d\x64\xfa\x88\x55\x3b\x48\x1a\x59\x13\x36\xa2\xcf\xbe\x51\xcf\xc3\x13\x98\x91\xa1\xdb\x6e\xc4\xd5\x12\xe6\xf2\xf2\xc3\x45\x36\x23\x57\x00\x78\x2e\x94\x4c\x56\x30\x91\x6c\x33\x76\x99\x89\x04\xb1\x05\x4c\x33\xde\x45\x4a\x49\x29\x3d\xe2\x7b\xdc\x36\x2a\x60\xb3\x9f\x8b\x9a\xa6\x83\xf9\xba\xa1\xd1\xd6\x34\xee\x72\x14\xff\x00\x39\x8a\x19\x91\xcd\xb3\xf5\x0c\xbe\x17\xdc\xaa\x07\x75\x09\x0a\x55\x15\x18\x2f\x8c\x86\xc3\x1a\x51\x2f\x6b\x91\x7f\x8a\x7a\xa4\xaa\xa8\x5b\xb5\x4a\x27\xd6\x6f\xda\x5f\x09\x47\xf5\x54\x53\xbb\xc5\xbc\x75\x8b\x39\xff\xf8\x09\xa2\x5e\x66\xdd\xc0\x8b\x0f\x17\x27\xc4\x8e\x3a\x47\x38\xc7\xa1\x77\x42\xfa\x45\xdc\x28\xcb\x9a\x72\x5c\xe8\xdc\xc6\xbc\xfc\x80\x13\x3d\xfe\xb4\xfd\xac\xd4\xfe\x63\xe2\x50\xaf\x7a\xc0\xd7\xc7\x2f\xc8\x1b\x45\xe9\xe8\xe2\xc3\x05\x63\x1e\x79\x56\x5b\x59\xbf\x73\xa0\x9d\x06\x0e\x17\xeb\xc6\xb8\x43\x7a\x54\x6e\xe1\xf5\x21\x26\x6c\xf5\xeb\x83\x7a\x3f\xf3\xea\x84\x3b\xa4\x8f\xfd\xb0\x0c\xa5\x4c\x6d\x4e\xae\xde\x71\xc2\xa4\xdd\x4d\xc3\x5d\x25\x84\x64\x33\x21\x90\x5d\x92\xa7\x99\x5e\x60\xdc\x2a\x52\x49\x33\x6e\xcc\x10\x72\x78\x6f\x74\x99\x90\x71\x37\x8b\xca\x4b\xa7\xbd\xef\xd0\x32\xbe\x47\x4d\x74\x97\xf6\x58\xf9\x6c\x2c\x9b\xf6\x78\x8e\x39\xc5\x27\x47\xdf\xdb\xf5\x40\x19\x43\x60\xe8\xae\xbd\x54\x31\x9f\x64\xc7\x03\xd3\xc9\xe2\x2d\x36\x24\x3c\xd7\x02\x35\x91\x22\xf1\x9e\xe3\xb0\xbb\xa5\x08\x92\x21\x0c\x66\xa2\x78\xeb\xfc\x01\x73\x97\xc9\xbc\xac\x7e\xde\x23\xca\x18\x7e\x18\x49\xe6\xab\x00\xa1\x22\xc6\x0f\xd5\xb4\xf1\xf1\x68\xf6\x50\x49\x7f\xba\x04\xb3\xdb\xe2\x5f\x45\xb8\x05\x95\x8b\xf4\xe1\x0c\x04\x65\x1f\xcd\x5c\xa0\x53\x0f\x62\xa8\xd0\x05\xe3\xb2\x48\x7a\xc4\xd2\xe6\xa3\x87\x7d\x94\xa8\xd1\x4d\xa1\xa9\xe5\x75\xb5\xf3\x33\xee\xfc\x8c\x33\x6d\xec\xfc\x8c\x3b\x3f\x63\xee\xf3\x3b\x3f\x63\xa6\x9f\x31\x43\xd7\x9b\x5e\xd3\x05\x95\xef\xd5\x34\xec\x65\xd6\xef\x36\x96\x96\xca\x5c\x97\x39\x3b\x61\xce\x7a\x5c\x69\x3f\x5c\xb8\x06\x9f\x78\xe9\xe0\x62\x6b\xeb\xf7\x25\x32\xf8\x66\xd6\x53\xd6\x7a\x58\x38\xc9\x19\x4b\x23\x63\x72\x97\x5a\x20\x73\x27\x74\x8b\x7d\x29\xf9\x13\x95\x6b\xb5\xc6\x5c\x10\xa5\x0e\x66\xfa\x4e\xe1\xa9\xc6\xba\xd3\x25\x2b\x51\xf7\xf5\x38\x16\x8e\x53\x76\xc5\x06\x2f\x89\x71\x07\x21\x90\xf1\xa0\x62\x66\xb9\xec\x88\x83\x0d\x54\x2a\x5d\xe0\x72\x82\xdb\x84\x73\x22\x25\x35\x55\xef\xc6\x62\x30\xee\x0b\x3c\xbc\xc8\xbb\x05\x5d\xe0\xa1\x42\x8c\xbf\xe0\xcc\x21\x42\x30\xbe\x6a\x38\xc7\x58\x1b\xbf\x15\xc6\xbd\xe3\x51\xa7\x57\xcb\xe5\xde\x30\xc4\x1e\xb3\x21\xa6\x36\x86\xe5\x37\x4b\x67\x9f\xee\x24\xf3\xe2\x48\x20\x17\x27\x58\x5b\x69\xbb\x70\x61\x54\x41\x87\xc8\x50\x13\x33\x0b\xb4\x1d\x66\xd6\x6f\x1b\xbf\x14\x30\xe9\x0f\xa9\x4b\xb0\xce\x7e\xaf\x3c\x38\x61\x57\x40\xab\xbc\x02\x5a\x79\x92\x6c\x7b\xac\xd3\x18\x8b\x48\xa6\xef\xee\x82\x04\x0e\xf5\x8e\xbc\x88\x2c\x8d\x11\xb1\xb4\x4d\x64\xdc\xd5\x66\xc0\x00\x77\x85\xb2\x96\xaf\xac\xae\x36\x0d\x98\x37\xdb\x8b\xb2\x3d\xe1\x24\x70\x73\xea\x13\xfe\x53\x10\x8e\x3e\x07\x36\x14\xed\xa7\x41\xa7\xdc\x13\xd6\x71\x61\x5b\x0b\x81\x0a\xcb\x6a\x50\x56\x09\x02\x83\x6a\xbe\x41\xd9\x62\x26\x70\x87\x05\x38\x27\x14\x60\xda\xba\xbf\x8d\x4a\x95\x1e\x46\xf6\x59\xc8\x9d\x48\x94\x10\x7b\x97\x1d\x98\xa3\x8a\xe1\x40\x40\x27\xe1\x8e\xc1\x49\x87\xb2\x60\xa5\x5c\xf8\x59\x37\xf8\x5e\x73\x7f\x3c\x82\xd1\x65\x77\x28\xbb\xfe\xf0\xbe\xbe\xba\x5b\x3c\x6d\x2c\x87\x69\x5e\xb5\x61\x9e\x96\x65\x43\x27\x96\x65\x03\xec\x46\xd3\x6c\x66\xa0\x39\xaf\xd9\xc1\x41\x78\x26\x82\xcb\x45\x17\xdb\x1c\x52\x9d\x59\x4e\x24\xcd\x99\x10\x11\x49\x4a\xb5\x8c\x77\xf3\x95\x23\x6c\xa6\xe4\x6d\xaf\xb9\xaf\x44\x6e\x44\xdd\x3a\x88\xdd\x98\x28\x23\xb7\x80\xe9\xc4\xc3\xe6\x57\x6c\xb4\xff\x6c\xfc\xfd\xfa\xdf\xff\xf5\xbb\xc9\x05\x77\xa0\xb2\x69\xd8\x8d\x28\xfc\xf0\xcd\xb8\x7e\xa1\x36\xa7\x67\xb5\x9d\xc3\xfd\x8f\x14\x2d\x86\x1d\xea\x51\x99\x09\xcc\x73\xd1\xc5\x82\xf0\xc2\x97\xd1\xd2\x41\xdd\x4a\x3e\x0e\xd5\x68\xb2\xce\xc3\x2e\x09\x98\x4f\x9d\xb5\x5d\x99\x26\xc0\x53\xc2\xb8\xef\xbd\xe6\x3e\x67\x4c\xd6\x9b\x19\x07\xc7\x78\x09\xeb\x77\x2c\xab\x61\x73\x36\x10\x84\x5b\x56\x23\x59\xcb\x2f\x56\xd1\x28\x16\xae\xd9\x4b\x82\x1d\x59\x6c\xd5\x66\x08\x04\x2a\xa3\x16\xa9\xe2\x99\x8b\x25\x36\xb8\x22\x2a\x6f\xf5\x4d\xbc\xab\x5e\xd8\x6b\xee\x1b\x50\xe0\xdb\xc0\xae\xcb\x02\x51\x6f\x7e\x6d\x18\xd7\xf1\x5a\x7f\x6e\x59\x6e\x3d\x41\xc3\xbb\x9b\x89\x31\x19\xdf\xc5\xcc\xa4\xa1\x69\x03\xc9\xcb\x07\x7d\x4d\x05\x3a\xa2\xaa\x43\x17\x54\x97\x4b\x68\xcb\x7a\x51\x68\x19\xca\x0c\x5d\xe8\xea\xd4\x82\x0b\x2c\x04\x09\x3a\xd5\xa0\xc1\x3c\xe8\x64\x0c\x14\xb7\x38\xfd\xe3\xe1\x4b\xdb\x90\x0b\xa2\xf9\xaa\x28\x0b\x13\xc2\x32\xb7\x59\xea\x03\x60\xe5\x4a\xe5\xd2\xcb\x82\x3e\xbc\x09\x35\x11\xcf\x76\x41\x78\xdf\xaf\x4e\xa0\x45\x20\x53\xef\xa5\x81\x24\x9c\x08\xb9\x01\x20\x00\xd3\xd4\xb5\xf4\xe1\xe2\x9c\x90\x91\x9a\x57\xcd\x90\x06\x54\x56\x11\x56\x9e\x74\x95\xb9\x82\x21\x67\x30\x87\x27\xef\x08\x71\xd1\x65\xcc\x83\xb5\xc7\x92\x6b\x0a\x33\x01\xb6\x96\x61\x8a\x6a\x4d\xff\x37\x53\x5c\x62\xa0\xbb\xcf\xad\x25\x36\xb7\xa2\x61\xc6\xf1\xf2\xb4\xe3\xe5\x99\x76\xf5\x6c\xf3\x21\xd7\x31\x2d\x06\x13\x39\x52\xa3\x1e\x6b\x88\x6e\x39\x81\xd7\x49\xb7\x8e\x17\x09\x49\x78\x1c\xe3\xf6\x72\xce\x13\x61\xe2\x17\xc9\x78\x86\xab\xd4\xd5\x8c\x07\x44\xb2\x93\x6e\x3a\x92\x2e\x9c\x60\xe4\x62\x5e\x17\xa8\x20\xb3\xe1\x1b\xcc\xc2\xe3\xb1\xe5\x60\xef\x7b\x2f\x53\x05\x0b\x4b\x00\x1f\x0c\x0f\x07\x9d\xa5\x94\xdc\x78\x4d\x0e\xdb\xc9\xf4\x66\xaf\x4a\x12\xc8\xa1\xaf\x22\x53\xb7\xa9\xf4\xf7\xd4\x7e\x1e\x4e\x11\xb9\xfa\x36\x3e\xd1\x4a\x83\x44\x19\x2c\x11\x39\x15\xe1\x3e\x30\xa1\xe2\x1f\x2e\x70\x40\xbc\x15\xd4\x4f\xd5\xc5\x5e\x73\xff\xe3\xe8\x4b\x9d\x06\xf3\x35\x7e\x43\xd3\x91\x3e\xbe\x1a\x1a\x37\xcc\x19\xd3\xa0\x41\x96\xd5\x0c\xc7\x0d\x95\x7c\x04\x10\xd1\xcb\xf6\x65\x79\xb4\xd3\xa9\xca\x1c\x2d\x42\x1c\x8c\x8d\x0b\xf7\x7d\x26\x89\x30\xfe\x5c\x46\x96\xa8\xa2\x7a\x75\x81\xa3\x9d\x4e\xf6\xe2\x63\x01\xd9\xc2\x3c\x34\x45\xd6\xce\x91\xbc\xb5\x8e\x64\x25\x5b\x2c\xc8\xbe\xbb\x78\x4c\xe6\x85\x10\x55\x1e\x5a\x93\x06\x37\xc4\x1e\x40\x8f\xc1\xa5\xc5\x1b\x9a\xa1\xa6\xae\x24\x85\xe1\xe4\xf5\xd2\xc6\xba\xe9\xf1\x56\xa6\x2b\x4c\x1a\xd4\xdc\xd7\xda\x86\x86\xfa\x96\xe5\xbe\xa8\x37\x1f\x58\xd4\xd6\x22\x6a\xee\xeb\x65\x6d\x66\x1e\x93\x30\x65\x50\x38\x53\x8f\x6c\xf1\x26\xcf\x1a\xeb\xbd\x2b\x97\x7a\xf7\x55\x07\x19\x8c\x20\x63\x7c\xde\xb0\x4d\x3d\xaf\xf0\xaa\xfa\x48\x6d\x8e\xd5\xef\xa5\x2e\xab\x9b\xd9\x75\x15\xd3\xa5\x9c\xeb\xe6\x58\x9d\x30\x27\x7e\xf0\xab\x81\x0e\x4c\x7a\xc8\x72\x34\x30\x6f\xe8\xaf\xc3\xb1\x12\x37\xb5\xd7\xdc\x7f\x13\xea\x3e\x75\xd1\xb6\x91\x02\x13\x54\x7f\x72\xe2\xbd\xb5\x60\x80\x8c\xcb\x38\x5e\xcb\x8c\x9f\xb5\x2c\x85\xcd\x6c\xd5\xea\xb9\x21\x6f\xe9\x0b\xa5\x9b\x5f\x62\xb2\x13\x8c\xca\xac\xfd\x9d\xc1\xa5\x17\x90\x9b\x4a\x8d\x0d\x5a\xa8\x63\xb9\xb4\x8f\x52\x8f\x7f\xda\xf7\x7a\xdc\xd3\x49\x77\x99\xba\x14\x13\xb2\xc3\xc9\x18\xe6\xe5\xc9\x45\x78\xa4\x5b\x90\x1a\x08\xe0\xb6\x64\x26\x1c\xc2\x45\xa1\x83\xc3\x6a\xee\x6c\xc9\xac\x63\xb5\x44\x24\x95\x1e\xe4\x66\x5d\x7c\xfe\x72\x7a\xf9\xfe\x70\x6c\xbc\x8e\x23\x46\x91\x1c\x86\xf0\x7b\x97\xba\x2e\x09\xac\x1a\x8a\x73\xb9\x44\x97\x85\xd4\x5d\x57\x81\x84\x30\xe6\x48\xc3\x5d\x58\x7f\xf9\x82\x13\x9b\xba\x9b\xae\x57\x65\x86\x8a\x8c\x69\x37\x5a\x0d\xbb\xc1\x7d\x12\x40\xab\x91\x4e\xf7\x9a\xfb\xfa\xc9\x91\x19\xda\xea\xe9\x7a\xc9\xdb\x8d\x6a\x3d\x73\x97\xe1\xa4\x4d\xef\x8d\x77\x9c\x90\xf5\xd7\x48\x4f\xb6\x5b\x45\x43\x9b\x13\x52\xc5\x01\xe6\x11\xdc\x87\xcd\x65\x12\x0f\x2e\xed\xd0\xcc\xe0\x8b\x90\xb8\xd5\x65\x61\x59\xd5\x49\x66\x2d\x04\xaa\x03\x58\x40\xcb\xc0\x9b\x59\x96\x78\xa1\x56\xec\xc1\x03\x02\xe3\x2a\xa6\xc8\x3c\xf2\x98\xd3\x43\x10\xeb\x82\xae\x5f\x20\x9f\xb9\x91\x47\xd0\x5e\x73\xff\xc3\xe9\xe1\xc9\xe9\xe5\xe8\xea\xf3\x05\xe8\xa3\xa2\x69\x18\x07\xb3\xaf\x9e\xeb\x87\xa7\x5b\x80\xe7\x4a\xb8\x73\x8e\xe9\xdd\xf9\xd3\x76\xf0\x6a\x7f\x48\x78\xb5\xe4\x80\x4f\x97\x76\xa6\x3e\xc3\xa9\xf0\x37\xb8\xe7\x52\xe1\x57\xa1\xe0\x42\xbb\x77\xd9\x8a\x9c\xd6\x7d\xd1\x17\x16\xb8\x84\xb7\x23\xaf\xf2\xe3\x7a\x42\xc5\x9d\x08\x51\x1a\xdc\x62\xd7\x66\xf7\xb7\xb5\xc2\x97\xb1\xbd\xe6\xfe\x60\x30\xb0\xac\x46\xbd\x19\x2b\xf0\x83\x64\x0c\xf1\xb9\x0e\x27\xfa\x00\x2b\xbe\x8e\x3a\x44\x5d\xdd\xba\x61\xe9\x87\xfa\x74\xd7\x05\x78\x7d\xc1\x68\xce\x2d\x82\x0f\x43\xc9\x7c\x1a\x10\x5e\xf2\x4d\x76\xaf\xb9\xaf\xf8\x57\xdf\x6f\x9a\x66\x18\x32\xaa\x73\x5e\x4c\x8f\xda\x66\xd9\x57\xd5\x90\xd1\x6c\x95\x1a\xb8\x01\x09\x7e\xeb\xf5\x83\xa4\x4b\x2e\xee\xbd\x2a\x84\xff\xb4\x03\x55\x05\x51\xf2\x0c\x36\x48\x06\x1a\xfd\xc6\xeb\xdb\x2a\x05\x38\x26\x66\xa4\xfe\xc2\x4e\xe4\xb1\x48\xd4\x21\xac\x2d\x89\x62\x83\x7f\xae\xeb\xf5\x66\x25\x38\x7b\x31\xe3\x34\x0d\x63\xcb\xd9\x42\xbe\xf5\xe9\xc6\xd3\x19\x63\x32\x2a\x61\x87\x6f\x33\x21\x99\xd3\x9b\xd2\x96\xe3\x61\x2f\x66\xcb\x3d\xf5\xa9\x40\x9f\xfd\x80\x3a\x5d\x1c\x04\xc4\x2b\xba\xc0\x5e\x3e\xde\x37\x04\x5f\x8c\x83\x28\x83\x8e\x81\xc3\xf0\xad\x55\xbb\xb4\xc5\x71\x17\x07\x1d\x72\x18\x86\xa5\x64\x2d\xcc\x1d\xe6\x4e\x95\x7d\x72\xaa\xec\x61\xd0\x89\x3c\xcc\x7f\x6a\x6d\x47\xfc\xef\x5e\x73\xdf\xa5\xfd\x11\xfc\x56\xd7\x30\x39\x5a\x82\xa7\xe7\xe8\x8d\xfe\x76\x99\xe8\x60\xac\xc7\xb9\x74\x38\x30\xec\xbc\x4d\xee\x7c\x6d\xc0\xae\xfb\xbc\xfe\xbc\x6e\xc6\x2d\x65\x07\x08\xc7\x0f\xad\x37\x44\x38\xee\x74\x49\x87\x47\xbc\xe5\xab\xd5\x9c\xa3\x3c\xa9\x67\x54\x39\x1c\xc9\x50\x4b\xe6\x86\xb5\x26\xde\x8f\x97\x15\xeb\x12\xba\x8a\x90\x91\x34\x5e\x71\x72\x7b\x61\x86\x45\xf6\x39\x64\x13\x6c\x5d\xbd\xf8\x30\xb2\x01\x8d\xcd\xa9\xa2\x08\xbc\x62\x4e\xdc\x7e\x76\x84\x44\x64\x7b\xd4\x29\xa1\x8a\xe1\xd4\x9e\xad\x5b\x85\x77\xf2\x4a\xdc\x56\x1c\xd4\x05\x1b\xe5\x77\x1e\xd3\x05\x1e\xd4\xa5\x8d\x05\x6a\xfe\x1c\x3f\x67\x61\x71\x82\x8e\x5b\xad\x0a\x43\xfe\x92\x83\x26\xf5\xa4\xed\x35\xf7\xe3\x23\xa1\xfe\xc2\xac\x37\xc3\x88\xc3\x36\x63\x24\x9b\x8c\x23\x26\x76\xb9\xf4\x66\x9d\x38\x90\xb4\x7d\x1b\xde\x31\x22\x63\xaf\xb9\x2f\x7c\x63\xe4\xbb\xc6\xc8\xeb\x18\xa3\x7b\xcf\x80\x16\x5c\xc3\xb2\xdc\x35\x39\x0e\x22\x4e\x1c\x21\xb2\x5c\x98\xc3\x0e\x00\x6c\xac\xa1\xa0\x4a\xca\xdf\x30\xee\x52\xf3\xf2\xab\x55\xfb\xe1\x7a\x4d\xdc\x88\x3b\xce\xbc\xea\x0c\x39\x2b\x69\xa3\x6a\x49\x4e\xb0\x2f\x8c\x13\x2a\x24\xa7\x76\x24\x63\xbc\xc1\xa4\x8b\x8d\x6c\x56\x1f\x31\xc7\xfd\xf4\x9a\x82\xaa\x01\x5a\xdc\x46\xcc\xaf\xe9\xeb\x07\xca\x07\xfa\xca\xd9\xac\xd1\xaa\x60\x5f\x59\x57\x92\x25\xaf\x25\xe8\xc9\x43\x84\xcd\x5c\x5f\xd0\x32\xf8\x78\x9e\x16\xe5\x65\xce\x9d\x70\xc8\x59\xde\x89\x33\x19\xea\x57\x51\xbe\x7c\x91\xb0\xbe\x0d\xe5\xcb\x4f\x85\xeb\xcd\xe3\xcf\x3f\x22\x1c\x48\x07\x0b\xb9\x5e\x0c\xaf\xb8\x59\xcb\x6a\xfc\x06\x04\xa8\x94\x85\xd8\xf4\xac\xbe\xa8\xc8\x40\xf6\x5b\x32\xda\x2c\x91\xf9\x47\x44\x04\x6c\xee\xaf\x0e\x03\x31\xc8\x0b\x07\x3a\x56\x35\xd4\xe5\x34\xc0\xdc\xd2\x87\x29\x38\x2d\x27\xfc\xa3\xb3\x14\xe4\x73\xd1\xfc\x0d\x1b\x8e\x2e\xbf\x06\x7f\x42\x71\x81\x24\x94\x7a\xef\xeb\x9f\x8d\xbf\x83\x28\xee\x80\x02\xfe\x40\x49\x81\x5a\x9a\x63\x31\xc1\x4a\x4c\xb2\x97\x39\x75\x7a\x8d\x0a\x0a\xcf\x27\x12\x3c\x0d\x2d\xc6\x42\x12\x08\xe6\x29\x1d\x29\xf6\x93\x58\xb5\x83\xe3\xf3\x16\xb2\x87\x25\x98\x30\xd5\x68\x2c\x0b\xc6\x03\x08\x25\xfd\x32\x72\xe7\x26\x49\x2e\xc0\x49\xcc\x65\xc5\x01\x4d\x05\x18\xba\xd7\xdc\x87\x40\x84\x10\x16\x98\x83\xb9\x44\xf6\x70\xd4\xea\x79\x24\x44\x2a\x3b\x32\x20\x92\x0d\x86\xf5\x32\x19\x8e\xb9\xdc\x04\xc7\xf3\x22\x59\x2f\xa9\xd3\x45\x57\xe4\x5e\xa2\x53\x97\x4a\xc6\xb7\x32\x66\xee\x37\x18\x47\xb6\x4b\xf9\xf2\xa8\x85\xb4\xb5\x7f\xa5\xac\xf3\x47\x3a\x1e\xee\x7d\x2f\x10\xfb\x8e\xea\xff\x6d\x09\x52\x33\x31\x9a\xdd\x29\xf3\x64\x4f\x19\x6e\x0b\x2d\x12\x8d\xf6\x42\x4f\xec\x65\x05\x27\x4b\x09\xd9\xd5\x7b\xcd\x7d\xa0\x6c\x74\x49\xb0\xe7\x0d\x8f\xcf\x5b\xab\x63\x73\x29\x4e\xc0\x5d\xa3\x4d\x17\x32\xe1\x04\x29\x10\x1a\x16\x94\x5d\x5d\x63\xe9\x82\x6e\xaf\x5f\xff\xd5\x8b\xa2\x30\xea\x88\xe1\x2b\x50\xab\x3d\x16\xb9\x6d\xae\x0b\xce\x07\x44\x42\x62\xbe\xc7\xa0\x0f\x43\xbf\x2c\xcc\xc6\x73\x43\x7f\x53\x81\xe6\xcd\x5d\xa1\xf9\xa2\xfc\xf9\xf6\x62\x29\x3a\x61\x39\x26\xf7\x13\xe6\x44\xa0\x6e\xab\xd6\x1e\x05\xd4\x95\x9a\xaf\xa6\x4a\xf6\x73\x97\x39\x50\x1f\xcb\x23\xb3\x96\xc1\x18\x52\x28\x89\x5f\x9c\x7c\x35\xb5\xd2\xc3\xdb\x96\xd5\xe0\x91\x3d\x6c\x33\xae\x34\x70\xc6\x3b\x3a\xcc\x11\xc6\xf6\xc6\xc4\x07\x68\xd2\x87\x55\x35\x60\xea\x65\x64\x0f\x37\x92\xe5\x76\xce\x82\x0e\x27\xde\xe8\xcb\xe9\x11\xa7\x4e\x6f\x04\x84\xd4\x9f\xc2\xfe\x08\x73\xb7\x7c\x22\xf3\x04\x68\x32\xcc\xb3\xfa\xcf\x42\x21\xc7\x4e\x4f\x97\xa9\xca\xde\x2f\xe1\x91\x22\x12\xae\x0e\x26\x68\xf4\x61\xf1\xab\x75\x1b\x23\x77\xe2\xb6\x76\x71\xe3\x70\x80\x0c\x7b\xcc\x04\x24\x43\xc3\x51\x12\xb0\x50\xf0\x68\xf0\x91\xe5\xc5\x81\x83\x8d\xf8\x1c\x53\x6f\x09\xf3\xd7\x4d\xda\x72\xb1\x2d\x37\xde\x36\x39\xa6\x81\xc7\x58\x68\xf6\xcd\xd4\x44\x61\xaa\x83\xc2\x31\x71\x18\x7a\xc4\x90\x2c\x72\xba\x06\x75\xd4\x95\x27\x0c\x3a\xe6\x41\x6e\x88\xe3\xdc\x46\x6b\x99\x2a\x03\xf7\x0e\xc3\x70\xa2\x7e\xd0\x4d\xfa\xe2\xb3\x9d\xda\xfa\x04\xd5\xd6\xc4\xd1\x9f\x88\x82\x52\x78\x16\x2f\x8a\x5e\x24\x49\x80\x4e\x8e\x8e\x73\x63\x22\x36\x07\x2b\x31\x97\xca\xc9\x87\xf6\x67\xf8\x45\x1c\xb0\xa8\x52\x07\x54\x10\xae\x5f\xd6\xd6\x55\x85\x49\xb8\xb2\x12\x9c\x36\x9b\x34\x9a\xa7\xd0\x25\x74\xd3\x0e\x95\xd8\x43\xc7\xd3\xe1\x72\x1b\xe7\xf3\x2a\x31\x24\xae\x1e\x4b\x42\x63\x61\x4e\xf8\x2e\xde\x74\x36\x2e\x07\x22\x2a\x89\xb6\x54\x2d\xe7\x98\x36\x70\xd8\xc5\xc4\xdb\x70\xcc\x29\xd7\x54\x4c\x44\xe5\x82\x09\xab\xe2\xb0\x23\xd7\xa7\x92\x0f\x6d\xcc\x71\xc0\xfa\xa2\x47\x87\x13\x41\xa9\x31\x41\x19\x1b\x94\x08\x6d\x9a\x57\x2f\x62\x41\xa1\x9b\x42\xc7\xf7\x4c\x0f\x73\x4e\x8c\xf4\x89\x4d\xae\x3b\xbd\xa5\x6b\x42\x32\x83\xe3\x2f\xf1\xd0\xe9\xe2\x1c\xeb\xec\x47\xda\x27\x60\x9b\x92\xe5\x8a\x17\x0e\x43\xb5\xe3\x2a\x0a\x74\x92\x7b\x72\xc1\x2e\x5d\xaa\xe2\x5e\x32\x82\x41\x2e\xf1\x90\x6d\xa1\x55\x46\x91\xb5\xbc\x66\xb5\x8b\x77\xfd\xa3\xc7\xbb\x66\xe5\xed\xed\x4a\x8b\xee\x4a\x8b\xee\x4a\x8b\xee\x4a\x8b\xe6\x4c\x06\xda\x95\x16\x2d\x70\x45\x4e\xd5\xa9\x21\x6b\xd0\xc5\x77\x16\xb7\x5d\x7a\xdc\xd4\x06\x95\xc7\xc1\x6b\xa5\x36\x5e\x9e\xbc\x5b\xac\x3a\x92\x35\x7a\x20\x38\x71\x99\x83\x72\xab\x09\xa8\xc7\x2c\xab\xb1\xd7\xdc\xd7\xc8\x48\xf5\xe6\xae\x7e\xc0\xae\x7e\x00\x2a\xee\x91\x20\x36\xf5\xbc\xa1\xa9\x85\x7b\xa1\xe4\x8f\x05\xa1\x7a\xe0\xab\xf9\x93\x9d\x71\x6b\x5f\x75\x82\x8b\x4f\xee\x9c\x89\x2d\xef\xa6\x36\x3d\x81\xf3\xf9\xef\xa2\x0f\x79\x97\xd6\x47\xdd\xf2\xa7\x3a\x98\x77\xc9\x8f\x1f\xd8\x60\x80\x0c\x27\x2e\x5c\x68\xb3\x8c\x48\xc4\x75\x69\x0e\x97\xce\x89\x10\xb8\x43\xd0\x11\x54\x88\x7b\x04\x32\x9b\xf6\xf1\x4e\x44\x65\xea\xce\x47\x53\xc5\x31\xb5\x73\x97\xab\x5f\xde\xd4\x77\x05\x30\xb7\xd6\x81\xb0\x34\x6e\xa9\x03\x85\x1e\xf5\xc4\xe6\x48\x24\xa0\x4d\x2c\x55\x6d\x6c\x25\x91\xbc\x58\x58\xc7\x35\x21\x61\x25\xd3\x8c\x4b\xf4\x93\xf1\x0d\x77\xaa\xad\xa5\x9d\xbf\x88\x05\xe8\x12\x53\x4f\x54\x7e\x3b\x1f\xcb\xa6\xcf\xdc\x5b\xa8\xce\x04\xdd\x8e\x80\xc3\x39\x37\xf4\x39\xcf\x3f\x4e\x7e\x27\x55\x09\x5d\x09\x43\xb9\x2f\xa1\x86\x1b\x65\x81\x01\xe8\x53\x60\x91\xf9\xf7\xeb\x57\xbf\x9b\xcb\xa8\x0d\x08\xd5\x1c\xc1\xdb\x46\x88\x39\xf6\xd5\x5d\x18\x47\xb2\x4b\x02\x49\x1d\x2a\x87\xb7\x92\xf5\x48\xf0\xac\xf6\xf8\x1b\xfe\x8c\xd7\x1e\x6d\x2e\x95\x26\xdf\x93\x8f\x9e\x7c\x86\xcc\x5c\x8f\xff\x52\x97\x3d\x68\x81\x05\x4a\x7e\x97\xd9\xcf\xd4\x85\x4f\xaf\xee\x6c\x45\x84\x06\x7d\xda\x61\x1c\x4b\xf2\x54\xcb\xba\xa7\xa3\x1d\x0f\x25\xab\x8a\xfd\x25\xf9\x2d\xa2\x9c\xfc\xd4\xda\x38\x8c\x0c\xd7\x94\x54\xe3\xa2\xd3\x6d\xe7\xa9\xa1\x82\x06\x55\x94\x11\xbb\x51\x2d\x2b\xc5\xc1\xb2\x5a\xcf\xab\x4e\x8b\x5a\x78\x4a\xed\x92\xbb\x97\x4e\xee\x76\x70\xe4\x74\x59\xb6\x0e\xd4\x27\xd8\x1b\xdf\xa6\x36\x8a\xc3\x74\x33\x32\xeb\x5c\x11\xf4\x48\xaf\xee\x42\x19\xfa\x40\x3b\x5d\x8f\x76\xba\xb2\x70\x1d\xf0\x39\xf9\xd6\x68\x1d\x40\xee\x33\xa9\xef\xdd\x84\xf0\x0d\x00\xba\xc3\xc9\x95\xf6\xaf\x37\x21\xb3\xa8\x04\x7a\xd8\x6e\x74\x71\x8f\xfa\x0d\x41\x4c\x3d\xb7\xc6\x9d\xc8\x92\x46\xef\xe9\xd6\x14\x7a\xcf\xbe\xe7\xfd\x49\xd9\xae\xd8\xd2\x11\x91\x20\x14\xde\x38\xaa\x22\x43\x34\x84\x47\xdd\xbc\x9c\xce\x32\xe1\x11\x9e\xeb\xab\xda\x57\xcb\xfa\xc1\xaa\x5d\x7f\xbd\xf9\x01\xa0\xdd\x63\x2a\xbe\x9a\x96\x35\xd0\x75\x9c\x1d\x01\xa9\x9a\x7d\xc2\xdf\xa6\xd1\x87\xf1\x2b\x05\x0c\xc4\x71\x73\x66\xd2\x9e\x59\xde\x5e\xf7\x85\x71\x17\x10\x6f\x0b\x6e\x74\x93\x79\x49\x68\x06\xc8\xbd\xe0\xcd\x0e\x18\x07\x3d\x6b\x00\xfc\xd4\x61\x1c\x02\xde\xe2\x20\x4d\x7d\x5b\xc2\xbb\x0a\x0d\x22\x68\x4d\x41\x53\xa8\xe0\x7e\xd1\x25\x44\xaa\x8f\x48\x23\xeb\x0f\x42\x00\xf7\x88\x13\x68\x47\x34\x70\xbc\xc8\x25\xa2\x6e\xce\x38\xf3\x63\x74\x90\xe7\x1a\x1d\x44\x35\x80\xfd\xd0\x18\x84\x46\xed\xe5\x83\x3e\x55\xcb\x02\x2a\x68\x00\xe5\xe1\x24\x64\x4f\xc1\xed\x7b\x21\x55\x85\x2f\x90\xd3\x81\x17\xe9\x64\xa2\xdc\xf0\xcf\x1d\x16\xc3\x1f\x12\x8b\xe1\x65\xfe\x64\xce\x22\x24\x17\x98\xce\x39\x48\xc9\xcb\x4c\xe1\xd3\x66\xf3\x03\x64\xe5\xa5\x2e\xf3\x03\xc6\xdd\x10\x96\xe4\xb2\xc7\x5d\x92\x37\x2b\xbb\xc4\x27\x61\x14\x38\xdd\x4c\xcc\xae\x4b\xea\xf4\x44\x17\x0f\x36\x1d\x7e\x19\x93\x51\x95\x6a\xbe\xab\xaf\x54\x51\x7d\xa5\xc4\x20\x2e\xba\x91\x94\x84\x6b\x68\x55\x95\x80\x12\xcf\x68\x86\xe4\x75\xba\x72\x1b\x6c\x2b\x40\x47\x15\x76\x95\xf1\x85\x66\x31\x0b\x98\xdc\x82\xf1\x33\x7d\xef\x7b\xe1\x30\x3f\xa4\x1e\xe1\x95\xc7\x3d\x43\x97\x8d\x89\xbb\xde\x7c\xde\x48\xb2\x9d\xc9\xc3\x31\x65\x00\x77\x00\x71\x7f\x59\x40\x07\x0b\xf7\xa3\x5d\x36\xd0\x86\x41\x72\x5b\xff\xf8\x48\x25\xf9\x0e\x0b\x3e\xa8\xb2\xbc\xbf\x79\x54\x92\x65\xf6\x78\x4e\x25\xc9\x01\xd4\xba\x64\xd8\xa5\xdf\x8a\xa1\x7f\x2e\x53\xcf\x6b\x5e\x48\xc2\xb8\xab\xea\x56\xb9\xee\x63\x0f\xdc\x74\x7f\x36\xfe\x6e\x59\x80\x63\x78\xfd\xa2\x8e\x0c\xb4\x5b\xef\x4f\x70\xbd\x0f\xfd\x36\x0b\x86\x3b\x3c\xc4\xdd\x1d\xfc\x29\xe3\x21\xee\x9b\xa6\xd0\x92\xbc\x2c\x0c\x2f\x57\xfb\x59\x96\x0d\x94\xd9\x34\x78\xaa\x09\x98\xe9\x20\x6d\x1a\x74\x7f\xcb\x3e\xa6\x9c\xde\xe5\x0a\x67\xd4\xcb\x35\xa0\xb4\x2c\x75\x3c\x39\x3d\x40\xdb\x9a\x82\xf0\x5b\xce\x82\xbb\x28\xb3\x20\x63\x63\xcc\xc8\x24\x58\xea\x64\x9a\x9b\x31\xb0\xc5\x45\xb2\xf2\x23\xfc\xb3\xce\x9e\xe9\x54\x99\x9c\x73\xe7\x41\x8a\xcc\x52\x8c\x5d\x26\x2d\x66\x1b\x4f\xab\xcc\x74\x97\x9c\x83\x2b\x27\xcd\x65\xa5\x43\x6b\x61\x6a\xcb\x13\xb7\x4d\x16\x4b\x59\xf9\x7d\x89\x3b\xc5\x4c\x9a\xca\x2e\x67\x6f\x97\xb3\xf7\x98\xcd\x09\xed\x72\xf6\x76\x39\x7b\xbb\x9c\xbd\x65\xf4\x7b\xa7\xc7\xf3\x2c\x34\x51\xe0\x1e\x47\x36\x29\x03\xe9\x69\x61\x80\x83\x2a\xb5\x7d\x30\xee\xab\xea\xd4\x87\x9d\x65\x65\xf3\x70\xa0\x1c\x66\xdb\x89\xec\xec\x70\xda\xc8\x06\xc8\x2e\x14\x97\xec\xdc\xba\x1a\x4a\x5c\xd3\x17\x57\x1f\xad\xa2\x92\xd2\x74\x0f\xd9\x7e\xd9\x71\xf0\x7d\x75\xc5\x09\x32\x82\xea\x37\x94\xe8\x35\x1b\x04\xbf\x88\x35\xb3\x09\x25\x25\xc4\xf3\x15\x4d\x1e\x29\x9c\x38\xb2\x74\x51\xfd\x25\x92\x44\x16\x19\x09\x8a\x26\x86\xec\xe0\x1b\xb7\x10\xbe\x71\x4e\x02\xc7\x7c\xf9\xbf\xa7\x9b\xa9\xca\xc1\xa1\x67\xdc\x21\x81\x2c\xdd\x59\x0e\x2d\x67\xea\x2e\xf7\xdb\x10\x2a\x70\xaf\x1d\xe3\x96\x35\x48\xa3\x33\xc0\x69\x8e\xe5\xc8\xb2\x1a\x1d\x8f\xd9\xd8\xab\xd4\x79\x9e\xe4\x0c\xd3\x3e\xb9\xcf\x30\xe5\xb6\x1a\x47\x11\xf5\x72\xc3\x59\x37\xe1\x3d\x6f\x59\x56\x4a\xdc\x63\x2c\xbd\xc2\xd6\x8d\x34\x78\xb4\x90\x0b\x87\x17\x55\x64\xac\xb4\x0e\x2f\x40\x23\xf9\x42\x70\x9f\x70\x74\x38\xde\xac\xe3\xb6\x36\x79\x7e\x0a\x9c\x59\x91\xbf\x75\xf2\x11\x5d\x71\xea\xe6\x22\x91\xaf\x5e\xee\x83\xfa\x1a\xd7\xfa\x56\x3a\xbe\x65\xb9\xff\x7e\xf5\xf2\xf5\xef\x50\x07\xef\xdf\xff\xf9\xbb\x65\x35\x6a\x6b\xf3\x04\x08\x57\xc5\xde\x41\x6d\x67\x37\x72\xa4\x30\x65\x3c\xee\x45\xac\x81\x92\x13\x5c\x20\xf0\xf5\x6f\x64\x6f\x15\x9a\x00\xc0\x3e\xa8\xa0\xb8\xe9\x44\xeb\x0d\x27\x8b\x09\x92\x0f\xab\x4f\x59\x7e\xa3\x87\xaf\x42\xa6\x0f\x2c\x4b\x3c\xbf\xc4\xfd\x18\x88\x34\x68\xd3\x8e\x65\xed\xfd\x00\x01\xf1\xcf\x7f\x78\x89\xfe\x6d\x59\xe2\x05\x27\x1e\xc1\x82\xec\xa3\x1f\x94\xc3\x43\x3b\xe2\x7f\xa8\xad\x07\x22\x46\x28\xa6\x00\x62\xdf\x42\xb6\x9d\x9d\x0b\x2a\xc9\xba\x36\xdb\x44\x5d\x14\xd4\x87\xc2\x93\x54\x92\x91\xc3\x38\xa9\x9b\x79\xca\x61\xeb\xec\xbc\xe1\x13\x97\x46\xfe\x3c\x30\xf0\x65\xb6\x19\xea\x77\x38\x23\x61\x23\xf0\xcc\xa4\xa0\x8c\x19\x32\x2e\xdb\xcc\xa3\x2c\xa9\x37\x65\x93\x2e\x21\xfc\xf6\x2f\x3f\xfe\xe7\xab\xd7\x8b\x79\x77\xfe\xae\x7a\xd4\x06\x87\x13\x97\x4a\x61\x36\xad\x1a\x52\xe6\x89\xb7\x56\xad\x05\xe3\x25\xe8\x1c\x3b\x5d\x1a\x10\x81\xde\x31\x1e\xf9\xf0\x3b\xe6\x1d\x22\xc1\x6e\x6b\x7b\x38\xe8\x59\x35\x94\x94\xfc\x0c\xc8\xe0\x76\x40\x03\xab\x76\xd0\x3a\x7f\x87\x52\xd7\x1b\x80\xf7\xef\x8c\x1c\xdf\x49\xcd\x13\xa1\xa4\xc6\x8f\x85\x26\x4b\x4b\x6f\x7d\x3e\x3a\x43\xaf\x2a\xcf\x27\x02\x40\x12\x28\x12\x27\x24\xe6\x12\xb1\x36\x6a\xd1\x8e\xa0\x11\xfa\x1c\x78\x34\x20\xe8\x28\x12\x40\xa8\x40\x67\x81\x4b\xee\x47\x69\xa2\x4a\x2a\xd4\x82\xd9\xf4\x55\xe5\xf8\x24\x3f\x31\xe6\x7b\xb8\x78\xaa\xd0\x72\xbe\x9d\x63\xbd\xdf\x18\xa7\x01\x84\x22\x27\x12\xa9\xfb\xfc\x13\x4a\x65\xb2\xb8\x13\x07\x98\x9a\x14\xfc\xa5\xee\x5b\xab\x36\xe0\x38\x0c\x09\xbf\xe5\x56\x6d\x04\x00\x9f\x90\xc6\x33\xd2\x6c\xaa\x27\xb5\x84\xdb\x84\xb8\x23\xb8\x0d\xb0\x80\x04\x52\xd4\x4d\x87\xf9\xb7\xa3\x37\x12\xdb\x1e\x99\x2a\x1d\x1c\x52\xcf\xab\x97\xe4\x0a\x9a\x55\xaf\xe3\x41\xab\xd8\xd4\x3c\x9f\xef\x2e\x8c\xe8\xbb\x2e\xab\x0a\xdb\xd9\x9d\x12\x97\x65\xd1\x22\x84\xda\x62\xa0\x63\x53\x6d\x1f\x0d\xc5\xb4\x45\xfb\xe0\xc5\xc9\xbf\x4a\xdb\x05\xd5\xf4\xbe\xa3\x9c\xb4\xd9\xbd\xd1\x0a\x5d\x35\xc1\x96\xe5\xaa\x3b\xb9\xbb\xc9\x9b\x97\xd3\xe5\xcc\xa7\x91\xaf\x62\xda\x05\x50\xb6\x90\x1f\x67\x17\x8f\xaf\x3a\x7d\x0c\xfb\x8c\x48\x65\x1c\x1a\x1d\x2f\x78\xf4\x3f\x27\x8d\x95\xad\x90\x86\x95\x94\x80\x99\xdd\x7b\x52\x30\x28\xa0\x46\x19\x41\xc4\x04\x04\xb1\x0a\xa0\xfd\xaa\xb3\x1e\xaf\xeb\xcd\x5d\xd8\xfc\xd3\xd6\x83\x42\x1a\x66\xf9\x7a\x5a\xff\xf8\x88\x8e\x22\xd7\xcd\xb9\x4c\x26\x91\xf5\xe8\x1c\x07\xb8\xb3\xf2\x7d\x12\xce\x6b\xed\x76\x4c\xfb\x7d\x63\xea\x2f\x46\x1a\x52\x90\x05\x8e\x47\x9d\x1e\x28\x3c\xd4\x25\xe7\x98\x06\xc7\x9e\xaa\x5c\xb9\x67\xd5\xba\xcc\x87\x72\x18\x61\x37\xac\xef\xf4\xf8\xef\x45\x7e\x7f\xf3\x6c\x10\x93\x4c\x6b\xd9\x64\x8a\x48\x8e\x00\x6f\x2d\xd6\xd1\x74\xaa\xc7\xdc\x61\xfe\xb3\x75\x5a\x1d\xbc\x24\xb4\x0e\x41\x3e\x4d\xcb\x12\x4d\xa3\x39\xa9\x94\x2e\x74\xa8\x65\xbc\xb3\x49\xdb\x6a\x24\x48\xa6\xb8\x7c\x79\xf7\xd9\xce\x77\x6c\x3f\xe6\xce\x97\x67\x42\x1c\xb4\x99\xa2\xa0\x1a\xb4\xd2\x09\xe8\xd8\xb4\xa7\xf1\x5f\x0b\xd9\x82\x29\x46\x17\xc7\x27\x79\xd5\xd2\x03\xc9\x99\xb7\x8a\x80\xc5\xed\x2b\x48\x1b\x53\xbb\x4b\xb7\x40\x58\x30\xc5\x46\xe8\xb8\x99\x02\xa3\x1c\x7d\x77\xe5\xba\xb0\x1f\xec\xe7\xaa\x97\xbd\xe6\xfe\xb3\xd1\xd7\x1b\x9d\xca\x74\x5d\xdf\x0c\xc4\xd6\xe9\x7d\x58\x1c\x30\x64\x66\xd8\x2f\xcb\x08\x93\x7e\xc0\x9b\x98\xa2\x67\xdb\x1d\x13\xfd\x09\xf2\xab\x67\xe0\xa6\xd7\x79\xfd\x7e\xda\x57\xe4\x80\xb9\x64\x3a\x9f\x1d\x2d\x11\xd9\x4c\xb4\x84\x8c\x2b\x58\x15\x51\x30\x04\x2c\xb9\xec\xcc\xef\x16\xf6\x88\x68\xb3\xdc\x92\x67\xc7\x97\xe7\x8f\x41\xd2\x7e\x6b\xd5\x6c\x8e\x03\xf7\x1f\x11\x06\x2b\x3d\xe6\xc3\x77\x1d\x2e\xac\xda\x74\x9b\x95\xa2\xeb\x8b\x74\xa4\xd9\x7b\x61\xf2\x54\x5a\x0b\x0e\x1d\x43\x71\xe2\x52\x12\x92\xa6\x0e\x8c\x13\xe2\xe3\xc0\x1d\x60\x4e\x10\x49\xfb\xca\x74\xdd\x4e\xb1\xd4\x4d\x5f\xb7\xac\x06\x71\x3b\x44\x44\x30\xea\x3c\x67\xcd\xd4\x6b\x71\x31\xcb\x72\x03\x03\xc6\x3d\x64\x33\x9a\xf7\xb0\x51\x82\x11\xa2\x8a\xd8\x80\x94\xb6\x69\xeb\xc1\x6a\xb6\x82\x89\x31\x96\x6c\x71\x2e\x6a\x7c\xc9\x34\xc0\x54\x7c\xda\x94\x63\x9c\xd9\x99\x8c\x77\x26\xe3\xc5\x86\x9f\x62\x67\x21\xac\xe9\x5c\x73\x11\xfe\x46\x25\xdb\xd6\x2a\xab\x37\x31\x79\xab\x5f\x06\xe0\xf5\xcc\x5d\xd9\xc1\x1e\xfe\x23\xd4\xd4\x49\xa4\x04\xc6\x93\x1b\xc8\xdb\x72\xba\x0c\x82\xa7\x72\x2a\x32\x16\x30\x87\xff\x6a\x24\x6d\x4d\x64\x78\x55\x5d\xee\x3c\x6f\xd6\x63\x8a\xb2\xe7\x9d\x42\x78\x6e\x9b\x3a\xe8\x23\x0d\xa2\xfb\x0a\xed\x30\xf3\x7b\x9a\x67\x7d\x99\x7d\x72\xa3\x3c\x4c\x68\xf1\x80\x94\x4c\x61\x22\xd8\xf7\x88\x10\xdb\x89\x19\x94\x50\x67\x59\x8d\xe6\x03\xd8\x92\x65\x17\x57\xdc\x56\x0e\x1e\x4b\x8b\x74\xa0\x1e\xd5\x46\x82\xd9\x1c\x17\xa2\xb9\x84\xa6\x40\x07\xc9\x9a\x38\xe9\xaa\x0a\xdb\x54\xdc\x55\x36\x3f\x3c\xe2\xc8\x57\x9b\xae\xb5\x2c\x34\x19\x69\x90\x30\x44\x0e\xb7\x23\xcf\x2b\x19\xf0\xee\xee\x1f\x11\xe1\xc3\xa5\x41\xef\x66\x06\x8d\xd6\x80\x7a\x77\xf7\x1b\x50\xba\x42\x51\x2e\xfd\x62\x76\x55\x2e\xfd\x4c\x6c\x0f\x55\x8f\x02\x96\xae\x0a\x9b\x63\x41\xbd\xf9\x76\x6e\x90\x44\xa5\x20\xc6\x31\x45\x45\xcd\x09\x5a\xb8\x95\xc8\x64\xc2\xa5\xb5\xe0\xf6\x27\xa9\x63\x44\xb4\x42\x88\xeb\xe4\x26\x3c\x86\xb1\x8d\xbb\x1d\xcf\x42\x8c\x59\x5c\xcb\xbd\x11\x4f\xbc\xba\xc6\x92\xdb\x62\xcc\xa7\xec\xcd\x22\x70\xba\x18\x5d\xb1\xc8\xe9\xae\x92\x76\x30\x46\x0b\x61\x36\xf5\x48\x65\x19\x09\x42\xd1\x69\x48\xa0\xb3\x92\xea\x10\xba\x83\x99\x50\x6a\xc5\x95\x85\x9c\xe3\x24\x70\x69\x48\xe5\xb0\x5c\x1c\xb0\x72\x8e\xe5\x19\x5d\x67\x4c\x2c\x60\x6e\x36\x52\x39\xac\xbd\x9c\x7b\x8e\x4f\x3c\x0f\x21\x56\x53\xaf\xec\x02\x1a\x9e\xaa\x43\x58\xfc\x7d\x98\xa9\x59\x76\xb1\xcb\x06\x95\x7a\x69\x74\x17\x69\x03\x9b\xf1\xcf\xec\x64\x71\x1b\x64\x51\x49\x82\x21\x89\xd3\x0d\x98\xc7\x3a\x94\x80\x92\xdf\x88\x7a\x19\xd2\x19\x12\x47\x88\x35\x9e\xfa\xcf\xc7\x55\xe6\xac\xda\xf5\x73\x11\x53\x30\x7d\x72\x03\x49\x79\x87\x57\xfc\xe2\x57\xa3\xb1\x4c\xbd\xce\xf9\xfd\x4d\x3e\x9c\x3c\x51\x8d\x1f\x3c\x6d\x3d\x4b\x7f\xe8\x62\x4e\xae\xba\x34\x6f\x5a\xa8\xdb\x21\xb2\x64\x9d\x00\x8a\x21\x08\xe8\x5f\x76\xa9\x88\x2f\x5f\x25\x2b\x05\x49\xeb\xd9\x2c\x20\x9e\x77\x16\x1c\x1e\xb1\x1c\xb3\xc6\x25\xf1\x99\x24\xe8\xd0\x71\x88\x10\x8f\x02\x9d\x50\x5d\xa2\xb3\x00\x1d\xa2\x23\x76\x9f\x84\x80\x8d\xe5\xc2\x8f\x84\x44\x36\x41\x24\x80\xa0\x6c\x17\xb5\x19\x47\x13\x64\xbe\x31\x03\xa6\xd9\x79\xb0\x9e\x44\x35\x01\x7d\xd3\x00\xdb\xec\x3e\x9b\x93\x34\x18\x6e\x7d\xe1\x9a\xfc\x65\x43\x83\x61\x83\x0b\x19\xb9\x94\xe5\x0e\xb7\x25\x71\x45\xa6\x8b\x99\x4c\xc5\xe7\x90\x60\x26\xb8\xf3\xf6\x2b\x14\x55\x69\xa6\xa8\x10\x83\x81\x5e\x47\x34\x18\x82\xbb\x2e\x5e\x47\x4e\x87\x1a\x36\x0d\xcc\xa9\xef\x3b\xd4\xb2\x9a\x5f\x6f\x7e\xb0\x6a\x96\x25\x0e\xae\x9f\x7f\xd5\x7f\x99\x07\xb9\x35\x5a\x6e\xd2\xee\x1c\xe6\x52\x87\xec\x35\xf7\xed\x38\x63\x63\x24\x84\x37\x0a\x39\x1b\x51\x11\xaa\x0d\x6e\x11\x29\x1d\x22\x1d\xe6\x6a\x3a\xca\x5e\xe6\x71\x8f\xd9\xb3\xc5\x42\x2c\x39\xab\x08\x3a\x32\x99\x2d\x9b\xb9\xc3\x71\xd2\xca\x4c\x9f\x93\x89\xa7\x90\xe0\x86\x63\xfb\x53\xf2\xd8\x3c\x2c\x8f\x87\x93\x51\xf4\xcd\xd9\x2b\x45\x8f\x0c\xa1\x5a\x83\x98\xe6\xc6\xaa\x4c\x27\x09\xa3\xc6\x44\xe4\xb1\xdf\xc1\x2e\x7e\xc2\xc0\x9d\x22\x1e\x42\xde\x30\x09\x7f\xaa\x83\x4c\x63\x21\xd4\x28\x1a\xa1\x97\x3d\xcc\x61\x59\x41\x0f\xbf\x1a\x49\x83\xd5\x7b\x43\x76\xd7\x8a\x1d\xf4\xf5\x7a\x39\x35\xa6\x7a\x17\x80\xf0\xfd\x42\x5f\x67\x08\xfe\xa9\x07\x5a\x55\x31\xb9\x9f\xd9\x54\x51\xd1\x88\xd2\xb8\x8f\xf5\x05\xf6\xec\x56\xc3\xae\x18\xdb\xbc\x3d\x1d\x15\x43\x73\x9d\xb7\xb7\xa3\xa7\x1b\x0c\x3c\x3d\xc2\x0c\x4c\xd6\x87\xd2\x8f\x8a\x01\xb1\x16\x58\x05\xe8\xb1\x60\xac\x79\x2b\x62\x85\x55\x81\xbe\x0b\x18\xd7\x39\xab\x07\xad\x00\xd9\x3a\xe7\x5c\x59\xe6\x58\x22\xea\x0c\x88\xf1\xb8\x50\x21\x23\xaf\xd2\xca\xf3\x2e\x3c\x6d\x5c\xde\x45\x60\x36\x2b\xa4\xcb\xc2\x77\x87\xe3\xa8\xd5\x67\xd5\x15\xd2\xc9\xe9\x6a\x39\x13\x12\x30\x25\x8f\x6d\xb4\x3d\xac\xd6\x16\x91\x7a\xbf\xb5\xcd\xc8\x34\xc7\x46\x03\xda\x1e\xe6\x18\x1b\x4a\xbf\x3a\xd3\x76\xae\x20\x01\x14\xc8\x16\x05\x57\xb2\xce\x3e\xf5\x71\x67\x3c\xb5\x96\xb5\x6f\x59\xa6\x65\x99\xd4\xef\xc4\x8c\x04\x92\x71\x38\x55\x1c\x77\x55\x89\x89\x9b\xca\x84\x5b\x05\x2e\x49\x22\xab\x97\x1b\x55\x57\xf8\x79\xec\x3f\x19\x5b\xff\x94\x00\xf9\x43\xa1\xc9\x98\x6b\xb4\xcf\xb4\x1e\x16\x78\x7f\x76\x16\x06\xc4\xbe\x05\x7c\xd0\x89\x65\x2a\x93\xad\x74\x77\xc1\xaf\x4e\x51\xaf\x30\xa9\x59\x4f\x61\xc3\xf9\x96\x25\xe6\x90\x21\x52\xad\x9c\x27\xce\x18\xdd\x97\xde\xf9\xe3\xe2\x69\x5f\x6f\xde\x5c\xbf\xc8\x93\x6b\x48\xe5\x14\xf1\xdb\xf5\x91\x39\x20\xb6\xe9\x40\xfa\x84\x09\x90\x5b\xd7\xff\xfe\xf1\xcf\xbf\xdf\x36\x5e\xd4\x67\x1d\x84\x3a\xb0\x2a\xde\x3e\xe2\x44\x1b\x9f\x06\xb3\x8f\x91\xa0\x43\x03\x62\x26\xd4\xe5\x2c\x92\x49\x2c\xde\x07\x4c\xdc\x2d\x95\x27\x67\x0b\x9b\x2d\x62\xfd\xbd\x54\x7d\x7c\x50\x8c\xfa\xf7\x97\xbb\xd8\xd1\x3f\x7a\xec\x68\xac\xff\xe4\xa6\x04\xd2\x4e\x80\xbd\x1c\xd4\x82\xea\x8a\x9e\x25\x93\x63\x42\xac\x82\x2d\x75\x6e\x64\xac\xc1\x48\xdc\x99\xdd\xbf\xd5\x63\xb2\x4b\x6c\x55\x5f\x59\x3f\x00\x8f\xd2\x36\x58\x36\x2c\xab\x59\x05\x7a\xaa\xe2\x50\xc3\xc9\x80\x00\xa5\x5e\x1f\x3f\x1e\xd6\xe9\x41\x5c\x18\x34\x5b\x66\x99\xd3\x95\xc0\x3e\xbd\x3e\x86\x5c\x83\xac\x10\x39\xea\xf5\x09\x6f\x49\x4e\x43\x52\x11\xb2\x70\xcc\x18\x64\x0f\xd1\x1b\x3c\x0e\x40\x3a\xb8\x7e\x31\xd5\x79\x19\x17\xd6\x07\xed\xed\xce\xf8\x27\xa5\x0e\x8f\x97\x2d\x4c\xa4\x50\x13\x99\x2d\xbd\x00\xea\xf9\xe1\xea\xaa\x1a\xbc\xf0\xb4\xf5\xb9\xc5\xae\x36\xb3\xa8\xc9\x3d\x06\xaa\xb2\x8f\x05\x3f\xf4\xfe\x7f\xff\xa7\xcd\xca\xd8\xd6\x14\x3b\x5c\x2d\x7e\x1a\x43\xd5\x66\xb5\x8d\xbb\xe3\x63\x42\x1a\x6d\xbe\x98\x09\x92\xa0\x73\x22\x09\xaf\x26\x18\x29\x2f\x42\x92\x4a\xe2\x43\xef\xf1\x21\x77\x27\x4c\x87\x45\x81\xfe\xe2\x4e\x58\x56\x13\x9e\x78\x5b\x2b\x3f\x81\x33\xe9\x38\x5b\x42\x24\x39\xc6\x12\x7b\x43\xb1\x99\x34\x33\x53\x7c\xbd\x35\xae\x01\xf4\xb5\x92\xdc\x07\x60\x04\x76\x99\xad\x96\x89\x29\x98\x17\x81\xda\x23\x4c\x97\x76\xa8\xc4\x9e\xe1\x27\x4a\x51\x36\x00\x26\x95\xe4\xd4\xa5\xf2\x91\xcb\xa8\x0a\xbc\x85\x84\xb2\xc7\x4a\x0a\xa0\x6b\x67\x15\x62\xa0\x92\x00\x42\x79\xd5\x35\x07\x20\x8a\x4f\xeb\x04\xcf\xcd\xff\xc3\x54\xe1\x5c\xa6\xfa\xc1\xb2\x1a\x58\x74\xef\xd7\x64\x93\x8d\x47\x9b\x99\xef\x4e\x25\x69\xd3\xa0\x50\x8e\xcc\xfa\x73\x55\x53\xe2\xd0\x5e\x23\xd3\x5e\xbf\xf8\x9a\xbd\xab\xf0\xb9\xab\xf0\xb9\xab\xf0\xb9\xab\xf0\x99\x33\x19\x68\x57\xe1\xb3\x98\x1d\x27\x39\x69\xf5\xbe\x9c\xad\x94\xf5\xa3\x3e\xa5\x2e\xee\xf5\xd8\x36\xaa\x1c\x13\xd4\xad\x7e\xc2\x8e\x1b\x69\xb4\xe9\x62\x4e\x7c\xf3\x49\xaf\xf2\x32\x93\x13\x18\xc4\x78\xca\xbb\xa7\xcc\x90\x71\x79\x49\x10\x3c\x1a\x74\x0c\x31\x4c\x32\x72\x46\xa0\xb6\xe8\xe7\x13\xbd\x25\xeb\xf1\x7a\x6e\x32\xd5\x83\x57\x4a\x4e\x06\x50\xdc\xcc\x94\x3c\x40\x54\xae\x1c\x5e\xe1\x81\x33\xf5\xc5\xd4\x09\x63\xd6\x9b\x02\xe8\x30\x64\x97\xf8\xa4\x58\x1e\xda\xcc\x11\x65\xaa\x06\x1e\x99\x87\xbe\x33\xae\x7f\x07\xc0\x0c\x3d\x12\x0c\xba\x84\x78\x84\x37\x62\x70\x5c\xca\xb4\xf4\x64\xac\x11\xdf\xce\x4b\x88\xab\x62\x95\x4c\x6d\x4a\x42\x53\x01\xc8\x0b\x5c\x7a\x85\xd3\x35\xf5\x5b\xa5\xae\x86\x73\xc6\xae\x18\xf3\xc4\xd2\xeb\x61\x6d\x31\xea\x93\x2a\x0c\x63\x12\x88\x9d\x32\x36\x54\x2e\x97\x69\xaf\x4b\xaa\x0b\xb1\x95\x82\xe2\x86\x4d\x4c\x50\x84\x94\xbb\x3b\x9e\xc4\x1c\xf9\x44\xaf\xb6\x43\x42\x5f\xad\x26\xa2\xaf\x76\x3b\xf6\x6e\xc7\x5e\x69\x5d\xbc\x5a\xb8\x30\x7c\xcc\x25\x3a\x74\xa7\xaf\x7c\x95\x6b\x95\x63\xdb\x16\xf4\x8f\x5d\x7d\x81\xd1\x0a\x9e\x65\x99\x0e\xf6\xbc\x35\x59\xb5\x26\xfb\xcf\xd4\x02\xe1\xc1\x56\x05\xe5\x10\x13\x8e\x28\x76\x68\x7a\x80\x46\x80\x60\x69\xee\xbb\x03\x31\x12\xf0\x68\xdd\xb2\x9a\xd4\x7d\xbb\x9a\x1f\x70\x1c\x0e\x77\x49\x5c\xec\x48\x12\x71\xa4\xc6\x73\x16\x08\x89\x83\x47\x1a\x4a\x49\x40\x1c\x0c\x25\x13\xc3\xc8\x36\x5b\x09\xfd\xe3\xbf\x8c\xf1\x5f\xf4\xd7\x90\xf0\xac\x6a\xa5\xea\x49\xc9\xf8\x9a\xc3\x3a\x93\xdd\xc7\xbf\x13\x1e\xb5\xab\x80\x1b\x4a\x07\x96\x29\x63\x01\x0e\x97\xca\x87\x7f\xf9\x48\x17\x5e\x80\x43\x73\xef\x2b\xd4\x98\x4a\xeb\xc8\xad\x3b\x8d\xf2\x03\x16\x3d\xe2\x79\x6b\x71\x2d\x6f\xaf\x1b\x78\x40\x7b\xb4\xd1\xd5\xac\x50\x78\x68\x09\x5b\x8a\xc6\xc3\x04\x38\x6c\x27\x72\x91\x27\x62\x0d\xd1\xef\x6c\x1c\x2e\x30\xc0\x10\x04\x2d\xfa\x1d\x50\x85\xaa\x2a\x2a\x0e\xbd\x88\x7e\x27\xab\xa4\x78\xc0\x98\x4d\x37\xe3\x04\x55\x5d\xc7\x46\x0d\xf8\x10\xea\x6a\x4d\x15\x78\x3d\x55\x4f\x99\x62\xc1\x6c\x7a\xc1\xd9\xfa\x4b\xbc\x94\xb4\x8b\xec\x0a\x71\xee\x0a\x71\xee\xd2\x38\x17\x74\xf9\xa4\x0a\x71\xce\x14\xe1\x0c\x39\xcb\x8e\x42\x60\x4e\x8f\x48\xd8\xe0\x57\x40\xb3\x2c\x77\x3b\x57\x94\x58\x56\x83\xb2\xd5\xa2\x36\x16\x6e\x6e\x0f\x0b\xe5\x7c\x8f\x2a\xd2\xc3\x82\x37\xf9\x00\xb3\xa9\x70\x2c\x94\x9e\xb6\xbc\xe2\x5b\x94\x42\x36\xbe\xa9\x69\xd2\xd0\xa9\x71\x45\x1d\xcc\x89\x44\x70\xf7\x14\x14\xfd\x0b\x7f\xfb\x5f\xff\x97\xf7\xbf\xfe\x2f\xff\x7f\xfd\x5f\x8f\xbb\xb6\xb1\xb6\x94\xd9\x37\x5e\xe6\x31\x97\xf4\x1f\x1f\x26\x27\x74\x43\xb7\x82\x88\x5c\x08\xfb\xe4\x36\xec\xd2\x7e\x0c\x8b\xf4\xd5\xaa\xfd\x70\xed\x0e\x03\xec\x53\xe7\x84\xf6\xd5\x47\x44\xdd\xf8\xfb\xb8\x44\xae\xfe\xaf\x36\xf4\x80\x6d\xa8\xa9\x7e\xdd\xd5\xaf\xff\x6e\x52\xc4\xb4\x88\xe5\x88\x33\xdf\xe6\xa2\x97\x0b\x85\xec\x63\xe4\x90\x34\xcf\x15\xfd\x11\x92\x7b\x72\x95\x41\x38\xae\x77\x75\xe1\x56\xd2\xd8\xee\x70\x1f\xaf\x9a\xf0\xef\x29\x49\x6b\xe0\x10\xb2\x12\x95\x19\x02\x02\xfe\xcc\xa2\x0b\xf1\xe1\xeb\x82\x79\xdc\xcc\x58\x90\x91\xf8\x2d\xc2\x9c\xa0\xcf\x7e\x40\x2b\xa8\x8f\x56\x49\x14\x61\x4a\xf5\x2a\x01\x84\x87\x10\x76\x8b\x8e\x99\xe7\xbe\x8b\xd4\x59\x58\x75\xfc\xe0\x31\x63\x3d\xaa\xba\x3e\x7e\x77\xf5\xf9\xe7\xd3\x4f\x6f\x97\x88\x08\xfc\x93\x61\xa0\xd6\xd5\xe1\xe5\x15\xd2\xad\x5f\xe1\x8e\x0a\xc2\x68\xfb\xb5\x25\xbc\x37\xa6\xd3\xc6\x77\xf8\xde\xac\x55\xbc\x69\x1c\xbf\x3b\xff\xb8\xdb\x34\x56\xda\x34\xc6\xd1\xe0\x29\x18\xbe\xc3\x3c\xb7\xad\x64\xd4\x68\x63\x9f\x7a\xc3\xf4\x1a\xb6\xec\xae\xb2\x74\xe3\xc5\x92\x60\xd2\x75\x98\x73\xe8\xf7\x21\xd1\xc1\xa5\x79\x59\x6c\x38\x94\x4e\x17\x4f\x98\xd4\x4b\x70\x76\x15\xc6\x39\xc0\x21\xd8\x01\x05\x10\xab\xc2\xbb\xab\x89\x74\x4a\x9b\xcf\xe6\x58\x80\xf9\x3f\x22\x9b\xe4\x40\x3c\x9f\x90\x3e\xd2\x21\x0e\x8f\xf0\x8f\xc7\xae\x71\xd3\x11\xc2\x14\xd0\x71\x5c\x65\xc3\x6a\xf6\xdf\xce\xa9\x59\x90\x64\xe0\x27\x24\xa6\x48\xc8\x79\x8c\x86\x04\x13\x3b\x0a\x5c\x8f\x8c\xfb\xb9\x13\x93\x9d\x3c\x5b\x3e\x5f\xfe\x66\xcc\xaa\x55\x8e\x82\x19\x1d\xe7\x7b\xbc\xd2\xcf\xea\x2a\x05\x17\x7e\x80\xf9\x6f\x91\x4d\xb2\xeb\xc6\xb0\x28\x70\xcf\x71\x80\x3b\x79\x4e\xed\x0a\x7d\x1d\xe5\x1b\xf2\x9d\x2e\xf5\x3c\x1f\x07\x34\x2d\x5d\x02\x35\xca\x41\xa8\xa3\xc0\xf5\xf5\x70\x17\xfb\xfa\xc3\x2e\x0d\x72\x62\xb4\x4e\x98\x13\x41\xc5\x29\x25\xea\x8f\x59\xe0\xc7\x9c\x60\x49\x5c\x14\xa9\x0d\x74\x9c\xda\x9a\xee\x79\x42\x51\x63\xb8\x0c\x92\xa2\x61\x2a\xad\xda\x81\xa6\xf0\x8d\x89\x0f\xd0\x1e\x80\x62\xc0\xca\xb4\xac\xc6\x9a\x42\x01\x54\xe7\x8d\x90\x39\x8c\x65\xa6\x55\x86\xd4\x25\x3c\xa9\xec\x4e\x03\xfa\x29\x0f\x60\x67\xa9\x2a\xf0\x15\x28\xad\x9a\x46\x34\x45\xf8\xea\x51\xca\xaa\x15\x47\xb7\xa2\xec\xb4\x34\xa0\x59\x39\x41\x21\x0d\xb6\xa2\x78\xdd\xce\xb2\xf3\x24\x2d\x3b\x21\x0d\x1a\xce\x60\xb1\x74\x79\x51\xd0\xab\xce\x6f\x99\x68\x1e\x61\x0a\xd2\xde\x66\x0c\xf2\x54\x6b\x07\xff\xc3\x61\xe1\xf0\xbf\xd1\x57\x43\x85\x4f\x20\x4d\x09\x3a\x0b\x1c\x1d\xba\x93\x7c\x31\x86\x09\x82\x2f\xed\x88\x7a\x2e\x4a\xbe\x52\x71\x6c\x50\x96\x18\xf0\x83\x9e\xbf\x31\xc3\x83\xc7\x06\xf8\x8c\xa9\x78\xc4\x02\x87\x26\x32\x75\x44\xf5\x84\x5b\x49\x56\x79\xdc\xf4\x26\x81\x21\x0a\x8c\x9f\x93\x6a\xb1\xa6\xc0\x41\x9c\x96\xa5\x31\xb1\x10\x04\x0e\x79\x88\x1f\x32\xb1\xe7\x19\x5f\xb1\xf1\x0d\xa4\xee\xdf\xaf\x5f\xfd\xae\x55\x66\xe5\x00\x3e\x18\xbd\x89\xeb\x7c\x58\x96\x78\xae\xa8\xd4\xb2\xc8\x59\x24\x89\x18\x49\x8e\x03\xe1\xa9\xa3\x5d\x8c\x70\x48\x6f\x7b\x64\x58\xaf\x57\x6d\x24\xbf\x8c\xec\x21\x62\x01\xba\xc4\xb4\x68\x40\xf4\x23\x0c\x1e\x63\x51\xf2\x99\x7b\x0b\x63\x87\x6e\x47\x1c\x3b\xbd\x9c\xa4\xc9\x39\xcf\x97\x16\xc1\x9a\x4c\xe1\xe4\x3d\x62\x72\x12\xcd\x65\x22\xaf\x11\xaa\x39\x82\xb7\x8d\x10\x73\xec\xab\xa3\x00\xd6\x3f\x14\x94\x75\xa8\x1c\xde\x4a\xd6\x23\xc1\xb3\x12\x5c\xf2\x30\x71\xdb\xe1\x93\x87\xf4\x4b\x16\x74\x38\xf1\x46\x5f\x4e\x8f\x38\x75\x7a\x23\x20\xae\xfe\x47\x72\xb5\xf3\xc8\x1e\x4e\x95\x96\x5e\xca\xce\x22\xe2\x16\x58\xa0\xe4\x77\x29\x8f\x3b\xec\x13\x69\x75\x8e\xac\x6d\xef\x37\x4e\x48\x50\xda\x59\xab\x97\x20\x67\x92\x38\x72\x02\xc1\x55\x75\xf2\x6c\xa3\x20\x1a\x42\xd1\x90\xe5\x37\x56\x86\x27\x11\x62\x87\x94\x8e\x22\x32\xd1\x74\xb6\x4a\x02\xd6\x59\x28\xc5\x85\xa8\x40\x13\x04\x59\x56\x03\x19\xc6\x9a\x2a\x3d\x29\x0d\x6d\xdc\x77\xb6\xf4\x44\x94\x73\xe2\x9d\x63\xea\xe5\x6b\x0e\xea\xa9\xd5\x54\x35\xe1\x63\xcf\x3b\x98\xec\x0e\xf5\x75\xf2\x3c\x4a\x63\x5d\xb5\xbe\x65\x73\xb4\xf3\x10\x7f\x0f\xc5\x07\x63\x59\xf0\x31\xf5\x32\x6f\xf6\xbf\x45\xf4\x1b\x3a\xc7\x92\xd3\xfb\x0a\x40\xcf\x26\x5b\xcf\x5d\xda\x50\xbe\xeb\x32\x0a\x02\x90\x07\x38\xfe\x86\x2d\x16\x71\x87\x8c\x54\x2b\xf5\xa9\x66\x1e\x97\xb9\xbc\x90\xaa\x9d\x7c\x3f\x25\xf9\xfe\x96\x89\xcb\x22\xb1\xd3\xf3\xf0\x06\x8a\x36\x6a\xbd\x37\x06\x12\xd7\x54\xa4\x59\x3e\xb1\xab\xc3\x04\xc0\x27\x73\xa0\x7a\xb7\x2c\x73\xaf\xb9\x0f\x9a\xf1\xa1\xf1\xbf\xc7\x06\xc0\x4a\x02\xc3\x35\x29\xd9\xd8\xd9\x12\x73\x8f\xc8\xfc\xb3\x6a\xf9\x5b\xee\xcd\x85\x87\x9d\xde\xfe\xfe\x07\x0c\x3e\x09\xbe\xbf\x9f\xf4\xb5\x19\x5b\x17\xe1\xde\xfa\x17\xb1\x65\xd9\xd0\xb1\x65\xd9\x60\x17\x69\x9a\xcd\x7e\x33\x37\xa2\x78\xcb\x56\x29\xe1\xde\x32\x8a\x36\xf4\xef\x84\x38\x80\x77\xcc\x90\xb9\xe6\xc4\xac\x2f\x10\x40\x19\xc3\xa2\x6d\x26\x41\x61\xdc\x7f\x52\x9b\x51\x7f\x4a\xfe\xad\x20\x53\x61\xdc\x65\xa6\x2e\x09\x96\x90\xd4\x9c\x5e\xa5\x21\x06\x40\xb1\x85\xea\x07\xf5\x59\x90\xfa\x31\x74\xee\x22\x50\x61\xe8\x5f\x21\x2e\xc2\x25\x56\x6d\x04\x99\xa6\x03\x71\x6b\xb3\xfb\x2b\x16\xae\xc9\x71\x31\x49\x47\xc3\x25\x99\x5c\x73\x49\x50\x55\xf5\x8d\xb4\xfd\x4d\xde\xdd\xb4\x14\x69\x42\xf2\x44\x68\x68\x7b\xac\xb7\x7d\x60\x32\x22\x25\xed\xd1\x5c\x50\xcd\x64\xb3\x81\xe3\xd0\x65\x83\x60\x1c\x60\xbe\xc1\x10\xfa\x84\x98\x52\xc3\xe7\x8f\x18\x93\xaa\xe5\x0d\x54\x0a\x9c\x68\xf7\x8d\x90\x43\x8f\x1c\x98\x96\xf5\xfc\x4f\x96\xf5\x1c\xa5\x64\xa1\xfe\x5e\x1a\xc1\xac\xff\x3b\x63\xa8\x1c\x17\x66\x69\xc6\x9b\x0f\x54\x67\x81\x98\x89\x71\xa6\xbb\x9d\xb4\xa6\x0d\xbe\xba\x40\x40\xbd\x39\x2e\xa0\xbe\xa0\xad\xa4\xd8\x79\xfa\xfe\xc3\x6a\xe7\xd3\xef\x27\x69\x4b\x89\x3b\x44\xbf\xde\xf1\x86\x61\x97\x3a\x2c\x40\xe9\x5f\xc6\x32\x31\x62\x72\x40\xa5\x3e\x66\x34\xe0\x48\x7c\xde\xd8\x73\xa6\x0e\xa1\x5a\xfa\x75\x51\x48\x80\x19\x24\x1c\xf8\x78\x27\xea\x4d\x73\xc1\xa8\xd7\x86\x81\xd1\x21\x32\x25\xa1\x28\x8a\xfd\x7b\xc6\x3a\x1e\xc4\x10\xba\x04\x5d\x70\x22\xe5\xb8\x2e\x50\x8e\x64\xcf\xb1\xcd\xa1\x27\x1d\x3f\xa2\x10\x52\x3b\x8a\x1f\x3a\x1c\xc2\xd4\x1f\x0c\xf8\xc1\x08\x13\xe6\x14\xbd\x45\x25\xbb\xcf\x9d\xc8\xdd\x31\x69\xf9\xe6\x81\x9b\xb8\xe1\x8d\x1a\x3e\x15\x09\x59\x86\x4f\x09\xa7\x54\xb5\x65\x56\xa6\x42\x45\xc6\x15\xcb\x55\xcf\x4a\xcb\x32\xad\x1a\x92\x98\x77\x88\x04\x90\x4a\xdb\xc3\x41\xcf\xaa\x1d\xac\xaf\x32\x55\x4c\x4b\x8e\x90\xe4\x42\xc6\x5f\xe0\x21\x44\xda\xa0\x0b\xce\x1c\x22\x04\xe3\x2b\x63\x69\xa4\xc0\x9d\x2e\x96\xd8\x10\xd9\x88\xf1\x08\x21\x84\x50\x0d\x2e\xdd\x0d\xfd\x68\x6e\x31\xf0\x95\x74\x52\x68\x39\x93\x45\x91\xed\x51\x9f\xfc\x42\x5d\x92\x23\x50\xea\x11\x74\xe1\xe1\x21\xe1\x25\x6b\x1c\xb1\x61\x42\x93\xd2\x87\x7e\x2c\x4b\xc5\xb4\xdc\x09\x33\xf1\xcf\xbd\xa8\xc2\xfe\x30\xd1\x63\xa6\xe1\x26\xb2\x39\x65\x41\x99\xbb\x0d\x3c\x3d\x6e\x19\x95\x59\x85\xe1\x61\xb0\x7c\xdc\xc9\xce\xb0\xf8\x44\x0d\x8b\x7a\xfe\xb2\x97\xb1\x13\xf1\xbc\xe3\xf0\xe4\x53\xae\x80\xde\x1b\x42\xb5\x64\xa8\x92\x58\xfb\x71\x92\xe0\xcb\x07\x3f\x53\x77\x0d\x45\xdf\x73\xcf\x49\x45\x8b\xda\x28\x16\xf3\xc5\x8b\xca\x58\xb6\xef\xa7\x8c\xf5\x91\x17\x99\x4d\xc0\x7f\x6e\x3e\xdb\x8c\xad\x70\x57\x74\x1d\xe5\x0f\x1c\xed\xf0\x09\x16\xf1\x1f\x6d\x75\xd1\xf5\xdc\xed\xd0\x8b\xb2\x94\xe3\xc8\x67\xe7\xa4\x98\x93\xe5\xe5\xfa\x2a\x50\x79\x0c\xbb\x4a\xc9\xf1\x99\x5f\x8d\xae\xa7\x5a\xce\x3e\x24\x82\xcf\x39\x11\xc0\x0b\x60\xc3\x8b\x05\x0d\x42\xf3\x7b\x68\x5c\x8f\x71\xbc\x2a\xd4\x53\x1e\x91\x86\xae\x8f\xb8\xe0\xe9\x0d\x46\x1b\x32\x8e\x1d\x2f\xad\xc4\x81\x39\x15\x8b\x99\x18\x42\x18\xc3\x37\xe2\x16\x36\xd4\xbd\xe7\x18\x8c\x31\x65\x9b\xe9\x52\x42\xb4\xd9\x24\xb1\xc0\xc0\x3f\xd7\xf5\x7a\xb3\x92\xa2\x25\x2a\x70\x96\x82\x63\x20\x20\x72\x32\xf9\xc1\x1c\x93\xb3\x90\x73\x7d\x5b\x7a\x39\x0b\xf3\xc8\x63\x1d\xb1\x81\x74\x52\x45\x9a\x5e\x95\xcf\x1e\x69\xef\x55\x4d\x65\x2e\xc3\x01\x21\xf2\xd0\x23\x5c\xae\x15\x06\xf5\xa1\xb9\xf1\x85\x00\x4a\x0c\x0c\xa4\x3c\x34\x38\xe6\x89\xdf\xa2\x77\x4b\x15\x39\xe0\xaa\xfc\x8b\xfc\xeb\x24\x02\x32\x74\xac\xfa\x35\xf3\x39\xfc\x6a\x2b\x58\xac\xa8\x7d\xb5\x1a\x8b\x27\xdf\xc5\x9e\x57\x6f\x4e\x37\x53\x3a\xb7\x27\x3a\x9d\x60\xfa\x62\x4e\xd3\xb6\x2c\xe4\x9e\x2f\xea\xad\x98\xa3\x6e\x4f\xf7\x31\xcf\xe5\x35\xe7\x89\xe4\x3b\xe3\x18\x6e\x32\x45\xd0\x4e\x32\x6a\x00\x26\xed\x97\x10\xe8\x33\xd5\xd4\xee\x2e\xfe\xe4\xee\xe2\xf1\xfc\x65\x06\xb0\xc1\x33\xc3\x3c\xc3\x63\x8b\x60\xee\x74\xd1\xa9\xd2\x88\xca\xd6\x0d\x62\x0a\x62\x07\x0e\xf1\x6d\xe2\xaa\xcd\xe2\x59\xc9\x3a\x67\xdc\x4f\xe6\x71\x37\x75\x57\x2d\x61\x87\xd8\x25\xa1\x7d\x8f\xeb\x6e\xfa\x9e\xb8\x40\xd0\x42\x5c\x69\x4c\xf3\xc3\xf4\xd9\xd8\x2b\x02\x3d\xeb\xfc\x59\xab\xa6\xb2\x7e\x26\xce\x92\x96\xfe\x51\x3c\x87\x84\xda\x52\x2a\xc8\x42\x83\xab\xe4\xba\xef\x22\xcc\xd6\x10\x61\x96\xde\x03\x60\x96\xb2\x93\xe3\x87\x01\xf3\x58\x67\x88\x4e\xa8\xe8\xb5\x74\xae\x77\xb6\xf8\xc6\x00\x18\x08\xa2\x66\x70\x67\x55\xcf\x5e\xc0\xe2\xf4\xb4\x09\x78\xb7\x1f\xc4\x30\x60\x46\xc0\x0c\xfd\xd3\x0f\x79\xaa\xe9\x80\xd8\x38\xa4\x26\x09\x24\x1f\xc2\x21\xd3\xa1\x96\xd5\xc4\x21\x7d\xdb\xfa\xd7\xa7\xcf\x3a\xf8\xe1\x98\x71\x32\x7a\x47\x3d\x22\xf4\xd0\x20\x85\xfc\x84\x88\x9e\x54\x08\xbb\xcb\x03\x3d\x64\xb0\x0b\xa1\x9a\x4b\x34\x91\x54\x17\xe7\xbb\x99\x78\x08\x85\x9c\x81\x8b\x47\x20\x8c\xda\x91\xe7\x19\x6d\x82\x65\x04\xab\x33\x88\xf9\x89\xa5\x04\x25\xd1\x45\x62\x92\xb1\xab\x9c\x87\x31\x8d\xd9\xdb\x54\x20\xf1\xfd\x07\xda\xe9\x7a\x50\x63\x3c\x2f\xaa\xb0\x8c\xb4\x59\x28\xa3\xa0\xb8\x33\x82\x5b\x4a\x5d\x97\x85\xea\x26\x73\x74\xc4\x23\xd1\x1d\x5d\x41\x45\xa3\x13\xd2\xc6\x91\x27\xeb\xb5\xf5\x18\xf0\xe3\xfb\x85\xb2\xba\x28\xae\x74\x27\xb8\xb2\x80\x7d\x57\x5f\x68\x8f\xe6\x59\xf7\x7a\xf4\x11\x15\x11\x74\xbd\x90\xbd\xe6\xbe\x82\x35\x19\x61\x4f\xd6\xdf\x5a\x35\x95\xe3\x03\x03\x40\x54\xa0\x70\xbc\xbd\xcb\x2e\x41\x8a\x26\xe4\x30\xcf\xc3\x36\xe3\xb1\xd0\x79\x58\xb6\x19\xf7\x0b\x94\x87\x52\xaf\xc3\x55\x54\xff\x2a\x46\x12\x80\xc8\x3d\x6a\xcf\x5e\xf5\xea\xb5\x5d\xac\xf1\x93\x8a\x35\x56\x13\x99\x75\x55\x90\x98\x7a\x50\x3d\x70\x1c\xe1\x55\x85\x82\x9c\x63\xa3\x98\x20\xa2\xb8\x8d\xa2\x64\x1b\xcf\x98\x84\xcc\x6c\x80\xab\x7f\x5d\x7c\x7e\x8d\x2a\x00\xb2\x78\xc0\xa2\x44\xd5\x93\xc3\x90\xbd\xde\x6b\xee\x3b\x2c\x68\x8f\x24\xf1\xc3\xba\x59\x7b\xf9\xa0\x80\x0a\xd2\x55\xf6\xe6\x3d\x9c\x87\x8a\x94\xfb\x4e\xa6\x36\xa8\x18\x02\x59\x4a\xb0\x9b\x9f\xb7\xe0\xaf\xfa\xc4\x4d\x40\xcd\xa7\x78\x71\x7c\xde\x9a\xb5\xaf\xef\xae\x3e\x4f\xe2\xea\xa3\x16\x07\x48\x48\xa6\x2a\x79\x85\x69\x27\xe7\xea\x73\x26\x44\x44\xd0\x15\x64\xd4\x97\x17\xbf\x53\xd2\x71\x74\x72\x87\x83\x0e\xdb\x6c\x60\xf0\x5e\x73\x3f\x9c\x34\x00\x6a\x28\x07\x4d\x19\x9a\x10\xb0\x37\x96\x65\xe2\x83\xd1\x38\xdc\xeb\xb9\xaa\xd3\xac\x90\x8e\x01\x0f\xc0\xa7\xae\xeb\x11\xa8\xfa\xa4\x10\x00\xd4\xf7\xea\xb1\x83\xfa\xa6\xcb\x37\x5f\x0c\x65\x77\x42\x67\xce\x63\x35\xaa\x18\x4b\xe0\x66\x64\x59\xa2\xae\x89\x7a\x4a\x75\x9c\x97\x75\x8e\xab\x01\xae\x0c\x27\xe0\x2a\x09\x8c\xbd\x6c\x45\xa3\x93\x0f\x83\x4e\xe4\x61\xfe\x53\x6b\x73\x95\x0f\x27\x9a\x87\xfb\x87\x4b\xfb\x23\xf8\x4d\xd7\xd1\x08\x3a\x06\x0e\xc3\xb7\x33\x41\xed\xfa\xdb\x65\x02\xd6\xb1\x1e\xe7\xd2\x35\xe9\xa0\xda\x4d\x93\x3b\x3a\x0f\xfd\x79\xfd\x79\xdd\x8c\x5b\xca\x2e\x52\x17\x3f\xb4\xde\x0a\x8d\x71\xa7\x77\x4b\xa1\x49\x48\x38\x10\x32\x82\x24\xae\xf0\xa0\xd7\xc8\x0b\x21\xfe\x48\xfb\x04\x1d\x77\xb1\x2c\xd7\x38\x6d\xa6\xf6\x68\x89\x07\x3d\xf8\x87\x95\x6b\x95\x96\xf1\xe0\x16\x8d\x9d\x60\x8f\xe0\xf6\x7a\x93\xf9\x4a\xcf\xd1\x93\x7a\x14\x59\x96\x06\x18\x28\x8d\xfc\xca\xab\x09\xe6\x69\xb8\x7b\xcd\xfd\xd8\x60\x5a\x6f\xc2\xec\x28\xf8\x62\x49\x7f\xd3\x61\xbf\x4a\xdd\x7f\x99\x0a\x87\xd4\x44\x9b\x91\xc4\x9d\x2a\x5c\x16\x71\xfb\x39\x7c\xf3\x8f\xa9\xcc\x71\x59\x1c\x41\x28\x04\x3a\x3e\x5b\x11\x4f\x30\xd9\xf4\x44\x88\x83\x14\xd5\x2c\x46\xc8\xb8\xc2\x60\x47\x9e\xf9\xe9\x0b\xe3\xae\x55\x3b\xf8\x45\x3f\xf2\xc6\x84\x5f\x0f\x26\x70\xcd\xea\x2b\x82\x96\xcd\x31\xb5\xa5\x0c\x58\x5e\x95\x3b\x54\xb0\xe3\xe8\x8a\xf9\x0e\x96\xc5\x35\xba\xa9\xe4\xed\xa5\x6f\x0c\x37\xba\x57\xe3\x98\x0d\x99\x24\xb3\xba\x44\xe6\xfd\xc1\xb2\x6c\x4d\xab\xb6\x4a\x18\xdb\x62\x90\xc8\x55\xe6\x76\xe0\xfc\x1b\x01\xe7\x97\x4a\x58\x26\xd0\xf5\x8b\xa8\x63\x3b\x90\xe1\x87\x20\xc3\x2f\xff\x63\x57\x12\xf9\x0f\x50\x12\x39\x63\x1a\xcf\x19\x24\x56\x15\x2e\x99\xb5\xd9\x99\xf4\x15\xb1\x1b\x4a\x40\xd5\x9d\x8f\x13\x1e\xf3\x38\x0b\x70\x6d\x6c\x22\xc2\x04\x6d\x75\x61\x7b\xb0\xac\x24\x04\x8f\xd4\x5f\xd8\x89\x3c\x16\x89\xfa\xc4\x81\x3b\x3f\x6c\xb5\xea\x5d\x09\x14\xe9\x94\xb6\x99\x1b\x56\x06\xff\x2f\x09\x76\xe4\x76\x5c\xad\xc7\x09\x87\x5c\x11\xb5\xc4\xbc\xa8\x17\x60\x0a\x06\x54\x76\x0d\xec\xba\x2c\x10\xf5\xe6\x0a\x9b\x59\xd2\x50\xc6\x5e\xa6\x1e\x59\xef\xbd\x59\x75\x59\x7c\x4e\xff\x19\x00\xf3\xa1\xd2\xce\x13\xd9\xb1\xa2\x31\xc1\x33\x47\xc4\x9a\x4f\x86\x7d\xd3\x1c\xd3\xb2\x64\x6d\x40\x55\x9d\x92\x48\x9b\x63\x1a\x68\xcf\x8f\x24\xd8\x07\x00\xd5\xc5\xb6\x6e\xe2\x11\xe1\xb0\x90\x3c\x31\x2c\xf3\x73\x22\x09\xe3\xdb\xb1\x6b\xa4\x5e\xae\xdb\x5b\x5f\x91\x65\x38\x42\xdc\xde\x6e\xda\x40\x0d\xe8\xb2\xec\xe4\x68\xb9\x6b\xcd\x9c\x62\x6b\xdf\xcf\x55\x06\xd6\x8f\x0f\x5c\x73\xed\x07\x16\xe6\x97\xf9\x0c\x7f\x58\xd3\x74\x77\x8f\x2c\xce\xfc\x87\xb5\x50\xd1\xb2\x95\x79\xf5\xea\x2b\xaa\xf7\xed\xf4\x8e\x27\xaa\x77\xe4\xda\x24\xe3\x23\x0d\x87\x61\x56\xb4\xc8\xd5\x27\x74\x7a\x1f\x72\x22\x04\xfa\x42\xec\x27\x76\xfe\x5d\x41\xdd\x59\x19\xf1\xa2\xf5\xe1\x2f\xcf\x4b\x14\xd7\x3f\x19\x86\x92\xd8\x94\x08\x14\xdb\x51\xf7\x11\xc0\x3e\x3d\xd7\x88\x4f\xc9\x3f\x8b\xd4\xa6\x35\x9e\x84\xd4\xe1\x0c\x8a\x03\xa3\xc3\xd6\x45\xe3\xd3\xe9\xd5\x72\x5b\xf4\x22\x27\x39\x5a\xce\x5d\xfb\xab\x71\x28\xc2\x4f\x44\x1a\x31\xb3\x94\xe3\xb6\xf1\xa2\x5e\x20\xdb\xf9\xb0\x75\x61\x59\x8a\xf0\x62\x1b\xfe\x4c\x7b\x13\x68\x2a\xca\xbd\x6e\xd5\x6e\x6f\x7f\x39\x3b\xfd\xd2\xba\x3a\xbc\x3a\xad\x6d\xe6\x74\x40\x53\xff\xfb\xf7\xcc\xe7\x89\xc9\x3b\x3b\x6b\x3d\xe0\x50\xde\x94\xa1\x85\x96\xf0\x05\xf4\xe7\xcc\x1d\x9a\xb1\x90\x03\x78\x71\x22\x53\x46\xbd\x79\x76\xd6\xca\x74\xb9\xcf\xe1\x45\xc6\x01\xbd\x88\xbc\x45\x53\xb1\xe8\xf9\xb9\x53\xb2\x88\x94\xc5\x53\x33\x7f\x7a\xa6\xa6\xe8\x0b\x0d\x5c\x36\x10\x31\xb7\x6b\x2f\xe7\x3f\x9d\x3b\x63\x28\x23\x67\xba\xc0\x80\x0b\xcc\xe1\xcc\x3c\x7e\xa1\xc1\xeb\x57\xa3\x2f\x34\xf8\xcf\xbf\xcc\xef\xe5\xf7\x45\xbd\x2c\x9c\xb8\x2c\xda\xb2\x26\x30\xeb\xbd\x85\x13\x99\x45\xe2\x42\x4d\x2c\xaf\xb7\x39\x18\xaf\x89\xa8\xab\x2b\xe5\x40\x4f\xb6\xd0\x5c\x9c\xd3\xc6\xef\x85\xa4\x73\xbe\xf6\x4d\xa9\x98\xc5\x3e\x78\xd8\x64\x8e\x2e\x99\x68\x83\x58\x3c\xc4\x51\x28\xa0\xc5\x3f\xdc\x6f\x0a\x1c\x0f\x73\xf7\x99\x95\x42\x79\x96\xdd\x5b\xb6\x4c\xe9\x2f\xbc\xad\xe7\xee\x19\x05\x76\xf8\x02\x7b\xc5\xa3\xf6\xf9\xcc\xfd\xe1\x09\x6d\xe8\x2b\x2e\xc8\x25\xd6\xfd\xef\x2b\xdc\xb4\xe7\xac\xf5\x22\xeb\x33\x53\x70\x72\x96\x6a\x8e\xc0\xac\xb4\x60\x17\x0a\xc9\xd3\xbe\x8e\x17\x9c\xfc\xa5\xee\xe7\x32\xb9\x2f\xc4\xa9\x42\x4b\xd7\x49\x5d\xd4\xc0\x7c\xcb\xe6\xf4\x0d\x69\xd1\xcd\x2e\xb9\x19\x2d\x1d\x6b\xb2\xda\x2d\xa8\x42\x93\x68\xc6\x2d\xa7\x9a\xba\x64\x85\x6e\x34\x85\x6e\x33\xb9\x57\xce\x62\x37\x98\xcd\x5c\x2f\x77\x1a\xc3\x4e\x63\xd8\x69\x0c\x1b\xd2\x18\x96\x39\x7f\x66\x6e\x04\x59\xc6\xe1\xe9\x35\x5d\x6d\xbc\xdd\x12\xeb\x77\x1b\x63\xe7\x76\x0a\xd9\x93\x53\xc8\xe6\xaf\xa7\xac\xf5\xb0\x70\x92\x33\x96\x46\xc6\xe4\x2e\xb5\x40\xe6\x4e\xe8\xf6\x06\xe7\x15\x98\xa8\x0a\x94\x5d\x0d\x05\x58\x41\x5d\xa7\xa4\xe9\x0d\x46\xe8\x4b\x4d\x42\x43\x62\x66\xe3\xcc\x3a\xf6\x57\xe4\x5e\x62\xaf\x57\x0d\x98\xfb\xe3\xd1\x46\x62\xf2\xc0\xdf\x04\xa0\xeb\x8f\xc3\xc6\x93\xba\xb1\x86\x20\x59\xdc\x08\xb1\x94\x84\x07\x68\x2b\xca\xd5\xcf\xe3\x47\x4c\xe0\x2e\x9f\x76\x4b\xf3\x69\xb3\x62\x52\x87\xad\x7f\x7c\x2c\x36\x05\x73\xe2\x4a\x9e\x6e\xf8\xb5\x3f\x14\xbf\x79\xcb\x80\xec\xca\xb1\x9c\x67\xee\xe2\x5d\xe2\x51\x9c\xbf\x4c\x5f\x3e\x76\x27\x9b\x48\x25\x84\xe0\xa5\x91\x2a\xe3\x33\xd2\x6c\xd4\x39\x85\x49\x71\xef\x36\x67\x81\xfc\xdc\x6e\x53\x87\x98\xbb\xba\xb2\x7f\xf0\xf5\xbc\x03\x42\x2f\x7a\x6f\xd9\x01\xa1\xff\xe1\x80\xd0\xa5\xda\x7c\xb3\x0a\x97\x5c\x75\x69\xd0\x1b\x8b\x4a\x59\xf0\xac\xd3\x13\x9a\x76\xb2\x43\x67\x44\xdf\x29\x3a\xa3\x52\xee\x41\x0c\xe0\x3d\x27\x58\x28\x8e\xd4\xa1\x3e\xbe\x5f\xef\x5d\x27\x11\x0c\x95\x6c\x2c\x35\x05\x49\xda\x71\xb9\x20\x46\x8a\x0b\xba\x83\x4c\x9d\x89\x3a\x32\xb7\xfa\xfe\x63\xf5\xa4\x24\xc2\xfb\xb9\xd4\xbd\xcd\xab\x38\x50\x1d\x9a\x93\xee\x33\x9b\x09\xae\xeb\x0d\xf3\xb1\xdc\xa6\x94\xc7\x73\x22\x04\xee\x10\x74\xc4\x30\x77\x27\xaa\x37\x9c\x30\x27\x82\x0c\x2a\x8d\xbc\x76\xc5\x98\x37\xf1\xe3\x63\xd0\xe0\x34\x07\x87\x21\x51\xa5\x66\x41\x27\xb6\x2c\xb3\x1f\x28\xec\x02\x35\x00\xc0\xf7\x2a\x2f\xef\xfa\x66\xcc\x95\x67\xe3\x01\x38\x2c\x1c\x72\x00\xc5\x9b\x79\x04\x39\x9c\x60\xa9\xf1\x6a\x7e\x22\x9c\xf8\x43\x74\x19\x09\x39\x89\x8a\x38\x0d\x1c\x3a\xb7\xf5\xf1\x38\x8c\xfe\xd8\x27\x76\x03\x4e\xb1\x67\x2b\xe3\x20\x8e\x1b\xcd\x96\x81\x1e\x45\x6a\x24\xc7\xe7\x2d\xf4\x9e\xb3\x28\x04\xdc\x9c\x12\x04\x42\xcf\xf9\xcb\xe9\xaa\x02\x2f\x2b\x85\x42\x8a\xdb\x06\x3b\xf8\xe8\xb6\x2e\x1f\xc8\x45\x36\xa6\xeb\x55\xfa\xfc\x4a\xdc\xce\x06\x99\xbb\xa2\x9e\x8b\xab\x06\x4c\x7b\x3e\x51\x92\x59\x42\x87\x00\x69\xe6\x06\x50\x89\x79\x20\x46\x86\xed\x31\xa7\x27\xea\x79\x68\x68\x45\xdf\x2c\x61\x8b\xf2\x5c\xdc\x70\x9c\xc5\x3c\xf3\x49\xe8\x31\xb9\xe1\x22\x1f\x32\x26\xa3\x92\x62\x1e\x0a\xa6\x96\xfa\xd4\x23\x86\xae\xfb\xaf\x82\xd6\xcd\xa4\xcf\xc5\x19\x58\x34\x18\x9e\x1f\x9f\x66\xb3\xe6\x92\x3a\x5d\x04\xd6\x32\x74\xea\x52\xc9\xca\x5e\x67\xa6\xa4\xc1\xf0\xb6\xe9\x3b\xa4\xc2\x7a\x08\x4a\x4e\x82\xa1\xef\x90\xec\x6d\x4c\xe2\x60\x63\x20\x2b\x25\x8f\x56\xe2\xe0\xf5\x7f\xfe\x39\x73\xb8\x50\x1e\x89\x1d\x63\x2e\xab\xd1\x60\x4a\xb0\xd1\x8e\x29\x5c\x99\x11\xaa\x09\x07\x73\x99\xcd\x0a\x1e\x60\x97\x4d\x79\x98\xca\xf5\x64\x4c\x76\x30\xd7\xc5\xba\x21\x39\xd1\x74\x0d\x88\x9d\x79\xee\x30\x89\x3d\xa8\x90\x5c\x55\xa9\xfb\x9b\xb4\x87\x67\xb5\x4d\xc3\x31\x01\x25\xaa\x12\x72\x96\xbc\x70\xec\x54\x88\xd2\x98\x1c\xc9\x18\x51\x17\xc0\x48\x39\x76\x62\x48\xc3\xb1\xee\xb3\xa0\xc8\x09\x54\x0f\x57\x90\x43\x92\xb3\xa0\x73\x00\x04\xec\x35\xf7\xbf\x22\xf3\xfa\x81\xc4\x55\x78\x95\x9f\xc5\x27\x5c\xd3\x6d\xbe\x28\x16\xe1\x16\x5f\xd7\x1f\x60\x0c\xe6\xda\x8e\x38\x76\x1a\xc4\xed\x90\x01\xf6\xbc\xcc\x45\x0c\xb2\xf8\x93\x58\x2f\x5a\x5a\xdc\xac\xd4\xeb\xa0\x8a\x63\x1d\x5a\xce\xae\x30\x7e\xc5\xff\xff\xec\xbd\xed\x76\xdb\xb6\xb2\x37\xfe\xfd\x5c\x05\xaa\x7f\xd7\x8e\x15\x87\x62\xdb\x74\xef\x7d\xea\x93\x58\xcb\x76\x9c\xd4\xbb\x71\xe2\x13\x3b\x2f\x6d\x98\x78\x81\x24\x2c\xc1\x22\x01\x96\x00\xf5\xe2\xaa\xdf\xfe\x57\xf2\x5c\xda\x73\x25\xcf\x1a\x80\xa4\x28\x59\x22\x29\x59\x94\xed\x98\xfd\xd0\x58\x12\x09\x80\xc3\xc1\x60\x5e\x7f\x83\x99\xa0\x17\x64\x58\x5a\x05\xbc\xe5\x06\x26\xa5\xdc\x13\xc9\x43\x15\x3e\xb9\x17\x39\xd4\xdd\x58\x8d\x7d\x3c\xa0\x9e\xd4\xb2\x5a\x94\xd1\x9b\xb6\x15\xab\x3d\x86\x77\x35\x68\x53\xe3\x3b\xa1\x7b\x83\xef\x54\x46\xf5\x99\x88\x8b\xdc\x58\x44\x88\x47\xb7\xe2\xf9\x95\x29\xce\xa4\x65\xb5\xec\xb0\x51\x89\x54\x55\x54\x8b\x47\x9f\xfb\xf4\x91\x6f\x7b\xe1\x8d\x9b\x5f\x2a\x61\xa1\xc7\x32\xde\x0b\x12\x96\xe9\x6b\xf7\x8c\x5e\xc0\xc1\x14\x43\xd4\x6b\x07\x8d\x1a\x61\x63\x3e\xe1\x84\x4c\x6a\xd6\xdc\x93\x67\x40\x08\x3b\xc6\xb7\x78\xe4\xc6\x53\x24\x0b\xa9\xd6\xb9\xd0\x09\x09\x61\x82\x3b\x3d\x8d\x7c\x03\x73\x42\x78\x64\x21\x71\xa8\xea\x8d\xb2\x86\xc4\xa8\xa9\x33\x07\xae\x9e\x1d\xfe\xb6\xad\xa9\x78\x2d\x8e\x9f\xaf\xa2\x0d\xa8\x90\x95\x87\x37\x93\x49\xea\xf0\xe6\x43\x0d\x6f\x4a\x60\x01\xb8\xa7\x88\x17\x89\x5b\x88\xcb\xb1\xa2\x23\x28\x1d\xfd\x2e\x79\x81\xf4\xa2\x7c\x2c\x43\x3a\xd4\x22\x2c\xc4\x8e\x09\x61\x1f\x33\x43\x8e\xc5\x04\x93\x85\xdd\xaf\x92\x46\xed\xeb\xf5\x1d\x9b\x49\x73\x26\x88\xe3\xe9\x65\xc4\x11\xe1\xd8\x0f\x5e\x85\xc5\xa9\xe7\x29\x60\x21\xb8\x04\x1d\xfa\xfc\x92\xa2\xad\xb3\x01\x81\x3f\x9a\xd5\xf5\x07\x2b\x52\x9c\xf4\x02\xaa\x6e\xef\x1c\x13\x66\xd2\x6c\x38\x9e\xd7\x2c\xa2\xd3\x4b\x75\x46\xdc\x17\xf3\xbc\x36\x87\xbe\x71\x73\x08\x6e\xb9\x50\x2c\x79\x29\x4a\xb4\xce\x4e\xb8\x18\xd2\x0b\x30\xbc\xad\x09\x45\xaa\xeb\x56\x5e\x1c\xbd\x4e\x97\x33\xb6\x3d\xce\xdd\x2e\x8f\x98\xdb\xd4\x1d\x1d\x35\x3d\xc6\x76\xc4\x5c\x8f\xdc\xb8\x11\x79\xbd\x1f\x1e\xc0\x7e\x98\x23\xdc\xb3\xec\xbe\x68\x6b\x8c\x02\x72\x82\xdd\x1b\x5b\xcb\x15\xc4\x16\x61\xf9\x01\x76\x1b\x37\xb2\x6e\xf4\x18\xb9\xaa\xc0\x28\x20\x4e\x97\xdf\x45\x0a\xc4\x4b\xdb\x42\x33\x1a\x7f\x6d\xd6\xdc\x3b\xb3\x46\xbf\xc9\xfc\xce\x72\xa3\x80\xf4\x68\x81\x92\xf5\x92\x33\x89\xb4\x18\x5e\xf3\x81\x14\x09\x88\x06\x48\xbd\x88\x79\xfe\xaa\x1b\xab\xe5\x7a\xe8\xbc\xbd\xf8\xfe\xe8\x37\x2a\x8b\x8d\xba\x1b\x77\xe7\x54\x9e\xb9\xa4\xdd\x8b\x0e\xc9\x6e\xb5\x77\xa2\x9e\xe1\x70\x26\x31\x65\x24\x1c\x47\x3d\x43\x10\x47\x75\x53\x2e\xca\xa4\x8a\x28\x3c\xd6\xe3\x2a\x34\xf6\x0e\x91\x11\x2d\x22\xda\xf1\x51\x6b\x4d\x7e\xaa\x57\x5a\xfa\xa4\x3b\xf3\xfd\xf1\x91\x65\xb5\xaa\xf6\x52\xd5\xa2\xeb\x4e\xf7\xc4\x84\x73\x34\xf2\xa9\x01\x1e\xc2\x30\x5a\xc8\x85\x6f\x8e\x3e\xe5\xb3\xe0\x82\x8a\xef\x52\x4e\x99\xf7\x8c\x0e\x6f\xd3\xff\x12\x31\x3a\xcc\x0b\xdd\xbf\xb7\x49\xe8\x6c\x3c\x4f\x2b\x91\x3f\xce\x39\xcc\x6d\xc6\xff\x9e\xab\x34\xce\xb5\xda\x09\x2f\xc2\x28\xc0\x25\xdb\x58\xa7\xd2\xa2\xe4\xce\x3b\x1c\x06\x34\x54\xc3\x34\x7e\xfc\x05\xbd\xe1\x7d\xf4\xe3\x2f\xff\xfe\xef\xd9\x6d\xa7\x57\x60\x1c\x40\x2f\xa0\x38\x12\x33\x73\xc5\xab\xa9\x2c\x5f\x7d\xbd\xee\xc9\x5b\x3e\xc3\x24\x33\xe0\x74\xf9\xa3\xae\x7b\xb4\x1a\x26\xf0\x85\x30\xa1\xcb\xa2\xee\xdf\x3e\xc6\x9e\xd7\x84\xcf\xb2\x4b\x7c\x22\xc6\x3e\x77\x23\x8f\x88\xa4\xb1\x70\x39\xe3\xc6\x55\x8b\x5d\x06\x39\xf9\x5a\x56\xf3\x52\xcf\x5b\x97\xbf\x3d\xd8\xf2\x37\xd5\xe7\x54\xf1\xca\x32\x29\x4c\xea\x08\x88\x45\x5c\xbe\x18\x8c\x98\x8c\x2a\x3c\x06\xb2\xe3\xcf\x79\xe3\xf1\xef\xb7\x1c\x52\x8b\xd4\x2a\x94\x93\x7b\x82\xec\x31\x97\x5c\x9e\x0c\x71\x75\xe9\xbd\x89\x24\x03\xf7\x3b\x52\x85\x03\x58\x29\xb4\x93\x34\x44\xcb\x74\x3a\xd4\xb0\x29\xb3\x2c\xf3\xfd\x81\xce\x1e\xb7\x2c\x70\x37\xe9\x0b\xfe\xd1\x6c\xc3\xc4\x5d\xcc\xe4\x91\xfb\x5c\x7d\x57\xa4\x0c\x67\x07\x9c\xb8\x8c\x2c\xab\xbd\x5e\x63\x22\x02\xd2\x15\x25\x0d\xbf\xf7\xed\x10\x3b\x7c\x1d\x9a\x71\x3c\x94\xf1\x21\xbf\x70\x67\x96\xf6\xc1\xf5\x1c\x50\x75\x92\x44\xf1\xca\x6e\xee\x23\x98\x1a\xa9\x86\x04\x44\xa8\x86\x04\xac\x21\x01\x6b\x48\xc0\xfc\x97\x80\x6a\x48\xc0\x55\x20\x01\x8b\xce\x24\x2d\x8a\x73\x4f\x24\x66\xf3\x88\x39\x45\x65\x1a\x71\x19\x57\xb2\xc2\xc6\x6b\xcc\x5c\x60\xc2\x13\x55\x81\x09\xdd\x90\xcb\xe4\x34\x7c\x32\x92\xe9\x0c\xb8\xf1\xc8\xcd\x4d\x61\x2b\x32\x32\xed\xa4\x95\xf8\xda\x1d\x83\x51\xbc\xca\x7c\xc2\x5d\xef\x19\x77\x6b\xb1\xbb\x65\xfa\xc1\xad\x8f\x48\xd7\xfb\xbe\xcd\x25\x94\xc0\x36\xf5\x3c\x5c\x61\xf1\xcb\x66\x3c\x2e\xf1\x73\xe4\x31\x45\x24\xe2\x2c\x8f\x4a\x2a\x26\x12\x55\xc2\xa5\x7d\x8d\x7a\xa4\x4a\x7d\x22\x72\xae\x33\x57\xac\xc6\x86\x4a\xfc\xd3\xa7\x5c\xf8\xc6\x49\xf8\x2a\xa2\x20\x20\xf2\x09\x01\x17\xa2\xb7\xcc\x86\x0a\xee\xc9\xc5\xd5\xd5\x94\x08\x89\x25\x75\x2c\xab\x05\x4f\xd0\xd1\x2b\x7c\xbc\x56\xbf\x54\xdd\xa5\xeb\xdb\xeb\x0e\x3a\x20\x76\x80\x9d\x5e\xb9\xb7\x3a\x27\x25\x0b\xdd\x5f\xf4\x38\xa5\x77\xe8\xc7\x6f\x69\x82\x99\x65\x28\x76\xbd\xb5\xe0\x43\x6c\x7b\x7e\xbd\x45\xe0\xdf\x65\x24\x6b\x2c\x97\x8a\x04\xec\x6b\xda\x2b\x50\xda\x5e\xd3\x3e\x41\x07\x5d\x2c\xd7\x1e\x96\x0d\x3d\xda\x23\x7a\x4f\x4f\xef\xe8\xf4\x47\x8f\xda\xd9\xdf\xd7\x7c\x10\xeb\x39\x8a\x28\xf4\x0e\xfc\xde\xf7\x5d\xeb\x50\xee\x3b\x41\xc2\x10\x1e\xa6\xe8\x89\x3f\x70\xea\x90\xfb\xfe\xc4\xf0\xb4\x7d\x78\x90\xfc\xa7\xed\xe2\x2e\x75\x97\x02\x10\xc2\xc1\xba\x73\x89\x2f\x85\x19\xc5\x0b\x51\x7c\xfe\x7d\x5d\xa6\x58\x63\xc5\x7e\x63\x58\xb1\x39\x44\x7a\x1b\x10\xf6\x1a\x8f\x48\x58\xf2\xa4\x9f\xec\x40\xb4\x81\x04\x4d\x1e\x10\xe6\xe9\xd5\x6d\x46\x51\x9a\x4c\xb8\x74\x24\x2b\x16\x22\x79\x12\xef\xc3\xd1\x87\x0f\x6f\xef\x66\xdb\xd7\x82\x27\xec\xd3\x7e\x9f\xe7\x55\x87\x7e\x38\x31\xf6\x4e\x4f\xaa\x0d\x39\x61\x65\x0e\xed\xc6\x42\x0c\xed\x8f\x90\x9e\x15\x9d\x76\x79\x10\x80\xf8\x84\xb0\xd7\x33\x13\xef\x16\xc5\x93\xfa\xe2\xe9\x3f\x7f\x58\xab\xd1\x58\xc7\x3d\xea\xb8\x47\x1d\xf7\xa8\xe3\x1e\x45\x2f\x03\xd5\x71\x8f\xf2\x67\x6a\x3f\x80\x9b\xf2\x0e\xd4\xb3\xc3\x4f\xeb\x02\xa7\xca\xec\x69\x18\x16\x1d\xbd\xcd\xa0\x6c\x26\x38\x4c\x3b\xa8\xd1\x97\xe4\xf6\xb2\x06\x81\x98\xb0\x80\x14\xfe\x76\x11\x55\xd0\x11\x93\xa4\xa3\xd2\x6e\xd1\xa9\xe4\x61\x65\x18\x5e\xb0\x1a\x83\xa6\x93\x19\x42\x4d\x76\x8b\xaa\x8a\xc7\x2f\x71\xbc\x1e\x17\x17\xa0\x3a\x7c\xc0\xd8\xa5\xac\xc2\xdc\xf0\x22\x3d\x44\xcd\xbf\xcf\xb9\x14\x32\xc4\x41\x36\xec\x73\x83\xa0\xcf\x42\x25\x05\xdc\xd5\x0f\xdc\xa1\x77\x89\xfb\x78\xa9\x0a\x28\xfd\x8a\x72\x85\x10\x66\xc5\xb1\xb1\x19\xa4\xdb\x65\x77\x59\x32\x47\xbe\x82\x6c\x73\x77\xa4\xb1\xe3\xb6\xda\x3b\x2f\xa8\x70\x22\x01\x9a\xa1\x80\x40\xed\xb8\xaf\x87\x68\xd6\x3d\x35\x1e\x40\x69\x50\xfc\xb2\x2f\x78\x18\xf9\xb9\x91\xdd\x0f\x38\x64\x54\x74\x0b\x8c\x52\x48\x90\x8e\x71\xb9\x8b\x38\xf7\x03\xc5\xea\x58\xd0\xe3\x6e\xb5\x77\x90\x65\x6d\xc5\xb3\x4c\xd4\x62\xcb\x6a\x66\xde\x52\xe3\x93\x91\xac\x63\x3a\x0b\x7b\xf2\x83\xb1\xa7\xb2\x0c\x73\x7e\xef\x90\xc5\x3f\xce\xcb\xf0\xce\xfc\xfc\x2b\x17\x32\x26\xc8\xf2\xa9\x0c\x6b\x77\x15\xc7\xa4\x33\x1c\x58\x74\xee\xbb\x23\xcc\xc5\xeb\x3b\xd7\xfb\x30\x9c\xd1\xa5\x92\xba\xb7\x4f\x08\xb5\x98\x5c\xa9\x4b\xf8\x10\x57\x8e\xda\x9b\x5a\xc0\x7e\x47\x27\xbb\x26\x20\x4c\x56\xe3\xcb\xb6\x02\x21\xa4\xac\x63\x59\xad\x3e\xac\xa6\x00\x8f\x29\x1e\xab\xcc\x5d\x37\xa7\x1e\x1f\xe6\x53\x8f\xba\x84\xff\xa7\xa0\xbc\x4b\x5d\x84\x4e\xb2\x0e\xb8\x95\xf3\x1c\x92\xba\xb8\x3e\x8c\x69\x5c\x8a\x6d\xab\x51\xe8\xa5\xb9\x22\x4c\x43\x7f\x31\x22\x4d\xc7\x54\xb7\x56\x10\x8b\x52\xe3\xe6\x63\x49\x7e\xa0\x9d\xd7\x94\xf5\x6e\x0d\x24\x3a\x9e\x01\x80\x4e\x55\xc4\xde\x34\xd5\x3f\x7d\xda\x81\x6a\x92\x18\xfb\x04\x07\xd4\x1c\xf7\x3b\x1e\xd3\x65\x3a\xcd\x75\x53\x49\xcd\x55\x40\x25\x9b\x6f\x12\xa7\x5e\x67\x25\xf4\x61\x5a\x4d\x03\xc0\x9c\xef\xf0\xa0\xcb\x25\x60\x1b\xeb\xef\x0a\x2b\x2d\x57\x19\xe5\xe6\x46\x1c\x4c\x57\x40\x4c\x46\xa4\x24\x55\xd1\x53\x11\x10\x36\x64\x87\x19\x6d\x32\x94\x9b\x83\x91\xeb\xc7\x4f\x96\xff\xf4\x3e\xe1\x95\x4b\xa7\xad\xf6\x0e\x54\x64\x05\x38\xc4\xfe\x58\x65\x22\xea\x92\xac\x3e\xcc\x1e\xef\x2a\x9f\xf3\x0e\xf6\x38\x0f\xc6\x31\x0e\x1f\x5c\x11\xa8\x59\x15\xd7\xa4\x57\x36\x1b\x1b\xf2\xc4\xfb\xa4\x80\x71\x42\x19\x91\xe3\xca\x0b\x40\x5c\xda\xd7\xb6\x46\xdf\x3f\xc6\x94\x81\x8d\x51\xb5\x69\xf1\x1f\xce\x7d\x0f\x57\x53\x31\xf8\xc9\x38\xe0\x4c\x12\x26\x8d\x43\x06\x38\xe0\x89\xb1\xa0\xe7\xfc\x0e\xa5\xaa\x6b\x79\xbf\x39\xb0\x57\x72\xfe\x29\x4a\x0d\x42\x1c\x04\x24\x3c\x0f\xad\xc6\x78\x52\x0a\x98\xe9\x81\xb8\xd5\xde\xb9\x20\xc4\x1d\x3b\xdc\x0f\x38\x23\x4c\x8a\xa6\xe9\x70\xff\x7c\xfc\x4c\x62\xdb\x23\x53\x27\x69\x40\x3d\xaf\xb9\x26\xef\xfb\x6c\xfd\x47\xfc\xd0\xa0\xbd\xd7\x75\x7f\x75\xdd\x5f\x91\x6b\xf7\x52\xb1\xcb\x32\x11\xd3\xbe\x12\x52\x3e\x94\x5a\xe5\x05\x15\xe1\x2a\x2e\x0a\x0e\x82\x99\xe0\x7d\x29\x17\x6f\x32\x32\xbc\xd1\x1f\x8c\x5f\xe6\xc0\xa0\xdc\x0c\x71\xe5\x60\xaa\xe7\x53\xfa\x09\xfd\x03\x9a\x41\xfd\x0f\xb2\x2c\xf7\xaf\x9f\xff\x46\x2a\xf8\x4e\x59\x0f\x9d\xf2\x0b\x99\x69\x9d\x84\x50\xe3\x37\x32\x1a\x70\xf0\x14\xc1\xfd\xe9\x75\xc9\xb2\xd1\x69\x80\xc3\x3f\xbd\xc6\x0d\xd4\x0f\x3d\x50\x4b\x05\xbc\x29\xeb\x89\x41\xbe\x4b\x99\x8a\x08\x7b\xd0\x45\xfa\xd5\xfb\xa3\xdb\xf3\x97\x2a\xc5\x16\xfa\x0a\x75\x86\xdf\x2f\xd3\x9e\x69\x7a\xf9\x75\x74\xb7\x8e\xee\xd6\xd1\xdd\x3a\xba\x5b\xea\x25\xa0\x3a\xba\x5b\x51\x74\xb7\x43\xaf\x7c\xae\x23\x99\x41\xc8\xdd\xc8\x91\xc2\xec\x2b\x41\x6d\x0c\x88\x6d\x74\x22\x5a\xe2\x38\x82\x71\xd1\xdb\x40\x52\x9f\x5e\x15\x81\xd1\xde\xb4\x66\xe7\x3b\xc3\x40\x5b\xed\x9d\x53\x89\x43\x39\x3e\x64\x6e\x13\x2d\x5a\x07\xda\x6b\x9f\x8e\x98\xd3\x0d\x39\xe3\x91\x40\xd0\xe1\x08\x19\x46\xa1\xc3\xcb\x25\x7d\x65\x53\xc2\xa0\x31\xcd\x78\x32\xe4\xda\xdd\x84\x4a\x0d\x18\xf0\x12\xc7\xfe\x09\x96\xdd\x5b\xe9\x1f\xa3\x29\x11\x60\xd9\x4d\xea\x54\x94\xf3\x54\x10\x80\x12\xa3\x52\x39\xc1\xb6\x75\x2f\x18\x93\x32\xc7\x8b\x5c\x52\x81\x7f\x30\xee\x10\xa0\xe6\xd5\x9b\x8f\xd8\x06\x4e\x9e\xd8\x10\xb1\xee\x66\x4e\x56\xbb\x90\x9c\xdc\x8b\xe0\x30\x47\x5b\x1f\x7e\x6c\x56\xdf\x3f\x56\x7b\xac\xa7\x7a\x44\x99\x7d\x11\x5c\x50\x8f\x08\xb3\x88\x17\xcd\x7e\xbc\xd8\x4c\x50\x3a\xa9\x1c\x5a\x63\x48\xba\xd6\xac\x6a\xcd\xaa\xd6\xac\x6a\xcd\xaa\xd6\xac\xd6\xa1\x59\x25\xf7\x24\xb2\x3b\xd7\x4f\x3c\x39\x8c\x7e\xaa\xf8\x30\x52\x79\x29\xea\x30\x52\xb5\xac\x7d\x66\x04\xb8\x43\x74\xdb\xec\x8a\x5d\xc6\x7b\xac\x13\x79\x38\xfc\xcf\xe9\xdd\x28\xe8\x05\x8c\x37\xda\x1f\xc3\x6f\xda\xe3\xcb\x3a\x06\x0e\x82\xe7\xd3\x87\xcf\x33\xfd\xed\x32\xe5\xbe\x58\x3f\xe7\xd2\xf5\xbd\x50\x21\xdc\x0e\x9d\xcf\x2d\xcb\x72\xbf\x3c\x6e\x3e\x6e\x9a\xf1\x48\xf9\x15\xbf\xf1\x45\x9b\xad\xf9\x8d\x27\x5d\xb2\x1e\xb3\xf4\x7e\x88\x96\x42\x81\x58\x0f\x5c\xac\xde\x0f\x00\x13\x4b\x9a\x46\x61\xd5\x42\x44\x4a\xbc\xdf\xc6\xcc\x39\x6a\xf6\xa3\x8a\x7b\x2c\xf7\xa3\x02\xf8\x88\x37\xd1\x50\x6e\x94\xb6\x10\x9b\x52\xf2\x46\x85\x5d\xce\xcf\x59\x34\x04\x68\x85\x94\x44\xcf\x34\x71\xf5\x35\xbb\x5a\xe4\x5b\x56\xeb\xfc\xfc\xcd\xfb\x4f\x67\xe7\xe7\x85\xba\xb1\x1a\xd0\x5c\x97\x0e\x3c\xc5\x79\xe8\xae\x20\x0e\x4c\x73\x65\x39\x29\x54\x8e\x43\x97\xe3\x52\x54\x71\x66\xdd\x14\xf7\x96\x92\x28\xf0\xf2\xf3\x19\xfe\xe3\x53\xa4\x5a\x1b\x23\x9d\x78\xb6\xb6\xe4\xba\x59\xab\x64\x7a\x9e\x72\xad\x85\x32\xde\x0c\xf5\xb2\x67\xd6\x5a\xf1\x79\xfc\x91\x87\xee\x49\x48\x84\x28\x1f\xc5\x9d\x62\x9b\x0c\x66\x7e\x49\x7b\x0f\x2c\x61\x98\x39\x24\xde\x73\x6b\x72\x96\x04\x50\xee\x0b\x9b\x1e\x00\x11\x12\xd8\x95\x52\x5b\x04\x06\x44\x30\xda\x67\xab\xf1\xe8\x8b\x42\x72\x15\x5d\x42\xa4\xfa\x88\x34\xaa\xcb\x20\x30\xb6\xda\x3b\x8e\x8e\x28\x8f\x63\xdf\x04\x00\xb8\x4e\x9f\xf3\x71\x74\xf8\xb1\x8e\xe9\xaa\x01\xb0\x1f\x18\x83\xc0\x68\x3c\xb9\x36\xa7\x1a\x59\x58\x96\xbb\x0d\x2b\x0f\xb2\x0e\xa1\x72\xbb\x73\xf1\xaa\x56\x8b\x12\x7f\x4d\x5f\x26\x2a\xcc\xa6\xad\xa3\xc4\xdf\x64\x94\xf8\x49\xf1\xcb\x9c\x2d\xc3\x2e\xf1\x3a\xe7\x94\x63\x2f\xf3\x0a\xef\x37\x99\xaf\x95\x6f\x2f\x67\x31\xf2\xd0\x0d\x60\x4b\x2e\x5b\xbf\x3c\x78\x6a\x40\x3b\x71\x6d\x03\x27\x83\x18\x81\x17\x75\x28\x13\xe6\xe0\xa9\xa1\x3a\xe4\xeb\xa4\xe4\xc5\xc7\xde\x01\x8f\x98\xac\xca\x0f\x5f\xa0\x95\x0d\x9e\x3a\x7a\xf6\x38\x73\xab\xba\xee\xe3\x9a\x5e\xf1\x6c\x79\x36\xc5\xc7\xc3\xfd\x4f\x27\x7b\xbf\x57\x6a\x5d\x9f\x5c\x47\x83\xb5\xb2\xc6\x0f\x1c\x16\xc4\x1e\x06\x78\x14\x37\xd1\x6d\xec\xc6\xcb\x7a\xb6\x21\xac\xb1\x78\xfa\x5c\x42\xfd\x7a\x7c\x70\xba\x59\x9c\xfb\xf5\x73\x44\xd7\x77\x72\xb3\x88\x3f\x9e\xa0\x77\xdc\xe9\x11\x59\xa1\x42\x98\x4c\x31\xab\x0b\x66\xaa\x1e\xf4\x05\xc6\x9b\x0e\x65\x43\x63\x7f\x14\x60\x21\x4a\xb5\x21\x4e\x15\xc6\xf4\x31\x6a\x5d\xb1\xd6\x15\x6b\x5d\xb1\xd6\x15\x6b\x5d\xf1\x01\xe9\x8a\x81\x11\x2a\xe9\xdf\xf2\x49\xce\x41\x77\xc8\x3a\x94\x15\x78\x3e\x4e\x30\x2e\x46\xc9\x1f\x04\xc4\xb0\x41\x91\x63\x9b\xa8\x4a\xab\xcf\xa2\xfa\x2c\xaa\xcf\xa2\xfa\x2c\xaa\xcf\xa2\x3b\x7f\x16\xa9\xbb\x02\xa2\xce\x99\x5c\xab\x0b\x87\x41\x15\x2d\xfd\xbf\xc2\xc0\xe6\x16\xc8\x35\x1d\xb8\x80\xbf\x9a\xdb\xcd\xf6\xf7\xb7\xd3\xd7\xf0\x57\x2c\x7a\xc4\xf3\x1e\x38\xbe\xc7\x80\xf6\x68\xab\xab\x49\xa1\x8e\xc8\x84\x2c\x4b\xb8\xc2\x84\xc4\x4e\x0f\x77\x54\xed\xbd\x19\xe8\xbf\xcd\x01\xb0\xd1\x22\x16\x23\xf6\x4f\xc1\x68\x7d\x65\x11\x73\x04\xf4\x40\x4f\xb1\x9a\x63\x4c\xdd\x5b\xe4\x07\xbb\x7e\x4a\xce\x99\xb2\x1c\x02\xc8\x48\x76\x39\xdb\x3c\x08\x08\x94\x47\x8f\x2d\x4b\x34\xf5\x02\x16\xe6\x93\xdd\x61\x94\x0f\xb5\xf0\xb2\xa0\xe6\x75\xa3\x75\xf4\x4d\x34\x5a\xd7\x2e\xd2\x9f\x82\x7c\x0f\x69\x89\xd2\xab\x4d\x80\x87\xce\x13\x13\xaf\xde\x1f\xcd\xd6\xc7\x2e\x27\x30\x48\xe8\x6d\x5e\x5c\x58\x96\x0d\x13\x5b\x96\x0d\x75\xae\x6d\xb3\xdd\x6f\xdf\x37\x71\x41\xc2\xa5\x3b\x2e\x0e\x88\xdd\x89\x68\x6e\xf2\x02\xb1\x4f\x22\xdb\xa3\xa2\x4b\xc2\xbb\xc7\x6f\x1f\x0f\xf7\x2d\x6b\x3c\x59\xe0\xaa\x1e\x7b\xe1\x60\xc6\x88\x6c\xb9\xbd\x1c\x3a\x9c\x52\x49\xd0\xa7\x7f\x96\x6b\xac\xb4\x69\x4a\x1c\x31\x87\xfb\xc4\xa5\x18\x4d\x56\x8a\xb6\x2c\x6b\xb0\x8d\x8a\xf7\x62\xb1\x3c\x82\x6f\x86\xff\x2c\x10\x49\x2e\xe9\xaf\x81\x38\x5a\x3d\x7a\x41\xfa\xa7\xa1\x53\x36\x14\x81\x3e\x63\xe3\x6a\xcf\xf8\xe3\x07\xe3\x97\xf3\x2f\xfa\x89\xcd\xf8\xdf\x9d\x2f\xdb\x48\x8f\x07\x85\xb6\x96\xe5\x4e\x84\x53\x6e\x15\xce\x32\x42\xef\x70\xff\xc5\xe1\x87\xef\x57\x2f\xc0\x55\x5b\x91\x32\x97\xf4\x93\x92\x12\x97\xf4\x4d\xf8\x62\xd8\x52\x8f\xbb\x98\xe6\x74\x58\x7d\x22\x5d\x61\x1d\xae\x3d\x80\x85\x54\x11\x5b\x85\x71\x0b\xb8\xce\x2f\x74\x2d\x1e\x84\xa3\x40\x72\xb8\x2e\x5c\x15\xf1\x29\x05\xfb\xd8\x06\xcc\xa7\xcf\x96\xf5\xc8\x6a\x7c\xc9\x84\x34\x61\x70\xf0\x65\x5d\x99\x6a\x1a\xcb\x6a\xf7\xc8\xe8\xf9\xe6\x42\xaa\xbe\xb2\x7b\xaf\xcc\x1c\x3a\x89\x9b\x37\x29\xcd\x54\x09\x10\x5b\xc4\x91\x75\x7d\x4e\x5a\xd6\x7a\xcb\xe6\x57\x14\x53\x05\xbc\x22\x4a\x44\x5c\xe7\x34\xfc\x59\x19\x9f\x68\x3b\xf5\xb0\x42\x99\x98\x9e\x5d\x7d\xcc\x64\x9f\x26\xd0\x1f\x58\xe7\x80\x6b\x40\x23\x75\x8b\x10\xed\x1d\xd3\xdc\x10\x13\x11\xd6\x02\x73\x39\x80\x33\x44\xd9\xba\xf0\xc9\x84\xc3\x24\x0d\xef\x2e\x22\xea\x09\x10\x4b\xde\x41\xed\x60\xb2\xb6\x9b\xf0\x54\xa0\x86\x28\xe2\x2c\xec\x91\xfb\x9c\xb2\xa0\x9f\xa0\xe5\x92\xbc\x67\x04\xb5\xe2\x20\x24\x8a\xbe\x77\xf2\x6d\x67\x16\x88\xec\x11\xea\x72\x21\x25\x71\xba\x93\xdd\x36\x10\xce\x79\x48\xa0\xd1\xa1\xcf\xdd\xb9\x10\x8f\x35\xac\xe9\xed\x59\x30\x75\x3b\x97\xb5\xb6\x73\xa9\x4b\x09\xee\x57\x29\x01\x98\x01\x89\xc8\x6a\x39\xdd\xc4\xee\x72\x62\x89\x9b\x2f\x98\xf7\x71\x8f\xdc\x5d\xa9\xac\x57\x57\x0b\xda\x5a\xd0\xde\x5b\x41\x5b\xce\x49\x62\x03\xa3\xff\xa4\x14\x68\xc2\xcc\x2e\xf7\x49\x2b\xe8\xe6\xc5\x8a\x64\x48\x7a\xbd\xcd\xa6\x48\xaf\x3f\xd9\x35\x7e\x8c\x02\x1d\x59\x82\xe6\x25\xaa\x85\x65\xa1\x7e\x67\x02\xb1\xf8\xe2\xe0\xf4\xe8\xf8\x15\xc0\x5d\xec\x7e\xd9\x1e\xa4\x0b\xd8\x0c\x5d\x78\xe8\xb9\x03\xea\x92\x56\x3a\x71\x3e\x79\x88\xed\x8d\x36\x25\xbf\x93\xbd\xec\xb2\x38\x13\x87\xb8\x54\xfa\x23\x78\x84\x95\xa0\x65\x6a\xf9\x5d\xcb\xef\xfb\x2c\xbf\x53\x27\xac\xda\x85\xf9\xfb\xb4\xe3\xf1\xf5\x79\x90\x50\x32\xa2\x71\x16\x62\x26\x3c\xe8\x9a\xb3\x52\x66\x63\x76\x47\xb7\x06\x6a\xc8\x78\x27\x4f\x6c\xdf\xc0\x88\xb3\xe6\xcc\xb4\x92\x47\x3f\xcd\x9a\xdd\x91\x8a\x90\x30\x70\xc1\x79\x70\x45\x6f\x43\xde\xb5\xfe\x25\xbb\x80\x18\x15\x2e\x99\xfe\x70\x45\x1b\x37\xf1\xd0\x5e\xd1\x78\xca\xb9\xc4\xa0\xd0\x04\xa0\x13\x46\x41\x40\x58\xb5\xf0\x1b\x10\x2d\xf9\x78\xf4\xdb\xd1\x9b\x57\xaf\xde\xbd\x3f\x39\x39\x7c\xb3\x29\x27\x75\xf6\x11\x5b\x82\x98\x39\xb4\xe8\x61\x20\x48\x41\x0a\x0d\xed\x51\xb1\xc6\x22\x29\x5d\xcd\x92\xce\xbd\x06\xcb\x67\x7a\xac\x55\x54\x08\x18\x41\xe5\x30\xe5\x05\x68\x63\x4c\xa1\x83\xc3\x7c\x7a\x2d\xc0\x0d\x2a\xe5\xed\xb7\x2c\xfb\x23\x65\x07\x87\x96\x65\xdf\xa6\x83\x7f\x0a\x9b\xa7\x88\x1e\x53\x18\x4b\x15\xd0\xe4\x1a\x4e\xd2\x1d\xa0\xc9\x75\xbc\xa2\x05\x14\x2a\x30\x38\x8e\xb9\x4d\x3d\x92\x75\x04\x3d\xd9\x60\x3c\x71\xab\xbd\x73\x0e\x7a\x08\x74\xe3\x86\x7f\xc7\x03\xca\x7a\xcd\x29\x74\x99\xb5\x29\xe9\x94\xf5\x24\xe7\x5e\x8f\xca\x82\x3d\x26\x24\x67\x44\x31\x95\x47\x24\x02\x74\x78\xac\xe2\x89\x15\xe4\x92\x5e\x9b\x6d\xab\xbd\x93\x4e\x38\xd6\xb5\x14\x4d\xd4\x9f\xa7\xe1\x5e\x2f\x01\x8c\xc7\xda\x6a\xef\xb4\x8a\xd1\x20\x36\x63\x18\xc5\x4b\x6a\x09\x1e\x85\x0e\xb9\xe0\x61\x87\xe4\xe1\x7e\x7f\xa4\xc3\x1b\xaa\x09\x8a\x2e\x1f\xe9\xd0\x78\xa7\x3c\xff\x24\x34\x52\x62\xcf\xf4\x80\xd2\x17\xfd\x19\x11\x21\x8d\x23\x77\xfe\xef\xfa\x5e\x63\x2f\x94\xf4\x02\x3b\x99\xeb\x56\x62\x76\x21\xb1\xa4\x0e\xe8\x6c\x74\x98\xfe\xbd\xf6\x16\x40\x83\x82\x28\x3a\xf7\x2e\xd0\xa4\x4a\x65\xdd\xed\x45\xa0\xf5\xc1\xf4\x39\x3b\xe0\xde\x85\xe3\x0b\x5d\xb8\xa2\xbe\xd9\x4d\xd6\x00\x17\x9b\x78\xb7\xd9\x46\x94\x09\xea\x92\xf1\x59\x17\xb3\x1e\x1a\xf1\x08\x5d\xf0\x10\x45\xaa\xa3\xce\x33\x3c\x7d\x53\xdd\xc7\xee\x21\xf4\xb1\x53\x86\x85\xe6\x9c\x5c\x69\xcd\x3d\xe9\x61\x1b\x1d\x70\xdf\x8f\x18\x95\xa3\xc9\x49\x55\x21\x58\x7d\x12\xa9\xc0\x21\xfa\x78\xf0\xf2\xfc\x64\xef\xec\x57\xc5\xa3\x45\xf0\x51\x1f\x0f\x5e\x5a\x56\x6b\xb5\xd3\xad\x66\xd7\x7b\xc0\xae\xc0\x8b\xf9\xc2\x97\x1f\x24\x26\x5d\xf5\x96\xdf\xb5\xc9\xa6\x8a\x01\x1f\x7d\xfe\xfa\xe8\xcb\xf6\x23\x68\xe5\xf3\x68\xc0\x79\x32\x21\x14\xdc\x79\x78\xc4\x23\x39\x16\x3e\xf6\x3c\xe1\x84\x84\xb0\xb1\x36\x77\xbc\xa6\xe1\x08\xf1\x08\x69\x01\xff\x48\xd9\x7b\x90\xb7\xa2\xf1\x98\xe7\x39\x1d\x26\x03\x9b\x58\x08\x22\x85\xe9\x88\xa9\xaf\x01\x87\x30\x9e\xcf\xc8\x4c\xa8\xc2\x77\x8e\x10\x90\xd1\x3d\x49\xe4\x7e\x54\x08\xd0\x36\x3d\xf2\x54\x28\x30\xcd\x10\x7f\x9e\xb6\xfe\xc8\x85\x33\xbe\x66\xe4\x4d\xe8\x79\xa3\xa4\xe7\xba\xa2\x14\xa1\xba\xa2\x34\xf9\xaf\xae\x28\xad\x2b\x4a\xeb\x8a\xd2\xbb\x81\x84\xc5\xb9\xec\x12\x9f\x88\x18\x0b\x8b\x67\x0f\xe1\x05\xc7\x79\x10\xe2\x5b\xc1\xbc\x9a\xd8\x92\x6a\x0d\x95\x18\x92\x9a\x24\x41\x88\x0b\x54\x1a\x81\x8b\x8d\xc9\x27\x9b\x4f\x8d\x9c\x23\xab\xb9\xc0\x37\x49\xdd\x87\xfb\x5b\x6c\x71\x8a\xfe\xcc\xc1\x5e\x86\x1c\x99\xc3\x3c\xc7\xa5\xb1\xcc\x01\x9e\x9b\xa8\x7c\xe3\x43\xbb\xd4\x81\x5d\x78\x58\xe7\x29\x70\xb9\x07\x74\xd9\x17\xbd\xf0\x50\xae\x4d\xab\x3b\x67\x5a\xd5\x11\xed\x75\x44\xb4\x67\x0f\xbd\x5c\x01\x85\x4e\xa3\x80\x84\xeb\x06\x29\xfe\x78\x62\xa8\x71\xa7\xba\xee\x97\x85\x96\x9b\xba\xb5\xc6\xf4\xa9\x2d\xb0\xda\x02\xab\x2d\xb0\xda\x02\x7b\x38\x16\xd8\xd5\x2f\x2d\xca\x41\x3a\x08\x75\x10\x28\xbc\xe1\xc5\x89\x23\x7c\x70\x85\xd1\xb1\x2a\x82\x2e\x13\xfd\xcf\x5e\xb9\x6a\xba\xa9\xa4\xd2\x23\xbb\xd7\x67\x86\x5a\xe3\x6d\xb4\xa5\xdc\x8b\x83\x6d\x04\x85\xcf\xd0\x5d\xa7\xd9\x8e\xa5\x64\x5a\x32\x09\x68\x03\x76\x44\x3d\xa8\x4c\xde\x6e\xb6\x67\x9d\x8f\x95\x66\xed\xc2\xaa\xf3\xcc\xb8\x4f\xc6\x41\xd5\x8d\xe3\x33\xa9\x38\x7a\x36\xd5\x65\x5c\xd1\xa2\xd9\x4e\xc2\x7c\x19\x18\xe3\x14\xc5\x78\x68\x38\x38\x8c\x53\xdc\x4c\x1d\x55\x7c\xbc\x9b\x31\x36\xa6\xee\xd4\xed\xee\xb4\xaf\x77\xfc\x8f\xe6\x10\x6e\x3d\xbf\xe0\xa1\x7f\x4e\xdd\xe7\x50\x3a\x0e\xfd\x6e\xfe\x7a\xfa\xd3\xdf\x5b\xed\x9d\x7f\x8c\xbf\x6f\x16\x1a\x24\xa2\x47\x19\xf4\x62\xf7\x39\x3b\xd7\x1d\xf4\x7c\xee\x46\xf0\xef\x89\x6e\x5e\x79\x0e\xdd\x1f\x39\x13\xe6\x45\xc4\x9c\x65\xc1\x76\xae\x53\xa2\xb6\x53\xee\x67\x08\x48\x73\x69\xee\x1e\xdb\x3b\x3e\x39\x59\x53\x16\x49\x81\x94\x52\x53\x01\x57\xc5\xbd\x0e\x51\xa6\x70\xbb\xd9\x7e\x66\xea\xab\x56\xcb\x7c\xc3\x91\xec\x6a\xe6\xfd\x0d\x53\xf4\x56\x0c\xb0\xe7\xa2\x53\x42\x5d\x6f\xa5\xb2\x9f\xbd\x20\x63\x85\xa0\xe2\xee\x8f\x53\xe4\x41\x2b\x40\x45\xe9\x09\xb7\xda\x3b\xdf\x8f\x27\xd5\x63\xe3\xcf\x5f\x4d\xe3\x4b\x73\x1c\x43\x49\xd9\xcd\x5f\xcf\xce\x4e\x5e\x34\xef\x03\x9f\x62\xf5\x3c\x65\xb1\xa3\x6a\x93\xba\x04\x91\x6a\x81\x7a\xcb\x8e\x9f\x1a\x18\x6a\x73\xc0\x50\x5a\x7e\x5c\x84\x54\xd5\x2d\xc5\xd5\x6c\x43\xec\x07\x41\x2e\x20\xcd\xa7\xe3\xfd\x22\x9d\x5b\x08\xdc\x21\x68\x9f\xe3\xd0\xbd\x49\xeb\xed\xac\xe2\x78\xbc\xbf\x39\xa5\x79\xe8\xdb\x17\x3c\x8c\xfc\xdc\x33\xfd\xed\xdb\x93\xd3\xbb\x57\x8a\xab\x97\x55\x6b\x92\xf7\x53\x93\xe4\x3c\xc8\xf5\xe3\x7e\x7a\x47\x3a\x87\xc3\xa0\x34\x1a\xd4\x0c\xf0\xe3\xba\x62\x90\xc3\x90\x74\xc8\x30\x28\xd3\x9a\x32\x83\xec\x18\xdf\xb5\x10\xda\x31\x19\xb6\x92\x24\xf0\x64\xec\xbc\x1d\x5d\x69\x3d\xca\x33\xf8\x02\xec\x55\x85\x8f\x30\x84\x8a\x0f\xe5\x01\x5f\x51\x17\x87\xfb\x1b\x3b\xa8\xa1\x06\x5a\x61\xbf\x03\x93\x3c\x70\xe0\xe0\x4b\xdc\xc7\x4b\x15\x7f\xc3\xc9\x50\x54\xa9\xf3\x09\x5f\xe2\xe1\xad\xef\x4f\x58\xc4\xb9\xc3\x43\x52\xcd\x5e\x82\xe1\x8d\x20\xe4\x97\xc4\x91\xf9\xc4\x60\x38\xa4\xfc\xae\x40\x26\xcd\x9e\x95\x43\xbd\x3c\x24\xba\x3c\x80\xea\x9a\x01\x0e\x49\x63\x75\xa2\xa8\xc1\x72\xc0\x95\x3e\x11\xf6\x92\x87\xbc\x7a\xdd\x69\xab\xbd\xa3\x81\x82\xa1\xe6\x7b\x28\x09\x73\x2d\x6b\x4b\x86\x11\x79\x82\xe2\x35\x8c\x5f\x82\x7a\x83\x92\x87\x56\x0a\x96\xfe\xe5\xff\xfe\xff\xff\x67\x0c\xba\xd7\xa7\x97\x3b\x76\x88\x99\x4b\x59\x67\x9c\x8a\x2e\x5d\x70\x1f\x5f\x69\x35\x9a\x19\x57\x1c\x5c\x82\xf4\xcf\x2f\xad\x06\xaa\x53\xf5\xeb\x00\xfd\x03\xf1\x26\x14\xc9\x05\xc2\x2e\x78\xc8\x73\x15\x0f\xc2\x8b\x92\xc9\x66\xaa\x16\x9e\xcc\x73\x1b\x3e\xf9\xaf\x62\x9e\x2f\x55\x9b\xa6\xd6\x33\xd5\xce\x67\x0e\x7f\xab\x8b\x0e\x22\x9b\x14\x24\x1f\x51\x16\x44\x12\xc9\x51\x40\xc0\xbb\x4f\x5d\x97\x30\xab\x81\xe0\xd1\xa1\x4f\xb7\x18\x89\x73\x9b\x32\xf7\x5c\x85\x4c\x1a\x5a\x82\x5c\xfb\xd6\xdc\x2d\xee\xcf\x6d\xd3\x73\x11\x88\xf3\x7e\x6b\xbb\xa2\xf6\x8f\x43\x78\xe0\xdc\xd3\x8e\x4a\xec\xd3\x2a\x8a\x05\xf5\xc8\xe5\x9a\x3d\x6f\xc4\x48\x1e\xaa\x05\xe5\xf2\x34\x67\xd4\xb9\x9d\x2e\x98\x93\xf0\x11\xac\xc1\x10\xdc\x8b\x54\x98\xc6\xb2\x5a\x2e\xd1\x80\xbb\x96\x05\x80\x3d\x56\x03\x49\x1c\x76\x88\x04\x9e\xb3\x3d\xcc\x7a\x56\x63\x77\xe6\x26\x74\x9a\x51\x0e\xa0\x66\xad\x88\x13\x41\xeb\x32\x2f\x85\x47\xed\x18\x14\x3e\x59\x88\x62\x4b\x3d\x71\x7e\x98\xa8\x47\x46\x10\x47\x15\x4a\x45\x99\x5e\x4d\xe3\x46\x0c\x3c\x3d\x56\x9e\xa6\xa2\x62\xb8\x65\x1a\x7a\xdd\x8a\x8b\x23\xbb\xba\x1b\x11\x44\x0d\x34\xe9\x27\xb3\x30\xe8\xfc\xfb\xfb\xa3\xdb\x56\xe9\x61\xf3\x8f\x22\x6a\x8e\x47\x11\xa4\x0e\x8d\x70\x97\x73\x1c\xd0\x18\x9f\xb7\xb9\x5e\x71\x37\x8a\xa8\xa7\x1e\x23\x17\x25\xe5\xf7\xf7\x47\xe8\x05\x2f\xd8\xe4\x2f\xb8\x13\xf9\x84\x41\xd2\x32\x67\x65\xd2\xc9\xf2\xea\x4b\x13\x3d\xf4\x31\x9a\xac\x30\x8e\x0e\x87\xee\x85\x19\xcb\x46\x53\x93\x28\x74\x2f\xc6\xcf\x6c\xee\x8e\x94\xe6\xaa\x73\x9c\xac\xc6\x28\xa2\x4f\x0d\x88\xec\x1a\x02\xfb\xcd\xcd\xb8\x14\x5d\xd2\x27\x1e\x0f\x48\xa8\x5f\x9b\x5a\x2f\xbc\xcb\x51\x44\x5d\xee\x2c\x24\x2f\xde\xdf\x80\x9f\x75\x31\x4c\xc6\x08\xdb\xda\x0b\xba\x39\xbf\x6b\x3a\x65\x2e\xdb\x01\x0d\xd1\x9e\xdb\x27\xa1\xa4\x50\x28\x5c\x90\x86\x3f\xb9\x10\xbd\x21\xf2\x06\x55\x9f\x59\x80\x70\xec\x6a\xf8\x85\x64\x2b\xce\xc2\xf0\xa4\x10\x65\x8e\x47\x9d\x1e\xec\x52\x9b\x60\x48\x9a\xb3\x9d\x99\x5b\x0a\xf6\x3d\x76\x29\x93\x24\xc4\xc3\x2a\x12\xfd\xf1\x84\x36\x13\xd6\xcc\xa5\xfb\x77\x68\x72\x6a\xaf\xe3\x70\x57\x8a\xe5\xa7\x77\x2f\x4e\x8d\xd7\xdc\x51\x32\x02\xa6\x32\x47\x42\xc2\x31\x5a\x22\xaf\x5d\x11\x59\x5d\x9d\xa1\x2b\x5b\x5b\xa7\xe2\x02\x02\xaa\xba\x45\x1b\xca\xd5\x21\xe7\x69\xb2\xbb\x49\x51\x01\x49\x4c\xcc\x33\xdc\x41\xc7\x98\xe1\x4e\x51\x1a\x53\xe6\xc2\x9b\x04\x54\xb6\xda\x3b\x87\xcc\x45\xcd\xf6\xf5\xf9\x73\xfb\x24\xc4\x63\xd8\x40\xe3\x4b\x89\x3b\xa0\xcb\x04\xa6\xde\x0f\x6b\x06\x96\x92\xb8\xe3\xeb\x15\xa5\xf4\x84\xb9\x0a\x08\x09\x7a\xf5\xa4\xe0\xe6\x36\xea\x72\x20\xc7\x62\x44\xfd\x4e\x7c\x1e\xf9\xd4\x1c\x0d\x70\x35\xdd\x12\x5a\x38\x79\x84\x32\xbb\x56\x35\x98\x78\x41\x43\xe2\xc8\x8d\x49\xca\x11\x96\xb8\x83\xd2\x33\x17\x1b\x01\x0e\x25\x23\xe1\xf9\x39\x76\x85\xd5\x28\x64\xb4\xb4\xbe\x18\x33\xb5\xad\x63\x9d\x3d\x8c\xcc\xf5\x12\x33\x5e\x56\x3c\x43\x09\x3a\x1e\x13\x19\xd2\xde\xed\x54\x7e\xf9\xce\x34\x2d\x2c\xcb\xf4\xf5\x72\x2c\xcb\x1c\x60\xe9\x74\x67\xe2\x29\x1a\x09\xed\x52\xb8\xc4\xa3\xfd\x50\xcb\x45\xcb\x32\x59\xe0\x5b\x96\xa9\x07\x32\xd4\x08\x0e\x36\xe2\xfb\xb3\xe3\xac\x95\xd2\xf1\x4a\x4b\x51\x7a\x20\xaa\x30\xa2\x61\x5c\x95\x6e\xb7\x21\x13\x7a\xa1\xeb\xec\x30\xf4\x70\xaa\xb5\x6c\xd0\x7d\xa9\xe7\xdd\x42\x6f\xcf\x4e\xcc\xad\xf6\x8e\x22\xc3\xde\xfe\x3b\xe3\xde\xe4\x38\x80\x8a\x48\xd4\x43\x2c\x93\xe9\x30\xc2\x03\xd1\xea\x8e\x6c\x12\xe6\x39\x6f\x7e\xa7\xc4\x73\x3d\x6c\x57\x2e\x22\x0b\x36\xf9\xd7\x54\xf4\xc1\x2b\x02\x74\x05\xcb\x6a\x35\xdb\xa3\x78\x79\x7a\x13\xaf\x59\x0a\x26\x83\xe7\x78\x04\x7e\xa7\xb4\x7a\x58\x95\x62\x8f\xce\x88\xd2\x8b\x64\x92\x34\x2d\x18\xe9\xda\x1a\x88\x74\x84\x0c\x7b\x56\x63\xf7\x77\x4a\x27\x8b\x51\x4e\x9b\x27\x05\xfe\xc8\x3e\xf6\x22\xf8\x62\xd2\x62\xea\xcb\x5f\x3f\xff\xf0\xf7\xc4\x53\xf9\xfb\xd1\xd1\xf9\xc1\xe9\xbb\x97\xe7\x67\x6f\x7f\x3b\x7c\x63\x35\x90\x65\x99\xd9\x61\xbf\xb3\xac\xcf\x07\x2f\xf6\xce\xf6\x2c\xeb\xf3\xef\x47\x47\xc6\xfe\xeb\xb7\x07\xbf\x41\xbd\xcb\xaf\x87\x7b\x2f\xc6\xfb\x6f\x5f\xfc\x6e\xec\x1f\xbe\x3a\x7a\xa3\xff\x3c\x7c\xf3\xa2\x69\x59\x5f\x0a\xbd\x9a\x31\x72\x46\x76\x55\xff\xfd\x37\x08\x6f\x4a\x95\x8c\xfe\x3e\x13\x68\xd7\xdf\x6d\xb5\x77\xfa\xd8\xa3\xae\xd2\xa5\xc7\xd8\x91\xb4\x4f\x5e\xf2\xd0\x6f\xd6\xd0\x33\xdf\x54\xb6\x48\x82\xc1\x9a\xdd\x11\xb9\xa7\x2a\xc7\x42\xa2\xd3\xc3\xb7\xf9\xdb\x18\x2e\x58\xdd\xbc\x38\xeb\x52\x81\x60\x95\x88\x0a\xc4\x03\x49\x7d\x7a\x45\x5c\x34\xa0\xb2\x8b\x64\x97\x20\xbd\x88\xad\xf6\xce\xa4\x74\xab\xd9\x3e\x3d\x7c\x8b\x34\x6a\x0c\xea\x67\xba\xb6\xd5\xa5\x80\x75\x29\xe0\xa2\x7d\x56\x97\x02\xd6\xa5\x80\x75\x29\xe0\x37\x58\x0a\x38\x82\x03\x22\x17\x74\xe4\xc4\x38\x85\x58\x82\x28\xf6\xf3\xac\x21\x26\x93\x3d\xdb\x52\xa3\x1e\xd4\xd3\xa9\x65\xd4\xc7\x56\x7d\x6c\xd5\xc7\x56\x7d\x6c\xd5\xc7\xd6\x83\x3c\xb6\xd4\x5d\x81\x21\xd2\xf3\x20\xdf\x0c\x8b\xce\x42\xec\x14\x60\xd4\x1e\x09\x11\x11\xa4\x2e\x5c\x39\xde\xc3\xb8\xa1\x6a\x3d\x9f\x5b\xe9\xa4\xe0\x78\x4f\x9e\xbe\xa1\xd2\xd0\x43\x02\x1e\x02\x37\xa9\x9e\xde\xb6\x1a\xbb\xa3\xf8\x62\x94\x62\x65\x3e\x9b\xdc\x14\xbb\x4d\x70\x10\x78\x54\x87\xeb\x4c\x1e\x10\x26\x08\x0e\x9d\xae\x4b\x34\x0b\x50\xce\x2c\x6b\x7b\xe8\x7b\x56\x03\x5d\x5f\x82\xb9\xbb\xb9\x78\xf2\x25\x91\x76\x88\x29\xd3\xb8\x6e\x00\x35\x0a\x6b\x30\xf3\x5e\x0e\x24\xb4\xe5\xbe\x9b\x0f\xd4\x25\x1c\x9d\x78\x78\xb4\x7a\x28\x6e\xab\xbd\x13\xe0\x10\xfb\x63\xe2\xdb\xc4\x1d\x6b\x0b\x5e\x77\x06\x86\x45\x46\xb6\x02\x47\x65\xdc\xe1\xbc\x47\x89\x46\x44\xe5\x3e\x78\x04\xfb\xfa\x96\xe6\x06\x63\xf2\x7a\x41\x79\x4c\x4d\x0f\x3f\x1d\x0e\x9d\x2e\x66\x9d\xbb\xd8\x9c\x70\x6a\x75\xb5\xab\xeb\xde\x65\x1a\xd7\x65\xe2\x1b\x2d\x13\xdf\x63\x9d\xc8\xc3\xe1\x1d\x6a\x28\x8b\x58\xc7\xe8\x6b\x34\x83\xe7\x56\x23\x7d\x23\x56\x63\xfe\x44\x1b\x25\xaf\x32\x85\x34\xc5\x5a\x94\x97\xd6\x17\x48\x22\x90\x5a\x94\x0c\x41\x53\xcb\x13\xaf\x7f\xfc\x56\x7d\xe8\x45\x99\xb8\x7f\xfc\x86\x3e\xb7\x14\x5a\x84\xf8\xb2\x5d\x22\x1d\xe4\xaa\x87\x97\x0e\xc8\x2f\xe4\xbb\xba\x20\x6d\xe9\x82\xb4\xab\x1e\xcf\x87\xfd\xfb\xe3\xfd\xbb\x7d\xf4\x92\x47\x4c\x87\x83\x36\xc0\x46\xa9\x9d\x7d\x91\xce\xfa\xf9\xeb\x2e\xa8\x96\x4e\xc6\x65\xa1\x0c\x77\x94\xb1\xdc\x33\xe8\x3d\x2a\xa5\x6a\xec\x13\x97\x46\xfe\xd8\x83\xf4\xec\xa6\x61\x59\xee\x5f\x3f\x3e\xf9\xe9\x6f\xe4\x70\x2f\xf2\xd9\x86\x9a\x7b\x4e\x1e\xa1\x75\x15\x85\xb9\xf0\xfe\x7f\x60\xdb\xa6\xc3\xb5\x35\x0c\x5c\x48\xde\x34\x9d\xf5\xca\x1e\x1e\x60\xcf\x3b\xe1\x42\x6a\xf9\x2b\x56\x2b\x23\xdd\x4b\x21\x5d\xfe\xd8\xdb\xdf\x3f\xfa\x84\x4e\x8f\xf6\x6a\x25\xe9\x7e\x56\x8f\x5f\x29\x26\xcc\x67\x53\xc6\x87\x9b\x4b\x97\xf5\x3b\x7a\x8f\x8b\xd0\x49\x2d\x4c\x0c\x79\x72\x57\xb0\x8e\x72\xf9\xaf\x99\x4b\x4d\xfd\xab\x30\x93\xef\x20\xfe\xbd\xd6\x1d\xaf\x06\xce\x25\x20\x61\xa8\x3a\xb8\xb4\x9b\xdb\x3a\xe9\xfa\x6e\x62\xf1\x5d\x11\x56\x88\x68\xf5\x07\x61\xee\xfa\x92\xb4\x66\x37\x2b\x8c\xbe\xd5\xde\xd1\xb7\x37\xdb\x3a\x59\x49\x98\x5f\xda\xd7\xfa\x77\xdc\x4e\xf9\xd3\x15\x61\x6e\x11\x75\x88\xe8\xa1\x83\x2e\x2e\x60\x95\xd7\xb4\x4f\xf4\x65\x6b\xcd\x22\xea\xff\x04\xbb\x8c\x07\xd4\xaf\x22\x6d\x5c\x0d\x5c\xf0\xfc\x17\xdc\x2b\x2a\xfc\x3e\xe9\x72\xc9\xd1\x2b\xec\x79\x64\x8d\xf5\x32\xeb\xcd\x46\xbe\x8a\x9f\x24\xff\x69\x03\xc9\x6f\xbb\x3e\xe8\x0a\x16\x51\x49\xb5\xbf\x1a\xf9\x52\xe4\x53\x20\x12\x4e\xd5\x28\x92\xcf\x00\xcb\x31\x49\xc7\x0a\x34\x02\xa3\xd5\x40\x3e\x91\x5d\x0e\x1e\xcc\x80\x0b\xf8\x0c\x0e\x4d\xce\xd2\xf3\xc6\xb2\xda\x2e\x7f\x8e\x5d\x57\x72\x58\xe1\x3f\xe0\x46\x70\x78\xaa\xc2\xd2\xad\xf6\x77\xcf\x2c\xcb\x84\x81\x77\x5b\xcd\xed\xfc\x5a\xd5\x74\x8c\x4c\xba\x98\x1e\x66\xb7\xae\x76\x7f\x08\xea\x55\xcc\xe4\xb9\x1b\x81\x32\x46\x0b\xb2\xc3\x4b\x34\x7e\xa8\x42\x33\xd0\x2b\x5b\x9e\xf7\x5e\x5c\x62\xd6\xe1\xe5\xdd\x6f\x55\xf8\x81\xc0\x5f\x9e\xcd\x0d\xd5\x7d\x21\xf5\xca\xb2\xa1\x53\xd8\xcc\x78\x77\xac\xf7\xb1\x52\x3d\xd5\xde\x55\x71\x61\x47\x84\x17\x3e\x75\x5d\x8f\x40\x25\xaf\xe4\x3d\xc2\xd4\xf7\xea\xb2\xdd\x66\xc5\xbe\xa4\xc9\xc3\x2c\x0a\xd9\x8e\x64\x37\xb5\xd0\x8b\x49\x8d\xaa\x8c\xda\xc6\x3e\x4e\xd1\xd4\x8b\x9a\x5b\x77\x3e\xe7\x81\xd1\x3d\x0c\xc9\xaa\x07\xcc\xba\x46\xd1\x32\xc1\x42\x57\x71\x60\x02\x84\xb3\x84\xe7\x46\xdf\x68\xd8\x1e\xef\x18\x57\x6a\x67\xe6\x4a\x15\x68\xa4\x7b\x59\x3e\xdd\x65\xed\x3d\x9b\xd7\xab\x53\x75\xa8\xec\x46\xca\x9d\x92\xb8\x4e\xcd\x2b\xfd\x84\xe6\x62\x0a\x04\xa4\x8a\x9a\x90\xaf\x30\xb0\x79\xab\xa6\x04\x0f\x48\x9e\x0b\x0f\x2b\x26\x41\x8e\x2f\xee\x5e\x64\x2d\xb3\xb6\x5a\xa7\xb9\xb7\xdd\x4b\xf5\x5b\x84\x76\xbb\x97\xc1\x42\x2e\x54\x4a\x75\x97\x84\xbc\x75\x59\x82\x11\x9f\x2c\x03\x5b\xb3\xac\x73\x60\xb2\x16\xb4\x77\x72\xd4\x58\xc9\x5a\x9a\x8c\x71\xe0\x51\xc2\xe4\x5a\x8b\x23\xde\x70\x37\x23\xae\x1f\x6a\x30\x83\x71\x97\x5c\x2e\xdd\x89\x6f\xf2\x62\xf2\x2d\x4e\xec\x1f\x74\x71\x28\xcb\x9f\x89\xaf\x42\x1c\x74\xd7\x5e\x92\x89\x7d\x47\x2d\xa3\x12\xc3\x3b\x1d\x3c\x8f\x0e\x8c\xfa\x58\x92\x96\x23\xc4\x86\xa2\x3b\x3a\x41\x73\x4a\x27\x34\x9b\xed\x78\x1d\xba\x00\xc9\xa7\x4c\x15\xad\xc1\xa2\x36\xa3\x52\xb8\x98\x11\x97\xb0\x56\xac\x5a\x50\x6e\x66\x08\xb3\x50\xab\xb0\xb1\xe8\x11\xb9\x8c\x66\x55\x91\xf3\x26\x5e\xc8\xda\x99\x48\x45\xa7\x5d\x77\xc4\x85\x8f\x19\xcd\x50\x27\x7d\xf2\x85\xb4\x71\x4e\x30\x23\x5e\x3e\x61\x7e\xe5\x42\x82\xdc\x52\x97\x2e\xa3\x76\x39\x81\x08\xfb\xae\xb9\xa0\x97\xf2\xdc\xd8\x74\xbc\x9e\x8d\xa5\x3d\x39\x01\xcc\x97\x9c\xa5\x73\x29\xd4\xa1\xb2\xfa\x88\x5f\x02\xbb\xf8\x08\xa6\x7b\xd4\x78\x32\xa3\x88\x4d\x57\x4b\x3e\x8a\x1f\xa0\x43\xe1\x44\xbb\x1a\xfe\xe4\xfc\x1c\x87\x4e\xe0\x6e\x13\xdb\x3c\x92\xe6\xa3\x5d\xf8\x90\x24\xad\x63\xe3\x0a\x0a\x80\x73\x91\xad\xca\x6b\x84\x5f\xe7\x0c\xfd\xfd\x0a\xca\xe1\x84\xb4\x05\xc7\xe7\x0b\xd2\xcf\x82\x0a\xdd\xf2\x91\x39\x4b\x0e\xcb\xb2\x81\xee\x09\x9f\x03\x46\x72\x63\x5d\xe7\x6b\x87\x4a\x43\x38\xfe\x32\x56\x67\x87\x4a\xcd\x14\x29\x4b\x2c\xe4\x6d\xee\x9f\x02\x90\x59\x35\x38\x07\x69\x80\x30\xc5\xd3\x79\x0c\x45\x81\x2a\x0f\xd5\xe1\xbe\x80\x99\xad\xc6\x58\x38\xe1\x73\x8d\xa1\x13\x7f\xd7\x1c\x5b\x56\x4b\xfd\xe5\xe0\xd0\x0d\x89\x4e\x3b\x8d\x39\xdc\x26\xd8\xe1\x1a\xef\x60\x7c\xf0\xf6\xf8\xf4\xe0\xed\xbb\x43\x0d\xbb\xe3\x70\x56\x14\x65\x5c\xcf\xb8\x37\x95\x3b\xc9\x73\xe6\x1d\xf8\x2e\xb1\x23\x59\x85\x29\xac\x06\xb6\x2c\xb3\x9d\x69\x18\x73\xab\x41\x36\x90\xc2\x76\x08\x8d\x99\xc3\x7c\x82\x90\xe0\x98\xb2\xc2\xcc\xd3\x70\x14\x48\xee\xab\x0b\xd7\x8b\xfb\x92\xcc\x5f\xc5\xe1\x9d\x71\x95\xc0\x3c\x03\x66\x4e\x1e\x77\x01\x3d\xc8\x8f\x3f\xfc\xfb\x86\xae\x82\x39\x46\x97\x1a\x75\x25\xfa\x7c\xfe\x1a\xb7\xde\x82\x21\xea\x22\xf4\x6f\xcd\x83\x00\x6f\x35\xcf\x7f\x45\x4e\x47\xcc\xa5\x07\x58\xae\x83\x27\x5f\x84\xc4\x91\x3c\x1c\x19\xa7\x09\x51\x1a\x5f\x27\x13\x54\xe5\xe6\x9a\x4c\x81\x6a\x3f\xd7\x3d\xe5\x52\x01\x6f\xd0\xc1\xb9\xc1\x3b\xf2\x07\x3a\x89\x6c\x8f\x8a\xee\xfa\xbb\xda\xcf\x23\x7b\x66\xbe\xaa\x58\x77\xd1\x14\x35\xe7\xde\x1b\xce\xbd\x6a\x31\xbe\x90\x63\x2f\x6e\x35\x2c\x14\x4f\x61\x92\x0b\x28\x57\x52\x1a\x7a\x3b\xed\xee\x32\x56\x70\xcb\xfd\xa4\xc9\xcb\x5a\x55\x43\xf5\xdc\xb9\xa7\x0e\x73\xa3\x75\xf9\xaa\x67\x4c\xcc\xa5\x76\xb8\x5a\x46\x36\x53\x71\xd3\x58\x63\xb5\x2f\x7a\x35\x5f\xb4\x7e\x73\xf9\x3e\xe8\x42\xff\x4f\x49\xce\xa9\x40\xee\x2f\x74\x3c\xac\xb0\xd9\x66\x1c\x0d\x0b\x28\xe1\x51\x51\x11\x35\x12\x6f\x41\xb0\x7b\x72\x3d\x07\xe4\x15\x95\xaf\xa9\x90\x68\xc6\x95\x58\xa7\x62\x7d\xb3\xe5\x80\xb5\x6b\xb0\x22\xd7\x20\xec\xe0\xbc\x33\xb5\x43\xe5\x80\xd8\xd5\xee\x71\xf0\xf4\x83\x07\x79\x40\x6c\xa4\x70\xaf\x2f\xb0\x43\x50\x3f\xdb\xca\x38\xc3\xd3\x8b\x55\x12\x55\x8c\xef\x98\x7a\xc9\x85\x95\x02\xb3\xef\x46\xdf\x96\x0d\x71\xc1\xeb\x59\xad\x41\x77\xdd\xce\x74\x3d\xed\x4c\x6b\x89\xb0\x79\x89\x50\x78\xec\xf3\xfe\x44\x83\xbe\x43\x79\x49\x2f\xc2\x28\xc0\x9e\x16\x17\x00\xca\x64\x59\x5b\xea\xa1\x2c\xcb\xb4\x2c\xd3\x55\xbf\x6a\xe0\x22\xcb\x82\x5f\xb7\x51\xfc\x24\x2b\x64\xc7\xaa\xc1\xca\x63\x31\x2d\xb3\x9f\x0f\x87\x01\x0d\xd5\x30\x8d\x1f\x7f\x41\x6f\x78\x1f\xfd\xf8\xcb\xbf\xff\x7b\xf6\xf0\xd7\x2b\x30\x0e\x54\x91\xfc\x0e\x62\x91\xe7\xcd\x5c\xf1\x6a\xca\x99\xa5\xaf\x57\x66\x9a\x28\x2f\x14\x32\x03\x02\x7a\x05\x24\x02\x8c\x15\x72\x93\x46\xad\xb0\x1a\x26\xb0\x8f\x00\x88\x0a\x97\x5c\xe0\xc8\x93\x63\xec\x79\x4d\xf8\x2c\xbb\xc4\x27\x62\xec\x73\x37\xf2\x16\x83\x25\xcd\x47\x5c\x4a\xdf\xd5\xa5\x58\x15\x63\x69\xa9\xe7\xad\x51\x96\xbe\x49\x94\xa5\xd2\xf9\xbc\x8a\x57\x96\x43\xfe\x19\x0c\x5a\x1d\xde\x87\xf4\xb9\x0e\xef\xb7\x70\xb4\x50\x58\x46\x8c\x3a\x3c\x64\x55\xc4\xee\x92\xb1\x37\xd9\x21\x6c\xb1\xca\x33\x9b\xc9\xbe\x21\xa5\xa7\x6c\xd6\xfa\x1d\x56\x7a\xae\x65\xa3\x17\x1e\xd2\xf1\x8b\xcf\xd3\xdb\xbb\x38\xa0\x85\x9e\xb0\x1b\x27\x6a\xd6\x8e\xad\xfb\xe1\xd8\x02\x6e\xc8\x77\x6b\xd1\x03\x3e\x29\xf8\xb9\x0b\x15\xce\xf1\x82\xb4\x6e\xb3\x3c\xa7\xec\xb9\xdc\x26\xe8\x80\x7b\xee\xcb\x48\xd0\xb2\x92\x69\xd5\x52\x26\x84\x1a\x07\x0a\xb5\x0b\xa6\x3e\x78\xa9\xd0\xe2\x9f\x2f\xa1\x5c\x81\x05\x7c\x7a\xb6\xf7\xee\x0c\xe9\xd1\xcf\x70\x47\xf5\x78\xbb\x58\x0e\xa9\xd2\xb9\x80\x1e\xcd\x65\x81\x29\x57\x56\x81\x0e\x5e\x1e\xbf\xbe\x35\x1d\xe8\x7e\xeb\x29\x18\xd8\x52\x65\x76\xc4\xb5\xac\xc2\x74\xb8\xe7\x5e\x28\x1e\x35\x2e\xb0\x4f\xbd\x51\x4b\x3d\xd2\xb2\x9a\xcc\x2a\x83\x17\x8a\x8d\x91\x1d\x52\x0d\xa3\x47\x1d\xb5\x1d\x17\x49\x0f\xc0\x33\x95\xf8\x0e\x89\x0f\x97\x08\xda\x61\x24\xcc\xae\xae\xf6\xfb\xde\x3b\xbf\xaf\x7e\x73\xd0\x8d\xb5\xdc\x7b\x98\xe1\xb1\x92\xb4\x9f\x21\xa9\x9e\x14\xfa\xc3\x7f\x5f\x5e\x86\x4f\x85\x29\x2c\x4b\x6c\xeb\x50\xc5\xdc\x07\x48\x44\xbe\xb9\xef\x71\xa7\x87\xa0\xd1\x07\xfa\xb2\x8d\xb4\xb1\x8c\xe2\xf6\x21\x87\xef\xc6\x67\x6f\x4f\x20\x92\x2a\xda\x00\xcb\x35\xe7\xf6\x63\x7d\xc3\xf4\x28\x46\x02\xe1\x85\xd6\xed\xab\xca\x3c\x4b\x6d\x47\xd7\x68\xc5\x35\x5a\xf1\x2d\xa0\x15\xc7\xce\x87\x20\x15\x51\xcb\x78\x77\xa9\xbe\xad\x45\x17\xe6\x91\xd2\x8f\x45\xc1\x9e\x43\x97\x4a\x1e\x6e\x3e\xb8\xfd\x15\x96\xb6\x85\x66\x76\xdd\x2a\x05\x56\x41\xe0\x69\x2d\x89\x7a\xf4\x82\x98\x14\xe2\x5b\x8b\xc8\xd1\xa3\x03\xda\x2b\x6a\x82\x45\x7b\xf4\x46\xb5\x55\x1a\x63\x1f\x7b\xaa\x93\x95\x24\x56\x03\xcd\x41\x5c\x1e\x1a\xb0\x92\x0c\xb8\x32\xbc\x08\x24\xbb\x54\xa0\x00\x77\xe0\xa6\xb4\x8d\x2f\x60\xea\x99\xf1\xd2\xc1\x8a\xe8\xd0\x0c\x06\x5f\xda\x62\x0b\xbc\xb4\x4e\x87\x1a\x36\x65\x66\xb3\x3d\x75\xb9\x86\x4f\xd9\x4c\xd9\x4d\x3c\x71\x8b\xb2\x8b\xc5\xea\xa5\x1f\x90\x90\x62\x54\x41\xdc\x21\x21\x0b\xf5\x83\x1d\x8f\xf6\x89\x01\xeb\x40\x62\x24\x34\x3e\xf6\x0f\xc6\x2f\xd8\xb8\x30\xbe\x28\xd8\x98\x1d\x04\x96\xf7\x79\xfa\x8b\xf9\xe5\xb1\xd5\x68\xb6\xd1\x63\xd5\x15\x6c\x25\x16\x7f\x75\xf8\xe6\xf0\xdd\xde\xd9\xdb\x77\x8a\xc5\x8f\x8e\x4f\x0e\xdf\x1d\xed\x25\x20\xdc\x7f\xfd\xf4\xe4\xef\xe6\x76\xa6\xdb\xd7\x27\xe3\x48\x53\xc2\x00\x04\x29\xe3\x88\x5d\xac\x6e\x20\xd7\x31\xca\x35\xc5\x28\xcb\xf8\x8a\x03\x1a\x72\x38\xec\x8c\x98\x93\xe3\xdc\x7e\x6d\xa7\xf5\x64\xfa\x47\x44\x6c\x88\x7a\x3b\x5d\x69\xc6\x57\x1a\x71\x9d\xfd\xdc\x6d\xc1\x7f\x46\x77\x32\x14\xf7\xea\xed\x67\x34\xfe\xb2\x7d\x70\x7c\x8a\x0e\x21\x9e\x1f\x84\x54\xac\xde\xb4\x9f\x71\x49\x98\xcd\x7d\x12\xb6\x98\x67\x9e\x68\xd3\x96\x30\xf3\x40\x77\xe4\x38\xd7\x8d\x83\xa1\xdd\xbc\x49\xf9\xcf\xe7\xe3\xf3\x3c\x92\x05\x86\x87\x6d\xe2\x55\x53\xe2\x54\x90\x9f\xe0\x78\xdc\x16\x55\x34\x07\x1e\x0c\x5a\xc9\x83\xe5\xf9\xf8\x2e\xff\xf7\x8c\x47\x4e\x41\x9e\xf3\x31\xb7\xa9\x47\x2a\x4b\x1b\xbd\xfc\x53\xc2\x1a\x2a\x29\x9d\x4e\xc6\xce\x25\x41\x44\xc2\xd1\x6d\x57\xfe\x5e\xfe\x09\xab\xf8\xdc\x32\xbe\xc4\xc2\xef\x31\xc4\xfd\xa1\xef\xe7\xe3\x99\x66\xbe\x99\x72\x6b\x7d\x53\x9a\x69\xdb\x6c\xcf\x5c\xaa\x7f\x8f\x09\xab\x2e\x6b\xf7\x49\x08\xd8\xba\x94\xb3\x66\xfb\xf9\x35\x39\xbb\xb6\x7a\xa5\x78\xe6\x42\xb2\xa3\x63\xda\x81\xea\xd5\xbb\x43\x7e\x5f\x2f\x08\x12\x99\x33\xe4\x99\xa5\x71\x4a\xcc\x1b\xd0\x70\xe1\x39\x3c\xc5\x91\xa8\x34\xf0\xfa\x0c\x69\x50\x55\x01\x8d\xcc\xec\xa5\xb9\x76\x49\xce\x5d\x8d\x7b\x51\xd5\x00\xef\xd3\x5c\x5d\xea\xa8\xcf\x14\xee\xe9\xbb\xe3\x7f\x8c\x98\xcf\x8a\xb6\x87\x12\xbd\xb7\x2c\x9e\xd3\xad\xa1\x26\xca\xec\x8c\x7a\x63\xd4\x1b\x63\xd5\x8d\xa1\x6f\xd1\x2c\x55\x7c\x4e\x18\xc1\x25\x1e\x56\xbb\x0d\x66\x5a\xc9\x6d\xab\xbe\x48\x30\xad\x01\x7d\xde\xf0\xf5\x82\xe1\xbc\xad\x02\xf7\xad\x77\xa3\x20\xd4\x50\x8b\x91\xd4\x27\x3c\x92\x33\x19\x5e\xfa\xb7\x90\x04\x1e\x76\xc8\xdc\xdf\x82\x48\x74\x57\xb6\x0c\xeb\x8d\xf7\x4d\x9e\x48\x2e\xb9\x88\x58\x4f\x26\x47\x92\xda\x63\xb9\xdb\x10\x9d\x06\x38\xec\x79\x94\x91\xdb\x06\x5c\xd2\x4b\x06\xb8\x86\x64\x45\xab\xd9\x0f\x35\xcb\x7f\xe3\x2c\xbf\x63\x9a\xdc\x67\x34\x00\x8f\x81\x84\x40\x46\x32\x40\xca\x38\x66\x01\xcf\xbf\x3f\xba\x1b\xd6\x89\x11\xd1\x32\xf6\xe1\xec\xfb\x35\x22\x5a\x64\x21\x1a\x11\xad\x77\x4f\xbd\x7b\x16\xb9\x50\xe0\x8e\x88\xe6\x2a\x69\x7f\x9e\x78\x5c\xde\xfa\x91\x10\x78\x5c\xd6\x8c\x5c\x33\x72\x4e\x23\x50\xcd\x23\x39\xac\xec\x51\x7b\x30\x18\x18\xe0\xd3\x37\x5c\x4c\x7c\x5e\x49\xa2\xf6\xf5\x59\xd2\x34\x65\xcb\xca\xd2\x71\xe3\x49\xdb\x75\x0c\xa8\xfa\x18\x10\x84\xfb\x89\xc4\x00\x2d\x08\xf7\x98\x01\x77\x4d\x68\x23\xb9\xb3\xf3\x42\x73\xdc\x42\xde\xec\xc0\xed\x6e\x35\x1c\xa9\xc7\xde\x64\xe9\x40\x89\x0d\x9b\x2c\x2b\x0f\x80\xd1\xc7\xb2\x7b\x07\xc0\x3b\x61\x19\xd7\xa4\xe8\x5a\x09\x02\x33\xe4\xe3\x5d\xf8\x94\xd1\xf3\xca\x78\x64\x32\xfa\x1d\xe2\x12\xec\xf8\x3a\x77\x43\xf0\x0b\x09\x2d\x1d\xcc\x0c\x11\x16\x91\x89\xbb\xe7\x38\x92\xdd\xf3\x00\xfb\x65\x09\x85\x0e\x87\x92\x30\x38\xef\x96\x22\x59\x66\xa6\x3b\x22\xe2\xeb\x3e\xba\xeb\xef\xa3\x5b\xc0\xa3\x01\xf6\x5b\x82\x47\xa1\x43\x2e\x78\xd8\x21\xca\x0c\x9d\xe2\xc1\x1c\x3e\x75\x71\xbf\x5a\x16\xd5\xa7\x2e\x4c\xd5\x6c\xbf\xd8\xfb\xa0\x3f\xde\x7e\xf9\x58\xcd\xa6\x1b\x67\xd3\x01\xb1\x5d\xdc\x57\x2a\x49\xc2\x79\x39\x8c\x79\x81\x85\x9c\x24\x92\x55\x28\x3f\xe3\x89\x6a\xae\x7c\xa0\x5c\x39\x18\xb4\x62\x16\x50\xe7\x7c\x86\x25\x4c\x97\x3b\x22\xfb\x45\x5a\xcb\xb2\x88\x69\x2f\x7b\xd5\xf3\xeb\x65\xef\x4e\x9d\xf4\xe8\x8c\xfb\x0e\x96\x9b\xe2\xd9\xaf\x7a\x56\xe3\x80\x8f\xb8\x24\x29\x25\x62\x3a\xe4\x56\xae\x58\x96\xad\xd7\xaa\xcf\x20\xe3\xae\xd8\x80\x28\xfd\x6f\x41\xb2\xfb\x4c\xcf\x72\x54\x97\xbf\x2d\x18\xad\xb8\xd7\x39\x5a\x22\xed\x5d\x2a\x66\x69\xcd\x11\x29\x4f\x6a\x09\x7e\x87\x24\xf8\xb5\xf7\x14\x7f\x63\x3c\x6d\x3d\x35\x5c\xee\x98\x5a\x6a\x1a\x5d\x3e\x90\xbc\x50\x84\x07\x13\x17\x55\x85\x42\x1c\x66\xa9\x7d\x72\x0f\x06\x3b\xaa\x96\x0a\x9b\x37\x8a\xe1\xf5\x4c\xdf\xb6\x70\xcb\x67\xa1\x44\xaa\xdc\xf4\x8b\x11\x43\x6a\xf4\x94\x7b\x8c\x9e\x52\x6f\xfc\xbb\x66\xd0\xf9\xdc\x9d\x7e\x4f\x8b\xb6\x7e\x88\x9d\x0d\x98\x6c\x30\xcb\x22\x53\xe5\x9a\x99\xb2\xe8\x86\x5b\x91\x13\xef\x22\x7b\x84\x38\x43\xef\x30\xf5\x44\xe5\x90\x26\xd3\x54\xdb\x6a\xef\x84\x30\xed\x18\xa8\xd1\xcc\xb7\xed\xe6\x5c\xbf\xb6\xd0\xb7\x89\x85\x20\x52\x98\x99\xe2\x48\xe3\x73\xdc\x6d\xe4\xaf\xa7\x3f\xfd\x6d\x2e\x09\x1c\x07\x2d\xa2\x8d\x00\x87\xd8\x57\x06\x2d\x78\x69\x09\x93\xd4\xa1\x72\x74\xae\x1a\x46\x7f\xbf\x86\x6a\x77\x78\x71\x77\xa6\xcd\xf3\x31\x67\x9d\x90\x78\xe3\x8f\x87\xfb\x21\x75\x7a\x63\x58\x5c\xf3\x5b\xaa\x62\x0f\x23\x7b\x64\x78\x98\x75\x56\xee\xf1\x0c\x23\x70\xa6\xf8\xb7\x3e\x5b\xee\xae\x52\xd9\x55\xd0\x36\x01\x48\x04\xd6\xc9\xef\x18\xa5\xe5\xf8\x44\x6c\x56\x7a\xbe\x50\x4f\x2c\x79\xc0\xcc\xb9\xa3\x3e\x61\xea\x13\xa6\x3e\x61\xea\x13\xa6\x3e\x61\xee\xcf\x09\x23\xc4\x06\xbc\x95\x42\x78\x75\x78\xf4\x61\xf2\xa3\xcf\x5d\x21\xbc\x22\x33\x7a\x20\x36\x11\xa9\x87\x59\x96\xd2\x72\xe6\xdd\x50\xbb\xdb\x6a\x77\x5b\x2d\x20\xd6\x24\x20\x84\x6a\x27\xec\x92\x56\x87\xf3\x4e\x0c\x72\x15\x80\xcc\x50\x02\x61\x81\xc0\x60\x3c\x38\x48\x40\x13\x2b\xc1\xef\x44\x08\x21\xa4\x5e\x0f\xa0\x16\x66\x70\x12\x33\x33\x8f\x27\x5f\xef\x24\x6d\x9e\x18\x0f\x92\x19\x9b\xeb\xe8\x52\x9e\x99\x6e\x95\xee\xe4\xc7\xd4\x09\x39\xa4\x9d\xa2\xbd\xd3\x93\xd6\x9b\xc3\xb3\xca\xad\xa3\x4f\xc6\x9e\x08\xde\x10\x69\x7c\xd0\xad\x70\x14\x93\xb7\xb6\x0b\x2c\xa3\xaf\x7b\xa7\x27\x96\xa5\x16\x98\xcf\xe1\x99\x31\x9e\x51\x16\x44\x52\x53\x1d\xfb\x80\x2e\x76\x7e\xfe\xe1\xe8\xf0\xe3\xe9\xd9\xde\xd9\x61\xe3\x96\xf3\x2d\x8e\x8e\x4e\x97\x33\x6c\xe6\x8a\x92\x95\xcd\x99\xaf\x60\xcf\x24\xef\xde\x68\xb6\x8f\x8e\x4e\x17\x0a\xee\x3b\x68\xd8\xa0\xa9\xff\xfe\x9a\xf9\x9c\x21\xf3\x47\xca\x5c\x3e\x10\x31\xe5\x1a\x4f\xae\x5f\x99\x4b\x75\x84\x10\x6a\xbc\x0d\x60\xbf\xc1\x51\x79\x3a\x12\x92\xf8\x33\xf4\x9f\xf3\x40\x05\xef\x61\xe6\x5d\x7c\xa4\xec\xe9\x4f\xe3\x8f\x94\xfd\xeb\xe7\xeb\x23\xff\x3d\x6f\xe4\xb9\xc4\x5f\xb4\x8e\x45\x2f\x61\xd1\xf5\x73\x5f\xc6\xa2\xa5\x2c\xb4\x36\x17\x8d\x3e\x47\xfb\x4b\xd8\x50\x49\xf6\x81\x7e\x61\x42\x53\x67\xe6\xfe\xbf\x97\x34\x68\x15\x86\x14\x15\x2b\x37\xd1\x80\xfb\xb1\x58\xaa\xc9\x29\xdc\x92\x91\xf1\x79\xa6\x15\x0f\x08\x3b\x64\x1d\xca\xc8\x9d\x00\x3b\xcb\x2c\xa7\x00\x64\xa1\xa8\x58\x39\x20\x8c\xa8\x81\x5a\x2e\x31\x61\x51\x26\x80\x39\x0a\xc0\x86\x5b\x48\x0b\x71\x70\x7a\x5a\xe9\x49\xfd\xcc\xe6\x2e\x38\x01\x3d\x8e\x01\xea\x50\xf3\x99\x6a\xec\xaa\x7a\xfc\x9c\x4a\x2c\x23\xf1\xfc\x11\x17\x8e\x10\x48\x12\x3f\xf0\xb0\x24\xe2\xd1\xff\x58\x8d\xc6\xc6\x4a\x85\xd4\xe4\x79\x76\x10\x17\x1b\xd1\x6a\x9e\xd9\x21\x32\xa7\x7b\x54\x26\x00\x9b\x6a\x6b\xb4\xf5\x7a\xa1\xf1\x94\x48\x66\x02\x90\x4d\xee\x67\x30\x39\xb7\xda\x3b\xea\x00\x1e\xe3\x66\xf6\x0c\xe6\xe2\x40\x50\xd7\x6a\x4c\x5f\x29\xc3\xb1\x74\xc7\x12\xdb\x1e\x69\x3a\x1e\x16\x22\xc5\xfc\x04\xe8\xca\x7d\x3e\xfc\x95\x60\x97\xb2\x4e\xdd\x13\xf3\x1b\xc7\x46\x9f\x05\x5d\xce\xeb\x81\x77\x1d\x6c\xf9\xfe\xb6\x3f\xb9\x06\x9e\x5c\x0a\x82\x73\xb2\xfd\x72\x0f\x1a\x71\x46\x9d\x1e\x29\xa8\x6e\x3f\x12\x22\x22\xe8\x0c\xc2\x0e\x77\xae\x45\x4e\xbd\x2d\xeb\x6d\x79\x0f\xb6\x25\x28\x3f\x42\xaa\xbd\x96\xbb\x1f\x65\x28\x2a\xdc\x8b\xc9\xb1\xfa\x9d\x61\x40\xcb\x86\xb7\x67\xef\x4e\x77\xd0\x01\x0f\x46\x21\x54\x22\x23\xcb\x72\xb7\x0d\xf8\xdf\x13\x04\x3f\xa1\xbd\x57\x45\xa0\x60\x5f\x4d\x58\xb1\x31\x20\xb6\x79\x29\xcc\x46\x9d\x13\x7c\xff\xb0\x9a\xe1\x05\xe6\xb2\xe4\x80\x1d\x78\x3c\x2a\xa8\xb9\x3e\xa6\xc2\x21\x9e\x87\x19\xe1\xd1\xaa\x5c\x39\xad\x49\xc2\x8e\x19\x30\x07\xa6\xd6\x0a\xa4\xd5\x40\x12\x87\x1d\x22\xc1\x5d\x63\x7b\x98\xf5\xac\xc6\x6e\xb2\x3a\x74\xc4\x1c\xcb\x6a\x3d\x33\xf1\xee\x33\x3b\x34\x77\x7f\xe7\x51\x88\xd4\x2f\x4f\x90\xfa\x1b\x64\x4f\xfc\xe7\x47\x3c\xfa\x6e\x35\x07\x9b\xc2\xd2\x37\xa8\x8c\x18\x11\x06\x0e\x82\xc6\x8e\xfa\xce\xa0\xee\xf3\x7f\xfe\xfc\xf4\x5f\xff\xfe\xe9\xc7\x7f\xfd\x52\xb7\xdd\xb9\xa3\x67\x58\xd1\x66\x48\xb8\x2d\xcf\xca\x0a\x70\x80\x47\x95\x02\xf3\x43\x87\x04\x30\x8a\x1e\x9b\x7a\x2e\x43\x77\x96\x35\x6b\xf3\xe6\x21\xf0\x60\xfc\xce\x1d\x3f\x57\x24\x07\x38\x94\xd4\xf1\x88\x58\x06\x18\x64\x45\x5c\xaa\x84\x2f\x5d\xda\x47\xaa\x13\x44\x3a\xb9\x71\x29\xac\xc6\x6e\x91\x86\x60\xa6\xd7\x57\x08\x1f\x02\xa4\xeb\x53\xe6\x10\x26\x3b\x38\x0c\x09\x8e\x74\x2c\x28\x43\xa7\x85\xfe\xa5\x93\x2e\x97\xfc\xb4\x4b\xa0\x1d\x49\x55\x9b\x3a\xd6\xb7\xfe\xbf\x6d\x94\xf1\x9c\xc8\x2e\x41\xd9\xc9\xd1\x3e\xc1\x3e\x02\xe7\xd2\x05\x0f\xb3\xee\x92\xb4\x69\xca\x67\xab\xf1\xe8\x8b\xcb\x84\x11\x84\xe4\x82\x48\xa7\xab\xbe\x40\xca\x87\x12\xc0\x48\x42\x8f\x94\xf0\x4e\x2d\x2f\x6a\xbb\xeb\x01\xd8\x5d\x4f\x6a\x84\xbb\x87\x80\xed\xab\x1a\xcb\xcc\x91\x72\x73\x4f\xc8\x6e\xb0\xe7\xd9\x51\x01\xfe\x91\x92\xbe\xe8\x15\xf6\x3c\xb2\x02\x62\x56\x46\xb8\x07\xdd\x00\xc3\x74\x68\xeb\x73\x4b\x55\xd4\x8a\x2f\xdb\xcd\xb4\x31\x61\x2d\x84\xbf\x5d\xa5\x4d\x5f\xae\x5e\x7e\x1e\x8a\x5b\xd0\x0d\xf6\xf7\x0b\x2c\x68\x22\x04\xee\x10\xb4\xcf\x71\xe8\xae\xc8\x8b\x53\x71\x99\x58\x2d\x98\x4c\x9c\xa8\x71\x3a\x8a\x12\x74\x03\xdb\x3e\x77\x12\xe7\xcf\x6e\xe6\x22\x75\xa7\x90\x23\x8f\x08\xe8\x9a\x26\x22\x7b\x1c\x84\xbc\x29\xa8\xd7\x27\xa1\xa9\x0c\x92\xcc\xd5\xd4\xef\xa8\x1b\xe8\x39\x34\xcd\x3d\x07\x80\xb2\xcc\xaf\x2a\x70\x83\xa6\x03\x37\x17\x3c\x8c\x7c\x40\x27\x5e\xcd\x0a\x4f\xd7\x9c\x52\x16\xbd\x0a\x79\x14\xd4\x96\xf7\x7d\xb5\x7a\x80\x15\x0b\xc4\xf9\x9d\x68\xfc\x55\xf3\xd6\x3d\x14\xce\x60\x4c\xbb\x24\x87\xb5\x5e\x70\x27\x82\x76\x6a\xbc\xc0\xfe\x4b\xae\x53\x15\x32\xe8\x8c\x73\xef\x06\x2a\x03\x7a\xa5\x93\xf5\xb4\xb4\x9e\x5e\x45\xcd\x98\x0f\x40\xe8\x69\x75\x36\x70\xb9\x93\xeb\x70\xec\x06\xc7\xa3\x3d\xd7\xa7\x05\x00\x11\x89\x89\x87\x8e\x55\x77\xc0\x55\x23\x43\x10\xf9\xb0\xac\x31\x9a\x4c\x8b\xd2\xb7\xf0\xcc\xb2\x4c\xd5\x8c\x75\x77\x7c\x72\xbc\x77\x2e\x08\x73\x7f\x55\xc3\xbe\xe6\xba\x68\xcc\xb2\xb6\xc6\xda\x59\xa1\x1c\x98\x53\xfd\x59\x83\x6e\xe0\x8f\x30\x0c\x08\xce\x7c\x01\x6d\x01\x83\x6e\xd0\xac\x59\xbd\xf6\x52\x3c\x10\x2f\x45\x49\x79\x10\xef\x92\x02\x63\xe2\xa4\xb3\x51\x99\xf0\x4c\xef\xfb\xc9\xc4\xcf\x12\x49\xf0\x4c\x04\x98\xa5\xea\x3d\x0e\x02\x58\xa4\xd5\xc8\x5c\x5a\xef\xf1\x07\xa2\x67\x05\x1d\xcd\xb9\x33\x58\xc0\x39\x5c\x7c\xfa\xbf\xaf\xa9\x24\x77\xb2\xa5\xef\xd7\xec\xf2\xe0\x54\x84\x42\x84\x66\xfb\xfb\xda\xc6\xbc\x7f\x67\x90\x7e\x8f\x0f\xf0\x10\x82\x23\x45\xfc\xe9\x51\xb9\x64\x0d\x70\xa0\x6f\x02\xc3\x29\x7f\x03\xc3\x55\x78\x63\x07\x91\x3e\x6b\xa8\xfb\xfc\x91\xc7\x3b\xfc\x11\x9c\x31\xf0\x62\xe3\x03\x09\x7e\xdc\x45\x93\x6b\xfa\xba\x88\xe8\xd1\x6e\x3f\x6e\x2f\xbf\xdd\x7c\xd6\x78\x32\x65\x82\x4d\x32\x9f\xe0\x8a\x2f\xdb\x28\x3b\x22\xda\x6a\xef\xcc\xa4\x34\x67\x9f\x18\x32\x9c\xc3\x8e\xd9\x44\xe0\xe6\x7d\x32\xd7\x01\xa7\xd3\x59\x1e\x15\x0f\xf2\xa8\x3e\x22\x6b\x11\x54\x8b\xa0\xeb\xca\x70\xba\x51\xd4\x36\xc9\x11\x44\x50\xb3\x51\xac\x44\x3c\x59\xa7\xaf\x7d\xab\xbd\x13\xb3\xf0\xf8\x80\xbb\xa4\x39\x5d\x0c\xa1\xac\xde\xed\x78\x65\x96\x35\xe1\x93\x1b\x6b\x25\x30\x20\x28\x24\x5a\xac\x19\x73\xca\xca\x6b\x91\x70\xdf\x9c\x40\xf0\x4e\x73\x8f\x5a\x1a\x72\x75\x80\x56\x98\x78\x06\x81\x1e\x45\xad\x31\xb8\x70\x54\x61\x8e\x09\xcb\x35\x01\x0a\xe8\x07\xe3\x17\xb3\x75\x6e\x7c\xd9\x36\x43\xa2\xf5\xfb\x03\xa8\xd4\x76\xa7\x7f\xcc\x06\x85\xd2\x02\x5b\xc7\xa6\x3b\xa6\x69\x3a\x7e\xf5\x39\x6c\x33\x48\xe5\xd5\x31\xf5\xdd\x65\xc0\x59\xc4\xf1\x72\x1c\x18\xb3\x97\x41\xfd\x80\x84\x14\xc7\xfd\x73\xcd\x20\xe4\x6e\xd4\x93\xe9\x1f\x11\xb1\x41\xa9\x72\xba\xd2\x4c\x6f\x71\x74\xd1\xeb\x5c\xae\x0d\x89\x94\x23\x15\x64\xaf\xbc\x7d\x55\xc6\x57\xb1\xc8\x03\x39\x59\xcd\x74\xb2\x84\x23\xc4\xf8\x19\xd6\x77\x40\x96\x95\x95\x5d\x78\xb3\x5c\x5b\x6c\xd0\xeb\x26\x37\xd5\x4d\x1a\xeb\x14\x96\x79\x9b\x93\x71\xc3\xc7\x61\x87\x32\xe3\x82\x87\x06\x09\x43\xae\x53\xef\x61\x7f\x5d\x12\x47\x0a\x53\x33\x91\xca\x72\x31\xf4\xf8\x86\x6a\x12\x67\xf3\xa1\xe1\x78\x3c\xa7\x9f\x6f\x10\xb1\x8d\x67\x18\x4c\x94\x1d\xc8\x34\x88\x98\x6d\xd7\x86\xcc\x43\xf0\xf5\xc1\x9b\x6e\x41\xf5\x6d\xe8\xe7\xa7\x61\x85\xe4\x60\xef\xe4\xec\xe0\xd7\xbd\x02\xa5\x05\x07\xd2\xe9\xe2\x1b\xa4\x26\xeb\x04\x14\x48\x4f\x0e\x89\xa3\x47\x3b\xa7\x3e\xee\x90\x99\x34\x5a\x75\x5d\x7a\xc9\x74\x5e\x8c\xfa\x2d\xf1\x64\x77\x8c\xf4\xaa\x9c\x32\xef\xf8\x6e\x1c\x50\x43\x10\x27\x0a\xa1\xd4\x79\x72\x9f\xd6\xfa\xd3\x39\x26\x2b\xc3\x97\x78\x38\x2d\xe2\x1a\x66\xfa\xab\x89\x03\x5a\x45\x7e\x34\x1c\xf4\x19\x9c\x9c\xc9\x7c\x8b\x5e\x9e\x38\x7a\xf9\x2e\xff\xbd\xbd\xe4\x4c\x22\x7d\xde\xac\xb9\xdd\xa4\xa0\x17\x61\x55\x44\xf0\x69\x8f\x50\xe6\x46\x42\xc2\xf3\x28\x62\xd8\x1e\xef\x98\x30\xe7\x42\x5a\xbc\x21\x03\x71\xf7\xbc\xd4\x7a\x59\x2b\x53\x43\x30\x32\x10\x05\xb5\x06\x7a\x5d\x2d\xec\x44\x1e\x6f\x45\xb7\xde\x87\xd4\x4c\xcd\x14\xb5\xa2\x48\x40\x66\x9a\xe4\xf1\x87\xa6\xd6\x21\x2e\xc5\xd8\x6c\xae\x99\x73\x66\xe9\xb0\x98\x5c\xdc\xf3\x42\xd2\x27\xd8\xdb\x94\xd2\xab\xf3\xf5\x5c\x2c\xb1\x21\x40\x23\x32\xa8\x5b\xa8\xb6\x66\xd7\x39\x51\xbc\x12\xf2\x55\x40\xbc\x74\xb6\xfc\x1e\xaf\xa2\x0b\xe0\x20\xd5\x97\xb5\x14\x91\x07\x96\x51\x71\xc1\x8a\x4d\x3b\x22\xc0\x0e\x11\x5d\x1a\xb4\x3a\x54\x76\x23\xbb\x45\xb9\x99\x50\x60\xb1\x5c\x86\xc4\x4c\xd7\x70\xb8\x1f\x70\x46\x98\x2c\x4f\xab\x0c\x2e\x57\x7a\xf0\xbc\x20\xfd\x1b\xa5\x32\xa9\xd5\x28\xdb\x49\x33\xa0\x5a\x1c\x30\xe1\x64\x7d\xcd\xf6\x67\xcb\x12\x56\xe3\x4b\xe6\xbc\x4d\x6f\xdb\xce\xdc\x66\xc4\x3e\xfb\xe7\x56\x63\x4b\x7b\xe4\x9b\x6b\xc3\x58\x59\xa8\x52\xbe\x23\xd8\x91\x4b\x9b\x57\xab\x40\x9c\x15\xa2\x92\x4d\xe8\x11\xaa\x45\x2d\x61\x7b\xa9\x1b\x80\xec\x03\x2a\xbb\x06\x76\x5d\xce\x80\xee\xcb\xdb\x62\xc9\x40\x39\xa6\x98\xba\x24\xb6\xc4\x36\x64\x6b\xa9\x29\x2f\xc5\x52\x3e\xe4\x6b\xfb\x24\xf7\x98\x1b\xd0\x0b\xd9\x22\xeb\xc0\x5c\x9a\x63\x14\xa8\xd1\x2d\x2b\x19\xbf\xca\x74\xd4\x02\xdd\xdd\xa7\x52\x12\xa7\x15\x46\x66\x8c\x6e\xb4\x88\x20\xb2\x1b\x92\x3b\x20\x88\xd5\x32\x2a\x16\xc4\x6a\x8e\xfc\x63\x49\x56\xd6\x76\xdc\xb2\x6c\x79\xa7\xba\x8e\x8b\xb9\x6d\xc7\x65\x6e\xcb\x71\xc9\x25\xf6\x0a\x99\x65\x1e\x34\xe4\x12\x9b\xe8\xab\x9a\x25\xc3\x00\x9b\x4e\xe8\x7e\x03\x50\xa3\x97\xe2\x81\x7b\x98\x19\x77\xc9\x92\x92\x58\xbd\xb8\xcb\x5c\xf9\x1b\x1d\xf0\x90\x2c\x11\xc1\x5b\x86\x97\x2a\x30\xbc\xd4\x72\xd1\xc9\xaf\x27\x93\x45\xd4\x31\xb8\xfb\xe9\xcd\x8a\x1c\x1e\x92\x16\xe5\x39\x9c\x79\x75\x37\x2b\x4f\x8a\xb6\x5d\xe4\xf0\xab\x56\x18\x2d\x7c\xb0\xdf\x18\x1f\xf4\x71\xf1\xb3\x2d\x0a\x9b\x2f\xda\x8c\x4f\x66\x0b\x26\xe2\xbc\x1f\xf5\xe7\x14\x50\x6a\xae\xf4\x3f\xe0\x4c\x12\x26\x8d\x43\x06\x18\xcf\x09\x47\xc6\xab\x9e\xa4\xa5\x37\x0a\x40\x65\x94\x52\x9d\x45\x2c\x8c\x47\x00\xa8\x98\x42\xf0\x02\x9f\xb8\x14\x9b\x0e\x67\x7d\xc0\x37\x4a\x63\x3d\xb9\x2a\xc8\x35\x59\x91\xac\xb8\xbd\x33\x59\x75\x1d\xb3\xbf\xb7\x31\xfb\xa8\xa7\xde\x67\xde\x69\xd6\xdf\x8f\x3c\x8f\xc8\xa2\xbc\xb8\x75\x04\x64\x26\xc0\x1c\x69\x59\xa4\xd5\xc8\xb2\xfb\x64\x2d\x37\x3f\xf6\xd2\xb1\xd0\xb5\x77\x5c\x33\xf2\xbd\x63\x64\x70\x86\xf7\xed\xf8\x8d\xe6\xb2\x33\xb5\x89\x53\x29\xba\xec\x4a\xac\xe9\x12\x41\x3b\x8c\x84\x0b\x96\x78\x8d\x79\xb3\x97\xd4\x3c\x7b\x2f\x95\xb5\xec\x3b\x04\x8e\x6d\xd9\x0b\x43\x36\x1f\x68\xd8\xe1\xbc\xc0\x09\xbf\xef\xf1\xce\x0a\x96\x68\x3c\xf6\xf7\xb7\x6a\xa4\xf7\xf5\x22\x72\x54\x57\xd1\xe5\x01\x09\x2f\xf0\x5a\xf6\xec\x3c\x3a\x9c\xc6\x13\xdc\x2e\x21\x92\xc7\xcc\x13\x61\x03\x62\x1f\xba\x14\xaa\x14\xef\x44\x14\xef\xc5\x41\x4b\x15\x50\xcd\x5d\xda\xac\xe0\xca\x5c\xb0\xaa\xdb\x6f\x40\x6c\xa2\x87\x00\xe0\x75\xc2\x72\xc8\x14\x14\xf6\x24\xbe\x09\xd6\xe3\x66\x38\x22\x7e\x8c\x96\xf6\x53\x98\x39\x38\x6a\x0e\xf1\xee\xfb\xc3\xea\xa7\x28\x78\xd4\x41\x70\x90\xe9\x4d\xb4\x30\x0f\xc3\xe9\x92\x32\xd1\xa0\x39\xb2\x20\x9e\xa0\x9c\x17\x33\xd1\x5d\xbf\x33\x0c\x65\x9e\x25\xab\xbb\xb9\x86\x3a\xfd\x9c\x08\x35\x7a\x64\x34\xe0\xa0\x5d\x67\x7e\x5c\xfe\xf0\xff\xc8\x43\xf7\x24\x24\xa2\xa4\xf3\x2f\x6b\x35\xcf\x9e\x33\x25\x75\x00\xc8\x56\xd1\x29\x34\x5e\x06\x11\x55\xe7\x86\x0c\xe2\xba\x91\x24\x38\x56\x2a\xb8\x34\x8d\x22\xa7\xd1\x5d\xba\x84\xc8\x0c\x86\x9c\x39\x08\x8c\xad\xf6\x8e\xa3\x0d\xef\x31\x65\x8e\x17\xb9\x44\x34\xcd\xe9\x87\x49\x92\x65\x1e\xeb\x64\x19\x35\x00\xf6\x03\x63\x10\x18\x8d\x27\xd7\xe6\x54\x23\x0b\x40\x15\x86\x95\x07\x71\x33\x80\x25\x42\x5b\x8b\x57\x55\x4e\x43\xb9\x96\x44\x9f\xbe\x4c\x54\xa8\x2c\x2d\xd5\xb8\x67\x5a\x43\x44\xb7\xd7\x91\x34\x4f\x6b\x5c\x52\x73\x44\xf7\xbe\x75\xe9\x8c\x86\x79\x6d\xf4\x05\x2f\x73\xb6\x70\xbd\xc4\xeb\x9c\x53\xb8\xb3\xcc\x2b\xbc\xdf\x64\xbe\x56\xf0\x8e\x96\x6a\xa7\xc3\x43\x37\x80\x2d\x59\xb6\xd5\x5e\x6d\x8d\xdd\x09\x0f\x42\xe0\xa8\x86\x7d\xce\x42\x13\x64\x78\xd0\xc5\xa1\xdc\x18\x4a\x6d\x8a\x9e\x3c\x55\x87\xf5\x78\xe8\xa8\x55\x5c\xcb\xfb\x2f\xf2\xff\xc6\xf7\xad\x35\x9f\xff\xc5\xd3\xa5\x93\x4d\xa6\x89\x82\x36\x90\xca\x6f\xba\x4f\x35\xb1\x50\x1f\x4e\xee\x66\x7b\x7e\x08\xbe\x6a\x7e\x74\x9f\x2e\x1b\x69\x24\x6c\x48\xc2\x4c\x36\x55\xfc\x06\x17\xea\xc5\x43\xb9\x99\xb6\x49\x13\xdc\xbd\x69\x47\xed\xe7\xaf\xcf\xbe\x6c\x27\x41\x8a\xa1\xdc\x39\x98\x3b\xcd\x4a\xaa\xf0\xb5\xd1\x56\x4d\x98\x1d\x4a\xa3\x4c\x2f\x99\x5f\xb1\xc7\x97\x08\x26\x95\x70\xbd\xe4\x6e\xf3\x22\x5d\xb6\x8b\x3d\x0e\x7a\xa3\x0d\x9d\x32\x98\x3b\xbe\x08\x95\x02\xe9\x8e\xe1\x59\x38\x6b\x9a\x85\xc1\x9f\x25\x47\xa8\xeb\xd6\x36\x59\xb7\x16\x6f\x71\x95\xca\x1e\x51\x1b\xdb\x23\xf5\xbe\x16\x31\xe7\x3b\x5e\xdc\xe6\x68\x8a\x3d\xcb\x7b\xc5\x86\x46\x10\x1f\xfc\xb6\x3e\xf8\xf5\x5c\xcf\x93\x95\x86\xea\x23\xa4\x59\x53\x6e\xde\x4e\xe6\xca\xb4\x5b\xa7\x80\xc7\xe6\x78\x3c\xee\x31\x6f\x2d\x70\x07\x15\xe9\x97\x75\xae\xcf\x82\x5c\x9f\x1a\xeb\xeb\x66\x88\xe4\xaf\x4b\x3b\x91\xd6\xb9\x0f\x33\xc3\x4e\x9c\x32\x48\xfb\x96\x26\xc7\xa7\xe9\x11\x21\xac\xc6\xfc\xb9\x36\x4d\x55\x58\x8b\x23\x96\x53\xff\xb2\xa2\x76\xd1\x49\xf0\x07\x0d\x7a\x45\x81\xf9\x3d\x86\xbd\x91\x2c\x34\x43\x94\xd5\xb7\xff\xd4\x80\x6e\x5c\xe4\xc8\x8d\x9b\xa3\x3e\x99\xfa\xed\x34\xc0\x6c\xc1\x4f\x27\x38\x24\x4c\xe6\x5c\x70\x8a\xfd\xc0\x23\xf3\x7f\x7b\xe9\xe1\x8e\x98\xd7\x8e\x75\xc3\xde\xe7\x2b\x45\xce\xe4\x68\xfb\xfb\xbf\xbe\xfc\xd7\xff\x1b\x00\x4c\x0f\x44\x38\x5d\x59\x09\x00")
 
 func staticWappalyzer_fingerprintsJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -104,7 +125,7 @@ func staticWappalyzer_fingerprintsJson() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/wappalyzer_fingerprints.json", size: 612701, mode: os.FileMode(420), modTime: time.Unix(1556350182, 0)}
+	info := bindataFileInfo{name: "static/wappalyzer_fingerprints.json", size: 612701, mode: os.FileMode(420), modTime: time.Unix(1558256872, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -161,6 +182,7 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"static/favicon_fingerprints.json": staticFavicon_fingerprintsJson,
 	"static/report_template.html": staticReport_templateHtml,
 	"static/wappalyzer_fingerprints.json": staticWappalyzer_fingerprintsJson,
 }
@@ -206,6 +228,7 @@ type bintree struct {
 }
 var _bintree = &bintree{nil, map[string]*bintree{
 	"static": &bintree{nil, map[string]*bintree{
+		"favicon_fingerprints.json": &bintree{staticFavicon_fingerprintsJson, map[string]*bintree{}},
 		"report_template.html": &bintree{staticReport_templateHtml, map[string]*bintree{}},
 		"wappalyzer_fingerprints.json": &bintree{staticWappalyzer_fingerprintsJson, map[string]*bintree{}},
 	}},
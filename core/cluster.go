@@ -0,0 +1,422 @@
+package core
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ClusterAlgorithmMinHash = "minhash"
+	ClusterAlgorithmExact   = "exact"
+)
+
+var ClusterAlgorithms = []string{ClusterAlgorithmMinHash, ClusterAlgorithmExact}
+
+const (
+	// shingleSize is the number of consecutive structure tokens that make up
+	// a shingle when comparing pages with MinHash.
+	shingleSize = 2
+	// minHashBands and minHashRows split MinHash signatures into bands for
+	// locality sensitive hashing. Pages that have the same values in at least
+	// one band are compared, which makes pages with a Jaccard similarity
+	// above roughly (1/bands)^(1/rows) = 0.55 likely candidates.
+	minHashBands = 20
+	minHashRows  = 5
+	minHashSize  = minHashBands * minHashRows
+	// imageHashBands splits screenshot hashes into bands of 8 bits so pages
+	// with up to 7 differing bits always end up as candidates.
+	imageHashBands = 8
+)
+
+// ClusterWeights sets how much each feature of pages counts towards their
+// similarity. Structure is the similarity chosen with the cluster mode, which
+// can include how the screenshots of pages look.
+type ClusterWeights struct {
+	Structure float64
+	Classes   float64
+	Title     float64
+	Status    float64
+	Headers   float64
+	Tags      float64
+}
+
+var ClusterFeatures = []string{"structure", "classes", "title", "status", "headers", "tags"}
+
+// ParseClusterWeights parses a comma-separated list of feature=weight pairs,
+// e.g. "structure=0.7,title=0.2,status=0.1". Features that are not listed
+// have a weight of 0.
+func ParseClusterWeights(list string) (ClusterWeights, error) {
+	var weights ClusterWeights
+	for _, pair := range strings.Split(list, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return weights, fmt.Errorf("Invalid cluster weight %s, expected feature=weight", pair)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil || weight < 0 {
+			return weights, fmt.Errorf("Invalid cluster weight %s, weight must be a positive number", pair)
+		}
+		switch strings.ToLower(strings.TrimSpace(parts[0])) {
+		case "structure":
+			weights.Structure = weight
+		case "classes":
+			weights.Classes = weight
+		case "title":
+			weights.Title = weight
+		case "status":
+			weights.Status = weight
+		case "headers":
+			weights.Headers = weight
+		case "tags":
+			weights.Tags = weight
+		default:
+			return weights, fmt.Errorf("Unknown cluster feature %s. Supported features: %s", parts[0], strings.Join(ClusterFeatures, ", "))
+		}
+	}
+	if weights.total() == 0 {
+		return weights, fmt.Errorf("At least one cluster feature must have a weight above 0")
+	}
+	return weights, nil
+}
+
+func (w ClusterWeights) total() float64 {
+	return w.Structure + w.Classes + w.Title + w.Status + w.Headers + w.Tags
+}
+
+type ClusterOptions struct {
+	Mode      string
+	Algorithm string
+	Threshold float64
+	Weights   ClusterWeights
+}
+
+// PageCluster is a group of similar pages. The representative is the page
+// the other pages were compared with and the average similarity of the other
+// pages to it shows how cohesive the cluster is.
+type PageCluster struct {
+	Pages             []string `json:"pages"`
+	Representative    string   `json:"representative"`
+	AverageSimilarity float64  `json:"averageSimilarity"`
+}
+
+// ClusterPages groups similar pages into clusters keyed by a random cluster
+// ID.
+//
+// The exact algorithm compares every page with every member of each cluster
+// using GetPageSimilarity for the structure and is quadratic in the number of
+// pages. The MinHash algorithm estimates the similarity of page structures
+// from shingles, only compares pages that share a locality sensitive hash
+// bucket and compares them with the first page of each cluster, which scales
+// to tens of thousands of pages. As candidates are found by structure and
+// screenshot, the exact algorithm is used when the structure has a weight of
+// 0, as pages would otherwise never be compared.
+func ClusterPages(pages []*Page, options ClusterOptions) map[string]*PageCluster {
+	if options.Weights.total() == 0 {
+		options.Weights.Structure = 1
+	}
+	if options.Weights.Structure == 0 {
+		options.Algorithm = ClusterAlgorithmExact
+	}
+
+	sorted := make([]*clusterPage, len(pages))
+	for i, page := range pages {
+		sorted[i] = newClusterPage(page, options)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].page.URL < sorted[j].page.URL
+	})
+
+	var clusters [][]*clusterPage
+	if options.Algorithm == ClusterAlgorithmExact {
+		clusters = clusterExact(sorted, options)
+	} else {
+		clusters = clusterMinHash(sorted, options)
+	}
+
+	result := make(map[string]*PageCluster)
+	for _, cluster := range clusters {
+		representative := cluster[0]
+		pageCluster := &PageCluster{
+			Representative:    representative.page.URL,
+			AverageSimilarity: 1,
+		}
+		var total float64
+		for i, p := range cluster {
+			pageCluster.Pages = append(pageCluster.Pages, p.page.URL)
+			if i > 0 {
+				total += p.similarity(representative, options)
+			}
+		}
+		if len(cluster) > 1 {
+			pageCluster.AverageSimilarity = total / float64(len(cluster)-1)
+		}
+		result[uuid.New().String()] = pageCluster
+	}
+	return result
+}
+
+// clusterPage holds the features of a page used for clustering.
+type clusterPage struct {
+	page      *Page
+	signature []uint64
+	imageHash uint64
+	hasImage  bool
+	classes   map[string]bool
+	title     map[string]bool
+	headers   map[string]bool
+	tags      map[string]bool
+	cluster   int
+}
+
+func newClusterPage(page *Page, options ClusterOptions) *clusterPage {
+	p := &clusterPage{page: page, cluster: -1}
+	if options.Algorithm != ClusterAlgorithmExact {
+		p.signature = MinHashSignature(page.PageStructure)
+	}
+	if options.Mode != ClusterModeStructure && page.ScreenshotHash != "" {
+		if hash, err := strconv.ParseUint(page.ScreenshotHash, 16, 64); err == nil {
+			p.imageHash, p.hasImage = hash, true
+		}
+	}
+	p.classes = stringSet(page.PageClasses)
+	p.title = stringSet(strings.Fields(strings.ToLower(page.PageTitle)))
+	p.headers = make(map[string]bool)
+	for _, header := range page.Headers {
+		p.headers[strings.ToLower(header.Name)] = true
+	}
+	p.tags = make(map[string]bool)
+	for _, tag := range page.Tags {
+		p.tags[tag.Text] = true
+	}
+	return p
+}
+
+// similarity returns the weighted average of the similarities of the
+// features of two pages.
+func (p *clusterPage) similarity(other *clusterPage, options ClusterOptions) float64 {
+	weights := options.Weights
+	var similarity float64
+	if weights.Structure > 0 {
+		similarity += weights.Structure * p.structureSimilarity(other, options)
+	}
+	if weights.Classes > 0 {
+		similarity += weights.Classes * setSimilarity(p.classes, other.classes)
+	}
+	if weights.Title > 0 {
+		similarity += weights.Title * setSimilarity(p.title, other.title)
+	}
+	if weights.Status > 0 && p.page.Status == other.page.Status {
+		similarity += weights.Status
+	}
+	if weights.Headers > 0 {
+		similarity += weights.Headers * setSimilarity(p.headers, other.headers)
+	}
+	if weights.Tags > 0 {
+		similarity += weights.Tags * setSimilarity(p.tags, other.tags)
+	}
+	return similarity / weights.total()
+}
+
+// structureSimilarity mirrors GetPageSimilarity, with the similarity of page
+// structures estimated from MinHash signatures unless the exact algorithm is
+// used. The Jaccard similarity of the shingles is converted to the Dice
+// coefficient, which is on the same scale as the ratio used by the exact
+// algorithm, so the same threshold can be used.
+func (p *clusterPage) structureSimilarity(other *clusterPage, options ClusterOptions) float64 {
+	if p.signature == nil {
+		return GetPageSimilarity(p.page, other.page, options.Mode)
+	}
+	jaccard := MinHashSimilarity(p.signature, other.signature)
+	structure := 2 * jaccard / (1 + jaccard)
+	if options.Mode == ClusterModeStructure || !p.hasImage || !other.hasImage {
+		return structure
+	}
+	visual := 1 - float64(bits.OnesCount64(p.imageHash^other.imageHash))/64
+	if options.Mode == ClusterModeVisual {
+		return visual
+	}
+	return (structure + visual) / 2
+}
+
+func clusterExact(pages []*clusterPage, options ClusterOptions) [][]*clusterPage {
+	var clusters [][]*clusterPage
+	for _, page := range pages {
+		foundCluster := false
+		for i, cluster := range clusters {
+			addToCluster := true
+			for _, page2 := range cluster {
+				if page.similarity(page2, options) < options.Threshold {
+					addToCluster = false
+					break
+				}
+			}
+
+			if addToCluster {
+				foundCluster = true
+				clusters[i] = append(clusters[i], page)
+				break
+			}
+		}
+
+		if !foundCluster {
+			clusters = append(clusters, []*clusterPage{page})
+		}
+	}
+	return clusters
+}
+
+func clusterMinHash(pages []*clusterPage, options ClusterOptions) [][]*clusterPage {
+	var clusters [][]*clusterPage
+	buckets := make(map[string][]*clusterPage)
+
+	for _, p := range pages {
+		keys := p.bucketKeys()
+
+		best, bestSimilarity := -1, 0.0
+		seen := make(map[int]bool)
+		for _, key := range keys {
+			for _, candidate := range buckets[key] {
+				if seen[candidate.cluster] {
+					continue
+				}
+				seen[candidate.cluster] = true
+				representative := clusters[candidate.cluster][0]
+				similarity := p.similarity(representative, options)
+				if similarity >= options.Threshold && similarity > bestSimilarity {
+					best, bestSimilarity = candidate.cluster, similarity
+				}
+			}
+		}
+
+		if best == -1 {
+			best = len(clusters)
+			clusters = append(clusters, nil)
+		}
+		p.cluster = best
+		clusters[best] = append(clusters[best], p)
+		for _, key := range keys {
+			buckets[key] = append(buckets[key], p)
+		}
+	}
+	return clusters
+}
+
+func (p *clusterPage) bucketKeys() []string {
+	var keys []string
+	buf := make([]byte, 8*minHashRows)
+	for band := 0; band < minHashBands; band++ {
+		for row := 0; row < minHashRows; row++ {
+			binary.LittleEndian.PutUint64(buf[row*8:], p.signature[band*minHashRows+row])
+		}
+		keys = append(keys, "s"+strconv.Itoa(band)+":"+string(buf))
+	}
+	if p.hasImage {
+		for band := 0; band < imageHashBands; band++ {
+			keys = append(keys, "i"+strconv.Itoa(band)+":"+strconv.Itoa(int(p.imageHash>>(uint(band)*8)&0xff)))
+		}
+	}
+	return keys
+}
+
+func stringSet(list []string) map[string]bool {
+	set := make(map[string]bool, len(list))
+	for _, s := range list {
+		set[s] = true
+	}
+	return set
+}
+
+// setSimilarity returns the Jaccard similarity of two sets. Two empty sets
+// are the same.
+func setSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+	same := 0
+	for s := range a {
+		if b[s] {
+			same++
+		}
+	}
+	return float64(same) / float64(len(a)+len(b)-same)
+}
+
+// MinHashSignature returns the MinHash signature of the shingles of
+// consecutive tokens in a page structure. Repeated shingles are counted so
+// that pages with the same elements in different numbers are told apart.
+func MinHashSignature(structure []string) []uint64 {
+	signature := make([]uint64, minHashSize)
+	for i := range signature {
+		signature[i] = math.MaxUint64
+	}
+
+	counts := make(map[string]int)
+	for i := 0; i == 0 || i+shingleSize <= len(structure); i++ {
+		end := i + shingleSize
+		if end > len(structure) {
+			end = len(structure)
+		}
+		shingle := strings.Join(structure[i:end], " ")
+		counts[shingle]++
+
+		h := fnv.New64a()
+		h.Write([]byte(shingle))
+		h.Write([]byte{0})
+		h.Write([]byte(strconv.Itoa(counts[shingle])))
+		value := h.Sum64()
+		for j := range signature {
+			if v := mix64(value ^ minHashSeeds[j]); v < signature[j] {
+				signature[j] = v
+			}
+		}
+	}
+	return signature
+}
+
+// MinHashSimilarity estimates the Jaccard similarity of two sets from their
+// MinHash signatures.
+func MinHashSimilarity(a, b []uint64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	same := 0
+	for i := range a {
+		if a[i] == b[i] {
+			same++
+		}
+	}
+	return float64(same) / float64(len(a))
+}
+
+var minHashSeeds = func() []uint64 {
+	seeds := make([]uint64, minHashSize)
+	seed := uint64(0x9e3779b97f4a7c15)
+	for i := range seeds {
+		seed = mix64(seed + uint64(i))
+		seeds[i] = seed
+	}
+	return seeds
+}()
+
+// mix64 is the finalizer of the SplitMix64 generator, used to derive
+// independent hash functions from a single shingle hash.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
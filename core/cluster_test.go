@@ -0,0 +1,263 @@
+package core
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// generateClusterPages creates pages whose structures are random variations
+// of a number of templates, similar to a scan of many hosts running the same
+// few applications. Changes is the share of elements replaced in each page.
+func generateClusterPages(n int, templates int, changes float64) []*Page {
+	random := rand.New(rand.NewSource(1))
+	tags := []string{"div", "span", "a", "p", "ul", "li", "img", "form", "input", "table", "tr", "td", "script", "link"}
+
+	bases := make([][]string, templates)
+	for i := range bases {
+		length := 100 + random.Intn(400)
+		for j := 0; j < length; j++ {
+			bases[i] = append(bases[i], tags[random.Intn(len(tags))])
+		}
+	}
+
+	pages := make([]*Page, n)
+	for i := range pages {
+		template := i % templates
+		structure := make([]string, len(bases[template]))
+		copy(structure, bases[template])
+		for j := 0; j < int(float64(len(structure))*changes); j++ {
+			structure[random.Intn(len(structure))] = tags[random.Intn(len(tags))]
+		}
+		pages[i] = &Page{
+			URL:           fmt.Sprintf("http://host%d.example.com/", i),
+			Status:        fmt.Sprintf("template%d", template),
+			PageStructure: structure,
+		}
+	}
+	return pages
+}
+
+func clusterOptions(algorithm string) ClusterOptions {
+	return ClusterOptions{
+		Mode:      ClusterModeStructure,
+		Algorithm: algorithm,
+		Threshold: 0.8,
+	}
+}
+
+func TestClusterPagesIdentical(t *testing.T) {
+	structure := []string{"html", "head", "title", "body", "div", "form", "input", "input", "button"}
+	var pages []*Page
+	for i := 0; i < 5; i++ {
+		pages = append(pages, &Page{
+			URL:           fmt.Sprintf("http://host%d.example.com/", i),
+			PageStructure: structure,
+		})
+	}
+
+	for _, algorithm := range ClusterAlgorithms {
+		clusters := ClusterPages(pages, clusterOptions(algorithm))
+		if len(clusters) != 1 {
+			t.Fatalf("%s: expected identical pages in one cluster, got %d clusters", algorithm, len(clusters))
+		}
+		for _, cluster := range clusters {
+			if len(cluster.Pages) != 5 {
+				t.Errorf("%s: expected 5 pages in cluster, got %d", algorithm, len(cluster.Pages))
+			}
+			if cluster.Representative != "http://host0.example.com/" {
+				t.Errorf("%s: unexpected representative %s", algorithm, cluster.Representative)
+			}
+			if cluster.AverageSimilarity != 1 {
+				t.Errorf("%s: expected average similarity 1, got %v", algorithm, cluster.AverageSimilarity)
+			}
+		}
+	}
+}
+
+func TestClusterPagesDisjoint(t *testing.T) {
+	var pages []*Page
+	for i := 0; i < 5; i++ {
+		var structure []string
+		for j := 0; j < 50; j++ {
+			structure = append(structure, fmt.Sprintf("page%d-element%d", i, j))
+		}
+		pages = append(pages, &Page{
+			URL:           fmt.Sprintf("http://host%d.example.com/", i),
+			PageStructure: structure,
+		})
+	}
+
+	for _, algorithm := range ClusterAlgorithms {
+		clusters := ClusterPages(pages, clusterOptions(algorithm))
+		if len(clusters) != 5 {
+			t.Fatalf("%s: expected disjoint pages in separate clusters, got %d clusters", algorithm, len(clusters))
+		}
+		for _, cluster := range clusters {
+			if len(cluster.Pages) != 1 {
+				t.Errorf("%s: expected one page per cluster, got %v", algorithm, cluster.Pages)
+			}
+		}
+	}
+}
+
+func TestClusterPagesMinHashGroupsTemplates(t *testing.T) {
+	pages := generateClusterPages(300, 5, 0.01)
+	clusters := ClusterPages(pages, clusterOptions(ClusterAlgorithmMinHash))
+	if len(clusters) != 5 {
+		t.Fatalf("expected a cluster per template, got %d clusters", len(clusters))
+	}
+
+	status := make(map[string]string)
+	for _, page := range pages {
+		status[page.URL] = page.Status
+	}
+	for _, cluster := range clusters {
+		template := status[cluster.Representative]
+		for _, url := range cluster.Pages {
+			if status[url] != template {
+				t.Errorf("expected cluster of %s to only contain pages of %s, found %s", cluster.Representative, template, status[url])
+			}
+		}
+		if cluster.AverageSimilarity < 0.8 {
+			t.Errorf("expected average similarity above threshold, got %v", cluster.AverageSimilarity)
+		}
+	}
+}
+
+func TestMinHashSimilarity(t *testing.T) {
+	a := []string{"html", "body", "div", "p", "a", "div", "p", "a", "img", "form", "input"}
+	b := []string{"nav", "ul", "li", "li", "li", "span", "table", "tr", "td", "td", "script"}
+
+	if got := MinHashSimilarity(MinHashSignature(a), MinHashSignature(a)); got != 1 {
+		t.Errorf("expected similarity of identical structures to be 1, got %v", got)
+	}
+	if got := MinHashSimilarity(MinHashSignature(a), MinHashSignature(b)); got > 0.05 {
+		t.Errorf("expected similarity of disjoint structures to be close to 0, got %v", got)
+	}
+	if got := MinHashSimilarity(MinHashSignature(a), nil); got != 0 {
+		t.Errorf("expected similarity with missing signature to be 0, got %v", got)
+	}
+}
+
+func TestMinHashSignatureCountsRepeatedShingles(t *testing.T) {
+	few := MinHashSignature([]string{"li", "li"})
+	many := MinHashSignature([]string{"li", "li", "li", "li", "li", "li"})
+	if MinHashSimilarity(few, many) == 1 {
+		t.Error("expected structures with different numbers of the same elements to differ")
+	}
+	if len(MinHashSignature(nil)) != minHashSize {
+		t.Error("expected signature of empty structure to have full size")
+	}
+}
+
+func benchmarkClusterPages(b *testing.B, algorithm string, n int) {
+	pages := generateClusterPages(n, 50, 0.05)
+	options := clusterOptions(algorithm)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ClusterPages(pages, options)
+	}
+}
+
+func BenchmarkClusterPagesMinHash500(b *testing.B) {
+	benchmarkClusterPages(b, ClusterAlgorithmMinHash, 500)
+}
+
+func BenchmarkClusterPagesMinHash2000(b *testing.B) {
+	benchmarkClusterPages(b, ClusterAlgorithmMinHash, 2000)
+}
+
+func BenchmarkClusterPagesMinHash10000(b *testing.B) {
+	benchmarkClusterPages(b, ClusterAlgorithmMinHash, 10000)
+}
+
+func BenchmarkClusterPagesExact500(b *testing.B) {
+	benchmarkClusterPages(b, ClusterAlgorithmExact, 500)
+}
+
+func TestParseClusterWeights(t *testing.T) {
+	tests := []struct {
+		list    string
+		want    ClusterWeights
+		wantErr bool
+	}{
+		{"structure=1", ClusterWeights{Structure: 1}, false},
+		{"structure=0.6,classes=0.2,title=0.1,status=0.1", ClusterWeights{Structure: 0.6, Classes: 0.2, Title: 0.1, Status: 0.1}, false},
+		{" Headers = 2 , tags=1,", ClusterWeights{Headers: 2, Tags: 1}, false},
+		{"title=0,status=1", ClusterWeights{Status: 1}, false},
+		{"", ClusterWeights{}, true},
+		{"structure=0", ClusterWeights{}, true},
+		{"structure", ClusterWeights{}, true},
+		{"structure=high", ClusterWeights{}, true},
+		{"structure=-1", ClusterWeights{}, true},
+		{"colour=1", ClusterWeights{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseClusterWeights(tt.list)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseClusterWeights(%q): expected error", tt.list)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseClusterWeights(%q): unexpected error: %s", tt.list, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseClusterWeights(%q) = %+v, want %+v", tt.list, got, tt.want)
+		}
+	}
+}
+
+func TestClusterPagesWeights(t *testing.T) {
+	structure := []string{"html", "body", "div", "form", "input", "input", "button"}
+	pages := []*Page{
+		{URL: "http://a.example.com/", PageTitle: "Admin login", Status: "200 OK", PageStructure: structure},
+		{URL: "http://b.example.com/", PageTitle: "Admin login", Status: "200 OK", PageStructure: structure},
+		{URL: "http://c.example.com/", PageTitle: "Webmail", Status: "401 Unauthorized", PageStructure: structure},
+	}
+
+	for _, algorithm := range ClusterAlgorithms {
+		options := clusterOptions(algorithm)
+		if clusters := ClusterPages(pages, options); len(clusters) != 1 {
+			t.Errorf("%s: expected pages with the same structure in one cluster, got %d clusters", algorithm, len(clusters))
+		}
+
+		// With title and status counting for half of the similarity, the
+		// page with a different title and status is split off.
+		options.Weights = ClusterWeights{Structure: 0.5, Title: 0.25, Status: 0.25}
+		clusters := ClusterPages(pages, options)
+		if len(clusters) != 2 {
+			t.Fatalf("%s: expected 2 clusters with weights, got %d", algorithm, len(clusters))
+		}
+		for _, cluster := range clusters {
+			if cluster.Representative == "http://c.example.com/" && len(cluster.Pages) != 1 {
+				t.Errorf("%s: expected page with different title and status on its own, got %v", algorithm, cluster.Pages)
+			}
+		}
+	}
+}
+
+func TestClusterPagesWithoutStructureWeight(t *testing.T) {
+	pages := []*Page{
+		{URL: "http://a.example.com/", PageTitle: "Admin login", Status: "200 OK", PageStructure: []string{"html", "body", "form", "input"}},
+		{URL: "http://b.example.com/", PageTitle: "Admin login", Status: "200 OK", PageStructure: []string{"html", "head", "script", "body", "div", "img"}},
+		{URL: "http://c.example.com/", PageTitle: "Webmail", Status: "401 Unauthorized", PageStructure: []string{"html", "body", "form", "input"}},
+	}
+
+	for _, algorithm := range ClusterAlgorithms {
+		options := clusterOptions(algorithm)
+		options.Weights = ClusterWeights{Title: 1, Status: 1}
+		clusters := ClusterPages(pages, options)
+		if len(clusters) != 2 {
+			t.Fatalf("%s: expected 2 clusters by title and status, got %d", algorithm, len(clusters))
+		}
+		for _, cluster := range clusters {
+			if cluster.Representative == "http://a.example.com/" && len(cluster.Pages) != 2 {
+				t.Errorf("%s: expected pages with the same title and status in one cluster, got %v", algorithm, cluster.Pages)
+			}
+		}
+	}
+}
@@ -6,5 +6,6 @@ const (
 	Host          = "host"
 	URL           = "url"
 	URLResponsive = "url:responsive"
+	URLRendered   = "url:rendered"
 	TCPPort       = "port:tcp"
 )
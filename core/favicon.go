@@ -0,0 +1,86 @@
+package core
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+type Favicon struct {
+	URL    string `json:"url"`
+	MMH3   int32  `json:"mmh3"`
+	MD5    string `json:"md5"`
+	SHA256 string `json:"sha256"`
+}
+
+func NewFavicon(url string, data []byte) *Favicon {
+	return &Favicon{
+		URL:    url,
+		MMH3:   FaviconMMH3(data),
+		MD5:    fmt.Sprintf("%x", md5.Sum(data)),
+		SHA256: fmt.Sprintf("%x", sha256.Sum256(data)),
+	}
+}
+
+// FaviconMMH3 calculates the favicon hash used by Shodan, which is the signed
+// 32-bit MurmurHash3 of the favicon encoded as base64 with a newline after
+// every 76 characters and at the end.
+func FaviconMMH3(data []byte) int32 {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	var wrapped []byte
+	for len(encoded) > 76 {
+		wrapped = append(wrapped, encoded[:76]...)
+		wrapped = append(wrapped, '\n')
+		encoded = encoded[76:]
+	}
+	wrapped = append(wrapped, encoded...)
+	wrapped = append(wrapped, '\n')
+	return int32(murmur3(wrapped, 0))
+}
+
+func murmur3(data []byte, seed uint32) uint32 {
+	const (
+		c1 = 0xcc9e2d51
+		c2 = 0x1b873593
+	)
+
+	h := seed
+	blocks := len(data) / 4
+	for i := 0; i < blocks; i++ {
+		k := binary.LittleEndian.Uint32(data[i*4:])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+		h = bits.RotateLeft32(h, 13)
+		h = h*5 + 0xe6546b64
+	}
+
+	var k uint32
+	tail := data[blocks*4:]
+	switch len(tail) {
+	case 3:
+		k ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		k ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		k ^= uint32(tail[0])
+		k *= c1
+		k = bits.RotateLeft32(k, 15)
+		k *= c2
+		h ^= k
+	}
+
+	h ^= uint32(len(data))
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
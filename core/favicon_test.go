@@ -0,0 +1,62 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+)
+
+func sequence(n int) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	return data
+}
+
+func TestMurmur3(t *testing.T) {
+	tests := []struct {
+		data string
+		seed uint32
+		want uint32
+	}{
+		{"", 0, 0},
+		{"", 1, 0x514e28b7},
+		{"hello", 0, 0x248bfa47},
+		{"The quick brown fox jumps over the lazy dog", 0, 0x2e4ff723},
+	}
+	for _, tt := range tests {
+		if got := murmur3([]byte(tt.data), tt.seed); got != tt.want {
+			t.Errorf("murmur3(%q, %d) = %#x, want %#x", tt.data, tt.seed, got, tt.want)
+		}
+	}
+}
+
+func TestFaviconMMH3(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want int32
+	}{
+		{"short", []byte("hello"), 1155597304},
+		{"wrapped", sequence(100), -1165240594},
+		{"multiple lines", bytes.Repeat(sequence(256), 4), -1082603952},
+	}
+	for _, tt := range tests {
+		if got := FaviconMMH3(tt.data); got != tt.want {
+			t.Errorf("%s: FaviconMMH3() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNewFavicon(t *testing.T) {
+	favicon := NewFavicon("http://example.com/favicon.ico", []byte("hello"))
+	if favicon.MD5 != "5d41402abc4b2a76b9719d911017c592" {
+		t.Errorf("unexpected MD5 %s", favicon.MD5)
+	}
+	if favicon.SHA256 != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Errorf("unexpected SHA256 %s", favicon.SHA256)
+	}
+	if favicon.MMH3 != 1155597304 {
+		t.Errorf("unexpected MMH3 %d", favicon.MMH3)
+	}
+}
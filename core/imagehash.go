@@ -0,0 +1,96 @@
+package core
+
+import (
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math/bits"
+	"os"
+	"strconv"
+)
+
+const (
+	dHashWidth  = 9
+	dHashHeight = 8
+)
+
+// DHash computes the 64-bit difference hash of an image. The image is scaled
+// down to 9x8 grayscale pixels and each bit tells whether a pixel is brighter
+// than its right neighbour, so images that look alike have hashes that differ
+// in few bits regardless of their size and compression.
+//
+// Only the top of images taller than they are wide is hashed, so full page
+// screenshots of the same page are alike even if their length differs.
+func DHash(img image.Image) uint64 {
+	bounds := img.Bounds()
+	if bounds.Dy() > bounds.Dx() {
+		bounds.Max.Y = bounds.Min.Y + bounds.Dx()
+	}
+
+	var sums [dHashHeight][dHashWidth]float64
+	var counts [dHashHeight][dHashWidth]int
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return 0
+	}
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row := (y - bounds.Min.Y) * dHashHeight / height
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			col := (x - bounds.Min.X) * dHashWidth / width
+			r, g, b, _ := img.At(x, y).RGBA()
+			sums[row][col] += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)
+			counts[row][col]++
+		}
+	}
+
+	var hash uint64
+	for row := 0; row < dHashHeight; row++ {
+		for col := 0; col < dHashWidth-1; col++ {
+			hash <<= 1
+			if average(sums[row][col], counts[row][col]) > average(sums[row][col+1], counts[row][col+1]) {
+				hash |= 1
+			}
+		}
+	}
+	return hash
+}
+
+func average(sum float64, count int) float64 {
+	if count == 0 {
+		return 0
+	}
+	return sum / float64(count)
+}
+
+// ImageHash decodes a PNG or JPEG image and returns its difference hash as a
+// hexadecimal string.
+func ImageHash(r io.Reader) (string, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%016x", DHash(img)), nil
+}
+
+func ImageHashFile(filename string) (string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return ImageHash(f)
+}
+
+// GetImageHashSimilarity returns the share of bits that are the same in two
+// hexadecimal image hashes, from 0 to 1. Ok is false if either hash is
+// missing or invalid.
+func GetImageHashSimilarity(a, b string) (similarity float64, ok bool) {
+	hashA, errA := strconv.ParseUint(a, 16, 64)
+	hashB, errB := strconv.ParseUint(b, 16, 64)
+	if a == "" || b == "" || errA != nil || errB != nil {
+		return 0, false
+	}
+	return 1 - float64(bits.OnesCount64(hashA^hashB))/64, true
+}
@@ -0,0 +1,113 @@
+package core
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// gradient returns an image that gets darker from left to right, or from
+// right to left if reversed is true.
+func gradient(width, height int, reversed bool) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			v := uint8(255 - x*255/width)
+			if reversed {
+				v = 255 - v
+			}
+			img.SetGray(x, y, color.Gray{Y: v})
+		}
+	}
+	return img
+}
+
+func uniform(width, height int) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = 128
+	}
+	return img
+}
+
+func TestDHash(t *testing.T) {
+	tests := []struct {
+		name string
+		img  image.Image
+		want uint64
+	}{
+		{"uniform", uniform(90, 80), 0},
+		{"darker to the right", gradient(90, 80, false), 0xffffffffffffffff},
+		{"brighter to the right", gradient(90, 80, true), 0},
+		{"empty", image.NewGray(image.Rect(0, 0, 0, 0)), 0},
+	}
+	for _, tt := range tests {
+		if got := DHash(tt.img); got != tt.want {
+			t.Errorf("%s: DHash() = %016x, want %016x", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDHashIgnoresSize(t *testing.T) {
+	small := DHash(gradient(90, 80, false))
+	large := DHash(gradient(1440, 900, false))
+	if small != large {
+		t.Errorf("expected same hash for different sizes, got %016x and %016x", small, large)
+	}
+}
+
+func TestDHashOnlyHashesTopOfTallImages(t *testing.T) {
+	square := gradient(200, 200, false)
+	tall := gradient(200, 1000, false)
+	// Make the part below the top square look completely different.
+	for y := 200; y < 1000; y++ {
+		for x := 0; x < 200; x++ {
+			tall.SetGray(x, y, color.Gray{Y: uint8(x * 255 / 200)})
+		}
+	}
+	if DHash(square) != DHash(tall) {
+		t.Errorf("expected tall image to have the hash of its top, got %016x and %016x", DHash(square), DHash(tall))
+	}
+}
+
+func TestImageHash(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, gradient(90, 80, false)); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := ImageHash(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != "ffffffffffffffff" {
+		t.Errorf("unexpected hash %s", hash)
+	}
+
+	if _, err := ImageHash(strings.NewReader("not an image")); err == nil {
+		t.Error("expected error for invalid image")
+	}
+}
+
+func TestGetImageHashSimilarity(t *testing.T) {
+	tests := []struct {
+		a, b       string
+		similarity float64
+		ok         bool
+	}{
+		{"ffffffffffffffff", "ffffffffffffffff", 1, true},
+		{"ffffffffffffffff", "0000000000000000", 0, true},
+		{"ffffffffffffffff", "fffffffffffffffe", 63.0 / 64, true},
+		{"00000000ffffffff", "ffffffffffffffff", 0.5, true},
+		{"", "ffffffffffffffff", 0, false},
+		{"ffffffffffffffff", "not a hash", 0, false},
+	}
+	for _, tt := range tests {
+		similarity, ok := GetImageHashSimilarity(tt.a, tt.b)
+		if similarity != tt.similarity || ok != tt.ok {
+			t.Errorf("GetImageHashSimilarity(%q, %q) = %v, %v, want %v, %v", tt.a, tt.b, similarity, ok, tt.similarity, tt.ok)
+		}
+	}
+}
@@ -6,46 +6,128 @@ import (
 	"strings"
 )
 
+type StringList []string
+
+func (l *StringList) String() string {
+	return strings.Join(*l, ", ")
+}
+
+func (l *StringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+func stringList(fs *flag.FlagSet, name string, usage string) *StringList {
+	var l StringList
+	fs.Var(&l, name, usage)
+	return &l
+}
+
 type Options struct {
-	Threads           *int
-	OutDir            *string
-	SessionPath       *string
-	TemplatePath      *string
-	Proxy             *string
-	ChromePath        *string
-	Resolution        *string
-	Ports             *string
-	ScanTimeout       *int
-	HTTPTimeout       *int
-	ScreenshotTimeout *int
-	Nmap              *bool
-	SaveBody          *bool
-	Silent            *bool
-	Debug             *bool
-	Version           *bool
+	Threads            *int
+	OutDir             *string
+	SessionPath        *string
+	TemplatePath       *string
+	CDNAssets          *bool
+	Proxy              *string
+	Headers            *StringList
+	Cookies            *StringList
+	BasicAuth          *string
+	BearerToken        *string
+	ChromePath         *string
+	Resolution         *string
+	Viewports          *string
+	Ports              *string
+	ScopePath          *string
+	MaxRangeHosts      *int
+	ScanTimeout        *int
+	HTTPTimeout        *int
+	MaxRedirects       *int
+	ScreenshotTimeout  *int
+	ScreenshotTabs     *int
+	ScreenshotDelay    *int
+	WaitSelector       *string
+	ScreenshotScript   *string
+	ClusterMode        *string
+	ClusterAlgorithm   *string
+	ClusterThreshold   *float64
+	ClusterWeights     *string
+	CheckpointInterval *int
+	Agents             *string
+	DisableAgents      *string
+	InputFormat        *string
+	Nmap               *bool
+	FollowRedirects    *bool
+	FullPage           *bool
+	WaitNetworkIdle    *bool
+	Resume             *bool
+	ExportReport       *bool
+	ExportThumbnails   *bool
+	Recluster          *bool
+	SpoofHeaders       *bool
+	SaveBody           *bool
+	Silent             *bool
+	Debug              *bool
+	Version            *bool
 }
 
-func ParseOptions() (Options, error) {
-	options := Options{
-		Threads:           flag.Int("threads", 0, "Number of concurrent threads (default number of logical CPUs)"),
-		OutDir:            flag.String("out", ".", "Directory to write files to"),
-		SessionPath:       flag.String("session", "", "Load Aquatone session file and generate HTML report"),
-		TemplatePath:      flag.String("template-path", "", "Path to HTML template to use for report"),
-		Proxy:             flag.String("proxy", "", "Proxy to use for HTTP requests"),
-		ChromePath:        flag.String("chrome-path", "", "Full path to the Chrome/Chromium executable to use. By default, aquatone will search for Chrome or Chromium"),
-		Resolution:        flag.String("resolution", "1440,900", "screenshot resolution"),
-		Ports:             flag.String("ports", strings.Trim(strings.Join(strings.Fields(fmt.Sprint(MediumPortList)), ","), "[]"), "Ports to scan on hosts. Supported list aliases: small, medium, large, xlarge"),
-		ScanTimeout:       flag.Int("scan-timeout", 100, "Timeout in miliseconds for port scans"),
-		HTTPTimeout:       flag.Int("http-timeout", 3*1000, "Timeout in miliseconds for HTTP requests"),
-		ScreenshotTimeout: flag.Int("screenshot-timeout", 30*1000, "Timeout in miliseconds for screenshots"),
-		Nmap:              flag.Bool("nmap", false, "Parse input as Nmap/Masscan XML"),
-		SaveBody:          flag.Bool("save-body", true, "Save response bodies to files"),
-		Silent:            flag.Bool("silent", false, "Suppress all output except for errors"),
-		Debug:             flag.Bool("debug", false, "Print debugging information"),
-		Version:           flag.Bool("version", false, "Print current Aquatone version"),
+func defineOptions(fs *flag.FlagSet) Options {
+	return Options{
+		Threads:            fs.Int("threads", 0, "Number of concurrent threads (default number of logical CPUs)"),
+		OutDir:             fs.String("out", ".", "Directory to write files to"),
+		SessionPath:        fs.String("session", "", "Load Aquatone session file and generate HTML report"),
+		TemplatePath:       fs.String("template-path", "", "Path to HTML template to use for report"),
+		CDNAssets:          fs.Bool("cdn-assets", false, "Link JavaScript and CSS libraries in the HTML report from CDNs instead of inlining them for a smaller report file"),
+		Proxy:              fs.String("proxy", "", "Proxy to use for HTTP requests"),
+		Headers:            stringList(fs, "header", "Custom header to send with HTTP requests in the form 'Name: value'. Can be given multiple times"),
+		Cookies:            stringList(fs, "cookie", "Cookie to send with HTTP requests in the form 'name=value'. Can be given multiple times"),
+		BasicAuth:          fs.String("basic-auth", "", "Credentials for HTTP basic authentication in the form 'username:password'"),
+		BearerToken:        fs.String("bearer-token", "", "Token for HTTP bearer authentication"),
+		ChromePath:         fs.String("chrome-path", "", "Full path to the Chrome/Chromium executable to use. By default, aquatone will search for Chrome or Chromium"),
+		Resolution:         fs.String("resolution", "1440,900", "screenshot resolution"),
+		Viewports:          fs.String("viewports", "desktop", "Comma-separated list of viewports to take screenshots in. Supported viewports: desktop, tablet, mobile or WIDTHxHEIGHT"),
+		Ports:              fs.String("ports", strings.Trim(strings.Join(strings.Fields(fmt.Sprint(MediumPortList)), ","), "[]"), "Ports to scan on hosts. Supports ranges (8000-8100), exclusions (!8080), port files (@ports.txt) and list aliases: small, medium, large, xlarge"),
+		ScopePath:          fs.String("scope", "", "Path to scope file with hosts, CIDR ranges and ports to include or exclude (! prefix)"),
+		MaxRangeHosts:      fs.Int("max-range-hosts", 65536, "Maximum number of hosts to expand from a single CIDR or IP range in input (at most 16777216)"),
+		ScanTimeout:        fs.Int("scan-timeout", 100, "Timeout in miliseconds for port scans"),
+		HTTPTimeout:        fs.Int("http-timeout", 3*1000, "Timeout in miliseconds for HTTP requests"),
+		MaxRedirects:       fs.Int("max-redirects", 10, "Maximum number of redirects to follow for HTTP requests"),
+		ScreenshotTimeout:  fs.Int("screenshot-timeout", 30*1000, "Timeout in miliseconds for screenshots"),
+		ScreenshotTabs:     fs.Int("screenshot-tabs", 4, "Number of browser tabs to use for concurrent screenshots"),
+		ScreenshotDelay:    fs.Int("screenshot-delay", 0, "Delay in miliseconds before taking screenshots after pages have loaded"),
+		WaitSelector:       fs.String("wait-selector", "", "CSS selector of an element to wait for before taking screenshots"),
+		ScreenshotScript:   fs.String("screenshot-script", "", "JavaScript to run in pages before taking screenshots, e.g. to dismiss cookie banners. Prefix with @ to read the script from a file"),
+		ClusterMode:        fs.String("cluster-mode", "structure", "How to cluster similar pages in the report. Supported modes: structure, visual (screenshot similarity), combined"),
+		ClusterAlgorithm:   fs.String("cluster-algorithm", "minhash", "Algorithm for clustering similar pages. Supported algorithms: minhash (fast, approximate), exact (slow for many pages)"),
+		ClusterThreshold:   fs.Float64("cluster-threshold", 0.80, "Minimum similarity from 0 to 1 for pages to be clustered together"),
+		ClusterWeights:     fs.String("cluster-weights", "structure=1", "Comma-separated list of feature=weight pairs to cluster pages by. Supported features: structure, classes, title, status, headers, tags"),
+		CheckpointInterval: fs.Int("checkpoint-interval", 30*1000, "Interval in miliseconds between writing session checkpoints to disk (0 to disable)"),
+		Agents:             fs.String("agents", "", "Comma-separated list of agents to enable. Use default for all default agents (default all default agents)"),
+		DisableAgents:      fs.String("disable-agents", "", "Comma-separated list of agents to disable"),
+		InputFormat:        fs.String("input-format", "auto", "Format of input. Supported formats: auto, text, nmap-xml, nmap-grepable, masscan-json, burp-xml, har"),
+		Nmap:               fs.Bool("nmap", false, "Parse input as Nmap/Masscan XML (same as -input-format nmap-xml)"),
+		FollowRedirects:    fs.Bool("follow-redirects", true, "Follow redirects for HTTP requests"),
+		FullPage:           fs.Bool("full-page", false, "Take screenshots of the full height of pages instead of only the visible viewport"),
+		WaitNetworkIdle:    fs.Bool("wait-network-idle", false, "Wait for network activity to stop before taking screenshots"),
+		ExportReport:       fs.Bool("export-report", false, "Also write aquatone_report_export.html, a single-file HTML report with embedded screenshots and headers for sharing"),
+		ExportThumbnails:   fs.Bool("export-thumbnails", false, "Embed screenshots in the single-file HTML report as downscaled JPEG thumbnails to keep it small"),
+		Resume:             fs.Bool("resume", false, "Resume an interrupted scan from aquatone_session.json in the output directory"),
+		Recluster:          fs.Bool("recluster", false, "Recalculate page structures and clusters of the session loaded with -session and update the session file"),
+		SpoofHeaders:       fs.Bool("spoof-headers", true, "Send random X-Forwarded-For, Via and Forwarded headers with HTTP requests"),
+		SaveBody:           fs.Bool("save-body", true, "Save response bodies to files"),
+		Silent:             fs.Bool("silent", false, "Suppress all output except for errors"),
+		Debug:              fs.Bool("debug", false, "Print debugging information"),
+		Version:            fs.Bool("version", false, "Print current Aquatone version"),
 	}
+}
 
+func ParseOptions() (Options, error) {
+	options := defineOptions(flag.CommandLine)
 	flag.Parse()
 
 	return options, nil
 }
+
+func DefaultOptions() Options {
+	return defineOptions(flag.NewFlagSet(Name, flag.ContinueOnError))
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -95,22 +96,65 @@ type Note struct {
 	Type string `json:"type"`
 }
 
+type Redirect struct {
+	URL      string `json:"url"`
+	Status   string `json:"status"`
+	Location string `json:"location"`
+}
+
+type Screenshot struct {
+	Viewport string `json:"viewport"`
+	Path     string `json:"path"`
+	FullPage bool   `json:"fullPage"`
+}
+
+type ConsoleMessage struct {
+	Level  string `json:"level"`
+	Text   string `json:"text"`
+	Source string `json:"source"`
+}
+
+type FailedRequest struct {
+	URL    string `json:"url"`
+	Status int    `json:"status"`
+	Error  string `json:"error"`
+}
+
 type Page struct {
 	sync.Mutex
-	UUID           string   `json:"uuid"`
-	URL            string   `json:"url"`
-	Hostname       string   `json:"hostname"`
-	Addrs          []string `json:"addrs"`
-	Status         string   `json:"status"`
-	PageTitle      string   `json:"pageTitle"`
-	PageStructure  []string `json:"-"`
-	HeadersPath    string   `json:"headersPath"`
-	BodyPath       string   `json:"bodyPath"`
-	ScreenshotPath string   `json:"screenshotPath"`
-	HasScreenshot  bool     `json:"hasScreenshot"`
-	Headers        []Header `json:"headers"`
-	Tags           []Tag    `json:"tags"`
-	Notes          []Note   `json:"notes"`
+	UUID           string           `json:"uuid"`
+	URL            string           `json:"url"`
+	Hostname       string           `json:"hostname"`
+	Addrs          []string         `json:"addrs"`
+	Status         string           `json:"status"`
+	PageTitle      string           `json:"pageTitle"`
+	PageStructure  []string         `json:"-"`
+	PageClasses    []string         `json:"-"`
+	HeadersPath    string           `json:"headersPath"`
+	BodyPath       string           `json:"bodyPath"`
+	RenderedPath   string           `json:"renderedPath"`
+	ScreenshotPath string           `json:"screenshotPath"`
+	HasScreenshot  bool             `json:"hasScreenshot"`
+	ScreenshotHash string           `json:"screenshotHash"`
+	Screenshots    []Screenshot     `json:"screenshots"`
+	Headers        []Header         `json:"headers"`
+	Redirects      []Redirect       `json:"redirects"`
+	Console        []ConsoleMessage `json:"console"`
+	FailedRequests []FailedRequest  `json:"failedRequests"`
+	TLS            *TLSInfo         `json:"tls"`
+	Favicon        *Favicon         `json:"favicon"`
+	Tags           []Tag            `json:"tags"`
+	Notes          []Note           `json:"notes"`
+	Processed      bool             `json:"processed"`
+}
+
+// MarshalJSON encodes the page while holding its lock, so pages can be saved
+// while agents are still updating them.
+func (p *Page) MarshalJSON() ([]byte, error) {
+	p.Lock()
+	defer p.Unlock()
+	type page Page
+	return json.Marshal((*page)(p))
 }
 
 func (p *Page) AddHeader(name string, value string) {
@@ -124,10 +168,67 @@ func (p *Page) AddHeader(name string, value string) {
 	p.Headers = append(p.Headers, header)
 }
 
+func (p *Page) AddRedirect(url string, status string, location string) {
+	p.Lock()
+	defer p.Unlock()
+	p.Redirects = append(p.Redirects, Redirect{
+		URL:      url,
+		Status:   status,
+		Location: location,
+	})
+}
+
+// AddScreenshot records a screenshot taken in a viewport. The first screenshot
+// is also used as the page's main screenshot, in which case true is returned.
+func (p *Page) AddScreenshot(viewport string, path string, fullPage bool) bool {
+	p.Lock()
+	defer p.Unlock()
+	p.Screenshots = append(p.Screenshots, Screenshot{
+		Viewport: viewport,
+		Path:     path,
+		FullPage: fullPage,
+	})
+	if p.HasScreenshot {
+		return false
+	}
+	p.ScreenshotPath = path
+	p.HasScreenshot = true
+	return true
+}
+
+func (p *Page) HasTag(text string) bool {
+	p.Lock()
+	defer p.Unlock()
+	for _, tag := range p.Tags {
+		if tag.Text == text {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *Page) AddTag(text string, tagType string, link string) {
 	p.Lock()
 	defer p.Unlock()
+	p.addTag(text, tagType, link)
+}
+
+// AddTagIfMissing adds a tag unless the page already has a tag with the same
+// text. The check and the insert happen under the same lock, so concurrent
+// agents cannot add the same tag twice.
+func (p *Page) AddTagIfMissing(text string, tagType string, link string) bool {
+	p.Lock()
+	defer p.Unlock()
+	for _, tag := range p.Tags {
+		if tag.Text == text {
+			return false
+		}
+	}
+	p.addTag(text, tagType, link)
+	return true
+}
 
+func (p *Page) addTag(text string, tagType string, link string) {
 	h := sha1.New()
 	io.WriteString(h, text)
 	io.WriteString(h, tagType)
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestPageAddTagIfMissing(t *testing.T) {
+	page, err := NewPage("http://example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			page.AddTagIfMissing("Nginx", "info", "https://nginx.org/")
+			page.AddTagIfMissing("PHP", "info", "https://php.net/")
+		}()
+	}
+	wg.Wait()
+
+	if len(page.Tags) != 2 {
+		t.Fatalf("expected 2 tags, got %d: %v", len(page.Tags), page.Tags)
+	}
+	if page.AddTagIfMissing("Nginx", "info", "") {
+		t.Error("expected existing tag not to be added again")
+	}
+}
+
+func TestPageAddScreenshot(t *testing.T) {
+	page, err := NewPage("http://example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The first viewport failed, so the tablet screenshot is the main one.
+	if !page.AddScreenshot("tablet", "screenshots/example__tablet.png", false) {
+		t.Error("expected first screenshot to become the main screenshot")
+	}
+	if page.AddScreenshot("mobile", "screenshots/example__mobile.png", false) {
+		t.Error("expected later screenshot not to replace the main screenshot")
+	}
+	if page.ScreenshotPath != "screenshots/example__tablet.png" || !page.HasScreenshot {
+		t.Errorf("unexpected main screenshot %q", page.ScreenshotPath)
+	}
+	if len(page.Screenshots) != 2 {
+		t.Errorf("expected 2 screenshots, got %d", len(page.Screenshots))
+	}
+}
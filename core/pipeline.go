@@ -0,0 +1,348 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/asaskevich/EventBus"
+	"github.com/remeh/sizedwaitgroup"
+)
+
+// Pipeline keeps track of outstanding work in a session. An event is counted
+// as outstanding from the moment it is published until every handler
+// subscribed to it has returned, and a job is counted from WaitGroup.Add
+// until the matching WaitGroup.Done. As agents always publish follow-up
+// events and add jobs before their own handler or job finishes, the pipeline
+// only drains when the whole Host -> TCPPort -> URL -> URLResponsive chain is
+// done.
+//
+// Work on a single page is counted the same way, from the URL, URLResponsive
+// and URLRendered events published for it and the jobs added for it with
+// WaitGroup.AddPage, so a page can be reported as done before the whole
+// pipeline drains.
+type Pipeline struct {
+	sync.Mutex
+	cond        *sync.Cond
+	outstanding map[string]int
+	jobs        int
+	pages       map[string]int
+	onPageDone  []func(url string)
+}
+
+func NewPipeline() *Pipeline {
+	p := &Pipeline{
+		outstanding: make(map[string]int),
+		pages:       make(map[string]int),
+	}
+	p.cond = sync.NewCond(&p.Mutex)
+	return p
+}
+
+func (p *Pipeline) AddEvent(stage string, n int) {
+	if n == 0 {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.outstanding[stage] += n
+}
+
+func (p *Pipeline) DoneEvent(stage string) {
+	p.Lock()
+	defer p.Unlock()
+	if p.outstanding[stage] <= 0 {
+		panic(fmt.Sprintf("pipeline: negative outstanding event count for stage %s", stage))
+	}
+	p.outstanding[stage]--
+	if p.outstanding[stage] == 0 {
+		delete(p.outstanding, stage)
+	}
+	p.broadcastIfDrained()
+}
+
+func (p *Pipeline) AddJob() {
+	p.Lock()
+	defer p.Unlock()
+	p.jobs++
+}
+
+func (p *Pipeline) DoneJob() {
+	p.Lock()
+	defer p.Unlock()
+	if p.jobs <= 0 {
+		panic("pipeline: negative outstanding job count")
+	}
+	p.jobs--
+	p.broadcastIfDrained()
+}
+
+// OnPageDone registers a function that is called with the URL of a page when
+// all work on it has finished. The function is called before the work is
+// removed from the pipeline, so Wait doesn't return before it has returned.
+func (p *Pipeline) OnPageDone(fn func(url string)) {
+	p.Lock()
+	defer p.Unlock()
+	p.onPageDone = append(p.onPageDone, fn)
+}
+
+func (p *Pipeline) AddPageWork(url string, n int) {
+	if n == 0 {
+		return
+	}
+	p.Lock()
+	defer p.Unlock()
+	p.pages[url] += n
+}
+
+func (p *Pipeline) DonePageWork(url string) {
+	p.Lock()
+	if p.pages[url] <= 0 {
+		p.Unlock()
+		panic(fmt.Sprintf("pipeline: negative outstanding work count for page %s", url))
+	}
+	p.pages[url]--
+	if p.pages[url] > 0 {
+		p.Unlock()
+		return
+	}
+	delete(p.pages, url)
+	callbacks := p.onPageDone
+	p.Unlock()
+
+	for _, fn := range callbacks {
+		fn(url)
+	}
+}
+
+func (p *Pipeline) OutstandingPageWork(url string) int {
+	p.Lock()
+	defer p.Unlock()
+	return p.pages[url]
+}
+
+func (p *Pipeline) Outstanding(stage string) int {
+	p.Lock()
+	defer p.Unlock()
+	return p.outstanding[stage]
+}
+
+func (p *Pipeline) Jobs() int {
+	p.Lock()
+	defer p.Unlock()
+	return p.jobs
+}
+
+func (p *Pipeline) Drained() bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.drained()
+}
+
+func (p *Pipeline) Wait() {
+	p.Lock()
+	defer p.Unlock()
+	for !p.drained() {
+		p.cond.Wait()
+	}
+}
+
+func (p *Pipeline) drained() bool {
+	return p.jobs == 0 && len(p.outstanding) == 0
+}
+
+func (p *Pipeline) broadcastIfDrained() {
+	if p.drained() {
+		p.cond.Broadcast()
+	}
+}
+
+// WaitGroup is a sized wait group that registers every added goroutine as a
+// job in the session pipeline.
+type WaitGroup struct {
+	sizedwaitgroup.SizedWaitGroup
+	pipeline *Pipeline
+}
+
+func NewWaitGroup(limit int, pipeline *Pipeline) WaitGroup {
+	return WaitGroup{
+		SizedWaitGroup: sizedwaitgroup.New(limit),
+		pipeline:       pipeline,
+	}
+}
+
+func (wg *WaitGroup) Add() {
+	wg.pipeline.AddJob()
+	wg.SizedWaitGroup.Add()
+}
+
+func (wg *WaitGroup) Done() {
+	wg.SizedWaitGroup.Done()
+	wg.pipeline.DoneJob()
+}
+
+// AddPage adds a job that works on the page with the given URL. It must be
+// matched by a call to DonePage with the same URL.
+func (wg *WaitGroup) AddPage(url string) {
+	wg.pipeline.AddPageWork(url, 1)
+	wg.Add()
+}
+
+func (wg *WaitGroup) DonePage(url string) {
+	wg.SizedWaitGroup.Done()
+	wg.pipeline.DonePageWork(url)
+	wg.pipeline.DoneJob()
+}
+
+// trackedHandler is a handler subscribed through a trackedBus. pending is the
+// number of published events that were counted for the handler but that it
+// has not received yet.
+type trackedHandler struct {
+	fn      reflect.Value
+	wrapper interface{}
+	once    bool
+	pending int
+}
+
+// PublishFilter is consulted before an event is published and drops the
+// event when it returns false.
+type PublishFilter func(topic string, args ...interface{}) bool
+
+// trackedBus wraps an EventBus.Bus and reports published events and handler
+// completions to a Pipeline.
+type trackedBus struct {
+	EventBus.Bus
+	sync.Mutex
+	pipeline *Pipeline
+	filter   PublishFilter
+	handlers map[string][]*trackedHandler
+}
+
+func newTrackedBus(bus EventBus.Bus, pipeline *Pipeline, filter PublishFilter) *trackedBus {
+	return &trackedBus{
+		Bus:      bus,
+		pipeline: pipeline,
+		filter:   filter,
+		handlers: make(map[string][]*trackedHandler),
+	}
+}
+
+func (b *trackedBus) Subscribe(topic string, fn interface{}) error {
+	return b.subscribe(topic, fn, false, func(wrapper interface{}) error {
+		return b.Bus.Subscribe(topic, wrapper)
+	})
+}
+
+func (b *trackedBus) SubscribeAsync(topic string, fn interface{}, transactional bool) error {
+	return b.subscribe(topic, fn, false, func(wrapper interface{}) error {
+		return b.Bus.SubscribeAsync(topic, wrapper, transactional)
+	})
+}
+
+func (b *trackedBus) SubscribeOnce(topic string, fn interface{}) error {
+	return b.subscribe(topic, fn, true, func(wrapper interface{}) error {
+		return b.Bus.SubscribeOnce(topic, wrapper)
+	})
+}
+
+func (b *trackedBus) SubscribeOnceAsync(topic string, fn interface{}) error {
+	return b.subscribe(topic, fn, true, func(wrapper interface{}) error {
+		return b.Bus.SubscribeOnceAsync(topic, wrapper)
+	})
+}
+
+func (b *trackedBus) Unsubscribe(topic string, fn interface{}) error {
+	b.Lock()
+	defer b.Unlock()
+	v := reflect.ValueOf(fn)
+	for i, h := range b.handlers[topic] {
+		if h.fn.Pointer() != v.Pointer() {
+			continue
+		}
+		if err := b.Bus.Unsubscribe(topic, h.wrapper); err != nil {
+			return err
+		}
+		b.handlers[topic] = append(b.handlers[topic][:i], b.handlers[topic][i+1:]...)
+		return nil
+	}
+	return fmt.Errorf("topic %s doesn't exist", topic)
+}
+
+// Publish counts the event once for every handler that will receive it.
+// Handlers subscribed with SubscribeOnce are counted by the first publish
+// only and are forgotten right away, so a concurrent publish can't count a
+// handler that EventBus will never call again.
+func (b *trackedBus) Publish(topic string, args ...interface{}) {
+	if b.filter != nil && !b.filter(topic, args...) {
+		return
+	}
+	b.Lock()
+	handlers := b.handlers[topic]
+	remaining := make([]*trackedHandler, 0, len(handlers))
+	for _, h := range handlers {
+		h.pending++
+		if !h.once {
+			remaining = append(remaining, h)
+		}
+	}
+	b.handlers[topic] = remaining
+	b.pipeline.AddEvent(topic, len(handlers))
+	if url, ok := pageURL(topic, args); ok {
+		b.pipeline.AddPageWork(url, len(handlers))
+	}
+	b.Unlock()
+	b.Bus.Publish(topic, args...)
+}
+
+func (b *trackedBus) subscribe(topic string, fn interface{}, once bool, subscribe func(interface{}) error) error {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func {
+		return fmt.Errorf("%s is not of type reflect.Func", v.Kind())
+	}
+
+	handler := &trackedHandler{fn: v, once: once}
+	handler.wrapper = reflect.MakeFunc(v.Type(), func(args []reflect.Value) []reflect.Value {
+		if b.received(handler) {
+			defer b.pipeline.DoneEvent(topic)
+			if len(args) > 0 {
+				if url, ok := pageURL(topic, []interface{}{args[0].Interface()}); ok {
+					defer b.pipeline.DonePageWork(url)
+				}
+			}
+		}
+		return v.Call(args)
+	}).Interface()
+
+	b.Lock()
+	defer b.Unlock()
+	if err := subscribe(handler.wrapper); err != nil {
+		return err
+	}
+	b.handlers[topic] = append(b.handlers[topic], handler)
+	return nil
+}
+
+// received marks a counted event as delivered to a handler. It returns false
+// if the handler was called for an event that was not counted for it, which
+// can happen when it was subscribed while the event was being published.
+func (b *trackedBus) received(h *trackedHandler) bool {
+	b.Lock()
+	defer b.Unlock()
+	if h.pending == 0 {
+		return false
+	}
+	h.pending--
+	return true
+}
+
+// pageURL returns the URL of the page an event is about, if any.
+func pageURL(topic string, args []interface{}) (string, bool) {
+	switch topic {
+	case URL, URLResponsive, URLRendered:
+		if len(args) > 0 {
+			url, ok := args[0].(string)
+			return url, ok
+		}
+	}
+	return "", false
+}
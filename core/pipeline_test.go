@@ -0,0 +1,181 @@
+package core
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/asaskevich/EventBus"
+)
+
+func newTestPipeline() (*Pipeline, *trackedBus) {
+	pipeline := NewPipeline()
+	return pipeline, newTrackedBus(EventBus.New(), pipeline, nil)
+}
+
+func jitter() {
+	time.Sleep(time.Duration(rand.Intn(500)) * time.Microsecond)
+}
+
+func TestPipelineWaitsForChainedHandlers(t *testing.T) {
+	const n = 500
+	pipeline, bus := newTestPipeline()
+	wg := NewWaitGroup(8, pipeline)
+
+	var mutex sync.Mutex
+	pages := make(map[string]bool)
+
+	bus.SubscribeAsync(Host, func(host string) {
+		jitter()
+		bus.Publish(URL, fmt.Sprintf("http://%s/", host))
+	}, false)
+	bus.SubscribeAsync(URL, func(url string) {
+		wg.Add()
+		go func() {
+			defer wg.Done()
+			jitter()
+			bus.Publish(URLResponsive, url)
+		}()
+	}, false)
+	bus.SubscribeAsync(URLResponsive, func(url string) {
+		jitter()
+		mutex.Lock()
+		pages[url] = true
+		mutex.Unlock()
+	}, false)
+
+	for i := 0; i < n; i++ {
+		bus.Publish(Host, fmt.Sprintf("host%d.example.com", i))
+	}
+	pipeline.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(pages) != n {
+		t.Fatalf("expected %d pages to reach the end of the pipeline, got %d", n, len(pages))
+	}
+	if !pipeline.Drained() {
+		t.Errorf("expected pipeline to be drained")
+	}
+}
+
+func TestPipelineReportsDonePages(t *testing.T) {
+	const n = 100
+	pipeline, bus := newTestPipeline()
+	wg := NewWaitGroup(8, pipeline)
+
+	var mutex sync.Mutex
+	rendered := make(map[string]bool)
+	done := make(map[string]int)
+	pipeline.OnPageDone(func(url string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if !rendered[url] {
+			t.Errorf("expected %s to be done after it was rendered", url)
+		}
+		done[url]++
+	})
+
+	bus.SubscribeAsync(URL, func(url string) {
+		wg.AddPage(url)
+		go func() {
+			defer wg.DonePage(url)
+			jitter()
+			bus.Publish(URLResponsive, url)
+		}()
+	}, false)
+	bus.SubscribeAsync(URLResponsive, func(url string) {
+		wg.AddPage(url)
+		go func() {
+			defer wg.DonePage(url)
+			jitter()
+			bus.Publish(URLRendered, url)
+		}()
+	}, false)
+	bus.SubscribeAsync(URLRendered, func(url string) {
+		jitter()
+		mutex.Lock()
+		rendered[url] = true
+		mutex.Unlock()
+	}, false)
+
+	for i := 0; i < n; i++ {
+		bus.Publish(URL, fmt.Sprintf("http://host%d.example.com/", i))
+	}
+	pipeline.Wait()
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(done) != n {
+		t.Fatalf("expected %d pages to be done, got %d", n, len(done))
+	}
+	for url, count := range done {
+		if count != 1 {
+			t.Errorf("expected %s to be done once, got %d", url, count)
+		}
+	}
+}
+
+func TestPipelineCountsOnceHandlersOnce(t *testing.T) {
+	pipeline, bus := newTestPipeline()
+
+	var count int32
+	bus.SubscribeOnceAsync(SessionEnd, func() {
+		jitter()
+		atomic.AddInt32(&count, 1)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			bus.Publish(SessionEnd)
+		}()
+	}
+	wg.Wait()
+
+	done := make(chan struct{})
+	go func() {
+		pipeline.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("pipeline did not drain, %d events outstanding", pipeline.Outstanding(SessionEnd))
+	}
+	if got := atomic.LoadInt32(&count); got != 1 {
+		t.Errorf("expected once handler to be called once, got %d", got)
+	}
+}
+
+func expectPanic(t *testing.T, name string, fn func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected %s to panic", name)
+		}
+	}()
+	fn()
+}
+
+func TestPipelinePanicsOnNegativeCounts(t *testing.T) {
+	pipeline, _ := newTestPipeline()
+	expectPanic(t, "DoneJob without a job", pipeline.DoneJob)
+	expectPanic(t, "DoneEvent without an event", func() { pipeline.DoneEvent(URL) })
+	expectPanic(t, "DonePageWork without work", func() { pipeline.DonePageWork("http://example.com/") })
+
+	// The pipeline is still usable after a panic.
+	pipeline.AddJob()
+	if pipeline.Drained() {
+		t.Fatalf("expected pipeline with a job not to be drained")
+	}
+	pipeline.DoneJob()
+	if !pipeline.Drained() {
+		t.Fatalf("expected pipeline to be drained")
+	}
+}
@@ -1,5 +1,12 @@
 package core
 
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
 var (
 	SmallPortList = []int{80, 443}
 
@@ -17,3 +24,137 @@ var (
 		9090, 9091, 9200, 9443, 9800, 9981, 12443, 16080, 18091, 18092,
 		20720, 28017}
 )
+
+var PortListAliases = map[string][]int{
+	"small":   SmallPortList,
+	"medium":  MediumPortList,
+	"default": MediumPortList,
+	"large":   LargePortList,
+	"xlarge":  XLargePortList,
+	"huge":    XLargePortList,
+}
+
+const maxPortFileDepth = 8
+
+// ParsePorts parses a comma-separated port specification into a list of
+// ports. Entries can be single ports (8080), ranges (8000-8100), list aliases
+// (large), files with more entries (@ports.txt) and exclusions of any of these
+// (!8080). Exclusions are applied after all other entries regardless of their
+// position, and a port file is resolved on its own before it is used.
+func ParsePorts(spec string) ([]int, error) {
+	ports, err := parsePortSpec(spec, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("No ports left to scan in %s", spec)
+	}
+	return ports, nil
+}
+
+func parsePortSpec(spec string, depth int) ([]int, error) {
+	var include, exclude []int
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		target := &include
+		if strings.HasPrefix(entry, "!") {
+			target = &exclude
+			entry = strings.TrimSpace(strings.TrimPrefix(entry, "!"))
+		}
+
+		var ports []int
+		var err error
+		if strings.HasPrefix(entry, "@") {
+			if depth >= maxPortFileDepth {
+				return nil, fmt.Errorf("Port files are nested too deeply at %s", entry)
+			}
+			ports, err = parsePortFile(strings.TrimPrefix(entry, "@"), depth+1)
+		} else {
+			ports, err = parsePortEntry(entry)
+		}
+		if err != nil {
+			return nil, err
+		}
+		*target = append(*target, ports...)
+	}
+
+	excluded := make(map[int]bool)
+	for _, port := range exclude {
+		excluded[port] = true
+	}
+
+	var ports []int
+	seen := make(map[int]bool)
+	for _, port := range include {
+		if excluded[port] || seen[port] {
+			continue
+		}
+		ports = append(ports, port)
+		seen[port] = true
+	}
+	return ports, nil
+}
+
+func parsePortFile(filename string, depth int) ([]int, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read port file %s: %s", filename, err)
+	}
+
+	var entries []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if i := strings.Index(line, "#"); i != -1 {
+			line = line[:i]
+		}
+		entries = append(entries, strings.Fields(line)...)
+	}
+
+	ports, err := parsePortSpec(strings.Join(entries, ","), depth)
+	if err != nil {
+		return nil, fmt.Errorf("%s in port file %s", err, filename)
+	}
+	return ports, nil
+}
+
+func parsePortEntry(entry string) ([]int, error) {
+	if ports, ok := PortListAliases[strings.ToLower(entry)]; ok {
+		return ports, nil
+	}
+
+	first, last := entry, entry
+	if i := strings.Index(entry, "-"); i != -1 {
+		first, last = entry[:i], entry[i+1:]
+	}
+	start, err := parsePort(first)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parsePort(last)
+	if err != nil {
+		return nil, err
+	}
+	if start > end {
+		return nil, fmt.Errorf("Invalid port range given: %s", entry)
+	}
+
+	var ports []int
+	for port := start; port <= end; port++ {
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("Invalid port given: %s", s)
+	}
+	if port < 1 || port > 65535 {
+		return 0, fmt.Errorf("Invalid port given: %v", port)
+	}
+	return port, nil
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParsePorts(t *testing.T) {
+	tests := []struct {
+		spec string
+		want []int
+		err  bool
+	}{
+		{spec: "80", want: []int{80}},
+		{spec: "80,443, 8080", want: []int{80, 443, 8080}},
+		{spec: "8000-8003", want: []int{8000, 8001, 8002, 8003}},
+		{spec: "small", want: []int{80, 443}},
+		{spec: "SMALL,8080", want: []int{80, 443, 8080}},
+		{spec: "medium,!8000,!8443", want: []int{80, 443, 8080}},
+		{spec: "!443,small", want: []int{80}},
+		{spec: "8000-8005,!8001-8004", want: []int{8000, 8005}},
+		{spec: "80,80,small", want: []int{80, 443}},
+		{spec: "80,,443,", want: []int{80, 443}},
+		{spec: "65535", want: []int{65535}},
+		{spec: "0", err: true},
+		{spec: "65536", err: true},
+		{spec: "http", err: true},
+		{spec: "8003-8000", err: true},
+		{spec: "80-", err: true},
+		{spec: "80,!80", err: true},
+		{spec: "@/nonexistent/ports.txt", err: true},
+	}
+
+	for _, test := range tests {
+		got, err := ParsePorts(test.spec)
+		if test.err {
+			if err == nil {
+				t.Errorf("ParsePorts(%q): expected error, got %v", test.spec, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParsePorts(%q): unexpected error: %s", test.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ParsePorts(%q) = %v, want %v", test.spec, got, test.want)
+		}
+	}
+}
+
+func TestParsePortsFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aquatone-ports")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	nested := filepath.Join(dir, "nested.txt")
+	if err := ioutil.WriteFile(nested, []byte("9000\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ports := filepath.Join(dir, "ports.txt")
+	content := "# web ports\n80 443\n8000-8002 # dev servers\n!8001\n@" + nested + "\n"
+	if err := ioutil.WriteFile(ports, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loop := filepath.Join(dir, "loop.txt")
+	if err := ioutil.WriteFile(loop, []byte("@"+loop+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ParsePorts("@" + ports + ",!443")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []int{80, 8000, 8002, 9000}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParsePorts() = %v, want %v", got, want)
+	}
+
+	if _, err := ParsePorts("@" + loop); err == nil {
+		t.Error("expected error for recursive port file")
+	}
+}
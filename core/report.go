@@ -8,6 +8,10 @@ import (
 type Report struct {
 	Session  *Session
 	Template string
+	// InlineAssets inlines the JavaScript and CSS libraries embedded in the
+	// binary into the report so it works offline, instead of linking them
+	// from CDNs.
+	InlineAssets bool
 }
 
 func (r *Report) Render(dest io.Writer) error {
@@ -15,6 +19,9 @@ func (r *Report) Render(dest io.Writer) error {
 		"json": func(json string) template.JS {
 			return template.JS(json)
 		},
+		"asset": func(name string) (template.HTML, error) {
+			return assetTag(name, r.InlineAssets)
+		},
 	}
 
 	tmpl, err := template.New("Aquatone Report").Funcs(funcMap).Parse(r.Template)
@@ -32,7 +39,8 @@ func (r *Report) Render(dest io.Writer) error {
 
 func NewReport(s *Session, templ string) *Report {
 	return &Report{
-		Session:  s,
-		Template: templ,
+		Session:      s,
+		Template:     templ,
+		InlineAssets: true,
 	}
 }
@@ -0,0 +1,184 @@
+package core
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// ReportAsset is a JavaScript or CSS library used by the HTML report. Assets
+// are inlined from static/vendor/ when they are embedded in the binary, or
+// else linked from their CDN. Images referenced by stylesheets are assets
+// without an integrity hash.
+type ReportAsset struct {
+	Name      string
+	URL       string
+	Integrity string
+}
+
+var ReportAssets = []ReportAsset{
+	{"bootstrap.min.css", "https://stackpath.bootstrapcdn.com/bootstrap/4.3.1/css/bootstrap.min.css", "sha384-ggOyR0iXCbMQv3Xipma34MD+dH/1fQ784/j6cY/iJTQUOhcWr7x9JvoRxT2MZw1T"},
+	{"vis.min.css", "https://cdn.jsdelivr.net/npm/visjs-network@4.24.7/dist/vis.min.css", "sha384-hv6STAGuk4qTwmryFbZZTn3QrGRyZW1soC9K/Dy68zs8subBFOU69tg/GGZfkIBb"},
+	{"jquery-3.3.1.slim.min.js", "https://code.jquery.com/jquery-3.3.1.slim.min.js", "sha384-q8i/X+965DzO0rT7abK41JStQIAqVgRVzpbzo5smXKp4YfRvH+8abtTE1Pi6jizo"},
+	{"popper.min.js", "https://cdnjs.cloudflare.com/ajax/libs/popper.js/1.14.7/umd/popper.min.js", "sha384-UO2eT0CpHqdSJQ6hJty5KVphtPhzWj9WO1clHTMGa3JDZwrnQq4sF86dIHNDz0W1"},
+	{"bootstrap.min.js", "https://stackpath.bootstrapcdn.com/bootstrap/4.3.1/js/bootstrap.min.js", "sha384-JjSmVgyd0p3pXB1rRibZUAYoIIy6OrQ6VrjIEaFf/nJGzIxFDsf4x0xIM+B07jRM"},
+	{"underscore-min.js", "https://cdn.jsdelivr.net/npm/underscore@1.9.1/underscore-min.js", "sha384-5DWzr9S4agqS3WKvPrhFKJagpYyHOBsf3/DxuDKORyqCv2sYer9c/ExdhPOL8CGh"},
+	{"vue.min.js", "https://cdn.jsdelivr.net/npm/vue@2.6.10/dist/vue.min.js", "sha384-8t+aLluUVnn5SPPG/NbeZCH6TWIvaXIm/gDbutRvtEeElzxxWaZN+G/ZIEdI/f+y"},
+	{"vue-router.min.js", "https://cdn.jsdelivr.net/npm/vue-router@3.0.6/dist/vue-router.min.js", "sha384-eNSGvYAboU/sfO4dGv1q8C58mrNJs93uBoyn/VQ5S8+KB/XqmiocEDAkgdaCGOMT"},
+	{"vis.min.js", "https://cdn.jsdelivr.net/npm/visjs-network@4.24.7/dist/vis.min.js", "sha384-oakKFXWtujbJX7wGZH2I3z1MXq8kc0RqfMnyFBYctBBM/1G5r9ZpLG7ese6fTdu4"},
+}
+
+var cssURLRegex = regexp.MustCompile(`url\(\s*(?:"([^"]*)"|'([^']*)'|([^'")\s]*))\s*\)`)
+
+// readAsset returns the content of a file embedded in the binary.
+var readAsset = Asset
+
+func (a ReportAsset) Path() string {
+	return "static/vendor/" + a.Name
+}
+
+// Embedded returns the content of the asset if it is embedded in the binary
+// and matches its integrity hash, if it has one.
+func (a ReportAsset) Embedded() ([]byte, bool) {
+	content, err := readAsset(a.Path())
+	if err != nil || (a.Integrity != "" && !VerifyIntegrity(content, a.Integrity)) {
+		return nil, false
+	}
+	return content, true
+}
+
+// Images returns the images referenced by relative url() references in a
+// stylesheet, named by their path next to the stylesheet in static/vendor/.
+func (a ReportAsset) Images(css []byte) []ReportAsset {
+	var images []ReportAsset
+	seen := make(map[string]bool)
+	for _, groups := range cssURLRegex.FindAllStringSubmatch(string(css), -1) {
+		image, ok := a.image(groups[1] + groups[2] + groups[3])
+		if !ok || image.Name == "" || seen[image.Name] {
+			continue
+		}
+		seen[image.Name] = true
+		images = append(images, image)
+	}
+	return images
+}
+
+// image returns the image a relative url() reference in the stylesheet
+// refers to. Images outside the directory of the stylesheet can't be
+// vendored and only have a URL.
+func (a ReportAsset) image(ref string) (ReportAsset, bool) {
+	if ref == "" || strings.HasPrefix(ref, "#") {
+		return ReportAsset{}, false
+	}
+	u, err := url.Parse(ref)
+	if err != nil || u.IsAbs() || u.Host != "" || u.Path == "" {
+		return ReportAsset{}, false
+	}
+	base, err := url.Parse(a.URL)
+	if err != nil {
+		return ReportAsset{}, false
+	}
+	image := ReportAsset{URL: base.ResolveReference(&url.URL{Path: u.Path}).String()}
+	name := path.Join(path.Dir(a.Name), u.Path)
+	if !strings.HasPrefix(u.Path, "/") && name != ".." && !strings.HasPrefix(name, "../") {
+		image.Name = name
+	}
+	return image, true
+}
+
+// VerifyIntegrity checks content against a sha384 subresource integrity
+// value.
+func VerifyIntegrity(content []byte, integrity string) bool {
+	sum := sha512.Sum384(content)
+	return "sha384-"+base64.StdEncoding.EncodeToString(sum[:]) == integrity
+}
+
+// MissingReportAssets returns the names of report assets and the images of
+// their stylesheets that are not embedded in the binary and are linked from
+// their CDN instead.
+func MissingReportAssets() []string {
+	var missing []string
+	for _, asset := range ReportAssets {
+		content, ok := asset.Embedded()
+		if !ok {
+			missing = append(missing, asset.Name)
+			continue
+		}
+		for _, image := range asset.Images(content) {
+			if _, ok := image.Embedded(); !ok {
+				missing = append(missing, image.Name)
+			}
+		}
+	}
+	return missing
+}
+
+func findReportAsset(name string) (ReportAsset, error) {
+	for _, asset := range ReportAssets {
+		if asset.Name == name {
+			return asset, nil
+		}
+	}
+	return ReportAsset{}, fmt.Errorf("Unknown report asset %s", name)
+}
+
+// assetTag returns a style or script element with the asset inlined, or a
+// link or script element referencing the CDN if inline is false or the asset
+// is not embedded.
+func assetTag(name string, inline bool) (template.HTML, error) {
+	asset, err := findReportAsset(name)
+	if err != nil {
+		return "", err
+	}
+	stylesheet := strings.HasSuffix(asset.Name, ".css")
+
+	if inline {
+		if content, ok := asset.Embedded(); ok {
+			// Keep the library from closing the element it is inlined in.
+			if stylesheet {
+				css := inlineCSSImages(string(content), asset)
+				return template.HTML("<style type=\"text/css\">\n" + strings.Replace(css, "</style", "<\\/style", -1) + "\n</style>"), nil
+			}
+			return template.HTML("<script>\n" + strings.Replace(string(content), "</script", "<\\/script", -1) + "\n</script>"), nil
+		}
+	}
+
+	if stylesheet {
+		return template.HTML(fmt.Sprintf(`<link rel="stylesheet" href="%s" integrity="%s" crossorigin="anonymous">`, asset.URL, asset.Integrity)), nil
+	}
+	return template.HTML(fmt.Sprintf(`<script src="%s" integrity="%s" crossorigin="anonymous"></script>`, asset.URL, asset.Integrity)), nil
+}
+
+// inlineCSSImages replaces relative url() references in a stylesheet with
+// data URIs of the embedded images. Once the stylesheet is inlined, they
+// would otherwise be resolved against the location of the report, where the
+// referenced images don't exist. Images that are not embedded are referenced
+// by their CDN URL instead.
+func inlineCSSImages(css string, asset ReportAsset) string {
+	return cssURLRegex.ReplaceAllStringFunc(css, func(match string) string {
+		groups := cssURLRegex.FindStringSubmatch(match)
+		image, ok := asset.image(groups[1] + groups[2] + groups[3])
+		if !ok {
+			return match
+		}
+		if image.Name == "" {
+			return fmt.Sprintf("url(%q)", image.URL)
+		}
+		content, ok := image.Embedded()
+		if !ok {
+			return fmt.Sprintf("url(%q)", image.URL)
+		}
+		contentType := mime.TypeByExtension(path.Ext(image.Name))
+		if contentType == "" {
+			contentType = http.DetectContentType(content)
+		}
+		return fmt.Sprintf("url(%q)", dataURI(content, contentType))
+	})
+}
@@ -0,0 +1,143 @@
+package core
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestReportAssetsEmbedded fails builds that would link the report libraries
+// from their CDNs because static/vendor/ was not populated before generating
+// bindata.go.
+func TestReportAssetsEmbedded(t *testing.T) {
+	if missing := MissingReportAssets(); len(missing) > 0 {
+		t.Fatalf("Report assets not embedded or not matching their integrity hash: %s. Run go run ./cmd/fetchassets and regenerate core/bindata.go", strings.Join(missing, ", "))
+	}
+}
+
+func TestVerifyIntegrity(t *testing.T) {
+	content := []byte("alert('Hello, world.');")
+	if !VerifyIntegrity(content, "sha384-H8BRh8j48O9oYatfu5AZzq6A9RINhZO5H16dQZngK7T62em8MUt1FLm52t+eX6xO") {
+		t.Error("expected content to match its integrity hash")
+	}
+	if VerifyIntegrity(append(content, ' '), "sha384-H8BRh8j48O9oYatfu5AZzq6A9RINhZO5H16dQZngK7T62em8MUt1FLm52t+eX6xO") {
+		t.Error("expected changed content not to match integrity hash")
+	}
+}
+
+// fakeAssets replaces the embedded files with the given files until the
+// returned function is called.
+func fakeAssets(files map[string][]byte) func() {
+	original := readAsset
+	readAsset = func(name string) ([]byte, error) {
+		content, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("Asset %s not found", name)
+		}
+		return content, nil
+	}
+	return func() {
+		readAsset = original
+	}
+}
+
+func TestReportAssetImages(t *testing.T) {
+	asset := ReportAsset{Name: "vis.min.css", URL: "https://cdn.jsdelivr.net/npm/visjs-network@4.24.7/dist/vis.min.css"}
+	css := `a{background:url(img/network/addNodeIcon.png)} b{background:url('img/network/addNodeIcon.png')} ` +
+		`c{background:url("./img/network/cross.png?v=1")} d{background:url(../img/e.png)} ` +
+		`f{background:url(data:image/png;base64,AAAA)} g{filter:url(#shadow)} h{background:url(https://example.com/h.png)}`
+
+	images := asset.Images([]byte(css))
+	want := []ReportAsset{
+		{Name: "img/network/addNodeIcon.png", URL: "https://cdn.jsdelivr.net/npm/visjs-network@4.24.7/dist/img/network/addNodeIcon.png"},
+		{Name: "img/network/cross.png", URL: "https://cdn.jsdelivr.net/npm/visjs-network@4.24.7/dist/img/network/cross.png"},
+	}
+	if !reflect.DeepEqual(images, want) {
+		t.Errorf("unexpected images\n got: %+v\nwant: %+v", images, want)
+	}
+	if images[0].Path() != "static/vendor/img/network/addNodeIcon.png" {
+		t.Errorf("unexpected image path %s", images[0].Path())
+	}
+}
+
+func TestInlineCSSImages(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\nfake")
+	defer fakeAssets(map[string][]byte{
+		"static/vendor/img/network/addNodeIcon.png": png,
+	})()
+
+	asset := ReportAsset{Name: "vis.min.css", URL: "https://cdn.jsdelivr.net/npm/visjs-network@4.24.7/dist/vis.min.css"}
+	pngURI := "data:image/png;base64," + base64.StdEncoding.EncodeToString(png)
+	tests := []struct {
+		css  string
+		want string
+	}{
+		{
+			`.vis-button{background-image:url(img/network/addNodeIcon.png)}`,
+			`.vis-button{background-image:url("` + pngURI + `")}`,
+		},
+		{
+			`a{background:url('img/missing.png')} b{background:url( "../img/b.png" )}`,
+			`a{background:url("https://cdn.jsdelivr.net/npm/visjs-network@4.24.7/dist/img/missing.png")} b{background:url("https://cdn.jsdelivr.net/npm/visjs-network@4.24.7/img/b.png")}`,
+		},
+		{
+			`a{background:url("data:image/svg+xml,%3csvg xmlns='http://www.w3.org/2000/svg'%3e%3c/svg%3e")}`,
+			`a{background:url("data:image/svg+xml,%3csvg xmlns='http://www.w3.org/2000/svg'%3e%3c/svg%3e")}`,
+		},
+		{
+			`a{background:url(https://example.com/a.png)} b{filter:url(#shadow)}`,
+			`a{background:url(https://example.com/a.png)} b{filter:url(#shadow)}`,
+		},
+	}
+	for _, tt := range tests {
+		if got := inlineCSSImages(tt.css, asset); got != tt.want {
+			t.Errorf("inlineCSSImages(%s)\n got: %s\nwant: %s", tt.css, got, tt.want)
+		}
+	}
+}
+
+func TestMissingReportAssetsIncludesImages(t *testing.T) {
+	files := make(map[string][]byte)
+	for _, asset := range ReportAssets {
+		files[asset.Path()] = []byte(asset.Name)
+	}
+	defer fakeAssets(files)()
+
+	// None of the fake assets match their integrity hash.
+	if missing := MissingReportAssets(); len(missing) != len(ReportAssets) {
+		t.Errorf("expected all assets to be missing, got %v", missing)
+	}
+
+	original := ReportAssets
+	defer func() { ReportAssets = original }()
+	css := []byte(`a{background:url(img/a.png)} b{background:url(img/b.png)}`)
+	ReportAssets = []ReportAsset{{Name: "test.css", Integrity: integrity(css)}}
+	files["static/vendor/test.css"] = css
+	files["static/vendor/img/a.png"] = []byte("a")
+
+	if missing := MissingReportAssets(); !reflect.DeepEqual(missing, []string{"img/b.png"}) {
+		t.Errorf("expected image that isn't embedded to be missing, got %v", missing)
+	}
+}
+
+func integrity(content []byte) string {
+	sum := sha512.Sum384(content)
+	return "sha384-" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func TestAssetTagLinksCDN(t *testing.T) {
+	tag, err := assetTag("vue.min.js", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<script src="https://cdn.jsdelivr.net/npm/vue@2.6.10/dist/vue.min.js" integrity="sha384-8t+aLluUVnn5SPPG/NbeZCH6TWIvaXIm/gDbutRvtEeElzxxWaZN+G/ZIEdI/f+y" crossorigin="anonymous"></script>`
+	if string(tag) != want {
+		t.Errorf("unexpected tag %s", tag)
+	}
+	if _, err := assetTag("missing.js", true); err == nil {
+		t.Error("expected error for unknown asset")
+	}
+}
@@ -0,0 +1,160 @@
+package core
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const (
+	thumbnailWidth   = 640
+	thumbnailQuality = 70
+)
+
+type ExportOptions struct {
+	// Thumbnails embeds screenshots as downscaled JPEG images instead of the
+	// original PNG images to keep the report small.
+	Thumbnails bool
+}
+
+// RenderSingleFile renders the report with screenshots and raw headers
+// embedded as data URIs, so the report can be shared as a single file. Links
+// to response bodies and rendered DOMs are left out as they would make the
+// file too big. The libraries used by the report are always inlined, and the
+// export fails if any of them is not embedded in the binary.
+func (r *Report) RenderSingleFile(dest io.Writer, options ExportOptions) error {
+	if missing := MissingReportAssets(); len(missing) > 0 {
+		return fmt.Errorf("Report assets %s are not embedded in this build, so the report can't be exported as a single file", strings.Join(missing, ", "))
+	}
+	exported, err := r.Session.exportSession(options)
+	if err != nil {
+		return err
+	}
+	report := *r
+	report.Session = exported
+	report.InlineAssets = true
+	return report.Render(dest)
+}
+
+// exportSession returns a copy of the session with file paths of pages
+// replaced by data URIs. Files that can't be read are left out.
+func (s *Session) exportSession(options ExportOptions) (*Session, error) {
+	var exported Session
+	if err := json.Unmarshal([]byte(s.ToJSON()), &exported); err != nil {
+		return nil, err
+	}
+
+	for _, page := range exported.Pages {
+		for i := range page.Screenshots {
+			page.Screenshots[i].Path = s.screenshotDataURI(page.Screenshots[i].Path, options)
+		}
+		if len(page.Screenshots) > 0 {
+			// The report shows the screenshots of pages with viewports from
+			// Screenshots, so the main screenshot isn't embedded twice.
+			page.ScreenshotPath = ""
+			page.HasScreenshot = page.Screenshots[0].Path != ""
+		} else {
+			page.ScreenshotPath = s.screenshotDataURI(page.ScreenshotPath, options)
+			page.HasScreenshot = page.ScreenshotPath != ""
+		}
+		page.HeadersPath = s.fileDataURI(page.HeadersPath, "text/plain; charset=utf-8")
+		page.BodyPath = ""
+		page.RenderedPath = ""
+	}
+	return &exported, nil
+}
+
+func (s *Session) fileDataURI(p string, contentType string) string {
+	if p == "" {
+		return ""
+	}
+	content, err := s.ReadFile(p)
+	if err != nil {
+		return ""
+	}
+	return dataURI(content, contentType)
+}
+
+func (s *Session) screenshotDataURI(p string, options ExportOptions) string {
+	if p == "" {
+		return ""
+	}
+	content, err := s.ReadFile(p)
+	if err != nil {
+		return ""
+	}
+
+	if options.Thumbnails {
+		if thumbnail, err := Thumbnail(content, thumbnailWidth); err == nil {
+			return dataURI(thumbnail, "image/jpeg")
+		}
+	}
+	return dataURI(content, http.DetectContentType(content))
+}
+
+func dataURI(content []byte, contentType string) string {
+	return "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(content)
+}
+
+// Thumbnail downscales an image to the given width, keeping its aspect
+// ratio, and encodes it as a JPEG image. Images that are already narrower
+// are only re-encoded.
+func Thumbnail(content []byte, width int) ([]byte, error) {
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dx() > width {
+		img = downscale(img, width, bounds.Dy()*width/bounds.Dx())
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// downscale resizes an image by averaging the pixels that make up each pixel
+// of the smaller image.
+func downscale(img image.Image, width, height int) image.Image {
+	if height < 1 {
+		height = 1
+	}
+	bounds := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := bounds.Min.Y + (y+1)*bounds.Dy()/height
+		if y1 == y0 {
+			y1 = y0 + 1
+		}
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := bounds.Min.X + (x+1)*bounds.Dx()/width
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r, g, b, a = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa)
+					n++
+				}
+			}
+			if n == 0 {
+				continue
+			}
+			dst.Set(x, y, color.RGBA64{uint16(r / n), uint16(g / n), uint16(b / n), uint16(a / n)})
+		}
+	}
+	return dst
+}
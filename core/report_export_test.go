@@ -0,0 +1,181 @@
+package core
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var jpegDataURIRegex = regexp.MustCompile(`data:image/jpeg;base64,([A-Za-z0-9+/=]+)`)
+
+// solidImage returns an image of the given size filled with one color.
+func solidImage(width, height int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// fakeReportAssets replaces the report assets with a single embedded script
+// until the returned function is called.
+func fakeReportAssets(script []byte) func() {
+	original := ReportAssets
+	ReportAssets = []ReportAsset{{Name: "test.js", URL: "https://cdn.example.com/test.js", Integrity: integrity(script)}}
+	restoreAssets := fakeAssets(map[string][]byte{"static/vendor/test.js": script})
+	return func() {
+		restoreAssets()
+		ReportAssets = original
+	}
+}
+
+func TestRenderSingleFileRequiresEmbeddedAssets(t *testing.T) {
+	s := newTestSession(t)
+	defer os.RemoveAll(*s.Options.OutDir)
+	defer fakeAssets(map[string][]byte{})()
+	report := NewReport(s, `{{asset "vue.min.js"}}`)
+
+	var buf bytes.Buffer
+	err := report.RenderSingleFile(&buf, ExportOptions{})
+	if err == nil || !strings.Contains(err.Error(), "vue.min.js") {
+		t.Fatalf("expected export to fail for missing assets, got %v", err)
+	}
+}
+
+func TestRenderSingleFile(t *testing.T) {
+	s := newTestSession(t)
+	defer os.RemoveAll(*s.Options.OutDir)
+	defer fakeReportAssets([]byte("var library = true;"))()
+
+	screenshot := encodePNG(t, solidImage(1280, 800, color.RGBA{0, 128, 255, 255}))
+	if err := os.MkdirAll(filepath.Join(*s.Options.OutDir, "screenshots"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(*s.Options.OutDir, "screenshots", "example.png"), screenshot, 0644); err != nil {
+		t.Fatal(err)
+	}
+	page, err := s.AddPage("http://example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	page.AddScreenshot("desktop", "screenshots/example.png", false)
+
+	report := NewReport(s, `{{asset "test.js"}}<script>var session = {{json .ToJSON}};</script>`)
+	report.InlineAssets = false
+
+	var buf bytes.Buffer
+	if err := report.RenderSingleFile(&buf, ExportOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+	if !strings.Contains(html, "var library = true;") || strings.Contains(html, "cdn.example.com") {
+		t.Error("expected assets to be inlined in single-file report")
+	}
+	if !strings.Contains(html, "data:image/png;base64,"+base64.StdEncoding.EncodeToString(screenshot)) {
+		t.Error("expected screenshot to be embedded as PNG data URI")
+	}
+	if strings.Contains(html, "screenshots/example.png") {
+		t.Error("expected screenshot path to be replaced")
+	}
+
+	buf.Reset()
+	if err := report.RenderSingleFile(&buf, ExportOptions{Thumbnails: true}); err != nil {
+		t.Fatal(err)
+	}
+	match := jpegDataURIRegex.FindStringSubmatch(buf.String())
+	if match == nil {
+		t.Fatal("expected screenshot to be embedded as JPEG data URI")
+	}
+	thumbnail, err := base64.StdEncoding.DecodeString(match[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := jpeg.DecodeConfig(bytes.NewReader(thumbnail))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Width != thumbnailWidth || config.Height != 400 {
+		t.Errorf("expected %dx400 thumbnail, got %dx%d", thumbnailWidth, config.Width, config.Height)
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	tests := []struct {
+		width, height int
+		wantWidth     int
+		wantHeight    int
+	}{
+		{1280, 800, 640, 400},
+		{1000, 3000, 640, 1920},
+		{320, 200, 320, 200},
+		{2000, 1, 640, 1},
+	}
+	for _, tt := range tests {
+		content := encodePNG(t, solidImage(tt.width, tt.height, color.White))
+		thumbnail, err := Thumbnail(content, 640)
+		if err != nil {
+			t.Fatalf("Thumbnail(%dx%d): %s", tt.width, tt.height, err)
+		}
+		config, format, err := image.DecodeConfig(bytes.NewReader(thumbnail))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if format != "jpeg" || config.Width != tt.wantWidth || config.Height != tt.wantHeight {
+			t.Errorf("Thumbnail(%dx%d) = %s %dx%d, want jpeg %dx%d", tt.width, tt.height, format, config.Width, config.Height, tt.wantWidth, tt.wantHeight)
+		}
+	}
+
+	if _, err := Thumbnail([]byte("not an image"), 640); err == nil {
+		t.Error("expected error for invalid image")
+	}
+}
+
+func TestDownscale(t *testing.T) {
+	// The left half is black and white stripes, the right half is red.
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 2; y++ {
+			switch {
+			case x >= 2:
+				img.Set(x, y, color.RGBA{255, 0, 0, 255})
+			case x == 0:
+				img.Set(x, y, color.Black)
+			default:
+				img.Set(x, y, color.White)
+			}
+		}
+	}
+
+	scaled := downscale(img, 2, 1)
+	if bounds := scaled.Bounds(); bounds.Dx() != 2 || bounds.Dy() != 1 {
+		t.Fatalf("expected 2x1 image, got %dx%d", bounds.Dx(), bounds.Dy())
+	}
+	gray := color.RGBAModel.Convert(scaled.At(0, 0)).(color.RGBA)
+	if gray.R != 127 || gray.G != 127 || gray.B != 127 || gray.A != 255 {
+		t.Errorf("expected black and white to average to gray, got %v", gray)
+	}
+	red := color.RGBAModel.Convert(scaled.At(1, 0)).(color.RGBA)
+	if red != (color.RGBA{255, 0, 0, 255}) {
+		t.Errorf("expected red to stay red, got %v", red)
+	}
+}
@@ -0,0 +1,232 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Scope decides which hosts, ports and URLs Aquatone is allowed to touch.
+// Exclude rules always win over include rules, and when there are no include
+// rules everything that is not excluded is in scope.
+type Scope struct {
+	includes []scopeRule
+	excludes []scopeRule
+}
+
+type scopeRule struct {
+	hostname string
+	wildcard bool
+	network  *net.IPNet
+	ports    map[int]bool
+}
+
+func NewScope() *Scope {
+	return &Scope{}
+}
+
+func LoadScope(filename string) (*Scope, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseScope(f)
+}
+
+func ParseScope(r io.Reader) (*Scope, error) {
+	scope := NewScope()
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i != -1 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if err := scope.AddRule(line); err != nil {
+			return nil, fmt.Errorf("Invalid scope rule on line %d: %s", lineNum, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return scope, nil
+}
+
+func (s *Scope) AddRule(rule string) error {
+	exclude := false
+	if strings.HasPrefix(rule, "!") {
+		exclude = true
+		rule = strings.TrimSpace(strings.TrimPrefix(rule, "!"))
+	}
+
+	r, err := parseScopeRule(rule)
+	if err != nil {
+		return err
+	}
+	if exclude {
+		s.excludes = append(s.excludes, r)
+	} else {
+		s.includes = append(s.includes, r)
+	}
+	return nil
+}
+
+func (s *Scope) Empty() bool {
+	return len(s.includes) == 0 && len(s.excludes) == 0
+}
+
+// HostInScope reports whether a host may be processed at all. Rules with port
+// restrictions include the host, but only excludes without ports exclude it.
+func (s *Scope) HostInScope(host string) bool {
+	return s.inScope(host, 0)
+}
+
+func (s *Scope) PortInScope(host string, port int) bool {
+	return s.inScope(host, port)
+}
+
+func (s *Scope) URLInScope(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return false
+	}
+	port, err := strconv.Atoi(u.Port())
+	if err != nil {
+		switch strings.ToLower(u.Scheme) {
+		case "https":
+			port = 443
+		default:
+			port = 80
+		}
+	}
+	return s.inScope(u.Hostname(), port)
+}
+
+func (s *Scope) inScope(host string, port int) bool {
+	host = strings.ToLower(strings.TrimSuffix(strings.Trim(host, "[]"), "."))
+	for _, rule := range s.excludes {
+		if port == 0 && len(rule.ports) > 0 {
+			continue
+		}
+		if rule.matches(host, port) {
+			return false
+		}
+	}
+	if len(s.includes) == 0 {
+		return true
+	}
+	for _, rule := range s.includes {
+		if rule.matches(host, port) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r scopeRule) matches(host string, port int) bool {
+	if port != 0 && len(r.ports) > 0 && !r.ports[port] {
+		return false
+	}
+	if r.network != nil {
+		ip := net.ParseIP(host)
+		return ip != nil && r.network.Contains(ip)
+	}
+	if r.wildcard {
+		return strings.HasSuffix(host, "."+r.hostname)
+	}
+	return host == r.hostname
+}
+
+func parseScopeRule(rule string) (scopeRule, error) {
+	var r scopeRule
+	host, ports := splitScopeRule(rule)
+	if ports != "" {
+		parsed, err := parseScopePorts(ports)
+		if err != nil {
+			return r, err
+		}
+		r.ports = parsed
+	}
+
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if host == "" {
+		return r, fmt.Errorf("%s has no host", rule)
+	}
+
+	if strings.Contains(host, "/") {
+		_, network, err := net.ParseCIDR(host)
+		if err != nil {
+			return r, fmt.Errorf("%s is not a valid CIDR range", host)
+		}
+		r.network = network
+		return r, nil
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		bits := 32
+		if ip.To4() == nil {
+			bits = 128
+		}
+		r.network = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		return r, nil
+	}
+
+	if strings.HasPrefix(host, "*.") {
+		r.wildcard = true
+		host = strings.TrimPrefix(host, "*.")
+	}
+	if host == "" || strings.ContainsAny(host, "*/ ") {
+		return r, fmt.Errorf("%s is not a valid hostname", rule)
+	}
+	r.hostname = host
+	return r, nil
+}
+
+// splitScopeRule splits a rule into its host and port parts. IPv6 addresses
+// must be wrapped in brackets to be combined with ports.
+func splitScopeRule(rule string) (string, string) {
+	if strings.HasPrefix(rule, "[") {
+		end := strings.Index(rule, "]")
+		if end == -1 {
+			return rule, ""
+		}
+		host := rule[1:end]
+		rest := rule[end+1:]
+		if strings.HasPrefix(rest, "/") {
+			i := strings.Index(rest, ":")
+			if i == -1 {
+				return host + rest, ""
+			}
+			return host + rest[:i], rest[i+1:]
+		}
+		return host, strings.TrimPrefix(rest, ":")
+	}
+	if strings.Count(rule, ":") != 1 {
+		return rule, ""
+	}
+	i := strings.Index(rule, ":")
+	return rule[:i], rule[i+1:]
+}
+
+func parseScopePorts(list string) (map[int]bool, error) {
+	parsed, err := ParsePorts(list)
+	if err != nil {
+		return nil, err
+	}
+	ports := make(map[int]bool)
+	for _, port := range parsed {
+		ports[port] = true
+	}
+	return ports, nil
+}
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseScope(t *testing.T) {
+	tests := []struct {
+		rules string
+		err   bool
+	}{
+		{rules: "example.com\n*.example.com\n", err: false},
+		{rules: "# comment\n\n  10.0.0.0/24  # office\n", err: false},
+		{rules: "!admin.example.com\n!10.0.0.1\n", err: false},
+		{rules: "example.com:80,443\n[2001:db8::1]:8080\n[2001:db8::]/32:443\n", err: false},
+		{rules: "example.com:1-1024,!22\n", err: false},
+		{rules: "10.0.0.0/33\n", err: true},
+		{rules: "*.\n", err: true},
+		{rules: "foo*.example.com\n", err: true},
+		{rules: ":80\n", err: true},
+		{rules: "example.com:http\n", err: true},
+	}
+
+	for _, test := range tests {
+		_, err := ParseScope(strings.NewReader(test.rules))
+		if test.err && err == nil {
+			t.Errorf("ParseScope(%q): expected error", test.rules)
+		}
+		if !test.err && err != nil {
+			t.Errorf("ParseScope(%q): unexpected error: %s", test.rules, err)
+		}
+	}
+}
+
+func TestParseScopeErrorLine(t *testing.T) {
+	_, err := ParseScope(strings.NewReader("example.com\n# comment\n10.0.0.0/33\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("expected error on line 3, got %v", err)
+	}
+}
+
+func TestScopeURLInScope(t *testing.T) {
+	scope, err := ParseScope(strings.NewReader(`
+example.com
+*.example.com
+!admin.example.com
+!*.internal.example.com
+10.0.0.0/24
+!10.0.0.1
+api.example.org:443
+[2001:db8::1]:8080
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		url  string
+		want bool
+	}{
+		{"http://example.com/", true},
+		{"https://EXAMPLE.com./login", true},
+		{"https://www.example.com/", true},
+		{"https://admin.example.com/", false},
+		{"https://db.internal.example.com/", false},
+		{"https://notexample.com/", false},
+		{"http://10.0.0.20:8080/", true},
+		{"http://10.0.0.1/", false},
+		{"http://10.0.1.1/", false},
+		{"https://api.example.org/", true},
+		{"http://api.example.org/", false},
+		{"https://api.example.org:8443/", false},
+		{"http://[2001:db8::1]:8080/", true},
+		{"http://[2001:db8::1]/", false},
+		{"not a url", false},
+		{"/relative/path", false},
+	}
+
+	for _, test := range tests {
+		if got := scope.URLInScope(test.url); got != test.want {
+			t.Errorf("URLInScope(%q) = %v, want %v", test.url, got, test.want)
+		}
+	}
+}
+
+func TestScopeHostAndPortInScope(t *testing.T) {
+	scope, err := ParseScope(strings.NewReader("example.com:443\n*.example.com\n!www.example.com:80\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !scope.HostInScope("example.com") {
+		t.Error("expected host with port rule to be in scope")
+	}
+	if !scope.HostInScope("www.example.com") {
+		t.Error("expected host excluded on a single port to be in scope")
+	}
+	if scope.PortInScope("example.com", 80) {
+		t.Error("expected port not in rule to be out of scope")
+	}
+	if !scope.PortInScope("example.com", 443) {
+		t.Error("expected port in rule to be in scope")
+	}
+	if scope.PortInScope("www.example.com", 80) {
+		t.Error("expected excluded port to be out of scope")
+	}
+	if !scope.PortInScope("www.example.com", 443) {
+		t.Error("expected other port of partly excluded host to be in scope")
+	}
+}
+
+func TestEmptyScope(t *testing.T) {
+	scope := NewScope()
+	if !scope.Empty() {
+		t.Error("expected new scope to be empty")
+	}
+	if !scope.URLInScope("https://anything.example.com/") {
+		t.Error("expected everything to be in scope of an empty scope")
+	}
+}
@@ -1,11 +1,15 @@
 package core
 
 import (
+	"context"
 	"crypto/sha1"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
+	"net/textproto"
 	"net/url"
 	"os"
 	"path"
@@ -18,9 +22,10 @@ import (
 	"time"
 
 	"github.com/asaskevich/EventBus"
-	"github.com/remeh/sizedwaitgroup"
 )
 
+const SessionFilename = "aquatone_session.json"
+
 type Stats struct {
 	StartedAt            time.Time `json:"startedAt"`
 	FinishedAt           time.Time `json:"finishedAt"`
@@ -34,6 +39,27 @@ type Stats struct {
 	ResponseCode5xx      uint32    `json:"responseCode5xx"`
 	ScreenshotSuccessful uint32    `json:"screenshotSuccessful"`
 	ScreenshotFailed     uint32    `json:"screenshotFailed"`
+	OutOfScope           uint32    `json:"outOfScope"`
+}
+
+// snapshot returns a copy of the stats that is safe to read while counters are
+// still being incremented.
+func (s *Stats) snapshot() *Stats {
+	return &Stats{
+		StartedAt:            s.StartedAt,
+		FinishedAt:           s.FinishedAt,
+		PortOpen:             atomic.LoadUint32(&s.PortOpen),
+		PortClosed:           atomic.LoadUint32(&s.PortClosed),
+		RequestSuccessful:    atomic.LoadUint32(&s.RequestSuccessful),
+		RequestFailed:        atomic.LoadUint32(&s.RequestFailed),
+		ResponseCode2xx:      atomic.LoadUint32(&s.ResponseCode2xx),
+		ResponseCode3xx:      atomic.LoadUint32(&s.ResponseCode3xx),
+		ResponseCode4xx:      atomic.LoadUint32(&s.ResponseCode4xx),
+		ResponseCode5xx:      atomic.LoadUint32(&s.ResponseCode5xx),
+		ScreenshotSuccessful: atomic.LoadUint32(&s.ScreenshotSuccessful),
+		ScreenshotFailed:     atomic.LoadUint32(&s.ScreenshotFailed),
+		OutOfScope:           atomic.LoadUint32(&s.OutOfScope),
+	}
 }
 
 func (s *Stats) Duration() time.Duration {
@@ -80,33 +106,136 @@ func (s *Stats) IncrementScreenshotFailed() {
 	atomic.AddUint32(&s.ScreenshotFailed, 1)
 }
 
+func (s *Stats) IncrementOutOfScope() {
+	atomic.AddUint32(&s.OutOfScope, 1)
+}
+
 type Session struct {
-	sync.Mutex
-	Version                string                        `json:"version"`
-	Options                Options                       `json:"-"`
-	Out                    *Logger                       `json:"-"`
-	Stats                  *Stats                        `json:"stats"`
-	Pages                  map[string]*Page              `json:"pages"`
-	PageSimilarityClusters map[string][]string           `json:"pageSimilarityClusters"`
-	Ports                  []int                         `json:"-"`
-	EventBus               EventBus.Bus                  `json:"-"`
-	WaitGroup              sizedwaitgroup.SizedWaitGroup `json:"-"`
-}
-
-func (s *Session) Start() {
+	sync.RWMutex
+	Version                string                  `json:"version"`
+	Options                Options                 `json:"-"`
+	Out                    *Logger                 `json:"-"`
+	Stats                  *Stats                  `json:"stats"`
+	Pages                  map[string]*Page        `json:"pages"`
+	PageSimilarityClusters map[string][]string     `json:"pageSimilarityClusters"`
+	PageClusters           map[string]*PageCluster `json:"pageClusters"`
+	Ports                  []int                   `json:"-"`
+	Viewports              []Viewport              `json:"-"`
+	ClusterWeights         ClusterWeights          `json:"-"`
+	Scope                  *Scope                  `json:"-"`
+	RequestHeaders         map[string]string       `json:"-"`
+	Pipeline               *Pipeline               `json:"-"`
+	EventBus               EventBus.Bus            `json:"-"`
+	WaitGroup              WaitGroup               `json:"-"`
+	checkpointStop         chan struct{}
+	abortFuncs             []func()
+	pageDoneFuncs          []func(page *Page)
+	ctx                    context.Context
+	cancel                 context.CancelFunc
+}
+
+func (s *Session) Start() error {
 	s.Pages = make(map[string]*Page)
 	s.PageSimilarityClusters = make(map[string][]string)
+	s.PageClusters = make(map[string]*PageCluster)
+	s.initContext()
 	s.initStats()
 	s.initLogger()
-	s.initPorts()
+	if err := s.initPorts(); err != nil {
+		return err
+	}
+	if err := s.initScope(); err != nil {
+		return err
+	}
+	if err := s.initRequestHeaders(); err != nil {
+		return err
+	}
+	if err := s.initViewports(); err != nil {
+		return err
+	}
+	if err := s.initClusterOptions(); err != nil {
+		return err
+	}
 	s.initThreads()
+	s.initPipeline()
 	s.initEventBus()
 	s.initWaitGroup()
-	s.initDirectories()
+	if err := s.initDirectories(); err != nil {
+		return err
+	}
+	return nil
 }
 
+// End stops checkpointing and records when the session finished. Only the
+// first call records the finish time.
 func (s *Session) End() {
-	s.Stats.FinishedAt = time.Now()
+	s.StopCheckpointing()
+	if s.Stats.FinishedAt.IsZero() {
+		s.Stats.FinishedAt = time.Now()
+	}
+}
+
+func (s *Session) Context() context.Context {
+	return s.ctx
+}
+
+func (s *Session) Stop() {
+	s.cancel()
+}
+
+func (s *Session) Stopped() bool {
+	return s.ctx.Err() != nil
+}
+
+// OnAbort registers a function that releases resources outside of the
+// process, such as a browser, when the session is aborted.
+func (s *Session) OnAbort(fn func()) {
+	s.Lock()
+	defer s.Unlock()
+	s.abortFuncs = append(s.abortFuncs, fn)
+}
+
+// Abort runs the functions registered with OnAbort. It is called before the
+// process exits without waiting for agents to finish.
+func (s *Session) Abort() {
+	s.RLock()
+	funcs := s.abortFuncs
+	s.RUnlock()
+	for _, fn := range funcs {
+		fn()
+	}
+}
+
+func (s *Session) StartCheckpointing(filename string) {
+	if *s.Options.CheckpointInterval <= 0 || s.checkpointStop != nil {
+		return
+	}
+
+	s.checkpointStop = make(chan struct{})
+	ticker := time.NewTicker(time.Duration(*s.Options.CheckpointInterval) * time.Millisecond)
+	go func(stop chan struct{}) {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				if err := s.SaveToFile(filename); err != nil {
+					s.Out.Error("Failed to write session checkpoint: %s\n", err)
+					continue
+				}
+				s.Out.Debug("Wrote session checkpoint to %s\n", s.GetFilePath(filename))
+			}
+		}
+	}(s.checkpointStop)
+}
+
+func (s *Session) StopCheckpointing() {
+	if s.checkpointStop == nil {
+		return
+	}
+	close(s.checkpointStop)
+	s.checkpointStop = nil
 }
 
 func (s *Session) AddPage(url string) (*Page, error) {
@@ -126,6 +255,8 @@ func (s *Session) AddPage(url string) (*Page, error) {
 }
 
 func (s *Session) GetPage(url string) *Page {
+	s.RLock()
+	defer s.RUnlock()
 	if page, ok := s.Pages[url]; ok {
 		return page
 	}
@@ -133,6 +264,8 @@ func (s *Session) GetPage(url string) *Page {
 }
 
 func (s *Session) GetPageByUUID(id string) *Page {
+	s.RLock()
+	defer s.RUnlock()
 	for _, page := range s.Pages {
 		if page.UUID == id {
 			return page
@@ -141,6 +274,10 @@ func (s *Session) GetPageByUUID(id string) *Page {
 	return nil
 }
 
+func (s *Session) initContext() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+}
+
 func (s *Session) initStats() {
 	if s.Stats != nil {
 		return
@@ -150,32 +287,118 @@ func (s *Session) initStats() {
 	}
 }
 
-func (s *Session) initPorts() {
-	var ports []int
-	switch *s.Options.Ports {
-	case "small":
-		ports = SmallPortList
-	case "", "medium", "default":
-		ports = MediumPortList
-	case "large":
-		ports = LargePortList
-	case "xlarge", "huge":
-		ports = XLargePortList
-	default:
-		for _, p := range strings.Split(*s.Options.Ports, ",") {
-			port, err := strconv.Atoi(strings.TrimSpace(p))
-			if err != nil {
-				s.Out.Fatal("Invalid port range given\n")
-				os.Exit(1)
-			}
-			if port < 1 || port > 65535 {
-				s.Out.Fatal("Invalid port given: %v\n", port)
-				os.Exit(1)
-			}
-			ports = append(ports, port)
-		}
+func (s *Session) initPorts() error {
+	if strings.TrimSpace(*s.Options.Ports) == "" {
+		s.Ports = MediumPortList
+		return nil
+	}
+	ports, err := ParsePorts(*s.Options.Ports)
+	if err != nil {
+		return err
 	}
 	s.Ports = ports
+	return nil
+}
+
+func (s *Session) initViewports() error {
+	viewports, err := ParseViewports(*s.Options.Viewports, *s.Options.Resolution)
+	if err != nil {
+		return err
+	}
+	s.Viewports = viewports
+	return nil
+}
+
+func (s *Session) initClusterOptions() error {
+	if !containsString(ClusterModes, *s.Options.ClusterMode) {
+		return fmt.Errorf("Unknown cluster mode %s. Supported modes: %s", *s.Options.ClusterMode, strings.Join(ClusterModes, ", "))
+	}
+	if !containsString(ClusterAlgorithms, *s.Options.ClusterAlgorithm) {
+		return fmt.Errorf("Unknown cluster algorithm %s. Supported algorithms: %s", *s.Options.ClusterAlgorithm, strings.Join(ClusterAlgorithms, ", "))
+	}
+	if *s.Options.ClusterThreshold < 0 || *s.Options.ClusterThreshold > 1 {
+		return fmt.Errorf("Cluster threshold must be between 0 and 1")
+	}
+	weights, err := ParseClusterWeights(*s.Options.ClusterWeights)
+	if err != nil {
+		return err
+	}
+	s.ClusterWeights = weights
+	return nil
+}
+
+// SetPageClusters stores clusters of similar pages. The URLs of the pages in
+// each cluster are also stored in PageSimilarityClusters for reports and
+// tools that read it.
+func (s *Session) SetPageClusters(clusters map[string]*PageCluster) {
+	s.PageClusters = clusters
+	s.PageSimilarityClusters = make(map[string][]string)
+	for id, cluster := range clusters {
+		s.PageSimilarityClusters[id] = cluster.Pages
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Session) initScope() error {
+	if *s.Options.ScopePath == "" {
+		s.Scope = NewScope()
+		return nil
+	}
+	scope, err := LoadScope(*s.Options.ScopePath)
+	if err != nil {
+		return fmt.Errorf("Unable to load scope file %s: %s", *s.Options.ScopePath, err)
+	}
+	s.Scope = scope
+	return nil
+}
+
+func (s *Session) initRequestHeaders() error {
+	s.RequestHeaders = make(map[string]string)
+	addHeader := func(name string, value string, separator string) {
+		name = textproto.CanonicalMIMEHeaderKey(name)
+		if existing, ok := s.RequestHeaders[name]; ok {
+			value = existing + separator + value
+		}
+		s.RequestHeaders[name] = value
+	}
+
+	for _, header := range *s.Options.Headers {
+		parts := strings.SplitN(header, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return fmt.Errorf("Invalid header given: %s. Headers must be in the form 'Name: value'", header)
+		}
+		addHeader(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), ", ")
+	}
+
+	for _, cookie := range *s.Options.Cookies {
+		if !strings.Contains(cookie, "=") {
+			return fmt.Errorf("Invalid cookie given: %s. Cookies must be in the form 'name=value'", cookie)
+		}
+		addHeader("Cookie", strings.TrimSpace(cookie), "; ")
+	}
+
+	if *s.Options.BasicAuth != "" && *s.Options.BearerToken != "" {
+		return fmt.Errorf("Basic and bearer authentication can't be used at the same time")
+	}
+	if *s.Options.BasicAuth != "" {
+		if !strings.Contains(*s.Options.BasicAuth, ":") {
+			return fmt.Errorf("Invalid basic authentication credentials given. Credentials must be in the form 'username:password'")
+		}
+		s.RequestHeaders["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(*s.Options.BasicAuth))
+	}
+	if *s.Options.BearerToken != "" {
+		s.RequestHeaders["Authorization"] = "Bearer " + *s.Options.BearerToken
+	}
+
+	return nil
 }
 
 func (s *Session) initLogger() {
@@ -191,25 +414,89 @@ func (s *Session) initThreads() {
 	}
 }
 
+func (s *Session) initPipeline() {
+	s.Pipeline = NewPipeline()
+	s.Pipeline.OnPageDone(s.pageDone)
+}
+
+// OnPageDone registers a function that is called with each page when all
+// agents have finished with it.
+func (s *Session) OnPageDone(fn func(page *Page)) {
+	s.Lock()
+	defer s.Unlock()
+	s.pageDoneFuncs = append(s.pageDoneFuncs, fn)
+}
+
+// pageDone marks a page as processed when all agents have finished with it.
+// Pages finished after the session was stopped may have been skipped by some
+// agents and are left unmarked, so a resumed session processes them again.
+func (s *Session) pageDone(url string) {
+	page := s.GetPage(url)
+	if page == nil {
+		return
+	}
+	if !s.Stopped() {
+		page.Lock()
+		page.Processed = true
+		page.Unlock()
+	}
+
+	s.RLock()
+	funcs := s.pageDoneFuncs
+	s.RUnlock()
+	for _, fn := range funcs {
+		fn(page)
+	}
+}
+
 func (s *Session) initEventBus() {
-	s.EventBus = EventBus.New()
+	s.EventBus = newTrackedBus(EventBus.New(), s.Pipeline, s.inScope)
+}
+
+func (s *Session) inScope(topic string, args ...interface{}) bool {
+	var target string
+	var ok bool
+	switch topic {
+	case Host:
+		host, _ := args[0].(string)
+		target, ok = host, s.Scope.HostInScope(host)
+	case TCPPort:
+		port, _ := args[0].(int)
+		host, _ := args[1].(string)
+		target, ok = net.JoinHostPort(host, strconv.Itoa(port)), s.Scope.PortInScope(host, port)
+	case URL:
+		url, _ := args[0].(string)
+		target, ok = url, s.Scope.URLInScope(url)
+	default:
+		return true
+	}
+
+	if !ok {
+		s.OutOfScope(target)
+	}
+	return ok
+}
+
+func (s *Session) OutOfScope(target string) {
+	s.Stats.IncrementOutOfScope()
+	s.Out.Warn("%s: out of scope, skipping\n", target)
 }
 
 func (s *Session) initWaitGroup() {
-	s.WaitGroup = sizedwaitgroup.New(*s.Options.Threads)
+	s.WaitGroup = NewWaitGroup(*s.Options.Threads, s.Pipeline)
 }
 
-func (s *Session) initDirectories() {
+func (s *Session) initDirectories() error {
 	for _, d := range []string{"headers", "html", "screenshots"} {
 		d = s.GetFilePath(d)
 		if _, err := os.Stat(d); os.IsNotExist(err) {
 			err = os.MkdirAll(d, 0755)
 			if err != nil {
-				s.Out.Fatal("Failed to create required directory %s\n", d)
-				os.Exit(1)
+				return fmt.Errorf("Failed to create required directory %s", d)
 			}
 		}
 	}
+	return nil
 }
 
 func (s *Session) BaseFilenameFromURL(stru string) string {
@@ -240,18 +527,87 @@ func (s *Session) ReadFile(p string) ([]byte, error) {
 	return content, nil
 }
 
+// ReadPageBody returns the DOM of a page as rendered by the browser if it has
+// been saved, or else the raw HTML body of the response.
+func (s *Session) ReadPageBody(page *Page) ([]byte, error) {
+	if page.RenderedPath != "" {
+		if body, err := s.ReadFile(page.RenderedPath); err == nil {
+			return body, nil
+		}
+	}
+	if page.BodyPath != "" {
+		return s.ReadFile(page.BodyPath)
+	}
+	return s.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+}
+
+// ToJSON encodes the session. It is also used for checkpoints while agents
+// are still running, so the pages are collected under the session lock and
+// each page is then encoded under its own lock.
 func (s *Session) ToJSON() string {
-	sessionJSON, _ := json.Marshal(s)
+	s.RLock()
+	snapshot := struct {
+		Version                string                  `json:"version"`
+		Stats                  *Stats                  `json:"stats"`
+		Pages                  map[string]*Page        `json:"pages"`
+		PageSimilarityClusters map[string][]string     `json:"pageSimilarityClusters"`
+		PageClusters           map[string]*PageCluster `json:"pageClusters"`
+	}{
+		Version:                s.Version,
+		Stats:                  s.Stats.snapshot(),
+		Pages:                  make(map[string]*Page, len(s.Pages)),
+		PageSimilarityClusters: s.PageSimilarityClusters,
+		PageClusters:           s.PageClusters,
+	}
+	for url, page := range s.Pages {
+		snapshot.Pages[url] = page
+	}
+	s.RUnlock()
+
+	sessionJSON, _ := json.Marshal(snapshot)
 	return string(sessionJSON)
 }
 
 func (s *Session) SaveToFile(filename string) error {
 	path := s.GetFilePath(filename)
-	err := ioutil.WriteFile(path, []byte(s.ToJSON()), 0644)
+	tmpPath := path + ".tmp"
+	err := ioutil.WriteFile(tmpPath, []byte(s.ToJSON()), 0644)
 	if err != nil {
 		return err
 	}
 
+	return os.Rename(tmpPath, path)
+}
+
+func (s *Session) LoadFromFile(filename string) error {
+	content, err := s.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	var saved struct {
+		Stats *Stats           `json:"stats"`
+		Pages map[string]*Page `json:"pages"`
+	}
+	if err := json.Unmarshal(content, &saved); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if saved.Stats != nil {
+		saved.Stats.FinishedAt = time.Time{}
+		s.Stats = saved.Stats
+	}
+	for url, page := range saved.Pages {
+		// Pages saved before all agents were done with them are requested
+		// again.
+		if !page.Processed {
+			continue
+		}
+		s.Pages[url] = page
+	}
+
 	return nil
 }
 
@@ -260,14 +616,24 @@ func (s *Session) Asset(name string) ([]byte, error) {
 }
 
 func NewSession() (*Session, error) {
-	var err error
+	options, err := ParseOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	envOutPath := os.Getenv("AQUATONE_OUT_PATH")
+	if *options.OutDir == "." && envOutPath != "" {
+		options.OutDir = &envOutPath
+	}
+
+	return NewSessionWithOptions(options)
+}
+
+func NewSessionWithOptions(options Options) (*Session, error) {
 	var session Session
 
 	session.Version = Version
-
-	if session.Options, err = ParseOptions(); err != nil {
-		return nil, err
-	}
+	session.Options = options
 
 	if *session.Options.ChromePath != "" {
 		if _, err := os.Stat(*session.Options.ChromePath); os.IsNotExist(err) {
@@ -287,16 +653,18 @@ func NewSession() (*Session, error) {
 		}
 	}
 
-	envOutPath := os.Getenv("AQUATONE_OUT_PATH")
-	if *session.Options.OutDir == "." && envOutPath != "" {
-		session.Options.OutDir = &envOutPath
-	}
-
 	outdir := filepath.Clean(*session.Options.OutDir)
 	session.Options.OutDir = &outdir
 
-	session.Version = Version
-	session.Start()
+	if err := session.Start(); err != nil {
+		return nil, err
+	}
+
+	if *session.Options.Resume {
+		if err := session.LoadFromFile(SessionFilename); err != nil {
+			return nil, fmt.Errorf("Unable to resume session from %s: %s", session.GetFilePath(SessionFilename), err)
+		}
+	}
 
 	return &session, nil
 }
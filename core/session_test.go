@@ -0,0 +1,136 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func newTestSession(t *testing.T) *Session {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "aquatone-session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Session{
+		Options: Options{OutDir: &dir},
+		Stats:   &Stats{},
+		Pages:   make(map[string]*Page),
+	}
+}
+
+func TestSessionToJSONWhileUpdatingPages(t *testing.T) {
+	s := newTestSession(t)
+	defer os.RemoveAll(*s.Options.OutDir)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				page, err := s.AddPage(fmt.Sprintf("http://example.com/%d/%d", i, j))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				page.AddHeader("Server", "nginx")
+				page.AddTag("Nginx", "info", "")
+				s.GetPage(page.URL)
+				s.Stats.IncrementRequestSuccessful()
+			}
+		}(i)
+	}
+	for i := 0; i < 20; i++ {
+		var saved Session
+		if err := json.Unmarshal([]byte(s.ToJSON()), &saved); err != nil {
+			t.Fatalf("ToJSON returned invalid JSON: %s", err)
+		}
+	}
+	wg.Wait()
+
+	var saved Session
+	if err := json.Unmarshal([]byte(s.ToJSON()), &saved); err != nil {
+		t.Fatal(err)
+	}
+	if len(saved.Pages) != 400 {
+		t.Errorf("expected 400 pages, got %d", len(saved.Pages))
+	}
+	if saved.Stats.RequestSuccessful != 400 {
+		t.Errorf("expected 400 successful requests, got %d", saved.Stats.RequestSuccessful)
+	}
+}
+
+func TestSessionLoadFromFileSkipsUnprocessedPages(t *testing.T) {
+	s := newTestSession(t)
+	defer os.RemoveAll(*s.Options.OutDir)
+	done, _ := s.AddPage("http://example.com/done")
+	done.Processed = true
+	pending, _ := s.AddPage("http://example.com/pending")
+	pending.AddScreenshot("desktop", "screenshots/pending.png", false)
+	if err := s.SaveToFile(SessionFilename); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(*s.Options.OutDir, SessionFilename)); err != nil {
+		t.Fatal(err)
+	}
+
+	resumed := &Session{
+		Options: s.Options,
+		Stats:   &Stats{},
+		Pages:   make(map[string]*Page),
+	}
+	if err := resumed.LoadFromFile(SessionFilename); err != nil {
+		t.Fatal(err)
+	}
+	if resumed.GetPage("http://example.com/done") == nil {
+		t.Error("expected processed page without screenshot to be resumed")
+	}
+	if resumed.GetPage("http://example.com/pending") != nil {
+		t.Error("expected unprocessed page to be processed again")
+	}
+}
+
+func TestSessionMarksPagesProcessed(t *testing.T) {
+	s := newTestSession(t)
+	defer os.RemoveAll(*s.Options.OutDir)
+	s.initContext()
+	s.Out = &Logger{}
+	s.initPipeline()
+	wg := NewWaitGroup(2, s.Pipeline)
+
+	first, _ := s.AddPage("http://example.com/first")
+	wg.AddPage(first.URL)
+	if first.Processed {
+		t.Fatal("expected page with outstanding work not to be processed")
+	}
+	wg.DonePage(first.URL)
+	if !first.Processed {
+		t.Error("expected page to be processed when its work is done")
+	}
+
+	second, _ := s.AddPage("http://example.com/second")
+	wg.AddPage(second.URL)
+	s.Stop()
+	wg.DonePage(second.URL)
+	if second.Processed {
+		t.Error("expected page finished after the session was stopped not to be processed")
+	}
+}
+
+func TestSessionAbort(t *testing.T) {
+	s := newTestSession(t)
+	defer os.RemoveAll(*s.Options.OutDir)
+
+	var calls []string
+	s.OnAbort(func() { calls = append(calls, "browser") })
+	s.OnAbort(func() { calls = append(calls, "files") })
+	s.Abort()
+	if len(calls) != 2 || calls[0] != "browser" || calls[1] != "files" {
+		t.Errorf("expected abort functions to run in order, got %v", calls)
+	}
+}
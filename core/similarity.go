@@ -3,6 +3,8 @@ package core
 import (
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/pmezard/go-difflib/difflib"
 	"golang.org/x/net/html"
@@ -30,7 +32,61 @@ func GetPageStructure(body io.Reader) ([]string, error) {
 	}
 }
 
+// GetPageClasses returns the CSS class names used in a page, sorted and
+// without duplicates.
+func GetPageClasses(body io.Reader) []string {
+	seen := make(map[string]bool)
+	var classes []string
+	z := html.NewTokenizer(body)
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			sort.Strings(classes)
+			return classes
+		case html.StartTagToken, html.SelfClosingTagToken:
+			for _, attr := range z.Token().Attr {
+				if attr.Key != "class" {
+					continue
+				}
+				for _, class := range strings.Fields(attr.Val) {
+					if !seen[class] {
+						seen[class] = true
+						classes = append(classes, class)
+					}
+				}
+			}
+		}
+	}
+}
+
 func GetSimilarity(a, b []string) float64 {
 	matcher := difflib.NewMatcher(a, b)
 	return matcher.Ratio()
 }
+
+const (
+	ClusterModeStructure = "structure"
+	ClusterModeVisual    = "visual"
+	ClusterModeCombined  = "combined"
+)
+
+var ClusterModes = []string{ClusterModeStructure, ClusterModeVisual, ClusterModeCombined}
+
+// GetPageSimilarity compares two pages by their HTML structure, by how their
+// screenshots look or by the average of both, depending on mode. Pages
+// without a screenshot hash are compared by structure only.
+func GetPageSimilarity(a, b *Page, mode string) float64 {
+	if mode == ClusterModeStructure {
+		return GetSimilarity(a.PageStructure, b.PageStructure)
+	}
+
+	visual, ok := GetImageHashSimilarity(a.ScreenshotHash, b.ScreenshotHash)
+	if !ok {
+		return GetSimilarity(a.PageStructure, b.PageStructure)
+	}
+	if mode == ClusterModeVisual {
+		return visual
+	}
+	return (GetSimilarity(a.PageStructure, b.PageStructure) + visual) / 2
+}
@@ -0,0 +1,147 @@
+package core
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var tlsVersionNames = map[uint16]string{
+	tls.VersionSSL30: "SSL 3.0",
+	tls.VersionTLS10: "TLS 1.0",
+	tls.VersionTLS11: "TLS 1.1",
+	tls.VersionTLS12: "TLS 1.2",
+	0x0304:           "TLS 1.3",
+}
+
+var tlsCipherSuiteNames = map[uint16]string{
+	tls.TLS_RSA_WITH_RC4_128_SHA:                "TLS_RSA_WITH_RC4_128_SHA",
+	tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA:           "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+	tls.TLS_RSA_WITH_AES_128_CBC_SHA:            "TLS_RSA_WITH_AES_128_CBC_SHA",
+	tls.TLS_RSA_WITH_AES_256_CBC_SHA:            "TLS_RSA_WITH_AES_256_CBC_SHA",
+	tls.TLS_RSA_WITH_AES_128_CBC_SHA256:         "TLS_RSA_WITH_AES_128_CBC_SHA256",
+	tls.TLS_RSA_WITH_AES_128_GCM_SHA256:         "TLS_RSA_WITH_AES_128_GCM_SHA256",
+	tls.TLS_RSA_WITH_AES_256_GCM_SHA384:         "TLS_RSA_WITH_AES_256_GCM_SHA384",
+	tls.TLS_ECDHE_ECDSA_WITH_RC4_128_SHA:        "TLS_ECDHE_ECDSA_WITH_RC4_128_SHA",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA:    "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA:    "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+	tls.TLS_ECDHE_RSA_WITH_RC4_128_SHA:          "TLS_ECDHE_RSA_WITH_RC4_128_SHA",
+	tls.TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA:     "TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA",
+	tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA:      "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+	tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA:      "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256: "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+	tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256:   "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+	tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256:   "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256: "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384:   "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384: "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305:    "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305",
+	tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305:  "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305",
+	0x1301: "TLS_AES_128_GCM_SHA256",
+	0x1302: "TLS_AES_256_GCM_SHA384",
+	0x1303: "TLS_CHACHA20_POLY1305_SHA256",
+}
+
+type Certificate struct {
+	Subject            string    `json:"subject"`
+	CommonName         string    `json:"commonName"`
+	SANs               []string  `json:"sans"`
+	Issuer             string    `json:"issuer"`
+	NotBefore          time.Time `json:"notBefore"`
+	NotAfter           time.Time `json:"notAfter"`
+	SerialNumber       string    `json:"serialNumber"`
+	KeyType            string    `json:"keyType"`
+	KeySize            int       `json:"keySize"`
+	SignatureAlgorithm string    `json:"signatureAlgorithm"`
+	Fingerprint        string    `json:"fingerprint"`
+}
+
+type TLSInfo struct {
+	Version      string        `json:"version"`
+	CipherSuite  string        `json:"cipherSuite"`
+	Certificates []Certificate `json:"certificates"`
+	Expired      bool          `json:"expired"`
+	SelfSigned   bool          `json:"selfSigned"`
+	NameMismatch bool          `json:"nameMismatch"`
+}
+
+// NewTLSInfo collects details about a TLS connection and its peer
+// certificate chain, leaf certificate first. Hostname is used to check if the
+// leaf certificate is valid for the requested host.
+func NewTLSInfo(state *tls.ConnectionState, hostname string) *TLSInfo {
+	info := &TLSInfo{
+		Version:     tlsVersionName(state.Version),
+		CipherSuite: tlsCipherSuiteName(state.CipherSuite),
+	}
+
+	for _, cert := range state.PeerCertificates {
+		info.Certificates = append(info.Certificates, newCertificate(cert))
+	}
+
+	if len(state.PeerCertificates) > 0 {
+		leaf := state.PeerCertificates[0]
+		now := time.Now()
+		info.Expired = now.After(leaf.NotAfter) || now.Before(leaf.NotBefore)
+		// CheckSignatureFrom would reject self-signed certificates that are
+		// not marked as a CA, so the signature is checked directly.
+		info.SelfSigned = bytes.Equal(leaf.RawIssuer, leaf.RawSubject) && leaf.CheckSignature(leaf.SignatureAlgorithm, leaf.RawTBSCertificate, leaf.Signature) == nil
+		info.NameMismatch = hostname != "" && leaf.VerifyHostname(hostname) != nil
+	}
+
+	return info
+}
+
+func (t *TLSInfo) Leaf() *Certificate {
+	if len(t.Certificates) == 0 {
+		return nil
+	}
+	return &t.Certificates[0]
+}
+
+func newCertificate(cert *x509.Certificate) Certificate {
+	c := Certificate{
+		Subject:            cert.Subject.String(),
+		CommonName:         cert.Subject.CommonName,
+		Issuer:             cert.Issuer.String(),
+		NotBefore:          cert.NotBefore,
+		NotAfter:           cert.NotAfter,
+		SerialNumber:       strings.ToUpper(cert.SerialNumber.Text(16)),
+		KeyType:            cert.PublicKeyAlgorithm.String(),
+		SignatureAlgorithm: cert.SignatureAlgorithm.String(),
+		Fingerprint:        fmt.Sprintf("%x", sha256.Sum256(cert.Raw)),
+	}
+
+	c.SANs = append(c.SANs, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		c.SANs = append(c.SANs, ip.String())
+	}
+
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		c.KeySize = key.N.BitLen()
+	case *ecdsa.PublicKey:
+		c.KeySize = key.Curve.Params().BitSize
+	}
+
+	return c
+}
+
+func tlsVersionName(version uint16) string {
+	if name, ok := tlsVersionNames[version]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%04X", version)
+}
+
+func tlsCipherSuiteName(suite uint16) string {
+	if name, ok := tlsCipherSuiteNames[suite]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%04X", suite)
+}
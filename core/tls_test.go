@@ -0,0 +1,154 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, template *x509.Certificate, parent *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{cert: cert, key: key}
+}
+
+func certTemplate(serial int64, cn string, notBefore, notAfter time.Time) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: cn, Organization: []string{"Aquatone Test"}},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		DNSNames:              []string{cn},
+		BasicConstraintsValid: true,
+	}
+}
+
+func TestNewTLSInfo(t *testing.T) {
+	now := time.Now()
+	valid := certTemplate(0xABCDEF, "example.com", now.Add(-time.Hour), now.Add(time.Hour))
+	valid.DNSNames = append(valid.DNSNames, "*.example.com")
+	valid.IPAddresses = []net.IP{net.ParseIP("10.0.0.1")}
+	selfSigned := newTestCert(t, valid, nil)
+	expired := newTestCert(t, certTemplate(2, "example.com", now.Add(-2*time.Hour), now.Add(-time.Hour)), nil)
+	notYetValid := newTestCert(t, certTemplate(3, "example.com", now.Add(time.Hour), now.Add(2*time.Hour)), nil)
+
+	caTemplate := certTemplate(4, "Aquatone Test CA", now.Add(-time.Hour), now.Add(time.Hour))
+	caTemplate.IsCA = true
+	caTemplate.KeyUsage = x509.KeyUsageCertSign
+	ca := newTestCert(t, caTemplate, nil)
+	leaf := newTestCert(t, certTemplate(5, "www.example.com", now.Add(-time.Hour), now.Add(time.Hour)), ca)
+
+	tests := []struct {
+		name         string
+		chain        []*x509.Certificate
+		hostname     string
+		expired      bool
+		selfSigned   bool
+		nameMismatch bool
+	}{
+		{name: "self-signed", chain: []*x509.Certificate{selfSigned.cert}, hostname: "example.com", selfSigned: true},
+		{name: "wildcard name", chain: []*x509.Certificate{selfSigned.cert}, hostname: "www.example.com", selfSigned: true},
+		{name: "ip address", chain: []*x509.Certificate{selfSigned.cert}, hostname: "10.0.0.1", selfSigned: true},
+		{name: "name mismatch", chain: []*x509.Certificate{selfSigned.cert}, hostname: "example.org", selfSigned: true, nameMismatch: true},
+		{name: "no hostname", chain: []*x509.Certificate{selfSigned.cert}, selfSigned: true},
+		{name: "expired", chain: []*x509.Certificate{expired.cert}, hostname: "example.com", expired: true, selfSigned: true},
+		{name: "not yet valid", chain: []*x509.Certificate{notYetValid.cert}, hostname: "example.com", expired: true, selfSigned: true},
+		{name: "ca signed", chain: []*x509.Certificate{leaf.cert, ca.cert}, hostname: "www.example.com"},
+		{name: "no certificates", chain: nil, hostname: "example.com"},
+	}
+
+	for _, test := range tests {
+		info := NewTLSInfo(&tls.ConnectionState{
+			Version:          tls.VersionTLS12,
+			CipherSuite:      tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+			PeerCertificates: test.chain,
+		}, test.hostname)
+
+		if info.Version != "TLS 1.2" || info.CipherSuite != "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256" {
+			t.Errorf("%s: unexpected version %s and cipher suite %s", test.name, info.Version, info.CipherSuite)
+		}
+		if len(info.Certificates) != len(test.chain) {
+			t.Errorf("%s: expected %d certificates, got %d", test.name, len(test.chain), len(info.Certificates))
+		}
+		if info.Expired != test.expired {
+			t.Errorf("%s: Expired = %v, want %v", test.name, info.Expired, test.expired)
+		}
+		if info.SelfSigned != test.selfSigned {
+			t.Errorf("%s: SelfSigned = %v, want %v", test.name, info.SelfSigned, test.selfSigned)
+		}
+		if info.NameMismatch != test.nameMismatch {
+			t.Errorf("%s: NameMismatch = %v, want %v", test.name, info.NameMismatch, test.nameMismatch)
+		}
+	}
+}
+
+func TestNewTLSInfoCertificate(t *testing.T) {
+	now := time.Now()
+	template := certTemplate(0xABCDEF, "example.com", now.Add(-time.Hour), now.Add(time.Hour))
+	template.IPAddresses = []net.IP{net.ParseIP("10.0.0.1")}
+	cert := newTestCert(t, template, nil)
+
+	info := NewTLSInfo(&tls.ConnectionState{
+		Version:          0x0305,
+		CipherSuite:      0x1301,
+		PeerCertificates: []*x509.Certificate{cert.cert},
+	}, "example.com")
+
+	if info.Version != "0x0305" {
+		t.Errorf("expected unknown version to be shown in hex, got %s", info.Version)
+	}
+	if info.CipherSuite != "TLS_AES_128_GCM_SHA256" {
+		t.Errorf("unexpected cipher suite %s", info.CipherSuite)
+	}
+
+	leaf := info.Leaf()
+	if leaf == nil {
+		t.Fatal("expected leaf certificate")
+	}
+	if leaf.CommonName != "example.com" || leaf.Subject != "CN=example.com,O=Aquatone Test" {
+		t.Errorf("unexpected subject %s", leaf.Subject)
+	}
+	if leaf.SerialNumber != "ABCDEF" {
+		t.Errorf("unexpected serial number %s", leaf.SerialNumber)
+	}
+	if len(leaf.SANs) != 2 || leaf.SANs[0] != "example.com" || leaf.SANs[1] != "10.0.0.1" {
+		t.Errorf("unexpected SANs %v", leaf.SANs)
+	}
+	if leaf.KeyType != "ECDSA" || leaf.KeySize != 256 {
+		t.Errorf("unexpected key %s %d", leaf.KeyType, leaf.KeySize)
+	}
+	if len(leaf.Fingerprint) != 64 {
+		t.Errorf("unexpected fingerprint %s", leaf.Fingerprint)
+	}
+
+	if (&TLSInfo{}).Leaf() != nil {
+		t.Error("expected no leaf certificate without certificates")
+	}
+}
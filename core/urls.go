@@ -2,6 +2,8 @@ package core
 
 import (
 	"fmt"
+	"net/url"
+	"strings"
 )
 
 var (
@@ -11,6 +13,9 @@ var (
 
 func HostAndPortToURL(host string, port int, protocol string) string {
 	var url string
+	if strings.Contains(host, ":") && !strings.HasPrefix(host, "[") {
+		host = fmt.Sprintf("[%s]", host)
+	}
 	if protocol != "" {
 		url = fmt.Sprintf("%s://%s", protocol, host)
 	} else if isSecurePort(port) {
@@ -41,3 +46,27 @@ func isStandardPort(port int) bool {
 	}
 	return false
 }
+
+// SameOrigin reports whether two URLs have the same scheme, host and port.
+// A missing port is treated as the default port of the scheme.
+func SameOrigin(a *url.URL, b *url.URL) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	return strings.EqualFold(a.Scheme, b.Scheme) &&
+		strings.EqualFold(a.Hostname(), b.Hostname()) &&
+		originPort(a) == originPort(b)
+}
+
+func originPort(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http":
+		return "80"
+	case "https":
+		return "443"
+	}
+	return ""
+}
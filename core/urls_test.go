@@ -0,0 +1,28 @@
+package core
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestSameOrigin(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"http://example.com/", "http://example.com/favicon.ico", true},
+		{"http://example.com/", "http://EXAMPLE.com:80/a", true},
+		{"https://example.com/", "https://example.com:443/", true},
+		{"http://example.com/", "https://example.com/", false},
+		{"http://example.com/", "http://example.com:8080/", false},
+		{"http://example.com/", "http://cdn.example.com/", false},
+		{"http://[::1]:8080/", "http://[::1]:8080/x", true},
+	}
+	for _, tt := range tests {
+		a, _ := url.Parse(tt.a)
+		b, _ := url.Parse(tt.b)
+		if got := SameOrigin(a, b); got != tt.want {
+			t.Errorf("SameOrigin(%s, %s) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type Viewport struct {
+	Name              string
+	Width             int
+	Height            int
+	DeviceScaleFactor float64
+	Mobile            bool
+	UserAgent         string
+}
+
+// ViewportProfiles are the named viewports that can be given to -viewports.
+// The desktop profile has no fixed size as it uses the -resolution option.
+var ViewportProfiles = map[string]Viewport{
+	"desktop": {
+		Name:              "desktop",
+		DeviceScaleFactor: 1,
+	},
+	"tablet": {
+		Name:              "tablet",
+		Width:             768,
+		Height:            1024,
+		DeviceScaleFactor: 2,
+		Mobile:            true,
+		UserAgent:         "Mozilla/5.0 (iPad; CPU OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.6 Mobile/15E148 Safari/604.1",
+	},
+	"mobile": {
+		Name:              "mobile",
+		Width:             390,
+		Height:            844,
+		DeviceScaleFactor: 3,
+		Mobile:            true,
+		UserAgent:         "Mozilla/5.0 (iPhone; CPU iPhone OS 16_6 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/16.6 Mobile/15E148 Safari/604.1",
+	},
+}
+
+var viewportSizeRegex = regexp.MustCompile(`^(\d+)x(\d+)$`)
+
+// ParseViewports parses a comma-separated list of viewport profile names and
+// custom sizes in the form WIDTHxHEIGHT. Resolution is the desktop size in the
+// form width,height.
+func ParseViewports(list string, resolution string) ([]Viewport, error) {
+	var viewports []Viewport
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(list, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		if match := viewportSizeRegex.FindStringSubmatch(name); match != nil {
+			width, _ := strconv.Atoi(match[1])
+			height, _ := strconv.Atoi(match[2])
+			if width == 0 || height == 0 {
+				return nil, fmt.Errorf("Invalid viewport size %s", name)
+			}
+			viewports = append(viewports, Viewport{
+				Name:              name,
+				Width:             width,
+				Height:            height,
+				DeviceScaleFactor: 1,
+			})
+			continue
+		}
+
+		viewport, ok := ViewportProfiles[name]
+		if !ok {
+			return nil, fmt.Errorf("Unknown viewport %s. Supported viewports: desktop, tablet, mobile or WIDTHxHEIGHT", name)
+		}
+		if name == "desktop" {
+			width, height, err := parseResolution(resolution)
+			if err != nil {
+				return nil, err
+			}
+			viewport.Width = width
+			viewport.Height = height
+		}
+		viewports = append(viewports, viewport)
+	}
+
+	if len(viewports) == 0 {
+		return nil, fmt.Errorf("No viewports given")
+	}
+	return viewports, nil
+}
+
+func parseResolution(resolution string) (int, int, error) {
+	parts := strings.Split(resolution, ",")
+	if len(parts) == 2 {
+		width, err1 := strconv.Atoi(strings.TrimSpace(parts[0]))
+		height, err2 := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err1 == nil && err2 == nil && width > 0 && height > 0 {
+			return width, height, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("Invalid screenshot resolution %s, expected width,height", resolution)
+}
@@ -2,18 +2,28 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"net/url"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
-	"github.com/google/uuid"
-	"github.com/michenriksen/aquatone/agents"
 	"github.com/michenriksen/aquatone/core"
 	"github.com/michenriksen/aquatone/parsers"
+	"github.com/michenriksen/aquatone/scanner"
+)
+
+const (
+	exportReportFilename = "aquatone_report_export.html"
+	// exportReportWarnSize is the size of single-file reports above which
+	// a warning is printed.
+	exportReportWarnSize = 50 << 20
 )
 
 var (
@@ -21,26 +31,137 @@ var (
 	err  error
 )
 
-func isURL(s string) bool {
-	u, err := url.ParseRequestURI(s)
-	if err != nil {
-		return false
+func handleSignals(cancel context.CancelFunc) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		sess.Out.Warn("\nReceived %s signal. Stopping scan and writing report on collected pages...\n", sig)
+		sess.Out.Warn("Send the signal again to abort immediately.\n\n")
+		cancel()
+		<-signals
+		sess.Out.Error("Aborting.\n")
+		sess.Abort()
+		os.Exit(1)
+	}()
+}
+
+func newInputParser(format string) (parsers.Parser, error) {
+	switch format {
+	case parsers.FormatText:
+		parser := parsers.NewRegexParser()
+		parser.MaxRangeHosts = *sess.Options.MaxRangeHosts
+		parser.Out = sess.Out
+		return parser, nil
+	case parsers.FormatNmapXML:
+		return parsers.NewNmapParser(), nil
+	case parsers.FormatNmapGrepable:
+		return parsers.NewNmapGrepableParser(), nil
+	case parsers.FormatMasscanJSON:
+		return parsers.NewMasscanParser(), nil
+	case parsers.FormatBurpXML:
+		return parsers.NewBurpParser(), nil
+	case parsers.FormatHAR:
+		return parsers.NewHARParser(), nil
+	}
+	return nil, fmt.Errorf("Unknown input format %s. Supported formats: %s", format, strings.Join(parsers.Formats, ", "))
+}
+
+// calculatePageFeatures reads the body and screenshot of a page and
+// calculates the features used to cluster it. It returns false if the body
+// could not be read.
+func calculatePageFeatures(s *core.Session, page *core.Page) bool {
+	if page.HasScreenshot && page.ScreenshotHash == "" {
+		page.ScreenshotHash, _ = core.ImageHashFile(s.GetFilePath(page.ScreenshotPath))
 	}
-	if u.Scheme == "" {
+	body, err := s.ReadPageBody(page)
+	if err != nil {
 		return false
 	}
+	structure, _ := core.GetPageStructure(bytes.NewReader(body))
+	page.PageStructure = structure
+	page.PageClasses = core.GetPageClasses(bytes.NewReader(body))
 	return true
 }
 
-func hasSupportedScheme(s string) bool {
-	u, err := url.ParseRequestURI(s)
+func clusterPages(s *core.Session) {
+	pages := make([]*core.Page, 0, len(s.Pages))
+	for _, page := range s.Pages {
+		pages = append(pages, page)
+	}
+	s.SetPageClusters(core.ClusterPages(pages, core.ClusterOptions{
+		Mode:      *s.Options.ClusterMode,
+		Algorithm: *s.Options.ClusterAlgorithm,
+		Threshold: *s.Options.ClusterThreshold,
+		Weights:   s.ClusterWeights,
+	}))
+}
+
+// recluster recalculates the page structures and clusters of a loaded
+// session with the current options and writes it back to the session file.
+func recluster(loaded *core.Session) {
+	sess.Out.Important("Calculating page structures...")
+	missing := 0
+	for _, page := range loaded.Pages {
+		if !calculatePageFeatures(loaded, page) {
+			missing++
+		}
+	}
+	sess.Out.Important(" done\n")
+	if missing > 0 {
+		sess.Out.Warn("Unable to read the body of %d pages, their structure is treated as empty\n", missing)
+	}
+
+	sess.Out.Important("Clustering similar pages...")
+	clusterPages(loaded)
+	sess.Out.Important(" done\n")
+
+	sess.Out.Important("Writing session file...")
+	if err := loaded.SaveToFile(filepath.Base(*sess.Options.SessionPath)); err != nil {
+		sess.Out.Fatal("Unable to write session file to %s: %s\n", *sess.Options.SessionPath, err)
+		os.Exit(1)
+	}
+	sess.Out.Important(" done\n")
+}
+
+func newReport(s *core.Session, template string) *core.Report {
+	report := core.NewReport(s, template)
+	report.InlineAssets = !*sess.Options.CDNAssets
+	return report
+}
+
+// exportReport writes the report as a single HTML file with embedded
+// screenshots and headers that can be shared without the output directory.
+// It returns false if the report could not be exported.
+func exportReport(report *core.Report) bool {
+	filename := sess.GetFilePath(exportReportFilename)
+	sess.Out.Important("Exporting single-file HTML report...")
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.Error("Unable to write single-file HTML report to %s: %s\n", filename, err)
 		return false
 	}
-	if u.Scheme == "http" || u.Scheme == "https" {
-		return true
+	defer f.Close()
+
+	if err := report.RenderSingleFile(f, core.ExportOptions{Thumbnails: *sess.Options.ExportThumbnails}); err != nil {
+		sess.Out.Error("Failed!\n")
+		sess.Out.Error("Unable to export single-file HTML report: %s\n", err)
+		f.Close()
+		os.Remove(filename)
+		return false
 	}
-	return false
+	sess.Out.Important(" done\n\n")
+	sess.Out.Important("Wrote single-file HTML report to: %s\n\n", filename)
+
+	if fi, err := f.Stat(); err == nil && fi.Size() > exportReportWarnSize {
+		hint := ""
+		if !*sess.Options.ExportThumbnails {
+			hint = " Use the -export-thumbnails flag to embed smaller screenshots."
+		}
+		sess.Out.Warn("The single-file HTML report is %d MB and may be slow to open and hard to share.%s\n\n", fi.Size()>>20, hint)
+	}
+	return true
 }
 
 func main() {
@@ -68,6 +189,17 @@ func main() {
 
 	sess.Out.Important("%s v%s started at %s\n\n", core.Name, core.Version, sess.Stats.StartedAt.Format(time.RFC3339))
 
+	if !*sess.Options.CDNAssets {
+		if missing := core.MissingReportAssets(); len(missing) > 0 {
+			sess.Out.Warn("The HTML report will link %s from CDNs as they are not embedded in this build\n\n", strings.Join(missing, ", "))
+		}
+	}
+
+	if *sess.Options.Recluster && *sess.Options.SessionPath == "" {
+		sess.Out.Fatal("The -recluster flag requires a session file given with -session\n")
+		os.Exit(1)
+	}
+
 	if *sess.Options.SessionPath != "" {
 		jsonSession, err := ioutil.ReadFile(*sess.Options.SessionPath)
 		if err != nil {
@@ -82,6 +214,15 @@ func main() {
 		}
 
 		sess.Out.Important("Loaded Aquatone session at %s\n", *sess.Options.SessionPath)
+		// Files referenced by the session are read relative to the directory
+		// of the session file.
+		sessionDir := filepath.Dir(*sess.Options.SessionPath)
+		parsedSession.Options = sess.Options
+		parsedSession.Options.OutDir = &sessionDir
+		parsedSession.ClusterWeights = sess.ClusterWeights
+		if *sess.Options.Recluster {
+			recluster(&parsedSession)
+		}
 		sess.Out.Important("Generating HTML report...")
 		var template []byte
 		if *sess.Options.TemplatePath != "" {
@@ -95,8 +236,8 @@ func main() {
 			os.Exit(1)
 		}
 
-		report := core.NewReport(&parsedSession, string(template))
-		f, err := os.OpenFile(sess.GetFilePath("aquatone_report.html"), os.O_RDWR|os.O_CREATE, 0644)
+		report := newReport(&parsedSession, string(template))
+		f, err := os.OpenFile(sess.GetFilePath("aquatone_report.html"), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 		if err != nil {
 			sess.Out.Fatal("Error during report generation: %s\n", err)
 			os.Exit(1)
@@ -109,35 +250,43 @@ func main() {
 		}
 		sess.Out.Important(" done\n\n")
 		sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath("aquatone_report.html"))
+		if *sess.Options.ExportReport && !exportReport(report) {
+			os.Exit(1)
+		}
 		os.Exit(0)
 	}
 
-	agents.NewTCPPortScanner().Register(sess)
-	agents.NewURLPublisher().Register(sess)
-	agents.NewURLRequester().Register(sess)
-	agents.NewURLHostnameResolver().Register(sess)
-	agents.NewURLPageTitleExtractor().Register(sess)
-	agents.NewURLScreenshotter().Register(sess)
-	agents.NewURLTechnologyFingerprinter().Register(sess)
-	agents.NewURLTakeoverDetector().Register(sess)
+	if *sess.Options.Resume {
+		sess.Out.Important("Resuming session with %d already processed pages\n\n", len(sess.Pages))
+	}
+
+	scan, err := scanner.NewWithSession(sess, scanner.Config{})
+	if err != nil {
+		sess.Out.Fatal("%s\n", err)
+		os.Exit(1)
+	}
 
 	reader := bufio.NewReader(os.Stdin)
 	var targets []string
 
+	format := *sess.Options.InputFormat
 	if *sess.Options.Nmap {
-		parser := parsers.NewNmapParser()
-		targets, err = parser.Parse(reader)
-		if err != nil {
-			sess.Out.Fatal("Unable to parse input as Nmap/Masscan XML: %s\n", err)
-			os.Exit(1)
-		}
-	} else {
-		parser := parsers.NewRegexParser()
-		targets, err = parser.Parse(reader)
-		if err != nil {
-			sess.Out.Fatal("Unable to parse input.\n")
-			os.Exit(1)
-		}
+		format = parsers.FormatNmapXML
+	}
+	if format == parsers.FormatAuto {
+		format = parsers.DetectFormat(reader)
+		sess.Out.Debug("Detected input format: %s\n", format)
+	}
+
+	parser, err := newInputParser(format)
+	if err != nil {
+		sess.Out.Fatal("%s\n", err)
+		os.Exit(1)
+	}
+	targets, err = parser.Parse(reader)
+	if err != nil {
+		sess.Out.Fatal("Unable to parse input as %s: %s\n", format, err)
+		os.Exit(1)
 	}
 
 	if len(targets) == 0 {
@@ -147,70 +296,37 @@ func main() {
 
 	sess.Out.Important("Targets    : %d\n", len(targets))
 	sess.Out.Important("Threads    : %d\n", *sess.Options.Threads)
-	sess.Out.Important("Ports      : %s\n", strings.Trim(strings.Replace(fmt.Sprint(sess.Ports), " ", ", ", -1), "[]"))
+	if len(sess.Ports) > 20 {
+		sess.Out.Important("Ports      : %d ports\n", len(sess.Ports))
+	} else {
+		sess.Out.Important("Ports      : %s\n", strings.Trim(strings.Replace(fmt.Sprint(sess.Ports), " ", ", ", -1), "[]"))
+	}
+	sess.Out.Important("Agents     : %d of %d\n", len(scan.Agents()), len(core.AgentNames()))
+	if *sess.Options.ScopePath != "" {
+		sess.Out.Important("Scope      : %s\n", *sess.Options.ScopePath)
+	}
 	sess.Out.Important("Output dir : %s\n\n", *sess.Options.OutDir)
 
-	sess.EventBus.Publish(core.SessionStart)
-
-	for _, target := range targets {
-		if isURL(target) {
-			if hasSupportedScheme(target) {
-				sess.EventBus.Publish(core.URL, target)
-			}
-		} else {
-			sess.EventBus.Publish(core.Host, target)
-		}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	handleSignals(cancel)
+	if err := scan.Scan(ctx, targets); err != nil && err != context.Canceled {
+		sess.Out.Fatal("Error during scan: %s\n", err)
+		os.Exit(1)
 	}
 
-	time.Sleep(1 * time.Second)
-	sess.EventBus.WaitAsync()
-	sess.WaitGroup.Wait()
-
-	sess.EventBus.Publish(core.SessionEnd)
-	time.Sleep(1 * time.Second)
-	sess.EventBus.WaitAsync()
-	sess.WaitGroup.Wait()
-
 	sess.Out.Important("Calculating page structures...")
-	f, _ := os.OpenFile(sess.GetFilePath("aquatone_urls.txt"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	f, _ := os.OpenFile(sess.GetFilePath("aquatone_urls.txt"), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
 	for _, page := range sess.Pages {
-		filename := sess.GetFilePath(fmt.Sprintf("html/%s.html", page.BaseFilename()))
-		body, err := os.Open(filename)
-		if err != nil {
-			continue
+		if calculatePageFeatures(sess, page) {
+			f.WriteString(page.URL + "\n")
 		}
-		structure, _ := core.GetPageStructure(body)
-		page.PageStructure = structure
-		f.WriteString(page.URL + "\n")
 	}
 	f.Close()
 	sess.Out.Important(" done\n")
 
 	sess.Out.Important("Clustering similar pages...")
-	for _, page := range sess.Pages {
-		foundCluster := false
-		for clusterUUID, cluster := range sess.PageSimilarityClusters {
-			addToCluster := true
-			for _, pageURL := range cluster {
-				page2 := sess.GetPage(pageURL)
-				if page2 != nil && core.GetSimilarity(page.PageStructure, page2.PageStructure) < 0.80 {
-					addToCluster = false
-					break
-				}
-			}
-
-			if addToCluster {
-				foundCluster = true
-				sess.PageSimilarityClusters[clusterUUID] = append(sess.PageSimilarityClusters[clusterUUID], page.URL)
-				break
-			}
-		}
-
-		if !foundCluster {
-			newClusterUUID := uuid.New().String()
-			sess.PageSimilarityClusters[newClusterUUID] = []string{page.URL}
-		}
-	}
+	clusterPages(sess)
 	sess.Out.Important(" done\n")
 
 	sess.Out.Important("Generating HTML report...")
@@ -225,8 +341,8 @@ func main() {
 		sess.Out.Fatal("Can't read report template file\n")
 		os.Exit(1)
 	}
-	report := core.NewReport(sess, string(template))
-	f, err = os.OpenFile(sess.GetFilePath("aquatone_report.html"), os.O_RDWR|os.O_CREATE, 0644)
+	report := newReport(sess, string(template))
+	f, err = os.OpenFile(sess.GetFilePath("aquatone_report.html"), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		sess.Out.Fatal("Error during report generation: %s\n", err)
 		os.Exit(1)
@@ -238,15 +354,20 @@ func main() {
 	}
 	sess.Out.Important(" done\n\n")
 
-	sess.End()
+	exportFailed := *sess.Options.ExportReport && !exportReport(report)
 
 	sess.Out.Important("Writing session file...")
-	err = sess.SaveToFile("aquatone_session.json")
+	err = sess.SaveToFile(core.SessionFilename)
 	if err != nil {
 		sess.Out.Error("Failed!\n")
 		sess.Out.Debug("Error: %v\n", err)
 	}
 
+	if sess.Stopped() {
+		sess.Out.Warn("Scan was interrupted before all targets were processed.\n")
+		sess.Out.Warn("Run the same command with the -resume flag to continue where it left off.\n\n")
+	}
+
 	sess.Out.Important("Time:\n")
 	sess.Out.Info(" - Started at  : %v\n", sess.Stats.StartedAt.Format(time.RFC3339))
 	sess.Out.Info(" - Finished at : %v\n", sess.Stats.FinishedAt.Format(time.RFC3339))
@@ -265,5 +386,14 @@ func main() {
 	sess.Out.Info(" - Successful : %v\n", sess.Stats.ScreenshotSuccessful)
 	sess.Out.Info(" - Failed     : %v\n\n", sess.Stats.ScreenshotFailed)
 
+	if !sess.Scope.Empty() {
+		sess.Out.Important("Scope:\n")
+		sess.Out.Info(" - Out of scope : %v\n\n", sess.Stats.OutOfScope)
+	}
+
 	sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath("aquatone_report.html"))
+
+	if exportFailed {
+		os.Exit(1)
+	}
 }
@@ -0,0 +1,35 @@
+package parsers
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+type burpItems struct {
+	Items []struct {
+		URL string `xml:"url"`
+	} `xml:"item"`
+}
+
+type BurpParser struct{}
+
+func NewBurpParser() *BurpParser {
+	return &BurpParser{}
+}
+
+func (p *BurpParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	var items burpItems
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	if err := decoder.Decode(&items); err != nil {
+		return targets, err
+	}
+
+	var urls []string
+	for _, item := range items.Items {
+		urls = append(urls, item.URL)
+	}
+
+	return seedURLs(urls), nil
+}
@@ -0,0 +1,43 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBurpParser(t *testing.T) {
+	input := `<?xml version="1.0"?>
+<!DOCTYPE items [
+<!ELEMENT items (item*)>
+]>
+<items burpVersion="2.0.11beta" exportTime="Mon May 06 12:00:00 CEST 2019">
+  <item>
+    <time>Mon May 06 12:00:00 CEST 2019</time>
+    <url><![CDATA[https://Example.com/login?next=/]]></url>
+    <host ip="93.184.216.34">example.com</host>
+    <request base64="true"><![CDATA[R0VUIC8gSFRUUC8xLjE=]]></request>
+  </item>
+  <item>
+    <url><![CDATA[https://example.com/login?next=/admin#top]]></url>
+  </item>
+  <item>
+    <url><![CDATA[http://example.com:8080]]></url>
+  </item>
+  <item>
+    <url><![CDATA[ftp://example.com/file]]></url>
+  </item>
+</items>`
+
+	got, err := NewBurpParser().Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []string{
+		"https://example.com/login?next=/",
+		"http://example.com:8080/",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+}
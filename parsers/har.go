@@ -0,0 +1,37 @@
+package parsers
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type harFile struct {
+	Log struct {
+		Entries []struct {
+			Request struct {
+				URL string `json:"url"`
+			} `json:"request"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+type HARParser struct{}
+
+func NewHARParser() *HARParser {
+	return &HARParser{}
+}
+
+func (p *HARParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	var har harFile
+	if err := json.NewDecoder(r).Decode(&har); err != nil {
+		return targets, err
+	}
+
+	var urls []string
+	for _, entry := range har.Log.Entries {
+		urls = append(urls, entry.Request.URL)
+	}
+
+	return seedURLs(urls), nil
+}
@@ -0,0 +1,42 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHARParser(t *testing.T) {
+	input := `{
+  "log": {
+    "version": "1.2",
+    "creator": {"name": "Firefox", "version": "66.0"},
+    "entries": [
+      {"request": {"method": "GET", "url": "https://example.com/"}},
+      {"request": {"method": "GET", "url": "https://example.com/?utm_source=test"}},
+      {"request": {"method": "GET", "url": "https://cdn.example.com/app.js"}},
+      {"request": {"method": "GET", "url": "data:image/png;base64,AAAA"}},
+      {"request": {"method": "POST", "url": "https://EXAMPLE.com/api/login"}}
+    ]
+  }
+}`
+
+	got, err := NewHARParser().Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []string{
+		"https://example.com/",
+		"https://cdn.example.com/app.js",
+		"https://example.com/api/login",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+}
+
+func TestHARParserInvalid(t *testing.T) {
+	if _, err := NewHARParser().Parse(strings.NewReader(`{"log": `)); err == nil {
+		t.Error("expected error for invalid HAR file")
+	}
+}
@@ -0,0 +1,147 @@
+package parsers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultMaxRangeHosts = 65536
+	// MaxRangeHostsLimit is the hard limit on the number of hosts expanded
+	// from a single range, whatever the -max-range-hosts flag is set to.
+	MaxRangeHostsLimit = 1 << 24
+)
+
+// errNotIPRange is returned by parseIPRange for strings that don't look like
+// an IP range at all, as opposed to malformed ranges.
+var errNotIPRange = errors.New("not an IP range")
+
+// expandIPRange expands CIDR notation (10.0.0.0/24, 2001:db8::/120) and dash
+// ranges (192.168.1.10-50, 192.168.1.10-192.168.2.20, 2001:db8::1-ff) into
+// the individual IP addresses they contain. A limit of 0 or above
+// MaxRangeHostsLimit is treated as MaxRangeHostsLimit.
+func expandIPRange(s string, limit int) ([]string, error) {
+	first, last, err := parseIPRange(s)
+	if err != nil {
+		return nil, err
+	}
+
+	size := new(big.Int).Sub(new(big.Int).SetBytes(last), new(big.Int).SetBytes(first))
+	size.Add(size, big.NewInt(1))
+	if limit <= 0 || limit > MaxRangeHostsLimit {
+		limit = MaxRangeHostsLimit
+	}
+	if size.Cmp(big.NewInt(int64(limit))) > 0 {
+		if limit == MaxRangeHostsLimit {
+			return nil, fmt.Errorf("Range %s contains %s hosts which exceeds the maximum of %d hosts", s, size, limit)
+		}
+		return nil, fmt.Errorf("Range %s contains %s hosts which exceeds the limit of %d hosts. Use the -max-range-hosts flag to increase the limit", s, size, limit)
+	}
+
+	var hosts []string
+	for ip := first; bytes.Compare(ip, last) <= 0; ip = nextIP(ip) {
+		hosts = append(hosts, ip.String())
+		if ip.Equal(last) {
+			break
+		}
+	}
+	return hosts, nil
+}
+
+// parseIPRange returns the first and last address of an IP range. It returns
+// errNotIPRange if s doesn't start with an IP address followed by a CIDR
+// prefix length or a dash, and another error if it does but the range is
+// malformed.
+func parseIPRange(s string) (net.IP, net.IP, error) {
+	if i := strings.Index(s, "/"); i >= 0 {
+		if net.ParseIP(s[:i]) == nil || !isDigits(s[i+1:]) {
+			return nil, nil, errNotIPRange
+		}
+		ip, network, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s is not a valid CIDR range", s)
+		}
+		first := normalizeIP(ip.Mask(network.Mask))
+		last := make(net.IP, len(first))
+		for i := range first {
+			last[i] = first[i] | ^network.Mask[i]
+		}
+		return first, last, nil
+	}
+
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return nil, nil, errNotIPRange
+	}
+	first := normalizeIP(net.ParseIP(parts[0]))
+	if first == nil {
+		return nil, nil, errNotIPRange
+	}
+
+	last := normalizeIP(net.ParseIP(parts[1]))
+	if last == nil {
+		last = make(net.IP, len(first))
+		copy(last, first)
+		if len(first) == net.IPv4len {
+			octet, err := strconv.ParseUint(parts[1], 10, 8)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s is not a valid IP range", s)
+			}
+			last[3] = byte(octet)
+		} else {
+			hextet, err := strconv.ParseUint(parts[1], 16, 16)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s is not a valid IP range", s)
+			}
+			last[14] = byte(hextet >> 8)
+			last[15] = byte(hextet)
+		}
+	}
+
+	if len(first) != len(last) {
+		return nil, nil, fmt.Errorf("%s mixes IPv4 and IPv6 addresses", s)
+	}
+	if bytes.Compare(first, last) > 0 {
+		return nil, nil, fmt.Errorf("%s ends before it starts", s)
+	}
+	return first, last, nil
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeIP(ip net.IP) net.IP {
+	if ip == nil {
+		return nil
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip.To16()
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+	return next
+}
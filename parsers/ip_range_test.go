@@ -0,0 +1,63 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpandIPRange(t *testing.T) {
+	tests := []struct {
+		in    string
+		limit int
+		want  []string
+		err   bool
+	}{
+		{in: "10.0.0.0/30", limit: DefaultMaxRangeHosts, want: []string{"10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"}},
+		{in: "10.0.0.1/32", limit: DefaultMaxRangeHosts, want: []string{"10.0.0.1"}},
+		{in: "10.0.0.255-10.0.1.1", limit: DefaultMaxRangeHosts, want: []string{"10.0.0.255", "10.0.1.0", "10.0.1.1"}},
+		{in: "192.168.1.10-12", limit: DefaultMaxRangeHosts, want: []string{"192.168.1.10", "192.168.1.11", "192.168.1.12"}},
+		{in: "2001:db8::/127", limit: DefaultMaxRangeHosts, want: []string{"2001:db8::", "2001:db8::1"}},
+		{in: "2001:db8::fe-ff", limit: DefaultMaxRangeHosts, want: []string{"2001:db8::fe", "2001:db8::ff"}},
+		{in: "10.0.0.0/30", limit: 0, want: []string{"10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"}},
+		{in: "10.0.0.0/24", limit: 100, err: true},
+		{in: "10.0.0.0/7", limit: 0, err: true},
+		{in: "10.0.0.0/7", limit: 1 << 30, err: true},
+		{in: "2001:db8::/64", limit: 0, err: true},
+		{in: "10.0.0.50-10", limit: DefaultMaxRangeHosts, err: true},
+		{in: "10.0.0.1-300", limit: DefaultMaxRangeHosts, err: true},
+		{in: "10.0.0.1-2001:db8::1", limit: DefaultMaxRangeHosts, err: true},
+		{in: "10.0.0.0/33", limit: DefaultMaxRangeHosts, err: true},
+	}
+
+	for _, test := range tests {
+		got, err := expandIPRange(test.in, test.limit)
+		if test.err {
+			if err == nil {
+				t.Errorf("expandIPRange(%q, %d): expected error, got %d hosts", test.in, test.limit, len(got))
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("expandIPRange(%q, %d): unexpected error: %s", test.in, test.limit, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("expandIPRange(%q, %d) = %v, want %v", test.in, test.limit, got, test.want)
+		}
+	}
+}
+
+func TestParseIPRangeNotRange(t *testing.T) {
+	for _, in := range []string{
+		"example.com",
+		"10.0.0.1",
+		"http://10.0.0.1/admin",
+		"10.0.0.1/admin",
+		"2019-05-01",
+		"foo-bar.example.com",
+	} {
+		if _, _, err := parseIPRange(in); err != errNotIPRange {
+			t.Errorf("parseIPRange(%q): expected errNotIPRange, got %v", in, err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+type masscanRecord struct {
+	IP    string        `json:"ip"`
+	Ports []masscanPort `json:"ports"`
+}
+
+type masscanPort struct {
+	Port    int    `json:"port"`
+	Proto   string `json:"proto"`
+	Status  string `json:"status"`
+	Service struct {
+		Name string `json:"name"`
+	} `json:"service"`
+}
+
+type MasscanParser struct{}
+
+func NewMasscanParser() *MasscanParser {
+	return &MasscanParser{}
+}
+
+func (p *MasscanParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return targets, err
+	}
+
+	records, err := p.decode(data)
+	if err != nil {
+		return targets, err
+	}
+
+	// Masscan reports the open port and any grabbed banners as separate
+	// records, so the service name has to be merged in from the latter.
+	type openPort struct {
+		host    string
+		port    int
+		open    bool
+		service string
+	}
+	var order []string
+	ports := make(map[string]*openPort)
+	for _, record := range records {
+		for _, port := range record.Ports {
+			if port.Proto != "" && port.Proto != "tcp" {
+				continue
+			}
+			key := fmt.Sprintf("%s:%d", record.IP, port.Port)
+			if _, found := ports[key]; !found {
+				ports[key] = &openPort{host: record.IP, port: port.Port}
+				order = append(order, key)
+			}
+			if port.Status == "open" {
+				ports[key].open = true
+			}
+			if port.Service.Name != "" && ports[key].service == "" {
+				ports[key].service = port.Service.Name
+			}
+		}
+	}
+
+	for _, key := range order {
+		port := ports[key]
+		if !port.open {
+			continue
+		}
+		if url, ok := serviceToURL(port.host, port.port, port.service, ""); ok {
+			targets = append(targets, url)
+		}
+	}
+
+	return targets, nil
+}
+
+func (p *MasscanParser) decode(data []byte) ([]masscanRecord, error) {
+	var records []masscanRecord
+	if err := json.Unmarshal(data, &records); err == nil {
+		return records, nil
+	}
+
+	// Older masscan versions write a trailing comma after the last record and
+	// a non-JSON {finished: 1} line, so fall back to decoding line by line.
+	records = nil
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		line = bytes.TrimSuffix(bytes.TrimPrefix(line, []byte(",")), []byte(","))
+		if !bytes.HasPrefix(line, []byte("{")) || bytes.HasPrefix(line, []byte("{finished")) {
+			continue
+		}
+		var record masscanRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
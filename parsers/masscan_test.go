@@ -0,0 +1,64 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMasscanParser(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{
+			name: "json array",
+			input: `[
+{"ip": "10.0.0.1", "timestamp": "1", "ports": [{"port": 80, "proto": "tcp", "status": "open"}]},
+{"ip": "10.0.0.1", "timestamp": "1", "ports": [{"port": 8443, "proto": "tcp", "status": "open"}]},
+{"ip": "10.0.0.2", "timestamp": "1", "ports": [{"port": 22, "proto": "tcp", "status": "open"}]}
+]`,
+			want: []string{"http://10.0.0.1/", "https://10.0.0.1:8443/"},
+		},
+		{
+			name: "trailing comma and finished line",
+			input: `[
+{   "ip": "10.0.0.1",   "timestamp": "1", "ports": [ {"port": 443, "proto": "tcp", "status": "open", "reason": "syn-ack", "ttl": 64} ] },
+{   "ip": "10.0.0.3",   "timestamp": "1", "ports": [ {"port": 80, "proto": "tcp", "status": "open", "reason": "syn-ack", "ttl": 64} ] },
+{finished: 1}
+]`,
+			want: []string{"https://10.0.0.1/", "http://10.0.0.3/"},
+		},
+		{
+			name: "banner records add the service",
+			input: `[
+{"ip": "10.0.0.1", "ports": [{"port": 10000, "proto": "tcp", "status": "open"}]},
+{"ip": "10.0.0.1", "ports": [{"port": 10000, "proto": "tcp", "service": {"name": "http", "banner": "HTTP/1.1 200 OK"}}]}
+]`,
+			want: []string{"http://10.0.0.1:10000/"},
+		},
+		{
+			name:  "closed and udp ports",
+			input: `[{"ip": "10.0.0.1", "ports": [{"port": 80, "proto": "tcp", "status": "closed"}, {"port": 443, "proto": "udp", "status": "open"}]}]`,
+			want:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := NewMasscanParser().Parse(strings.NewReader(test.input))
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: Parse() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestMasscanParserInvalid(t *testing.T) {
+	if _, err := NewMasscanParser().Parse(strings.NewReader("[\n{\"ip\": \n]")); err == nil {
+		t.Error("expected error for invalid masscan output")
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"io"
 	"io/ioutil"
 
-	"github.com/michenriksen/aquatone/core"
-
 	"github.com/lair-framework/go-nmap"
 )
 
@@ -36,15 +34,6 @@ func (p *NmapParser) Parse(r io.Reader) ([]string, error) {
 	return targets, nil
 }
 
-func (p *NmapParser) isHTTPPort(port int) bool {
-	for _, p := range core.XLargePortList {
-		if p == port {
-			return true
-		}
-	}
-	return false
-}
-
 func (p *NmapParser) hostToURLs(host nmap.Host) []string {
 	var urls []string
 	for _, port := range host.Ports {
@@ -52,29 +41,20 @@ func (p *NmapParser) hostToURLs(host nmap.Host) []string {
 			continue
 		}
 
-		var protocol string
-		if port.Service.Name == "ssl" {
-			protocol = "https"
-		} else if port.Service.Tunnel == "ssl" && (port.Service.Name != "smtp" && port.Service.Name != "imap" && port.Service.Name != "pop3") {
-			protocol = "https"
-		} else if port.Service.Name == "http" || port.Service.Name == "http-alt" {
-			protocol = "http"
-		} else {
-			if !p.isHTTPPort(port.PortId) {
-				continue
-			}
-		}
-
 		if len(host.Hostnames) > 0 {
 			for _, hostname := range host.Hostnames {
-				urls = append(urls, core.HostAndPortToURL(hostname.Name, port.PortId, protocol))
+				if url, ok := serviceToURL(hostname.Name, port.PortId, port.Service.Name, port.Service.Tunnel); ok {
+					urls = append(urls, url)
+				}
 			}
 		} else {
 			for _, address := range host.Addresses {
 				if address.AddrType == "mac" {
 					continue
 				}
-				urls = append(urls, core.HostAndPortToURL(address.Addr, port.PortId, protocol))
+				if url, ok := serviceToURL(address.Addr, port.PortId, port.Service.Name, port.Service.Tunnel); ok {
+					urls = append(urls, url)
+				}
 			}
 		}
 	}
@@ -0,0 +1,98 @@
+package parsers
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type NmapGrepableParser struct{}
+
+func NewNmapGrepableParser() *NmapGrepableParser {
+	return &NmapGrepableParser{}
+}
+
+func (p *NmapGrepableParser) Parse(r io.Reader) ([]string, error) {
+	var targets []string
+	targetsFilter := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var host, ports string
+		for _, field := range strings.Split(line, "\t") {
+			field = strings.TrimSpace(field)
+			if strings.HasPrefix(field, "Host: ") {
+				host = p.parseHost(strings.TrimPrefix(field, "Host: "))
+			} else if strings.HasPrefix(field, "Ports: ") {
+				ports = strings.TrimPrefix(field, "Ports: ")
+			}
+		}
+		if host == "" || ports == "" {
+			continue
+		}
+
+		for _, url := range p.portsToURLs(host, ports) {
+			if _, found := targetsFilter[url]; found {
+				continue
+			}
+			targets = append(targets, url)
+			targetsFilter[url] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return targets, err
+	}
+
+	return targets, nil
+}
+
+// parseHost returns the hostname from a "192.168.1.1 (router.local)" host
+// field if one was resolved, and the address otherwise.
+func (p *NmapGrepableParser) parseHost(field string) string {
+	parts := strings.SplitN(field, " ", 2)
+	if len(parts) == 2 {
+		hostname := strings.Trim(strings.TrimSpace(parts[1]), "()")
+		if hostname != "" {
+			return hostname
+		}
+	}
+	return parts[0]
+}
+
+// portsToURLs converts a ports field such as
+// "22/open/tcp//ssh///, 443/open/tcp//ssl|http///" into URLs. Each entry has
+// the form port/state/protocol/owner/service/rpc info/version/.
+func (p *NmapGrepableParser) portsToURLs(host string, ports string) []string {
+	var urls []string
+	for _, entry := range strings.Split(ports, ",") {
+		fields := strings.Split(strings.TrimSpace(entry), "/")
+		if len(fields) < 5 {
+			continue
+		}
+		if fields[1] != "open" || fields[2] != "tcp" {
+			continue
+		}
+		port, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+
+		service := fields[4]
+		var tunnel string
+		if parts := strings.SplitN(service, "|", 2); len(parts) == 2 {
+			tunnel, service = parts[0], parts[1]
+		}
+
+		if url, ok := serviceToURL(host, port, service, tunnel); ok {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
@@ -0,0 +1,45 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNmapGrepableParser(t *testing.T) {
+	input := "# Nmap 7.70 scan initiated as: nmap -oG - 10.0.0.0/24\n" +
+		"Host: 10.0.0.1 (router.local)\tStatus: Up\n" +
+		"Host: 10.0.0.1 (router.local)\tPorts: 22/open/tcp//ssh///, 80/open/tcp//http///, 443/open/tcp//ssl|http///\tIgnored State: closed (997)\n" +
+		"Host: 10.0.0.2 ()\tPorts: 8080/open/tcp//http-proxy///, 8443/filtered/tcp//https-alt///, 53/open/udp//domain///\n" +
+		"Host: 10.0.0.3 ()\tPorts: 10000/open/tcp//http///, 25/open/tcp//ssl|smtp///\n" +
+		"Host: 10.0.0.1 (router.local)\tPorts: 80/open/tcp//http///\n" +
+		"# Nmap done at Mon May  6 12:00:00 2019 -- 256 IP addresses (3 hosts up) scanned in 2.00 seconds\n"
+
+	got, err := NewNmapGrepableParser().Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []string{
+		"http://router.local/",
+		"https://router.local/",
+		"http://10.0.0.2:8080/",
+		"http://10.0.0.3:10000/",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+}
+
+func TestNmapGrepableParseHost(t *testing.T) {
+	tests := map[string]string{
+		"10.0.0.1 (router.local)": "router.local",
+		"10.0.0.1 ()":             "10.0.0.1",
+		"10.0.0.1":                "10.0.0.1",
+	}
+	p := NewNmapGrepableParser()
+	for in, want := range tests {
+		if got := p.parseHost(in); got != want {
+			t.Errorf("parseHost(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/michenriksen/aquatone/core"
+)
+
+const (
+	FormatAuto         = "auto"
+	FormatText         = "text"
+	FormatNmapXML      = "nmap-xml"
+	FormatNmapGrepable = "nmap-grepable"
+	FormatMasscanJSON  = "masscan-json"
+	FormatBurpXML      = "burp-xml"
+	FormatHAR          = "har"
+)
+
+var Formats = []string{FormatAuto, FormatText, FormatNmapXML, FormatNmapGrepable, FormatMasscanJSON, FormatBurpXML, FormatHAR}
+
+type Parser interface {
+	Parse(r io.Reader) ([]string, error)
+}
+
+func DetectFormat(r *bufio.Reader) string {
+	peek, _ := r.Peek(4096)
+	peek = bytes.TrimSpace(bytes.TrimPrefix(peek, []byte("\xef\xbb\xbf")))
+
+	switch {
+	case bytes.HasPrefix(peek, []byte("<")) && bytes.Contains(peek, []byte("<nmaprun")):
+		return FormatNmapXML
+	case bytes.HasPrefix(peek, []byte("<")) && bytes.Contains(peek, []byte("<items burpVersion")):
+		return FormatBurpXML
+	case bytes.HasPrefix(peek, []byte("{")) && bytes.Contains(peek, []byte(`"log"`)) && bytes.Contains(peek, []byte(`"entries"`)):
+		return FormatHAR
+	case (bytes.HasPrefix(peek, []byte("[")) || bytes.HasPrefix(peek, []byte("{"))) && bytes.Contains(peek, []byte(`"ports"`)):
+		return FormatMasscanJSON
+	case bytes.HasPrefix(peek, []byte("# Nmap")) || bytes.HasPrefix(peek, []byte("# Masscan")):
+		return FormatNmapGrepable
+	case bytes.Contains(peek, []byte("Host: ")) && bytes.Contains(peek, []byte("\tPorts: ")):
+		return FormatNmapGrepable
+	}
+	return FormatText
+}
+
+func isHTTPPort(port int) bool {
+	for _, p := range core.XLargePortList {
+		if p == port {
+			return true
+		}
+	}
+	return false
+}
+
+func serviceToURL(host string, port int, service string, tunnel string) (string, bool) {
+	var protocol string
+	if service == "ssl" {
+		protocol = "https"
+	} else if tunnel == "ssl" && (service != "smtp" && service != "imap" && service != "pop3") {
+		protocol = "https"
+	} else if service == "http" || service == "http-alt" {
+		protocol = "http"
+	} else {
+		if !isHTTPPort(port) {
+			return "", false
+		}
+	}
+
+	return core.HostAndPortToURL(host, port, protocol), true
+}
+
+// seedURLs filters a list of request URLs down to one http(s) URL per
+// distinct origin and path, keeping the first URL seen for each.
+func seedURLs(urls []string) []string {
+	var targets []string
+	targetsFilter := make(map[string]struct{})
+	for _, raw := range urls {
+		u, err := url.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			continue
+		}
+		u.Scheme = strings.ToLower(u.Scheme)
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			continue
+		}
+		u.Host = strings.ToLower(u.Host)
+		u.Fragment = ""
+		if u.Path == "" {
+			u.Path = "/"
+		}
+
+		key := u.Scheme + "://" + u.Host + u.EscapedPath()
+		if _, found := targetsFilter[key]; found {
+			continue
+		}
+		targets = append(targets, u.String())
+		targetsFilter[key] = struct{}{}
+	}
+	return targets
+}
@@ -0,0 +1,31 @@
+package parsers
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"example.com\n10.0.0.1\n", FormatText},
+		{"", FormatText},
+		{"\xef\xbb\xbf<?xml version=\"1.0\"?>\n<!DOCTYPE nmaprun>\n<nmaprun scanner=\"nmap\">", FormatNmapXML},
+		{"<?xml version=\"1.0\"?>\n<items burpVersion=\"2.0\" exportTime=\"\">", FormatBurpXML},
+		{`{"log": {"version": "1.2", "entries": []}}`, FormatHAR},
+		{`[{"ip": "10.0.0.1", "ports": [{"port": 80}]}]`, FormatMasscanJSON},
+		{"# Nmap 7.70 scan initiated\nHost: 10.0.0.1 ()\tStatus: Up\n", FormatNmapGrepable},
+		{"# Masscan 1.0.5 scan initiated\n", FormatNmapGrepable},
+		{"Host: 10.0.0.1 ()\tPorts: 80/open/tcp//http///\n", FormatNmapGrepable},
+		{"  \n<html><body>nmaprun</body></html>", FormatText},
+	}
+
+	for _, test := range tests {
+		if got := DetectFormat(bufio.NewReader(strings.NewReader(test.input))); got != test.want {
+			t.Errorf("DetectFormat(%q) = %s, want %s", test.input, got, test.want)
+		}
+	}
+}
@@ -3,29 +3,108 @@ package parsers
 import (
 	"bufio"
 	"io"
+	"net"
+	"strings"
 
+	"github.com/michenriksen/aquatone/core"
 	"github.com/mvdan/xurls"
 )
 
-type RegexParser struct{}
+type RegexParser struct {
+	MaxRangeHosts int
+	Out           *core.Logger
+}
 
 func NewRegexParser() *RegexParser {
-	return &RegexParser{}
+	return &RegexParser{
+		MaxRangeHosts: DefaultMaxRangeHosts,
+	}
 }
 
 func (p *RegexParser) Parse(r io.Reader) ([]string, error) {
 	var targets []string
 	targetsFilter := make(map[string]struct{})
+	addTarget := func(target string) {
+		if _, found := targetsFilter[target]; found {
+			return
+		}
+		targets = append(targets, target)
+		targetsFilter[target] = struct{}{}
+	}
 
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		for _, target := range xurls.Relaxed().FindAllString(scanner.Text(), -1) {
-			if _, found := targetsFilter[target]; found {
-				continue
-			}
-			targets = append(targets, target)
-			targetsFilter[target] = struct{}{}
+		hosts, rest := p.parseIPs(scanner.Text())
+		for _, host := range hosts {
+			addTarget(host)
+		}
+		for _, target := range xurls.Relaxed().FindAllString(rest, -1) {
+			addTarget(target)
 		}
 	}
 	return targets, nil
 }
+
+// parseIPs returns the hosts of IP ranges and the IPv6 addresses in a line,
+// which the URL regex doesn't match, along with the rest of the line with
+// those fields blanked out. Invalid ranges are skipped with a warning and
+// blanked out as well.
+func (p *RegexParser) parseIPs(line string) ([]string, string) {
+	var hosts []string
+	var rest strings.Builder
+	last := 0
+	for _, span := range fieldSpans(line) {
+		field := line[span[0]:span[1]]
+		if _, _, err := parseIPRange(field); err != errNotIPRange {
+			rangeHosts, err := expandIPRange(field, p.MaxRangeHosts)
+			if err != nil {
+				p.warn("%s, skipping\n", err)
+			}
+			hosts = append(hosts, rangeHosts...)
+		} else if ip := net.ParseIP(field); ip != nil && ip.To4() == nil {
+			hosts = append(hosts, ip.String())
+		} else {
+			continue
+		}
+		rest.WriteString(line[last:span[0]])
+		rest.WriteString(" ")
+		last = span[1]
+	}
+	rest.WriteString(line[last:])
+	return hosts, rest.String()
+}
+
+func (p *RegexParser) warn(format string, args ...interface{}) {
+	if p.Out != nil {
+		p.Out.Warn(format, args...)
+	}
+}
+
+// fieldSpans returns the start and end index of every field in a line, with
+// fields separated as by isFieldSeparator.
+func fieldSpans(line string) [][2]int {
+	var spans [][2]int
+	start := -1
+	for i, r := range line {
+		if isFieldSeparator(r) {
+			if start >= 0 {
+				spans = append(spans, [2]int{start, i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, [2]int{start, len(line)})
+	}
+	return spans
+}
+
+func isFieldSeparator(r rune) bool {
+	switch r {
+	case ' ', '\t', ',', ';', '"', '\'':
+		return true
+	}
+	return false
+}
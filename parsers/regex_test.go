@@ -0,0 +1,52 @@
+package parsers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRegexParserIPRanges(t *testing.T) {
+	input := "10.0.0.1-2, 2001:db8::1\n" +
+		"10.0.0.50-10.0.0.1\n" +
+		"10.1.0.0/16\n" +
+		"https://10.0.0.1-2.example.com/ 10.0.0.1-2 192.168.0.1/31\n"
+
+	parser := NewRegexParser()
+	parser.MaxRangeHosts = 256
+	got, err := parser.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []string{
+		"10.0.0.1",
+		"10.0.0.2",
+		"2001:db8::1",
+		"192.168.0.0",
+		"192.168.0.1",
+		"https://10.0.0.1-2.example.com/",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+}
+
+func TestRegexParserSkipsOnlyInvalidRanges(t *testing.T) {
+	input := "10.0.0.50-10.0.0.1 example.com, 10.0.0.3-4 10.1.0.0/16 https://example.org/\n"
+
+	parser := NewRegexParser()
+	parser.MaxRangeHosts = 256
+	got, err := parser.Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []string{
+		"10.0.0.3",
+		"10.0.0.4",
+		"example.com",
+		"https://example.org/",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,128 @@
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	_ "github.com/michenriksen/aquatone/agents"
+	"github.com/michenriksen/aquatone/core"
+)
+
+type Config struct {
+	Options core.Options
+	// OnPage is called with each page as soon as all agents have finished
+	// with it, while the scan is still running.
+	OnPage func(page *core.Page)
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Options: core.DefaultOptions(),
+	}
+}
+
+type Scanner struct {
+	Session *core.Session
+	config  Config
+	agents  []core.Agent
+}
+
+func New(config Config) (*Scanner, error) {
+	sess, err := core.NewSessionWithOptions(config.Options)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithSession(sess, config)
+}
+
+func NewWithSession(sess *core.Session, config Config) (*Scanner, error) {
+	agents, err := core.LoadAgents(*sess.Options.Agents, *sess.Options.DisableAgents)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, agent := range agents {
+		if err := agent.Register(sess); err != nil {
+			return nil, fmt.Errorf("Unable to register %s: %s", agent.ID(), err)
+		}
+		sess.Out.Debug("Registered %s\n", agent.ID())
+	}
+	if config.OnPage != nil {
+		sess.OnPageDone(config.OnPage)
+	}
+
+	return &Scanner{
+		Session: sess,
+		config:  config,
+		agents:  agents,
+	}, nil
+}
+
+func (s *Scanner) Agents() []core.Agent {
+	return s.agents
+}
+
+func (s *Scanner) Scan(ctx context.Context, targets []string) error {
+	sess := s.Session
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			sess.Stop()
+		case <-done:
+		}
+	}()
+
+	sess.EventBus.Publish(core.SessionStart)
+	sess.StartCheckpointing(core.SessionFilename)
+
+	for _, target := range targets {
+		if sess.Stopped() {
+			break
+		}
+		if isURL(target) {
+			if *sess.Options.Resume && sess.GetPage(target) != nil {
+				sess.Out.Debug("Skipping URL %s already present in resumed session\n", target)
+				continue
+			}
+			if hasSupportedScheme(target) {
+				sess.EventBus.Publish(core.URL, target)
+			}
+		} else {
+			sess.EventBus.Publish(core.Host, target)
+		}
+	}
+
+	sess.Pipeline.Wait()
+
+	sess.StopCheckpointing()
+	sess.EventBus.Publish(core.SessionEnd)
+	sess.Pipeline.Wait()
+	sess.End()
+
+	return ctx.Err()
+}
+
+func isURL(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "" {
+		return false
+	}
+	return true
+}
+
+func hasSupportedScheme(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "http" || u.Scheme == "https" {
+		return true
+	}
+	return false
+}
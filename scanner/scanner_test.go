@@ -0,0 +1,87 @@
+package scanner
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/michenriksen/aquatone/core"
+)
+
+func TestScanReportsPagesAsTheyAreDone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "<html><head><title>Page %s</title></head></html>", r.URL.Path)
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "aquatone-scanner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := DefaultConfig()
+	*config.Options.OutDir = dir
+	*config.Options.Silent = true
+	*config.Options.Agents = "url_requester,url_page_title_extractor"
+
+	var mutex sync.Mutex
+	sessionEnded := false
+	titles := make(map[string]string)
+	config.OnPage = func(page *core.Page) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if sessionEnded {
+			t.Errorf("expected %s to be reported before the session ended", page.URL)
+		}
+		if !page.Processed {
+			t.Errorf("expected %s to be processed when reported", page.URL)
+		}
+		page.Lock()
+		titles[page.URL] = page.PageTitle
+		page.Unlock()
+	}
+
+	scan, err := New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scan.Session.EventBus.SubscribeAsync(core.SessionEnd, func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+		sessionEnded = true
+	}, false)
+
+	targets := []string{server.URL + "/a", server.URL + "/b"}
+	if err := scan.Scan(context.Background(), targets); err != nil {
+		t.Fatal(err)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if !sessionEnded {
+		t.Error("expected the session to end")
+	}
+	if len(titles) != 2 {
+		t.Fatalf("expected 2 pages to be reported, got %d", len(titles))
+	}
+	for _, target := range targets {
+		if want := "Page " + target[len(server.URL):]; titles[target] != want {
+			t.Errorf("expected title of %s to be %q, got %q", target, want, titles[target])
+		}
+	}
+
+	finishedAt := scan.Session.Stats.FinishedAt
+	if finishedAt.IsZero() {
+		t.Fatal("expected the session to record when it finished")
+	}
+	scan.Session.End()
+	if !scan.Session.Stats.FinishedAt.Equal(finishedAt) {
+		t.Error("expected a second End not to change the finish time")
+	}
+}